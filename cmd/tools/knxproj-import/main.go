@@ -0,0 +1,84 @@
+// Command knxproj-import reads the group addresses of an ETS project export and prints KNX driver config for them.
+// Group addresses are grouped into devices and assigned traits based on their name and datapoint type,
+// the config should be checked and edited before it is used.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx"
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/ets"
+)
+
+var (
+	name    = flag.String("name", "knx", "the driver name used in the config, and the default device name prefix")
+	prefix  = flag.String("prefix", "", "prefix for device names, defaults to -name followed by a slash")
+	mode    = flag.String("mode", config.ModeTunnel, "how the driver connects to the bus, tunnel or routing")
+	gateway = flag.String("gateway", "", "host:port of the tunnelling gateway")
+	list    = flag.Bool("list", false, "list the group addresses in the project instead of printing config")
+	out     = flag.String("out", "", "file to write to, defaults to stdout")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] project.knxproj\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	if flag.NArg() != 1 {
+		flag.Usage()
+		return fmt.Errorf("a project file is required")
+	}
+	project, err := ets.ReadProjectFile(flag.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if *list {
+		for _, ga := range project.GroupAddresses {
+			fmt.Fprintf(w, "%-10s %-8s %s\n", ga.Address, ga.DPT, ga.Name)
+		}
+		return nil
+	}
+
+	devPrefix := *prefix
+	if devPrefix == "" {
+		devPrefix = *name + "/"
+	}
+	proposal := ets.ProposeDevices(project, devPrefix)
+	for _, ga := range proposal.Skipped {
+		fmt.Fprintf(os.Stderr, "skipped %s %q\n", ga.Address, ga.Name)
+	}
+
+	cfg := config.Root{
+		Conn:    config.Conn{Mode: *mode, Gateway: *gateway},
+		Devices: proposal.Devices,
+	}
+	cfg.Name = *name
+	cfg.Type = knx.DriverName
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(cfg)
+}
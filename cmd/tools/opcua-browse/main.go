@@ -0,0 +1,148 @@
+// Command opcua-browse walks the address space of an OPC UA server, printing the Objects and Variables it finds.
+// With -propose it instead prints OPC UA driver config with a device for each Object that has Variables,
+// which can be edited and used as the driver config.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gopcua/opcua"
+	"github.com/gopcua/opcua/ua"
+
+	opcuadriver "github.com/smart-core-os/sc-bos/pkg/driver/opcua"
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/rpc"
+)
+
+var (
+	endpoint   = flag.String("endpoint", "", "OPC UA server endpoint, like opc.tcp://localhost:4840")
+	root       = flag.String("root", "", "NodeId to start browsing from, defaults to the Objects folder")
+	depth      = flag.Int("depth", opcuadriver.DefaultBrowseDepth, "how many levels below root to browse")
+	namespaces = flag.String("ns", "", "comma separated namespace indexes to browse, defaults to all")
+	maxNodes   = flag.Int("max-nodes", opcuadriver.DefaultBrowseMaxNodes, "stop browsing after this many nodes")
+	propose    = flag.Bool("propose", false, "print proposed driver config instead of the address space")
+	name       = flag.String("name", "opcua", "the driver name used in proposed config, and the default device name prefix")
+	prefix     = flag.String("prefix", "", "prefix for proposed device names, defaults to -name")
+	asJSON     = flag.Bool("json", false, "print the address space as JSON")
+	out        = flag.String("out", "", "file to write to, defaults to stdout")
+	timeout    = flag.Duration("timeout", 5*time.Minute, "give up after this long")
+)
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	if *endpoint == "" {
+		return fmt.Errorf("-endpoint is required")
+	}
+	opts := opcuadriver.BrowseOptions{MaxDepth: *depth, MaxNodes: *maxNodes}
+	if *root != "" {
+		id, err := ua.ParseNodeID(*root)
+		if err != nil {
+			return fmt.Errorf("-root: %w", err)
+		}
+		opts.Root = id
+	}
+	for _, s := range strings.Split(*namespaces, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		ns, err := strconv.ParseUint(s, 10, 16)
+		if err != nil {
+			return fmt.Errorf("-ns: %w", err)
+		}
+		opts.Namespaces = append(opts.Namespaces, uint16(ns))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	client, err := opcua.NewClient(*endpoint)
+	if err != nil {
+		return err
+	}
+	if err := client.Connect(ctx); err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+	defer client.Close(context.Background())
+
+	tree, truncated, err := opcuadriver.Browse(ctx, client, opts)
+	if err != nil {
+		return err
+	}
+	if truncated {
+		fmt.Fprintf(os.Stderr, "warning: stopped after %d nodes, use -max-nodes, -depth, or -ns to browse more\n", opts.MaxNodes)
+	}
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	switch {
+	case *propose:
+		devPrefix := *prefix
+		if devPrefix == "" {
+			devPrefix = *name
+		}
+		cfg := config.Root{
+			Conn:    config.Conn{Endpoint: *endpoint},
+			Devices: opcuadriver.ProposeDevices(devPrefix, tree),
+		}
+		cfg.Name = *name
+		cfg.Type = opcuadriver.DriverName
+		return writeJSON(w, cfg)
+	case *asJSON:
+		return writeJSON(w, tree)
+	default:
+		printTree(w, tree, 0)
+		return nil
+	}
+}
+
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// printTree prints n and its children, one per line, indented by depth.
+func printTree(w io.Writer, n *rpc.Node, depth int) {
+	line := fmt.Sprintf("%s%s (%s)", strings.Repeat("  ", depth), n.DisplayName, n.NodeId)
+	if n.NodeClass == rpc.Node_VARIABLE {
+		var info []string
+		if n.DataType != "" {
+			info = append(info, n.DataType)
+		}
+		if n.EngineeringUnits != "" {
+			info = append(info, n.EngineeringUnits)
+		}
+		if n.Writable {
+			info = append(info, "writable")
+		}
+		line += " [" + strings.Join(info, ", ") + "]"
+	} else if n.NodeClass == rpc.Node_METHOD {
+		line += " method"
+	}
+	fmt.Fprintln(w, line)
+	for _, c := range n.Children {
+		printTree(w, c, depth+1)
+	}
+}
@@ -0,0 +1,169 @@
+// Command rename-devices rewrites automation and zone config to use new device names.
+//
+// The tool reads a CSV file of old and new device names, then scans a directory of config files for string values in
+// the automation and zones sections that exactly match an old name, replacing them with the new name.
+// Only the matching strings are changed, the rest of each file is left as is.
+//
+// Optionally the tool writes the renames as an aliases config file, which can be included in the controller's config
+// so requests and history queries using the old names keep working.
+// See docs/aliases.md.
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/smart-core-os/sc-bos/pkg/alias"
+)
+
+var (
+	namesFile  = flag.String("names", "", "CSV file of old,new device names (required)")
+	dir        = flag.String("dir", ".", "directory of config files to rewrite")
+	suffix     = flag.String("suffix", ".json", "file suffix to rewrite")
+	dryRun     = flag.Bool("dry-run", false, "report what would change without writing any files")
+	verbose    = flag.Bool("v", false, "verbose output")
+	aliasesOut = flag.String("aliases-out", "", "write the renames to this file as aliases config")
+	deprecated = flag.Bool("deprecated", false, "mark aliases written to -aliases-out as deprecated")
+)
+
+func main() {
+	flag.Parse()
+	if *namesFile == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	aliases, err := readNames(*namesFile, *deprecated)
+	if err != nil {
+		log.Fatalf("read %s: %v", *namesFile, err)
+	}
+	// checks for loops and duplicates, and resolves names that were renamed more than once
+	table, err := alias.NewTable(aliases)
+	if err != nil {
+		log.Fatalf("read %s: %v", *namesFile, err)
+	}
+	names := make(map[string]string, len(aliases))
+	for _, a := range aliases {
+		resolved, _ := table.Resolve(a.Alias)
+		names[a.Alias] = resolved.Name
+	}
+
+	var files, total int
+	err = filepath.WalkDir(*dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, *suffix) {
+			return nil
+		}
+		if *aliasesOut != "" && sameFile(path, *aliasesOut) {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		out, n, err := rewrite(data, names)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if n == 0 {
+			if *verbose {
+				log.Printf("%s: no changes", path)
+			}
+			return nil
+		}
+		files++
+		total += n
+		log.Printf("%s: %d names replaced", path, n)
+		if *dryRun {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(path, out, info.Mode().Perm())
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("%d names replaced in %d files", total, files)
+
+	if *aliasesOut != "" && !*dryRun {
+		if err := writeAliases(*aliasesOut, aliases); err != nil {
+			log.Fatalf("write %s: %v", *aliasesOut, err)
+		}
+		log.Printf("wrote %d aliases to %s", len(aliases), *aliasesOut)
+	}
+}
+
+// readNames reads old,new rows from the CSV file at path.
+// A header row of "old,new" or "alias,name" is skipped.
+func readNames(path string, deprecated bool) ([]alias.Alias, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = 2
+	r.TrimLeadingSpace = true
+	r.Comment = '#'
+
+	var aliases []alias.Alias
+	for first := true; ; first = false {
+		row, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if first && isHeader(row) {
+			continue
+		}
+		aliases = append(aliases, alias.Alias{Alias: row[0], Name: row[1], Deprecated: deprecated})
+	}
+	if len(aliases) == 0 {
+		return nil, errors.New("no names found")
+	}
+	return aliases, nil
+}
+
+func isHeader(row []string) bool {
+	header := strings.ToLower(row[0]) + "," + strings.ToLower(row[1])
+	return header == "old,new" || header == "alias,name"
+}
+
+// writeAliases writes aliases to path as a config file that can be included in the controller's config.
+func writeAliases(path string, aliases []alias.Alias) error {
+	data, err := json.MarshalIndent(struct {
+		Aliases []alias.Alias `json:"aliases"`
+	}{aliases}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+func sameFile(a, b string) bool {
+	aInfo, err := os.Stat(a)
+	if err != nil {
+		return false
+	}
+	bInfo, err := os.Stat(b)
+	if err != nil {
+		return false
+	}
+	return os.SameFile(aInfo, bInfo)
+}
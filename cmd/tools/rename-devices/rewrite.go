@@ -0,0 +1,115 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// rewriteSections are the top level properties of a config file whose values are rewritten.
+var rewriteSections = []string{"automation", "zones"}
+
+// rewrite returns data with string values inside rewriteSections that exactly match a key of names replaced by the
+// corresponding value.
+// Object keys are not rewritten.
+// Only the replaced strings are changed, formatting and property order are preserved.
+// Returns the number of strings replaced.
+func rewrite(data []byte, names map[string]string) ([]byte, int, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	// stack of open containers, with whether the next string in each is an object key
+	type frame struct {
+		object    bool
+		expectKey bool
+	}
+	var (
+		stack   []frame
+		topKey  string // the top level property we're in, if any
+		out     bytes.Buffer
+		written int64 // data before this offset has been copied to out
+		count   int
+	)
+	for {
+		start := dec.InputOffset()
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			if len(stack) > 0 {
+				return nil, 0, io.ErrUnexpectedEOF
+			}
+			break
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		end := dec.InputOffset()
+
+		var top *frame
+		if len(stack) > 0 {
+			top = &stack[len(stack)-1]
+		}
+		isKey := top != nil && top.object && top.expectKey
+		if top != nil && top.object && tok != json.Delim('}') {
+			top.expectKey = !top.expectKey // keys and values alternate
+		}
+
+		switch tok := tok.(type) {
+		case json.Delim:
+			switch tok {
+			case '{', '[':
+				stack = append(stack, frame{object: tok == '{', expectKey: true})
+			case '}', ']':
+				stack = stack[:len(stack)-1]
+				if len(stack) == 1 {
+					topKey = ""
+				}
+			}
+		case string:
+			if isKey {
+				if len(stack) == 1 {
+					topKey = tok
+				}
+				continue
+			}
+			if len(stack) < 2 || !slices.Contains(rewriteSections, topKey) {
+				continue
+			}
+			name, ok := names[tok]
+			if !ok {
+				continue
+			}
+			// the token is preceded by whitespace and separators, none of which contain a quote
+			quote := bytes.IndexByte(data[start:end], '"')
+			if quote < 0 {
+				return nil, 0, fmt.Errorf("no string found at offset %d", start)
+			}
+			encoded, err := encodeString(name)
+			if err != nil {
+				return nil, 0, err
+			}
+			out.Write(data[written : start+int64(quote)])
+			out.Write(encoded)
+			written = end
+			count++
+		}
+	}
+	if count == 0 {
+		return data, 0, nil
+	}
+	out.Write(data[written:])
+	return out.Bytes(), count, nil
+}
+
+// encodeString returns s as a JSON string, without escaping HTML characters.
+func encodeString(s string) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRewrite(t *testing.T) {
+	names := map[string]string{
+		"ahu-1":  "FL01/AHU/01",
+		"<lamp>": "lamp & shade",
+	}
+	tests := []struct {
+		name      string
+		in        string
+		want      string
+		wantCount int
+	}{
+		{
+			name: "automation and zones",
+			in: `{
+  "name": "ahu-1",
+  "drivers": [{"name": "ahu-1"}],
+  "automation": [
+    {"name": "history", "source": {"name": "ahu-1", "trait": "smartcore.traits.OnOff"}},
+    {"name": "lights", "devices": ["ahu-1", "ahu-10", "<lamp>"]}
+  ],
+  "zones": [{"name": "floor1", "ahu-1": {"devices":["ahu-1"]}}]
+}`,
+			want: `{
+  "name": "ahu-1",
+  "drivers": [{"name": "ahu-1"}],
+  "automation": [
+    {"name": "history", "source": {"name": "FL01/AHU/01", "trait": "smartcore.traits.OnOff"}},
+    {"name": "lights", "devices": ["FL01/AHU/01", "ahu-10", "lamp & shade"]}
+  ],
+  "zones": [{"name": "floor1", "ahu-1": {"devices":["FL01/AHU/01"]}}]
+}`,
+			wantCount: 4,
+		},
+		{
+			name:      "no matches",
+			in:        `{"automation": [{"name": "ahu-2"}]}`,
+			want:      `{"automation": [{"name": "ahu-2"}]}`,
+			wantCount: 0,
+		},
+		{
+			name:      "values after nested containers",
+			in:        `{"automation": [{"a": {"b": [1, {}]}, "c": "ahu-1", "ahu-1": "ahu-1"}], "x": "ahu-1"}`,
+			want:      `{"automation": [{"a": {"b": [1, {}]}, "c": "FL01/AHU/01", "ahu-1": "FL01/AHU/01"}], "x": "ahu-1"}`,
+			wantCount: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, n, err := rewrite([]byte(tt.in), names)
+			if err != nil {
+				t.Fatalf("rewrite() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, string(got)); diff != "" {
+				t.Errorf("rewrite() (-want +got):\n%s", diff)
+			}
+			if n != tt.wantCount {
+				t.Errorf("rewrite() count = %d, want %d", n, tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestRewrite_invalid(t *testing.T) {
+	if _, _, err := rewrite([]byte(`{"automation": [`), map[string]string{"a": "b"}); err == nil {
+		t.Error("rewrite() of invalid JSON returned no error")
+	}
+}
@@ -466,6 +466,7 @@ var typeToTraitMap = map[string]string{
 	"device_query_condition_namedescendantin":                            "devicespb.Device_Query_Condition_NameDescendantIn",
 	"device_query_condition_namedescendantinc":                           "devicespb.Device_Query_Condition_NameDescendantInc",
 	"device_query_condition_namedescendantincin":                         "devicespb.Device_Query_Condition_NameDescendantIncIn",
+	"device_query_condition_none":                                        "devicespb.Device_Query_Condition_NONE",
 	"device_query_condition_present":                                     "devicespb.Device_Query_Condition_Present",
 	"device_query_condition_stringcontains":                              "devicespb.Device_Query_Condition_StringContains",
 	"device_query_condition_stringcontainsfold":                          "devicespb.Device_Query_Condition_StringContainsFold",
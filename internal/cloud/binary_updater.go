@@ -224,6 +224,7 @@ func (u *BinaryUpdater) installBinary(ctx context.Context, client Client, latest
 		DownloadUrl:  version.PayloadURL,
 		Checksum:     version.Checksum,
 		DeploymentId: deploymentID,
+		Signature:    version.Signature,
 	})
 	switch {
 	case err == nil:
@@ -78,13 +78,15 @@ type StreamDeployment struct {
 }
 
 // VersionProjection is the artefact version a deployment targets: where to download it and how to
-// verify it. Checksum, when set, is a type-prefixed "<algo>:<base64>" digest of the payload.
+// verify it. Checksum, when set, is a type-prefixed "<algo>:<base64>" digest of the payload. Signature,
+// when set, is a detached signature of the payload that the Supervisor verifies against its signing key.
 type VersionProjection struct {
 	ID          string    `json:"id"`
 	Version     string    `json:"version"`
 	Description string    `json:"description"`
 	PayloadURL  string    `json:"payloadUrl"`
 	Checksum    string    `json:"checksum,omitempty"`
+	Signature   []byte    `json:"signature,omitempty"`
 	CreateTime  time.Time `json:"createTime"`
 }
 
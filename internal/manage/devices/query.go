@@ -18,6 +18,12 @@ import (
 	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
 )
 
+// MatchesQuery returns true if all the conditions in query match fields of device.
+// A nil query matches all devices.
+func MatchesQuery(query *devicespb.Device_Query, device *devicespb.Device) bool {
+	return deviceMatchesQuery(query, device)
+}
+
 // deviceMatchesQuery returns true if all the conditions in query match fields of device.
 func deviceMatchesQuery(query *devicespb.Device_Query, device *devicespb.Device) bool {
 	if query == nil {
@@ -71,7 +77,7 @@ func conditionMatchesValue(cond *devicespb.Device_Query_Condition, v value) bool
 }
 
 // conditionMatchesValues returns true if values match according to cmp.
-// cond.RepeatedMatch determines whether any or all values must match.
+// cond.Matcher determines whether any, all, or none of the values must match.
 func conditionMatchesValues(cond *devicespb.Device_Query_Condition, values iter.Seq[value], cmp func(value) bool) bool {
 	switch cond.Matcher {
 	case devicespb.Device_Query_Condition_MATCHER_UNSPECIFIED, devicespb.Device_Query_Condition_ANY:
@@ -90,6 +96,13 @@ func conditionMatchesValues(cond *devicespb.Device_Query_Condition, values iter.
 			}
 		}
 		return found
+	case devicespb.Device_Query_Condition_NONE:
+		for v := range values {
+			if cmp(v) {
+				return false
+			}
+		}
+		return true
 	default:
 		return false // unknown repeated match type
 	}
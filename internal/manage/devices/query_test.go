@@ -1019,3 +1019,49 @@ func Example_anyOfMatchesAbnormalOrUnreliable() {
 	//   Device02 matches: true
 	//   Device03 matches: true
 }
+
+func Example_noneExcludesChecksInMaintenance() {
+	// a value is out of range
+	device01 := &devicespb.Device{
+		Name: "Device01",
+		HealthChecks: []*healthpb.HealthCheck{
+			{Id: "Temperature", Normality: healthpb.HealthCheck_HIGH},
+		},
+	}
+	// the device is being worked on, so the abnormal check is expected
+	device02 := &devicespb.Device{
+		Name: "Device02",
+		HealthChecks: []*healthpb.HealthCheck{
+			{Id: "Temperature", Normality: healthpb.HealthCheck_HIGH, Maintenance: &healthpb.HealthCheck_Maintenance{WindowId: "w1"}},
+		},
+	}
+
+	// a device is abnormal if ANY health check is abnormal and NONE of its maintenance fields are present
+	abnormalQuery := &devicespb.Device_Query{
+		Conditions: []*devicespb.Device_Query_Condition{
+			{
+				Field: "health_checks",
+				Value: &devicespb.Device_Query_Condition_Matches{Matches: &devicespb.Device_Query{Conditions: []*devicespb.Device_Query_Condition{
+					{
+						Field: "normality",
+						Value: &devicespb.Device_Query_Condition_StringIn{StringIn: &devicespb.Device_Query_StringList{Strings: []string{"ABNORMAL", "HIGH", "LOW"}}},
+					},
+					{
+						Field:   "maintenance",
+						Matcher: devicespb.Device_Query_Condition_NONE,
+						Value:   &devicespb.Device_Query_Condition_Present{Present: &emptypb.Empty{}},
+					},
+				}}},
+			},
+		},
+	}
+	fmt.Println("Abnormal devices not in maintenance:")
+	for _, device := range []*devicespb.Device{device01, device02} {
+		fmt.Printf("  %s matches: %v\n", device.Name, deviceMatchesQuery(abnormalQuery, device))
+	}
+
+	// Output:
+	// Abnormal devices not in maintenance:
+	//   Device01 matches: true
+	//   Device02 matches: false
+}
@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor records the number, result, and latency of unary RPCs handled by the server.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		service, method := splitMethod(info.FullMethod)
+		grpcStarted.WithLabelValues(service, method).Inc()
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		grpcHandlingSeconds.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+		grpcHandled.WithLabelValues(service, method, status.Code(err).String()).Inc()
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records the number and result of streaming RPCs handled by the server.
+//
+// Requests served by an UnknownServiceHandler, like routed trait requests, are always streams,
+// the latency of those that are actually unary is recorded if the interceptor is chained after
+// interceptors.CorrectStreamInfo.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		service, method := splitMethod(info.FullMethod)
+		grpcStarted.WithLabelValues(service, method).Inc()
+		start := time.Now()
+		err := handler(srv, ss)
+		if !info.IsClientStream && !info.IsServerStream {
+			grpcHandlingSeconds.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+		}
+		grpcHandled.WithLabelValues(service, method, status.Code(err).String()).Inc()
+		return err
+	}
+}
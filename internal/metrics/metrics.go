@@ -0,0 +1,120 @@
+// Package metrics defines the Prometheus metrics exported by a controller.
+//
+// Metrics describing controller internals are package level collectors that other packages update directly,
+// use Register to add them to a registry.
+// ServiceCollector and TraitCollector are created per controller to report service states and device trait values.
+package metrics
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"google.golang.org/grpc/status"
+
+	"github.com/smart-core-os/sc-bos/internal/router"
+)
+
+// Namespace prefixes the names of all metrics exported by the controller.
+const Namespace = "scbos"
+
+var (
+	grpcStarted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "grpc_server",
+		Name:      "started_total",
+		Help:      "Number of RPCs started on the server.",
+	}, []string{"grpc_service", "grpc_method"})
+	grpcHandled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "grpc_server",
+		Name:      "handled_total",
+		Help:      "Number of RPCs completed on the server, regardless of success or failure.",
+	}, []string{"grpc_service", "grpc_method", "grpc_code"})
+	grpcHandlingSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "grpc_server",
+		Name:      "handling_seconds",
+		Help:      "Time taken by the server to handle unary RPCs.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"grpc_service", "grpc_method"})
+
+	routerResolutions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "router",
+		Name:      "resolutions_total",
+		Help:      "Number of requests the router has resolved a route for, by the kind of route matched.",
+	}, []string{"grpc_service", "route", "grpc_code"})
+
+	gatewayNodeConnected = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "gateway",
+		Name:      "node_connected",
+		Help:      "Whether the gateway has a ready connection to the remote node, 1 if connected, 0 if not.",
+	}, []string{"node"})
+
+	historyAppends = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "history",
+		Name:      "appends_total",
+		Help:      "Number of records appended to history stores by history automations.",
+	}, []string{"trait", "storage", "result"})
+)
+
+// Register adds the controller internal metrics and the standard Go runtime and process collectors to reg.
+func Register(reg prometheus.Registerer) error {
+	return errors.Join(
+		reg.Register(collectors.NewGoCollector()),
+		reg.Register(collectors.NewProcessCollector(collectors.ProcessCollectorOpts{})),
+		reg.Register(grpcStarted),
+		reg.Register(grpcHandled),
+		reg.Register(grpcHandlingSeconds),
+		reg.Register(routerResolutions),
+		reg.Register(gatewayNodeConnected),
+		reg.Register(historyAppends),
+	)
+}
+
+// ObserveResolve records the outcome of a router resolving a request.
+// It can be used with router.WithResolveObserver.
+func ObserveResolve(service string, kind router.RouteKind, err error) {
+	route := string(kind)
+	if err != nil {
+		route = "none"
+	}
+	routerResolutions.WithLabelValues(service, route, status.Code(err).String()).Inc()
+}
+
+// SetGatewayNodeConnected records whether the gateway is connected to the node at addr.
+func SetGatewayNodeConnected(addr string, connected bool) {
+	v := 0.0
+	if connected {
+		v = 1
+	}
+	gatewayNodeConnected.WithLabelValues(addr).Set(v)
+}
+
+// ForgetGatewayNode stops reporting the connection state of the node at addr,
+// typically because the node has left the cohort.
+func ForgetGatewayNode(addr string) {
+	gatewayNodeConnected.DeleteLabelValues(addr)
+}
+
+// ObserveHistoryAppend records the result of appending a record of the given trait to a history store.
+func ObserveHistoryAppend(trait, storage string, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	historyAppends.WithLabelValues(trait, storage, result).Inc()
+}
+
+// splitMethod splits a full gRPC method name, like "/pkg.Service/Method", into its service and method.
+func splitMethod(fullMethod string) (service, method string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", "unknown"
+}
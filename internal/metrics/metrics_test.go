@@ -0,0 +1,145 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smart-core-os/sc-bos/internal/router"
+	"github.com/smart-core-os/sc-bos/pkg/task/service"
+)
+
+func TestRegister(t *testing.T) {
+	// registering twice, as if for two controllers in one process, is fine
+	for range 2 {
+		if err := Register(prometheus.NewRegistry()); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// resetGRPC clears the package level gRPC metrics so tests can be repeated.
+func resetGRPC() {
+	grpcStarted.Reset()
+	grpcHandled.Reset()
+	grpcHandlingSeconds.Reset()
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	resetGRPC()
+	interceptor := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.UnaryService/Get"}
+	ok := func(context.Context, any) (any, error) { return "ok", nil }
+	notFound := func(context.Context, any) (any, error) { return nil, status.Error(codes.NotFound, "missing") }
+	for _, handler := range []grpc.UnaryHandler{ok, ok, notFound} {
+		_, _ = interceptor(context.Background(), nil, info, handler)
+	}
+
+	if got := testutil.ToFloat64(grpcStarted.WithLabelValues("test.UnaryService", "Get")); got != 3 {
+		t.Errorf("started = %v, want 3", got)
+	}
+	if got := testutil.ToFloat64(grpcHandled.WithLabelValues("test.UnaryService", "Get", "OK")); got != 2 {
+		t.Errorf("handled OK = %v, want 2", got)
+	}
+	if got := testutil.ToFloat64(grpcHandled.WithLabelValues("test.UnaryService", "Get", "NotFound")); got != 1 {
+		t.Errorf("handled NotFound = %v, want 1", got)
+	}
+	if got := testutil.CollectAndCount(grpcHandlingSeconds, "scbos_grpc_server_handling_seconds"); got == 0 {
+		t.Error("no latency recorded")
+	}
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	resetGRPC()
+	interceptor := StreamServerInterceptor()
+	handler := func(any, grpc.ServerStream) error { return errors.New("boom") }
+	// a routed unary call, as corrected by CorrectStreamInfo
+	_ = interceptor(nil, nil, &grpc.StreamServerInfo{FullMethod: "/test.StreamService/Get"}, handler)
+	_ = interceptor(nil, nil, &grpc.StreamServerInfo{FullMethod: "/test.StreamService/Pull", IsServerStream: true}, handler)
+
+	if got := testutil.ToFloat64(grpcHandled.WithLabelValues("test.StreamService", "Pull", "Unknown")); got != 1 {
+		t.Errorf("handled Pull = %v, want 1", got)
+	}
+	assertHistogramCount(t, "test.StreamService", "Pull", 0)
+	assertHistogramCount(t, "test.StreamService", "Get", 1)
+}
+
+// assertHistogramCount checks how many latencies have been recorded for the method.
+func assertHistogramCount(t *testing.T, service, method string, want uint64) {
+	t.Helper()
+	reg := prometheus.NewPedanticRegistry()
+	reg.MustRegister(grpcHandlingSeconds)
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range families {
+		for _, m := range f.GetMetric() {
+			labels := map[string]string{}
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			if labels["grpc_service"] == service && labels["grpc_method"] == method {
+				if got := m.GetHistogram().GetSampleCount(); got != want {
+					t.Errorf("%s/%s latency samples = %d, want %d", service, method, got, want)
+				}
+				return
+			}
+		}
+	}
+	if want != 0 {
+		t.Errorf("%s/%s has no latency recorded, want %d samples", service, method, want)
+	}
+}
+
+func TestObserveResolve(t *testing.T) {
+	routerResolutions.Reset()
+	ObserveResolve("test.ResolveService", router.RouteKey, nil)
+	ObserveResolve("test.ResolveService", "", status.Error(codes.NotFound, "no route found"))
+
+	if got := testutil.ToFloat64(routerResolutions.WithLabelValues("test.ResolveService", "key", "OK")); got != 1 {
+		t.Errorf("key resolutions = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(routerResolutions.WithLabelValues("test.ResolveService", "none", "NotFound")); got != 1 {
+		t.Errorf("failed resolutions = %v, want 1", got)
+	}
+}
+
+func TestServiceCollector(t *testing.T) {
+	m := service.NewMap(func(id, kind string) (service.Lifecycle, error) {
+		return service.New(service.MonoApply(func(context.Context, struct{}) error { return nil }),
+			service.WithParser(func([]byte) (struct{}, error) { return struct{}{}, nil })), nil
+	}, service.IdIsRequired)
+	mustCreate := func(id string, state service.State) {
+		t.Helper()
+		if _, _, err := m.Create(id, "test", state); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mustCreate("running", service.State{Active: true, Config: []byte("{}")})
+	mustCreate("stopped", service.State{})
+
+	c := NewServiceCollector(map[string]*service.Map{"drivers": m})
+	want := `
+# HELP scbos_service_state The lifecycle state of each service, 1 for the current state and 0 for the others.
+# TYPE scbos_service_state gauge
+scbos_service_state{id="running",kind="drivers",state="active",type="test"} 1
+scbos_service_state{id="running",kind="drivers",state="error",type="test"} 0
+scbos_service_state{id="running",kind="drivers",state="inactive",type="test"} 0
+scbos_service_state{id="running",kind="drivers",state="loading",type="test"} 0
+scbos_service_state{id="stopped",kind="drivers",state="active",type="test"} 0
+scbos_service_state{id="stopped",kind="drivers",state="error",type="test"} 0
+scbos_service_state{id="stopped",kind="drivers",state="inactive",type="test"} 1
+scbos_service_state{id="stopped",kind="drivers",state="loading",type="test"} 0
+`
+	if err := testutil.CollectAndCompare(c, strings.NewReader(want), "scbos_service_state"); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,78 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/smart-core-os/sc-bos/pkg/task/service"
+)
+
+// Service states reported by ServiceCollector.
+const (
+	ServiceStateInactive = "inactive"
+	ServiceStateLoading  = "loading"
+	ServiceStateActive   = "active"
+	ServiceStateError    = "error"
+)
+
+var serviceStates = []string{ServiceStateInactive, ServiceStateLoading, ServiceStateActive, ServiceStateError}
+
+var (
+	serviceStateDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(Namespace, "service", "state"),
+		"The lifecycle state of each service, 1 for the current state and 0 for the others.",
+		[]string{"kind", "id", "type", "state"}, nil,
+	)
+	serviceFailedAttemptsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(Namespace, "service", "failed_attempts"),
+		"Number of consecutive attempts to start or configure each service that have failed.",
+		[]string{"kind", "id", "type"}, nil,
+	)
+)
+
+// ServiceCollector reports the lifecycle state of the services in each service.Map.
+// Services are read when metrics are collected, the collector doesn't need to be told about changes.
+type ServiceCollector struct {
+	maps map[string]*service.Map // keyed by kind, like "drivers"
+}
+
+// NewServiceCollector returns a ServiceCollector that reports on each of maps, keyed by the kind of service they
+// contain, like "drivers" or "automations".
+func NewServiceCollector(maps map[string]*service.Map) *ServiceCollector {
+	return &ServiceCollector{maps: maps}
+}
+
+func (c *ServiceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- serviceStateDesc
+	ch <- serviceFailedAttemptsDesc
+}
+
+func (c *ServiceCollector) Collect(ch chan<- prometheus.Metric) {
+	for kind, m := range c.maps {
+		for _, r := range m.States() {
+			current := ServiceState(r.State)
+			for _, state := range serviceStates {
+				v := 0.0
+				if state == current {
+					v = 1
+				}
+				ch <- prometheus.MustNewConstMetric(serviceStateDesc, prometheus.GaugeValue, v, kind, r.Id, r.Kind, state)
+			}
+			ch <- prometheus.MustNewConstMetric(serviceFailedAttemptsDesc, prometheus.GaugeValue,
+				float64(r.State.FailedAttempts), kind, r.Id, r.Kind)
+		}
+	}
+}
+
+// ServiceState summarises s as one of the ServiceState constants.
+func ServiceState(s service.State) string {
+	switch {
+	case s.Err != nil:
+		return ServiceStateError
+	case s.Loading:
+		return ServiceStateLoading
+	case s.Active:
+		return ServiceStateActive
+	default:
+		return ServiceStateInactive
+	}
+}
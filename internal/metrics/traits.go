@@ -0,0 +1,308 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/smart-core-os/sc-bos/pkg/node/alltraits"
+	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+)
+
+// TraitQuery selects device traits whose values are exported by a TraitCollector.
+type TraitQuery struct {
+	// Query selects the devices to export.
+	Query *devicespb.Device_Query
+	// Traits limits which of the devices traits are exported.
+	// If empty all traits the device has are exported, except Metadata.
+	Traits []trait.Name
+}
+
+const (
+	// traitCollectTimeout bounds how long a single collection of trait values can take.
+	traitCollectTimeout = 10 * time.Second
+	// traitCollectConcurrency limits how many trait values are fetched at once.
+	traitCollectConcurrency = 10
+	// traitFieldMaxDepth limits how deep into nested messages numeric fields are exported from.
+	traitFieldMaxDepth = 5
+)
+
+// TraitCollector exports numeric trait values of devices as gauges.
+//
+// Each time metrics are collected the devices matching each query are listed,
+// the Get methods of their traits are called,
+// and each numeric, bool, and enum field of the response is exported as a gauge labelled with the device name.
+// Gauges are named after the Get method and field path,
+// for example the ambient_temperature.value_celsius field returned by GetAirTemperature is exported as
+// scbos_trait_air_temperature_ambient_temperature_value_celsius.
+type TraitCollector struct {
+	devices devicespb.DevicesApiClient
+	conn    grpc.ClientConnInterface
+	queries []TraitQuery
+	logger  *zap.Logger
+}
+
+// NewTraitCollector returns a TraitCollector that finds devices using the devices client,
+// and reads their trait values using conn.
+func NewTraitCollector(devices devicespb.DevicesApiClient, conn grpc.ClientConnInterface, queries []TraitQuery, logger *zap.Logger) *TraitCollector {
+	return &TraitCollector{
+		devices: devices,
+		conn:    conn,
+		queries: queries,
+		logger:  logger,
+	}
+}
+
+// Describe sends nothing, the metrics of a TraitCollector depend on the devices it finds so it is unchecked.
+func (c *TraitCollector) Describe(chan<- *prometheus.Desc) {}
+
+func (c *TraitCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), traitCollectTimeout)
+	defer cancel()
+
+	targets, err := c.listTargets(ctx)
+	if err != nil {
+		c.logger.Warn("failed to list devices for trait metrics", zap.Error(err))
+		// export what we can
+	}
+
+	var mu sync.Mutex // guards ch, only one metric is sent at a time
+	send := func(m prometheus.Metric) {
+		mu.Lock()
+		defer mu.Unlock()
+		ch <- m
+	}
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(traitCollectConcurrency)
+	for _, target := range targets {
+		for _, getter := range traitGetters()[target.trait] {
+			group.Go(func() error {
+				res, err := getter.get(ctx, c.conn, target.name)
+				if err != nil {
+					c.logger.Debug("failed to get trait value for metrics",
+						zap.String("name", target.name), zap.String("method", getter.fullMethod), zap.Error(err))
+					return nil
+				}
+				exportFields(res.ProtoReflect(), getter.metricPrefix, 0, func(metric, help string, v float64) {
+					desc := prometheus.NewDesc(metric, help, []string{"name"}, nil)
+					send(prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, v, target.name))
+				})
+				return nil
+			})
+		}
+	}
+	_ = group.Wait()
+}
+
+// traitTarget is a device trait whose values are exported.
+type traitTarget struct {
+	name  string
+	trait trait.Name
+}
+
+// listTargets returns the device traits matching the collectors queries, without duplicates.
+func (c *TraitCollector) listTargets(ctx context.Context) ([]traitTarget, error) {
+	seen := make(map[traitTarget]bool)
+	var targets []traitTarget
+	for _, q := range c.queries {
+		req := &devicespb.ListDevicesRequest{
+			ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "metadata.traits"}},
+			PageSize: 1000,
+			Query:    q.Query,
+		}
+		for {
+			res, err := c.devices.ListDevices(ctx, req)
+			if err != nil {
+				return targets, err
+			}
+			for _, d := range res.Devices {
+				for _, t := range d.GetMetadata().GetTraits() {
+					tn := trait.Name(t.Name)
+					if !q.exports(tn) {
+						continue
+					}
+					target := traitTarget{name: d.Name, trait: tn}
+					if seen[target] {
+						continue
+					}
+					seen[target] = true
+					targets = append(targets, target)
+				}
+			}
+			if res.NextPageToken == "" {
+				break
+			}
+			req.PageToken = res.NextPageToken
+		}
+	}
+	return targets, nil
+}
+
+func (q TraitQuery) exports(tn trait.Name) bool {
+	if len(q.Traits) == 0 {
+		return tn != trait.Metadata
+	}
+	for _, t := range q.Traits {
+		if t == tn {
+			return true
+		}
+	}
+	return false
+}
+
+// traitGetter calls a Get method of a trait.
+type traitGetter struct {
+	fullMethod   string
+	metricPrefix string // like scbos_trait_air_temperature
+	req, res     protoreflect.MessageType
+	nameField    protoreflect.FieldDescriptor
+}
+
+func (g traitGetter) get(ctx context.Context, conn grpc.ClientConnInterface, name string) (proto.Message, error) {
+	req := g.req.New()
+	req.Set(g.nameField, protoreflect.ValueOfString(name))
+	res := g.res.New().Interface()
+	if err := conn.Invoke(ctx, g.fullMethod, req.Interface(), res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// traitGetters returns the Get methods of each known trait that can be called with only a name.
+var traitGetters = sync.OnceValue(func() map[trait.Name][]traitGetter {
+	getters := make(map[trait.Name][]traitGetter)
+	for _, tn := range alltraits.Names() {
+		for _, sd := range alltraits.ServiceDesc(tn) {
+			d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(sd.ServiceName))
+			if err != nil {
+				continue
+			}
+			service, ok := d.(protoreflect.ServiceDescriptor)
+			if !ok {
+				continue
+			}
+			for i := 0; i < service.Methods().Len(); i++ {
+				if g, ok := newTraitGetter(service.Methods().Get(i)); ok {
+					getters[tn] = append(getters[tn], g)
+				}
+			}
+		}
+	}
+	return getters
+})
+
+// newTraitGetter returns a traitGetter for method if it is a unary Get method whose request has a name field and at
+// most a read_mask besides.
+func newTraitGetter(method protoreflect.MethodDescriptor) (traitGetter, bool) {
+	if method.IsStreamingClient() || method.IsStreamingServer() || !strings.HasPrefix(string(method.Name()), "Get") {
+		return traitGetter{}, false
+	}
+	fields := method.Input().Fields()
+	nameField := fields.ByName("name")
+	if nameField == nil || nameField.Kind() != protoreflect.StringKind || nameField.IsList() {
+		return traitGetter{}, false
+	}
+	for i := 0; i < fields.Len(); i++ {
+		switch fields.Get(i).Name() {
+		case "name", "read_mask":
+		default:
+			return traitGetter{}, false
+		}
+	}
+	subject := strings.TrimPrefix(string(method.Name()), "Get")
+	return traitGetter{
+		fullMethod:   fmt.Sprintf("/%s/%s", method.Parent().FullName(), method.Name()),
+		metricPrefix: prometheus.BuildFQName(Namespace, "trait", snakeCase(subject)),
+		req:          messageType(method.Input()),
+		res:          messageType(method.Output()),
+		nameField:    nameField,
+	}, true
+}
+
+// messageType returns the generated type for desc, or a dynamic type if none is registered.
+func messageType(desc protoreflect.MessageDescriptor) protoreflect.MessageType {
+	if mt, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName()); err == nil {
+		return mt
+	}
+	return dynamicpb.NewMessageType(desc)
+}
+
+// wrapperTypes are the well known types whose value field is exported, other well known types are skipped.
+var wrapperTypes = map[protoreflect.FullName]bool{
+	"google.protobuf.DoubleValue": true,
+	"google.protobuf.FloatValue":  true,
+	"google.protobuf.Int64Value":  true,
+	"google.protobuf.UInt64Value": true,
+	"google.protobuf.Int32Value":  true,
+	"google.protobuf.UInt32Value": true,
+	"google.protobuf.BoolValue":   true,
+}
+
+// exportFields calls export for each numeric, bool, and enum field in m, recursing into set message fields.
+// Repeated and map fields are skipped, as are fields with presence that aren't set.
+func exportFields(m protoreflect.Message, prefix string, depth int, export func(metric, help string, v float64)) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsList() || fd.IsMap() || (fd.HasPresence() && !m.Has(fd)) {
+			continue
+		}
+		metric := prefix + "_" + string(fd.Name())
+		help := fmt.Sprintf("Value of the %s field of %s.", fd.Name(), m.Descriptor().FullName())
+		v := m.Get(fd)
+		switch fd.Kind() {
+		case protoreflect.BoolKind:
+			if v.Bool() {
+				export(metric, help, 1)
+			} else {
+				export(metric, help, 0)
+			}
+		case protoreflect.EnumKind:
+			export(metric, help, float64(v.Enum()))
+		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+			protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+			export(metric, help, float64(v.Int()))
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			export(metric, help, float64(v.Uint()))
+		case protoreflect.FloatKind, protoreflect.DoubleKind:
+			export(metric, help, v.Float())
+		case protoreflect.MessageKind, protoreflect.GroupKind:
+			msgName := fd.Message().FullName()
+			if depth >= traitFieldMaxDepth || (msgName.Parent() == "google.protobuf" && !wrapperTypes[msgName]) {
+				continue
+			}
+			exportFields(v.Message(), metric, depth+1, export)
+		}
+	}
+}
+
+// snakeCase converts a CamelCase name, like AirTemperature, to snake_case, like air_temperature.
+func snakeCase(s string) string {
+	var sb strings.Builder
+	runes := []rune(s)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// start a new word at an upper case letter, unless it continues an acronym
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1]))) {
+				sb.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
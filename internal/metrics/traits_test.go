@@ -0,0 +1,87 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/zap"
+
+	"github.com/smart-core-os/sc-bos/internal/manage/devices"
+	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/proto/airtemperaturepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/onoffpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/typespb"
+	"github.com/smart-core-os/sc-bos/pkg/resource"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+	"github.com/smart-core-os/sc-bos/pkg/wrap"
+)
+
+func TestTraitCollector(t *testing.T) {
+	n := node.New("test")
+	announceTemp := func(name, subsystem string, celsius float64) {
+		model := airtemperaturepb.NewModel(resource.WithInitialValue(&airtemperaturepb.AirTemperature{
+			AmbientTemperature: &typespb.Temperature{ValueCelsius: celsius},
+			Mode:               airtemperaturepb.AirTemperature_HEAT,
+		}))
+		n.Announce(name,
+			node.HasTrait(trait.AirTemperature, node.WithClients(airtemperaturepb.WrapApi(airtemperaturepb.NewModelServer(model)))),
+			node.HasTrait(trait.OnOff, node.WithClients(onoffpb.WrapApi(onoffpb.NewModelServer(onoffpb.NewModel())))),
+			node.HasMetadata(&metadatapb.Metadata{Membership: &metadatapb.Metadata_Membership{Subsystem: subsystem}}),
+		)
+	}
+	announceTemp("fcu1", "hvac", 21.5)
+	announceTemp("fcu2", "hvac", 19)
+	announceTemp("other", "lighting", 30)
+
+	devicesClient := devicespb.NewDevicesApiClient(wrap.ServerToClient(devicespb.DevicesApi_ServiceDesc, devices.NewServer(n)))
+	hvac := &devicespb.Device_Query{Conditions: []*devicespb.Device_Query_Condition{
+		{Field: "metadata.membership.subsystem", Value: &devicespb.Device_Query_Condition_StringEqual{StringEqual: "hvac"}},
+	}}
+	c := NewTraitCollector(devicesClient, n.ClientConn(), []TraitQuery{
+		{Query: hvac, Traits: []trait.Name{trait.AirTemperature}},
+		// fcu1 is selected twice but only exported once
+		{Query: &devicespb.Device_Query{Conditions: []*devicespb.Device_Query_Condition{
+			{Field: "name", Value: &devicespb.Device_Query_Condition_StringEqual{StringEqual: "fcu1"}},
+		}}},
+	}, zap.NewNop())
+
+	want := `
+# HELP scbos_trait_air_temperature_ambient_temperature_value_celsius Value of the value_celsius field of smartcore.bos.types.v1.Temperature.
+# TYPE scbos_trait_air_temperature_ambient_temperature_value_celsius gauge
+scbos_trait_air_temperature_ambient_temperature_value_celsius{name="fcu1"} 21.5
+scbos_trait_air_temperature_ambient_temperature_value_celsius{name="fcu2"} 19
+# HELP scbos_trait_air_temperature_mode Value of the mode field of smartcore.bos.airtemperature.v1.AirTemperature.
+# TYPE scbos_trait_air_temperature_mode gauge
+scbos_trait_air_temperature_mode{name="fcu1"} 3
+scbos_trait_air_temperature_mode{name="fcu2"} 3
+# HELP scbos_trait_on_off_state Value of the state field of smartcore.bos.onoff.v1.OnOff.
+# TYPE scbos_trait_on_off_state gauge
+scbos_trait_on_off_state{name="fcu1"} 0
+`
+	err := testutil.CollectAndCompare(c, strings.NewReader(want),
+		"scbos_trait_air_temperature_ambient_temperature_value_celsius",
+		"scbos_trait_air_temperature_mode",
+		"scbos_trait_on_off_state",
+	)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"AirTemperature": "air_temperature",
+		"Demand":         "demand",
+		"OnOff":          "on_off",
+		"HVACMode":       "hvac_mode",
+		"PM25":           "pm25",
+	}
+	for in, want := range tests {
+		if got := snakeCase(in); got != want {
+			t.Errorf("snakeCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
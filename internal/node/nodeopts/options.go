@@ -4,7 +4,10 @@ package nodeopts
 import (
 	"context"
 
+	"google.golang.org/grpc"
+
 	"github.com/smart-core-os/sc-bos/internal/router"
+	"github.com/smart-core-os/sc-bos/pkg/node/priority"
 	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
 	"github.com/smart-core-os/sc-bos/pkg/resource"
 )
@@ -33,6 +36,22 @@ func WithRouter(r *router.Router) Option {
 	})
 }
 
+// WithArbiter arbitrates writes to the devices announced by the Node using a.
+// Writes to services announced with HasServices are not arbitrated, the node serving them does that.
+func WithArbiter(a *priority.Arbiter) Option {
+	return optionFunc(func(o *Struct) {
+		o.Arbiter = a
+	})
+}
+
+// WithMaintenance checks writes to the devices announced by the Node against maintenance windows, see maintenance.Windows.
+// Like WithArbiter, writes to services announced with HasServices are checked by the node serving them.
+func WithMaintenance(w ConnWrapper) Option {
+	return optionFunc(func(o *Struct) {
+		o.Maintenance = w
+	})
+}
+
 // Join combines multiple options into a single struct.
 func Join(opts ...Option) Struct {
 	var o Struct
@@ -44,8 +63,10 @@ func Join(opts ...Option) Struct {
 
 // Struct contains all options for a Node as a struct for easy access.
 type Struct struct {
-	Store  Store
-	Router *router.Router
+	Store       Store
+	Router      *router.Router
+	Arbiter     *priority.Arbiter
+	Maintenance ConnWrapper
 }
 
 func (s Struct) apply(o *Struct) {
@@ -54,6 +75,11 @@ func (s Struct) apply(o *Struct) {
 	}
 }
 
+// ConnWrapper wraps the conn used to reach a named device.
+type ConnWrapper interface {
+	Conn(name string, conn grpc.ClientConnInterface) grpc.ClientConnInterface
+}
+
 // Store describes how a node stores its announced devices.
 type Store interface {
 	GetDevice(name string, opts ...resource.ReadOption) (*devicespb.Device, error)
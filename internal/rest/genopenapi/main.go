@@ -0,0 +1,26 @@
+// Command genopenapi generates the OpenAPI document describing the REST API served by package rest.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/smart-core-os/sc-bos/internal/rest"
+)
+
+var output = flag.String("output", "openapi.json", "output file path")
+
+func main() {
+	flag.Parse()
+
+	doc, err := rest.OpenAPI()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error generating OpenAPI document: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(*output, doc, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", *output, err)
+		os.Exit(1)
+	}
+}
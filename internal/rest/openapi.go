@@ -0,0 +1,323 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// queryParamMaxDepth limits how deep into nested messages fields are documented as query parameters.
+const queryParamMaxDepth = 3
+
+// statusSchemaName is the schema of error responses.
+const statusSchemaName = "google.rpc.Status"
+
+// OpenAPI returns an OpenAPI 3 document describing Routes, encoded as JSON.
+func OpenAPI() ([]byte, error) {
+	b := &schemaBuilder{schemas: map[string]*schema{
+		statusSchemaName: {
+			Type:        "object",
+			Description: "The error returned when a request fails.",
+			Properties: map[string]*schema{
+				"code":    {Type: "integer", Format: "int32", Description: "The gRPC status code."},
+				"message": {Type: "string"},
+				"details": {Type: "array", Items: &schema{Type: "object"}},
+			},
+		},
+	}}
+	doc := document{
+		OpenAPI: "3.0.3",
+		Info: info{
+			Title:       "Smart Core BOS REST API",
+			Version:     "v1",
+			Description: "HTTP+JSON access to the trait APIs. Streaming methods respond with Server-Sent Events.",
+		},
+		Paths: make(map[string]*pathItem),
+		Components: components{
+			Schemas: b.schemas,
+			SecuritySchemes: map[string]securityScheme{
+				"bearerAuth": {Type: "http", Scheme: "bearer"},
+			},
+		},
+		Security: []map[string][]string{{"bearerAuth": {}}},
+	}
+	seenTags := make(map[string]bool)
+	for _, route := range Routes() {
+		if !seenTags[string(route.Trait)] {
+			seenTags[string(route.Trait)] = true
+			doc.Tags = append(doc.Tags, tag{Name: string(route.Trait)})
+		}
+		item := &pathItem{}
+		doc.Paths[route.Path] = item
+		item.Post = b.operation(route)
+		item.Post.RequestBody = &requestBody{
+			Content: map[string]mediaType{"application/json": {Schema: b.ref(route.Desc.Input())}},
+		}
+		if route.Get {
+			item.Get = b.operation(route)
+			item.Get.OperationID += ".get"
+			item.Get.Parameters = b.queryParams(route.Desc.Input(), "", 0)
+		}
+	}
+	slices.SortFunc(doc.Tags, func(a, b tag) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+type document struct {
+	OpenAPI    string                `json:"openapi"`
+	Info       info                  `json:"info"`
+	Tags       []tag                 `json:"tags,omitempty"`
+	Paths      map[string]*pathItem  `json:"paths"`
+	Components components            `json:"components"`
+	Security   []map[string][]string `json:"security,omitempty"`
+}
+
+type info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+type tag struct {
+	Name string `json:"name"`
+}
+
+type pathItem struct {
+	Get  *operation `json:"get,omitempty"`
+	Post *operation `json:"post,omitempty"`
+}
+
+type operation struct {
+	OperationID string              `json:"operationId"`
+	Summary     string              `json:"summary,omitempty"`
+	Tags        []string            `json:"tags,omitempty"`
+	Parameters  []parameter         `json:"parameters,omitempty"`
+	RequestBody *requestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]response `json:"responses"`
+}
+
+type parameter struct {
+	Name   string  `json:"name"`
+	In     string  `json:"in"`
+	Schema *schema `json:"schema"`
+}
+
+type requestBody struct {
+	Content map[string]mediaType `json:"content"`
+}
+
+type mediaType struct {
+	Schema *schema `json:"schema"`
+}
+
+type response struct {
+	Description string               `json:"description"`
+	Content     map[string]mediaType `json:"content,omitempty"`
+}
+
+type components struct {
+	Schemas         map[string]*schema        `json:"schemas"`
+	SecuritySchemes map[string]securityScheme `json:"securitySchemes,omitempty"`
+}
+
+type securityScheme struct {
+	Type   string `json:"type"`
+	Scheme string `json:"scheme,omitempty"`
+}
+
+type schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Items                *schema            `json:"items,omitempty"`
+	Properties           map[string]*schema `json:"properties,omitempty"`
+	AdditionalProperties *schema            `json:"additionalProperties,omitempty"`
+}
+
+// schemaBuilder converts message descriptors to schemas, collecting the schemas of referenced messages.
+type schemaBuilder struct {
+	schemas map[string]*schema // keyed by message full name
+}
+
+func (b *schemaBuilder) operation(route Route) *operation {
+	method := route.Desc
+	op := &operation{
+		OperationID: fmt.Sprintf("%s.%s", method.Parent().FullName(), method.Name()),
+		Summary:     fmt.Sprintf("%s of %s", method.Name(), method.Parent().Name()),
+		Tags:        []string{string(route.Trait)},
+		Responses: map[string]response{
+			"default": {
+				Description: "The request failed.",
+				Content:     map[string]mediaType{"application/json": {Schema: &schema{Ref: schemaRef(statusSchemaName)}}},
+			},
+		},
+	}
+	res := b.ref(method.Output())
+	if route.ServerStream {
+		op.Responses["200"] = response{
+			Description: fmt.Sprintf("A stream of Server-Sent Events, the data of each event is a %s. "+
+				"Errors are sent as an error event whose data is a %s.", method.Output().FullName(), statusSchemaName),
+			Content: map[string]mediaType{"text/event-stream": {Schema: res}},
+		}
+	} else {
+		op.Responses["200"] = response{
+			Description: "The request succeeded.",
+			Content:     map[string]mediaType{"application/json": {Schema: res}},
+		}
+	}
+	return op
+}
+
+// queryParams returns the query parameters that set the fields of md.
+func (b *schemaBuilder) queryParams(md protoreflect.MessageDescriptor, prefix string, depth int) []parameter {
+	var params []parameter
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsMap() {
+			continue
+		}
+		name := prefix + string(fd.Name())
+		if m := fd.Message(); m != nil && !fd.IsList() && !isWellKnown(m.FullName()) {
+			if depth < queryParamMaxDepth {
+				params = append(params, b.queryParams(m, name+".", depth+1)...)
+			}
+			continue
+		}
+		params = append(params, parameter{Name: name, In: "query", Schema: b.field(fd)})
+	}
+	return params
+}
+
+// ref returns a schema referencing the schema of md, adding it to the builders schemas if needed.
+// Well known types are inlined.
+func (b *schemaBuilder) ref(md protoreflect.MessageDescriptor) *schema {
+	if s, ok := wellKnownSchema(md); ok {
+		return s
+	}
+	name := string(md.FullName())
+	if _, ok := b.schemas[name]; !ok {
+		b.schemas[name] = &schema{} // placeholder for recursive messages
+		b.schemas[name] = b.message(md)
+	}
+	return &schema{Ref: schemaRef(name)}
+}
+
+func (b *schemaBuilder) message(md protoreflect.MessageDescriptor) *schema {
+	s := &schema{Type: "object", Properties: make(map[string]*schema)}
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		s.Properties[fd.JSONName()] = b.field(fd)
+	}
+	return s
+}
+
+func (b *schemaBuilder) field(fd protoreflect.FieldDescriptor) *schema {
+	switch {
+	case fd.IsMap():
+		return &schema{Type: "object", AdditionalProperties: b.value(fd.MapValue())}
+	case fd.IsList():
+		return &schema{Type: "array", Items: b.value(fd)}
+	default:
+		return b.value(fd)
+	}
+}
+
+// value returns the schema of a single value of fd, ignoring whether fd is repeated.
+func (b *schemaBuilder) value(fd protoreflect.FieldDescriptor) *schema {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return &schema{Type: "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return &schema{Type: "integer", Format: "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &schema{Type: "integer", Format: "uint32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return &schema{Type: "string", Format: "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return &schema{Type: "string", Format: "uint64"}
+	case protoreflect.FloatKind:
+		return &schema{Type: "number", Format: "float"}
+	case protoreflect.DoubleKind:
+		return &schema{Type: "number", Format: "double"}
+	case protoreflect.StringKind:
+		return &schema{Type: "string"}
+	case protoreflect.BytesKind:
+		return &schema{Type: "string", Format: "byte"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		s := &schema{Type: "string", Enum: make([]string, values.Len())}
+		for i := 0; i < values.Len(); i++ {
+			s.Enum[i] = string(values.Get(i).Name())
+		}
+		return s
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return b.ref(fd.Message())
+	default:
+		return &schema{}
+	}
+}
+
+func schemaRef(name string) string {
+	return "#/components/schemas/" + name
+}
+
+// wrapperKinds are the well known wrapper types, which are encoded as the value they wrap.
+var wrapperKinds = map[protoreflect.FullName]*schema{
+	"google.protobuf.DoubleValue": {Type: "number", Format: "double"},
+	"google.protobuf.FloatValue":  {Type: "number", Format: "float"},
+	"google.protobuf.Int64Value":  {Type: "string", Format: "int64"},
+	"google.protobuf.UInt64Value": {Type: "string", Format: "uint64"},
+	"google.protobuf.Int32Value":  {Type: "integer", Format: "int32"},
+	"google.protobuf.UInt32Value": {Type: "integer", Format: "uint32"},
+	"google.protobuf.BoolValue":   {Type: "boolean"},
+	"google.protobuf.StringValue": {Type: "string"},
+	"google.protobuf.BytesValue":  {Type: "string", Format: "byte"},
+}
+
+func isWrapper(name protoreflect.FullName) bool {
+	_, ok := wrapperKinds[name]
+	return ok
+}
+
+func isWellKnown(name protoreflect.FullName) bool {
+	return name.Parent() == "google.protobuf"
+}
+
+// wellKnownSchema returns the schema of the google.protobuf types that have a special JSON encoding.
+func wellKnownSchema(md protoreflect.MessageDescriptor) (*schema, bool) {
+	name := md.FullName()
+	if s, ok := wrapperKinds[name]; ok {
+		c := *s
+		return &c, true
+	}
+	switch name {
+	case "google.protobuf.Timestamp":
+		return &schema{Type: "string", Format: "date-time"}, true
+	case "google.protobuf.Duration":
+		return &schema{Type: "string", Description: "Seconds with up to nine fractional digits, suffixed with s, like 1.5s."}, true
+	case "google.protobuf.FieldMask":
+		return &schema{Type: "string", Description: "Comma separated field paths, like state,brightness.level."}, true
+	case "google.protobuf.Empty":
+		return &schema{Type: "object"}, true
+	case "google.protobuf.Struct", "google.protobuf.Any":
+		return &schema{Type: "object"}, true
+	case "google.protobuf.ListValue":
+		return &schema{Type: "array", Items: &schema{}}, true
+	case "google.protobuf.Value":
+		return &schema{}, true
+	}
+	return nil, false
+}
@@ -0,0 +1,111 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// decodeQuery sets the fields of m from query parameters.
+// Parameters are named after the field, using either the proto or JSON name, with dotted paths for nested fields.
+// Repeating a parameter sets each value of a repeated field.
+// Values use the same format as protojson, for example enums by name and timestamps in RFC 3339.
+func decodeQuery(query url.Values, m proto.Message) error {
+	if len(query) == 0 {
+		return nil
+	}
+	obj := make(map[string]any)
+	for key, values := range query {
+		if err := setQueryField(obj, m.ProtoReflect().Descriptor(), strings.Split(key, "."), key, values); err != nil {
+			return err
+		}
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return protojson.Unmarshal(data, m)
+}
+
+// setQueryField sets path in obj, a JSON object for a message described by md, to values.
+func setQueryField(obj map[string]any, md protoreflect.MessageDescriptor, path []string, key string, values []string) error {
+	fd := md.Fields().ByJSONName(path[0])
+	if fd == nil {
+		fd = md.Fields().ByName(protoreflect.Name(path[0]))
+	}
+	if fd == nil {
+		return fmt.Errorf("unknown query parameter %q", key)
+	}
+	if fd.IsMap() {
+		return fmt.Errorf("query parameter %q: map fields are not supported", key)
+	}
+	name := fd.JSONName()
+
+	if len(path) > 1 {
+		if fd.Message() == nil || fd.IsList() {
+			return fmt.Errorf("query parameter %q: %s is not a message", key, fd.Name())
+		}
+		child, ok := obj[name].(map[string]any)
+		if !ok {
+			child = make(map[string]any)
+			obj[name] = child
+		}
+		return setQueryField(child, fd.Message(), path[1:], key, values)
+	}
+
+	if !fd.IsList() {
+		if len(values) > 1 {
+			return fmt.Errorf("query parameter %q: repeated for a single value", key)
+		}
+		v, err := queryValue(fd, values[0])
+		if err != nil {
+			return fmt.Errorf("query parameter %q: %w", key, err)
+		}
+		obj[name] = v
+		return nil
+	}
+	list := make([]json.RawMessage, len(values))
+	for i, s := range values {
+		v, err := queryValue(fd, s)
+		if err != nil {
+			return fmt.Errorf("query parameter %q: %w", key, err)
+		}
+		list[i] = v
+	}
+	obj[name] = list
+	return nil
+}
+
+// queryValue converts s to the JSON protojson expects for a single value of fd.
+func queryValue(fd protoreflect.FieldDescriptor, s string) (json.RawMessage, error) {
+	kind := fd.Kind()
+	if md := fd.Message(); md != nil {
+		if !isWrapper(md.FullName()) {
+			// well known types like Timestamp, Duration, and FieldMask are encoded as strings
+			return json.Marshal(s)
+		}
+		kind = md.Fields().ByName("value").Kind()
+	}
+	switch kind {
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(b)
+	case protoreflect.EnumKind:
+		if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+			return json.Marshal(n)
+		}
+		return json.Marshal(s)
+	default:
+		// strings, bytes, and numbers, protojson accepts numbers as strings
+		return json.Marshal(s)
+	}
+}
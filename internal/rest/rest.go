@@ -0,0 +1,258 @@
+// Package rest serves the trait APIs as HTTP+JSON, for integrations that can't use gRPC or grpc-web.
+//
+// Each method of the services in alltraits is served at PathPrefix followed by the gRPC service and method,
+// for example /api/v1/smartcore.bos.onoff.v1.OnOffApi/UpdateOnOff.
+// Requests and responses are encoded using protojson.
+//
+// Unary methods accept POST requests with the request message as the body.
+// Methods that only read, like Get*, List*, and Describe*, also accept GET requests with the request fields as query
+// parameters, nested fields use dotted paths, like ?name=light-01&read_mask=state.
+// Server streaming methods, like Pull*, respond with Server-Sent Events, the data of each event is a response message.
+// Errors that happen after the stream has started are sent as an "error" event.
+// Client and bidirectional streaming methods are not served.
+//
+// Errors are returned as a google.rpc.Status encoded as JSON, with an HTTP status that matches the gRPC code.
+//
+// The OpenAPI document describing these routes is generated at build time and served at PathPrefix+"openapi.json".
+package rest
+
+//go:generate go run ./genopenapi -output openapi.json
+
+import (
+	_ "embed"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// PathPrefix is where the REST API is served.
+const PathPrefix = "/api/v1/"
+
+// OpenAPIPath is where the OpenAPI document is served.
+const OpenAPIPath = PathPrefix + "openapi.json"
+
+// maxRequestBytes limits the size of request bodies.
+const maxRequestBytes = 4 << 20
+
+//go:embed openapi.json
+var openAPIDoc []byte
+
+// Authorizer checks whether the HTTP request r can call the gRPC method fullMethod with req.
+// It returns a gRPC status error if the call isn't allowed.
+type Authorizer func(r *http.Request, fullMethod string, req proto.Message, serverStream bool) error
+
+// Limiter admits the HTTP request r calling the gRPC method fullMethod with req, which it may modify.
+// It returns a gRPC status error if the call is over a limit, otherwise done is called once the call,
+// or the stream of a server streaming method, has finished.
+type Limiter func(r *http.Request, fullMethod string, req proto.Message, serverStream bool) (done func(), err error)
+
+// Handler serves the trait APIs as HTTP+JSON by calling the matching gRPC method on a connection.
+type Handler struct {
+	conn      grpc.ClientConnInterface
+	authorize Authorizer
+	limit     Limiter
+	logger    *zap.Logger
+	routes    map[string]Route // keyed by full method
+}
+
+// NewHandler returns a Handler that calls trait methods using conn.
+// Requests are not authorized unless WithAuthorizer is used.
+func NewHandler(conn grpc.ClientConnInterface, opts ...Option) *Handler {
+	h := &Handler{
+		conn:   conn,
+		logger: zap.NewNop(),
+		routes: make(map[string]Route),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	for _, route := range Routes() {
+		h.routes[route.FullMethod] = route
+	}
+	return h
+}
+
+type Option func(h *Handler)
+
+// WithAuthorizer checks each request using a before the method is called.
+func WithAuthorizer(a Authorizer) Option {
+	return func(h *Handler) {
+		h.authorize = a
+	}
+}
+
+// WithLimiter admits each authorized request using l before the method is called.
+func WithLimiter(l Limiter) Option {
+	return func(h *Handler) {
+		h.limit = l
+	}
+}
+
+// WithLogger logs to logger.
+func WithLogger(logger *zap.Logger) Option {
+	return func(h *Handler) {
+		h.logger = logger
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == OpenAPIPath {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			writeError(w, http.StatusMethodNotAllowed, status.Error(codes.Unimplemented, "method not allowed"))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(openAPIDoc)
+		return
+	}
+
+	fullMethod := "/" + strings.TrimPrefix(r.URL.Path, PathPrefix)
+	route, ok := h.routes[fullMethod]
+	if !ok {
+		writeError(w, http.StatusNotFound, status.Errorf(codes.NotFound, "unknown method %s", fullMethod))
+		return
+	}
+	switch {
+	case r.Method == http.MethodPost:
+	case r.Method == http.MethodGet && route.Get:
+	default:
+		w.Header().Set("Allow", strings.Join(route.HTTPMethods(), ", "))
+		writeError(w, http.StatusMethodNotAllowed, status.Errorf(codes.Unimplemented, "%s not allowed for %s", r.Method, fullMethod))
+		return
+	}
+
+	req := route.req.New().Interface()
+	if err := decodeRequest(r, req); err != nil {
+		writeStatus(w, status.Error(codes.InvalidArgument, err.Error()))
+		return
+	}
+	if h.authorize != nil {
+		if err := h.authorize(r, fullMethod, req, route.ServerStream); err != nil {
+			writeStatus(w, err)
+			return
+		}
+	}
+	if h.limit != nil {
+		done, err := h.limit(r, fullMethod, req, route.ServerStream)
+		if err != nil {
+			writeStatus(w, err)
+			return
+		}
+		defer done()
+	}
+
+	ctx := r.Context()
+	// nodes further along the route, like those behind a gateway, check the callers credentials too
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", auth)
+	}
+	if route.ServerStream {
+		h.serveStream(ctx, w, route, req)
+		return
+	}
+	res := route.res.New().Interface()
+	if err := h.conn.Invoke(ctx, fullMethod, req, res); err != nil {
+		writeStatus(w, err)
+		return
+	}
+	writeMessage(w, http.StatusOK, res)
+}
+
+// decodeRequest reads the request message from the body of POST requests, or the query parameters of GET requests.
+func decodeRequest(r *http.Request, req proto.Message) error {
+	if r.Method == http.MethodGet {
+		return decodeQuery(r.URL.Query(), req)
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, maxRequestBytes))
+	if err != nil {
+		return err
+	}
+	if len(body) == 0 {
+		return nil
+	}
+	return protojson.Unmarshal(body, req)
+}
+
+func writeMessage(w http.ResponseWriter, code int, m proto.Message) {
+	body, err := protojson.Marshal(m)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, status.Error(codes.Internal, err.Error()))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(body)
+}
+
+// writeStatus writes err as a google.rpc.Status with an HTTP status matching its code.
+func writeStatus(w http.ResponseWriter, err error) {
+	writeError(w, httpStatus(status.Code(err)), err)
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	body, mErr := statusJSON(err)
+	if mErr != nil {
+		http.Error(w, err.Error(), code)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_, _ = w.Write(body)
+}
+
+// statusJSON encodes err as a google.rpc.Status using protojson.
+func statusJSON(err error) ([]byte, error) {
+	s := status.Convert(err)
+	body, mErr := protojson.Marshal(s.Proto())
+	if mErr != nil {
+		// details we don't know how to encode, try without them
+		body, mErr = protojson.Marshal(status.New(s.Code(), s.Message()).Proto())
+	}
+	return body, mErr
+}
+
+// httpStatus returns the HTTP status that best matches the gRPC code.
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499 // client closed request, as used by nginx
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// isEOF reports whether err means a stream ended normally.
+func isEOF(err error) bool {
+	return errors.Is(err, io.EOF)
+}
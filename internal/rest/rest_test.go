@@ -0,0 +1,270 @@
+package rest
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/proto/meterpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/onoffpb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+)
+
+func newTestHandler(t *testing.T, opts ...Option) (*Handler, *onoffpb.Model) {
+	t.Helper()
+	n := node.New("test")
+	model := onoffpb.NewModel()
+	n.Announce("light",
+		node.HasServer(onoffpb.RegisterOnOffApiServer, onoffpb.OnOffApiServer(onoffpb.NewModelServer(model))),
+		node.HasTrait(trait.OnOff),
+	)
+	return NewHandler(n.ClientConn(), opts...), model
+}
+
+func serve(h http.Handler, method, target, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func TestHandler_unary(t *testing.T) {
+	h, model := newTestHandler(t)
+
+	w := serve(h, http.MethodPost, "/api/v1/smartcore.bos.onoff.v1.OnOffApi/UpdateOnOff", `{"name": "light", "onOff": {"state": "ON"}}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("UpdateOnOff status = %d, body %s", w.Code, w.Body)
+	}
+	if got, _ := model.GetOnOff(); got.State != onoffpb.OnOff_ON {
+		t.Errorf("model state = %v after update, want ON", got.State)
+	}
+
+	w = serve(h, http.MethodGet, "/api/v1/smartcore.bos.onoff.v1.OnOffApi/GetOnOff?name=light", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetOnOff status = %d, body %s", w.Code, w.Body)
+	}
+	if ct := w.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", ct)
+	}
+	got := &onoffpb.OnOff{}
+	if err := protojson.Unmarshal(w.Body.Bytes(), got); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&onoffpb.OnOff{State: onoffpb.OnOff_ON}, got, protocmp.Transform()); diff != "" {
+		t.Errorf("GetOnOff response (-want +got):\n%s", diff)
+	}
+}
+
+func TestHandler_errors(t *testing.T) {
+	h, _ := newTestHandler(t)
+	tests := []struct {
+		name, method, target string
+		want                 int
+	}{
+		{"unknown method", http.MethodPost, "/api/v1/smartcore.bos.onoff.v1.OnOffApi/Explode", http.StatusNotFound},
+		{"unknown device", http.MethodGet, "/api/v1/smartcore.bos.onoff.v1.OnOffApi/GetOnOff?name=missing", http.StatusNotFound},
+		{"GET write", http.MethodGet, "/api/v1/smartcore.bos.onoff.v1.OnOffApi/UpdateOnOff?name=light", http.StatusMethodNotAllowed},
+		{"unknown param", http.MethodGet, "/api/v1/smartcore.bos.onoff.v1.OnOffApi/GetOnOff?name=light&colour=red", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serve(h, tt.method, tt.target, "")
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d", w.Code, tt.want)
+			}
+			if err := protojson.Unmarshal(w.Body.Bytes(), &spb.Status{}); err != nil {
+				t.Errorf("error body %s is not a status: %v", w.Body, err)
+			}
+		})
+	}
+}
+
+func TestHandler_authorize(t *testing.T) {
+	var gotMethod string
+	var gotReq proto.Message
+	h, model := newTestHandler(t, WithAuthorizer(func(r *http.Request, fullMethod string, req proto.Message, _ bool) error {
+		gotMethod, gotReq = fullMethod, req
+		return status.Error(codes.PermissionDenied, "no")
+	}))
+	w := serve(h, http.MethodPost, "/api/v1/smartcore.bos.onoff.v1.OnOffApi/UpdateOnOff", `{"name": "light", "onOff": {"state": "ON"}}`)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusForbidden)
+	}
+	if gotMethod != "/smartcore.bos.onoff.v1.OnOffApi/UpdateOnOff" {
+		t.Errorf("authorized method %q", gotMethod)
+	}
+	want := &onoffpb.UpdateOnOffRequest{Name: "light", OnOff: &onoffpb.OnOff{State: onoffpb.OnOff_ON}}
+	if diff := cmp.Diff(want, gotReq, protocmp.Transform()); diff != "" {
+		t.Errorf("authorized request (-want +got):\n%s", diff)
+	}
+	if got, _ := model.GetOnOff(); got.State == onoffpb.OnOff_ON {
+		t.Error("denied request changed the model")
+	}
+}
+
+func TestHandler_limit(t *testing.T) {
+	var streams int
+	done := make(chan struct{}, 1)
+	h, _ := newTestHandler(t, WithLimiter(func(r *http.Request, fullMethod string, req proto.Message, serverStream bool) (func(), error) {
+		if serverStream {
+			if streams > 0 {
+				return nil, status.Error(codes.ResourceExhausted, "too many streams")
+			}
+			streams++
+		}
+		return func() {
+			if serverStream {
+				streams--
+			}
+			done <- struct{}{}
+		}, nil
+	}))
+
+	w := serve(h, http.MethodGet, "/api/v1/smartcore.bos.onoff.v1.OnOffApi/GetOnOff?name=light", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetOnOff status = %d, body %s", w.Code, w.Body)
+	}
+	<-done
+
+	streams = 1
+	w = serve(h, http.MethodGet, "/api/v1/smartcore.bos.onoff.v1.OnOffApi/PullOnOff?name=light", "")
+	if w.Code != http.StatusTooManyRequests {
+		t.Errorf("PullOnOff status = %d, want %d", w.Code, http.StatusTooManyRequests)
+	}
+
+	// the stream is released when the client goes away
+	streams = 0
+	ctx, cancel := context.WithCancel(context.Background())
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/api/v1/smartcore.bos.onoff.v1.OnOffApi/PullOnOff?name=light", nil)
+	served := make(chan struct{})
+	go func() {
+		defer close(served)
+		h.ServeHTTP(httptest.NewRecorder(), r)
+	}()
+	cancel()
+	<-served
+	<-done
+	if streams != 0 {
+		t.Errorf("open streams = %d after stream ended, want 0", streams)
+	}
+}
+
+func TestHandler_stream(t *testing.T) {
+	h, model := newTestHandler(t)
+	server := httptest.NewServer(h)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/v1/smartcore.bos.onoff.v1.OnOffApi/PullOnOff?name=light", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := server.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if ct := res.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("Content-Type = %q, want text/event-stream", ct)
+	}
+
+	events := bufio.NewScanner(res.Body)
+	next := func() *onoffpb.PullOnOffResponse {
+		t.Helper()
+		for events.Scan() {
+			data, ok := strings.CutPrefix(events.Text(), "data: ")
+			if !ok {
+				continue
+			}
+			msg := &onoffpb.PullOnOffResponse{}
+			if err := protojson.Unmarshal([]byte(data), msg); err != nil {
+				t.Fatalf("event data %q: %v", data, err)
+			}
+			return msg
+		}
+		t.Fatalf("stream ended: %v", events.Err())
+		return nil
+	}
+	if got := next().Changes[0].OnOff.State; got != onoffpb.OnOff_STATE_UNSPECIFIED {
+		t.Errorf("first event state = %v, want unspecified", got)
+	}
+	if _, err := model.UpdateOnOff(&onoffpb.OnOff{State: onoffpb.OnOff_OFF}); err != nil {
+		t.Fatal(err)
+	}
+	if got := next().Changes[0].OnOff.State; got != onoffpb.OnOff_OFF {
+		t.Errorf("second event state = %v, want OFF", got)
+	}
+}
+
+func TestDecodeQuery(t *testing.T) {
+	query := url.Values{
+		"name":          {"meter-01"},
+		"readMask":      {"usage,unit"},
+		"updates_only":  {"true"},
+		"unknown_field": {"x"},
+	}
+	err := decodeQuery(query, &meterpb.PullMeterReadingsRequest{})
+	if err == nil {
+		t.Error("decodeQuery() with an unknown field succeeded, want error")
+	}
+
+	delete(query, "unknown_field")
+	got := &meterpb.PullMeterReadingsRequest{}
+	if err := decodeQuery(query, got); err != nil {
+		t.Fatalf("decodeQuery() error = %v", err)
+	}
+	want := &meterpb.PullMeterReadingsRequest{
+		Name:        "meter-01",
+		ReadMask:    &fieldmaskpb.FieldMask{Paths: []string{"usage", "unit"}},
+		UpdatesOnly: true,
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("decodeQuery() (-want +got):\n%s", diff)
+	}
+
+	nested := &onoffpb.UpdateOnOffRequest{}
+	if err := decodeQuery(url.Values{"name": {"light"}, "on_off.state": {"ON"}}, nested); err != nil {
+		t.Fatalf("decodeQuery() nested error = %v", err)
+	}
+	if nested.OnOff.GetState() != onoffpb.OnOff_ON {
+		t.Errorf("nested state = %v, want ON", nested.OnOff.GetState())
+	}
+}
+
+// TestOpenAPI checks openapi.json is up to date, run go generate if this fails.
+func TestOpenAPI(t *testing.T) {
+	want, err := OpenAPI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile("openapi.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("openapi.json is out of date, run go generate ./internal/rest")
+	}
+
+	h, _ := newTestHandler(t)
+	w := serve(h, http.MethodGet, OpenAPIPath, "")
+	if w.Code != http.StatusOK || !bytes.Equal(w.Body.Bytes(), want) {
+		t.Errorf("GET %s = %d, want the OpenAPI document", OpenAPIPath, w.Code)
+	}
+}
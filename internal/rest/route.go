@@ -0,0 +1,104 @@
+package rest
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/smart-core-os/sc-bos/pkg/node/alltraits"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+)
+
+// readPrefixes are the method name prefixes of methods that only read, which can be called using GET.
+var readPrefixes = []string{"Get", "List", "Describe", "Pull"}
+
+// Route is a gRPC method served by the REST API.
+type Route struct {
+	// FullMethod is the gRPC method, like /smartcore.bos.onoff.v1.OnOffApi/GetOnOff.
+	FullMethod string
+	// Path is where the method is served, like /api/v1/smartcore.bos.onoff.v1.OnOffApi/GetOnOff.
+	Path string
+	// Trait the method belongs to.
+	Trait trait.Name
+	// Desc describes the method.
+	Desc protoreflect.MethodDescriptor
+	// Get is true if the method can also be called using GET, with the request as query parameters.
+	Get bool
+	// ServerStream is true if the method responds with a stream of messages, served as Server-Sent Events.
+	ServerStream bool
+
+	req, res protoreflect.MessageType
+}
+
+// HTTPMethods returns the HTTP methods the route can be called with.
+func (r Route) HTTPMethods() []string {
+	if r.Get {
+		return []string{"GET", "POST"}
+	}
+	return []string{"POST"}
+}
+
+// Routes returns the methods of the alltraits services served by the REST API, sorted by path.
+var Routes = sync.OnceValue(func() []Route {
+	var routes []Route
+	seen := make(map[string]bool)
+	for _, tn := range alltraits.Names() {
+		for _, sd := range alltraits.ServiceDesc(tn) {
+			d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(sd.ServiceName))
+			if err != nil {
+				continue
+			}
+			service, ok := d.(protoreflect.ServiceDescriptor)
+			if !ok {
+				continue
+			}
+			for i := 0; i < service.Methods().Len(); i++ {
+				method := service.Methods().Get(i)
+				if method.IsStreamingClient() {
+					continue
+				}
+				fullMethod := fmt.Sprintf("/%s/%s", service.FullName(), method.Name())
+				if seen[fullMethod] {
+					continue // some services are shared between traits
+				}
+				seen[fullMethod] = true
+				routes = append(routes, Route{
+					FullMethod:   fullMethod,
+					Path:         PathPrefix + strings.TrimPrefix(fullMethod, "/"),
+					Trait:        tn,
+					Desc:         method,
+					Get:          isRead(method),
+					ServerStream: method.IsStreamingServer(),
+					req:          messageType(method.Input()),
+					res:          messageType(method.Output()),
+				})
+			}
+		}
+	}
+	slices.SortFunc(routes, func(a, b Route) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return routes
+})
+
+func isRead(method protoreflect.MethodDescriptor) bool {
+	for _, p := range readPrefixes {
+		if strings.HasPrefix(string(method.Name()), p) {
+			return true
+		}
+	}
+	return false
+}
+
+// messageType returns the generated type for desc, or a dynamic type if none is registered.
+func messageType(desc protoreflect.MessageDescriptor) protoreflect.MessageType {
+	if mt, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName()); err == nil {
+		return mt
+	}
+	return dynamicpb.NewMessageType(desc)
+}
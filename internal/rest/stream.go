@@ -0,0 +1,85 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// serveStream calls the server streaming method of route with req, writing each response as a Server-Sent Event.
+func (h *Handler) serveStream(ctx context.Context, w http.ResponseWriter, route Route, req proto.Message) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := h.conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, route.FullMethod)
+	if err != nil {
+		writeStatus(w, err)
+		return
+	}
+	if err := stream.SendMsg(req); err != nil {
+		writeStatus(w, err)
+		return
+	}
+	if err := stream.CloseSend(); err != nil {
+		writeStatus(w, err)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no") // stop proxies like nginx buffering events
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		h.logger.Debug("response doesn't support streaming", zap.String("method", route.FullMethod), zap.Error(err))
+		return
+	}
+
+	for {
+		res := route.res.New().Interface()
+		if err := stream.RecvMsg(res); err != nil {
+			if !isEOF(err) && ctx.Err() == nil {
+				if body, err := statusJSON(err); err == nil {
+					_ = writeEvent(w, "error", body)
+					_ = rc.Flush()
+				}
+			}
+			return
+		}
+		data, err := protojson.Marshal(res)
+		if err != nil {
+			h.logger.Warn("failed to encode stream response", zap.String("method", route.FullMethod), zap.Error(err))
+			return
+		}
+		if err := writeEvent(w, "", data); err != nil {
+			return // client has gone away
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// writeEvent writes a single Server-Sent Event with the given event type and data.
+// The default "message" type is used if event is empty.
+func writeEvent(w http.ResponseWriter, event string, data []byte) error {
+	var buf bytes.Buffer
+	if event != "" {
+		buf.WriteString("event: ")
+		buf.WriteString(event)
+		buf.WriteByte('\n')
+	}
+	// protojson output is a single line, but be safe as newlines would end the event early
+	for _, line := range bytes.Split(data, []byte{'\n'}) {
+		buf.WriteString("data: ")
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	buf.WriteByte('\n')
+	_, err := w.Write(buf.Bytes())
+	return err
+}
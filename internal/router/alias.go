@@ -0,0 +1,129 @@
+package router
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// DeprecationHeader is the response header that warns clients they used a deprecated alias.
+// Its value names the alias and the name that should be used in its place.
+const DeprecationHeader = "sc-deprecation"
+
+// AliasFunc returns the name that replaced key, if key is an alias.
+// If deprecated is true, responses to requests using key include a DeprecationHeader.
+// To use an AliasFunc, pass it to New with the WithAliases option.
+type AliasFunc func(key string) (name string, deprecated bool, ok bool)
+
+// WithAliases configures the router to route requests for an alias to the name returned by aliases.
+//
+// Aliases are only followed when no service-and-key or key-only route exists for the requested key,
+// so a device announced with the old name still receives its own requests.
+// The key field of requests routed via an alias is replaced with the name before they are sent to the target.
+// Aliases are looked up after any KeyInterceptor has been applied.
+func WithAliases(aliases AliasFunc) Option {
+	return func(router *Router) {
+		router.aliases = aliases
+	}
+}
+
+// resolveAlias returns the name key is an alias for,
+// and a header to add to responses if the alias is deprecated.
+// Returns false if key has its own routes, or is not an alias.
+// Must be called with r.m held.
+func (r *Router) resolveAlias(service, key string) (string, metadata.MD, bool) {
+	if r.aliases == nil || key == "" {
+		return "", nil, false
+	}
+	if _, ok := r.routes[routeID{Service: service, Key: key}]; ok {
+		return "", nil, false
+	}
+	if _, ok := r.routes[routeID{Key: key}]; ok {
+		return "", nil, false
+	}
+	name, deprecated, ok := r.aliases(key)
+	if !ok || name == key {
+		return "", nil, false
+	}
+	var header metadata.MD
+	if deprecated {
+		header = metadata.Pairs(DeprecationHeader, fmt.Sprintf("name %q is deprecated, use %q", key, name))
+	}
+	return name, header, true
+}
+
+// aliasConn is a grpc.ClientConnInterface that replaces the key field of requests with name before passing them to
+// conn, and adds header to responses.
+type aliasConn struct {
+	conn   grpc.ClientConnInterface
+	field  protoreflect.Name
+	name   string
+	header metadata.MD // may be nil
+}
+
+func (c *aliasConn) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	argsProto, ok := args.(proto.Message)
+	if !ok {
+		return ErrNonProtoMessage
+	}
+	// args belongs to the caller, don't modify it
+	argsProto = proto.Clone(argsProto)
+	c.rename(argsProto)
+	err := c.conn.Invoke(ctx, method, argsProto, reply, opts...)
+	if c.header != nil {
+		for _, opt := range opts {
+			if h, ok := opt.(grpc.HeaderCallOption); ok && h.HeaderAddr != nil {
+				*h.HeaderAddr = metadata.Join(*h.HeaderAddr, c.header)
+			}
+		}
+	}
+	return err
+}
+
+func (c *aliasConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := c.conn.NewStream(ctx, desc, method, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &aliasClientStream{ClientStream: stream, conn: c}, nil
+}
+
+// rename sets the key field of m to c.name.
+// Messages without the key field are left unchanged.
+func (c *aliasConn) rename(m proto.Message) {
+	msg := m.ProtoReflect()
+	fd := msg.Descriptor().Fields().ByName(c.field)
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.Cardinality() == protoreflect.Repeated {
+		return
+	}
+	msg.Set(fd, protoreflect.ValueOfString(c.name))
+}
+
+// aliasClientStream is a grpc.ClientStream that renames each message sent and adds the alias header to the response
+// headers.
+type aliasClientStream struct {
+	grpc.ClientStream
+	conn *aliasConn
+}
+
+func (s *aliasClientStream) SendMsg(m any) error {
+	if mProto, ok := m.(proto.Message); ok {
+		// m belongs to the caller, don't modify it
+		mProto = proto.Clone(mProto)
+		s.conn.rename(mProto)
+		m = mProto
+	}
+	return s.ClientStream.SendMsg(m)
+}
+
+func (s *aliasClientStream) Header() (metadata.MD, error) {
+	md, err := s.ClientStream.Header()
+	if err != nil || s.conn.header == nil {
+		return md, err
+	}
+	return metadata.Join(md, s.conn.header), nil
+}
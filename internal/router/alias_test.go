@@ -0,0 +1,108 @@
+package router
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/onoffpb"
+	"github.com/smart-core-os/sc-bos/pkg/wrap"
+)
+
+func TestWithAliases(t *testing.T) {
+	aliases := map[string]struct {
+		name       string
+		deprecated bool
+	}{
+		"old":        {name: "new"},
+		"older":      {name: "new", deprecated: true},
+		"overridden": {name: "new"},
+	}
+	r := New(WithAliases(func(key string) (string, bool, bool) {
+		a, ok := aliases[key]
+		return a.name, a.deprecated, ok
+	}))
+	check(t, r.AddService(routedRegistryService(t, onoffpb.OnOffApi_ServiceDesc.ServiceName, "name")))
+	newServer := &namesServer{}
+	check(t, r.AddRoute("", "new", wrap.ServerToClient(onoffpb.OnOffApi_ServiceDesc, newServer)))
+	overriddenServer := &namesServer{}
+	check(t, r.AddRoute("", "overridden", wrap.ServerToClient(onoffpb.OnOffApi_ServiceDesc, overriddenServer)))
+
+	tests := []struct {
+		name       string
+		wantServer *namesServer
+		wantName   string
+		wantHeader []string
+	}{
+		{name: "new", wantServer: newServer, wantName: "new"},
+		{name: "old", wantServer: newServer, wantName: "new"},
+		{name: "older", wantServer: newServer, wantName: "new", wantHeader: []string{`name "older" is deprecated, use "new"`}},
+		{name: "overridden", wantServer: overriddenServer, wantName: "overridden"},
+	}
+
+	run := func(t *testing.T, client onoffpb.OnOffApiClient) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				req := &onoffpb.GetOnOffRequest{Name: tt.name}
+				var header metadata.MD
+				if _, err := client.GetOnOff(t.Context(), req, grpc.Header(&header)); err != nil {
+					t.Fatalf("GetOnOff(%q) = %v", tt.name, err)
+				}
+				if got := tt.wantServer.last(); got != tt.wantName {
+					t.Errorf("server got name %q, want %q", got, tt.wantName)
+				}
+				if req.Name != tt.name {
+					t.Errorf("request modified, name is now %q", req.Name)
+				}
+				if got := header.Get(DeprecationHeader); !slices.Equal(got, tt.wantHeader) {
+					t.Errorf("header %s = %q, want %q", DeprecationHeader, got, tt.wantHeader)
+				}
+			})
+		}
+	}
+
+	t.Run("loopback", func(t *testing.T) {
+		run(t, onoffpb.NewOnOffApiClient(NewLoopback(r)))
+	})
+	t.Run("stream handler", func(t *testing.T) {
+		server := grpc.NewServer(grpc.UnknownServiceHandler(StreamHandler(r)))
+		t.Cleanup(server.Stop)
+		lis := bufconn.Listen(1024 * 1024)
+		go func() {
+			if err := server.Serve(lis); err != nil {
+				t.Errorf("server.Serve() = %v", err)
+			}
+		}()
+		conn, err := bufConn(lis)
+		if err != nil {
+			t.Fatalf("bufConn error %v", err)
+		}
+		t.Cleanup(func() { conn.Close() })
+		run(t, onoffpb.NewOnOffApiClient(conn))
+	})
+}
+
+// namesServer is an OnOffApiServer that records the name of the last request it received.
+type namesServer struct {
+	onoffpb.UnimplementedOnOffApiServer
+	mu   sync.Mutex
+	name string
+}
+
+func (s *namesServer) GetOnOff(_ context.Context, req *onoffpb.GetOnOffRequest) (*onoffpb.OnOff, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.name = req.Name
+	return &onoffpb.OnOff{}, nil
+}
+
+func (s *namesServer) last() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.name
+}
@@ -3,7 +3,9 @@ package router
 import (
 	"context"
 	"fmt"
+	"sync"
 
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -25,11 +27,15 @@ func NewLoopback(mr MethodResolver) *Loopback {
 	return &Loopback{mr: mr}
 }
 
-func (l *Loopback) Invoke(ctx context.Context, fullMethodName string, args any, reply any, opts ...grpc.CallOption) error {
+func (l *Loopback) Invoke(ctx context.Context, fullMethodName string, args any, reply any, opts ...grpc.CallOption) (err error) {
+	ctx, span := startSpan(ctx, fullMethodName, trace.SpanKindClient)
+	defer func() { endSpan(span, err) }()
+
 	argsProto, ok := args.(proto.Message)
 	if !ok {
 		return ErrNonProtoMessage
 	}
+	setSpanName(span, argsProto)
 
 	method, err := l.mr.ResolveMethod(fullMethodName)
 	if err != nil {
@@ -45,19 +51,25 @@ func (l *Loopback) Invoke(ctx context.Context, fullMethodName string, args any,
 }
 
 func (l *Loopback) NewStream(ctx context.Context, desc *grpc.StreamDesc, fullMethodName string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	ctx, span := startSpan(ctx, fullMethodName, trace.SpanKindClient)
 	method, err := l.mr.ResolveMethod(fullMethodName)
 	if err != nil {
+		endSpan(span, err)
 		return nil, err
 	}
 
-	return &deferredClientStream{
+	stream := &deferredClientStream{
 		ctx:            ctx,
 		desc:           desc,
 		fullMethodName: fullMethodName,
 		opts:           opts,
 		resolvedMethod: method,
 		ready:          make(chan struct{}),
-	}, nil
+		span:           span,
+	}
+	// streams can be abandoned by cancelling ctx without reading the final error
+	stream.stopSpan = context.AfterFunc(ctx, func() { stream.endSpan(ctx.Err()) })
+	return stream, nil
 }
 
 var (
@@ -80,6 +92,18 @@ type deferredClientStream struct {
 	ready  chan struct{}
 	stream grpc.ClientStream
 	err    error
+
+	// span covers the life of the stream, it is ended by the first error returned to the caller
+	span        trace.Span
+	stopSpan    func() bool
+	endSpanOnce sync.Once
+}
+
+func (d *deferredClientStream) endSpan(err error) {
+	d.endSpanOnce.Do(func() {
+		d.stopSpan()
+		endSpan(d.span, err)
+	})
 }
 
 func (d *deferredClientStream) Header() (metadata.MD, error) {
@@ -153,22 +177,27 @@ func (d *deferredClientStream) SendMsg(m any) error {
 	if !ok {
 		err := ErrNonProtoMessage
 		d.err = err
+		d.endSpan(err)
 		return err
 	}
+	setSpanName(d.span, mProto)
 	client, err := d.resolvedMethod.Resolver.ResolveConn(copyRecver{from: mProto})
 	if err != nil {
 		d.err = err
+		d.endSpan(err)
 		return err
 	}
 	// create the real stream and send the captured message
 	stream, err := client.NewStream(d.ctx, d.desc, d.fullMethodName, d.opts...)
 	if err != nil {
 		d.err = err
+		d.endSpan(err)
 		return err
 	}
 	err = stream.SendMsg(m)
 	if err != nil {
 		d.err = err
+		d.endSpan(err)
 		return err
 	}
 	d.stream = stream
@@ -176,6 +205,14 @@ func (d *deferredClientStream) SendMsg(m any) error {
 }
 
 func (d *deferredClientStream) RecvMsg(m any) error {
+	err := d.recvMsg(m)
+	if err != nil {
+		d.endSpan(err)
+	}
+	return err
+}
+
+func (d *deferredClientStream) recvMsg(m any) error {
 	select {
 	case <-d.ready:
 		if d.err != nil {
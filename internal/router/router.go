@@ -34,7 +34,9 @@ type MsgRecver interface {
 //
 // The list above is in order of precedence.
 type Router struct {
-	keyInterceptor KeyInterceptor // immutable
+	keyInterceptor  KeyInterceptor  // immutable
+	resolveObserver ResolveObserver // immutable
+	aliases         AliasFunc       // immutable
 
 	m sync.RWMutex
 	// mutable fields below guarded by m
@@ -307,20 +309,35 @@ func (r *Router) ResolveMethod(fullName string) (Method, error) {
 	connResolver := ConnResolverFunc(func(mr MsgRecver) (grpc.ClientConnInterface, error) {
 		var candidates []routeID // what routes should we try to match?
 		var key string
+		routable := false
 
 		if keyFunc, exists := service.keys[methodName]; exists {
 			// we can route by key
+			routable = true
 			var err error
 			key, err = keyFunc(mr)
 			if err != nil {
+				r.observeResolve(serviceName, "", err)
 				return nil, err
 			}
 			if r.keyInterceptor != nil {
 				key, err = r.keyInterceptor(key)
 				if err != nil {
+					r.observeResolve(serviceName, "", err)
 					return nil, err
 				}
 			}
+		}
+
+		r.m.RLock()
+		defer r.m.RUnlock()
+
+		var alias *aliasConn // set when key is an alias, to rename requests
+		if routable {
+			if name, header, ok := r.resolveAlias(serviceName, key); ok {
+				alias = &aliasConn{field: service.keyField, name: name, header: header}
+				key = name
+			}
 			candidates = []routeID{
 				{Service: serviceName, Key: key},
 				{Service: "", Key: key},
@@ -335,10 +352,13 @@ func (r *Router) ResolveMethod(fullName string) (Method, error) {
 			}
 		}
 
-		r.m.RLock()
-		defer r.m.RUnlock()
 		for _, candidate := range candidates {
 			if conn, exists := r.routes[candidate]; exists {
+				r.observeResolve(serviceName, candidate.kind(), nil)
+				if alias != nil {
+					alias.conn = conn
+					return alias, nil
+				}
 				return conn, nil
 			}
 		}
@@ -348,12 +368,16 @@ func (r *Router) ResolveMethod(fullName string) (Method, error) {
 		if key != "" {
 			for id := range r.routes {
 				if id.Key == key {
-					return nil, status.Error(codes.Unimplemented, "service not implemented for device")
+					err := status.Error(codes.Unimplemented, "service not implemented for device")
+					r.observeResolve(serviceName, "", err)
+					return nil, err
 				}
 			}
 		}
 
-		return nil, status.Error(codes.NotFound, "no route found")
+		err := status.Error(codes.NotFound, "no route found")
+		r.observeResolve(serviceName, "", err)
+		return nil, err
 	})
 
 	return Method{
@@ -372,6 +396,7 @@ type Service struct {
 	// immutable after creation
 	descriptor protoreflect.ServiceDescriptor
 	keys       map[string]KeyFunc // map from unqualified method name to key func
+	keyField   protoreflect.Name  // the request field keys are read from, if keys is not empty
 }
 
 // NewUnroutedService creates a new service that does not support routing by key.
@@ -394,6 +419,7 @@ func NewRoutedService(desc protoreflect.ServiceDescriptor, keyField string) (*Se
 	return &Service{
 		descriptor: desc,
 		keys:       keys,
+		keyField:   protoreflect.Name(keyField),
 	}, nil
 }
 
@@ -425,6 +451,36 @@ type routeID struct {
 	Service string
 }
 
+// kind returns which kind of route id is.
+func (id routeID) kind() RouteKind {
+	switch {
+	case id.Service != "" && id.Key != "":
+		return RouteServiceKey
+	case id.Key != "":
+		return RouteKey
+	case id.Service != "":
+		return RouteService
+	default:
+		return RouteDefault
+	}
+}
+
+// RouteKind describes which of the kinds of route supported by Router matched a request.
+type RouteKind string
+
+const (
+	RouteServiceKey RouteKind = "service_key"
+	RouteKey        RouteKind = "key"
+	RouteService    RouteKind = "service"
+	RouteDefault    RouteKind = "default"
+)
+
+func (r *Router) observeResolve(service string, kind RouteKind, err error) {
+	if r.resolveObserver != nil {
+		r.resolveObserver(service, kind, err)
+	}
+}
+
 func parseMethod(fullMethod string) (service, method string, ok bool) {
 	// strip leading /
 	if !strings.HasPrefix(fullMethod, "/") {
@@ -453,6 +509,20 @@ func WithKeyInterceptor(interceptor KeyInterceptor) Option {
 	}
 }
 
+// WithResolveObserver configures the router to call observer each time it resolves the route for a request.
+func WithResolveObserver(observer ResolveObserver) Option {
+	return func(router *Router) {
+		router.resolveObserver = observer
+	}
+}
+
+// ResolveObserver is notified of the outcome of each route resolution.
+// Service is the fully qualified name of the requested service.
+// If a route was found kind describes the matched route and err is nil, otherwise kind is empty and err is the error
+// returned to the client.
+// ResolveObserver is called while the router holds its lock so must not call back into the router.
+type ResolveObserver func(service string, kind RouteKind, err error)
+
 // KeyInterceptor is a function that can modify a key before it is used to look up a route.
 // To use a KeyInterceptor, pass it to New with the WithKeyInterceptor option.
 //
@@ -285,6 +285,34 @@ func TestWithKeyInterceptor(t *testing.T) {
 	}
 }
 
+func TestWithResolveObserver(t *testing.T) {
+	type resolved struct {
+		Service string
+		Kind    RouteKind
+		Code    codes.Code
+	}
+	var got []resolved
+	r := New(WithResolveObserver(func(service string, kind RouteKind, err error) {
+		got = append(got, resolved{service, kind, status.Code(err)})
+	}))
+	check(t, r.AddService(routedRegistryService(t, onoffpb.OnOffApi_ServiceDesc.ServiceName, "name")))
+	model := onoffpb.NewModel(resource.WithInitialValue(&onoffpb.OnOff{State: onoffpb.OnOff_OFF}))
+	check(t, r.AddRoute("", "foo", wrap.ServerToClient(onoffpb.OnOffApi_ServiceDesc, onoffpb.NewModelServer(model))))
+
+	client := onoffpb.NewOnOffApiClient(NewLoopback(r))
+	_, _ = client.GetOnOff(context.Background(), &onoffpb.GetOnOffRequest{Name: "foo"})
+	_, _ = client.GetOnOff(context.Background(), &onoffpb.GetOnOffRequest{Name: "bar"})
+
+	service := onoffpb.OnOffApi_ServiceDesc.ServiceName
+	want := []resolved{
+		{Service: service, Kind: RouteKey, Code: codes.OK},
+		{Service: service, Code: codes.NotFound},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("resolutions (-want +got):\n%s", diff)
+	}
+}
+
 // TestSwapRoute_RestoreRouteIfConn tests that SwapRoute + RestoreRouteIfConn correctly restores
 // the previous route when the current owner undoes its proxy.
 // This is important for the gateway system: when a non-gateway fallback announcer temporarily
@@ -6,6 +6,8 @@ import (
 	"io"
 	"sync"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -48,9 +50,13 @@ func StreamHandler(r MethodResolver) grpc.StreamHandler {
 		clientStream := &clientStream{notify: make(chan struct{})}
 		resolver := func(mr MsgRecver) (grpc.ClientStream, error) {
 			cc, err := target.Resolver.ResolveConn(mr)
+			// the request span was started by the server, record when the request was routed
+			span := trace.SpanFromContext(clientCtx)
 			if err != nil {
+				span.AddEvent("route failed", trace.WithAttributes(attribute.String("error", err.Error())))
 				return nil, err
 			}
+			span.AddEvent("routed")
 			return cc.NewStream(clientCtx, descriptorToStreamDesc(target.Desc), method)
 		}
 
@@ -0,0 +1,57 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var tracer = otel.Tracer("github.com/smart-core-os/sc-bos/internal/router")
+
+// startSpan starts a span for a request to fullMethod that is being routed.
+func startSpan(ctx context.Context, fullMethod string, kind trace.SpanKind) (context.Context, trace.Span) {
+	name := strings.TrimPrefix(fullMethod, "/")
+	attrs := []attribute.KeyValue{attribute.String("rpc.system", "grpc")}
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		attrs = append(attrs,
+			attribute.String("rpc.service", name[:i]),
+			attribute.String("rpc.method", name[i+1:]),
+		)
+	}
+	return tracer.Start(ctx, name, trace.WithSpanKind(kind), trace.WithAttributes(attrs...))
+}
+
+// setSpanName records the name of the device m is addressed to on span, if m has a name field.
+func setSpanName(span trace.Span, m proto.Message) {
+	if !span.IsRecording() {
+		return
+	}
+	msg := m.ProtoReflect()
+	fd := msg.Descriptor().Fields().ByName("name")
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+		return
+	}
+	span.SetAttributes(attribute.String("scbos.name", msg.Get(fd).String()))
+}
+
+// endSpan ends span recording the status of err, io.EOF is treated as success.
+func endSpan(span trace.Span, err error) {
+	if errors.Is(err, io.EOF) {
+		err = nil
+	}
+	s := status.Convert(err)
+	span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(s.Code())))
+	if err != nil {
+		span.SetStatus(otelcodes.Error, s.Message())
+	}
+	span.End()
+}
@@ -0,0 +1,159 @@
+// Package tracing sets up OpenTelemetry distributed tracing for a controller.
+//
+// Trace context is propagated between nodes using W3C Trace Context headers in gRPC metadata,
+// use ServerOption and DialOption to add this to gRPC servers and clients.
+// Propagation happens whether or not spans are exported, so a node without tracing configured doesn't break the
+// traces of requests passing through it.
+//
+// Packages create spans using otel.Tracer, spans are exported via the provider installed by Start.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"google.golang.org/grpc"
+)
+
+// ServiceName identifies spans created by a controller.
+const ServiceName = "sc-bos"
+
+// Exporters supported by Start.
+const (
+	ExporterOTLP     = "otlp"     // OTLP over gRPC, the default
+	ExporterOTLPHTTP = "otlphttp" // OTLP over HTTP
+	ExporterFile     = "file"     // JSON spans written to a file, one per line
+)
+
+// Propagator is how trace context is carried between nodes.
+var Propagator propagation.TextMapPropagator = propagation.NewCompositeTextMapPropagator(
+	propagation.TraceContext{},
+	propagation.Baggage{},
+)
+
+// Options configures the spans exported by Start.
+type Options struct {
+	// Exporter is one of the Exporter constants, defaults to ExporterOTLP.
+	Exporter string
+	// Endpoint is where OTLP spans are sent.
+	// For ExporterOTLP this is a host:port, for ExporterOTLPHTTP a URL.
+	// Defaults to the OTEL_EXPORTER_OTLP_ENDPOINT env var, or a collector on localhost.
+	Endpoint string
+	// Insecure disables TLS when connecting to Endpoint.
+	Insecure bool
+	// Headers are sent with each OTLP export, typically for authentication.
+	Headers map[string]string
+	// File is where ExporterFile writes spans.
+	File string
+	// SampleRatio is the fraction of new traces that are recorded, between 0 and 1.
+	// Traces started by other nodes are recorded if they were sampled there.
+	SampleRatio float64
+
+	// NodeName and Version describe the controller spans are exported by.
+	NodeName string
+	Version  string
+}
+
+// Start creates a TracerProvider exporting spans as described by opts, and installs it as the global provider.
+// Call Shutdown on the returned provider to flush remaining spans.
+func Start(ctx context.Context, opts Options) (*sdktrace.TracerProvider, error) {
+	exporter, err := newExporter(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	res := resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(ServiceName),
+		semconv.ServiceVersion(opts.Version),
+		semconv.ServiceInstanceID(opts.NodeName),
+	)
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider, nil
+}
+
+func newExporter(ctx context.Context, opts Options) (sdktrace.SpanExporter, error) {
+	switch opts.Exporter {
+	case "", ExporterOTLP:
+		var clientOpts []otlptracegrpc.Option
+		if opts.Endpoint != "" {
+			clientOpts = append(clientOpts, otlptracegrpc.WithEndpoint(opts.Endpoint))
+		}
+		if opts.Insecure {
+			clientOpts = append(clientOpts, otlptracegrpc.WithInsecure())
+		}
+		if len(opts.Headers) > 0 {
+			clientOpts = append(clientOpts, otlptracegrpc.WithHeaders(opts.Headers))
+		}
+		return otlptracegrpc.New(ctx, clientOpts...)
+	case ExporterOTLPHTTP:
+		var clientOpts []otlptracehttp.Option
+		if opts.Endpoint != "" {
+			clientOpts = append(clientOpts, otlptracehttp.WithEndpointURL(opts.Endpoint))
+		}
+		if opts.Insecure {
+			clientOpts = append(clientOpts, otlptracehttp.WithInsecure())
+		}
+		if len(opts.Headers) > 0 {
+			clientOpts = append(clientOpts, otlptracehttp.WithHeaders(opts.Headers))
+		}
+		return otlptracehttp.New(ctx, clientOpts...)
+	case ExporterFile:
+		if opts.File == "" {
+			return nil, errors.New("file exporter: no file")
+		}
+		if err := os.MkdirAll(filepath.Dir(opts.File), 0750); err != nil {
+			return nil, fmt.Errorf("file exporter: %w", err)
+		}
+		f, err := os.OpenFile(opts.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0640)
+		if err != nil {
+			return nil, fmt.Errorf("file exporter: %w", err)
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			_ = f.Close()
+			return nil, fmt.Errorf("file exporter: %w", err)
+		}
+		return &closingExporter{SpanExporter: exporter, closer: f}, nil
+	default:
+		return nil, fmt.Errorf("unknown exporter %q", opts.Exporter)
+	}
+}
+
+// closingExporter closes closer after the exporter is shut down.
+type closingExporter struct {
+	sdktrace.SpanExporter
+	closer io.Closer
+}
+
+func (e *closingExporter) Shutdown(ctx context.Context) error {
+	return errors.Join(e.SpanExporter.Shutdown(ctx), e.closer.Close())
+}
+
+// ServerOption returns a grpc.ServerOption that continues traces from incoming requests
+// and records a span for each RPC.
+func ServerOption() grpc.ServerOption {
+	return grpc.StatsHandler(otelgrpc.NewServerHandler(otelgrpc.WithPropagators(Propagator)))
+}
+
+// DialOption returns a grpc.DialOption that records a span for each outgoing RPC
+// and sends the trace context to the server.
+func DialOption() grpc.DialOption {
+	return grpc.WithStatsHandler(otelgrpc.NewClientHandler(otelgrpc.WithPropagators(Propagator)))
+}
@@ -0,0 +1,123 @@
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/onoffpb"
+)
+
+func TestStart_file(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "traces", "spans.json")
+	provider, err := Start(context.Background(), Options{
+		Exporter:    ExporterFile,
+		File:        file,
+		SampleRatio: 1,
+		NodeName:    "ac-01",
+		Version:     "v1.2.3",
+	})
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	_, span := otel.Tracer("test").Start(context.Background(), "test span")
+	span.End()
+	if err := provider.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	type exportedSpan struct {
+		Name     string
+		Resource []struct {
+			Key   string
+			Value struct{ Value any }
+		}
+	}
+	var spans []exportedSpan
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var s exportedSpan
+		if err := json.Unmarshal(scanner.Bytes(), &s); err != nil {
+			t.Fatalf("span %q is not JSON: %v", scanner.Text(), err)
+		}
+		spans = append(spans, s)
+	}
+	if len(spans) != 1 || spans[0].Name != "test span" {
+		t.Fatalf("exported spans %+v, want one named %q", spans, "test span")
+	}
+	res := make(map[string]any)
+	for _, kv := range spans[0].Resource {
+		res[kv.Key] = kv.Value.Value
+	}
+	if res["service.name"] != ServiceName || res["service.instance.id"] != "ac-01" || res["service.version"] != "v1.2.3" {
+		t.Errorf("span resource = %v, want service name, instance, and version", res)
+	}
+}
+
+func TestStart_unknownExporter(t *testing.T) {
+	if _, err := Start(context.Background(), Options{Exporter: "carrier-pigeon"}); err == nil {
+		t.Error("Start() with an unknown exporter succeeded, want error")
+	}
+}
+
+func TestServerOption_DialOption(t *testing.T) {
+	onOff := &contextServer{}
+	server := grpc.NewServer(ServerOption())
+	onoffpb.RegisterOnOffApiServer(server, onOff)
+	lis := bufconn.Listen(1024 * 1024)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough://bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		DialOption(),
+	)
+	if err != nil {
+		t.Fatalf("grpc.NewClient() failed: %v", err)
+	}
+	defer conn.Close()
+
+	// the provider isn't global, like a node that doesn't export spans but receives requests that are part of a trace
+	provider := sdktrace.NewTracerProvider()
+	defer provider.Shutdown(context.Background())
+	ctx, span := provider.Tracer("test").Start(context.Background(), "caller")
+	defer span.End()
+
+	if _, err := onoffpb.NewOnOffApiClient(conn).GetOnOff(ctx, &onoffpb.GetOnOffRequest{Name: "light"}); err != nil {
+		t.Fatalf("GetOnOff() error = %v", err)
+	}
+	got := trace.SpanContextFromContext(onOff.ctx)
+	if got.TraceID() != span.SpanContext().TraceID() {
+		t.Errorf("server trace ID = %v, want %v", got.TraceID(), span.SpanContext().TraceID())
+	}
+}
+
+// contextServer records the context of the last GetOnOff request.
+type contextServer struct {
+	onoffpb.UnimplementedOnOffApiServer
+	ctx context.Context
+}
+
+func (s *contextServer) GetOnOff(ctx context.Context, _ *onoffpb.GetOnOffRequest) (*onoffpb.OnOff, error) {
+	s.ctx = ctx
+	return &onoffpb.OnOff{}, nil
+}
@@ -0,0 +1,146 @@
+package pki
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"math/big"
+	"slices"
+	"sync"
+)
+
+// ErrRevoked is returned during TLS verification when the peer presents a revoked certificate.
+var ErrRevoked = errors.New("pki: certificate has been revoked")
+
+// Revoker reports whether a certificate should no longer be trusted, even if it is otherwise valid.
+type Revoker interface {
+	Revoked(cert *x509.Certificate) bool
+}
+
+// WithRevoker rejects TLS connections where the peer presents a certificate chain containing a certificate r reports as
+// revoked.
+// Any existing tls.Config.VerifyConnection is called before the revocation check.
+func WithRevoker(r Revoker) Option {
+	return func(cfg *tls.Config) {
+		next := cfg.VerifyConnection
+		cfg.VerifyConnection = func(cs tls.ConnectionState) error {
+			if next != nil {
+				if err := next(cs); err != nil {
+					return err
+				}
+			}
+			for _, cert := range cs.PeerCertificates {
+				if r.Revoked(cert) {
+					return ErrRevoked
+				}
+			}
+			return nil
+		}
+	}
+}
+
+// RevokerSet is a Revoker that reports a certificate as revoked if any of its members do.
+// Members can be added and removed while the set is in use by TLS handshakes.
+// The zero value is an empty set ready to use.
+type RevokerSet struct {
+	mu       sync.RWMutex
+	revokers []Revoker
+}
+
+// NewRevokerSet returns a RevokerSet containing rs.
+func NewRevokerSet(rs ...Revoker) *RevokerSet {
+	return &RevokerSet{revokers: rs}
+}
+
+func (rs *RevokerSet) Revoked(cert *x509.Certificate) bool {
+	if rs == nil {
+		return false
+	}
+	rs.mu.RLock()
+	defer rs.mu.RUnlock()
+	for _, r := range rs.revokers {
+		if r.Revoked(cert) {
+			return true
+		}
+	}
+	return false
+}
+
+// Append adds r to rs.
+func (rs *RevokerSet) Append(r Revoker) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.revokers = append(rs.revokers, r)
+}
+
+// Delete deletes r from rs.
+func (rs *RevokerSet) Delete(r Revoker) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.revokers = slices.DeleteFunc(rs.revokers, func(item Revoker) bool {
+		return item == r
+	})
+}
+
+// RevokedCert identifies a certificate by its issuer and serial number.
+type RevokedCert struct {
+	RawIssuer    []byte // DER encoded issuer distinguished name
+	SerialNumber *big.Int
+}
+
+// RevokedCertOf returns the RevokedCert that identifies cert.
+func RevokedCertOf(cert *x509.Certificate) RevokedCert {
+	return RevokedCert{RawIssuer: cert.RawIssuer, SerialNumber: cert.SerialNumber}
+}
+
+func (rc RevokedCert) key() string {
+	if rc.SerialNumber == nil {
+		return string(rc.RawIssuer) + "/"
+	}
+	return string(rc.RawIssuer) + "/" + rc.SerialNumber.Text(16)
+}
+
+// RevocationList is a Revoker backed by a set of RevokedCert.
+// The zero value is an empty list ready to use.
+// RevocationList is safe for concurrent use.
+type RevocationList struct {
+	mu      sync.RWMutex
+	revoked map[string]struct{}
+}
+
+func (l *RevocationList) Revoked(cert *x509.Certificate) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	_, ok := l.revoked[RevokedCertOf(cert).key()]
+	return ok
+}
+
+// Add adds certs to the list.
+func (l *RevocationList) Add(certs ...RevokedCert) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.revoked == nil {
+		l.revoked = make(map[string]struct{}, len(certs))
+	}
+	for _, c := range certs {
+		l.revoked[c.key()] = struct{}{}
+	}
+}
+
+// Set replaces all entries in the list with certs.
+func (l *RevocationList) Set(certs ...RevokedCert) {
+	revoked := make(map[string]struct{}, len(certs))
+	for _, c := range certs {
+		revoked[c.key()] = struct{}{}
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.revoked = revoked
+}
+
+// Len returns the number of entries in the list.
+func (l *RevocationList) Len() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return len(l.revoked)
+}
@@ -0,0 +1,116 @@
+package pki
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestRevocationList(t *testing.T) {
+	certA := &x509.Certificate{RawIssuer: []byte("issuer"), SerialNumber: big.NewInt(1)}
+	certB := &x509.Certificate{RawIssuer: []byte("issuer"), SerialNumber: big.NewInt(2)}
+	otherIssuer := &x509.Certificate{RawIssuer: []byte("other"), SerialNumber: big.NewInt(1)}
+
+	var l RevocationList
+	if l.Revoked(certA) {
+		t.Fatal("empty list should not revoke anything")
+	}
+
+	l.Add(RevokedCertOf(certA))
+	if !l.Revoked(certA) {
+		t.Error("certA should be revoked")
+	}
+	if l.Revoked(certB) {
+		t.Error("certB should not be revoked")
+	}
+	if l.Revoked(otherIssuer) {
+		t.Error("cert with same serial from another issuer should not be revoked")
+	}
+
+	l.Set(RevokedCertOf(certB))
+	if l.Revoked(certA) {
+		t.Error("certA should not be revoked after Set")
+	}
+	if !l.Revoked(certB) {
+		t.Error("certB should be revoked after Set")
+	}
+	if got := l.Len(); got != 1 {
+		t.Errorf("Len() = %d, want 1", got)
+	}
+}
+
+func TestWithRevoker(t *testing.T) {
+	key, err := GenerateECP256Key()
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := CreateSelfSignedCertificate(&x509.Certificate{Subject: pkix.Name{CommonName: "test"}}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cs := tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+
+	list := &RevocationList{}
+	cfg := &tls.Config{}
+	WithRevoker(list)(cfg)
+	if err := cfg.VerifyConnection(cs); err != nil {
+		t.Fatalf("VerifyConnection before revocation: %v", err)
+	}
+
+	list.Add(RevokedCertOf(cert))
+	if err := cfg.VerifyConnection(cs); !errors.Is(err, ErrRevoked) {
+		t.Fatalf("VerifyConnection after revocation: got %v, want %v", err, ErrRevoked)
+	}
+
+	// existing verification should still be performed first
+	nextErr := errors.New("next")
+	cfg = &tls.Config{VerifyConnection: func(tls.ConnectionState) error { return nextErr }}
+	WithRevoker(&RevokerSet{})(cfg)
+	if err := cfg.VerifyConnection(cs); !errors.Is(err, nextErr) {
+		t.Fatalf("VerifyConnection with existing verifier: got %v, want %v", err, nextErr)
+	}
+}
+
+func TestRevokerSet(t *testing.T) {
+	cert := &x509.Certificate{RawIssuer: []byte("issuer"), SerialNumber: big.NewInt(1)}
+	a, b := &RevocationList{}, &RevocationList{}
+	b.Add(RevokedCertOf(cert))
+
+	rs := NewRevokerSet(a)
+	if rs.Revoked(cert) {
+		t.Error("cert should not be revoked by a")
+	}
+	rs.Append(b)
+	if !rs.Revoked(cert) {
+		t.Error("cert should be revoked after appending b")
+	}
+	rs.Delete(b)
+	if rs.Revoked(cert) {
+		t.Error("cert should not be revoked after deleting b")
+	}
+}
+
+func TestRevokerSet_concurrent(t *testing.T) {
+	cert := &x509.Certificate{RawIssuer: []byte("issuer"), SerialNumber: big.NewInt(1)}
+	rs := &RevokerSet{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 1000 {
+			rs.Revoked(cert)
+		}
+	}()
+	for range 1000 {
+		l := &RevocationList{}
+		rs.Append(l)
+		rs.Delete(l)
+	}
+	<-done
+}
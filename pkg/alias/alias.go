@@ -0,0 +1,105 @@
+// Package alias maps device names that have been replaced to the names that replaced them.
+//
+// When devices are renamed, for example after a re-survey of a BACnet network, clients that still use the old names
+// keep working while they're updated: requests for an alias are routed to the device it names,
+// and history recorded under an alias is returned with the history of the device.
+// See the rename-devices tool for rewriting config to use the new names.
+package alias
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Alias is an alternative name for a device.
+type Alias struct {
+	// Alias is the name being replaced, requests for it are handled by the device called Name.
+	Alias string `json:"alias,omitempty"`
+	// Name is the name of the device.
+	Name string `json:"name,omitempty"`
+	// Deprecated adds a warning to responses to requests that use Alias, asking clients to use Name instead.
+	Deprecated bool `json:"deprecated,omitempty"`
+}
+
+// Table resolves aliases to device names.
+// A nil Table has no aliases.
+// Table is immutable, so safe for concurrent use.
+type Table struct {
+	byAlias map[string]Alias    // Name is the final name, following any chain of aliases
+	byName  map[string][]string // aliases that resolve to each name
+}
+
+// NewTable returns a Table of aliases.
+// An alias may name another alias, for example when a device is renamed twice,
+// in which case it resolves to the name at the end of the chain and is deprecated if any alias in the chain is.
+// Each alias must be unique, and chains must not loop.
+func NewTable(aliases []Alias) (*Table, error) {
+	direct := make(map[string]Alias, len(aliases))
+	for _, a := range aliases {
+		switch {
+		case a.Alias == "" || a.Name == "":
+			return nil, errors.New("alias and name are required")
+		case a.Alias == a.Name:
+			return nil, fmt.Errorf("alias %q names itself", a.Alias)
+		}
+		if _, ok := direct[a.Alias]; ok {
+			return nil, fmt.Errorf("duplicate alias %q", a.Alias)
+		}
+		direct[a.Alias] = a
+	}
+
+	t := &Table{
+		byAlias: make(map[string]Alias, len(direct)),
+		byName:  make(map[string][]string),
+	}
+	for _, a := range direct {
+		resolved := a
+		seen := map[string]bool{a.Alias: true}
+		for {
+			next, ok := direct[resolved.Name]
+			if !ok {
+				break
+			}
+			if seen[next.Alias] {
+				return nil, fmt.Errorf("alias %q loops back to itself", a.Alias)
+			}
+			seen[next.Alias] = true
+			resolved.Name = next.Name
+			resolved.Deprecated = resolved.Deprecated || next.Deprecated
+		}
+		t.byAlias[a.Alias] = resolved
+		t.byName[resolved.Name] = append(t.byName[resolved.Name], a.Alias)
+	}
+	for _, aliases := range t.byName {
+		slices.Sort(aliases)
+	}
+	return t, nil
+}
+
+// Resolve returns the alias for name, if name is an alias.
+// The returned Alias.Name is the device name to use in place of name.
+func (t *Table) Resolve(name string) (Alias, bool) {
+	if t == nil {
+		return Alias{}, false
+	}
+	a, ok := t.byAlias[name]
+	return a, ok
+}
+
+// AliasesOf returns the aliases that resolve to name, in order.
+// The returned slice must not be modified.
+func (t *Table) AliasesOf(name string) []string {
+	if t == nil {
+		return nil
+	}
+	return t.byName[name]
+}
+
+// Len returns the number of aliases in t.
+func (t *Table) Len() int {
+	if t == nil {
+		return 0
+	}
+	return len(t.byAlias)
+}
@@ -0,0 +1,71 @@
+package alias
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNewTable(t *testing.T) {
+	table, err := NewTable([]Alias{
+		{Alias: "floor1/ahu-01", Name: "FL01/AHU/01"},
+		{Alias: "ahu-1", Name: "floor1/ahu-01", Deprecated: true},
+		{Alias: "floor1/ahu-02", Name: "FL01/AHU/02"},
+	})
+	if err != nil {
+		t.Fatalf("NewTable: %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		want           string
+		wantDeprecated bool
+	}{
+		{"floor1/ahu-01", "FL01/AHU/01", false},
+		{"ahu-1", "FL01/AHU/01", true},
+		{"floor1/ahu-02", "FL01/AHU/02", false},
+	}
+	for _, tt := range tests {
+		got, ok := table.Resolve(tt.name)
+		if !ok || got.Name != tt.want || got.Deprecated != tt.wantDeprecated {
+			t.Errorf("Resolve(%q) = %+v, %v, want name %q deprecated %v", tt.name, got, ok, tt.want, tt.wantDeprecated)
+		}
+	}
+	if got, ok := table.Resolve("FL01/AHU/01"); ok {
+		t.Errorf("Resolve(name) = %+v, want not an alias", got)
+	}
+	if got, want := table.AliasesOf("FL01/AHU/01"), []string{"ahu-1", "floor1/ahu-01"}; !slices.Equal(got, want) {
+		t.Errorf("AliasesOf() = %v, want %v", got, want)
+	}
+}
+
+func TestNewTable_invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		aliases []Alias
+		wantErr string
+	}{
+		{"empty", []Alias{{Alias: "a"}}, "required"},
+		{"self", []Alias{{Alias: "a", Name: "a"}}, "names itself"},
+		{"duplicate", []Alias{{Alias: "a", Name: "b"}, {Alias: "a", Name: "c"}}, "duplicate"},
+		{"loop", []Alias{{Alias: "a", Name: "b"}, {Alias: "b", Name: "c"}, {Alias: "c", Name: "a"}}, "loops"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTable(tt.aliases)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("NewTable() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTable_nil(t *testing.T) {
+	var table *Table
+	if _, ok := table.Resolve("a"); ok {
+		t.Error("nil table resolved an alias")
+	}
+	if got := table.AliasesOf("a"); got != nil {
+		t.Errorf("AliasesOf() = %v, want nil", got)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"go.uber.org/multierr"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/smart-core-os/sc-bos/pkg/alias"
 	"github.com/smart-core-os/sc-bos/pkg/app/files"
 	"github.com/smart-core-os/sc-bos/pkg/auto"
 	"github.com/smart-core-os/sc-bos/pkg/driver"
@@ -34,6 +35,9 @@ type Config struct {
 	Drivers    []driver.RawConfig `json:"drivers,omitempty"`
 	Automation []auto.RawConfig   `json:"automation,omitempty"`
 	Zones      []zone.RawConfig   `json:"zones,omitempty"`
+	// Aliases map old device names to the names that replaced them.
+	// Aliases are merged using the Alias in a first-come, first-served nature.
+	Aliases []alias.Alias `json:"aliases,omitempty"`
 
 	// the path to the file this config was loaded from
 	FilePath string `json:"-"`
@@ -56,6 +60,7 @@ func (c *Config) mergeWith(other *Config) {
 	driverNames := c.driverNamesMap()
 	autoNames := c.autoNamesMap()
 	zoneNames := c.zoneNamesMap()
+	aliasNames := c.aliasNamesMap()
 	for _, d := range other.Drivers {
 		if _, found := driverNames[d.Name]; !found {
 			c.Drivers = append(c.Drivers, d)
@@ -71,6 +76,11 @@ func (c *Config) mergeWith(other *Config) {
 			c.Zones = append(c.Zones, z)
 		}
 	}
+	for _, a := range other.Aliases {
+		if _, found := aliasNames[a.Alias]; !found {
+			c.Aliases = append(c.Aliases, a)
+		}
+	}
 	// Includes are merged in a special way, we use the FilePath relative to c as the include.
 	relInc, err := filepath.Rel(filepath.Dir(c.FilePath), other.FilePath)
 	if err != nil {
@@ -105,6 +115,14 @@ func (c *Config) zoneNamesMap() map[string]bool {
 	return names
 }
 
+func (c *Config) aliasNamesMap() map[string]bool {
+	names := make(map[string]bool, len(c.Aliases))
+	for _, a := range c.Aliases {
+		names[a.Alias] = true
+	}
+	return names
+}
+
 func (c *Config) clone() Config {
 	return Config{
 		Name:       c.Name,
@@ -113,6 +131,7 @@ func (c *Config) clone() Config {
 		Drivers:    append([]driver.RawConfig(nil), c.Drivers...),
 		Automation: append([]auto.RawConfig(nil), c.Automation...),
 		Zones:      append([]zone.RawConfig(nil), c.Zones...),
+		Aliases:    append([]alias.Alias(nil), c.Aliases...),
 		FilePath:   c.FilePath,
 	}
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"golang.org/x/tools/txtar"
 
+	"github.com/smart-core-os/sc-bos/pkg/alias"
 	"github.com/smart-core-os/sc-bos/pkg/driver"
 )
 
@@ -254,6 +255,37 @@ func TestLoadLocalConfig(t *testing.T) {
 				FilePath: filepath.Join("data", "base.json"),
 			},
 		},
+		{
+			name: "duplicate alias ignored",
+			fs: fstest.MapFS{
+				filepath.Join("data", "base.json"): {
+					Data: []byte(`{
+						"name": "my-config",
+						"includes": ["aliases.json"],
+						"aliases": [{"alias": "ahu-1", "name": "FL01/AHU/01"}]
+					}`),
+				},
+				filepath.Join("data", "aliases.json"): {
+					Data: []byte(`{
+						"aliases": [
+							{"alias": "ahu-1", "name": "FL01/AHU/02"},
+							{"alias": "ahu-2", "name": "FL01/AHU/02", "deprecated": true}
+						]
+					}`),
+				},
+			},
+			dir:  "data",
+			file: "base.json",
+			config: &Config{
+				Name:     "my-config",
+				Includes: []string{"aliases.json"},
+				Aliases: []alias.Alias{
+					{Alias: "ahu-1", Name: "FL01/AHU/01"},
+					{Alias: "ahu-2", Name: "FL01/AHU/02", Deprecated: true},
+				},
+				FilePath: filepath.Join("data", "base.json"),
+			},
+		},
 		{
 			name: "reads directory",
 			fs:   readTxtarFS(t, "testdata/dir.txtar"),
@@ -16,6 +16,8 @@ import (
 
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"github.com/open-policy-agent/opa/v1/rego"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
 	"github.com/timshannon/bolthold"
 	"go.uber.org/multierr"
@@ -23,42 +25,54 @@ import (
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/smart-core-os/sc-bos/internal/account"
 	"github.com/smart-core-os/sc-bos/internal/audit"
 	"github.com/smart-core-os/sc-bos/internal/cloud"
 	"github.com/smart-core-os/sc-bos/internal/download"
 	"github.com/smart-core-os/sc-bos/internal/manage/devices"
+	"github.com/smart-core-os/sc-bos/internal/metrics"
 	"github.com/smart-core-os/sc-bos/internal/node/nodeopts"
 	opscloud "github.com/smart-core-os/sc-bos/internal/opsapi"
+	"github.com/smart-core-os/sc-bos/internal/rest"
 	"github.com/smart-core-os/sc-bos/internal/router"
 	"github.com/smart-core-os/sc-bos/internal/supervisor"
+	"github.com/smart-core-os/sc-bos/internal/tracing"
 	"github.com/smart-core-os/sc-bos/internal/util/grpc/interceptors"
 	"github.com/smart-core-os/sc-bos/internal/util/grpc/interceptors/protopkg"
 	"github.com/smart-core-os/sc-bos/internal/util/grpc/reflectionapi"
 	"github.com/smart-core-os/sc-bos/internal/util/pki"
 	"github.com/smart-core-os/sc-bos/internal/util/pki/expire"
+	"github.com/smart-core-os/sc-bos/pkg/alias"
 	"github.com/smart-core-os/sc-bos/pkg/app/files"
 	http2 "github.com/smart-core-os/sc-bos/pkg/app/http"
 	"github.com/smart-core-os/sc-bos/pkg/app/logcapture"
 	"github.com/smart-core-os/sc-bos/pkg/app/stores"
 	"github.com/smart-core-os/sc-bos/pkg/app/sysconf"
 	"github.com/smart-core-os/sc-bos/pkg/auth/policy"
+	"github.com/smart-core-os/sc-bos/pkg/auth/ratelimit"
 	"github.com/smart-core-os/sc-bos/pkg/auth/token"
 	"github.com/smart-core-os/sc-bos/pkg/history/dataretention"
+	"github.com/smart-core-os/sc-bos/pkg/maintenance"
 	"github.com/smart-core-os/sc-bos/pkg/manage/enrollment"
 	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/node/priority"
 	"github.com/smart-core-os/sc-bos/pkg/proto/accountpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/enrollmentpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/logpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/maintenancepb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/ops/cloudpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/prioritypb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/ratelimitpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/supervisorpb"
 	"github.com/smart-core-os/sc-bos/pkg/resource"
 	"github.com/smart-core-os/sc-bos/pkg/system/boot"
 	"github.com/smart-core-os/sc-bos/pkg/task"
+	"github.com/smart-core-os/sc-bos/pkg/task/service"
 	"github.com/smart-core-os/sc-bos/pkg/util/netutil"
 	"github.com/smart-core-os/sc-bos/pkg/wrap"
 )
@@ -99,6 +113,7 @@ func Bootstrap(ctx context.Context, config sysconf.Config) (*Controller, error)
 	if cName == "" {
 		cName = config.Name
 	}
+	shutdownTracing := initTracing(ctx, config, cName, logger)
 	idOrNodeName := func(oldID string) string {
 		if oldID == "" {
 			return cName
@@ -111,14 +126,59 @@ func Bootstrap(ctx context.Context, config sysconf.Config) (*Controller, error)
 		resource.WithIDInterceptor(idOrNodeName),
 		resource.WithNoDuplicates(),
 	)
+	// metricsRegistry collects the Prometheus metrics served by the HTTP server.
+	metricsRegistry := prometheus.NewRegistry()
+	if err := metrics.Register(metricsRegistry); err != nil {
+		logger.Warn("failed to register some metrics", zap.Error(err))
+	}
+	// aliases route requests for devices that have been renamed to the device's new name.
+	// We don't support changing aliases while running.
+	aliases, err := alias.NewTable(initialConfig.Aliases)
+	if err != nil {
+		return nil, fmt.Errorf("aliases: %w", err)
+	}
 	// nodeRouter allows non-Announced services to also be served via rootNode.ClientConn().
-	nodeRouter := router.New(router.WithKeyInterceptor(func(key string) (string, error) {
-		return idOrNodeName(key), nil
-	}))
+	nodeRouter := router.New(
+		router.WithKeyInterceptor(func(key string) (string, error) {
+			return idOrNodeName(key), nil
+		}),
+		router.WithAliases(func(key string) (string, bool, bool) {
+			a, ok := aliases.Resolve(key)
+			return a.Name, a.Deprecated, ok
+		}),
+		router.WithResolveObserver(metrics.ObserveResolve),
+	)
 	// rootNode grants both local (in-process) and networked (via grpc.Server) access to controller APIs.
 	// Announce devices on rootNode to expose them via Smart Core APIs; use rootNode.Clients to call them.
-	rootNode := node.New(cName, nodeopts.WithStore(deviceStore), nodeopts.WithRouter(nodeRouter))
+	nodeOpts := []node.Option{nodeopts.WithStore(deviceStore), nodeopts.WithRouter(nodeRouter)}
+	// arbiter decides which of the writes to a device is applied, when priority arbitration is enabled.
+	arbiter := newArbiter(config, logger)
+	if arbiter != nil {
+		nodeOpts = append(nodeOpts, nodeopts.WithArbiter(arbiter))
+	}
+	// windows are the maintenance windows of the controller's devices.
+	windows, err := maintenance.Open(ctx, files.Path(config.DataDir, sysconf.MaintenanceDBPath),
+		maintenance.WithDevices(deviceStore),
+		maintenance.WithLogger(logger.Named("maintenance")),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("maintenance windows: %w", err)
+	}
+	nodeOpts = append(nodeOpts, nodeopts.WithMaintenance(windows))
+	rootNode := node.New(cName, nodeOpts...)
 	rootNode.Logger = logger.Named("node")
+	rootNode.Announce(cName,
+		node.HasServer[maintenancepb.MaintenanceApiServer](maintenancepb.RegisterMaintenanceApiServer, maintenance.NewServer(windows)),
+	)
+	if arbiter != nil {
+		srv, err := node.RegistryService(prioritypb.PriorityApi_ServiceDesc, priority.NewServer(arbiter))
+		if err != nil {
+			return nil, err
+		}
+		if _, err := rootNode.AnnounceService(srv); err != nil {
+			return nil, err
+		}
+	}
 
 	var accountStore *account.Store
 	if config.Experimental != nil && config.Experimental.Accounts {
@@ -161,9 +221,18 @@ func Bootstrap(ctx context.Context, config sysconf.Config) (*Controller, error)
 	// Using it before enrollment results in 'not resolved' RPC errors; it becomes functional
 	// once enrolled as the manager address is updated automatically.
 	manager := node.DialChan(ctx, pi.EnrollServer.ManagerAddress(ctx),
-		grpc.WithTransportCredentials(credentials.NewTLS(pi.GRPCClient)))
+		grpc.WithTransportCredentials(credentials.NewTLS(pi.GRPCClient)),
+		tracing.DialOption())
 
-	ai := initAuth(config, logger)
+	ai, err := initAuth(config, logger)
+	if err != nil {
+		return nil, err
+	}
+	if ai.RateLimiter != nil {
+		rootNode.Announce(cName,
+			node.HasServer[ratelimitpb.RateLimitApiServer](ratelimitpb.RegisterRateLimitApiServer, ratelimit.NewServer(ai.RateLimiter)),
+		)
+	}
 
 	grpcServer, reflectionServer := buildGRPCServer(rootNode, nodeRouter, pi, ai)
 
@@ -172,13 +241,18 @@ func Bootstrap(ctx context.Context, config sysconf.Config) (*Controller, error)
 		return nil, err
 	}
 	devicesApi := buildDevicesAPI(rootNode, nodeRouter, downloadRouter)
+	devicesClient := devicespb.NewDevicesApiClient(wrap.ServerToClient(devicespb.DevicesApi_ServiceDesc, devicesApi))
+	registerTraitMetrics(config, metricsRegistry, devicesClient, rootNode, logger)
 
-	checkRegistry, closeHealthStore, err := setupHealthRegistry(ctx, config, deviceStore, rootNode, logger.Named("health"))
+	checkRegistry, closeHealthStore, err := setupHealthRegistry(ctx, config, deviceStore, windows, rootNode, logger.Named("health"))
 	if err != nil {
 		return nil, err
 	}
 
-	mux := buildHTTPMux(config, downloadRouter, ai.HTTPAuth, logger)
+	mux := buildHTTPMux(config, downloadRouter, metricsRegistry, ai.HTTPAuth, logger)
+	if !config.DisableREST {
+		mux.Handle(rest.PathPrefix, buildRESTHandler(rootNode, ai, logger))
+	}
 	setupAuditLog(ctx, downloadRouter, rootNode, ai.AuditSetup, logger)
 	httpServer := buildHTTPServer(config, pi, grpcServer, mux)
 
@@ -193,15 +267,19 @@ func Bootstrap(ctx context.Context, config sysconf.Config) (*Controller, error)
 		LogCapture:       capture,
 		LogLevel:         &logLevel,
 		Node:             rootNode,
-		Devices:          devicespb.NewDevicesApiClient(wrap.ServerToClient(devicespb.DevicesApi_ServiceDesc, devicesApi)),
+		Devices:          devicesClient,
 		CheckRegistry:    checkRegistry,
+		Maintenance:      windows,
+		Aliases:          aliases,
 		DeviceStore:      deviceStore,
 		Tasks:            &task.Group{},
+		Metrics:          metricsRegistry,
 		Database:         db,
 		Stores:           store,
 		Accounts:         accountStore,
 		TokenValidators:  ai.TokenValidator,
 		GRPCCerts:        pi.SystemSource,
+		Revokers:         pi.Revokers,
 		ReflectionServer: reflectionServer,
 		PrivateKey:       pi.Key,
 		Mux:              mux,
@@ -214,6 +292,7 @@ func Bootstrap(ctx context.Context, config sysconf.Config) (*Controller, error)
 	c.Defer(manager.Close)
 	c.Defer(store.Close)
 	c.Defer(closeHealthStore)
+	c.Defer(windows.Close)
 	c.Defer(ci.DataRoot.Close)
 	if ai.Interceptor != nil {
 		c.Defer(ai.Interceptor.Close)
@@ -225,6 +304,9 @@ func Bootstrap(ctx context.Context, config sysconf.Config) (*Controller, error)
 	if supConn != nil {
 		c.Defer(supConn.Close)
 	}
+	if shutdownTracing != nil {
+		c.Defer(shutdownTracing)
+	}
 	return c, nil
 }
 
@@ -251,6 +333,7 @@ type pkiInfo struct {
 	Key          pki.PrivateKey
 	EnrollServer *enrollment.Server
 	SystemSource *pki.SourceSet
+	Revokers     *pki.RevokerSet
 	GRPCServer   *tls.Config
 	GRPCClient   *tls.Config
 	HTTPServer   *tls.Config
@@ -260,6 +343,7 @@ type pkiInfo struct {
 type authInfo struct {
 	TokenValidator *token.ValidatorSet
 	Interceptor    *policy.Interceptor
+	RateLimiter    *ratelimit.Interceptor
 	AuditSetup     *audit.Setup
 	HTTPAuth       func(http.Handler) http.Handler
 }
@@ -350,6 +434,54 @@ func initSupervisor(config sysconf.Config, logger *zap.Logger) *grpc.ClientConn
 	return conn
 }
 
+// newArbiter returns the Arbiter for writes to the controller's devices, nil if priority arbitration isn't configured.
+func newArbiter(config sysconf.Config, logger *zap.Logger) *priority.Arbiter {
+	conf := config.Priority
+	if conf == nil {
+		return nil
+	}
+	return priority.NewArbiter(
+		priority.WithDefaultLease(conf.DefaultLease.Or(priority.DefaultLease)),
+		priority.WithLogger(logger.Named("priority")),
+	)
+}
+
+// initTracing starts exporting spans if configured, returning a func that flushes any remaining spans.
+// Failing to set up tracing doesn't stop the controller, spans just aren't exported.
+func initTracing(ctx context.Context, config sysconf.Config, nodeName string, logger *zap.Logger) Deferred {
+	conf := config.Tracing
+	if conf == nil {
+		return nil
+	}
+	opts := tracing.Options{
+		Exporter:    conf.Exporter,
+		Endpoint:    conf.Endpoint,
+		Insecure:    conf.Insecure,
+		Headers:     conf.Headers,
+		File:        conf.File,
+		SampleRatio: 1,
+		NodeName:    nodeName,
+		Version:     EffectiveVersion(),
+	}
+	if conf.SampleRatio != nil {
+		opts.SampleRatio = *conf.SampleRatio
+	}
+	if opts.File != "" && !filepath.IsAbs(opts.File) {
+		opts.File = filepath.Join(config.DataDir, opts.File)
+	}
+	provider, err := tracing.Start(ctx, opts)
+	if err != nil {
+		logger.Warn("failed to start tracing — spans will not be exported", zap.Error(err))
+		return nil
+	}
+	logger.Info("exporting traces", zap.String("exporter", conf.Exporter), zap.Float64("sampleRatio", opts.SampleRatio))
+	return func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return provider.Shutdown(ctx)
+	}
+}
+
 func loadAppConfig(ctx context.Context, config sysconf.Config, ci cloudInfo, logger *zap.Logger) (ConfigStore, error) {
 	if ci.Conn.State().Connectivity != cloud.Unconfigured {
 		return loadCloudAppConfig(ctx, config, ci.Store, ci.Conn, logger)
@@ -371,7 +503,14 @@ func initPKI(config sysconf.Config, nodeName string, logger *zap.Logger) (pkiInf
 	// It accepts CreateEnrollment requests, issuing certificates for outgoing TLS connections to other cohort
 	// members and providing trusted root certs so this controller can validate incoming client certificates.
 	// enrollServer also implements pki.Source, contributing these certs to the TLS config with no extra wiring.
-	enrollServer, err := enrollment.LoadOrCreateServer(files.Path(config.DataDir, "enrollment"), keyPEM, logger.Named("enrollment"))
+	var enrollOpts []enrollment.Option
+	if certConfig.RenewBefore != nil {
+		enrollOpts = append(enrollOpts, enrollment.WithRenewBefore(certConfig.RenewBefore.Duration))
+	}
+	if certConfig.RevocationPollInterval != nil {
+		enrollOpts = append(enrollOpts, enrollment.WithRevocationPollInterval(certConfig.RevocationPollInterval.Duration))
+	}
+	enrollServer, err := enrollment.LoadOrCreateServer(files.Path(config.DataDir, "enrollment"), keyPEM, logger.Named("enrollment"), enrollOpts...)
 	if err != nil {
 		return pkiInfo{}, err
 	}
@@ -431,8 +570,13 @@ func initPKI(config sysconf.Config, nodeName string, logger *zap.Logger) (pkiInf
 		return pkiInfo{}, fmt.Errorf("certs.tlsMinVersion: %w", err)
 	}
 	tlsVersionOpt := pki.WithMinVersion(tlsMinVersion)
-	tlsGRPCServerConfig := pki.TLSServerConfig(grpcSource, tlsVersionOpt)
-	tlsGRPCClientConfig := pki.TLSClientConfig(grpcSource, tlsVersionOpt)
+	// revokers rejects peers presenting certificates revoked by the hub we are enrolled with.
+	// Like systemSource, system plugins can contribute their own revocations at runtime, for example when this node is
+	// the hub.
+	revokers := pki.NewRevokerSet(enrollServer)
+	revokerOpt := pki.WithRevoker(revokers)
+	tlsGRPCServerConfig := pki.TLSServerConfig(grpcSource, tlsVersionOpt, revokerOpt)
+	tlsGRPCClientConfig := pki.TLSClientConfig(grpcSource, tlsVersionOpt, revokerOpt)
 
 	httpCertSource := pki.Source(grpcSource)
 	if certConfig.HTTPCert {
@@ -455,13 +599,14 @@ func initPKI(config sysconf.Config, nodeName string, logger *zap.Logger) (pkiInf
 		Key:          key,
 		EnrollServer: enrollServer,
 		SystemSource: systemSource,
+		Revokers:     revokers,
 		GRPCServer:   tlsGRPCServerConfig,
 		GRPCClient:   tlsGRPCClientConfig,
 		HTTPServer:   tlsHTTPServerConfig,
 	}, nil
 }
 
-func initAuth(config sysconf.Config, logger *zap.Logger) authInfo {
+func initAuth(config sysconf.Config, logger *zap.Logger) (authInfo, error) {
 	// tokenValidator is populated at runtime by system plugins, each registering support for a different
 	// token issuer (e.g. Keycloak, local accounts). Claims from validated tokens are forwarded to the
 	// policy engine alongside each request.
@@ -497,12 +642,25 @@ func initAuth(config sysconf.Config, logger *zap.Logger) authInfo {
 		httpAuth = interceptor.HTTPInterceptor
 	}
 
+	var rateLimiter *ratelimit.Interceptor
+	if config.RateLimits != nil {
+		var err error
+		rateLimiter, err = ratelimit.NewInterceptor(*config.RateLimits,
+			ratelimit.WithTokenVerifier(tokenValidator),
+			ratelimit.WithLogger(logger.Named("ratelimit")),
+		)
+		if err != nil {
+			return authInfo{}, fmt.Errorf("rateLimits: %w", err)
+		}
+	}
+
 	return authInfo{
 		TokenValidator: tokenValidator,
 		Interceptor:    interceptor,
+		RateLimiter:    rateLimiter,
 		AuditSetup:     auditSetup,
 		HTTPAuth:       httpAuth,
-	}
+	}, nil
 }
 
 func buildGRPCServer(rootNode *node.Node, nodeRouter *router.Router, pi pkiInfo, ai authInfo) (*grpc.Server, *reflectionapi.Server) {
@@ -519,14 +677,28 @@ func buildGRPCServer(rootNode *node.Node, nodeRouter *router.Router, pi pkiInfo,
 	)
 	grpcOpts = append(grpcOpts,
 		grpc.Creds(credentials.NewTLS(pi.GRPCServer)),
+		tracing.ServerOption(),
 		grpc.ChainStreamInterceptor(interceptors.CorrectStreamInfo(rootNode)),
 	)
+	// metrics are recorded after CorrectStreamInfo so routed unary calls are measured as unary,
+	// and before auth so rejected requests are counted too.
+	grpcOpts = append(grpcOpts,
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(metrics.StreamServerInterceptor()),
+	)
 	if ai.Interceptor != nil {
 		grpcOpts = append(grpcOpts,
 			grpc.ChainUnaryInterceptor(ai.Interceptor.GRPCUnaryInterceptor()),
 			grpc.ChainStreamInterceptor(ai.Interceptor.GRPCStreamingInterceptor()),
 		)
 	}
+	// rate limits are applied after auth so denied requests don't use up a client's quota.
+	if ai.RateLimiter != nil {
+		grpcOpts = append(grpcOpts,
+			grpc.ChainUnaryInterceptor(ai.RateLimiter.GRPCUnaryInterceptor()),
+			grpc.ChainStreamInterceptor(ai.RateLimiter.GRPCStreamingInterceptor()),
+		)
+	}
 	grpcOpts = append(grpcOpts, grpc.UnknownServiceHandler(rootNode.ServerHandler()))
 
 	grpcServer := grpc.NewServer(grpcOpts...)
@@ -569,7 +741,7 @@ func buildDevicesAPI(rootNode *node.Node, nodeRouter *router.Router, downloadRou
 	return devicesApi
 }
 
-func buildHTTPMux(config sysconf.Config, downloadRouter *download.Router, httpAuth func(http.Handler) http.Handler, logger *zap.Logger) *http.ServeMux {
+func buildHTTPMux(config sysconf.Config, downloadRouter *download.Router, metricsGatherer prometheus.Gatherer, httpAuth func(http.Handler) http.Handler, logger *zap.Logger) *http.ServeMux {
 	mux := http.NewServeMux()
 	// Shared download endpoint. Handlers compress their own responses when appropriate.
 	mux.Handle(downloadPathPrefix+"/", downloadRouter)
@@ -591,10 +763,42 @@ func buildHTTPMux(config sysconf.Config, downloadRouter *download.Router, httpAu
 		pprofMux.HandleFunc("GET /debug/pprof/trace", pprof.Trace)
 		mux.Handle("GET /__/debug/pprof/", httpAuth(http.StripPrefix("/__", pprofMux)))
 	}
+	if config.Metrics == nil || !config.Metrics.Disabled {
+		mux.Handle("GET "+sysconf.MetricsPath, httpAuth(promhttp.HandlerFor(metricsGatherer, promhttp.HandlerOpts{
+			ErrorLog: zap.NewStdLog(logger.Named("metrics")),
+		})))
+	}
 
 	return mux
 }
 
+// buildRESTHandler returns a handler serving the trait APIs as HTTP+JSON.
+// Requests are checked against the same policy, and rate limits, as if they'd been made via gRPC.
+func buildRESTHandler(rootNode *node.Node, ai authInfo, logger *zap.Logger) *rest.Handler {
+	opts := []rest.Option{rest.WithLogger(logger.Named("rest"))}
+	if ai.Interceptor != nil {
+		opts = append(opts, rest.WithAuthorizer(func(r *http.Request, fullMethod string, req proto.Message, serverStream bool) error {
+			return ai.Interceptor.CheckGRPCFromHTTP(r, fullMethod, req, policy.StreamAttributes{IsServerStream: serverStream})
+		}))
+	}
+	if ai.RateLimiter != nil {
+		opts = append(opts, rest.WithLimiter(ai.RateLimiter.CheckHTTP))
+	}
+	return rest.NewHandler(rootNode.ClientConn(), opts...)
+}
+
+// registerTraitMetrics adds a collector for the device trait values selected by config to reg.
+func registerTraitMetrics(config sysconf.Config, reg prometheus.Registerer, devicesClient devicespb.DevicesApiClient, rootNode *node.Node, logger *zap.Logger) {
+	if config.Metrics == nil || config.Metrics.Disabled || len(config.Metrics.Traits) == 0 {
+		return
+	}
+	queries := make([]metrics.TraitQuery, len(config.Metrics.Traits))
+	for i, t := range config.Metrics.Traits {
+		queries[i] = metrics.TraitQuery{Query: t.Devices.Pb(), Traits: t.Traits}
+	}
+	reg.MustRegister(metrics.NewTraitCollector(devicesClient, rootNode.ClientConn(), queries, logger.Named("metrics")))
+}
+
 // setupAuditLog wires the audit log subsystem against the shared download router,
 // starts the background metadata refresh goroutine, and announces the audit-log
 // device on the node so it appears as a Log trait.
@@ -705,12 +909,16 @@ type Controller struct {
 	Devices         devicespb.DevicesApiClient
 	DeviceStore     *devicespb.Collection // for low level control of devices
 	Tasks           *task.Group
+	Metrics         *prometheus.Registry // register collectors to have them served with the controllers metrics
 	Database        *bolthold.Store
 	TokenValidators *token.ValidatorSet
 	GRPCCerts       *pki.SourceSet
+	Revokers        *pki.RevokerSet
 	Stores          *stores.Stores
 	Accounts        *account.Store
 	CheckRegistry   *healthpb.Registry
+	Maintenance     *maintenance.Windows
+	Aliases         *alias.Table // old device names and the names that replaced them
 
 	ReflectionServer *reflectionapi.Server
 
@@ -777,6 +985,9 @@ func (c *Controller) Run(ctx context.Context) (err error) {
 		group.Go(func() error {
 			return c.Enrollment.AutoRenew(ctx)
 		})
+		group.Go(func() error {
+			return c.Enrollment.PollRevocations(ctx)
+		})
 	}
 	if c.Cloud != nil {
 		group.Go(func() error {
@@ -842,6 +1053,20 @@ func (c *Controller) Run(ctx context.Context) (err error) {
 	announceServices(c, "zones", zoneServices, c.SystemConfig.ZoneFactories, c.ControllerConfig.Zones())
 	go logServiceMapChanges(ctx, c.Logger.Named("zone"), zoneServices)
 
+	if c.Metrics != nil {
+		serviceCollector := metrics.NewServiceCollector(map[string]*service.Map{
+			"systems":     systemServices,
+			"drivers":     driverServices,
+			"automations": autoServices,
+			"zones":       zoneServices,
+		})
+		if err := c.Metrics.Register(serviceCollector); err != nil {
+			c.Logger.Warn("failed to register service metrics", zap.Error(err))
+		} else {
+			defer c.Metrics.Unregister(serviceCollector)
+		}
+	}
+
 	err = multierr.Append(err, group.Wait())
 	return
 }
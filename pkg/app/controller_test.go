@@ -4,16 +4,24 @@ import (
 	"context"
 	"crypto/tls"
 	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/smart-core-os/sc-bos/pkg/app/sysconf"
 	"github.com/smart-core-os/sc-bos/pkg/node"
 	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/meterpb"
+	"github.com/smart-core-os/sc-bos/pkg/resource"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
 )
 
 // TestController_protoPkgCompat tests that both versioned and unversioned proto packages are served.
@@ -77,3 +85,96 @@ func TestController_protoPkgCompat(t *testing.T) {
 		t.Errorf("versioned GetMeterReading() error = %v", err)
 	}
 }
+
+func TestController_metrics(t *testing.T) {
+	config := sysconf.Default()
+	config.PolicyMode = sysconf.PolicyOff
+	config.DataDir = t.TempDir()
+	config.Metrics = &sysconf.Metrics{Traits: []sysconf.MetricsTraits{{Traits: []trait.Name{meterpb.TraitName}}}}
+	c, err := Bootstrap(t.Context(), config)
+	if err != nil {
+		t.Fatalf("Bootstrap() error = %v", err)
+	}
+	c.Node.Announce("test-device",
+		node.HasServer(meterpb.RegisterMeterApiServer, meterpb.MeterApiServer(meterpb.NewModelServer(meterpb.NewModel(
+			resource.WithInitialValue(&meterpb.MeterReading{Usage: 12.5}),
+		)))),
+		node.HasTrait(meterpb.TraitName),
+	)
+
+	rec := httptest.NewRecorder()
+	c.Mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, sysconf.MetricsPath, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET %s status = %d, want %d", sysconf.MetricsPath, rec.Code, http.StatusOK)
+	}
+	body := rec.Body.String()
+	for _, want := range []string{
+		"go_goroutines ",
+		`scbos_trait_meter_reading_usage{name="test-device"} 12.5`,
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("metrics missing %q", want)
+		}
+	}
+}
+
+func TestController_invalidAliases(t *testing.T) {
+	dir := t.TempDir()
+	appConf := filepath.Join(dir, "app.conf.json")
+	err := os.WriteFile(appConf, []byte(`{"aliases": [{"alias": "old", "name": "new"}, {"alias": "old", "name": "other"}]}`), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := sysconf.Default()
+	config.PolicyMode = sysconf.PolicyOff
+	config.DataDir = dir
+	config.AppConfig = []string{appConf}
+	_, err = Bootstrap(t.Context(), config)
+	if err == nil || !strings.Contains(err.Error(), `duplicate alias "old"`) {
+		t.Fatalf("Bootstrap() error = %v, want duplicate alias error", err)
+	}
+}
+
+func TestController_rest(t *testing.T) {
+	const target = "/api/v1/smartcore.bos.meter.v1.MeterApi/GetMeterReading?name=test-device"
+	bootstrap := func(t *testing.T, mode sysconf.PolicyMode) *Controller {
+		config := sysconf.Default()
+		config.PolicyMode = mode
+		config.DataDir = t.TempDir()
+		c, err := Bootstrap(t.Context(), config)
+		if err != nil {
+			t.Fatalf("Bootstrap() error = %v", err)
+		}
+		c.Node.Announce("test-device",
+			node.HasServer(meterpb.RegisterMeterApiServer, meterpb.MeterApiServer(meterpb.NewModelServer(meterpb.NewModel(
+				resource.WithInitialValue(&meterpb.MeterReading{Usage: 12.5}),
+			)))),
+			node.HasTrait(meterpb.TraitName),
+		)
+		return c
+	}
+
+	t.Run("policy off", func(t *testing.T) {
+		c := bootstrap(t, sysconf.PolicyOff)
+		rec := httptest.NewRecorder()
+		c.Mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("GET status = %d, want %d: %s", rec.Code, http.StatusOK, rec.Body)
+		}
+		got := &meterpb.MeterReading{}
+		if err := protojson.Unmarshal(rec.Body.Bytes(), got); err != nil {
+			t.Fatal(err)
+		}
+		if got.Usage != 12.5 {
+			t.Errorf("GET usage = %v, want 12.5", got.Usage)
+		}
+	})
+	t.Run("policy on", func(t *testing.T) {
+		c := bootstrap(t, sysconf.PolicyOn)
+		rec := httptest.NewRecorder()
+		c.Mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("GET without a token status = %d, want %d: %s", rec.Code, http.StatusUnauthorized, rec.Body)
+		}
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/smart-core-os/sc-bos/internal/health/healthhistory"
 	"github.com/smart-core-os/sc-bos/pkg/app/files"
 	"github.com/smart-core-os/sc-bos/pkg/app/sysconf"
+	"github.com/smart-core-os/sc-bos/pkg/maintenance"
 	"github.com/smart-core-os/sc-bos/pkg/node"
 	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
@@ -21,7 +22,8 @@ import (
 )
 
 // setupHealthRegistry returns a healthpb.Registry that is integrated with the deviceStore and announced on the rootNode.
-func setupHealthRegistry(ctx context.Context, config sysconf.Config, deviceStore *devicespb.Collection, rootNode node.Announcer, logger *zap.Logger) (_ *healthpb.Registry, close func() error, _ error) {
+// Checks for devices in one of the windows are marked as in maintenance, windows may be nil.
+func setupHealthRegistry(ctx context.Context, config sysconf.Config, deviceStore *devicespb.Collection, windows *maintenance.Windows, rootNode node.Announcer, logger *zap.Logger) (_ *healthpb.Registry, close func() error, _ error) {
 	// persistent storage for health checks and history
 	var dbOpts []healthdb.Option
 	if config.Health.TTL.MaxCount != nil || config.Health.TTL.MaxAge != nil {
@@ -55,6 +57,12 @@ func setupHealthRegistry(ctx context.Context, config sysconf.Config, deviceStore
 	}
 	var announcedChecksMu sync.Mutex
 	announcedChecks := make(map[string]checkedDevice)
+	annotate := func(name string, c *healthpb.HealthCheck) *healthpb.HealthCheck {
+		if windows == nil {
+			return c
+		}
+		return windows.AnnotateCheck(name, c)
+	}
 
 	checkRegistry := healthpb.NewRegistry(
 		healthpb.WithOnNameCreate(func(name string) {
@@ -81,6 +89,7 @@ func setupHealthRegistry(ctx context.Context, config sysconf.Config, deviceStore
 			if oldCheck != nil {
 				c = oldCheck
 			}
+			c = annotate(name, c)
 
 			// update the health api
 			announcedChecksMu.Lock()
@@ -106,6 +115,7 @@ func setupHealthRegistry(ctx context.Context, config sysconf.Config, deviceStore
 			return c
 		}),
 		healthpb.WithOnCheckUpdate(func(name string, c *healthpb.HealthCheck) {
+			c = annotate(name, c)
 			// save the update to history
 			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 			defer cancel()
@@ -172,6 +182,38 @@ func setupHealthRegistry(ctx context.Context, config sysconf.Config, deviceStore
 			// note: the deviceStore doesn't need updating because undoing will manage that
 		}),
 	)
+
+	if windows != nil {
+		// checks only change when their device does, re-annotate them as devices enter and leave maintenance
+		stop := windows.OnChange(func() {
+			announcedChecksMu.Lock()
+			defer announcedChecksMu.Unlock()
+			for name, a := range announcedChecks {
+				for _, c := range a.m.ListHealthChecks() {
+					annotated := annotate(name, c)
+					if annotated == c {
+						continue
+					}
+					if _, err := a.m.UpdateHealthCheck(annotated); err != nil {
+						logger.Error("update health check maintenance", zap.String("name", name), zap.String("checkId", c.Id), zap.Error(err))
+						continue
+					}
+					_, err := deviceStore.Update(&devicespb.Device{Name: name}, resource.WithMerger(func(mask *masks.FieldUpdater, dst, _ proto.Message) {
+						dstDev := dst.(*devicespb.Device)
+						dstDev.HealthChecks = healthpb.MergeChecks(mask.Merge, dstDev.HealthChecks, removeMeasuredValues(annotated))
+					}))
+					if err != nil {
+						logger.Error("update device with health check maintenance", zap.String("name", name), zap.String("checkId", c.Id), zap.Error(err))
+					}
+				}
+			}
+		})
+		closeStore := close
+		close = func() error {
+			stop()
+			return closeStore()
+		}
+	}
 	return checkRegistry, close, nil
 }
 
@@ -10,13 +10,17 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/smart-core-os/sc-bos/internal/manage/devices"
 	"github.com/smart-core-os/sc-bos/internal/node/nodeopts"
 	"github.com/smart-core-os/sc-bos/pkg/app/sysconf"
+	"github.com/smart-core-os/sc-bos/pkg/maintenance"
 	"github.com/smart-core-os/sc-bos/pkg/node"
 	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/maintenancepb"
 )
 
 func Test_setupHealthRegistry(t *testing.T) {
@@ -85,6 +89,42 @@ func Test_setupHealthRegistry(t *testing.T) {
 		h.assertCheckHasFaultWithDetails(t, deviceName, faultSummary, faultDetails)
 	})
 
+	t.Run("maintenance", func(t *testing.T) {
+		h := newHealthTestHarness(t)
+		check := h.createFaultCheck("dev6", "maintained check")
+		defer check.Dispose()
+
+		window, err := h.windows.Create(h.ctx, &maintenancepb.MaintenanceWindow{
+			Reason:   "replacing filters",
+			Selector: &maintenancepb.MaintenanceWindow_Selector{Names: []string{"dev6"}},
+		})
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		want := &healthpb.HealthCheck_Maintenance{WindowId: window.Id, Reason: "replacing filters"}
+		h.assertCheckMaintenance(t, "dev6", want)
+
+		// updates to the check keep the annotation
+		check.SetFault(&healthpb.HealthCheck_Error{SummaryText: "filter removed"})
+		h.assertCheckNormality(t, "dev6", healthpb.HealthCheck_ABNORMAL)
+		h.assertCheckMaintenance(t, "dev6", want)
+		// queries for abnormal devices can exclude checks in maintenance
+		abnormal := &devicespb.Device_Query{Conditions: []*devicespb.Device_Query_Condition{{
+			Field: "health_checks",
+			Value: &devicespb.Device_Query_Condition_Matches{Matches: &devicespb.Device_Query{Conditions: []*devicespb.Device_Query_Condition{
+				{Field: "normality", Value: &devicespb.Device_Query_Condition_StringEqual{StringEqual: "ABNORMAL"}},
+				{Field: "maintenance", Matcher: devicespb.Device_Query_Condition_NONE, Value: &devicespb.Device_Query_Condition_Present{Present: &emptypb.Empty{}}},
+			}}},
+		}}}
+		h.assertDeviceMatches(t, "dev6", abnormal, false)
+
+		if err := h.windows.Delete(h.ctx, window.Id); err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+		h.assertCheckMaintenance(t, "dev6", nil)
+		h.assertDeviceMatches(t, "dev6", abnormal, true)
+	})
+
 	t.Run("value filtering", func(t *testing.T) {
 		t.Run("measured values in HealthApi", func(t *testing.T) {
 			h := newHealthTestHarness(t)
@@ -217,6 +257,7 @@ type healthTestHarness struct {
 	t               *testing.T
 	registry        *healthpb.Registry
 	devices         *devicespb.Collection
+	windows         *maintenance.Windows
 	healthApiClient healthpb.HealthApiClient
 	owner           string
 }
@@ -230,8 +271,9 @@ func newHealthTestHarness(t *testing.T) *healthTestHarness {
 	devices := devicespb.NewCollection()
 	announcer := node.New("test-node", nodeopts.WithStore(devices))
 	healthApiClient := healthpb.NewHealthApiClient(announcer.ClientConn())
+	windows := maintenance.New(maintenance.WithDevices(devices))
 
-	r, dispose, err := setupHealthRegistry(ctx, cfg, devices, announcer, zaptest.NewLogger(t))
+	r, dispose, err := setupHealthRegistry(ctx, cfg, devices, windows, announcer, zaptest.NewLogger(t))
 	if err != nil {
 		t.Fatalf("setupHealthRegistry() error = %v", err)
 	}
@@ -251,6 +293,7 @@ func newHealthTestHarness(t *testing.T) *healthTestHarness {
 		t:               t,
 		registry:        r,
 		devices:         devices,
+		windows:         windows,
 		healthApiClient: healthApiClient,
 		owner:           "test-owner",
 	}
@@ -342,6 +385,36 @@ func (h *healthTestHarness) assertCheckNormality(t *testing.T, deviceName string
 	}
 }
 
+func (h *healthTestHarness) assertCheckMaintenance(t *testing.T, deviceName string, want *healthpb.HealthCheck_Maintenance) {
+	t.Helper()
+	gotCheck := h.getOnlyCheck(t, deviceName)
+	if diff := cmp.Diff(want, gotCheck.Maintenance, protocmp.Transform()); diff != "" {
+		t.Errorf("HealthApi maintenance (-want +got):\n%s", diff)
+	}
+
+	dev, err := h.devices.GetDevice(deviceName)
+	if err != nil {
+		t.Fatalf("GetDevice() error = %v", err)
+	}
+	if len(dev.HealthChecks) != 1 {
+		t.Fatalf("expected 1 check in device, got %d", len(dev.HealthChecks))
+	}
+	if diff := cmp.Diff(want, dev.HealthChecks[0].Maintenance, protocmp.Transform()); diff != "" {
+		t.Errorf("DevicesApi maintenance (-want +got):\n%s", diff)
+	}
+}
+
+func (h *healthTestHarness) assertDeviceMatches(t *testing.T, deviceName string, query *devicespb.Device_Query, want bool) {
+	t.Helper()
+	dev, err := h.devices.GetDevice(deviceName)
+	if err != nil {
+		t.Fatalf("GetDevice() error = %v", err)
+	}
+	if got := devices.MatchesQuery(query, dev); got != want {
+		t.Errorf("device %s matches query = %v, want %v", deviceName, got, want)
+	}
+}
+
 func (h *healthTestHarness) assertCheckHasFault(t *testing.T, deviceName, faultSummary string) {
 	t.Helper()
 	gotCheck := h.getOnlyCheck(t, deviceName)
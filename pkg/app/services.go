@@ -33,6 +33,7 @@ func (c *Controller) startDrivers(configs []driver.RawConfig) (*service.Map, err
 		Node:            c.Node,
 		ClientTLSConfig: c.ClientTLSConfig,
 		HTTPMux:         c.Mux,
+		DownloadRouter:  c.DownloadRouter,
 		Database:        c.Database,
 	}
 
@@ -69,6 +70,7 @@ func (c *Controller) startAutomations(configs []auto.RawConfig) (*service.Map, e
 		GRPCServices:    c.GRPC,
 		CohortManager:   c.ManagerConn,
 		ClientTLSConfig: c.ClientTLSConfig,
+		Aliases:         c.Aliases,
 	}
 	// Give automations the node's Connect leaf credential (for mTLS to the Event
 	// Grid telemetry broker). The adapter reads the current registration per call,
@@ -79,6 +81,7 @@ func (c *Controller) startAutomations(configs []auto.RawConfig) (*service.Map, e
 
 	m := service.NewMap(func(id, kind string) (service.Lifecycle, error) {
 		autoServices := ctxServices
+		autoServices.Name = id
 		autoServices.Config = &serviceConfigStore{store: c.ControllerConfig.Automations(), id: id}
 		autoServices.Logger = loggerWithServiceInfo(autoServices.Logger, id, kind)
 		autoServices.Health = healthChecksForService(c.CheckRegistry, id, kind)
@@ -145,10 +148,12 @@ func (c *Controller) startSystems() (*service.Map, error) {
 		TokenValidators:  c.TokenValidators,
 		ReflectionServer: c.ReflectionServer,
 		GRPCCerts:        c.GRPCCerts,
+		Revokers:         c.Revokers,
 		PrivateKey:       c.PrivateKey,
 		CohortManager:    c.ManagerConn,
 		ClientTLSConfig:  c.ClientTLSConfig,
 		LogLevel:         c.LogLevel,
+		Maintenance:      c.Maintenance,
 	}
 	if c.LogCapture != nil {
 		ctxServices.AddLogCore = c.LogCapture.Add
@@ -163,11 +168,14 @@ func (c *Controller) startSystems() (*service.Map, error) {
 		}
 	}
 	m := service.NewMap(func(_, kind string) (service.Lifecycle, error) {
+		systemServices := ctxServices
+		systemServices.Health = healthChecksForService(c.CheckRegistry, kind, "system")
+
 		f, ok := c.SystemConfig.SystemFactories[kind]
 		if !ok {
 			return nil, fmt.Errorf("unsupported system type %v", kind)
 		}
-		return f.New(ctxServices), nil
+		return f.New(systemServices), nil
 	}, service.IdIsKind)
 
 	logger := c.Logger.Named("system")
@@ -185,6 +193,8 @@ func (c *Controller) startZones(configs []zone.RawConfig) (*service.Map, error)
 		Node:            c.Node,
 		ClientTLSConfig: c.ClientTLSConfig,
 		HTTPMux:         c.Mux,
+		DevicesApi:      c.Devices,
+		Database:        c.Database,
 		DriverFactories: c.SystemConfig.DriverFactories,
 	}
 
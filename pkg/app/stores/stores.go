@@ -13,6 +13,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/smart-core-os/sc-bos/internal/util/pgxutil"
+	retentionconfig "github.com/smart-core-os/sc-bos/pkg/history/dataretention/config"
 	"github.com/smart-core-os/sc-bos/pkg/history/sqlitestore"
 )
 
@@ -27,6 +28,9 @@ type Config struct {
 	// StorageHealthHighPercent is the disk utilisation percentage (0-100) at or above which
 	// a store's storage HealthCheck reports HIGH. When zero, a default of 90 is used.
 	StorageHealthHighPercent float32 `json:"storageHealthHighPercent,omitempty"`
+	// Retention are the rules deciding how long records are kept in the history stores, per source.
+	// The first rule that matches a source applies to it, records of sources no rule matches aren't removed by these rules.
+	Retention []retentionconfig.Rule `json:"retention,omitempty"`
 	// Local directory for storing database files.
 	DataDir string      `json:"-"`
 	Logger  *zap.Logger `json:"-"`
@@ -13,6 +13,7 @@ import (
 	"github.com/smart-core-os/sc-bos/pkg/app/http"
 	"github.com/smart-core-os/sc-bos/pkg/app/stores"
 	"github.com/smart-core-os/sc-bos/pkg/auth/policy"
+	"github.com/smart-core-os/sc-bos/pkg/auth/ratelimit"
 	"github.com/smart-core-os/sc-bos/pkg/auto"
 	"github.com/smart-core-os/sc-bos/pkg/block"
 	"github.com/smart-core-os/sc-bos/pkg/driver"
@@ -82,6 +83,12 @@ type Config struct {
 	Download *Download `json:"download,omitempty"`
 
 	DisablePprof bool `json:"disablePprof"` // don't register net/http/pprof handlers
+	DisableREST  bool `json:"disableRest"`  // don't serve the trait APIs as HTTP+JSON under /api/v1/
+
+	Metrics *Metrics `json:"metrics,omitempty"`
+	Tracing *Tracing `json:"tracing,omitempty"` // export spans, disabled if absent
+
+	Priority *Priority `json:"priority,omitempty"` // arbitrate writes between writers, disabled if absent
 
 	Health *Health `json:"health,omitempty"`
 
@@ -92,6 +99,8 @@ type Config struct {
 
 	AuditLog *AuditLogConfig `json:"auditLog,omitempty"`
 
+	RateLimits *ratelimit.Config `json:"rateLimits,omitempty"` // limit request rates, streams, and page sizes per client, disabled if absent
+
 	Experimental *Experimental `json:"experimental,omitempty"`
 
 	Cloud      *Cloud      `json:"cloud,omitempty"`
@@ -184,6 +193,14 @@ type Certs struct {
 	// TLSMinVersion sets the minimum TLS version accepted for gRPC and HTTPS connections.
 	// Valid values are "1.2" and "1.3". Defaults to "1.3" if not set.
 	TLSMinVersion string `json:"tlsMinVersion,omitempty"`
+
+	// RenewBefore is how long before the enrollment certificate expires that this node asks its hub to renew it.
+	// Defaults to renewing after 75% of the certificates validity period, which is also used if RenewBefore is not
+	// shorter than the validity period.
+	RenewBefore *jsontypes.Duration `json:"renewBefore,omitempty"`
+	// RevocationPollInterval is how often this node fetches the list of revoked certificates from its hub.
+	// Defaults to 5 minutes, which is also used if RevocationPollInterval is not positive.
+	RevocationPollInterval *jsontypes.Duration `json:"revocationPollInterval,omitempty"`
 }
 
 // ParseTLSMinVersion returns the tls.VersionTLS* constant for the configured minimum TLS version.
@@ -219,6 +236,10 @@ type Health struct {
 // Relative paths are relative to DataDir.
 const HealthDBPath = "health/checks.sqlite3"
 
+// MaintenanceDBPath is the location of the SQLite database file used to store maintenance windows.
+// Relative paths are relative to DataDir.
+const MaintenanceDBPath = "maintenance/windows.sqlite3"
+
 type HealthTTL struct {
 	MinCount *int                `json:"minCount,omitempty"` // defaults to 1, setting to 0 can disable seeding
 	MaxCount *int                `json:"maxCount,omitempty"` // defaults to no max count
@@ -0,0 +1,30 @@
+package sysconf
+
+import (
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
+)
+
+// MetricsPath is where Prometheus metrics are served on the HTTPS server.
+const MetricsPath = "/metrics"
+
+// Metrics configures the Prometheus metrics served at MetricsPath.
+// Metrics about the controller itself, like gRPC calls and service states, are always served unless Disabled.
+type Metrics struct {
+	// Disabled stops the controller serving metrics.
+	Disabled bool `json:"disabled,omitempty"`
+	// Traits selects device trait values to export as gauges.
+	// Trait values are read each time metrics are scraped.
+	Traits []MetricsTraits `json:"traits,omitempty"`
+}
+
+// MetricsTraits selects devices and traits whose numeric values are exported as metrics.
+type MetricsTraits struct {
+	// Devices selects devices using the DevicesApi query format, for example
+	// {"conditions": [{"field": "metadata.membership.subsystem", "stringEqual": "hvac"}]}.
+	// If absent all devices are selected.
+	Devices *jsontypes.DeviceQuery `json:"devices,omitempty"`
+	// Traits limits which traits of the selected devices are exported.
+	// If empty all traits the devices have are exported.
+	Traits []trait.Name `json:"traits,omitempty"`
+}
@@ -0,0 +1,14 @@
+package sysconf
+
+import (
+	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
+)
+
+// Priority configures arbitration of writes to the devices announced by this node.
+// Each written field of a device has a priority array, the highest priority write is applied to the device.
+type Priority struct {
+	// DefaultLease is how long writes that don't specify a priority, like those from the Ops UI, hold their level.
+	// Once it expires, writes made at a lower priority by automations are applied again.
+	// Defaults to 1h, "0s" holds the level until it is relinquished.
+	DefaultLease *jsontypes.Duration `json:"defaultLease,omitempty"`
+}
@@ -0,0 +1,23 @@
+package sysconf
+
+// Tracing configures exporting OpenTelemetry spans.
+// Trace context is propagated between nodes even when tracing is not configured.
+type Tracing struct {
+	// Exporter is where spans are sent: "otlp" (the default) sends them to an OTLP collector over gRPC,
+	// "otlphttp" over HTTP, and "file" writes them as JSON to File.
+	Exporter string `json:"exporter,omitempty"`
+	// Endpoint of the OTLP collector, a host:port for "otlp" or a URL for "otlphttp".
+	// Defaults to the OTEL_EXPORTER_OTLP_ENDPOINT env var, or a collector on localhost.
+	Endpoint string `json:"endpoint,omitempty"`
+	// Insecure connects to Endpoint without TLS.
+	Insecure bool `json:"insecure,omitempty"`
+	// Headers are sent with each export to the collector, typically for authentication.
+	Headers map[string]string `json:"headers,omitempty"`
+	// File spans are written to when using the "file" exporter.
+	// Relative paths are relative to the data dir.
+	File string `json:"file,omitempty"`
+	// SampleRatio is the fraction of traces started by this node that are recorded.
+	// Traces started by other nodes are recorded if they were recorded there.
+	// Defaults to 1, recording all traces.
+	SampleRatio *float64 `json:"sampleRatio,omitempty"`
+}
@@ -17,8 +17,17 @@ allow if {
   startswith(input.path, "/__/debug/pprof/")
 }
 
+allow if {
+  metrics_permission
+  input.path == "/metrics"
+}
+
 log_level_permission if token_has_role("admin")
 log_level_permission if token_has_role("super-admin")
 
 pprof_permission if token_has_role("admin")
 pprof_permission if token_has_role("super-admin")
+
+metrics_permission if token_has_role("admin")
+metrics_permission if token_has_role("super-admin")
+metrics_permission if token_has_role("viewer")
@@ -0,0 +1,19 @@
+package smartcore.bos.maintenance.v1.MaintenanceApi
+
+import data.scutil.rpc.read_request
+import data.scutil.token.token_has_role
+
+default allow := false
+
+# Unrestricted access for admin roles and valid certificates.
+allow if token_has_role("admin")
+allow if token_has_role("super-admin")
+allow if input.certificate_valid
+allow if token_has_role("commissioner")
+
+# Operators schedule maintenance with contractors.
+allow if token_has_role("operator")
+allow if {
+	token_has_role("viewer")
+	read_request
+}
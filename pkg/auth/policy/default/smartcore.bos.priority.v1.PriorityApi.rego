@@ -0,0 +1,26 @@
+package smartcore.bos.priority.v1.PriorityApi
+
+import data.scutil.rpc.read_request
+import data.scutil.token.token_has_role
+
+default allow := false
+
+# Unrestricted access for admin roles and valid certificates.
+allow if token_has_role("admin")
+allow if token_has_role("super-admin")
+allow if input.certificate_valid
+allow if token_has_role("commissioner")
+
+# Operators can see who controls a device and release manual overrides.
+allow if {
+	token_has_role("operator")
+	read_request
+}
+allow if {
+	token_has_role("operator")
+	input.method == "RelinquishPriority"
+}
+allow if {
+	token_has_role("viewer")
+	read_request
+}
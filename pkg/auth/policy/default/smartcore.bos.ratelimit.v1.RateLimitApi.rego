@@ -0,0 +1,14 @@
+package smartcore.bos.ratelimit.v1.RateLimitApi
+
+import data.scutil.token.token_has_role
+
+default allow := false
+
+# Unrestricted access for admin roles and valid certificates.
+allow if token_has_role("admin")
+allow if token_has_role("super-admin")
+allow if input.certificate_valid
+allow if token_has_role("commissioner")
+
+# Operators investigate misbehaving integrations, usage lists the subjects of other clients so viewers are excluded.
+allow if token_has_role("operator")
@@ -179,6 +179,29 @@ func (i *Interceptor) checkPolicyGrpc(ctx context.Context, creds *verifiedCreds,
 		}
 	}
 
+	addr := "unknown"
+	if p, ok := peer.FromContext(ctx); ok {
+		addr = p.Addr.String()
+	}
+	return creds, i.checkGRPCMethod(ctx, creds, addr, service, method, req, stream)
+}
+
+// CheckGRPCFromHTTP evaluates the policy for a call to the gRPC method fullMethod with req,
+// made on behalf of the HTTP request r, for example by a REST gateway.
+// The credentials of r are used, and the policy is evaluated as if the method had been called via gRPC.
+func (i *Interceptor) CheckGRPCFromHTTP(r *http.Request, fullMethod string, req any, stream StreamAttributes) error {
+	service, method, ok := rpcutil.SplitMethodPath(fullMethod)
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "invalid method %q", fullMethod)
+	}
+	creds, err := i.httpCreds(r)
+	if err != nil {
+		return err
+	}
+	return i.checkGRPCMethod(r.Context(), creds, r.RemoteAddr, service, method, req, stream)
+}
+
+func (i *Interceptor) checkGRPCMethod(ctx context.Context, creds *verifiedCreds, addr, service, method string, req any, stream StreamAttributes) error {
 	input := Attributes{
 		Protocol:           ProtocolGRPC,
 		Service:            service,
@@ -213,10 +236,6 @@ func (i *Interceptor) checkPolicyGrpc(ctx context.Context, creds *verifiedCreds,
 	}
 
 	queries, err := Validate(ctx, i.policy, input)
-	addr := "unknown"
-	if p, ok := peer.FromContext(ctx); ok {
-		addr = p.Addr.String()
-	}
 	if err != nil {
 		i.logger.Debug("request blocked by policy",
 			zap.Any("attributes", input),
@@ -235,30 +254,13 @@ func (i *Interceptor) checkPolicyGrpc(ctx context.Context, creds *verifiedCreds,
 			auditField{"method", method},
 		)
 	}
-	return creds, err
+	return err
 }
 
 func (i *Interceptor) checkPolicyHTTP(r *http.Request) (*verifiedCreds, error) {
-	hdr := r.Header.Get("Authorization")
-	creds := &verifiedCreds{}
-	if hdr != "" {
-		tkn, err := splitBearer(hdr)
-		if err != nil {
-			return nil, err
-		}
-		if i.verifier != nil {
-			claims, err := i.verifier.ValidateAccessToken(r.Context(), tkn)
-			if err != nil {
-				i.logger.Error("token failed verification", zap.Error(err))
-				return nil, err
-			}
-			creds.token = tkn
-			creds.tokenClaims = claims
-		}
-	}
-	if cert := httpPeerCert(r); cert != nil {
-		creds.cert = cert
-		creds.certValid = true
+	creds, err := i.httpCreds(r)
+	if err != nil {
+		return nil, err
 	}
 
 	input := Attributes{
@@ -295,6 +297,32 @@ func (i *Interceptor) checkPolicyHTTP(r *http.Request) (*verifiedCreds, error) {
 	return creds, err
 }
 
+// httpCreds returns the credentials presented by r, an error if r has an invalid token.
+func (i *Interceptor) httpCreds(r *http.Request) (*verifiedCreds, error) {
+	hdr := r.Header.Get("Authorization")
+	creds := &verifiedCreds{}
+	if hdr != "" {
+		tkn, err := splitBearer(hdr)
+		if err != nil {
+			return nil, err
+		}
+		if i.verifier != nil {
+			claims, err := i.verifier.ValidateAccessToken(r.Context(), tkn)
+			if err != nil {
+				i.logger.Error("token failed verification", zap.Error(err))
+				return nil, err
+			}
+			creds.token = tkn
+			creds.tokenClaims = claims
+		}
+	}
+	if cert := httpPeerCert(r); cert != nil {
+		creds.cert = cert
+		creds.certValid = true
+	}
+	return creds, nil
+}
+
 type InterceptorOption func(interceptor *Interceptor)
 
 func WithLogger(logger *zap.Logger) InterceptorOption {
@@ -123,6 +123,30 @@ func TestInterceptor_HTTP(t *testing.T) {
 	check(http.MethodPost, "/bar", http.StatusUnauthorized)
 }
 
+func TestInterceptor_CheckGRPCFromHTTP(t *testing.T) {
+	compiler, err := ast.CompileModules(regoFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	interceptor := NewInterceptor(&static{compiler: compiler})
+
+	check := func(fullMethod string, req any, wantCode codes.Code) {
+		t.Helper()
+		// the http policy allows all GET requests, the grpc policy should be used instead
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/", nil)
+		err := interceptor.CheckGRPCFromHTTP(r, fullMethod, req, StreamAttributes{})
+		if got := status.Code(err); got != wantCode {
+			t.Errorf("CheckGRPCFromHTTP(%s, %v) = %v, want %v", fullMethod, req, err, wantCode)
+		}
+	}
+
+	check("/smartcore.bos.onoff.v1.OnOffApi/GetOnOff", &onoffpb.GetOnOffRequest{Name: "allow"}, codes.OK)
+	check("/smartcore.bos.onoff.v1.OnOffApi/GetOnOff", &onoffpb.GetOnOffRequest{Name: "deny"}, codes.Unauthenticated)
+	check("/smartcore.bos.onoff.v1.OnOffApi/UpdateOnOff", &onoffpb.UpdateOnOffRequest{Name: "any", OnOff: &onoffpb.OnOff{State: onoffpb.OnOff_ON}}, codes.OK)
+	check("/smartcore.bos.onoff.v1.OnOffApi/UpdateOnOff", &onoffpb.UpdateOnOffRequest{Name: "any", OnOff: &onoffpb.OnOff{State: onoffpb.OnOff_OFF}}, codes.Unauthenticated)
+	check("not a method", nil, codes.InvalidArgument)
+}
+
 func TestIsWriteMethod(t *testing.T) {
 	tests := []struct {
 		method string
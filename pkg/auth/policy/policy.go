@@ -8,10 +8,16 @@ import (
 	"strings"
 
 	"github.com/open-policy-agent/opa/v1/rego"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+var tracer = otel.Tracer("github.com/smart-core-os/sc-bos/pkg/auth/policy")
+
 var (
 	ErrPermissionDenied = status.Error(codes.PermissionDenied, "you are not authorized to perform this operation")
 	ErrUnauthenticated  = status.Error(codes.Unauthenticated, "please authenticate to perform this operation")
@@ -97,6 +103,20 @@ var AllowAll Policy = Func(func(_ context.Context, _ string, _ Attributes) (rego
 //   - data.foo.allow
 //   - data.grpc_default.allow
 func Validate(ctx context.Context, policy Policy, attr Attributes) (tried []string, err error) {
+	ctx, span := tracer.Start(ctx, "policy.Validate", trace.WithAttributes(
+		attribute.String("policy.protocol", string(attr.Protocol)),
+		attribute.String("policy.service", attr.Service),
+		attribute.String("policy.method", attr.Method),
+		attribute.String("policy.path", attr.Path),
+	))
+	defer func() {
+		span.SetAttributes(attribute.Bool("policy.allowed", err == nil), attribute.StringSlice("policy.queries", tried))
+		if err != nil {
+			span.SetStatus(otelcodes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	queries := queryHierarchy(attr.Protocol, attr.Service)
 	for i, query := range queries {
 		result, err := policy.EvalPolicy(ctx, query, attr)
@@ -0,0 +1,140 @@
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/smart-core-os/sc-bos/pkg/auth/token"
+	"github.com/smart-core-os/sc-bos/pkg/proto/ratelimitpb"
+)
+
+// Config selects the Limits applied to each client.
+//
+// The limits for a client are, in order of precedence:
+//  1. Subjects, keyed by the subject of the client's access token.
+//  2. Tenants, keyed by the tenant id, for clients authenticated using a tenant secret.
+//  3. Roles, keyed by system role. A client with many roles gets the most generous of each limit of its roles.
+//  4. Default, for all other clients, including those without a valid access token.
+//
+// Limits are not merged between levels, a client with Subjects limits is not limited by any Default limits.
+type Config struct {
+	Default  Limits            `json:"default,omitempty"`
+	Roles    map[string]Limits `json:"roles,omitempty"`
+	Tenants  map[string]Limits `json:"tenants,omitempty"`
+	Subjects map[string]Limits `json:"subjects,omitempty"`
+}
+
+// Limits are the rate limits and quotas applied to a single client.
+// A zero value means no limit.
+type Limits struct {
+	// RequestsPerSecond is the sustained rate of requests allowed, opening a stream counts as a request.
+	RequestsPerSecond float64 `json:"requestsPerSecond,omitempty"`
+	// Burst is how many requests can be made at once before RequestsPerSecond applies.
+	// Defaults to RequestsPerSecond, rounded up.
+	Burst int `json:"burst,omitempty"`
+	// MaxStreams is the number of streams a client can have open at once.
+	MaxStreams int `json:"maxStreams,omitempty"`
+	// MaxPageSize is the largest page_size of requests, larger page sizes are reduced to MaxPageSize.
+	MaxPageSize int32 `json:"maxPageSize,omitempty"`
+}
+
+// Validate returns an error if any of the limits are invalid.
+func (c Config) Validate() error {
+	errs := []error{c.Default.validate("default")}
+	for name, l := range c.Roles {
+		errs = append(errs, l.validate(fmt.Sprintf("roles[%q]", name)))
+	}
+	for name, l := range c.Tenants {
+		errs = append(errs, l.validate(fmt.Sprintf("tenants[%q]", name)))
+	}
+	for name, l := range c.Subjects {
+		errs = append(errs, l.validate(fmt.Sprintf("subjects[%q]", name)))
+	}
+	return errors.Join(errs...)
+}
+
+// limitsFor returns the limits for a client with claims, which may be nil.
+func (c Config) limitsFor(claims *token.Claims) Limits {
+	if claims == nil {
+		return c.Default
+	}
+	if l, ok := c.Subjects[claims.Subject]; ok {
+		return l
+	}
+	if claims.IsService {
+		if l, ok := c.Tenants[claims.Subject]; ok {
+			return l
+		}
+	}
+	var merged Limits
+	var found bool
+	for _, role := range claims.SystemRoles {
+		l, ok := c.Roles[role]
+		if !ok {
+			continue
+		}
+		if !found {
+			merged, found = l, true
+			continue
+		}
+		merged = merged.mostGenerous(l)
+	}
+	if found {
+		return merged
+	}
+	return c.Default
+}
+
+func (l Limits) validate(path string) error {
+	switch {
+	case l.RequestsPerSecond < 0 || math.IsNaN(l.RequestsPerSecond) || math.IsInf(l.RequestsPerSecond, 0):
+		return fmt.Errorf("%s.requestsPerSecond must be a positive number", path)
+	case l.Burst < 0:
+		return fmt.Errorf("%s.burst must not be negative", path)
+	case l.MaxStreams < 0:
+		return fmt.Errorf("%s.maxStreams must not be negative", path)
+	case l.MaxPageSize < 0:
+		return fmt.Errorf("%s.maxPageSize must not be negative", path)
+	}
+	return nil
+}
+
+// burst returns the burst of the request rate limiter, at least 1.
+func (l Limits) burst() int {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+	return max(1, int(math.Ceil(l.RequestsPerSecond)))
+}
+
+// mostGenerous returns the higher of each of the limits of l and o, where no limit is highest.
+func (l Limits) mostGenerous(o Limits) Limits {
+	higher := func(a, b float64) float64 {
+		if a == 0 || b == 0 {
+			return 0
+		}
+		return max(a, b)
+	}
+	merged := Limits{
+		RequestsPerSecond: higher(l.RequestsPerSecond, o.RequestsPerSecond),
+		MaxStreams:        int(higher(float64(l.MaxStreams), float64(o.MaxStreams))),
+		MaxPageSize:       int32(higher(float64(l.MaxPageSize), float64(o.MaxPageSize))),
+	}
+	if merged.RequestsPerSecond != 0 {
+		merged.Burst = max(l.burst(), o.burst())
+	}
+	return merged
+}
+
+func (l Limits) toProto() *ratelimitpb.Limits {
+	pb := &ratelimitpb.Limits{
+		RequestsPerSecond: l.RequestsPerSecond,
+		MaxStreams:        int32(l.MaxStreams),
+		MaxPageSize:       l.MaxPageSize,
+	}
+	if l.RequestsPerSecond > 0 {
+		pb.Burst = int32(l.burst())
+	}
+	return pb
+}
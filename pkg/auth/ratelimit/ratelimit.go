@@ -0,0 +1,374 @@
+// Package ratelimit limits the rate of gRPC requests, the number of open streams, and the page size of requests made
+// by each client of a controller. Requests made via HTTP, like the REST API, are limited using CheckHTTP.
+//
+// Clients are identified by the subject of their access token, or by their IP address if they don't present a valid
+// token. The limits applied to each client are chosen by Config using the claims of the client's token.
+// Requests over a limit fail with ResourceExhausted, with a RetryInfo detail saying when to try again.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/pkg/auth/token"
+	"github.com/smart-core-os/sc-bos/pkg/proto/ratelimitpb"
+)
+
+const (
+	// forgetAfter is how long a client with no open streams can be idle before its usage is forgotten.
+	forgetAfter = 10 * time.Minute
+	// streamRetryDelay is the delay suggested to clients that have too many open streams.
+	streamRetryDelay = 5 * time.Second
+	// pageSizeField is the name of the request field that MaxPageSize applies to.
+	pageSizeField = "page_size"
+)
+
+// Interceptor applies rate limits and quotas to gRPC requests.
+type Interceptor struct {
+	cfg      Config
+	verifier token.Validator
+	logger   *zap.Logger
+	now      func() time.Time
+
+	mu        sync.Mutex
+	clients   map[string]*client
+	lastSweep time.Time
+}
+
+// Option configures an Interceptor.
+type Option func(*Interceptor)
+
+// WithTokenVerifier identifies clients using the subject of the access tokens validated by tv.
+// Without a verifier all clients are identified by their address.
+func WithTokenVerifier(tv token.Validator) Option {
+	return func(i *Interceptor) {
+		i.verifier = tv
+	}
+}
+
+// WithLogger sets the logger used to report rejected requests.
+func WithLogger(logger *zap.Logger) Option {
+	return func(i *Interceptor) {
+		i.logger = logger
+	}
+}
+
+// NewInterceptor returns an Interceptor that applies the limits of cfg.
+func NewInterceptor(cfg Config, opts ...Option) (*Interceptor, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	i := &Interceptor{
+		cfg:     cfg,
+		logger:  zap.NewNop(),
+		now:     time.Now,
+		clients: make(map[string]*client),
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i, nil
+}
+
+func (i *Interceptor) GRPCUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		c, err := i.admit(i.identify(ctx), info.FullMethod, false)
+		if err != nil {
+			return nil, err
+		}
+		i.capPageSize(c, req)
+		return handler(ctx, req)
+	}
+}
+
+func (i *Interceptor) GRPCStreamingInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		// Calls made via grpc.UnknownServiceHandler are all reported as streams,
+		// chain interceptors.CorrectStreamInfo before this interceptor so routed unary calls don't count towards MaxStreams.
+		stream := info.IsServerStream || info.IsClientStream
+		c, err := i.admit(i.identify(ss.Context()), info.FullMethod, stream)
+		if err != nil {
+			return err
+		}
+		if stream {
+			defer i.closeStream(c)
+		}
+		return handler(srv, &pageSizeStream{ServerStream: ss, i: i, c: c})
+	}
+}
+
+// CheckHTTP applies the limits to the HTTP request r calling the gRPC method fullMethod with req, capping the page size
+// of req. If serverStream is true the request opens a stream that counts towards MaxStreams until done is called.
+// It returns a gRPC status error if the request is over a limit.
+func (i *Interceptor) CheckHTTP(r *http.Request, fullMethod string, req proto.Message, serverStream bool) (done func(), err error) {
+	c, err := i.admit(i.identifyHTTP(r), fullMethod, serverStream)
+	if err != nil {
+		return nil, err
+	}
+	i.capPageSize(c, req)
+	if !serverStream {
+		return func() {}, nil
+	}
+	var once sync.Once
+	return func() { once.Do(func() { i.closeStream(c) }) }, nil
+}
+
+// ListClientUsages returns the usage of all known clients, ordered by client.
+func (i *Interceptor) ListClientUsages() []*ratelimitpb.ClientUsage {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	now := i.now()
+	usages := make([]*ratelimitpb.ClientUsage, 0, len(i.clients))
+	for _, c := range i.clients {
+		usages = append(usages, c.usage(now))
+	}
+	slices.SortFunc(usages, func(a, b *ratelimitpb.ClientUsage) int {
+		return strings.Compare(a.Client, b.Client)
+	})
+	return usages
+}
+
+// GetClientUsage returns the usage of the named client, or false if the client isn't known.
+func (i *Interceptor) GetClientUsage(name string) (*ratelimitpb.ClientUsage, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	c, ok := i.clients[name]
+	if !ok {
+		return nil, false
+	}
+	return c.usage(i.now()), true
+}
+
+// admit returns the client id, or an error if the request would exceed the client's limits.
+// If stream is true, the request opens a stream which must be closed with closeStream.
+func (i *Interceptor) admit(id identity, fullMethod string, stream bool) (*client, error) {
+	limits := i.cfg.limitsFor(id.claims)
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	now := i.now()
+	i.sweep(now)
+	c, ok := i.clients[id.key]
+	if !ok {
+		c = &client{key: id.key, first: now}
+		i.clients[id.key] = c
+	}
+	c.update(id.claims, limits, now)
+
+	if stream && limits.MaxStreams > 0 && c.openStreams >= limits.MaxStreams {
+		return nil, i.reject(c, now, fullMethod, streamRetryDelay, "streams",
+			fmt.Sprintf("too many open streams, the limit is %d", limits.MaxStreams))
+	}
+	if c.limiter != nil {
+		r := c.limiter.ReserveN(now, 1)
+		if delay := r.DelayFrom(now); delay > 0 {
+			r.CancelAt(now)
+			return nil, i.reject(c, now, fullMethod, delay, "requests",
+				fmt.Sprintf("too many requests, the limit is %g per second", limits.RequestsPerSecond))
+		}
+	}
+	c.requests++
+	if stream {
+		c.openStreams++
+	}
+	return c, nil
+}
+
+// reject records that a request from c was rejected, returning the error for the client.
+// Must be called with i.mu held.
+func (i *Interceptor) reject(c *client, now time.Time, fullMethod string, retryDelay time.Duration, quota, msg string) error {
+	c.rejected++
+	c.lastRejected = now
+	i.logger.Debug("request rate limited",
+		zap.String("client", c.key), zap.String("method", fullMethod), zap.String("quota", quota))
+	st, err := status.New(codes.ResourceExhausted, msg).WithDetails(
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(retryDelay)},
+		&errdetails.QuotaFailure{Violations: []*errdetails.QuotaFailure_Violation{
+			{Subject: c.key, Description: msg},
+		}},
+	)
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, msg)
+	}
+	return st.Err()
+}
+
+func (i *Interceptor) closeStream(c *client) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	c.openStreams--
+	c.last = i.now()
+}
+
+// capPageSize reduces the page_size of req to the MaxPageSize of c.
+func (i *Interceptor) capPageSize(c *client, req any) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return
+	}
+	i.mu.Lock()
+	maxPageSize := c.limits.MaxPageSize
+	i.mu.Unlock()
+	if maxPageSize <= 0 {
+		return
+	}
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(pageSizeField)
+	if fd == nil || fd.Kind() != protoreflect.Int32Kind || fd.Cardinality() == protoreflect.Repeated {
+		return
+	}
+	if m.Get(fd).Int() <= int64(maxPageSize) {
+		return
+	}
+	m.Set(fd, protoreflect.ValueOfInt32(maxPageSize))
+	i.mu.Lock()
+	c.pageSizeCapped++
+	i.mu.Unlock()
+}
+
+// sweep forgets clients that have been idle for forgetAfter, at most once a minute.
+// Must be called with i.mu held.
+func (i *Interceptor) sweep(now time.Time) {
+	if now.Sub(i.lastSweep) < time.Minute {
+		return
+	}
+	i.lastSweep = now
+	for key, c := range i.clients {
+		if c.openStreams == 0 && now.Sub(c.last) >= forgetAfter {
+			delete(i.clients, key)
+		}
+	}
+}
+
+// identity identifies the client making a request.
+type identity struct {
+	key    string
+	claims *token.Claims // nil if the client didn't present a valid token
+}
+
+func (i *Interceptor) identify(ctx context.Context) identity {
+	tkn, _ := grpc_auth.AuthFromMD(ctx, "Bearer")
+	var addr string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+	}
+	return i.identifyBy(ctx, tkn, addr)
+}
+
+func (i *Interceptor) identifyHTTP(r *http.Request) identity {
+	var tkn string
+	if scheme, value, ok := strings.Cut(r.Header.Get("Authorization"), " "); ok && strings.EqualFold(scheme, "bearer") {
+		tkn = value
+	}
+	return i.identifyBy(r.Context(), tkn, r.RemoteAddr)
+}
+
+// identifyBy identifies the client using tkn, if it is valid, otherwise using the host of addr.
+func (i *Interceptor) identifyBy(ctx context.Context, tkn, addr string) identity {
+	if i.verifier != nil && tkn != "" {
+		if claims, err := i.verifier.ValidateAccessToken(ctx, tkn); err == nil && claims != nil {
+			return identity{key: "subject:" + claims.Subject, claims: claims}
+		}
+	}
+	if addr == "" {
+		return identity{key: "addr:unknown"}
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	return identity{key: "addr:" + addr}
+}
+
+// client is the usage of a single client. Guarded by Interceptor.mu.
+type client struct {
+	key     string
+	claims  *token.Claims
+	limits  Limits
+	limiter *rate.Limiter // nil if requests are not limited
+
+	openStreams    int
+	requests       int64
+	rejected       int64
+	pageSizeCapped int64
+
+	first, last, lastRejected time.Time
+}
+
+// update records a request from the client with claims, applying limits.
+func (c *client) update(claims *token.Claims, limits Limits, now time.Time) {
+	c.claims = claims
+	c.last = now
+	if limits == c.limits && (c.limiter != nil) == (limits.RequestsPerSecond > 0) {
+		return
+	}
+	c.limits = limits
+	switch {
+	case limits.RequestsPerSecond <= 0:
+		c.limiter = nil
+	case c.limiter == nil:
+		c.limiter = rate.NewLimiter(rate.Limit(limits.RequestsPerSecond), limits.burst())
+	default:
+		c.limiter.SetLimitAt(now, rate.Limit(limits.RequestsPerSecond))
+		c.limiter.SetBurstAt(now, limits.burst())
+	}
+}
+
+func (c *client) usage(now time.Time) *ratelimitpb.ClientUsage {
+	u := &ratelimitpb.ClientUsage{
+		Client:              c.key,
+		Limits:              c.limits.toProto(),
+		OpenStreams:         int32(c.openStreams),
+		RequestCount:        c.requests,
+		RejectedCount:       c.rejected,
+		PageSizeCappedCount: c.pageSizeCapped,
+		FirstRequestTime:    timestamppb.New(c.first),
+		LastRequestTime:     timestamppb.New(c.last),
+	}
+	if c.claims != nil {
+		u.Subject = c.claims.Subject
+		u.DisplayName = c.claims.Name
+		u.Tenant = c.claims.IsService
+		u.Roles = slices.Clone(c.claims.SystemRoles)
+	}
+	if c.limiter != nil {
+		u.AvailableRequests = proto.Float64(c.limiter.TokensAt(now))
+	}
+	if !c.lastRejected.IsZero() {
+		u.LastRejectedTime = timestamppb.New(c.lastRejected)
+	}
+	return u
+}
+
+// pageSizeStream applies MaxPageSize to each message received on the stream.
+type pageSizeStream struct {
+	grpc.ServerStream
+	i *Interceptor
+	c *client
+}
+
+func (s *pageSizeStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	s.i.capPageSize(s.c, m)
+	return nil
+}
@@ -0,0 +1,347 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smart-core-os/sc-bos/internal/util/grpc/interceptors"
+	"github.com/smart-core-os/sc-bos/pkg/auth/token"
+	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/proto/airtemperaturepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/meterpb"
+)
+
+var testTokens = map[string]*token.Claims{
+	"tenant-a": {Subject: "tenant-a", IsService: true, SystemRoles: []string{"viewer"}},
+	"tenant-b": {Subject: "tenant-b", IsService: true},
+	"alice":    {Subject: "alice", SystemRoles: []string{"viewer", "operator"}},
+	"bob":      {Subject: "bob", SystemRoles: []string{"admin"}},
+}
+
+func testValidator() token.Validator {
+	return token.ValidatorFunc(func(_ context.Context, tkn string) (*token.Claims, error) {
+		if c, ok := testTokens[tkn]; ok {
+			return c, nil
+		}
+		return nil, errors.New("invalid token")
+	})
+}
+
+func TestConfig_limitsFor(t *testing.T) {
+	cfg := Config{
+		Default:  Limits{RequestsPerSecond: 1},
+		Roles:    map[string]Limits{"viewer": {RequestsPerSecond: 5, MaxStreams: 2, MaxPageSize: 50}, "operator": {RequestsPerSecond: 10, MaxStreams: 0, MaxPageSize: 100}},
+		Tenants:  map[string]Limits{"tenant-a": {RequestsPerSecond: 2}},
+		Subjects: map[string]Limits{"bob": {MaxStreams: 50}},
+	}
+	tests := []struct {
+		name string
+		want Limits
+	}{
+		{"", Limits{RequestsPerSecond: 1}},                                    // no token
+		{"tenant-a", Limits{RequestsPerSecond: 2}},                            // tenant beats roles
+		{"tenant-b", Limits{RequestsPerSecond: 1}},                            // default
+		{"alice", Limits{RequestsPerSecond: 10, Burst: 10, MaxPageSize: 100}}, // most generous of roles
+		{"bob", Limits{MaxStreams: 50}},                                       // subject
+	}
+	for _, tt := range tests {
+		if diff := cmp.Diff(tt.want, cfg.limitsFor(testTokens[tt.name])); diff != "" {
+			t.Errorf("limitsFor(%q) (-want +got):\n%s", tt.name, diff)
+		}
+	}
+}
+
+func TestConfig_Validate(t *testing.T) {
+	err := Config{Roles: map[string]Limits{"viewer": {RequestsPerSecond: -1}}}.Validate()
+	if err == nil {
+		t.Error("Validate() with negative rate returned no error")
+	}
+}
+
+func TestInterceptor_requestRate(t *testing.T) {
+	i := newTestInterceptor(t, Config{
+		Default: Limits{RequestsPerSecond: 1, Burst: 2},
+		Tenants: map[string]Limits{"tenant-a": {RequestsPerSecond: 10}},
+	})
+	unary := i.GRPCUnaryInterceptor()
+	call := func(ctx context.Context) error {
+		_, err := unary(ctx, &airtemperaturepb.ListAirTemperatureHistoryRequest{}, &grpc.UnaryServerInfo{FullMethod: "/test/Method"}, okHandler)
+		return err
+	}
+
+	anon := clientContext(t, "10.0.0.1:1234", "")
+	for n := range 2 {
+		if err := call(anon); err != nil {
+			t.Fatalf("request %d within burst: %v", n, err)
+		}
+	}
+	err := call(anon)
+	if code := status.Code(err); code != codes.ResourceExhausted {
+		t.Fatalf("request over limit got %v, want ResourceExhausted", err)
+	}
+	if got := retryDelay(t, err); got != time.Second {
+		t.Errorf("retry delay = %v, want 1s", got)
+	}
+	// other clients have their own limits
+	if err := call(clientContext(t, "10.0.0.2:1234", "")); err != nil {
+		t.Errorf("request from other address: %v", err)
+	}
+	if err := call(clientContext(t, "10.0.0.1:1234", "tenant-a")); err != nil {
+		t.Errorf("request from tenant at same address: %v", err)
+	}
+	// tokens are replenished over time
+	i.now = func() time.Time { return testNow.Add(time.Second) }
+	if err := call(anon); err != nil {
+		t.Errorf("request after waiting: %v", err)
+	}
+
+	usage, ok := i.GetClientUsage("addr:10.0.0.1")
+	if !ok {
+		t.Fatal("no usage for addr:10.0.0.1")
+	}
+	if usage.RequestCount != 3 || usage.RejectedCount != 1 || usage.LastRejectedTime == nil {
+		t.Errorf("usage = %v, want 3 requests and 1 rejected", usage)
+	}
+}
+
+func TestInterceptor_streams(t *testing.T) {
+	i := newTestInterceptor(t, Config{Default: Limits{MaxStreams: 1}})
+	stream := i.GRPCStreamingInterceptor()
+	ctx := clientContext(t, "10.0.0.1:1234", "")
+	serverStream := &grpc.StreamServerInfo{FullMethod: "/test/Pull", IsServerStream: true}
+
+	opened := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- stream(nil, &fakeStream{ctx: ctx}, serverStream, func(any, grpc.ServerStream) error {
+			close(opened)
+			<-release
+			return nil
+		})
+	}()
+	<-opened
+
+	err := stream(nil, &fakeStream{ctx: ctx}, serverStream, func(any, grpc.ServerStream) error { return nil })
+	if code := status.Code(err); code != codes.ResourceExhausted {
+		t.Fatalf("second stream got %v, want ResourceExhausted", err)
+	}
+	// unary requests via the stream handler don't count
+	unary := &grpc.StreamServerInfo{FullMethod: "/test/Get"}
+	if err := stream(nil, &fakeStream{ctx: ctx}, unary, func(any, grpc.ServerStream) error { return nil }); err != nil {
+		t.Errorf("unary request with open stream: %v", err)
+	}
+	if usage, _ := i.GetClientUsage("addr:10.0.0.1"); usage.OpenStreams != 1 {
+		t.Errorf("open streams = %d, want 1", usage.OpenStreams)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("first stream: %v", err)
+	}
+	if err := stream(nil, &fakeStream{ctx: ctx}, serverStream, func(any, grpc.ServerStream) error { return nil }); err != nil {
+		t.Errorf("stream after first closed: %v", err)
+	}
+}
+
+// TestInterceptor_routedStreams checks that unary calls made via the node's grpc.UnknownServiceHandler, which gRPC
+// reports as streams, don't count towards MaxStreams when chained after interceptors.CorrectStreamInfo.
+func TestInterceptor_routedStreams(t *testing.T) {
+	n := node.New("test")
+	n.Announce("meter",
+		node.HasServer(meterpb.RegisterMeterApiServer, meterpb.MeterApiServer(meterpb.NewModelServer(meterpb.NewModel()))),
+		node.HasTrait(meterpb.TraitName),
+	)
+	i, err := NewInterceptor(Config{Default: Limits{MaxStreams: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := grpc.NewServer(
+		grpc.UnknownServiceHandler(n.ServerHandler()),
+		grpc.ChainStreamInterceptor(interceptors.CorrectStreamInfo(n), i.GRPCStreamingInterceptor()),
+	)
+	lis := bufconn.Listen(1024 * 1024)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+	conn, err := grpc.NewClient("passthrough://bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	client := meterpb.NewMeterApiClient(conn)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	pull, err := client.PullMeterReadings(ctx, &meterpb.PullMeterReadingsRequest{Name: "meter"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pull.Recv(); err != nil {
+		t.Fatalf("first stream: %v", err)
+	}
+
+	if _, err := client.GetMeterReading(t.Context(), &meterpb.GetMeterReadingRequest{Name: "meter"}); err != nil {
+		t.Errorf("unary request with open stream: %v", err)
+	}
+	pull2, err := client.PullMeterReadings(t.Context(), &meterpb.PullMeterReadingsRequest{Name: "meter"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pull2.Recv(); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("second stream got %v, want ResourceExhausted", err)
+	}
+}
+
+func TestInterceptor_CheckHTTP(t *testing.T) {
+	i := newTestInterceptor(t, Config{
+		Default:  Limits{MaxStreams: 1, MaxPageSize: 100},
+		Subjects: map[string]Limits{"alice": {MaxStreams: 2}},
+	})
+	newRequest := func(tkn string) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/test/Pull", nil)
+		r.RemoteAddr = "10.0.0.1:1234"
+		if tkn != "" {
+			r.Header.Set("Authorization", "Bearer "+tkn)
+		}
+		return r
+	}
+
+	done, err := i.CheckHTTP(newRequest(""), "/test/Pull", nil, true)
+	if err != nil {
+		t.Fatalf("first stream: %v", err)
+	}
+	if _, err := i.CheckHTTP(newRequest(""), "/test/Pull", nil, true); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("second stream got %v, want ResourceExhausted", err)
+	}
+	if _, err := i.CheckHTTP(newRequest("alice"), "/test/Pull", nil, true); err != nil {
+		t.Errorf("stream with token: %v", err)
+	}
+	req := &airtemperaturepb.ListAirTemperatureHistoryRequest{PageSize: 1000}
+	if _, err := i.CheckHTTP(newRequest(""), "/test/List", req, false); err != nil {
+		t.Errorf("unary request with open stream: %v", err)
+	}
+	if req.PageSize != 100 {
+		t.Errorf("page size = %d, want 100", req.PageSize)
+	}
+
+	done()
+	done() // only closes the stream once
+	if usage, _ := i.GetClientUsage("addr:10.0.0.1"); usage.OpenStreams != 0 {
+		t.Errorf("open streams = %d, want 0", usage.OpenStreams)
+	}
+	if _, err := i.CheckHTTP(newRequest(""), "/test/Pull", nil, true); err != nil {
+		t.Errorf("stream after first closed: %v", err)
+	}
+}
+
+func TestInterceptor_pageSize(t *testing.T) {
+	i := newTestInterceptor(t, Config{Default: Limits{MaxPageSize: 100}})
+	ctx := clientContext(t, "10.0.0.1:1234", "")
+	info := &grpc.UnaryServerInfo{FullMethod: "/test/List"}
+	for _, tt := range []struct{ in, want int32 }{{0, 0}, {50, 50}, {1000, 100}} {
+		req := &airtemperaturepb.ListAirTemperatureHistoryRequest{PageSize: tt.in}
+		_, err := i.GRPCUnaryInterceptor()(ctx, req, info, okHandler)
+		if err != nil {
+			t.Fatalf("request: %v", err)
+		}
+		if req.PageSize != tt.want {
+			t.Errorf("page size %d became %d, want %d", tt.in, req.PageSize, tt.want)
+		}
+	}
+
+	// streams cap each message received
+	ss := &fakeStream{ctx: ctx, recv: &airtemperaturepb.ListAirTemperatureHistoryRequest{PageSize: 500}}
+	err := i.GRPCStreamingInterceptor()(nil, ss, &grpc.StreamServerInfo{FullMethod: "/test/List"}, func(_ any, ss grpc.ServerStream) error {
+		req := &airtemperaturepb.ListAirTemperatureHistoryRequest{}
+		if err := ss.RecvMsg(req); err != nil {
+			return err
+		}
+		if req.PageSize != 100 {
+			t.Errorf("streamed page size = %d, want 100", req.PageSize)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("stream: %v", err)
+	}
+	if usage, _ := i.GetClientUsage("addr:10.0.0.1"); usage.PageSizeCappedCount != 2 {
+		t.Errorf("page size capped count = %d, want 2", usage.PageSizeCappedCount)
+	}
+}
+
+var testNow = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newTestInterceptor(t *testing.T, cfg Config) *Interceptor {
+	t.Helper()
+	i, err := NewInterceptor(cfg, WithTokenVerifier(testValidator()))
+	if err != nil {
+		t.Fatalf("NewInterceptor: %v", err)
+	}
+	i.now = func() time.Time { return testNow }
+	return i
+}
+
+func clientContext(t *testing.T, addr, tkn string) context.Context {
+	t.Helper()
+	tcpAddr, err := net.ResolveTCPAddr("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := peer.NewContext(t.Context(), &peer.Peer{Addr: tcpAddr})
+	if tkn != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+tkn))
+	}
+	return ctx
+}
+
+func okHandler(context.Context, any) (any, error) {
+	return nil, nil
+}
+
+func retryDelay(t *testing.T, err error) time.Duration {
+	t.Helper()
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.RetryInfo); ok {
+			return info.RetryDelay.AsDuration()
+		}
+	}
+	t.Fatalf("no RetryInfo in %v", err)
+	return 0
+}
+
+type fakeStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	recv *airtemperaturepb.ListAirTemperatureHistoryRequest
+}
+
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeStream) RecvMsg(m any) error {
+	proto.Merge(m.(proto.Message), s.recv)
+	return nil
+}
@@ -0,0 +1,45 @@
+package ratelimit
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/ratelimitpb"
+)
+
+// Server implements the RateLimitApi, reporting the usage recorded by an Interceptor.
+type Server struct {
+	ratelimitpb.UnimplementedRateLimitApiServer
+	interceptor *Interceptor
+}
+
+func NewServer(interceptor *Interceptor) *Server {
+	return &Server{interceptor: interceptor}
+}
+
+func (s *Server) ListClientUsages(_ context.Context, request *ratelimitpb.ListClientUsagesRequest) (*ratelimitpb.ListClientUsagesResponse, error) {
+	usages := s.interceptor.ListClientUsages()
+	if request.OnlyRejected {
+		filtered := usages[:0]
+		for _, u := range usages {
+			if u.RejectedCount > 0 {
+				filtered = append(filtered, u)
+			}
+		}
+		usages = filtered
+	}
+	return &ratelimitpb.ListClientUsagesResponse{ClientUsages: usages}, nil
+}
+
+func (s *Server) GetClientUsage(_ context.Context, request *ratelimitpb.GetClientUsageRequest) (*ratelimitpb.ClientUsage, error) {
+	if request.Client == "" {
+		return nil, status.Error(codes.InvalidArgument, "client is required")
+	}
+	usage, ok := s.interceptor.GetClientUsage(request.Client)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "client %q has no recent requests", request.Client)
+	}
+	return usage, nil
+}
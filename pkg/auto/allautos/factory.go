@@ -5,6 +5,7 @@ import (
 	"github.com/smart-core-os/sc-bos/pkg/auto/azureiot"
 	"github.com/smart-core-os/sc-bos/pkg/auto/bms"
 	"github.com/smart-core-os/sc-bos/pkg/auto/connecttelemetry"
+	"github.com/smart-core-os/sc-bos/pkg/auto/demandresponse"
 	"github.com/smart-core-os/sc-bos/pkg/auto/export"
 	"github.com/smart-core-os/sc-bos/pkg/auto/exporthttp"
 	"github.com/smart-core-os/sc-bos/pkg/auto/healthbounds"
@@ -24,6 +25,7 @@ func Factories() map[string]auto.Factory {
 	return map[string]auto.Factory{
 		azureiot.FactoryName:        azureiot.Factory,
 		bms.AutoType:                bms.Factory,
+		demandresponse.AutoName:     demandresponse.Factory,
 		"export-mqtt":               export.MQTTFactory,
 		healthbounds.AutoName:       healthbounds.Factory,
 		"history":                   history.Factory,
@@ -343,7 +343,7 @@ func grpcClient[T any](a *Auto, f func(connInterface grpc.ClientConnInterface) T
 	// use local client config
 	rn := dev.RemoteNode
 	if rn == nil {
-		return f(a.services.Node.ClientConn()), nil
+		return f(a.services.ClientConn()), nil
 	}
 
 	a.connsMu.Lock()
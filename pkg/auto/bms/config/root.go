@@ -81,10 +81,8 @@ type Root struct {
 	DeadbandModeTargets []SwitchMode `json:"deadbandModeTargets,omitempty"` // Defaults: on=comfort, off=eco
 }
 
-type Range struct {
-	Start jsontypes.Schedule `json:"start"`
-	End   jsontypes.Schedule `json:"end"`
-}
+// Range is a recurring period of time, see jsontypes.ScheduleRange.
+type Range = jsontypes.ScheduleRange
 
 var (
 	DefaultModeSource           = SwitchMode{Key: "hvac.mode", On: "auto", Off: "manual"}
@@ -14,6 +14,7 @@ import (
 	"github.com/smart-core-os/sc-bos/pkg/auto"
 	"github.com/smart-core-os/sc-bos/pkg/auto/bms/config"
 	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/node/priority"
 	"github.com/smart-core-os/sc-bos/pkg/task/service"
 	"github.com/smart-core-os/sc-bos/pkg/util/chans"
 )
@@ -27,7 +28,7 @@ type factory struct{}
 func (f factory) New(services auto.Services) service.Lifecycle {
 	a := &Auto{
 		logger:  services.Logger.Named(AutoType),
-		clients: services.Node,
+		clients: services,
 	}
 	a.Service = service.New(a.applyConfig,
 		service.WithParser(config.ReadBytes),
@@ -170,7 +171,9 @@ func (a *Auto) processReadStates(ctx context.Context, readStates <-chan *ReadSta
 
 		writeState.Before()
 		writeState.CopyFromReadState(readState)
-		ttl, err := processReadState(ctx, readState, writeState, actions)
+		// write at the auto level, so manual overrides take precedence until they expire
+		writeCtx := priority.NewOutgoingContext(ctx, priority.Write{Level: priority.Auto, Owner: readState.Config.Name})
+		ttl, err := processReadState(writeCtx, readState, writeState, actions)
 		writeState.After()
 
 		refreshEvery := readState.Config.WriteEvery.Or(config.DefaultWriteEvery)
@@ -13,6 +13,7 @@ import (
 	"github.com/smart-core-os/sc-bos/pkg/proto/modepb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/occupancysensorpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/typespb"
+	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
 )
 
 func processReadState(ctx context.Context, readState *ReadState, writeState *WriteState, actions Actions) (time.Duration, error) {
@@ -24,7 +25,7 @@ func processReadState(ctx context.Context, readState *ReadState, writeState *Wri
 	// process occupancy state
 	unoccupiedDelay := readState.Config.UnoccupiedDelay.Or(config.DefaultUnoccupiedDelay)
 	occupiedCount, totalExpectedOccupancy, noResponseFromSensor, unoccupiedFor := analyseOccupancy(now, readState)
-	schedOccupied, occupiedStart, occupiedEnd, occupancySchedChanges := analyseTimeOfDay(now, readState.Config.OccupiedSchedule)
+	schedOccupied, occupiedStart, occupiedEnd, occupancySchedChanges := jsontypes.AnalyseScheduleRanges(now, readState.Config.OccupiedSchedule)
 	usingOccupancySched := !occupiedStart.IsZero() || !occupiedEnd.IsZero() || occupancySchedChanges != 0
 	if usingOccupancySched {
 		ttl.set(occupancySchedChanges)
@@ -86,7 +87,7 @@ func processReadState(ctx context.Context, readState *ReadState, writeState *Wri
 	}
 
 	// deadband adjustment processing
-	deadbandOn, onStart, onEnd, deadbandChangesIn := analyseTimeOfDay(now, readState.Config.DeadbandSchedule)
+	deadbandOn, onStart, onEnd, deadbandChangesIn := jsontypes.AnalyseScheduleRanges(now, readState.Config.DeadbandSchedule)
 	ttl.set(deadbandChangesIn)
 	switch {
 	case deadbandOn:
@@ -160,33 +161,6 @@ func analyseOccupancy(now time.Time, state *ReadState) (occupied, total, noRespo
 	return
 }
 
-// analyseTimeOfDay works out if we are currently within a schedule period, and when that might change.
-// It returns:
-//   - on: true if now is currently within a schedule config.Range
-//   - onStart: the next time the matching range starts
-//   - onEnd: the next time the matching range ends, aka the end of the matched range
-//   - changesIn: the time until the matching schedule range ends, or the next range starts
-func analyseTimeOfDay(now time.Time, schedule []config.Range) (on bool, onStart, onEnd time.Time, changesIn time.Duration) {
-	checkTime := func(t time.Time) {
-		d := t.Sub(now)
-		if changesIn == 0 || d < changesIn {
-			changesIn = d
-		}
-	}
-	for _, period := range schedule {
-		startAt := period.Start.Next(now)
-		endAt := period.End.Next(now)
-		if startAt.After(endAt) {
-			// If start is after end, then we're currently within the range of [start, end).
-			// This changesIn calculation falls down if periods overlap, but for simplicity we'll use this simple approach.
-			return true, startAt, endAt, endAt.Sub(now)
-		}
-		checkTime(startAt)
-		checkTime(endAt)
-	}
-	return
-}
-
 func analyseSetPoint(now time.Time, state *ReadState) (auto bool, setPoint float32, changesIn time.Duration, reason string) {
 	src := state.Config.ModeSource
 	if src.Name == "" {
@@ -0,0 +1,162 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/smart-core-os/sc-bos/pkg/auto"
+	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
+)
+
+const (
+	DefaultStepInterval = 5 * time.Minute
+	DefaultKeepEnded    = 24 * time.Hour
+	DefaultPollInterval = time.Minute
+)
+
+type Root struct {
+	auto.Config
+
+	// SiteMeter is the name of a device implementing the Electric trait that measures the demand of the whole site.
+	// Required for events with a target real power to shed tiers progressively.
+	SiteMeter string `json:"siteMeter,omitempty"`
+	// Tiers are groups of devices to adjust during an event, in priority order.
+	// The first tier is shed first and restored last.
+	Tiers []Tier `json:"tiers,omitempty"`
+	// StepInterval is how long to wait after shedding a tier before shedding the next when the site demand is above
+	// the event target. Defaults to DefaultStepInterval.
+	StepInterval *jsontypes.Duration `json:"stepInterval,omitempty"`
+	// KeepEnded is how long completed and cancelled events are kept before being removed.
+	// Defaults to DefaultKeepEnded.
+	KeepEnded *jsontypes.Duration `json:"keepEnded,omitempty"`
+
+	// OpenADR configures receiving events from an OpenADR 2.0b VTN.
+	OpenADR *OpenADR `json:"openAdr,omitempty"`
+	// Schedule creates events at regular times.
+	Schedule []ScheduledEvent `json:"schedule,omitempty"`
+}
+
+// Tier is a group of devices that are adjusted together to shed load.
+// All device writes are undone when the tier is restored.
+type Tier struct {
+	Name string `json:"name,omitempty"`
+
+	// Lights are dimmed to a maximum brightness.
+	Lights *Lights `json:"lights,omitempty"`
+	// AirTemperature set points are moved away from the ambient temperature, reducing heating or cooling.
+	AirTemperature *AirTemperature `json:"airTemperature,omitempty"`
+	// FanSpeed devices are slowed to a maximum speed.
+	FanSpeed *FanSpeed `json:"fanSpeed,omitempty"`
+	// OnOff devices, like EV chargers, are turned off.
+	OnOff *OnOff `json:"onOff,omitempty"`
+}
+
+// Devices returns the names of all devices in the tier.
+func (t Tier) Devices() []string {
+	var res []string
+	if t.Lights != nil {
+		res = append(res, t.Lights.Devices...)
+	}
+	if t.AirTemperature != nil {
+		res = append(res, t.AirTemperature.Devices...)
+	}
+	if t.FanSpeed != nil {
+		res = append(res, t.FanSpeed.Devices...)
+	}
+	if t.OnOff != nil {
+		res = append(res, t.OnOff.Devices...)
+	}
+	return res
+}
+
+type Lights struct {
+	Devices []string `json:"devices,omitempty"`
+	// MaxLevelPercent is the brightness lights are dimmed to, lights that are already dimmer are not changed.
+	MaxLevelPercent float32 `json:"maxLevelPercent"`
+}
+
+type AirTemperature struct {
+	Devices []string `json:"devices,omitempty"`
+	// SetPointOffset is how far, in degrees celsius, set points are moved away from the ambient temperature.
+	// Set point ranges are widened by this amount in both directions.
+	SetPointOffset float64 `json:"setPointOffsetCelsius"`
+}
+
+type FanSpeed struct {
+	Devices []string `json:"devices,omitempty"`
+	// MaxPercentage is the speed fans are slowed to, fans that are already slower are not changed.
+	MaxPercentage float32 `json:"maxPercentage"`
+}
+
+type OnOff struct {
+	Devices []string `json:"devices,omitempty"`
+}
+
+// OpenADR configures polling an OpenADR 2.0b VTN (virtual top node) for events, acting as a VEN (virtual end node).
+// Events using the SIMPLE signal shed as many tiers as the signal level, the level is typically between 1 and 3.
+type OpenADR struct {
+	// URL is the base URL of the VTN, like "https://vtn.example.com/OpenADR2/Simple/2.0b".
+	// Service names, like EiEvent, are appended to this URL.
+	URL   string `json:"url,omitempty"`
+	VenID string `json:"venId,omitempty"`
+	// PollInterval is how often the VTN is asked for events.
+	// Defaults to DefaultPollInterval.
+	PollInterval *jsontypes.Duration `json:"pollInterval,omitempty"`
+	// TLS configures the connection to the VTN, typically with a client certificate.
+	// The hub TLS config is never used.
+	TLS *jsontypes.TLSConfig `json:"tls,omitempty"`
+	// LevelTiers maps OpenADR signal levels to the number of tiers to shed.
+	// Keys are the signal level as a string, like "1". Levels without an entry shed as many tiers as the level.
+	LevelTiers map[string]int32 `json:"levelTiers,omitempty"`
+}
+
+// ScheduledEvent describes events that are created at regular times.
+type ScheduledEvent struct {
+	// Start is when each event starts.
+	Start       jsontypes.Schedule `json:"start"`
+	Duration    jsontypes.Duration `json:"duration"`
+	Description string             `json:"description,omitempty"`
+	// Level is the number of tiers to shed, 0 means all tiers.
+	Level int32 `json:"level,omitempty"`
+	// TargetRealPower is the site demand to shed tiers towards, in watts.
+	TargetRealPower *float32 `json:"targetRealPower,omitempty"`
+}
+
+func Read(data []byte) (Root, error) {
+	var cfg Root
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return cfg, err
+	}
+	return cfg, cfg.validate()
+}
+
+func (r Root) validate() error {
+	var errs []error
+	if len(r.Tiers) == 0 {
+		errs = append(errs, errors.New("no tiers"))
+	}
+	for i, t := range r.Tiers {
+		if len(t.Devices()) == 0 {
+			errs = append(errs, fmt.Errorf("tiers[%d] %q has no devices", i, t.Name))
+		}
+	}
+	if r.OpenADR != nil {
+		if r.OpenADR.URL == "" {
+			errs = append(errs, errors.New("openAdr.url is required"))
+		}
+		if r.OpenADR.VenID == "" {
+			errs = append(errs, errors.New("openAdr.venId is required"))
+		}
+	}
+	for i, s := range r.Schedule {
+		if s.Start.Schedule == nil {
+			errs = append(errs, fmt.Errorf("schedule[%d].start is required", i))
+		}
+		if s.Duration.Duration <= 0 {
+			errs = append(errs, fmt.Errorf("schedule[%d].duration must be positive", i))
+		}
+	}
+	return errors.Join(errs...)
+}
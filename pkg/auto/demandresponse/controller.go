@@ -0,0 +1,217 @@
+package demandresponse
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/demandresponsepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/electricpb"
+	"github.com/smart-core-os/sc-bos/pkg/resource"
+	"github.com/smart-core-os/sc-bos/pkg/task"
+)
+
+// restoreTimeout bounds how long restoring shed load can take when the controller stops.
+const restoreTimeout = 30 * time.Second
+
+// controller activates and ends events, shedding and restoring tiers of load as needed.
+type controller struct {
+	model        *demandresponsepb.Model
+	tiers        []*tier
+	siteMeter    string
+	electric     electricpb.ElectricApiClient
+	stepInterval time.Duration
+	keepEnded    time.Duration
+	logger       *zap.Logger
+
+	shedCount  int       // tiers[:shedCount] are shed
+	lastStep   time.Time // when a tier was last shed while working towards a target
+	siteDemand *float32
+}
+
+// run processes events until ctx is done, restoring any shed load before returning.
+func (c *controller) run(ctx context.Context) error {
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), restoreTimeout)
+		defer cancel()
+		c.apply(ctx, 0, time.Now())
+	}()
+
+	eventChanges := c.model.PullEvents(ctx, resource.WithUpdatesOnly(true))
+	var demandChanges <-chan float32
+	if c.siteMeter != "" {
+		demandChanges = c.pullSiteDemand(ctx)
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case _, ok := <-eventChanges:
+			if !ok {
+				return ctx.Err()
+			}
+		case demand := <-demandChanges:
+			c.siteDemand = &demand
+		case <-timer.C:
+		}
+
+		now := time.Now()
+		next := c.step(ctx, now)
+		if next.IsZero() {
+			timer.Stop()
+		} else {
+			timer.Reset(next.Sub(now))
+		}
+	}
+}
+
+// step updates event states and sheds or restores tiers based on the active event.
+// Returns when step should next be called, or the zero time if nothing will change until an event or the site demand changes.
+func (c *controller) step(ctx context.Context, now time.Time) time.Time {
+	var next time.Time
+	nextAt := func(t time.Time) {
+		if t.After(now) && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+
+	var active *demandresponsepb.Event
+	for _, e := range c.model.ListEvents() {
+		start, end := e.StartTime.AsTime(), e.EndTime.AsTime()
+		state := e.State
+		switch e.State {
+		case demandresponsepb.Event_COMPLETED, demandresponsepb.Event_CANCELLED:
+			if gcAt := end.Add(c.keepEnded); !now.Before(gcAt) {
+				_, _ = c.model.DeleteEvent(e.Id, resource.WithAllowMissing(true), resource.WithExpectedValue(e))
+			} else {
+				nextAt(gcAt)
+			}
+			continue
+		case demandresponsepb.Event_ACTIVE, demandresponsepb.Event_PENDING, demandresponsepb.Event_STATE_UNSPECIFIED:
+			switch {
+			case !now.Before(end):
+				state = demandresponsepb.Event_COMPLETED
+			case !now.Before(start):
+				state = demandresponsepb.Event_ACTIVE
+			default:
+				state = demandresponsepb.Event_PENDING
+			}
+		}
+		if state != e.State {
+			// the expected value protects against concurrent cancellation
+			_, err := c.model.UpdateEvent(&demandresponsepb.Event{Id: e.Id, State: state}, resource.WithUpdatePaths("state"), resource.WithExpectedValue(e))
+			if err != nil {
+				continue // the event changed, we'll be notified and try again
+			}
+		}
+		nextAt(start)
+		nextAt(end)
+		if state == demandresponsepb.Event_ACTIVE && (active == nil || c.maxTiers(e) > c.maxTiers(active)) {
+			active = e
+		}
+	}
+
+	want := 0
+	var target *float32
+	if active != nil {
+		maxTiers := c.maxTiers(active)
+		target = active.TargetRealPower
+		if target == nil || c.siteMeter == "" {
+			want = maxTiers
+		} else {
+			want = min(c.shedCount, maxTiers)
+			if c.siteDemand != nil && *c.siteDemand > *target && want < maxTiers {
+				if stepAt := c.lastStep.Add(c.stepInterval); c.lastStep.IsZero() || !now.Before(stepAt) {
+					want++
+					c.lastStep = now
+					nextAt(now.Add(c.stepInterval))
+				} else {
+					nextAt(stepAt)
+				}
+			}
+		}
+	}
+	c.apply(ctx, want, now)
+
+	status := &demandresponsepb.ShedStatus{
+		ShedTiers:       int32(c.shedCount),
+		SiteRealPower:   c.siteDemand,
+		TargetRealPower: target,
+	}
+	if active != nil {
+		status.EventId = active.Id
+	}
+	for _, t := range c.tiers {
+		st := &demandresponsepb.ShedStatus_Tier{Name: t.Name, Shed: t.shed, FailedDevices: int32(t.failed)}
+		if t.shed {
+			st.ShedTime = timestamppb.New(t.shedTime)
+		}
+		status.Tiers = append(status.Tiers, st)
+	}
+	_, _ = c.model.UpdateShedStatus(status, resource.WithUpdatePaths("event_id", "shed_tiers", "tiers", "site_real_power", "target_real_power"))
+	return next
+}
+
+// apply sheds or restores tiers until want tiers are shed.
+// Tiers are shed in order and restored in reverse order.
+func (c *controller) apply(ctx context.Context, want int, now time.Time) {
+	for c.shedCount < want {
+		t := c.tiers[c.shedCount]
+		c.logger.Info("shedding tier", zap.String("tier", t.Name), zap.Int("index", c.shedCount))
+		t.shedLoad(ctx, now)
+		c.shedCount++
+	}
+	for c.shedCount > want {
+		c.shedCount--
+		t := c.tiers[c.shedCount]
+		c.logger.Info("restoring tier", zap.String("tier", t.Name), zap.Int("index", c.shedCount))
+		t.restoreLoad(ctx)
+	}
+	if c.shedCount == 0 {
+		c.lastStep = time.Time{}
+	}
+}
+
+// maxTiers returns the number of tiers e allows to be shed.
+func (c *controller) maxTiers(e *demandresponsepb.Event) int {
+	if e.Level <= 0 || int(e.Level) > len(c.tiers) {
+		return len(c.tiers)
+	}
+	return int(e.Level)
+}
+
+// pullSiteDemand emits the real power of the site meter as it changes.
+func (c *controller) pullSiteDemand(ctx context.Context) <-chan float32 {
+	res := make(chan float32)
+	go func() {
+		// the task is configured to retry forever (until ctx is done) so the error is ignored.
+		_ = task.Run(ctx, func(ctx context.Context) (task.Next, error) {
+			stream, err := c.electric.PullDemand(ctx, &electricpb.PullDemandRequest{Name: c.siteMeter})
+			if err != nil {
+				return task.Normal, err
+			}
+			for {
+				msg, err := stream.Recv()
+				if err != nil {
+					return task.ResetBackoff, err
+				}
+				for _, change := range msg.Changes {
+					if d := change.GetDemand(); d == nil || d.RealPower == nil {
+						continue
+					}
+					select {
+					case <-ctx.Done():
+						return task.Normal, ctx.Err()
+					case res <- change.Demand.GetRealPower():
+					}
+				}
+			}
+		}, task.WithRetry(task.RetryUnlimited), task.WithBackoff(time.Second, time.Minute))
+	}()
+	return res
+}
@@ -0,0 +1,98 @@
+// Package demandresponse provides an automation that sheds load during demand response events.
+// Events are created via the DemandResponseApi, received from an OpenADR 2.0b VTN, or created on a schedule.
+// While an event is active configured tiers of devices are adjusted to reduce their load, in order,
+// and restored in reverse order once the event ends.
+package demandresponse
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smart-core-os/sc-bos/pkg/auto"
+	"github.com/smart-core-os/sc-bos/pkg/auto/demandresponse/config"
+	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/proto/demandresponsepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/electricpb"
+	"github.com/smart-core-os/sc-bos/pkg/task/service"
+)
+
+const AutoName = "demandresponse"
+
+var Factory auto.Factory = factory{}
+
+type factory struct{}
+
+func (f factory) New(services auto.Services) service.Lifecycle {
+	a := &impl{
+		Services:  services,
+		announcer: node.NewReplaceAnnouncer(services.Node),
+		// events outlive config changes
+		model: demandresponsepb.NewModel(),
+	}
+	a.Logger = a.Logger.Named(AutoName)
+	a.Service = service.New[config.Root](service.MonoApply(a.applyConfig), service.WithParser(config.Read))
+	return a
+}
+
+type impl struct {
+	auto.Services
+	*service.Service[config.Root]
+	announcer *node.ReplaceAnnouncer
+	model     *demandresponsepb.Model
+
+	// closed once the controller for the previous config has restored all load
+	controllerDone chan struct{}
+}
+
+func (a *impl) applyConfig(ctx context.Context, cfg config.Root) error {
+	var ven *ven
+	if cfg.OpenADR != nil {
+		var err error
+		ven, err = newVEN(cfg.OpenADR, a.model, a.Logger)
+		if err != nil {
+			return fmt.Errorf("openAdr: %w", err)
+		}
+	}
+
+	announce := a.announcer.Replace(ctx)
+	announce.Announce(cfg.Name,
+		node.HasServer(demandresponsepb.RegisterDemandResponseApiServer, demandresponsepb.DemandResponseApiServer(demandresponsepb.NewModelServer(a.model))),
+		node.HasTrait(demandresponsepb.TraitName),
+	)
+
+	clients := newClients(a.Services)
+	c := &controller{
+		model:        a.model,
+		siteMeter:    cfg.SiteMeter,
+		electric:     electricpb.NewElectricApiClient(a.ClientConn()),
+		stepInterval: cfg.StepInterval.Or(config.DefaultStepInterval),
+		keepEnded:    cfg.KeepEnded.Or(config.DefaultKeepEnded),
+		logger:       a.Logger,
+	}
+	for _, t := range cfg.Tiers {
+		c.tiers = append(c.tiers, &tier{Tier: t, clients: clients, logger: a.Logger})
+	}
+	prevDone := a.controllerDone
+	done := make(chan struct{})
+	a.controllerDone = done
+	go func() {
+		defer close(done)
+		// don't let the old and new controllers fight over the same devices.
+		// Waiting here rather than in applyConfig means a slow restore doesn't hold up the config being applied.
+		if prevDone != nil {
+			<-prevDone
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		_ = c.run(ctx)
+	}()
+
+	if ven != nil {
+		go ven.run(ctx)
+	}
+	for i, s := range cfg.Schedule {
+		go runSchedule(ctx, i, s, a.model, a.Logger)
+	}
+	return nil
+}
@@ -0,0 +1,322 @@
+package demandresponse
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/internal/node/nodeopts"
+	"github.com/smart-core-os/sc-bos/pkg/auto"
+	"github.com/smart-core-os/sc-bos/pkg/maintenance"
+	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/proto/demandresponsepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/electricpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/lightpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/maintenancepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/onoffpb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+)
+
+const testConfig = `{
+	"type": "demandresponse",
+	"name": "dr",
+	"siteMeter": "site",
+	"stepInterval": "5m",
+	"tiers": [
+		{"name": "lighting", "lights": {"devices": ["light"], "maxLevelPercent": 20}},
+		{"name": "chargers", "onOff": {"devices": ["charger"]}}
+	]
+}`
+
+func TestShedAndRestore(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		h := newTestHarness(t)
+		h.configure(testConfig)
+
+		// level 1 sheds only the first tier
+		event := h.createEvent(&demandresponsepb.Event{Level: 1, EndTime: timestamppb.New(time.Now().Add(time.Hour))})
+		synctest.Wait()
+		h.assertBrightness(20)
+		h.assertOnOff(onoffpb.OnOff_ON)
+		h.assertShedTiers(event.Id, 1)
+
+		time.Sleep(time.Hour)
+		synctest.Wait()
+		h.assertBrightness(80)
+		h.assertOnOff(onoffpb.OnOff_ON)
+		h.assertShedTiers("", 0)
+		got, _ := h.model.GetEvent(event.Id)
+		if got.State != demandresponsepb.Event_COMPLETED {
+			t.Errorf("event state = %v, want COMPLETED", got.State)
+		}
+	})
+}
+
+func TestCancelRestores(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		h := newTestHarness(t)
+		h.configure(testConfig)
+
+		// no level sheds all tiers, no target sheds them all at once
+		event := h.createEvent(&demandresponsepb.Event{EndTime: timestamppb.New(time.Now().Add(time.Hour))})
+		synctest.Wait()
+		h.assertBrightness(20)
+		h.assertOnOff(onoffpb.OnOff_OFF)
+		h.assertShedTiers(event.Id, 2)
+
+		_, err := h.client.CancelEvent(context.Background(), &demandresponsepb.CancelEventRequest{Name: "dr", Id: event.Id})
+		if err != nil {
+			t.Fatal(err)
+		}
+		synctest.Wait()
+		h.assertBrightness(80)
+		h.assertOnOff(onoffpb.OnOff_ON)
+		h.assertShedTiers("", 0)
+
+		_, err = h.client.CancelEvent(context.Background(), &demandresponsepb.CancelEventRequest{Name: "dr", Id: event.Id})
+		if err == nil {
+			t.Error("expected error cancelling an ended event")
+		}
+	})
+}
+
+func TestMaintenance(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		windows := maintenance.New()
+		h := newTestHarness(t, nodeopts.WithMaintenance(windows))
+		h.configure(testConfig)
+		_, err := windows.Create(context.Background(), &maintenancepb.MaintenanceWindow{
+			Reason:   "replacing drivers",
+			Selector: &maintenancepb.MaintenanceWindow_Selector{Names: []string{"light"}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the light in maintenance isn't shed, other devices are
+		h.createEvent(&demandresponsepb.Event{Level: 2, EndTime: timestamppb.New(time.Now().Add(time.Hour))})
+		synctest.Wait()
+		h.assertBrightness(80)
+		h.assertOnOff(onoffpb.OnOff_OFF)
+	})
+}
+
+func TestCreateEvent_requestUnchanged(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		h := newTestHarness(t)
+		h.configure(testConfig)
+
+		req := &demandresponsepb.Event{EndTime: timestamppb.New(time.Now().Add(time.Hour))}
+		want := proto.Clone(req)
+		// call the server directly, clients would marshal the request
+		_, err := demandresponsepb.NewModelServer(h.model).CreateEvent(context.Background(), &demandresponsepb.CreateEventRequest{Name: "dr", Event: req})
+		if err != nil {
+			t.Fatalf("CreateEvent: %v", err)
+		}
+		if !proto.Equal(req, want) {
+			t.Errorf("CreateEvent modified the request event, got %v, want %v", req, want)
+		}
+	})
+}
+
+func TestReconfigure_slowRestore(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		h := newTestHarness(t)
+		h.configure(testConfig)
+		event := h.createEvent(&demandresponsepb.Event{Level: 1, EndTime: timestamppb.New(time.Now().Add(time.Hour))})
+		synctest.Wait()
+		h.assertBrightness(20)
+
+		// restoring load via the old controller takes a while
+		h.lightDelay.Store(int64(20 * time.Second))
+		h.configure(strings.Replace(testConfig, `"5m"`, `"6m"`, 1))
+		if h.auto.State().Loading {
+			t.Error("config still loading while the old controller restores load")
+		}
+
+		// the new controller takes over once the old one has restored load
+		time.Sleep(time.Minute)
+		synctest.Wait()
+		h.assertBrightness(20)
+		h.assertShedTiers(event.Id, 1)
+		h.lightDelay.Store(0)
+	})
+}
+
+func TestTargetEscalation(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		h := newTestHarness(t)
+		h.configure(testConfig)
+		h.setDemand(10_000)
+
+		event := h.createEvent(&demandresponsepb.Event{
+			EndTime:         timestamppb.New(time.Now().Add(time.Hour)),
+			TargetRealPower: proto.Float32(5_000),
+		})
+		synctest.Wait()
+		h.assertShedTiers(event.Id, 1)
+		h.assertOnOff(onoffpb.OnOff_ON)
+
+		// still above target after the step interval
+		time.Sleep(5 * time.Minute)
+		synctest.Wait()
+		h.assertShedTiers(event.Id, 2)
+		h.assertOnOff(onoffpb.OnOff_OFF)
+
+		// dropping below the target doesn't restore load until the event ends
+		h.setDemand(4_000)
+		synctest.Wait()
+		h.assertShedTiers(event.Id, 2)
+	})
+}
+
+func TestSchedule(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		h := newTestHarness(t)
+		// the synctest clock starts at midnight UTC
+		h.configure(`{
+			"type": "demandresponse",
+			"name": "dr",
+			"tiers": [{"name": "lighting", "lights": {"devices": ["light"], "maxLevelPercent": 20}}],
+			"schedule": [{"start": "0 16 * * *", "duration": "2h", "description": "peak"}]
+		}`)
+
+		events := h.model.ListEvents()
+		if len(events) != 1 {
+			t.Fatalf("got %d events, want 1", len(events))
+		}
+		if events[0].Source != demandresponsepb.Event_SCHEDULE || events[0].State != demandresponsepb.Event_PENDING {
+			t.Fatalf("unexpected event %v", events[0])
+		}
+		h.assertBrightness(80)
+
+		time.Sleep(16*time.Hour + time.Minute)
+		synctest.Wait()
+		h.assertBrightness(20)
+		// the next occurrence is already pending
+		if got := len(h.model.ListEvents()); got != 2 {
+			t.Fatalf("got %d events, want 2", got)
+		}
+
+		time.Sleep(2 * time.Hour)
+		synctest.Wait()
+		h.assertBrightness(80)
+	})
+}
+
+type testHarness struct {
+	t          *testing.T
+	lightDelay atomic.Int64 // how long light updates take
+	node       *node.Node
+	auto       *impl
+	model      *demandresponsepb.Model
+	client     demandresponsepb.DemandResponseApiClient
+	light      *lightpb.Model
+	onOff      *onoffpb.Model
+	electric   *electricpb.Model
+}
+
+func newTestHarness(t *testing.T, opts ...nodeopts.Option) *testHarness {
+	t.Helper()
+	n := node.New("test", opts...)
+	h := &testHarness{
+		t:        t,
+		node:     n,
+		client:   demandresponsepb.NewDemandResponseApiClient(n.ClientConn()),
+		light:    lightpb.NewModel(),
+		onOff:    onoffpb.NewModel(),
+		electric: electricpb.NewModel(),
+	}
+	_, _ = h.light.UpdateBrightness(&lightpb.Brightness{LevelPercent: 80})
+	_, _ = h.onOff.UpdateOnOff(&onoffpb.OnOff{State: onoffpb.OnOff_ON})
+	n.Announce("light",
+		node.HasServer(lightpb.RegisterLightApiServer, lightpb.LightApiServer(&slowLight{LightApiServer: lightpb.NewModelServer(h.light), delay: &h.lightDelay})),
+		node.HasTrait(trait.Light),
+	)
+	n.Announce("charger",
+		node.HasServer(onoffpb.RegisterOnOffApiServer, onoffpb.OnOffApiServer(onoffpb.NewModelServer(h.onOff))),
+		node.HasTrait(trait.OnOff),
+	)
+	n.Announce("site",
+		node.HasServer(electricpb.RegisterElectricApiServer, electricpb.ElectricApiServer(electricpb.NewModelServer(h.electric))),
+		node.HasTrait(trait.Electric),
+	)
+
+	a := Factory.New(auto.Services{Logger: zaptest.NewLogger(t), Node: n}).(*impl)
+	if _, err := a.Start(); err != nil {
+		t.Fatalf("Failed to start automation: %v", err)
+	}
+	t.Cleanup(func() {
+		_, _ = a.Stop()
+		synctest.Wait()
+	})
+	h.auto = a
+	h.model = a.model
+	return h
+}
+
+type slowLight struct {
+	lightpb.LightApiServer
+	delay *atomic.Int64
+}
+
+func (l *slowLight) UpdateBrightness(ctx context.Context, req *lightpb.UpdateBrightnessRequest) (*lightpb.Brightness, error) {
+	time.Sleep(time.Duration(l.delay.Load()))
+	return l.LightApiServer.UpdateBrightness(ctx, req)
+}
+
+func (h *testHarness) configure(configJSON string) {
+	h.t.Helper()
+	if _, err := h.auto.Configure([]byte(configJSON)); err != nil {
+		h.t.Fatalf("Configure failed: %v", err)
+	}
+	synctest.Wait()
+}
+
+func (h *testHarness) createEvent(e *demandresponsepb.Event) *demandresponsepb.Event {
+	h.t.Helper()
+	res, err := h.client.CreateEvent(context.Background(), &demandresponsepb.CreateEventRequest{Name: "dr", Event: e})
+	if err != nil {
+		h.t.Fatalf("CreateEvent: %v", err)
+	}
+	return res
+}
+
+func (h *testHarness) setDemand(w float32) {
+	h.t.Helper()
+	if _, err := h.electric.UpdateDemand(&electricpb.ElectricDemand{RealPower: &w}); err != nil {
+		h.t.Fatalf("UpdateDemand: %v", err)
+	}
+	synctest.Wait()
+}
+
+func (h *testHarness) assertBrightness(want float32) {
+	h.t.Helper()
+	got, _ := h.light.GetBrightness()
+	if got.LevelPercent != want {
+		h.t.Errorf("brightness = %v, want %v", got.LevelPercent, want)
+	}
+}
+
+func (h *testHarness) assertOnOff(want onoffpb.OnOff_State) {
+	h.t.Helper()
+	got, _ := h.onOff.GetOnOff()
+	if got.State != want {
+		h.t.Errorf("onOff = %v, want %v", got.State, want)
+	}
+}
+
+func (h *testHarness) assertShedTiers(eventID string, want int32) {
+	h.t.Helper()
+	got := h.model.GetShedStatus()
+	if got.EventId != eventID || got.ShedTiers != want {
+		h.t.Errorf("shed status = {eventId: %q, shedTiers: %d}, want {eventId: %q, shedTiers: %d}", got.EventId, got.ShedTiers, eventID, want)
+	}
+}
@@ -0,0 +1,424 @@
+package demandresponse
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/pkg/auto/demandresponse/config"
+	"github.com/smart-core-os/sc-bos/pkg/proto/demandresponsepb"
+	"github.com/smart-core-os/sc-bos/pkg/resource"
+)
+
+// OpenADR 2.0b XML namespaces.
+const (
+	nsOADR = "http://openadr.org/oadr-2.0b/2012/07"
+	nsEI   = "http://docs.oasis-open.org/ns/energyinterop/201110"
+	nsPyld = "http://docs.oasis-open.org/ns/energyinterop/201110/payloads"
+)
+
+// ven is an OpenADR 2.0b virtual end node that polls a VTN for events, adding them to a model.
+type ven struct {
+	cfg    *config.OpenADR
+	client *http.Client
+	model  *demandresponsepb.Model
+	logger *zap.Logger
+
+	responded map[string]bool // keyed by eventID/modificationNumber
+}
+
+func newVEN(cfg *config.OpenADR, model *demandresponsepb.Model, logger *zap.Logger) (*ven, error) {
+	tlsConfig, err := cfg.TLS.Read("", nil)
+	if err != nil {
+		return nil, fmt.Errorf("tls: %w", err)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &ven{
+		cfg:       cfg,
+		client:    &http.Client{Transport: transport, Timeout: 30 * time.Second},
+		model:     model,
+		logger:    logger.With(zap.String("vtn", cfg.URL)),
+		responded: make(map[string]bool),
+	}, nil
+}
+
+// run polls the VTN until ctx is done.
+func (v *ven) run(ctx context.Context) error {
+	ticker := time.NewTicker(v.cfg.PollInterval.Or(config.DefaultPollInterval))
+	defer ticker.Stop()
+	for {
+		if err := v.poll(ctx); err != nil && ctx.Err() == nil {
+			v.logger.Warn("failed to poll VTN for events", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll requests events from the VTN, updating the model to match.
+func (v *ven) poll(ctx context.Context) error {
+	req := &oadrPayload{SignedObject: oadrSignedObject{RequestEvent: &oadrRequestEvent{
+		SchemaVersion: "2.0b",
+		EiRequestEvent: eiRequestEvent{
+			RequestID: uuid.NewString(),
+			VenID:     v.cfg.VenID,
+		},
+	}}}
+	var res oadrPayload
+	if err := v.post(ctx, "EiEvent", req, &res); err != nil {
+		return err
+	}
+	dist := res.SignedObject.DistributeEvent
+	if dist == nil {
+		return errors.New("response is not an oadrDistributeEvent")
+	}
+	if code := dist.EiResponse.ResponseCode; code != "" && code != "200" {
+		return fmt.Errorf("VTN responded %s: %s", code, dist.EiResponse.ResponseDescription)
+	}
+
+	responses := v.reconcile(dist.Events, time.Now())
+	if len(responses) == 0 {
+		return nil
+	}
+	created := &oadrPayload{SignedObject: oadrSignedObject{CreatedEvent: &oadrCreatedEvent{
+		SchemaVersion: "2.0b",
+		EiCreatedEvent: eiCreatedEvent{
+			EiResponse:     eiResponse{ResponseCode: "200", ResponseDescription: "OK"},
+			EventResponses: eventResponses{Responses: responses},
+			VenID:          v.cfg.VenID,
+		},
+	}}}
+	for i := range responses {
+		responses[i].RequestID = dist.RequestID
+	}
+	if err := v.post(ctx, "EiEvent", created, nil); err != nil {
+		return fmt.Errorf("oadrCreatedEvent: %w", err)
+	}
+	for _, r := range responses {
+		v.responded[r.QualifiedEventID.key()] = true
+	}
+	return nil
+}
+
+// reconcile updates the model to match the events known by the VTN.
+// Returns the responses that should be sent to the VTN for events that require them.
+func (v *ven) reconcile(events []oadrEvent, now time.Time) []eventResponse {
+	existing := make(map[string]*demandresponsepb.Event)
+	for _, e := range v.model.ListEvents() {
+		if e.Source == demandresponsepb.Event_OPENADR {
+			existing[e.ExternalId] = e
+		}
+	}
+
+	var responses []eventResponse
+	seen := make(map[string]bool)
+	for _, oe := range events {
+		desc := oe.EiEvent.EventDescriptor
+		logger := v.logger.With(zap.String("eventID", desc.EventID), zap.Int("modificationNumber", desc.ModificationNumber))
+		seen[desc.EventID] = true
+		old := existing[desc.EventID]
+
+		qualifiedID := qualifiedEventID{EventID: desc.EventID, ModificationNumber: desc.ModificationNumber}
+		if oe.ResponseRequired != "never" && !v.responded[qualifiedID.key()] {
+			responses = append(responses, eventResponse{
+				ResponseCode:        "200",
+				ResponseDescription: "OK",
+				QualifiedEventID:    qualifiedID,
+				OptType:             "optIn",
+			})
+		}
+
+		switch strings.ToLower(desc.EventStatus) {
+		case "cancelled":
+			if old != nil {
+				v.cancel(old)
+			}
+			continue
+		case "completed":
+			continue // our own clock decides when events complete
+		}
+
+		event, err := v.toEvent(oe, now)
+		if err != nil {
+			logger.Warn("ignoring event", zap.Error(err))
+			continue
+		}
+		if old == nil {
+			if _, err := v.model.CreateEvent(event); err != nil {
+				logger.Warn("failed to create event", zap.Error(err))
+			}
+			continue
+		}
+		switch old.State {
+		case demandresponsepb.Event_COMPLETED, demandresponsepb.Event_CANCELLED:
+			continue
+		}
+		event.Id = old.Id
+		_, err = v.model.UpdateEvent(event, resource.WithUpdatePaths("description", "start_time", "end_time", "level"))
+		if err != nil {
+			logger.Warn("failed to update event", zap.Error(err))
+		}
+	}
+
+	// events the VTN no longer knows about have been removed
+	for id, e := range existing {
+		if !seen[id] {
+			v.cancel(e)
+		}
+	}
+	return responses
+}
+
+func (v *ven) cancel(e *demandresponsepb.Event) {
+	switch e.State {
+	case demandresponsepb.Event_COMPLETED, demandresponsepb.Event_CANCELLED:
+		return
+	}
+	_, err := v.model.UpdateEvent(&demandresponsepb.Event{Id: e.Id, State: demandresponsepb.Event_CANCELLED}, resource.WithUpdatePaths("state"))
+	if err != nil {
+		v.logger.Warn("failed to cancel event", zap.String("eventID", e.ExternalId), zap.Error(err))
+	}
+}
+
+// toEvent converts an OpenADR event into an Event.
+// The level of the event is the highest SIMPLE signal value across all intervals.
+func (v *ven) toEvent(oe oadrEvent, now time.Time) (*demandresponsepb.Event, error) {
+	props := oe.EiEvent.ActivePeriod.Properties
+	start, err := time.Parse(time.RFC3339, strings.TrimSpace(props.DtStart.DateTime))
+	if err != nil {
+		return nil, fmt.Errorf("dtstart: %w", err)
+	}
+	dur, err := parseISODuration(props.Duration.Duration)
+	if err != nil {
+		return nil, fmt.Errorf("duration: %w", err)
+	}
+	if dur <= 0 {
+		return nil, errors.New("open ended events are not supported")
+	}
+
+	var level float64
+	var hasSignal bool
+	for _, sig := range oe.EiEvent.Signals {
+		if !strings.EqualFold(sig.SignalName, "SIMPLE") {
+			continue
+		}
+		hasSignal = true
+		for _, i := range sig.Intervals {
+			level = math.Max(level, i.Value)
+		}
+		if sig.CurrentValue != nil {
+			level = math.Max(level, *sig.CurrentValue)
+		}
+	}
+	if !hasSignal {
+		return nil, errors.New("no SIMPLE signal")
+	}
+	tiers := int32(math.Round(level))
+	if t, ok := v.cfg.LevelTiers[strconv.Itoa(int(tiers))]; ok {
+		tiers = t
+	}
+	if tiers <= 0 {
+		return nil, errors.New("signal level is normal")
+	}
+
+	return &demandresponsepb.Event{
+		Source:      demandresponsepb.Event_OPENADR,
+		ExternalId:  oe.EiEvent.EventDescriptor.EventID,
+		Description: fmt.Sprintf("OpenADR event %s", oe.EiEvent.EventDescriptor.EventID),
+		StartTime:   timestamppb.New(start),
+		EndTime:     timestamppb.New(start.Add(dur)),
+		Level:       tiers,
+		State:       demandresponsepb.Event_PENDING,
+	}, nil
+}
+
+func (v *ven) post(ctx context.Context, service string, body, dst any) error {
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	if err := xml.NewEncoder(&buf).Encode(body); err != nil {
+		return err
+	}
+	url := strings.TrimSuffix(v.cfg.URL, "/") + "/" + service
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, &buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/xml")
+	res, err := v.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", url, res.Status)
+	}
+	if dst == nil {
+		_, _ = io.Copy(io.Discard, res.Body)
+		return nil
+	}
+	return xml.NewDecoder(res.Body).Decode(dst)
+}
+
+var isoDurationRE = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// parseISODuration parses an ISO 8601 duration, as used by xCal, like "PT1H30M".
+// Years and months are not supported.
+func parseISODuration(s string) (time.Duration, error) {
+	m := isoDurationRE.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	var d time.Duration
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute}
+	for i, unit := range units {
+		if m[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+2])
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+		}
+		d += time.Duration(n) * unit
+	}
+	if m[6] != "" {
+		secs, err := strconv.ParseFloat(m[6], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", s, err)
+		}
+		d += time.Duration(secs * float64(time.Second))
+	}
+	if m[1] == "-" {
+		d = -d
+	}
+	return d, nil
+}
+
+// The types below model the subset of the OpenADR 2.0b schema used by the VEN.
+// When decoding, element names match regardless of namespace.
+
+type oadrPayload struct {
+	XMLName      xml.Name         `xml:"oadrPayload"`
+	SignedObject oadrSignedObject `xml:"oadrSignedObject"`
+}
+
+func (p *oadrPayload) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	type payload struct {
+		XMLName      xml.Name         `xml:"oadr:oadrPayload"`
+		XmlnsOADR    string           `xml:"xmlns:oadr,attr"`
+		XmlnsEI      string           `xml:"xmlns:ei,attr"`
+		XmlnsPyld    string           `xml:"xmlns:pyld,attr"`
+		SignedObject oadrSignedObject `xml:"oadr:oadrSignedObject"`
+	}
+	return e.Encode(payload{XmlnsOADR: nsOADR, XmlnsEI: nsEI, XmlnsPyld: nsPyld, SignedObject: p.SignedObject})
+}
+
+type oadrSignedObject struct {
+	RequestEvent    *oadrRequestEvent    `xml:"oadr:oadrRequestEvent,omitempty"`
+	CreatedEvent    *oadrCreatedEvent    `xml:"oadr:oadrCreatedEvent,omitempty"`
+	DistributeEvent *oadrDistributeEvent `xml:"oadrDistributeEvent,omitempty"`
+}
+
+type oadrRequestEvent struct {
+	SchemaVersion  string         `xml:"ei:schemaVersion,attr"`
+	EiRequestEvent eiRequestEvent `xml:"pyld:eiRequestEvent"`
+}
+
+type eiRequestEvent struct {
+	RequestID string `xml:"pyld:requestID"`
+	VenID     string `xml:"ei:venID"`
+}
+
+type oadrCreatedEvent struct {
+	SchemaVersion  string         `xml:"ei:schemaVersion,attr"`
+	EiCreatedEvent eiCreatedEvent `xml:"pyld:eiCreatedEvent"`
+}
+
+type eiCreatedEvent struct {
+	EiResponse     eiResponse     `xml:"ei:eiResponse"`
+	EventResponses eventResponses `xml:"ei:eventResponses"`
+	VenID          string         `xml:"ei:venID"`
+}
+
+type eiResponse struct {
+	ResponseCode        string `xml:"responseCode"`
+	ResponseDescription string `xml:"responseDescription"`
+}
+
+type eventResponses struct {
+	Responses []eventResponse `xml:"ei:eventResponse"`
+}
+
+type eventResponse struct {
+	ResponseCode        string           `xml:"ei:responseCode"`
+	ResponseDescription string           `xml:"ei:responseDescription"`
+	RequestID           string           `xml:"pyld:requestID"`
+	QualifiedEventID    qualifiedEventID `xml:"ei:qualifiedEventID"`
+	OptType             string           `xml:"ei:optType"`
+}
+
+type qualifiedEventID struct {
+	EventID            string `xml:"ei:eventID"`
+	ModificationNumber int    `xml:"ei:modificationNumber"`
+}
+
+func (q qualifiedEventID) key() string {
+	return fmt.Sprintf("%s/%d", q.EventID, q.ModificationNumber)
+}
+
+type oadrDistributeEvent struct {
+	EiResponse eiResponse  `xml:"eiResponse"`
+	RequestID  string      `xml:"requestID"`
+	VtnID      string      `xml:"vtnID"`
+	Events     []oadrEvent `xml:"oadrEvent"`
+}
+
+type oadrEvent struct {
+	EiEvent          eiEvent `xml:"eiEvent"`
+	ResponseRequired string  `xml:"oadrResponseRequired"`
+}
+
+type eiEvent struct {
+	EventDescriptor struct {
+		EventID            string `xml:"eventID"`
+		ModificationNumber int    `xml:"modificationNumber"`
+		EventStatus        string `xml:"eventStatus"`
+	} `xml:"eventDescriptor"`
+	ActivePeriod struct {
+		Properties struct {
+			DtStart struct {
+				DateTime string `xml:"date-time"`
+			} `xml:"dtstart"`
+			Duration struct {
+				Duration string `xml:"duration"`
+			} `xml:"duration"`
+		} `xml:"properties"`
+	} `xml:"eiActivePeriod"`
+	Signals []eiEventSignal `xml:"eiEventSignals>eiEventSignal"`
+}
+
+type eiEventSignal struct {
+	SignalName   string     `xml:"signalName"`
+	CurrentValue *float64   `xml:"currentValue>payloadFloat>value"`
+	Intervals    []interval `xml:"intervals>interval"`
+}
+
+type interval struct {
+	Value float64 `xml:"signalPayload>payloadFloat>value"`
+}
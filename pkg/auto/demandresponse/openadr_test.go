@@ -0,0 +1,178 @@
+package demandresponse
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+
+	"github.com/smart-core-os/sc-bos/pkg/auto/demandresponse/config"
+	"github.com/smart-core-os/sc-bos/pkg/proto/demandresponsepb"
+)
+
+func TestParseISODuration(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{in: "PT1H", want: time.Hour},
+		{in: "PT1H30M", want: 90 * time.Minute},
+		{in: "PT0.5S", want: 500 * time.Millisecond},
+		{in: "P1DT2H", want: 26 * time.Hour},
+		{in: "P1W", want: 7 * 24 * time.Hour},
+		{in: "-PT5M", want: -5 * time.Minute},
+		{in: "P", wantErr: true},
+		{in: "PT", wantErr: true},
+		{in: "P1M", wantErr: true},
+		{in: "1h", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseISODuration(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVEN_poll(t *testing.T) {
+	vtn := &fakeVTN{}
+	srv := httptest.NewServer(vtn)
+	defer srv.Close()
+
+	model := demandresponsepb.NewModel()
+	v, err := newVEN(&config.OpenADR{URL: srv.URL, VenID: "ven1", LevelTiers: map[string]int32{"3": 4}}, model, zaptest.NewLogger(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := t.Context()
+
+	start := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	vtn.setEvents(distributeEvent("e1", 0, "far", start, "PT2H", 3))
+	if err := v.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	events := model.ListEvents()
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1", len(events))
+	}
+	e := events[0]
+	if e.Source != demandresponsepb.Event_OPENADR || e.ExternalId != "e1" || e.Level != 4 || e.State != demandresponsepb.Event_PENDING {
+		t.Errorf("unexpected event %v", e)
+	}
+	if !e.StartTime.AsTime().Equal(start) || !e.EndTime.AsTime().Equal(start.Add(2*time.Hour)) {
+		t.Errorf("event times = [%v, %v), want [%v, %v)", e.StartTime.AsTime(), e.EndTime.AsTime(), start, start.Add(2*time.Hour))
+	}
+	if got := vtn.createdEvents(); len(got) != 1 || !strings.Contains(got[0], "optIn") || !strings.Contains(got[0], "e1") {
+		t.Errorf("expected a single optIn response for e1, got %v", got)
+	}
+
+	// polling again doesn't duplicate the event or the response
+	if err := v.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(model.ListEvents()); got != 1 {
+		t.Errorf("got %d events after re-poll, want 1", got)
+	}
+	if got := len(vtn.createdEvents()); got != 1 {
+		t.Errorf("got %d responses after re-poll, want 1", got)
+	}
+
+	// modifications update the event
+	vtn.setEvents(distributeEvent("e1", 1, "far", start, "PT1H", 1))
+	if err := v.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	e, _ = model.GetEvent(e.Id)
+	if e.Level != 1 || !e.EndTime.AsTime().Equal(start.Add(time.Hour)) {
+		t.Errorf("event not updated %v", e)
+	}
+
+	// removed events are cancelled
+	vtn.setEvents("")
+	if err := v.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	e, _ = model.GetEvent(e.Id)
+	if e.State != demandresponsepb.Event_CANCELLED {
+		t.Errorf("event state = %v, want CANCELLED", e.State)
+	}
+}
+
+type fakeVTN struct {
+	mu      sync.Mutex
+	events  string
+	created []string
+}
+
+func (f *fakeVTN) setEvents(events string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events = events
+}
+
+func (f *fakeVTN) createdEvents() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.created...)
+}
+
+func (f *fakeVTN) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/EiEvent" {
+		http.NotFound(w, r)
+		return
+	}
+	body, _ := io.ReadAll(r.Body)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var probe struct {
+		XMLName      xml.Name
+		SignedObject struct {
+			Inner []struct{ XMLName xml.Name } `xml:",any"`
+		} `xml:"oadrSignedObject"`
+	}
+	if err := xml.Unmarshal(body, &probe); err != nil || len(probe.SignedObject.Inner) != 1 {
+		http.Error(w, "bad payload", http.StatusBadRequest)
+		return
+	}
+	switch probe.SignedObject.Inner[0].XMLName.Local {
+	case "oadrRequestEvent":
+		_, _ = io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?>
+<oadr:oadrPayload xmlns:oadr="`+nsOADR+`" xmlns:ei="`+nsEI+`" xmlns:pyld="`+nsPyld+`">
+<oadr:oadrSignedObject><oadr:oadrDistributeEvent ei:schemaVersion="2.0b">
+<ei:eiResponse><ei:responseCode>200</ei:responseCode><ei:responseDescription>OK</ei:responseDescription><pyld:requestID/></ei:eiResponse>
+<pyld:requestID>req1</pyld:requestID><ei:vtnID>vtn1</ei:vtnID>`+f.events+`
+</oadr:oadrDistributeEvent></oadr:oadrSignedObject></oadr:oadrPayload>`)
+	case "oadrCreatedEvent":
+		f.created = append(f.created, string(body))
+	default:
+		http.Error(w, "unexpected payload", http.StatusBadRequest)
+	}
+}
+
+func distributeEvent(id string, modNum int, status string, start time.Time, dur string, level int) string {
+	return `<oadr:oadrEvent><ei:eiEvent>
+<ei:eventDescriptor><ei:eventID>` + id + `</ei:eventID><ei:modificationNumber>` + strconv.Itoa(modNum) + `</ei:modificationNumber><ei:eventStatus>` + status + `</ei:eventStatus></ei:eventDescriptor>
+<ei:eiActivePeriod><xcal:properties xmlns:xcal="urn:ietf:params:xml:ns:icalendar-2.0">
+<xcal:dtstart><xcal:date-time>` + start.Format(time.RFC3339) + `</xcal:date-time></xcal:dtstart>
+<xcal:duration><xcal:duration>` + dur + `</xcal:duration></xcal:duration>
+</xcal:properties></ei:eiActivePeriod>
+<ei:eiEventSignals><ei:eiEventSignal>
+<strm:intervals xmlns:strm="urn:ietf:params:xml:ns:icalendar-2.0:stream"><ei:interval><ei:signalPayload><ei:payloadFloat><ei:value>` + strconv.Itoa(level) + `</ei:value></ei:payloadFloat></ei:signalPayload></ei:interval></strm:intervals>
+<ei:signalName>simple</ei:signalName><ei:signalType>level</ei:signalType>
+<ei:currentValue><ei:payloadFloat><ei:value>0</ei:value></ei:payloadFloat></ei:currentValue>
+</ei:eiEventSignal></ei:eiEventSignals>
+</ei:eiEvent><oadr:oadrResponseRequired>always</oadr:oadrResponseRequired></oadr:oadrEvent>`
+}
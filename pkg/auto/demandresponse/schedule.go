@@ -0,0 +1,80 @@
+package demandresponse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/pkg/auto/demandresponse/config"
+	"github.com/smart-core-os/sc-bos/pkg/proto/demandresponsepb"
+	"github.com/smart-core-os/sc-bos/pkg/resource"
+)
+
+// runSchedule creates a pending event for each upcoming occurrence of s until ctx is done.
+// The next event is created as soon as the previous one starts so it is visible ahead of time.
+// Any pending event created by the schedule is cancelled when ctx is done.
+func runSchedule(ctx context.Context, index int, s config.ScheduledEvent, model *demandresponsepb.Model, logger *zap.Logger) {
+	logger = logger.With(zap.Int("schedule", index), zap.Stringer("start", &s.Start))
+	t := time.Now()
+	for {
+		start := s.Start.Next(t)
+		if start.IsZero() {
+			return // the schedule will never fire
+		}
+		event, err := scheduleEvent(model, index, s, start)
+		if err != nil {
+			logger.Warn("failed to create scheduled event", zap.Error(err))
+		}
+
+		timer := time.NewTimer(time.Until(start))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if event != nil {
+				cancelPending(model, event.Id)
+			}
+			return
+		case <-timer.C:
+		}
+		t = start
+	}
+}
+
+// scheduleEvent creates the event starting at start, unless it already exists.
+func scheduleEvent(model *demandresponsepb.Model, index int, s config.ScheduledEvent, start time.Time) (*demandresponsepb.Event, error) {
+	externalID := fmt.Sprintf("%d/%s", index, start.UTC().Format(time.RFC3339))
+	for _, e := range model.ListEvents() {
+		if e.Source == demandresponsepb.Event_SCHEDULE && e.ExternalId == externalID {
+			return e, nil
+		}
+	}
+	return model.CreateEvent(&demandresponsepb.Event{
+		Source:          demandresponsepb.Event_SCHEDULE,
+		ExternalId:      externalID,
+		Description:     s.Description,
+		StartTime:       timestamppb.New(start),
+		EndTime:         timestamppb.New(start.Add(s.Duration.Duration)),
+		Level:           s.Level,
+		TargetRealPower: s.TargetRealPower,
+		State:           demandresponsepb.Event_PENDING,
+	})
+}
+
+// cancelPending cancels the event with id if it hasn't started yet.
+func cancelPending(model *demandresponsepb.Model, id string) {
+	_, _ = model.UpdateEvent(&demandresponsepb.Event{Id: id, State: demandresponsepb.Event_CANCELLED},
+		resource.WithUpdatePaths("state"),
+		resource.WithExpectedCheck(func(msg proto.Message) error {
+			if e := msg.(*demandresponsepb.Event); e.State != demandresponsepb.Event_PENDING || !time.Now().Before(e.StartTime.AsTime()) {
+				return errStarted
+			}
+			return nil
+		}))
+}
+
+var errStarted = errors.New("event has started")
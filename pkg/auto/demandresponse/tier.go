@@ -0,0 +1,250 @@
+package demandresponse
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/smart-core-os/sc-bos/pkg/auto/demandresponse/config"
+	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/proto/airtemperaturepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/fanspeedpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/lightpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/onoffpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/typespb"
+)
+
+// undo reverts a change made to a device when a tier was shed.
+type undo func(ctx context.Context) error
+
+// clients holds the trait clients used to shed load.
+type clients struct {
+	light          lightpb.LightApiClient
+	airTemperature airtemperaturepb.AirTemperatureApiClient
+	fanSpeed       fanspeedpb.FanSpeedApiClient
+	onOff          onoffpb.OnOffApiClient
+}
+
+func newClients(conn node.ClientConner) clients {
+	cc := conn.ClientConn()
+	return clients{
+		light:          lightpb.NewLightApiClient(cc),
+		airTemperature: airtemperaturepb.NewAirTemperatureApiClient(cc),
+		fanSpeed:       fanspeedpb.NewFanSpeedApiClient(cc),
+		onOff:          onoffpb.NewOnOffApiClient(cc),
+	}
+}
+
+// tier tracks the shed state of a config.Tier.
+type tier struct {
+	config.Tier
+	clients clients
+	logger  *zap.Logger
+
+	shed     bool
+	shedTime time.Time
+	failed   int
+	undos    []undo
+}
+
+// shedLoad adjusts all devices in the tier to reduce their load, remembering how to undo each change.
+// Devices that fail to be adjusted are logged and counted, they don't stop other devices being adjusted.
+func (t *tier) shedLoad(ctx context.Context, now time.Time) {
+	t.shed = true
+	t.shedTime = now
+	t.failed = 0
+	t.undos = nil
+	do := func(name string, fn func(ctx context.Context, name string) (undo, error)) {
+		u, err := fn(ctx, name)
+		if err != nil {
+			t.failed++
+			t.logger.Warn("failed to shed load", zap.String("tier", t.Name), zap.String("device", name), zap.Error(err))
+			return
+		}
+		if u != nil {
+			t.undos = append(t.undos, u)
+		}
+	}
+	if t.Lights != nil {
+		for _, name := range t.Lights.Devices {
+			do(name, t.dimLight)
+		}
+	}
+	if t.AirTemperature != nil {
+		for _, name := range t.AirTemperature.Devices {
+			do(name, t.widenSetPoint)
+		}
+	}
+	if t.FanSpeed != nil {
+		for _, name := range t.FanSpeed.Devices {
+			do(name, t.slowFan)
+		}
+	}
+	if t.OnOff != nil {
+		for _, name := range t.OnOff.Devices {
+			do(name, t.turnOff)
+		}
+	}
+}
+
+// restoreLoad undoes all changes made by shedLoad, in reverse order.
+func (t *tier) restoreLoad(ctx context.Context) {
+	var errs []error
+	for i := len(t.undos) - 1; i >= 0; i-- {
+		if err := t.undos[i](ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		t.logger.Warn("failed to restore load", zap.String("tier", t.Name), zap.Error(err))
+	}
+	t.shed = false
+	t.shedTime = time.Time{}
+	t.failed = 0
+	t.undos = nil
+}
+
+func (t *tier) dimLight(ctx context.Context, name string) (undo, error) {
+	old, err := t.clients.light.GetBrightness(ctx, &lightpb.GetBrightnessRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	if old.LevelPercent <= t.Lights.MaxLevelPercent {
+		return nil, nil
+	}
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"level_percent"}}
+	_, err = t.clients.light.UpdateBrightness(ctx, &lightpb.UpdateBrightnessRequest{
+		Name:       name,
+		Brightness: &lightpb.Brightness{LevelPercent: t.Lights.MaxLevelPercent},
+		UpdateMask: mask,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context) error {
+		_, err := t.clients.light.UpdateBrightness(ctx, &lightpb.UpdateBrightnessRequest{
+			Name:       name,
+			Brightness: &lightpb.Brightness{LevelPercent: old.LevelPercent},
+			UpdateMask: mask,
+		})
+		return err
+	}, nil
+}
+
+func (t *tier) widenSetPoint(ctx context.Context, name string) (undo, error) {
+	old, err := t.clients.airTemperature.GetAirTemperature(ctx, &airtemperaturepb.GetAirTemperatureRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	offset := t.AirTemperature.SetPointOffset
+	shed := &airtemperaturepb.AirTemperature{}
+	restore := &airtemperaturepb.AirTemperature{}
+	var mask *fieldmaskpb.FieldMask
+	switch goal := old.TemperatureGoal.(type) {
+	case *airtemperaturepb.AirTemperature_TemperatureSetPoint:
+		sp := goal.TemperatureSetPoint.GetValueCelsius()
+		dir := setPointDirection(old)
+		if dir == 0 {
+			return nil, nil // we don't know which way is less work for the device
+		}
+		shed.TemperatureGoal = &airtemperaturepb.AirTemperature_TemperatureSetPoint{TemperatureSetPoint: &typespb.Temperature{ValueCelsius: sp + dir*offset}}
+		restore.TemperatureGoal = &airtemperaturepb.AirTemperature_TemperatureSetPoint{TemperatureSetPoint: goal.TemperatureSetPoint}
+		mask = &fieldmaskpb.FieldMask{Paths: []string{"temperature_set_point"}}
+	case *airtemperaturepb.AirTemperature_TemperatureRange:
+		r := proto.Clone(goal.TemperatureRange).(*airtemperaturepb.TemperatureRange)
+		r.Low = &typespb.Temperature{ValueCelsius: r.GetLow().GetValueCelsius() - offset}
+		r.High = &typespb.Temperature{ValueCelsius: r.GetHigh().GetValueCelsius() + offset}
+		shed.TemperatureGoal = &airtemperaturepb.AirTemperature_TemperatureRange{TemperatureRange: r}
+		restore.TemperatureGoal = &airtemperaturepb.AirTemperature_TemperatureRange{TemperatureRange: goal.TemperatureRange}
+		mask = &fieldmaskpb.FieldMask{Paths: []string{"temperature_range"}}
+	default:
+		return nil, nil
+	}
+	_, err = t.clients.airTemperature.UpdateAirTemperature(ctx, &airtemperaturepb.UpdateAirTemperatureRequest{
+		Name:       name,
+		State:      shed,
+		UpdateMask: mask,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context) error {
+		_, err := t.clients.airTemperature.UpdateAirTemperature(ctx, &airtemperaturepb.UpdateAirTemperatureRequest{
+			Name:       name,
+			State:      restore,
+			UpdateMask: mask,
+		})
+		return err
+	}, nil
+}
+
+// setPointDirection returns 1 if raising the set point of v reduces load, -1 if lowering it does, or 0 if unknown.
+func setPointDirection(v *airtemperaturepb.AirTemperature) float64 {
+	switch v.Mode {
+	case airtemperaturepb.AirTemperature_HEAT:
+		return -1
+	case airtemperaturepb.AirTemperature_COOL:
+		return 1
+	case airtemperaturepb.AirTemperature_OFF:
+		return 0
+	}
+	if v.AmbientTemperature == nil {
+		return 0
+	}
+	switch ambient, sp := v.AmbientTemperature.ValueCelsius, v.GetTemperatureSetPoint().GetValueCelsius(); {
+	case ambient > sp:
+		return 1 // cooling
+	case ambient < sp:
+		return -1 // heating
+	}
+	return 0
+}
+
+func (t *tier) slowFan(ctx context.Context, name string) (undo, error) {
+	old, err := t.clients.fanSpeed.GetFanSpeed(ctx, &fanspeedpb.GetFanSpeedRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	if old.Percentage <= t.FanSpeed.MaxPercentage {
+		return nil, nil
+	}
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"percentage"}}
+	_, err = t.clients.fanSpeed.UpdateFanSpeed(ctx, &fanspeedpb.UpdateFanSpeedRequest{
+		Name:       name,
+		FanSpeed:   &fanspeedpb.FanSpeed{Percentage: t.FanSpeed.MaxPercentage},
+		UpdateMask: mask,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context) error {
+		_, err := t.clients.fanSpeed.UpdateFanSpeed(ctx, &fanspeedpb.UpdateFanSpeedRequest{
+			Name:       name,
+			FanSpeed:   &fanspeedpb.FanSpeed{Percentage: old.Percentage},
+			UpdateMask: mask,
+		})
+		return err
+	}, nil
+}
+
+func (t *tier) turnOff(ctx context.Context, name string) (undo, error) {
+	old, err := t.clients.onOff.GetOnOff(ctx, &onoffpb.GetOnOffRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	if old.State != onoffpb.OnOff_ON {
+		return nil, nil
+	}
+	_, err = t.clients.onOff.UpdateOnOff(ctx, &onoffpb.UpdateOnOffRequest{Name: name, OnOff: &onoffpb.OnOff{State: onoffpb.OnOff_OFF}})
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context) error {
+		_, err := t.clients.onOff.UpdateOnOff(ctx, &onoffpb.UpdateOnOffRequest{Name: name, OnOff: &onoffpb.OnOff{State: onoffpb.OnOff_ON}})
+		return err
+	}, nil
+}
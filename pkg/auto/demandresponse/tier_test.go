@@ -0,0 +1,80 @@
+package demandresponse
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/smart-core-os/sc-bos/pkg/auto/demandresponse/config"
+	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/proto/airtemperaturepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/typespb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+)
+
+func TestTier_widenSetPoint(t *testing.T) {
+	setPoint := func(c float64) *airtemperaturepb.AirTemperature_TemperatureSetPoint {
+		return &airtemperaturepb.AirTemperature_TemperatureSetPoint{TemperatureSetPoint: &typespb.Temperature{ValueCelsius: c}}
+	}
+	tempRange := func(low, high float64) *airtemperaturepb.AirTemperature_TemperatureRange {
+		return &airtemperaturepb.AirTemperature_TemperatureRange{TemperatureRange: &airtemperaturepb.TemperatureRange{
+			Low:  &typespb.Temperature{ValueCelsius: low},
+			High: &typespb.Temperature{ValueCelsius: high},
+		}}
+	}
+	tests := []struct {
+		name string
+		old  *airtemperaturepb.AirTemperature
+		shed *airtemperaturepb.AirTemperature
+	}{
+		{
+			"set point",
+			&airtemperaturepb.AirTemperature{Mode: airtemperaturepb.AirTemperature_COOL, TemperatureGoal: setPoint(21)},
+			&airtemperaturepb.AirTemperature{Mode: airtemperaturepb.AirTemperature_COOL, TemperatureGoal: setPoint(23)},
+		},
+		{
+			"range",
+			&airtemperaturepb.AirTemperature{Mode: airtemperaturepb.AirTemperature_AUTO, TemperatureGoal: tempRange(19, 23)},
+			&airtemperaturepb.AirTemperature{Mode: airtemperaturepb.AirTemperature_AUTO, TemperatureGoal: tempRange(17, 25)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			n := node.New("test")
+			model := airtemperaturepb.NewModel()
+			if _, err := model.UpdateAirTemperature(tt.old); err != nil {
+				t.Fatal(err)
+			}
+			n.Announce("fcu",
+				node.HasServer(airtemperaturepb.RegisterAirTemperatureApiServer, airtemperaturepb.AirTemperatureApiServer(airtemperaturepb.NewModelServer(model))),
+				node.HasTrait(trait.AirTemperature),
+			)
+			tr := &tier{
+				Tier:    config.Tier{AirTemperature: &config.AirTemperature{SetPointOffset: 2}},
+				clients: newClients(n),
+				logger:  zaptest.NewLogger(t),
+			}
+
+			undo, err := tr.widenSetPoint(ctx, "fcu")
+			if err != nil {
+				t.Fatalf("widenSetPoint: %v", err)
+			}
+			got, _ := model.GetAirTemperature()
+			if diff := cmp.Diff(tt.shed, got, protocmp.Transform()); diff != "" {
+				t.Errorf("shed air temperature (-want +got):\n%s", diff)
+			}
+
+			if err := undo(ctx); err != nil {
+				t.Fatalf("undo: %v", err)
+			}
+			got, _ = model.GetAirTemperature()
+			if diff := cmp.Diff(tt.old, got, protocmp.Transform()); diff != "" {
+				t.Errorf("restored air temperature (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -32,7 +32,7 @@ type bacnet struct {
 }
 
 func (b *bacnet) applyConfig(ctx context.Context, cfg config.BacnetSource) error {
-	bacnetDriverClient := rpc.NewBacnetDriverServiceClient(b.services.Node.ClientConn())
+	bacnetDriverClient := rpc.NewBacnetDriverServiceClient(b.services.ClientConn())
 
 	delay := 5 * time.Second
 	if cfg.COV != nil && cfg.COV.PollDelay.Duration != 0 {
@@ -32,7 +32,7 @@ type smartCore struct {
 }
 
 func (s *smartCore) applyConfig(ctx context.Context, cfg config.SmartCoreSource) error {
-	conn := s.services.Node.ClientConn()
+	conn := s.services.ClientConn()
 	parentClient := parentpb.NewParentApiClient(conn)
 	lightClient := lightpb.NewLightApiClient(conn)
 
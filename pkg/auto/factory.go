@@ -8,14 +8,18 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
+	"github.com/smart-core-os/sc-bos/pkg/alias"
 	"github.com/smart-core-os/sc-bos/pkg/app/stores"
 	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/node/priority"
 	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
 	"github.com/smart-core-os/sc-bos/pkg/task/service"
 )
 
 type Services struct {
+	// Name of the automation, the owner of the writes it makes using ClientConn.
+	Name            string
 	Logger          *zap.Logger
 	Node            *node.Node // for advertising devices
 	Devices         devicespb.DevicesApiClient
@@ -33,6 +37,16 @@ type Services struct {
 	Now             func() time.Time
 	Config          service.ConfigUpdater
 	Health          *healthpb.Checks
+	// Aliases are the old names of devices, for automations that record or report on devices by name.
+	// A nil Table has no aliases.
+	Aliases *alias.Table
+}
+
+// ClientConn returns a connection to the node whose writes are made at priority.Auto,
+// so manual writes take precedence and devices in maintenance are left alone.
+// Automations should use this rather than Node.ClientConn.
+func (s Services) ClientConn() grpc.ClientConnInterface {
+	return priority.WriteConn(s.Node.ClientConn(), priority.Write{Level: priority.Auto, Owner: s.Name})
 }
 
 // CloudCredentialSource exposes the node's current Connect leaf certificate and
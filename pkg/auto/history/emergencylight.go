@@ -0,0 +1,73 @@
+package history
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smart-core-os/sc-bos/pkg/auto/history/config"
+	"github.com/smart-core-os/sc-bos/pkg/proto/emergencylightpb"
+	"github.com/smart-core-os/sc-bos/pkg/util/cmp"
+)
+
+func (a *automation) collectTestResultChanges(ctx context.Context, source config.Source, payloads chan<- []byte) {
+	client := emergencylightpb.NewEmergencyLightApiClient(a.clients.ClientConn())
+
+	last := newDeduper[*emergencylightpb.TestResultSet](cmp.Equal())
+
+	pullFn := func(ctx context.Context, changes chan<- []byte) error {
+		stream, err := client.PullTestResultSets(ctx, &emergencylightpb.PullTestResultRequest{Name: source.Name, UpdatesOnly: true, ReadMask: source.ReadMask.PB()})
+		if err != nil {
+			return err
+		}
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				return err
+			}
+			for _, change := range msg.Changes {
+				if !last.Changed(change.GetTestResult()) {
+					continue
+				}
+
+				payload, err := proto.Marshal(change.GetTestResult())
+				if err != nil {
+					return err
+				}
+
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case changes <- payload:
+				}
+			}
+		}
+	}
+	pollFn := func(ctx context.Context, changes chan<- []byte) error {
+		resp, err := client.GetTestResultSet(ctx, &emergencylightpb.GetTestResultSetRequest{Name: source.Name, ReadMask: source.ReadMask.PB()})
+		if err != nil {
+			return err
+		}
+
+		if !last.Changed(resp) {
+			return nil
+		}
+
+		payload, err := proto.Marshal(resp)
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case changes <- payload:
+		}
+		return nil
+	}
+
+	if err := collectChanges(ctx, source, pullFn, pollFn, payloads, a.logger); err != nil {
+		a.logger.Warn("collection aborted", zap.Error(err))
+	}
+}
@@ -8,8 +8,14 @@ import (
 	"fmt"
 
 	"github.com/timshannon/bolthold"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
+	"github.com/smart-core-os/sc-bos/internal/metrics"
+	"github.com/smart-core-os/sc-bos/pkg/alias"
 	"github.com/smart-core-os/sc-bos/pkg/app/stores"
 	"github.com/smart-core-os/sc-bos/pkg/auto"
 	"github.com/smart-core-os/sc-bos/pkg/auto/history/config"
@@ -26,6 +32,7 @@ import (
 	"github.com/smart-core-os/sc-bos/pkg/proto/bootpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/electricpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/emergencylightpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/enterleavesensorpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/historypb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/meterpb"
@@ -40,9 +47,11 @@ import (
 
 var Factory = auto.FactoryFunc(NewAutomation)
 
+var tracer = otel.Tracer("github.com/smart-core-os/sc-bos/pkg/auto/history")
+
 func NewAutomation(services auto.Services) service.Lifecycle {
 	a := &automation{
-		clients:   services.Node,
+		clients:   services,
 		announcer: node.NewReplaceAnnouncer(services.Node),
 		logger:    services.Logger.Named("history"),
 
@@ -50,6 +59,7 @@ func NewAutomation(services auto.Services) service.Lifecycle {
 		stores: services.Stores,
 
 		devices: services.Devices,
+		aliases: services.Aliases,
 
 		cohortManagerName: "", // use the default
 		cohortManager:     services.CohortManager,
@@ -73,6 +83,7 @@ type automation struct {
 	stores *stores.Stores
 
 	devices devicespb.DevicesApiClient
+	aliases *alias.Table
 
 	cohortManagerName string
 	cohortManager     node.Remote
@@ -102,7 +113,7 @@ func (a *automation) applyConfig(ctx context.Context, cfg config.Root) error {
 			case <-ctx.Done():
 				return
 			case payload := <-payloads:
-				_, err := store.Append(ctx, payload)
+				err := a.append(ctx, store, *cfg.Source, cfg.Storage.Type, payload)
 				if err != nil {
 					a.logger.Warn("storage failed", zap.Error(err))
 				}
@@ -217,7 +228,8 @@ func (a *automation) startDeviceRecording(ctx context.Context, announce node.Ann
 			case <-ctx.Done():
 				return
 			case payload := <-payloads:
-				if _, err := store.Append(ctx, payload); err != nil {
+				err := a.append(ctx, store, src, cfg.Storage.Type, payload)
+				if err != nil {
 					a.logger.Warn("storage failed", zap.String("device", deviceName), zap.Error(err))
 				}
 			}
@@ -230,6 +242,23 @@ func (a *automation) startDeviceRecording(ctx context.Context, announce node.Ann
 	return undo, nil
 }
 
+// append writes payload from src to store, recording the write in metrics and as a span.
+func (a *automation) append(ctx context.Context, store history.Store, src config.Source, storageType string, payload []byte) error {
+	ctx, span := tracer.Start(ctx, "history.Append", trace.WithAttributes(
+		attribute.String("history.source", src.Name),
+		attribute.String("history.trait", string(src.Trait)),
+		attribute.String("history.storage", storageType),
+		attribute.Int("history.payload_size", len(payload)),
+	))
+	defer span.End()
+	_, err := store.Append(ctx, payload)
+	metrics.ObserveHistoryAppend(string(src.Trait), storageType, err)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
 func (a *automation) createStore(ctx context.Context, src config.Source, storage *config.Storage) (history.Store, error) {
 	switch storage.Type {
 	case "postgres":
@@ -246,6 +275,9 @@ func (a *automation) createStore(ctx context.Context, src config.Source, storage
 				opts = append(opts, pgxstore.WithMaxCount(ttl.MaxCount))
 			}
 		}
+		if aliases := a.aliasSources(src); len(aliases) > 0 {
+			opts = append(opts, pgxstore.WithAliases(aliases...))
+		}
 		return pgxstore.SetupStoreFromPools(ctx, src.SourceName(), pools, opts...)
 	case "memory":
 		var opts []memstore.Option
@@ -303,12 +335,23 @@ func (a *automation) createStore(ctx context.Context, src config.Source, storage
 				opts = append(opts, sqlitestore.WithMaxCount(ttl.MaxCount))
 			}
 		}
-		return db.OpenStore(src.SourceName(), opts...), nil
+		return db.OpenAliasedStore(src.SourceName(), a.aliasSources(src), opts...), nil
 	default:
 		return nil, fmt.Errorf("unsupported storage type %s", storage.Type)
 	}
 }
 
+// aliasSources returns the store sources that records for src were written under before the device was renamed.
+func (a *automation) aliasSources(src config.Source) []string {
+	var sources []string
+	for _, name := range a.aliases.AliasesOf(src.Name) {
+		aliasSrc := src
+		aliasSrc.Name = name
+		sources = append(sources, aliasSrc.SourceName())
+	}
+	return sources
+}
+
 func (a *automation) createCollector(store history.Store, traitName trait.Name) (node.Feature, collector, error) {
 	switch traitName {
 	case allocationpb.TraitName:
@@ -319,6 +362,8 @@ func (a *automation) createCollector(store history.Store, traitName trait.Name)
 		return node.HasServer(airtemperaturepb.RegisterAirTemperatureHistoryServer, airtemperaturepb.AirTemperatureHistoryServer(historypb.NewAirTemperatureServer(store))), a.collectAirTemperatureChanges, nil
 	case trait.Electric:
 		return node.HasServer(electricpb.RegisterElectricHistoryServer, electricpb.ElectricHistoryServer(historypb.NewElectricServer(store))), a.collectElectricDemandChanges, nil
+	case emergencylightpb.TraitName:
+		return node.HasServer(emergencylightpb.RegisterEmergencyLightHistoryServer, emergencylightpb.EmergencyLightHistoryServer(historypb.NewEmergencyLightServer(store))), a.collectTestResultChanges, nil
 	case trait.EnterLeaveSensor:
 		return node.HasServer(enterleavesensorpb.RegisterEnterLeaveSensorHistoryServer, enterleavesensorpb.EnterLeaveSensorHistoryServer(historypb.NewEnterLeaveSensorServer(store))), a.collectEnterLeaveEventChanges, nil
 	case meterpb.TraitName:
@@ -13,6 +13,7 @@ import (
 
 	"github.com/smart-core-os/sc-bos/pkg/auto/lights/config"
 	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/node/priority"
 )
 
 // BrightnessAutomation implements turning lights on or off based on occupancy readings from PIRs and other devices.
@@ -190,7 +191,9 @@ func (b *BrightnessAutomation) processStateChanges(ctx context.Context, readStat
 		for _, reason := range reasons {
 			writeState.AddReason(reason) // record why we're running in the completion log
 		}
-		ttl, err := processState(ctx, readState, writeState, actions)
+		// write at the auto level, so manual overrides take precedence until they expire
+		writeCtx := priority.NewOutgoingContext(ctx, priority.Write{Level: priority.Auto, Owner: readState.Config.Name})
+		ttl, err := processState(writeCtx, readState, writeState, actions)
 		writeState.After()
 		duration := readState.Now().Sub(t0)
 
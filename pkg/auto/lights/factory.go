@@ -16,7 +16,7 @@ const AutoType = "lights"
 
 var Factory = auto.FactoryFunc(func(services auto.Services) service.Lifecycle {
 	logger := services.Logger.Named("lights")
-	impl := PirsTurnLightsOn(services.Node, logger)
+	impl := PirsTurnLightsOn(services, logger)
 	return autoToService(impl, logger)
 })
 
@@ -208,8 +208,8 @@ func (a *autoImpl) applyConfig(ctx context.Context, cfg config.Root) error {
 	logger = logger.With(zap.String("snmp.addr", cfg.Destination.Addr()))
 	applyDefaults(&cfg.Timing)
 
-	meterClient := meterpb.NewMeterApiClient(a.Node.ClientConn())
-	metadataClient := metadatapb.NewMetadataApiClient(a.Node.ClientConn())
+	meterClient := meterpb.NewMeterApiClient(a.ClientConn())
+	metadataClient := metadatapb.NewMetadataApiClient(a.ClientConn())
 
 	sendTime := cfg.Destination.SendTime
 	now := cfg.Now
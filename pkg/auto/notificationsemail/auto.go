@@ -168,7 +168,7 @@ func (a *autoImpl) applyConfig(ctx context.Context, cfg config.Root) error {
 	logger := a.Logger
 	logger = logger.With(zap.String("snmp.addr", cfg.Destination.Addr()))
 
-	alertClient := alertpb.NewAlertApiClient(a.Node.ClientConn())
+	alertClient := alertpb.NewAlertApiClient(a.ClientConn())
 
 	sendTime := cfg.Destination.SendTime
 	now := cfg.Now
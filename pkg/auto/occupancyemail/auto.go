@@ -42,7 +42,7 @@ func (a *autoImpl) applyConfig(ctx context.Context, cfg config.Root) error {
 	logger := a.Logger
 	logger = logger.With(zap.String("snmp.host", cfg.Destination.Host), zap.Int("snmp.port", cfg.Destination.Port))
 
-	ohClient := occupancysensorpb.NewOccupancySensorHistoryClient(a.Node.ClientConn())
+	ohClient := occupancysensorpb.NewOccupancySensorHistoryClient(a.ClientConn())
 	sendTime := cfg.Destination.SendTime
 	now := cfg.Now
 	if now == nil {
@@ -18,6 +18,7 @@ import (
 
 	"github.com/smart-core-os/sc-bos/pkg/auto"
 	"github.com/smart-core-os/sc-bos/pkg/auto/resetbrightness/config"
+	"github.com/smart-core-os/sc-bos/pkg/node/priority"
 	"github.com/smart-core-os/sc-bos/pkg/proto/lightpb"
 	"github.com/smart-core-os/sc-bos/pkg/task/service"
 	"github.com/smart-core-os/sc-bos/pkg/util/chans"
@@ -48,7 +49,7 @@ func (a *impl) applyConfig(ctx context.Context, cfg config.Root) error {
 
 	// pull brightness from all the devices, notify via readEvents
 	readEvents := make(chan func(rs *readState))
-	lightClient := lightpb.NewLightApiClient(a.Node.ClientConn())
+	lightClient := lightpb.NewLightApiClient(a.ClientConn())
 	for _, device := range cfg.Devices {
 		grp.Go(func() error {
 			return pull.Changes(ctx, brightnessPuller{
@@ -141,12 +142,14 @@ func (a *impl) applyConfig(ctx context.Context, cfg config.Root) error {
 				}
 			case <-timer.C:
 				var errs error
+				// write at the auto level, so manual overrides take precedence until they expire
+				writeCtx := priority.NewOutgoingContext(ctx, priority.Write{Level: priority.Auto, Owner: cfg.Name})
 				for _, device := range cfg.Devices {
 					req := &lightpb.UpdateBrightnessRequest{
 						Name:       device,
 						Brightness: &lightpb.Brightness{LevelPercent: cfg.ResetState},
 					}
-					_, err := lightClient.UpdateBrightness(ctx, req)
+					_, err := lightClient.UpdateBrightness(writeCtx, req)
 					if err != nil {
 						errs = multierr.Append(errs, fmt.Errorf("update %q: %w", device, err))
 					}
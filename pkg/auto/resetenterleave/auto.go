@@ -44,7 +44,7 @@ func (a *Auto) applyConfig(ctx context.Context, cfg config.Root) error {
 		return nil
 	}
 
-	elClient := enterleavesensorpb.NewEnterLeaveSensorApiClient(a.services.Node.ClientConn())
+	elClient := enterleavesensorpb.NewEnterLeaveSensorApiClient(a.services.ClientConn())
 
 	sched := cfg.Schedule
 	if sched == nil {
@@ -173,7 +173,7 @@ func (a *impl) newCheck(ctx context.Context, device *devicespb.Device, cfg confi
 	g, ctx := errgroup.WithContext(ctx)
 	// set up the value watcher
 	changes := make(chan anytrait.Value)
-	fetcher := r.Fetcher(a.Node.ClientConn(), anytrait.ReadRequest{
+	fetcher := r.Fetcher(a.ClientConn(), anytrait.ReadRequest{
 		Name:     device.GetName(),
 		ReadMask: readMask,
 	})
@@ -55,7 +55,7 @@ func (e *udmiAuto) applyConfig(ctx context.Context, cfg config.Root) error {
 		return fmt.Errorf("invalid stateQos %d: must be 0, 1, or 2", cfg.StateQoS)
 	}
 
-	udmiClient := udmipb.NewUdmiServiceClient(e.services.Node.ClientConn())
+	udmiClient := udmipb.NewUdmiServiceClient(e.services.ClientConn())
 
 	client, err := newMqttClient(cfg)
 	if err != nil {
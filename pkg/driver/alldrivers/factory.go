@@ -7,10 +7,12 @@ import (
 	"github.com/smart-core-os/sc-bos/pkg/driver/gallagher"
 	"github.com/smart-core-os/sc-bos/pkg/driver/helvarnet"
 	"github.com/smart-core-os/sc-bos/pkg/driver/hikcentral"
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx"
 	"github.com/smart-core-os/sc-bos/pkg/driver/mock"
 	"github.com/smart-core-os/sc-bos/pkg/driver/opcua"
 	"github.com/smart-core-os/sc-bos/pkg/driver/pestsense"
 	"github.com/smart-core-os/sc-bos/pkg/driver/proxy"
+	"github.com/smart-core-os/sc-bos/pkg/driver/replay"
 	seWiserKnx "github.com/smart-core-os/sc-bos/pkg/driver/se/wiser-knx"
 	shellyTrv "github.com/smart-core-os/sc-bos/pkg/driver/shelly/trv"
 	"github.com/smart-core-os/sc-bos/pkg/driver/sim"
@@ -26,10 +28,12 @@ func Factories() map[string]driver.Factory {
 		gallagher.DriverName:  gallagher.Factory,
 		helvarnet.DriverName:  helvarnet.Factory,
 		hikcentral.DriverName: hikcentral.Factory,
+		knx.DriverName:        knx.Factory,
 		mock.DriverName:       mock.Factory,
 		opcua.DriverName:      opcua.Factory,
 		pestsense.DriverName:  pestsense.Factory,
 		proxy.DriverName:      proxy.Factory,
+		replay.DriverName:     replay.Factory,
 		seWiserKnx.DriverName: seWiserKnx.Factory,
 		sim.DriverName:        sim.Factory,
 		shellyTrv.DriverName:  shellyTrv.Factory,
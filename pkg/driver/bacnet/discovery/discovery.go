@@ -0,0 +1,306 @@
+// Package discovery finds BACnet devices and their objects, suggesting driver config for them.
+// Discovery is exposed via rpc.BacnetDiscoveryService, see Server.
+package discovery
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/smart-core-os/gobacnet/property"
+	bactypes "github.com/smart-core-os/gobacnet/types"
+	"github.com/smart-core-os/gobacnet/types/objecttype"
+	"github.com/smart-core-os/sc-bos/pkg/driver/bacnet/adapt"
+	"github.com/smart-core-os/sc-bos/pkg/driver/bacnet/comm"
+	"github.com/smart-core-os/sc-bos/pkg/driver/bacnet/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/bacnet/rpc"
+)
+
+const (
+	// how many devices have their objects read at the same time
+	readConcurrency = 4
+	// how long reading the objects of a single device can take
+	deviceReadTimeout = 2 * time.Minute
+	// how many objects to read units for in each ReadPropertyMultiple request
+	unitsChunkSize = 5
+	// the bit in Protocol_Services_Supported for SubscribeCOV
+	serviceSubscribeCOV = int(bactypes.ServiceConfirmedSubscribeCOV)
+)
+
+// Client is the part of gobacnet.Client used for discovery.
+type Client interface {
+	WhoIs(ctx context.Context, low, high int) ([]bactypes.Device, error)
+	Objects(ctx context.Context, dev bactypes.Device) (bactypes.Device, error)
+	ReadProperties(ctx context.Context, dev bactypes.Device, rp bactypes.ReadMultipleProperty) (bactypes.ReadMultipleProperty, error)
+}
+
+type idRange struct {
+	min, max int
+}
+
+// whoIsRanges returns the device id ranges to send WhoIs requests for, split into chunks.
+func whoIsRanges(req *rpc.StartDiscoveryRequest, cfg *config.Discovery) []idRange {
+	var ranges []idRange
+	for _, r := range req.GetRanges() {
+		ranges = append(ranges, idRange{min: int(r.Min), max: int(min(r.Max, bactypes.MaxInstance))})
+	}
+	if len(ranges) == 0 {
+		r := idRange{min: 0, max: bactypes.MaxInstance}
+		if cfg != nil {
+			r.min = cfg.Min
+			if cfg.Max > 0 {
+				r.max = cfg.Max
+			}
+		}
+		ranges = append(ranges, r)
+	}
+	chunk := int(req.GetChunkSize())
+	if chunk == 0 && cfg != nil {
+		chunk = cfg.Chunk
+	}
+
+	var chunks []idRange
+	for _, r := range ranges {
+		if r.max < r.min {
+			continue
+		}
+		if chunk <= 0 {
+			chunks = append(chunks, r)
+			continue
+		}
+		for low := r.min; low <= r.max; low += chunk {
+			chunks = append(chunks, idRange{min: low, max: min(low+chunk-1, r.max)})
+		}
+	}
+	return chunks
+}
+
+// discover runs job, sending WhoIs requests then reading the objects of each device found.
+// Results are recorded in the job as they are found.
+func (s *Server) discover(ctx context.Context, j *job, req *rpc.StartDiscoveryRequest) error {
+	var chunkDelay time.Duration
+	if s.cfg.Discovery != nil {
+		chunkDelay = s.cfg.Discovery.ChunkDelay.Duration
+	}
+	configured := make(map[bactypes.ObjectInstance]string)
+	for _, d := range s.cfg.Devices {
+		if d.ID != 0 {
+			configured[d.ID] = adapt.DeviceName(d)
+		}
+	}
+
+	var toRead []*device
+	for i, r := range whoIsRanges(req, s.cfg.Discovery) {
+		if i > 0 && chunkDelay > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(chunkDelay):
+			}
+		}
+		found, err := s.client.WhoIs(ctx, r.min, r.max)
+		if err != nil {
+			return fmt.Errorf("WhoIs %d-%d: %w", r.min, r.max, err)
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		for _, bacDevice := range found {
+			name, isConfigured := configured[bacDevice.ID.Instance]
+			d, added := s.addDevice(j, bacDevice, name)
+			if added && (!isConfigured || req.GetIncludeConfigured()) {
+				toRead = append(toRead, d)
+			}
+		}
+	}
+
+	var g errgroup.Group
+	g.SetLimit(readConcurrency)
+	for _, d := range toRead {
+		g.Go(func() error {
+			ctx, cancel := context.WithTimeout(ctx, deviceReadTimeout)
+			defer cancel()
+			objects, err := s.readDevice(ctx, d)
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			j.proto.DevicesRead++
+			if err != nil {
+				d.proto.Error = err.Error()
+			}
+			d.proto.Objects = objects
+			s.suggest(j, d)
+			return nil
+		})
+	}
+	_ = g.Wait() // errors are recorded against each device
+	return ctx.Err()
+}
+
+// addDevice records a device found by WhoIs in the job, if it hasn't already been found.
+func (s *Server) addDevice(j *job, bacDevice bactypes.Device, configuredName string) (*device, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := j.devices[bacDevice.ID.Instance]; ok {
+		return nil, false
+	}
+	d := &device{
+		bac: bacDevice,
+		proto: &rpc.DiscoveredDevice{
+			DeviceId:       uint32(bacDevice.ID.Instance),
+			Network:        uint32(bacDevice.Addr.Net),
+			MacAddress:     dotNotation(bacDevice.Addr.Adr),
+			VendorId:       bacDevice.Vendor,
+			ConfiguredName: configuredName,
+		},
+	}
+	if addr, err := bacDevice.Addr.UDPAddr(); err == nil {
+		d.proto.Address = addr.String()
+	}
+	j.devices[bacDevice.ID.Instance] = d
+	j.proto.Devices = append(j.proto.Devices, d.proto)
+	j.proto.DevicesFound++
+	return d, true
+}
+
+// readDevice reads information about d and its objects.
+// Any information that could be read is recorded in d or returned, even if an error is returned.
+func (s *Server) readDevice(ctx context.Context, d *device) ([]*rpc.DiscoveredObject, error) {
+	deviceObj := bactypes.Object{ID: d.bac.ID, Properties: []bactypes.Property{
+		{ID: property.ObjectName, ArrayIndex: bactypes.ArrayAll},
+		{ID: property.VendorName, ArrayIndex: bactypes.ArrayAll},
+		{ID: property.ProtocolServicesSupported, ArrayIndex: bactypes.ArrayAll},
+	}}
+	var covSupported bool
+	res, err := s.client.ReadProperties(ctx, d.bac, bactypes.ReadMultipleProperty{Objects: []bactypes.Object{deviceObj}})
+	if err == nil && len(res.Objects) == 1 {
+		props := res.Objects[0].Properties
+		s.mu.Lock()
+		for _, p := range props {
+			switch p.ID {
+			case property.ObjectName:
+				d.proto.ObjectName, _ = comm.StringValue(p.Data)
+			case property.VendorName:
+				d.proto.VendorName, _ = comm.StringValue(p.Data)
+			case property.ProtocolServicesSupported:
+				if bits, err := comm.BitStringValue(p.Data); err == nil {
+					covSupported = bits.At(serviceSubscribeCOV)
+					d.proto.CovSupported = covSupported
+				}
+			}
+		}
+		s.mu.Unlock()
+	}
+
+	withObjects, err := s.client.Objects(ctx, d.bac)
+	if err != nil {
+		return nil, fmt.Errorf("read objects: %w", err)
+	}
+	bacObjects := withObjects.ObjectSlice()
+	slices.SortFunc(bacObjects, func(a, b bactypes.Object) int {
+		return cmp.Or(cmp.Compare(a.ID.Type, b.ID.Type), cmp.Compare(a.ID.Instance, b.ID.Instance))
+	})
+	var objects []*rpc.DiscoveredObject
+	var analog []*rpc.DiscoveredObject
+	for _, o := range bacObjects {
+		if o.ID.Type == objecttype.Device {
+			continue
+		}
+		obj := &rpc.DiscoveredObject{
+			ObjectIdentifier: adapt.ObjectIDToProto(o.ID),
+			ObjectType:       o.ID.Type.String(),
+			ObjectName:       o.Name,
+			Description:      o.Description,
+			CovSupported:     covSupported && covObjectType(o.ID.Type),
+		}
+		objects = append(objects, obj)
+		if hasUnits(o.ID.Type) {
+			analog = append(analog, obj)
+		}
+	}
+	return objects, s.readUnits(ctx, d.bac, analog)
+}
+
+// readUnits reads the Units property of objects.
+// Objects whose units can't be read are left without units.
+func (s *Server) readUnits(ctx context.Context, bacDevice bactypes.Device, objects []*rpc.DiscoveredObject) error {
+	for i := 0; i < len(objects); i += unitsChunkSize {
+		chunk := objects[i:min(i+unitsChunkSize, len(objects))]
+		req := bactypes.ReadMultipleProperty{}
+		for _, o := range chunk {
+			req.Objects = append(req.Objects, bactypes.Object{
+				ID:         adapt.ObjectIDFromProto(o.ObjectIdentifier),
+				Properties: []bactypes.Property{{ID: property.Units, ArrayIndex: bactypes.ArrayAll}},
+			})
+		}
+		res, err := s.client.ReadProperties(ctx, bacDevice, req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("read units: %w", err)
+			}
+			continue // units are nice to have, one bad object shouldn't stop the rest
+		}
+		for j, o := range res.Objects {
+			if j >= len(chunk) || len(o.Properties) == 0 {
+				break
+			}
+			if u, err := comm.IntValue(o.Properties[0].Data); err == nil {
+				chunk[j].Units = comm.EngineeringUnits(u).String()
+			}
+		}
+	}
+	return nil
+}
+
+// hasUnits returns whether objects of type t have a Units property.
+func hasUnits(t bactypes.ObjectType) bool {
+	switch t {
+	case objecttype.AnalogInput, objecttype.AnalogOutput, objecttype.AnalogValue,
+		objecttype.Accumulator, objecttype.PulseConverter, objecttype.Loop,
+		objecttype.IntegerValue, objecttype.LargeAnalogValue, objecttype.PositiveIntegerValue:
+		return true
+	}
+	return false
+}
+
+// covObjectType returns whether objects of type t support COV notifications, see BACnet clause 13.1.
+func covObjectType(t bactypes.ObjectType) bool {
+	switch t {
+	case objecttype.AnalogInput, objecttype.AnalogOutput, objecttype.AnalogValue,
+		objecttype.BinaryInput, objecttype.BinaryOutput, objecttype.BinaryValue,
+		objecttype.MultiStateInput, objecttype.MultiStateOutput, objecttype.MultiStateValue,
+		objecttype.Loop, objecttype.LifeSafetyPoint, objecttype.LifeSafetyZone,
+		objecttype.PulseConverter, objecttype.AccessDoor:
+		return true
+	}
+	return false
+}
+
+// comm returns config for communicating with d, based on how it replied to WhoIs.
+func (d *device) comm() *config.Comm {
+	c := &config.Comm{}
+	if addr, err := netip.ParseAddrPort(d.proto.Address); err == nil {
+		c.IP = &addr
+	}
+	if d.proto.Network != 0 {
+		c.Destination = &config.Destination{
+			Network: uint16(d.proto.Network),
+			Address: config.DestinationAddress(d.proto.MacAddress),
+		}
+	}
+	return c
+}
+
+func dotNotation(octets []byte) string {
+	parts := make([]string, len(octets))
+	for i, o := range octets {
+		parts[i] = strconv.Itoa(int(o))
+	}
+	return strings.Join(parts, ".")
+}
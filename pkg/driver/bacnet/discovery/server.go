@@ -0,0 +1,262 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"sync"
+
+	"github.com/pborman/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bactypes "github.com/smart-core-os/gobacnet/types"
+	"github.com/smart-core-os/sc-bos/pkg/driver/bacnet/adapt"
+	"github.com/smart-core-os/sc-bos/pkg/driver/bacnet/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/bacnet/rpc"
+)
+
+// Server implements rpc.BacnetDiscoveryServiceServer.
+// One discovery job runs at a time, the results of the most recent job are kept until the Server is discarded.
+type Server struct {
+	rpc.UnimplementedBacnetDiscoveryServiceServer
+
+	ctx    context.Context
+	client Client
+	cfg    config.Root
+	logger *zap.Logger
+
+	mu      sync.Mutex
+	job     *job
+	stopJob context.CancelFunc
+}
+
+// NewServer returns a Server that discovers devices using client.
+// The driver config cfg provides defaults for discovery and says which devices are already configured.
+// Any running job is cancelled when ctx is done.
+func NewServer(ctx context.Context, client Client, cfg config.Root, logger *zap.Logger) *Server {
+	return &Server{ctx: ctx, client: client, cfg: cfg, logger: logger}
+}
+
+type job struct {
+	proto   *rpc.DiscoveryJob
+	devices map[bactypes.ObjectInstance]*device
+	// config device names that have been used, configured or proposed
+	names map[string]bool
+	// suggestion id -> the device it belongs to
+	suggestions map[string]*device
+}
+
+type device struct {
+	bac   bactypes.Device
+	proto *rpc.DiscoveredDevice
+	// the name the device has, or would have, in the driver config
+	name   string
+	traits map[string]config.RawTrait
+}
+
+func (s *Server) StartDiscovery(_ context.Context, req *rpc.StartDiscoveryRequest) (*rpc.DiscoveryJob, error) {
+	for _, r := range req.GetRanges() {
+		if r.Min > r.Max {
+			return nil, status.Errorf(codes.InvalidArgument, "range min %d is greater than max %d", r.Min, r.Max)
+		}
+	}
+	if err := s.ctx.Err(); err != nil {
+		return nil, status.Error(codes.Unavailable, "driver is not running")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopJob != nil {
+		s.stopJob()
+	}
+	j := &job{
+		proto: &rpc.DiscoveryJob{
+			Id:        uuid.New(),
+			State:     rpc.DiscoveryJob_RUNNING,
+			StartTime: timestamppb.Now(),
+		},
+		devices:     make(map[bactypes.ObjectInstance]*device),
+		names:       make(map[string]bool),
+		suggestions: make(map[string]*device),
+	}
+	for _, d := range s.cfg.Devices {
+		j.names[adapt.DeviceName(d)] = true
+	}
+	ctx, stop := context.WithCancel(s.ctx)
+	s.job, s.stopJob = j, stop
+	go s.run(ctx, j, req)
+	return proto.Clone(j.proto).(*rpc.DiscoveryJob), nil
+}
+
+func (s *Server) run(ctx context.Context, j *job, req *rpc.StartDiscoveryRequest) {
+	logger := s.logger.With(zap.String("job", j.proto.Id))
+	logger.Info("discovery started")
+	err := s.discover(ctx, j, req)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	j.proto.EndTime = timestamppb.Now()
+	switch {
+	case errors.Is(err, context.Canceled):
+		j.proto.State = rpc.DiscoveryJob_CANCELLED
+		logger.Info("discovery cancelled")
+	case err != nil:
+		j.proto.State = rpc.DiscoveryJob_FAILED
+		j.proto.Error = err.Error()
+		logger.Warn("discovery failed", zap.Error(err))
+	default:
+		j.proto.State = rpc.DiscoveryJob_COMPLETE
+		logger.Info("discovery complete", zap.Int32("devices", j.proto.DevicesFound))
+	}
+}
+
+func (s *Server) GetDiscovery(_ context.Context, _ *rpc.GetDiscoveryRequest) (*rpc.DiscoveryJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.job == nil {
+		return nil, status.Error(codes.NotFound, "no discovery job has been started")
+	}
+	return proto.Clone(s.job.proto).(*rpc.DiscoveryJob), nil
+}
+
+func (s *Server) AcceptDiscovery(_ context.Context, req *rpc.AcceptDiscoveryRequest) (*rpc.AcceptDiscoveryResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	j := s.job
+	if j == nil || j.proto.Id != req.GetJobId() {
+		return nil, status.Errorf(codes.NotFound, "job %q is not the most recent discovery job", req.GetJobId())
+	}
+
+	accept := make(map[*device][]string)
+	for _, id := range req.GetDeviceIds() {
+		d, ok := j.devices[bactypes.ObjectInstance(id)]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "device %d was not discovered", id)
+		}
+		if _, ok := accept[d]; !ok {
+			accept[d] = nil
+		}
+	}
+	for _, id := range req.GetSuggestionIds() {
+		d, ok := j.suggestions[id]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown suggestion %q", id)
+		}
+		accept[d] = append(accept[d], id)
+	}
+	if req.GetAll() {
+		for _, d := range j.devices {
+			accept[d] = slices.Collect(maps.Keys(d.traits))
+		}
+	}
+
+	cfg := s.cfg
+	cfg.Devices = slices.Clone(cfg.Devices)
+	cfg.Traits = slices.Clone(cfg.Traits)
+	res := &rpc.AcceptDiscoveryResponse{}
+	// iterate in discovery order so the config is predictable
+	for _, dp := range j.proto.Devices {
+		d := j.devices[bactypes.ObjectInstance(dp.DeviceId)]
+		ids, ok := accept[d]
+		if !ok {
+			continue
+		}
+		i := slices.IndexFunc(cfg.Devices, func(cd config.Device) bool { return cd.ID == d.bac.ID.Instance })
+		if i < 0 {
+			cfg.Devices = append(cfg.Devices, config.Device{
+				Name:  d.name,
+				Title: d.proto.ObjectName,
+				ID:    d.bac.ID.Instance,
+				Comm:  d.comm(),
+			})
+			i = len(cfg.Devices) - 1
+			res.DevicesAdded++
+		}
+		cd := &cfg.Devices[i]
+		cd.Objects = slices.Clone(cd.Objects)
+
+		slices.Sort(ids)
+		for _, id := range ids {
+			t := d.traits[id]
+			if slices.ContainsFunc(cfg.Traits, func(ct config.RawTrait) bool { return ct.Name == t.Name && ct.Kind == t.Kind }) {
+				continue
+			}
+			cfg.Traits = append(cfg.Traits, t)
+			res.TraitsAdded++
+			// traits can only refer to objects the driver knows about
+			for _, sg := range d.proto.Suggestions {
+				if sg.Id != id {
+					continue
+				}
+				for _, oid := range sg.Objects {
+					id := config.ObjectID(adapt.ObjectIDFromProto(oid))
+					if slices.ContainsFunc(cd.Objects, func(co config.Object) bool { return co.ID == id }) {
+						continue
+					}
+					cd.Objects = append(cd.Objects, config.Object{ID: id, Title: objectName(d.proto, oid)})
+				}
+			}
+		}
+	}
+
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode config: %v", err)
+	}
+	res.Config = string(data)
+	return res, nil
+}
+
+// suggest names d and suggests traits for its objects, recording them in j.
+// s.mu must be held.
+func (s *Server) suggest(j *job, d *device) {
+	d.name = d.proto.ConfiguredName
+	if d.name == "" {
+		d.name = deviceNameSegment(d.proto)
+		if j.names[d.name] {
+			d.name = fmt.Sprintf("%s-%d", d.name, d.proto.DeviceId)
+		}
+		j.names[d.name] = true
+	}
+
+	d.traits = make(map[string]config.RawTrait)
+	scName := s.cfg.DeviceNamePrefix + d.name
+	for _, sg := range suggestTraits(d.proto.Objects) {
+		id := fmt.Sprintf("%d/%s", d.proto.DeviceId, sg.suffix)
+		if _, ok := d.traits[id]; ok {
+			id = fmt.Sprintf("%s-%d", id, len(d.traits))
+		}
+		t := sg.traitConfig(path.Join(scName, path.Base(id)), d.bac.ID.Instance)
+		d.traits[id] = t
+		j.suggestions[id] = d
+
+		p := &rpc.TraitSuggestion{
+			Id:     id,
+			Trait:  string(t.Kind),
+			Name:   t.Name,
+			Reason: sg.reason,
+			Config: string(t.Raw),
+		}
+		for _, o := range sg.objects() {
+			p.Objects = append(p.Objects, o.ObjectIdentifier)
+		}
+		d.proto.Suggestions = append(d.proto.Suggestions, p)
+	}
+}
+
+func objectName(d *rpc.DiscoveredDevice, id *rpc.ObjectIdentifier) string {
+	for _, o := range d.Objects {
+		if proto.Equal(o.ObjectIdentifier, id) {
+			return o.ObjectName
+		}
+	}
+	return ""
+}
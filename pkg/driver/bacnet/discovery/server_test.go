@@ -0,0 +1,379 @@
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/smart-core-os/gobacnet/property"
+	bactypes "github.com/smart-core-os/gobacnet/types"
+	"github.com/smart-core-os/gobacnet/types/objecttype"
+	"github.com/smart-core-os/sc-bos/pkg/driver/bacnet/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/bacnet/rpc"
+	"github.com/smart-core-os/sc-bos/pkg/proto/meterpb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+)
+
+func TestServer(t *testing.T) {
+	client := &fakeClient{devices: map[bactypes.ObjectInstance]*fakeDevice{}}
+	client.add(1001, "AHU 1", net.IPv4(10, 0, 0, 1), true,
+		fakeObject{id: oid(objecttype.AnalogInput, 1), name: "Supply Temp", units: 62},
+		fakeObject{id: oid(objecttype.AnalogValue, 2), name: "Zone SP", units: 62},
+		fakeObject{id: oid(objecttype.AnalogInput, 3), name: "Zone RH", units: 29},
+		fakeObject{id: oid(objecttype.BinaryOutput, 1), name: "Fan Run", units: -1},
+		fakeObject{id: oid(objecttype.BinaryInput, 2), name: "Fan Status", units: -1},
+	)
+	client.add(2001, "Meter", net.IPv4(10, 0, 0, 2), false,
+		fakeObject{id: oid(objecttype.AnalogInput, 1), name: "Energy", units: 19},
+	)
+	client.add(3001, "Existing", net.IPv4(10, 0, 0, 3), false,
+		fakeObject{id: oid(objecttype.AnalogInput, 1), name: "Energy", units: 19},
+	)
+	client.add(500_000, "Out of range", net.IPv4(10, 0, 0, 4), false)
+
+	cfg := config.Defaults()
+	cfg.Name = "bacnet"
+	cfg.Type = "bacnet"
+	cfg.Devices = []config.Device{{Name: "existing", ID: 3001}}
+	s := NewServer(t.Context(), client, cfg, zap.NewNop())
+
+	_, err := s.GetDiscovery(t.Context(), &rpc.GetDiscoveryRequest{})
+	if err == nil {
+		t.Fatalf("GetDiscovery before start: expected error")
+	}
+
+	started, err := s.StartDiscovery(t.Context(), &rpc.StartDiscoveryRequest{
+		Ranges:    []*rpc.DeviceIdRange{{Min: 0, Max: 10_000}},
+		ChunkSize: 2000,
+	})
+	if err != nil {
+		t.Fatalf("StartDiscovery: %v", err)
+	}
+	if started.State != rpc.DiscoveryJob_RUNNING {
+		t.Fatalf("started state: want RUNNING, got %v", started.State)
+	}
+	job := waitForJob(t, s)
+	if job.State != rpc.DiscoveryJob_COMPLETE {
+		t.Fatalf("state: want COMPLETE, got %v (%s)", job.State, job.Error)
+	}
+	if want := [][2]int{{0, 1999}, {2000, 3999}, {4000, 5999}, {6000, 7999}, {8000, 9999}, {10000, 10000}}; !equalRanges(client.whoIs, want) {
+		t.Fatalf("WhoIs ranges: want %v, got %v", want, client.whoIs)
+	}
+	if job.DevicesFound != 3 || job.DevicesRead != 2 {
+		t.Fatalf("want 3 found, 2 read, got %d found, %d read", job.DevicesFound, job.DevicesRead)
+	}
+
+	ahu := findDevice(t, job, 1001)
+	if ahu.ObjectName != "AHU 1" || ahu.Address != "10.0.0.1:47808" || !ahu.CovSupported {
+		t.Fatalf("unexpected AHU device %v", ahu)
+	}
+	if len(ahu.Objects) != 5 {
+		t.Fatalf("want 5 AHU objects, got %d", len(ahu.Objects))
+	}
+	if o := ahu.Objects[0]; o.ObjectType != "AnalogInput" || o.Units != "°C" || !o.CovSupported {
+		t.Fatalf("unexpected first object %v", o)
+	}
+	suggested := map[string]*rpc.TraitSuggestion{}
+	for _, sg := range ahu.Suggestions {
+		suggested[sg.Trait] = sg
+	}
+	if len(suggested) != 2 {
+		t.Fatalf("want AirTemperature and OnOff suggestions, got %v", ahu.Suggestions)
+	}
+	airTemp := suggested[string(trait.AirTemperature)]
+	if airTemp == nil || airTemp.Name != "bacnet/device/AHU-1/airTemperature" || len(airTemp.Objects) != 3 {
+		t.Fatalf("unexpected AirTemperature suggestion %v", airTemp)
+	}
+	var airTempCfg struct {
+		SetPoint           *config.ValueSource `json:"setPoint"`
+		AmbientTemperature *config.ValueSource `json:"ambientTemperature"`
+		AmbientHumidity    *config.ValueSource `json:"ambientHumidity"`
+	}
+	if err := json.Unmarshal([]byte(airTemp.Config), &airTempCfg); err != nil {
+		t.Fatalf("AirTemperature config: %v", err)
+	}
+	if got := airTempCfg.SetPoint.String(); got != "1001:AnalogValue:2" {
+		t.Fatalf("setPoint: want 1001:AnalogValue:2, got %s", got)
+	}
+	if got := airTempCfg.AmbientTemperature.String(); got != "1001:AnalogInput:1" {
+		t.Fatalf("ambientTemperature: want 1001:AnalogInput:1, got %s", got)
+	}
+	if airTempCfg.AmbientHumidity == nil {
+		t.Fatalf("ambientHumidity not suggested")
+	}
+	if onOff := suggested[string(trait.OnOff)]; onOff == nil || onOff.Objects[0].Type != uint32(objecttype.BinaryOutput) {
+		t.Fatalf("unexpected OnOff suggestion %v", onOff)
+	}
+	if existing := findDevice(t, job, 3001); existing.ConfiguredName != "existing" || len(existing.Objects) != 0 {
+		t.Fatalf("configured devices shouldn't be read, got %v", existing)
+	}
+
+	t.Run("AcceptDiscovery", func(t *testing.T) {
+		meter := findDevice(t, job, 2001)
+		res, err := s.AcceptDiscovery(t.Context(), &rpc.AcceptDiscoveryRequest{
+			JobId:         job.Id,
+			DeviceIds:     []uint32{1001},
+			SuggestionIds: []string{meter.Suggestions[0].Id},
+		})
+		if err != nil {
+			t.Fatalf("AcceptDiscovery: %v", err)
+		}
+		if res.DevicesAdded != 2 || res.TraitsAdded != 1 {
+			t.Fatalf("want 2 devices and 1 trait added, got %d and %d", res.DevicesAdded, res.TraitsAdded)
+		}
+		got, err := config.ReadBytes([]byte(res.Config))
+		if err != nil {
+			t.Fatalf("accepted config: %v", err)
+		}
+		if len(got.Devices) != 3 {
+			t.Fatalf("want 3 devices, got %d", len(got.Devices))
+		}
+		ahuCfg, meterCfg := got.Devices[1], got.Devices[2]
+		if ahuCfg.Name != "AHU-1" || ahuCfg.ID != 1001 || ahuCfg.Comm.IP.String() != "10.0.0.1:47808" || len(ahuCfg.Objects) != 0 {
+			t.Fatalf("unexpected AHU config %+v", ahuCfg)
+		}
+		if meterCfg.Name != "Meter" || len(meterCfg.Objects) != 1 || meterCfg.Objects[0].Title != "Energy" {
+			t.Fatalf("unexpected meter config %+v", meterCfg)
+		}
+		if len(got.Traits) != 1 || got.Traits[0].Kind != meterpb.TraitName || got.Traits[0].Name != "bacnet/device/Meter/Energy" {
+			t.Fatalf("unexpected traits %+v", got.Traits)
+		}
+	})
+
+	t.Run("AcceptDiscovery all", func(t *testing.T) {
+		res, err := s.AcceptDiscovery(t.Context(), &rpc.AcceptDiscoveryRequest{JobId: job.Id, All: true})
+		if err != nil {
+			t.Fatalf("AcceptDiscovery: %v", err)
+		}
+		if res.DevicesAdded != 2 || res.TraitsAdded != 3 {
+			t.Fatalf("want 2 devices and 3 traits added, got %d and %d", res.DevicesAdded, res.TraitsAdded)
+		}
+	})
+
+	t.Run("AcceptDiscovery unknown", func(t *testing.T) {
+		if _, err := s.AcceptDiscovery(t.Context(), &rpc.AcceptDiscoveryRequest{JobId: "other"}); err == nil {
+			t.Fatalf("unknown job: expected error")
+		}
+		if _, err := s.AcceptDiscovery(t.Context(), &rpc.AcceptDiscoveryRequest{JobId: job.Id, DeviceIds: []uint32{42}}); err == nil {
+			t.Fatalf("unknown device: expected error")
+		}
+	})
+}
+
+func TestServer_cancel(t *testing.T) {
+	client := &fakeClient{devices: map[bactypes.ObjectInstance]*fakeDevice{}, block: make(chan struct{})}
+	s := NewServer(t.Context(), client, config.Defaults(), zap.NewNop())
+	first, err := s.StartDiscovery(t.Context(), &rpc.StartDiscoveryRequest{})
+	if err != nil {
+		t.Fatalf("StartDiscovery: %v", err)
+	}
+	if _, err := s.StartDiscovery(t.Context(), &rpc.StartDiscoveryRequest{}); err != nil {
+		t.Fatalf("StartDiscovery: %v", err)
+	}
+	close(client.block)
+	second := waitForJob(t, s)
+	if second.Id == first.Id || second.State != rpc.DiscoveryJob_COMPLETE {
+		t.Fatalf("second job: want COMPLETE, got %v", second.State)
+	}
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	if len(client.whoIs) != 1 {
+		t.Fatalf("want only the second job to send WhoIs, got %v", client.whoIs)
+	}
+}
+
+func TestWhoIsRanges(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *rpc.StartDiscoveryRequest
+		cfg  *config.Discovery
+		want [][2]int
+	}{
+		{"defaults", &rpc.StartDiscoveryRequest{}, nil, [][2]int{{0, bactypes.MaxInstance}}},
+		{"config", &rpc.StartDiscoveryRequest{}, &config.Discovery{Min: 10, Max: 25, Chunk: 10}, [][2]int{{10, 19}, {20, 25}}},
+		{"request overrides config", &rpc.StartDiscoveryRequest{
+			Ranges:    []*rpc.DeviceIdRange{{Min: 1, Max: 3}, {Min: 100, Max: 100}},
+			ChunkSize: 2,
+		}, &config.Discovery{Min: 10, Max: 25, Chunk: 10}, [][2]int{{1, 2}, {3, 3}, {100, 100}}},
+		{"clamped to max instance", &rpc.StartDiscoveryRequest{
+			Ranges: []*rpc.DeviceIdRange{{Min: 5, Max: 0xFFFFFFFF}},
+		}, nil, [][2]int{{5, bactypes.MaxInstance}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got [][2]int
+			for _, r := range whoIsRanges(tt.req, tt.cfg) {
+				got = append(got, [2]int{r.min, r.max})
+			}
+			if !equalRanges(got, tt.want) {
+				t.Fatalf("want %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func waitForJob(t *testing.T, s *Server) *rpc.DiscoveryJob {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		job, err := s.GetDiscovery(t.Context(), &rpc.GetDiscoveryRequest{})
+		if err != nil {
+			t.Fatalf("GetDiscovery: %v", err)
+		}
+		if job.State != rpc.DiscoveryJob_RUNNING {
+			return job
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("discovery job didn't finish")
+	return nil
+}
+
+func findDevice(t *testing.T, job *rpc.DiscoveryJob, id uint32) *rpc.DiscoveredDevice {
+	t.Helper()
+	for _, d := range job.Devices {
+		if d.DeviceId == id {
+			return d
+		}
+	}
+	t.Fatalf("device %d not discovered", id)
+	return nil
+}
+
+func equalRanges(a, b [][2]int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func oid(t bactypes.ObjectType, i bactypes.ObjectInstance) bactypes.ObjectID {
+	return bactypes.ObjectID{Type: t, Instance: i}
+}
+
+type fakeObject struct {
+	id    bactypes.ObjectID
+	name  string
+	units int // -1 means the object has no units
+}
+
+type fakeDevice struct {
+	bactypes.Device
+	name    string
+	cov     bool
+	objects []fakeObject
+}
+
+// fakeClient implements Client with a fixed set of devices.
+type fakeClient struct {
+	devices map[bactypes.ObjectInstance]*fakeDevice
+	// if not nil, WhoIs blocks until this is closed or ctx is done
+	block chan struct{}
+
+	mu    sync.Mutex
+	whoIs [][2]int
+}
+
+func (c *fakeClient) add(id bactypes.ObjectInstance, name string, ip net.IP, cov bool, objects ...fakeObject) {
+	c.devices[id] = &fakeDevice{
+		Device: bactypes.Device{
+			ID:      bactypes.ObjectID{Type: objecttype.Device, Instance: id},
+			MaxApdu: 1476,
+			Addr:    bactypes.UDPToAddress(&net.UDPAddr{IP: ip, Port: 47808}),
+		},
+		name:    name,
+		cov:     cov,
+		objects: objects,
+	}
+}
+
+func (c *fakeClient) WhoIs(ctx context.Context, low, high int) ([]bactypes.Device, error) {
+	if c.block != nil {
+		select {
+		case <-c.block:
+		case <-ctx.Done():
+		}
+	}
+	if ctx.Err() != nil {
+		return nil, nil // gobacnet returns what it has found when ctx is done
+	}
+	c.mu.Lock()
+	c.whoIs = append(c.whoIs, [2]int{low, high})
+	c.mu.Unlock()
+	var res []bactypes.Device
+	for id, d := range c.devices {
+		if int(id) >= low && int(id) <= high {
+			res = append(res, d.Device)
+		}
+	}
+	return res, nil
+}
+
+func (c *fakeClient) Objects(_ context.Context, dev bactypes.Device) (bactypes.Device, error) {
+	d, ok := c.devices[dev.ID.Instance]
+	if !ok {
+		return dev, errors.New("unknown device")
+	}
+	dev.Objects = bactypes.ObjectMap{}
+	for _, o := range d.objects {
+		if dev.Objects[o.id.Type] == nil {
+			dev.Objects[o.id.Type] = map[bactypes.ObjectInstance]bactypes.Object{}
+		}
+		dev.Objects[o.id.Type][o.id.Instance] = bactypes.Object{ID: o.id, Name: o.name}
+	}
+	return dev, nil
+}
+
+func (c *fakeClient) ReadProperties(_ context.Context, dev bactypes.Device, rp bactypes.ReadMultipleProperty) (bactypes.ReadMultipleProperty, error) {
+	d, ok := c.devices[dev.ID.Instance]
+	if !ok {
+		return rp, errors.New("unknown device")
+	}
+	var res bactypes.ReadMultipleProperty
+	for _, ro := range rp.Objects {
+		obj := bactypes.Object{ID: ro.ID}
+		for _, p := range ro.Properties {
+			v, err := d.read(ro.ID, p.ID)
+			if err != nil {
+				return res, err
+			}
+			obj.Properties = append(obj.Properties, bactypes.Property{ID: p.ID, ArrayIndex: p.ArrayIndex, Data: v})
+		}
+		res.Objects = append(res.Objects, obj)
+	}
+	return res, nil
+}
+
+func (d *fakeDevice) read(id bactypes.ObjectID, prop property.ID) (any, error) {
+	if id == d.ID {
+		switch prop {
+		case property.ObjectName:
+			return d.name, nil
+		case property.VendorName:
+			return "Acme", nil
+		case property.ProtocolServicesSupported:
+			bits := bactypes.BitString{Bytes: make([]byte, 6)}
+			if d.cov {
+				bits.Bytes[0] = 1 << (7 - serviceSubscribeCOV)
+			}
+			return bits, nil
+		}
+	}
+	for _, o := range d.objects {
+		if o.id == id && prop == property.Units && o.units >= 0 {
+			return uint32(o.units), nil
+		}
+	}
+	return nil, errors.New("unknown property")
+}
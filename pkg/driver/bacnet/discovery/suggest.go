@@ -0,0 +1,200 @@
+package discovery
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	bactypes "github.com/smart-core-os/gobacnet/types"
+	"github.com/smart-core-os/gobacnet/types/objecttype"
+	"github.com/smart-core-os/sc-bos/pkg/driver/bacnet/adapt"
+	"github.com/smart-core-os/sc-bos/pkg/driver/bacnet/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/bacnet/rpc"
+	"github.com/smart-core-os/sc-bos/pkg/proto/meterpb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+)
+
+var (
+	setPointWords  = []string{"setpoint", "set point", "set-point", "stpt", "sp"}
+	onOffWords     = []string{"onoff", "on/off", "enable", "run", "start", "power"}
+	occupancyWords = []string{"occupancy", "occupied", "occ", "pir", "presence"}
+)
+
+// suggestion is a trait that could be implemented using some objects of a device.
+type suggestion struct {
+	kind trait.Name
+	// added to the device name to name the trait
+	suffix string
+	reason string
+	// trait config property -> the object used for it, like "ambientTemperature"
+	sources map[string]*rpc.DiscoveredObject
+	// other trait config properties, like "unit"
+	props map[string]any
+}
+
+// suggestTraits suggests traits that objects, all belonging to one device, could implement.
+// Suggestions are based on the object type, engineering units, and name of each object.
+func suggestTraits(objects []*rpc.DiscoveredObject) []suggestion {
+	var res []suggestion
+
+	airTemp := suggestion{kind: trait.AirTemperature, suffix: "airTemperature", sources: map[string]*rpc.DiscoveredObject{}}
+	var airTempReasons []string
+	for _, o := range objects {
+		typ := bactypes.ObjectType(o.ObjectIdentifier.GetType())
+		switch {
+		case isTemperatureUnit(o.Units) && isAnalog(typ):
+			prop := "ambientTemperature"
+			if nameContains(o, setPointWords...) {
+				prop = "setPoint"
+			}
+			if _, ok := airTemp.sources[prop]; !ok {
+				airTemp.sources[prop] = o
+				airTempReasons = append(airTempReasons, fmt.Sprintf("%s %s", prop, o.Units))
+			}
+		case isHumidityUnit(o.Units) && isAnalog(typ):
+			if _, ok := airTemp.sources["ambientHumidity"]; !ok {
+				airTemp.sources["ambientHumidity"] = o
+				airTempReasons = append(airTempReasons, fmt.Sprintf("ambientHumidity %s", o.Units))
+			}
+		case isEnergyUnit(o.Units):
+			res = append(res, suggestion{
+				kind:    meterpb.TraitName,
+				suffix:  objectNameSegment(o),
+				reason:  fmt.Sprintf("%s units", o.Units),
+				sources: map[string]*rpc.DiscoveredObject{"usage": o},
+				props:   map[string]any{"unit": o.Units},
+			})
+		case isBinary(typ) && typ != objecttype.BinaryInput && nameContains(o, onOffWords...):
+			res = append(res, suggestion{
+				kind:    trait.OnOff,
+				suffix:  objectNameSegment(o),
+				reason:  fmt.Sprintf("writable binary object named %q", o.ObjectName),
+				sources: map[string]*rpc.DiscoveredObject{"onOff": o},
+			})
+		case isBinary(typ) && nameContains(o, occupancyWords...):
+			res = append(res, suggestion{
+				kind:    trait.OccupancySensor,
+				suffix:  objectNameSegment(o),
+				reason:  fmt.Sprintf("binary object named %q", o.ObjectName),
+				sources: map[string]*rpc.DiscoveredObject{"occupancyStatus": o},
+			})
+		}
+	}
+	if _, ok := airTemp.sources["ambientHumidity"]; ok && len(airTemp.sources) == 1 {
+		// humidity on its own isn't worth an AirTemperature trait
+		delete(airTemp.sources, "ambientHumidity")
+	}
+	if len(airTemp.sources) > 0 {
+		airTemp.reason = strings.Join(airTempReasons, ", ")
+		res = append([]suggestion{airTemp}, res...)
+	}
+	return res
+}
+
+// traitConfig returns the driver trait config for s, named name, using objects of the device with the given id.
+func (s suggestion) traitConfig(name string, deviceID bactypes.ObjectInstance) config.RawTrait {
+	t := config.Trait{Name: name, Kind: s.kind}
+	cfg := map[string]any{"name": t.Name, "kind": t.Kind}
+	maps.Copy(cfg, s.props)
+	for prop, o := range s.sources {
+		cfg[prop] = config.ValueSource{
+			Device: config.NewDeviceRefID(deviceID),
+			Object: config.NewObjectRefID(config.ObjectID(adapt.ObjectIDFromProto(o.ObjectIdentifier))),
+		}
+	}
+	raw, err := json.Marshal(cfg)
+	if err != nil {
+		panic(err) // all the values are known to marshal
+	}
+	return config.RawTrait{Trait: t, Raw: raw}
+}
+
+// objects returns the objects used by s, sorted by object id.
+func (s suggestion) objects() []*rpc.DiscoveredObject {
+	objects := slices.Collect(maps.Values(s.sources))
+	slices.SortFunc(objects, func(a, b *rpc.DiscoveredObject) int {
+		if c := int(a.ObjectIdentifier.GetType()) - int(b.ObjectIdentifier.GetType()); c != 0 {
+			return c
+		}
+		return int(a.ObjectIdentifier.GetInstance()) - int(b.ObjectIdentifier.GetInstance())
+	})
+	return slices.Compact(objects)
+}
+
+// deviceNameSegment returns a name for d suitable for use as a config device name.
+func deviceNameSegment(d *rpc.DiscoveredDevice) string {
+	if name := nameSegment(d.ObjectName); name != "" {
+		return name
+	}
+	return strconv.Itoa(int(d.DeviceId))
+}
+
+// objectNameSegment returns a name for o suitable for use in a Smart Core name.
+func objectNameSegment(o *rpc.DiscoveredObject) string {
+	if name := nameSegment(o.ObjectName); name != "" {
+		return name
+	}
+	return config.ObjectID(adapt.ObjectIDFromProto(o.ObjectIdentifier)).String()
+}
+
+func nameSegment(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '/' || unicode.IsSpace(r) {
+			return '-'
+		}
+		return r
+	}, strings.TrimSpace(s))
+}
+
+func nameContains(o *rpc.DiscoveredObject, words ...string) bool {
+	name := strings.ToLower(o.ObjectName)
+	for _, w := range words {
+		if len(w) <= 3 {
+			// short words must be whole words, like "SP" in "Zone_SP"
+			for _, f := range strings.FieldsFunc(name, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }) {
+				if f == w {
+					return true
+				}
+			}
+			continue
+		}
+		if strings.Contains(name, w) {
+			return true
+		}
+	}
+	return false
+}
+
+func isAnalog(t bactypes.ObjectType) bool {
+	switch t {
+	case objecttype.AnalogInput, objecttype.AnalogOutput, objecttype.AnalogValue:
+		return true
+	}
+	return false
+}
+
+func isBinary(t bactypes.ObjectType) bool {
+	switch t {
+	case objecttype.BinaryInput, objecttype.BinaryOutput, objecttype.BinaryValue:
+		return true
+	}
+	return false
+}
+
+// The units below are those produced by comm.EngineeringUnits.String.
+
+func isTemperatureUnit(u string) bool {
+	return u == "°C"
+}
+
+func isHumidityUnit(u string) bool {
+	return u == "%RH"
+}
+
+func isEnergyUnit(u string) bool {
+	return u == "W·h" || u == "kW·h"
+}
@@ -21,8 +21,10 @@ import (
 	"github.com/smart-core-os/sc-bos/pkg/driver/bacnet/adapt"
 	"github.com/smart-core-os/sc-bos/pkg/driver/bacnet/config"
 	"github.com/smart-core-os/sc-bos/pkg/driver/bacnet/ctxerr"
+	"github.com/smart-core-os/sc-bos/pkg/driver/bacnet/discovery"
 	"github.com/smart-core-os/sc-bos/pkg/driver/bacnet/known"
 	"github.com/smart-core-os/sc-bos/pkg/driver/bacnet/merge"
+	"github.com/smart-core-os/sc-bos/pkg/driver/bacnet/rpc"
 	driverhealth "github.com/smart-core-os/sc-bos/pkg/driver/health"
 	"github.com/smart-core-os/sc-bos/pkg/node"
 	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
@@ -96,6 +98,10 @@ func (d *Driver) applyConfig(ctx context.Context, cfg config.Root) error {
 		return err
 	}
 
+	// discovery helps build the devices and traits config for this driver
+	discoveryServer := discovery.NewServer(ctx, d.client, cfg, d.logger.Named("discovery"))
+	rootAnnouncer.Announce(cfg.Name, node.HasServer(rpc.RegisterBacnetDiscoveryServiceServer, rpc.BacnetDiscoveryServiceServer(discoveryServer)))
+
 	d.controllerMu.Lock()
 	d.controllerHealths = make(map[string]*driverhealth.ControllerHealth)
 	d.controllerMu.Unlock()
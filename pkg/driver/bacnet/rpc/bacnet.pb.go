@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
 // 	protoc-gen-go v1.36.11
-// 	protoc        v7.34.0
+// 	protoc        v7.35.1
 // source: pkg/driver/bacnet/rpc/bacnet.proto
 
 package rpc
@@ -9,6 +9,7 @@ package rpc
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,6 +22,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DiscoveryJob_State int32
+
+const (
+	DiscoveryJob_STATE_UNSPECIFIED DiscoveryJob_State = 0
+	// Sending WhoIs requests or reading device objects.
+	DiscoveryJob_RUNNING  DiscoveryJob_State = 1
+	DiscoveryJob_COMPLETE DiscoveryJob_State = 2
+	DiscoveryJob_FAILED   DiscoveryJob_State = 3
+	// Cancelled by a newer job or the driver being reconfigured or stopped.
+	DiscoveryJob_CANCELLED DiscoveryJob_State = 4
+)
+
+// Enum value maps for DiscoveryJob_State.
+var (
+	DiscoveryJob_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "RUNNING",
+		2: "COMPLETE",
+		3: "FAILED",
+		4: "CANCELLED",
+	}
+	DiscoveryJob_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"RUNNING":           1,
+		"COMPLETE":          2,
+		"FAILED":            3,
+		"CANCELLED":         4,
+	}
+)
+
+func (x DiscoveryJob_State) Enum() *DiscoveryJob_State {
+	p := new(DiscoveryJob_State)
+	*p = x
+	return p
+}
+
+func (x DiscoveryJob_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiscoveryJob_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_driver_bacnet_rpc_bacnet_proto_enumTypes[0].Descriptor()
+}
+
+func (DiscoveryJob_State) Type() protoreflect.EnumType {
+	return &file_pkg_driver_bacnet_rpc_bacnet_proto_enumTypes[0]
+}
+
+func (x DiscoveryJob_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiscoveryJob_State.Descriptor instead.
+func (DiscoveryJob_State) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{18, 0}
+}
+
 type ObjectIdentifier struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Type          uint32                 `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
@@ -1012,39 +1070,29 @@ func (x *ListObjectsResponse) GetObjects() []*ObjectIdentifier {
 	return nil
 }
 
-// Represents a BACnet Date type.
-type PropertyValue_DateValue struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// 0 means absent, not year 0.
-	Year uint32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
-	// January = 1
-	// 13 means odd months.
-	// 14 means even months.
-	Month uint32 `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
-	// 32 means last day of month.
-	// 33 means odd days of month.
-	// 34 means even days of month.
-	DayOfMonth uint32 `protobuf:"varint,3,opt,name=day_of_month,json=dayOfMonth,proto3" json:"day_of_month,omitempty"`
-	// Monday = 1
-	DayOfWeek     uint32 `protobuf:"varint,4,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+// An inclusive range of device instance numbers.
+type DeviceIdRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           uint32                 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max           uint32                 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PropertyValue_DateValue) Reset() {
-	*x = PropertyValue_DateValue{}
+func (x *DeviceIdRange) Reset() {
+	*x = DeviceIdRange{}
 	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PropertyValue_DateValue) String() string {
+func (x *DeviceIdRange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PropertyValue_DateValue) ProtoMessage() {}
+func (*DeviceIdRange) ProtoMessage() {}
 
-func (x *PropertyValue_DateValue) ProtoReflect() protoreflect.Message {
+func (x *DeviceIdRange) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1056,65 +1104,54 @@ func (x *PropertyValue_DateValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PropertyValue_DateValue.ProtoReflect.Descriptor instead.
-func (*PropertyValue_DateValue) Descriptor() ([]byte, []int) {
-	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{2, 0}
-}
-
-func (x *PropertyValue_DateValue) GetYear() uint32 {
-	if x != nil {
-		return x.Year
-	}
-	return 0
-}
-
-func (x *PropertyValue_DateValue) GetMonth() uint32 {
-	if x != nil {
-		return x.Month
-	}
-	return 0
+// Deprecated: Use DeviceIdRange.ProtoReflect.Descriptor instead.
+func (*DeviceIdRange) Descriptor() ([]byte, []int) {
+	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *PropertyValue_DateValue) GetDayOfMonth() uint32 {
+func (x *DeviceIdRange) GetMin() uint32 {
 	if x != nil {
-		return x.DayOfMonth
+		return x.Min
 	}
 	return 0
 }
 
-func (x *PropertyValue_DateValue) GetDayOfWeek() uint32 {
+func (x *DeviceIdRange) GetMax() uint32 {
 	if x != nil {
-		return x.DayOfWeek
+		return x.Max
 	}
 	return 0
 }
 
-// Represents a BACnet Time type.
-type PropertyValue_TimeValue struct {
+type StartDiscoveryRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 24hr time
-	Hour               *uint32 `protobuf:"varint,1,opt,name=hour,proto3,oneof" json:"hour,omitempty"`
-	Minute             *uint32 `protobuf:"varint,2,opt,name=minute,proto3,oneof" json:"minute,omitempty"`
-	Second             *uint32 `protobuf:"varint,3,opt,name=second,proto3,oneof" json:"second,omitempty"`
-	HundredthsOfSecond *uint32 `protobuf:"varint,4,opt,name=hundredths_of_second,json=hundredthsOfSecond,proto3,oneof" json:"hundredths_of_second,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Device id ranges to send WhoIs requests for.
+	// Defaults to the range in the driver discovery config, or all device ids.
+	Ranges []*DeviceIdRange `protobuf:"bytes,2,rep,name=ranges,proto3" json:"ranges,omitempty"`
+	// How many device ids each WhoIs request covers.
+	// Defaults to the chunk in the driver discovery config, or the whole range.
+	ChunkSize uint32 `protobuf:"varint,3,opt,name=chunk_size,json=chunkSize,proto3" json:"chunk_size,omitempty"`
+	// Devices that are already configured are found but their objects are not read, unless this is true.
+	IncludeConfigured bool `protobuf:"varint,4,opt,name=include_configured,json=includeConfigured,proto3" json:"include_configured,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *PropertyValue_TimeValue) Reset() {
-	*x = PropertyValue_TimeValue{}
+func (x *StartDiscoveryRequest) Reset() {
+	*x = StartDiscoveryRequest{}
 	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PropertyValue_TimeValue) String() string {
+func (x *StartDiscoveryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PropertyValue_TimeValue) ProtoMessage() {}
+func (*StartDiscoveryRequest) ProtoMessage() {}
 
-func (x *PropertyValue_TimeValue) ProtoReflect() protoreflect.Message {
+func (x *StartDiscoveryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1126,61 +1163,60 @@ func (x *PropertyValue_TimeValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PropertyValue_TimeValue.ProtoReflect.Descriptor instead.
-func (*PropertyValue_TimeValue) Descriptor() ([]byte, []int) {
-	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{2, 1}
+// Deprecated: Use StartDiscoveryRequest.ProtoReflect.Descriptor instead.
+func (*StartDiscoveryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *PropertyValue_TimeValue) GetHour() uint32 {
-	if x != nil && x.Hour != nil {
-		return *x.Hour
+func (x *StartDiscoveryRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *PropertyValue_TimeValue) GetMinute() uint32 {
-	if x != nil && x.Minute != nil {
-		return *x.Minute
+func (x *StartDiscoveryRequest) GetRanges() []*DeviceIdRange {
+	if x != nil {
+		return x.Ranges
 	}
-	return 0
+	return nil
 }
 
-func (x *PropertyValue_TimeValue) GetSecond() uint32 {
-	if x != nil && x.Second != nil {
-		return *x.Second
+func (x *StartDiscoveryRequest) GetChunkSize() uint32 {
+	if x != nil {
+		return x.ChunkSize
 	}
 	return 0
 }
 
-func (x *PropertyValue_TimeValue) GetHundredthsOfSecond() uint32 {
-	if x != nil && x.HundredthsOfSecond != nil {
-		return *x.HundredthsOfSecond
+func (x *StartDiscoveryRequest) GetIncludeConfigured() bool {
+	if x != nil {
+		return x.IncludeConfigured
 	}
-	return 0
+	return false
 }
 
-type PropertyValue_BitStringValue struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Value              []byte                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	IgnoreTrailingBits uint32                 `protobuf:"varint,2,opt,name=ignore_trailing_bits,json=ignoreTrailingBits,proto3" json:"ignore_trailing_bits,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GetDiscoveryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PropertyValue_BitStringValue) Reset() {
-	*x = PropertyValue_BitStringValue{}
+func (x *GetDiscoveryRequest) Reset() {
+	*x = GetDiscoveryRequest{}
 	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PropertyValue_BitStringValue) String() string {
+func (x *GetDiscoveryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PropertyValue_BitStringValue) ProtoMessage() {}
+func (*GetDiscoveryRequest) ProtoMessage() {}
 
-func (x *PropertyValue_BitStringValue) ProtoReflect() protoreflect.Message {
+func (x *GetDiscoveryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1192,47 +1228,50 @@ func (x *PropertyValue_BitStringValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PropertyValue_BitStringValue.ProtoReflect.Descriptor instead.
-func (*PropertyValue_BitStringValue) Descriptor() ([]byte, []int) {
-	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{2, 2}
-}
-
-func (x *PropertyValue_BitStringValue) GetValue() []byte {
-	if x != nil {
-		return x.Value
-	}
-	return nil
+// Deprecated: Use GetDiscoveryRequest.ProtoReflect.Descriptor instead.
+func (*GetDiscoveryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *PropertyValue_BitStringValue) GetIgnoreTrailingBits() uint32 {
+func (x *GetDiscoveryRequest) GetName() string {
 	if x != nil {
-		return x.IgnoreTrailingBits
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type ReadPropertyMultipleRequest_ReadSpecification struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	ObjectIdentifier   *ObjectIdentifier      `protobuf:"bytes,1,opt,name=object_identifier,json=objectIdentifier,proto3" json:"object_identifier,omitempty"`
-	PropertyReferences []*PropertyReference   `protobuf:"bytes,2,rep,name=property_references,json=propertyReferences,proto3" json:"property_references,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type DiscoveryJob struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State     DiscoveryJob_State     `protobuf:"varint,2,opt,name=state,proto3,enum=smartcore.bos.driver.bacnet.v1.DiscoveryJob_State" json:"state,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Absent while the job is running.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Why the job failed, when state is FAILED.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// How many devices replied to WhoIs requests.
+	DevicesFound int32 `protobuf:"varint,6,opt,name=devices_found,json=devicesFound,proto3" json:"devices_found,omitempty"`
+	// How many devices have had their objects read, or failed to.
+	DevicesRead   int32               `protobuf:"varint,7,opt,name=devices_read,json=devicesRead,proto3" json:"devices_read,omitempty"`
+	Devices       []*DiscoveredDevice `protobuf:"bytes,8,rep,name=devices,proto3" json:"devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReadPropertyMultipleRequest_ReadSpecification) Reset() {
-	*x = ReadPropertyMultipleRequest_ReadSpecification{}
+func (x *DiscoveryJob) Reset() {
+	*x = DiscoveryJob{}
 	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReadPropertyMultipleRequest_ReadSpecification) String() string {
+func (x *DiscoveryJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadPropertyMultipleRequest_ReadSpecification) ProtoMessage() {}
+func (*DiscoveryJob) ProtoMessage() {}
 
-func (x *ReadPropertyMultipleRequest_ReadSpecification) ProtoReflect() protoreflect.Message {
+func (x *DiscoveryJob) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1244,47 +1283,106 @@ func (x *ReadPropertyMultipleRequest_ReadSpecification) ProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadPropertyMultipleRequest_ReadSpecification.ProtoReflect.Descriptor instead.
-func (*ReadPropertyMultipleRequest_ReadSpecification) Descriptor() ([]byte, []int) {
-	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{7, 0}
+// Deprecated: Use DiscoveryJob.ProtoReflect.Descriptor instead.
+func (*DiscoveryJob) Descriptor() ([]byte, []int) {
+	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ReadPropertyMultipleRequest_ReadSpecification) GetObjectIdentifier() *ObjectIdentifier {
+func (x *DiscoveryJob) GetId() string {
 	if x != nil {
-		return x.ObjectIdentifier
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DiscoveryJob) GetState() DiscoveryJob_State {
+	if x != nil {
+		return x.State
+	}
+	return DiscoveryJob_STATE_UNSPECIFIED
+}
+
+func (x *DiscoveryJob) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
 	}
 	return nil
 }
 
-func (x *ReadPropertyMultipleRequest_ReadSpecification) GetPropertyReferences() []*PropertyReference {
+func (x *DiscoveryJob) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PropertyReferences
+		return x.EndTime
 	}
 	return nil
 }
 
-type ReadPropertyMultipleResponse_ReadResult struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ObjectIdentifier *ObjectIdentifier      `protobuf:"bytes,1,opt,name=object_identifier,json=objectIdentifier,proto3" json:"object_identifier,omitempty"`
-	Results          []*PropertyReadResult  `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *DiscoveryJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-func (x *ReadPropertyMultipleResponse_ReadResult) Reset() {
-	*x = ReadPropertyMultipleResponse_ReadResult{}
+func (x *DiscoveryJob) GetDevicesFound() int32 {
+	if x != nil {
+		return x.DevicesFound
+	}
+	return 0
+}
+
+func (x *DiscoveryJob) GetDevicesRead() int32 {
+	if x != nil {
+		return x.DevicesRead
+	}
+	return 0
+}
+
+func (x *DiscoveryJob) GetDevices() []*DiscoveredDevice {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type DiscoveredDevice struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	DeviceId uint32                 `protobuf:"varint,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	// The IP:port the device replied from.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// The BACnet network and MAC address of devices behind a BACnet router.
+	// See config Comm.Destination.
+	Network    uint32 `protobuf:"varint,3,opt,name=network,proto3" json:"network,omitempty"`
+	MacAddress string `protobuf:"bytes,4,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	VendorId   uint32 `protobuf:"varint,5,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	VendorName string `protobuf:"bytes,6,opt,name=vendor_name,json=vendorName,proto3" json:"vendor_name,omitempty"`
+	// The Object_Name of the device object.
+	ObjectName string `protobuf:"bytes,7,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// Whether the device supports the SubscribeCOV service.
+	CovSupported bool `protobuf:"varint,8,opt,name=cov_supported,json=covSupported,proto3" json:"cov_supported,omitempty"`
+	// The name of the device in the driver config, if it is already configured.
+	ConfiguredName string              `protobuf:"bytes,9,opt,name=configured_name,json=configuredName,proto3" json:"configured_name,omitempty"`
+	Objects        []*DiscoveredObject `protobuf:"bytes,10,rep,name=objects,proto3" json:"objects,omitempty"`
+	Suggestions    []*TraitSuggestion  `protobuf:"bytes,11,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	// Why reading the objects of this device failed, if it did.
+	Error         string `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscoveredDevice) Reset() {
+	*x = DiscoveredDevice{}
 	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReadPropertyMultipleResponse_ReadResult) String() string {
+func (x *DiscoveredDevice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadPropertyMultipleResponse_ReadResult) ProtoMessage() {}
+func (*DiscoveredDevice) ProtoMessage() {}
 
-func (x *ReadPropertyMultipleResponse_ReadResult) ProtoReflect() protoreflect.Message {
+func (x *DiscoveredDevice) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1296,73 +1394,766 @@ func (x *ReadPropertyMultipleResponse_ReadResult) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadPropertyMultipleResponse_ReadResult.ProtoReflect.Descriptor instead.
-func (*ReadPropertyMultipleResponse_ReadResult) Descriptor() ([]byte, []int) {
-	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use DiscoveredDevice.ProtoReflect.Descriptor instead.
+func (*DiscoveredDevice) Descriptor() ([]byte, []int) {
+	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ReadPropertyMultipleResponse_ReadResult) GetObjectIdentifier() *ObjectIdentifier {
+func (x *DiscoveredDevice) GetDeviceId() uint32 {
 	if x != nil {
-		return x.ObjectIdentifier
+		return x.DeviceId
 	}
-	return nil
+	return 0
 }
 
-func (x *ReadPropertyMultipleResponse_ReadResult) GetResults() []*PropertyReadResult {
+func (x *DiscoveredDevice) GetAddress() string {
 	if x != nil {
-		return x.Results
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-type WritePropertyMultipleRequest_WriteSpecification struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ObjectIdentifier *ObjectIdentifier      `protobuf:"bytes,2,opt,name=object_identifier,json=objectIdentifier,proto3" json:"object_identifier,omitempty"`
-	WriteValues      []*PropertyWriteValue  `protobuf:"bytes,3,rep,name=write_values,json=writeValues,proto3" json:"write_values,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *DiscoveredDevice) GetNetwork() uint32 {
+	if x != nil {
+		return x.Network
+	}
+	return 0
 }
 
-func (x *WritePropertyMultipleRequest_WriteSpecification) Reset() {
-	*x = WritePropertyMultipleRequest_WriteSpecification{}
-	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[20]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DiscoveredDevice) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
 }
 
-func (x *WritePropertyMultipleRequest_WriteSpecification) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DiscoveredDevice) GetVendorId() uint32 {
+	if x != nil {
+		return x.VendorId
+	}
+	return 0
 }
 
-func (*WritePropertyMultipleRequest_WriteSpecification) ProtoMessage() {}
+func (x *DiscoveredDevice) GetVendorName() string {
+	if x != nil {
+		return x.VendorName
+	}
+	return ""
+}
 
-func (x *WritePropertyMultipleRequest_WriteSpecification) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[20]
+func (x *DiscoveredDevice) GetObjectName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ObjectName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use WritePropertyMultipleRequest_WriteSpecification.ProtoReflect.Descriptor instead.
-func (*WritePropertyMultipleRequest_WriteSpecification) Descriptor() ([]byte, []int) {
-	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{11, 0}
+func (x *DiscoveredDevice) GetCovSupported() bool {
+	if x != nil {
+		return x.CovSupported
+	}
+	return false
 }
 
-func (x *WritePropertyMultipleRequest_WriteSpecification) GetObjectIdentifier() *ObjectIdentifier {
+func (x *DiscoveredDevice) GetConfiguredName() string {
 	if x != nil {
-		return x.ObjectIdentifier
+		return x.ConfiguredName
 	}
-	return nil
+	return ""
 }
 
-func (x *WritePropertyMultipleRequest_WriteSpecification) GetWriteValues() []*PropertyWriteValue {
+func (x *DiscoveredDevice) GetObjects() []*DiscoveredObject {
 	if x != nil {
-		return x.WriteValues
+		return x.Objects
+	}
+	return nil
+}
+
+func (x *DiscoveredDevice) GetSuggestions() []*TraitSuggestion {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+func (x *DiscoveredDevice) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DiscoveredObject struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ObjectIdentifier *ObjectIdentifier      `protobuf:"bytes,1,opt,name=object_identifier,json=objectIdentifier,proto3" json:"object_identifier,omitempty"`
+	// The name of the object type, like "AnalogInput".
+	ObjectType  string `protobuf:"bytes,2,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
+	ObjectName  string `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// The engineering units of analog objects, like "°C".
+	Units string `protobuf:"bytes,5,opt,name=units,proto3" json:"units,omitempty"`
+	// Whether changes to this object can be subscribed to, based on the object type and the device supporting COV.
+	CovSupported  bool `protobuf:"varint,6,opt,name=cov_supported,json=covSupported,proto3" json:"cov_supported,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscoveredObject) Reset() {
+	*x = DiscoveredObject{}
+	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscoveredObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoveredObject) ProtoMessage() {}
+
+func (x *DiscoveredObject) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoveredObject.ProtoReflect.Descriptor instead.
+func (*DiscoveredObject) Descriptor() ([]byte, []int) {
+	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DiscoveredObject) GetObjectIdentifier() *ObjectIdentifier {
+	if x != nil {
+		return x.ObjectIdentifier
+	}
+	return nil
+}
+
+func (x *DiscoveredObject) GetObjectType() string {
+	if x != nil {
+		return x.ObjectType
+	}
+	return ""
+}
+
+func (x *DiscoveredObject) GetObjectName() string {
+	if x != nil {
+		return x.ObjectName
+	}
+	return ""
+}
+
+func (x *DiscoveredObject) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DiscoveredObject) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
+}
+
+func (x *DiscoveredObject) GetCovSupported() bool {
+	if x != nil {
+		return x.CovSupported
+	}
+	return false
+}
+
+// A suggestion for a driver trait config built from the objects of a device.
+type TraitSuggestion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique within the job.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The trait name, like "smartcore.traits.AirTemperature".
+	Trait string `protobuf:"bytes,2,opt,name=trait,proto3" json:"trait,omitempty"`
+	// The Smart Core name the trait would be announced on.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// The objects used by the trait.
+	Objects []*ObjectIdentifier `protobuf:"bytes,4,rep,name=objects,proto3" json:"objects,omitempty"`
+	// Why the trait was suggested, like "°C units".
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The JSON trait config that would be added to the driver traits.
+	Config        string `protobuf:"bytes,6,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TraitSuggestion) Reset() {
+	*x = TraitSuggestion{}
+	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TraitSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraitSuggestion) ProtoMessage() {}
+
+func (x *TraitSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraitSuggestion.ProtoReflect.Descriptor instead.
+func (*TraitSuggestion) Descriptor() ([]byte, []int) {
+	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TraitSuggestion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TraitSuggestion) GetTrait() string {
+	if x != nil {
+		return x.Trait
+	}
+	return ""
+}
+
+func (x *TraitSuggestion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TraitSuggestion) GetObjects() []*ObjectIdentifier {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+func (x *TraitSuggestion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TraitSuggestion) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+type AcceptDiscoveryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The id of the job to accept results from, which must be the most recent job.
+	JobId string `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// Devices to add to the config.
+	// Devices used by accepted suggestions are always added.
+	DeviceIds []uint32 `protobuf:"varint,3,rep,packed,name=device_ids,json=deviceIds,proto3" json:"device_ids,omitempty"`
+	// Suggestions to add to the config.
+	SuggestionIds []string `protobuf:"bytes,4,rep,name=suggestion_ids,json=suggestionIds,proto3" json:"suggestion_ids,omitempty"`
+	// Accept all devices and suggestions found by the job.
+	All           bool `protobuf:"varint,5,opt,name=all,proto3" json:"all,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptDiscoveryRequest) Reset() {
+	*x = AcceptDiscoveryRequest{}
+	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptDiscoveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptDiscoveryRequest) ProtoMessage() {}
+
+func (x *AcceptDiscoveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptDiscoveryRequest.ProtoReflect.Descriptor instead.
+func (*AcceptDiscoveryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AcceptDiscoveryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AcceptDiscoveryRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *AcceptDiscoveryRequest) GetDeviceIds() []uint32 {
+	if x != nil {
+		return x.DeviceIds
+	}
+	return nil
+}
+
+func (x *AcceptDiscoveryRequest) GetSuggestionIds() []string {
+	if x != nil {
+		return x.SuggestionIds
+	}
+	return nil
+}
+
+func (x *AcceptDiscoveryRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type AcceptDiscoveryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The full driver config JSON, suitable for ServicesApi.ConfigureService.
+	Config        string `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	DevicesAdded  int32  `protobuf:"varint,2,opt,name=devices_added,json=devicesAdded,proto3" json:"devices_added,omitempty"`
+	TraitsAdded   int32  `protobuf:"varint,3,opt,name=traits_added,json=traitsAdded,proto3" json:"traits_added,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptDiscoveryResponse) Reset() {
+	*x = AcceptDiscoveryResponse{}
+	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptDiscoveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptDiscoveryResponse) ProtoMessage() {}
+
+func (x *AcceptDiscoveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptDiscoveryResponse.ProtoReflect.Descriptor instead.
+func (*AcceptDiscoveryResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AcceptDiscoveryResponse) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+func (x *AcceptDiscoveryResponse) GetDevicesAdded() int32 {
+	if x != nil {
+		return x.DevicesAdded
+	}
+	return 0
+}
+
+func (x *AcceptDiscoveryResponse) GetTraitsAdded() int32 {
+	if x != nil {
+		return x.TraitsAdded
+	}
+	return 0
+}
+
+// Represents a BACnet Date type.
+type PropertyValue_DateValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 means absent, not year 0.
+	Year uint32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// January = 1
+	// 13 means odd months.
+	// 14 means even months.
+	Month uint32 `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	// 32 means last day of month.
+	// 33 means odd days of month.
+	// 34 means even days of month.
+	DayOfMonth uint32 `protobuf:"varint,3,opt,name=day_of_month,json=dayOfMonth,proto3" json:"day_of_month,omitempty"`
+	// Monday = 1
+	DayOfWeek     uint32 `protobuf:"varint,4,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PropertyValue_DateValue) Reset() {
+	*x = PropertyValue_DateValue{}
+	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyValue_DateValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyValue_DateValue) ProtoMessage() {}
+
+func (x *PropertyValue_DateValue) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyValue_DateValue.ProtoReflect.Descriptor instead.
+func (*PropertyValue_DateValue) Descriptor() ([]byte, []int) {
+	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *PropertyValue_DateValue) GetYear() uint32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *PropertyValue_DateValue) GetMonth() uint32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *PropertyValue_DateValue) GetDayOfMonth() uint32 {
+	if x != nil {
+		return x.DayOfMonth
+	}
+	return 0
+}
+
+func (x *PropertyValue_DateValue) GetDayOfWeek() uint32 {
+	if x != nil {
+		return x.DayOfWeek
+	}
+	return 0
+}
+
+// Represents a BACnet Time type.
+type PropertyValue_TimeValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 24hr time
+	Hour               *uint32 `protobuf:"varint,1,opt,name=hour,proto3,oneof" json:"hour,omitempty"`
+	Minute             *uint32 `protobuf:"varint,2,opt,name=minute,proto3,oneof" json:"minute,omitempty"`
+	Second             *uint32 `protobuf:"varint,3,opt,name=second,proto3,oneof" json:"second,omitempty"`
+	HundredthsOfSecond *uint32 `protobuf:"varint,4,opt,name=hundredths_of_second,json=hundredthsOfSecond,proto3,oneof" json:"hundredths_of_second,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PropertyValue_TimeValue) Reset() {
+	*x = PropertyValue_TimeValue{}
+	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyValue_TimeValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyValue_TimeValue) ProtoMessage() {}
+
+func (x *PropertyValue_TimeValue) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyValue_TimeValue.ProtoReflect.Descriptor instead.
+func (*PropertyValue_TimeValue) Descriptor() ([]byte, []int) {
+	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *PropertyValue_TimeValue) GetHour() uint32 {
+	if x != nil && x.Hour != nil {
+		return *x.Hour
+	}
+	return 0
+}
+
+func (x *PropertyValue_TimeValue) GetMinute() uint32 {
+	if x != nil && x.Minute != nil {
+		return *x.Minute
+	}
+	return 0
+}
+
+func (x *PropertyValue_TimeValue) GetSecond() uint32 {
+	if x != nil && x.Second != nil {
+		return *x.Second
+	}
+	return 0
+}
+
+func (x *PropertyValue_TimeValue) GetHundredthsOfSecond() uint32 {
+	if x != nil && x.HundredthsOfSecond != nil {
+		return *x.HundredthsOfSecond
+	}
+	return 0
+}
+
+type PropertyValue_BitStringValue struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Value              []byte                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	IgnoreTrailingBits uint32                 `protobuf:"varint,2,opt,name=ignore_trailing_bits,json=ignoreTrailingBits,proto3" json:"ignore_trailing_bits,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PropertyValue_BitStringValue) Reset() {
+	*x = PropertyValue_BitStringValue{}
+	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertyValue_BitStringValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyValue_BitStringValue) ProtoMessage() {}
+
+func (x *PropertyValue_BitStringValue) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyValue_BitStringValue.ProtoReflect.Descriptor instead.
+func (*PropertyValue_BitStringValue) Descriptor() ([]byte, []int) {
+	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *PropertyValue_BitStringValue) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *PropertyValue_BitStringValue) GetIgnoreTrailingBits() uint32 {
+	if x != nil {
+		return x.IgnoreTrailingBits
+	}
+	return 0
+}
+
+type ReadPropertyMultipleRequest_ReadSpecification struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ObjectIdentifier   *ObjectIdentifier      `protobuf:"bytes,1,opt,name=object_identifier,json=objectIdentifier,proto3" json:"object_identifier,omitempty"`
+	PropertyReferences []*PropertyReference   `protobuf:"bytes,2,rep,name=property_references,json=propertyReferences,proto3" json:"property_references,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ReadPropertyMultipleRequest_ReadSpecification) Reset() {
+	*x = ReadPropertyMultipleRequest_ReadSpecification{}
+	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadPropertyMultipleRequest_ReadSpecification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPropertyMultipleRequest_ReadSpecification) ProtoMessage() {}
+
+func (x *ReadPropertyMultipleRequest_ReadSpecification) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPropertyMultipleRequest_ReadSpecification.ProtoReflect.Descriptor instead.
+func (*ReadPropertyMultipleRequest_ReadSpecification) Descriptor() ([]byte, []int) {
+	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *ReadPropertyMultipleRequest_ReadSpecification) GetObjectIdentifier() *ObjectIdentifier {
+	if x != nil {
+		return x.ObjectIdentifier
+	}
+	return nil
+}
+
+func (x *ReadPropertyMultipleRequest_ReadSpecification) GetPropertyReferences() []*PropertyReference {
+	if x != nil {
+		return x.PropertyReferences
+	}
+	return nil
+}
+
+type ReadPropertyMultipleResponse_ReadResult struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ObjectIdentifier *ObjectIdentifier      `protobuf:"bytes,1,opt,name=object_identifier,json=objectIdentifier,proto3" json:"object_identifier,omitempty"`
+	Results          []*PropertyReadResult  `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReadPropertyMultipleResponse_ReadResult) Reset() {
+	*x = ReadPropertyMultipleResponse_ReadResult{}
+	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadPropertyMultipleResponse_ReadResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPropertyMultipleResponse_ReadResult) ProtoMessage() {}
+
+func (x *ReadPropertyMultipleResponse_ReadResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPropertyMultipleResponse_ReadResult.ProtoReflect.Descriptor instead.
+func (*ReadPropertyMultipleResponse_ReadResult) Descriptor() ([]byte, []int) {
+	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *ReadPropertyMultipleResponse_ReadResult) GetObjectIdentifier() *ObjectIdentifier {
+	if x != nil {
+		return x.ObjectIdentifier
+	}
+	return nil
+}
+
+func (x *ReadPropertyMultipleResponse_ReadResult) GetResults() []*PropertyReadResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type WritePropertyMultipleRequest_WriteSpecification struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ObjectIdentifier *ObjectIdentifier      `protobuf:"bytes,2,opt,name=object_identifier,json=objectIdentifier,proto3" json:"object_identifier,omitempty"`
+	WriteValues      []*PropertyWriteValue  `protobuf:"bytes,3,rep,name=write_values,json=writeValues,proto3" json:"write_values,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WritePropertyMultipleRequest_WriteSpecification) Reset() {
+	*x = WritePropertyMultipleRequest_WriteSpecification{}
+	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WritePropertyMultipleRequest_WriteSpecification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WritePropertyMultipleRequest_WriteSpecification) ProtoMessage() {}
+
+func (x *WritePropertyMultipleRequest_WriteSpecification) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WritePropertyMultipleRequest_WriteSpecification.ProtoReflect.Descriptor instead.
+func (*WritePropertyMultipleRequest_WriteSpecification) Descriptor() ([]byte, []int) {
+	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *WritePropertyMultipleRequest_WriteSpecification) GetObjectIdentifier() *ObjectIdentifier {
+	if x != nil {
+		return x.ObjectIdentifier
+	}
+	return nil
+}
+
+func (x *WritePropertyMultipleRequest_WriteSpecification) GetWriteValues() []*PropertyWriteValue {
+	if x != nil {
+		return x.WriteValues
 	}
 	return nil
 }
@@ -1371,7 +2162,7 @@ var File_pkg_driver_bacnet_rpc_bacnet_proto protoreflect.FileDescriptor
 
 const file_pkg_driver_bacnet_rpc_bacnet_proto_rawDesc = "" +
 	"\n" +
-	"\"pkg/driver/bacnet/rpc/bacnet.proto\x12\x1esmartcore.bos.driver.bacnet.v1\"B\n" +
+	"\"pkg/driver/bacnet/rpc/bacnet.proto\x12\x1esmartcore.bos.driver.bacnet.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"B\n" +
 	"\x10ObjectIdentifier\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\rR\x04type\x12\x1a\n" +
 	"\binstance\x18\x02 \x01(\rR\binstance\"i\n" +
@@ -1467,13 +2258,89 @@ const file_pkg_driver_bacnet_rpc_bacnet_proto_rawDesc = "" +
 	"\x12ListObjectsRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"a\n" +
 	"\x13ListObjectsResponse\x12J\n" +
-	"\aobjects\x18\x01 \x03(\v20.smartcore.bos.driver.bacnet.v1.ObjectIdentifierR\aobjects2\xb1\x05\n" +
+	"\aobjects\x18\x01 \x03(\v20.smartcore.bos.driver.bacnet.v1.ObjectIdentifierR\aobjects\"3\n" +
+	"\rDeviceIdRange\x12\x10\n" +
+	"\x03min\x18\x01 \x01(\rR\x03min\x12\x10\n" +
+	"\x03max\x18\x02 \x01(\rR\x03max\"\xc0\x01\n" +
+	"\x15StartDiscoveryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12E\n" +
+	"\x06ranges\x18\x02 \x03(\v2-.smartcore.bos.driver.bacnet.v1.DeviceIdRangeR\x06ranges\x12\x1d\n" +
+	"\n" +
+	"chunk_size\x18\x03 \x01(\rR\tchunkSize\x12-\n" +
+	"\x12include_configured\x18\x04 \x01(\bR\x11includeConfigured\")\n" +
+	"\x13GetDiscoveryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xda\x03\n" +
+	"\fDiscoveryJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12H\n" +
+	"\x05state\x18\x02 \x01(\x0e22.smartcore.bos.driver.bacnet.v1.DiscoveryJob.StateR\x05state\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12#\n" +
+	"\rdevices_found\x18\x06 \x01(\x05R\fdevicesFound\x12!\n" +
+	"\fdevices_read\x18\a \x01(\x05R\vdevicesRead\x12J\n" +
+	"\adevices\x18\b \x03(\v20.smartcore.bos.driver.bacnet.v1.DiscoveredDeviceR\adevices\"T\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aRUNNING\x10\x01\x12\f\n" +
+	"\bCOMPLETE\x10\x02\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x03\x12\r\n" +
+	"\tCANCELLED\x10\x04\"\xe6\x03\n" +
+	"\x10DiscoveredDevice\x12\x1b\n" +
+	"\tdevice_id\x18\x01 \x01(\rR\bdeviceId\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\rR\anetwork\x12\x1f\n" +
+	"\vmac_address\x18\x04 \x01(\tR\n" +
+	"macAddress\x12\x1b\n" +
+	"\tvendor_id\x18\x05 \x01(\rR\bvendorId\x12\x1f\n" +
+	"\vvendor_name\x18\x06 \x01(\tR\n" +
+	"vendorName\x12\x1f\n" +
+	"\vobject_name\x18\a \x01(\tR\n" +
+	"objectName\x12#\n" +
+	"\rcov_supported\x18\b \x01(\bR\fcovSupported\x12'\n" +
+	"\x0fconfigured_name\x18\t \x01(\tR\x0econfiguredName\x12J\n" +
+	"\aobjects\x18\n" +
+	" \x03(\v20.smartcore.bos.driver.bacnet.v1.DiscoveredObjectR\aobjects\x12Q\n" +
+	"\vsuggestions\x18\v \x03(\v2/.smartcore.bos.driver.bacnet.v1.TraitSuggestionR\vsuggestions\x12\x14\n" +
+	"\x05error\x18\f \x01(\tR\x05error\"\x90\x02\n" +
+	"\x10DiscoveredObject\x12]\n" +
+	"\x11object_identifier\x18\x01 \x01(\v20.smartcore.bos.driver.bacnet.v1.ObjectIdentifierR\x10objectIdentifier\x12\x1f\n" +
+	"\vobject_type\x18\x02 \x01(\tR\n" +
+	"objectType\x12\x1f\n" +
+	"\vobject_name\x18\x03 \x01(\tR\n" +
+	"objectName\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05units\x18\x05 \x01(\tR\x05units\x12#\n" +
+	"\rcov_supported\x18\x06 \x01(\bR\fcovSupported\"\xc7\x01\n" +
+	"\x0fTraitSuggestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05trait\x18\x02 \x01(\tR\x05trait\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12J\n" +
+	"\aobjects\x18\x04 \x03(\v20.smartcore.bos.driver.bacnet.v1.ObjectIdentifierR\aobjects\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06config\x18\x06 \x01(\tR\x06config\"\x9b\x01\n" +
+	"\x16AcceptDiscoveryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\x12\x1d\n" +
+	"\n" +
+	"device_ids\x18\x03 \x03(\rR\tdeviceIds\x12%\n" +
+	"\x0esuggestion_ids\x18\x04 \x03(\tR\rsuggestionIds\x12\x10\n" +
+	"\x03all\x18\x05 \x01(\bR\x03all\"y\n" +
+	"\x17AcceptDiscoveryResponse\x12\x16\n" +
+	"\x06config\x18\x01 \x01(\tR\x06config\x12#\n" +
+	"\rdevices_added\x18\x02 \x01(\x05R\fdevicesAdded\x12!\n" +
+	"\ftraits_added\x18\x03 \x01(\x05R\vtraitsAdded2\xb1\x05\n" +
 	"\x13BacnetDriverService\x12y\n" +
 	"\fReadProperty\x123.smartcore.bos.driver.bacnet.v1.ReadPropertyRequest\x1a4.smartcore.bos.driver.bacnet.v1.ReadPropertyResponse\x12\x91\x01\n" +
 	"\x14ReadPropertyMultiple\x12;.smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleRequest\x1a<.smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleResponse\x12|\n" +
 	"\rWriteProperty\x124.smartcore.bos.driver.bacnet.v1.WritePropertyRequest\x1a5.smartcore.bos.driver.bacnet.v1.WritePropertyResponse\x12\x94\x01\n" +
 	"\x15WritePropertyMultiple\x12<.smartcore.bos.driver.bacnet.v1.WritePropertyMultipleRequest\x1a=.smartcore.bos.driver.bacnet.v1.WritePropertyMultipleResponse\x12v\n" +
-	"\vListObjects\x122.smartcore.bos.driver.bacnet.v1.ListObjectsRequest\x1a3.smartcore.bos.driver.bacnet.v1.ListObjectsResponseB7Z5github.com/smart-core-os/sc-bos/pkg/driver/bacnet/rpcb\x06proto3"
+	"\vListObjects\x122.smartcore.bos.driver.bacnet.v1.ListObjectsRequest\x1a3.smartcore.bos.driver.bacnet.v1.ListObjectsResponse2\x87\x03\n" +
+	"\x16BacnetDiscoveryService\x12u\n" +
+	"\x0eStartDiscovery\x125.smartcore.bos.driver.bacnet.v1.StartDiscoveryRequest\x1a,.smartcore.bos.driver.bacnet.v1.DiscoveryJob\x12q\n" +
+	"\fGetDiscovery\x123.smartcore.bos.driver.bacnet.v1.GetDiscoveryRequest\x1a,.smartcore.bos.driver.bacnet.v1.DiscoveryJob\x12\x82\x01\n" +
+	"\x0fAcceptDiscovery\x126.smartcore.bos.driver.bacnet.v1.AcceptDiscoveryRequest\x1a7.smartcore.bos.driver.bacnet.v1.AcceptDiscoveryResponseB7Z5github.com/smart-core-os/sc-bos/pkg/driver/bacnet/rpcb\x06proto3"
 
 var (
 	file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescOnce sync.Once
@@ -1487,70 +2354,97 @@ func file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescGZIP() []byte {
 	return file_pkg_driver_bacnet_rpc_bacnet_proto_rawDescData
 }
 
-var file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_pkg_driver_bacnet_rpc_bacnet_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_pkg_driver_bacnet_rpc_bacnet_proto_goTypes = []any{
-	(*ObjectIdentifier)(nil),                                // 0: smartcore.bos.driver.bacnet.v1.ObjectIdentifier
-	(*PropertyReference)(nil),                               // 1: smartcore.bos.driver.bacnet.v1.PropertyReference
-	(*PropertyValue)(nil),                                   // 2: smartcore.bos.driver.bacnet.v1.PropertyValue
-	(*PropertyReadResult)(nil),                              // 3: smartcore.bos.driver.bacnet.v1.PropertyReadResult
-	(*PropertyWriteValue)(nil),                              // 4: smartcore.bos.driver.bacnet.v1.PropertyWriteValue
-	(*ReadPropertyRequest)(nil),                             // 5: smartcore.bos.driver.bacnet.v1.ReadPropertyRequest
-	(*ReadPropertyResponse)(nil),                            // 6: smartcore.bos.driver.bacnet.v1.ReadPropertyResponse
-	(*ReadPropertyMultipleRequest)(nil),                     // 7: smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleRequest
-	(*ReadPropertyMultipleResponse)(nil),                    // 8: smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleResponse
-	(*WritePropertyRequest)(nil),                            // 9: smartcore.bos.driver.bacnet.v1.WritePropertyRequest
-	(*WritePropertyResponse)(nil),                           // 10: smartcore.bos.driver.bacnet.v1.WritePropertyResponse
-	(*WritePropertyMultipleRequest)(nil),                    // 11: smartcore.bos.driver.bacnet.v1.WritePropertyMultipleRequest
-	(*WritePropertyMultipleResponse)(nil),                   // 12: smartcore.bos.driver.bacnet.v1.WritePropertyMultipleResponse
-	(*ListObjectsRequest)(nil),                              // 13: smartcore.bos.driver.bacnet.v1.ListObjectsRequest
-	(*ListObjectsResponse)(nil),                             // 14: smartcore.bos.driver.bacnet.v1.ListObjectsResponse
-	(*PropertyValue_DateValue)(nil),                         // 15: smartcore.bos.driver.bacnet.v1.PropertyValue.DateValue
-	(*PropertyValue_TimeValue)(nil),                         // 16: smartcore.bos.driver.bacnet.v1.PropertyValue.TimeValue
-	(*PropertyValue_BitStringValue)(nil),                    // 17: smartcore.bos.driver.bacnet.v1.PropertyValue.BitStringValue
-	(*ReadPropertyMultipleRequest_ReadSpecification)(nil),   // 18: smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleRequest.ReadSpecification
-	(*ReadPropertyMultipleResponse_ReadResult)(nil),         // 19: smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleResponse.ReadResult
-	(*WritePropertyMultipleRequest_WriteSpecification)(nil), // 20: smartcore.bos.driver.bacnet.v1.WritePropertyMultipleRequest.WriteSpecification
+	(DiscoveryJob_State)(0),                                 // 0: smartcore.bos.driver.bacnet.v1.DiscoveryJob.State
+	(*ObjectIdentifier)(nil),                                // 1: smartcore.bos.driver.bacnet.v1.ObjectIdentifier
+	(*PropertyReference)(nil),                               // 2: smartcore.bos.driver.bacnet.v1.PropertyReference
+	(*PropertyValue)(nil),                                   // 3: smartcore.bos.driver.bacnet.v1.PropertyValue
+	(*PropertyReadResult)(nil),                              // 4: smartcore.bos.driver.bacnet.v1.PropertyReadResult
+	(*PropertyWriteValue)(nil),                              // 5: smartcore.bos.driver.bacnet.v1.PropertyWriteValue
+	(*ReadPropertyRequest)(nil),                             // 6: smartcore.bos.driver.bacnet.v1.ReadPropertyRequest
+	(*ReadPropertyResponse)(nil),                            // 7: smartcore.bos.driver.bacnet.v1.ReadPropertyResponse
+	(*ReadPropertyMultipleRequest)(nil),                     // 8: smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleRequest
+	(*ReadPropertyMultipleResponse)(nil),                    // 9: smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleResponse
+	(*WritePropertyRequest)(nil),                            // 10: smartcore.bos.driver.bacnet.v1.WritePropertyRequest
+	(*WritePropertyResponse)(nil),                           // 11: smartcore.bos.driver.bacnet.v1.WritePropertyResponse
+	(*WritePropertyMultipleRequest)(nil),                    // 12: smartcore.bos.driver.bacnet.v1.WritePropertyMultipleRequest
+	(*WritePropertyMultipleResponse)(nil),                   // 13: smartcore.bos.driver.bacnet.v1.WritePropertyMultipleResponse
+	(*ListObjectsRequest)(nil),                              // 14: smartcore.bos.driver.bacnet.v1.ListObjectsRequest
+	(*ListObjectsResponse)(nil),                             // 15: smartcore.bos.driver.bacnet.v1.ListObjectsResponse
+	(*DeviceIdRange)(nil),                                   // 16: smartcore.bos.driver.bacnet.v1.DeviceIdRange
+	(*StartDiscoveryRequest)(nil),                           // 17: smartcore.bos.driver.bacnet.v1.StartDiscoveryRequest
+	(*GetDiscoveryRequest)(nil),                             // 18: smartcore.bos.driver.bacnet.v1.GetDiscoveryRequest
+	(*DiscoveryJob)(nil),                                    // 19: smartcore.bos.driver.bacnet.v1.DiscoveryJob
+	(*DiscoveredDevice)(nil),                                // 20: smartcore.bos.driver.bacnet.v1.DiscoveredDevice
+	(*DiscoveredObject)(nil),                                // 21: smartcore.bos.driver.bacnet.v1.DiscoveredObject
+	(*TraitSuggestion)(nil),                                 // 22: smartcore.bos.driver.bacnet.v1.TraitSuggestion
+	(*AcceptDiscoveryRequest)(nil),                          // 23: smartcore.bos.driver.bacnet.v1.AcceptDiscoveryRequest
+	(*AcceptDiscoveryResponse)(nil),                         // 24: smartcore.bos.driver.bacnet.v1.AcceptDiscoveryResponse
+	(*PropertyValue_DateValue)(nil),                         // 25: smartcore.bos.driver.bacnet.v1.PropertyValue.DateValue
+	(*PropertyValue_TimeValue)(nil),                         // 26: smartcore.bos.driver.bacnet.v1.PropertyValue.TimeValue
+	(*PropertyValue_BitStringValue)(nil),                    // 27: smartcore.bos.driver.bacnet.v1.PropertyValue.BitStringValue
+	(*ReadPropertyMultipleRequest_ReadSpecification)(nil),   // 28: smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleRequest.ReadSpecification
+	(*ReadPropertyMultipleResponse_ReadResult)(nil),         // 29: smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleResponse.ReadResult
+	(*WritePropertyMultipleRequest_WriteSpecification)(nil), // 30: smartcore.bos.driver.bacnet.v1.WritePropertyMultipleRequest.WriteSpecification
+	(*timestamppb.Timestamp)(nil),                           // 31: google.protobuf.Timestamp
 }
 var file_pkg_driver_bacnet_rpc_bacnet_proto_depIdxs = []int32{
-	17, // 0: smartcore.bos.driver.bacnet.v1.PropertyValue.bit_string:type_name -> smartcore.bos.driver.bacnet.v1.PropertyValue.BitStringValue
-	15, // 1: smartcore.bos.driver.bacnet.v1.PropertyValue.date:type_name -> smartcore.bos.driver.bacnet.v1.PropertyValue.DateValue
-	16, // 2: smartcore.bos.driver.bacnet.v1.PropertyValue.time:type_name -> smartcore.bos.driver.bacnet.v1.PropertyValue.TimeValue
-	0,  // 3: smartcore.bos.driver.bacnet.v1.PropertyValue.object_identifier:type_name -> smartcore.bos.driver.bacnet.v1.ObjectIdentifier
-	1,  // 4: smartcore.bos.driver.bacnet.v1.PropertyReadResult.property_reference:type_name -> smartcore.bos.driver.bacnet.v1.PropertyReference
-	2,  // 5: smartcore.bos.driver.bacnet.v1.PropertyReadResult.value:type_name -> smartcore.bos.driver.bacnet.v1.PropertyValue
-	1,  // 6: smartcore.bos.driver.bacnet.v1.PropertyWriteValue.property_reference:type_name -> smartcore.bos.driver.bacnet.v1.PropertyReference
-	2,  // 7: smartcore.bos.driver.bacnet.v1.PropertyWriteValue.value:type_name -> smartcore.bos.driver.bacnet.v1.PropertyValue
-	0,  // 8: smartcore.bos.driver.bacnet.v1.ReadPropertyRequest.object_identifier:type_name -> smartcore.bos.driver.bacnet.v1.ObjectIdentifier
-	1,  // 9: smartcore.bos.driver.bacnet.v1.ReadPropertyRequest.property_reference:type_name -> smartcore.bos.driver.bacnet.v1.PropertyReference
-	0,  // 10: smartcore.bos.driver.bacnet.v1.ReadPropertyResponse.object_identifier:type_name -> smartcore.bos.driver.bacnet.v1.ObjectIdentifier
-	3,  // 11: smartcore.bos.driver.bacnet.v1.ReadPropertyResponse.result:type_name -> smartcore.bos.driver.bacnet.v1.PropertyReadResult
-	18, // 12: smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleRequest.read_specifications:type_name -> smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleRequest.ReadSpecification
-	19, // 13: smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleResponse.read_results:type_name -> smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleResponse.ReadResult
-	0,  // 14: smartcore.bos.driver.bacnet.v1.WritePropertyRequest.object_identifier:type_name -> smartcore.bos.driver.bacnet.v1.ObjectIdentifier
-	4,  // 15: smartcore.bos.driver.bacnet.v1.WritePropertyRequest.write_value:type_name -> smartcore.bos.driver.bacnet.v1.PropertyWriteValue
-	20, // 16: smartcore.bos.driver.bacnet.v1.WritePropertyMultipleRequest.write_specifications:type_name -> smartcore.bos.driver.bacnet.v1.WritePropertyMultipleRequest.WriteSpecification
-	0,  // 17: smartcore.bos.driver.bacnet.v1.ListObjectsResponse.objects:type_name -> smartcore.bos.driver.bacnet.v1.ObjectIdentifier
-	0,  // 18: smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleRequest.ReadSpecification.object_identifier:type_name -> smartcore.bos.driver.bacnet.v1.ObjectIdentifier
-	1,  // 19: smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleRequest.ReadSpecification.property_references:type_name -> smartcore.bos.driver.bacnet.v1.PropertyReference
-	0,  // 20: smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleResponse.ReadResult.object_identifier:type_name -> smartcore.bos.driver.bacnet.v1.ObjectIdentifier
-	3,  // 21: smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleResponse.ReadResult.results:type_name -> smartcore.bos.driver.bacnet.v1.PropertyReadResult
-	0,  // 22: smartcore.bos.driver.bacnet.v1.WritePropertyMultipleRequest.WriteSpecification.object_identifier:type_name -> smartcore.bos.driver.bacnet.v1.ObjectIdentifier
-	4,  // 23: smartcore.bos.driver.bacnet.v1.WritePropertyMultipleRequest.WriteSpecification.write_values:type_name -> smartcore.bos.driver.bacnet.v1.PropertyWriteValue
-	5,  // 24: smartcore.bos.driver.bacnet.v1.BacnetDriverService.ReadProperty:input_type -> smartcore.bos.driver.bacnet.v1.ReadPropertyRequest
-	7,  // 25: smartcore.bos.driver.bacnet.v1.BacnetDriverService.ReadPropertyMultiple:input_type -> smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleRequest
-	9,  // 26: smartcore.bos.driver.bacnet.v1.BacnetDriverService.WriteProperty:input_type -> smartcore.bos.driver.bacnet.v1.WritePropertyRequest
-	11, // 27: smartcore.bos.driver.bacnet.v1.BacnetDriverService.WritePropertyMultiple:input_type -> smartcore.bos.driver.bacnet.v1.WritePropertyMultipleRequest
-	13, // 28: smartcore.bos.driver.bacnet.v1.BacnetDriverService.ListObjects:input_type -> smartcore.bos.driver.bacnet.v1.ListObjectsRequest
-	6,  // 29: smartcore.bos.driver.bacnet.v1.BacnetDriverService.ReadProperty:output_type -> smartcore.bos.driver.bacnet.v1.ReadPropertyResponse
-	8,  // 30: smartcore.bos.driver.bacnet.v1.BacnetDriverService.ReadPropertyMultiple:output_type -> smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleResponse
-	10, // 31: smartcore.bos.driver.bacnet.v1.BacnetDriverService.WriteProperty:output_type -> smartcore.bos.driver.bacnet.v1.WritePropertyResponse
-	12, // 32: smartcore.bos.driver.bacnet.v1.BacnetDriverService.WritePropertyMultiple:output_type -> smartcore.bos.driver.bacnet.v1.WritePropertyMultipleResponse
-	14, // 33: smartcore.bos.driver.bacnet.v1.BacnetDriverService.ListObjects:output_type -> smartcore.bos.driver.bacnet.v1.ListObjectsResponse
-	29, // [29:34] is the sub-list for method output_type
-	24, // [24:29] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	27, // 0: smartcore.bos.driver.bacnet.v1.PropertyValue.bit_string:type_name -> smartcore.bos.driver.bacnet.v1.PropertyValue.BitStringValue
+	25, // 1: smartcore.bos.driver.bacnet.v1.PropertyValue.date:type_name -> smartcore.bos.driver.bacnet.v1.PropertyValue.DateValue
+	26, // 2: smartcore.bos.driver.bacnet.v1.PropertyValue.time:type_name -> smartcore.bos.driver.bacnet.v1.PropertyValue.TimeValue
+	1,  // 3: smartcore.bos.driver.bacnet.v1.PropertyValue.object_identifier:type_name -> smartcore.bos.driver.bacnet.v1.ObjectIdentifier
+	2,  // 4: smartcore.bos.driver.bacnet.v1.PropertyReadResult.property_reference:type_name -> smartcore.bos.driver.bacnet.v1.PropertyReference
+	3,  // 5: smartcore.bos.driver.bacnet.v1.PropertyReadResult.value:type_name -> smartcore.bos.driver.bacnet.v1.PropertyValue
+	2,  // 6: smartcore.bos.driver.bacnet.v1.PropertyWriteValue.property_reference:type_name -> smartcore.bos.driver.bacnet.v1.PropertyReference
+	3,  // 7: smartcore.bos.driver.bacnet.v1.PropertyWriteValue.value:type_name -> smartcore.bos.driver.bacnet.v1.PropertyValue
+	1,  // 8: smartcore.bos.driver.bacnet.v1.ReadPropertyRequest.object_identifier:type_name -> smartcore.bos.driver.bacnet.v1.ObjectIdentifier
+	2,  // 9: smartcore.bos.driver.bacnet.v1.ReadPropertyRequest.property_reference:type_name -> smartcore.bos.driver.bacnet.v1.PropertyReference
+	1,  // 10: smartcore.bos.driver.bacnet.v1.ReadPropertyResponse.object_identifier:type_name -> smartcore.bos.driver.bacnet.v1.ObjectIdentifier
+	4,  // 11: smartcore.bos.driver.bacnet.v1.ReadPropertyResponse.result:type_name -> smartcore.bos.driver.bacnet.v1.PropertyReadResult
+	28, // 12: smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleRequest.read_specifications:type_name -> smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleRequest.ReadSpecification
+	29, // 13: smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleResponse.read_results:type_name -> smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleResponse.ReadResult
+	1,  // 14: smartcore.bos.driver.bacnet.v1.WritePropertyRequest.object_identifier:type_name -> smartcore.bos.driver.bacnet.v1.ObjectIdentifier
+	5,  // 15: smartcore.bos.driver.bacnet.v1.WritePropertyRequest.write_value:type_name -> smartcore.bos.driver.bacnet.v1.PropertyWriteValue
+	30, // 16: smartcore.bos.driver.bacnet.v1.WritePropertyMultipleRequest.write_specifications:type_name -> smartcore.bos.driver.bacnet.v1.WritePropertyMultipleRequest.WriteSpecification
+	1,  // 17: smartcore.bos.driver.bacnet.v1.ListObjectsResponse.objects:type_name -> smartcore.bos.driver.bacnet.v1.ObjectIdentifier
+	16, // 18: smartcore.bos.driver.bacnet.v1.StartDiscoveryRequest.ranges:type_name -> smartcore.bos.driver.bacnet.v1.DeviceIdRange
+	0,  // 19: smartcore.bos.driver.bacnet.v1.DiscoveryJob.state:type_name -> smartcore.bos.driver.bacnet.v1.DiscoveryJob.State
+	31, // 20: smartcore.bos.driver.bacnet.v1.DiscoveryJob.start_time:type_name -> google.protobuf.Timestamp
+	31, // 21: smartcore.bos.driver.bacnet.v1.DiscoveryJob.end_time:type_name -> google.protobuf.Timestamp
+	20, // 22: smartcore.bos.driver.bacnet.v1.DiscoveryJob.devices:type_name -> smartcore.bos.driver.bacnet.v1.DiscoveredDevice
+	21, // 23: smartcore.bos.driver.bacnet.v1.DiscoveredDevice.objects:type_name -> smartcore.bos.driver.bacnet.v1.DiscoveredObject
+	22, // 24: smartcore.bos.driver.bacnet.v1.DiscoveredDevice.suggestions:type_name -> smartcore.bos.driver.bacnet.v1.TraitSuggestion
+	1,  // 25: smartcore.bos.driver.bacnet.v1.DiscoveredObject.object_identifier:type_name -> smartcore.bos.driver.bacnet.v1.ObjectIdentifier
+	1,  // 26: smartcore.bos.driver.bacnet.v1.TraitSuggestion.objects:type_name -> smartcore.bos.driver.bacnet.v1.ObjectIdentifier
+	1,  // 27: smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleRequest.ReadSpecification.object_identifier:type_name -> smartcore.bos.driver.bacnet.v1.ObjectIdentifier
+	2,  // 28: smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleRequest.ReadSpecification.property_references:type_name -> smartcore.bos.driver.bacnet.v1.PropertyReference
+	1,  // 29: smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleResponse.ReadResult.object_identifier:type_name -> smartcore.bos.driver.bacnet.v1.ObjectIdentifier
+	4,  // 30: smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleResponse.ReadResult.results:type_name -> smartcore.bos.driver.bacnet.v1.PropertyReadResult
+	1,  // 31: smartcore.bos.driver.bacnet.v1.WritePropertyMultipleRequest.WriteSpecification.object_identifier:type_name -> smartcore.bos.driver.bacnet.v1.ObjectIdentifier
+	5,  // 32: smartcore.bos.driver.bacnet.v1.WritePropertyMultipleRequest.WriteSpecification.write_values:type_name -> smartcore.bos.driver.bacnet.v1.PropertyWriteValue
+	6,  // 33: smartcore.bos.driver.bacnet.v1.BacnetDriverService.ReadProperty:input_type -> smartcore.bos.driver.bacnet.v1.ReadPropertyRequest
+	8,  // 34: smartcore.bos.driver.bacnet.v1.BacnetDriverService.ReadPropertyMultiple:input_type -> smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleRequest
+	10, // 35: smartcore.bos.driver.bacnet.v1.BacnetDriverService.WriteProperty:input_type -> smartcore.bos.driver.bacnet.v1.WritePropertyRequest
+	12, // 36: smartcore.bos.driver.bacnet.v1.BacnetDriverService.WritePropertyMultiple:input_type -> smartcore.bos.driver.bacnet.v1.WritePropertyMultipleRequest
+	14, // 37: smartcore.bos.driver.bacnet.v1.BacnetDriverService.ListObjects:input_type -> smartcore.bos.driver.bacnet.v1.ListObjectsRequest
+	17, // 38: smartcore.bos.driver.bacnet.v1.BacnetDiscoveryService.StartDiscovery:input_type -> smartcore.bos.driver.bacnet.v1.StartDiscoveryRequest
+	18, // 39: smartcore.bos.driver.bacnet.v1.BacnetDiscoveryService.GetDiscovery:input_type -> smartcore.bos.driver.bacnet.v1.GetDiscoveryRequest
+	23, // 40: smartcore.bos.driver.bacnet.v1.BacnetDiscoveryService.AcceptDiscovery:input_type -> smartcore.bos.driver.bacnet.v1.AcceptDiscoveryRequest
+	7,  // 41: smartcore.bos.driver.bacnet.v1.BacnetDriverService.ReadProperty:output_type -> smartcore.bos.driver.bacnet.v1.ReadPropertyResponse
+	9,  // 42: smartcore.bos.driver.bacnet.v1.BacnetDriverService.ReadPropertyMultiple:output_type -> smartcore.bos.driver.bacnet.v1.ReadPropertyMultipleResponse
+	11, // 43: smartcore.bos.driver.bacnet.v1.BacnetDriverService.WriteProperty:output_type -> smartcore.bos.driver.bacnet.v1.WritePropertyResponse
+	13, // 44: smartcore.bos.driver.bacnet.v1.BacnetDriverService.WritePropertyMultiple:output_type -> smartcore.bos.driver.bacnet.v1.WritePropertyMultipleResponse
+	15, // 45: smartcore.bos.driver.bacnet.v1.BacnetDriverService.ListObjects:output_type -> smartcore.bos.driver.bacnet.v1.ListObjectsResponse
+	19, // 46: smartcore.bos.driver.bacnet.v1.BacnetDiscoveryService.StartDiscovery:output_type -> smartcore.bos.driver.bacnet.v1.DiscoveryJob
+	19, // 47: smartcore.bos.driver.bacnet.v1.BacnetDiscoveryService.GetDiscovery:output_type -> smartcore.bos.driver.bacnet.v1.DiscoveryJob
+	24, // 48: smartcore.bos.driver.bacnet.v1.BacnetDiscoveryService.AcceptDiscovery:output_type -> smartcore.bos.driver.bacnet.v1.AcceptDiscoveryResponse
+	41, // [41:49] is the sub-list for method output_type
+	33, // [33:41] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_pkg_driver_bacnet_rpc_bacnet_proto_init() }
@@ -1576,19 +2470,20 @@ func file_pkg_driver_bacnet_rpc_bacnet_proto_init() {
 		(*PropertyValue_Time)(nil),
 		(*PropertyValue_ObjectIdentifier)(nil),
 	}
-	file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[16].OneofWrappers = []any{}
+	file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes[25].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_driver_bacnet_rpc_bacnet_proto_rawDesc), len(file_pkg_driver_bacnet_rpc_bacnet_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   21,
+			NumEnums:      1,
+			NumMessages:   30,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_pkg_driver_bacnet_rpc_bacnet_proto_goTypes,
 		DependencyIndexes: file_pkg_driver_bacnet_rpc_bacnet_proto_depIdxs,
+		EnumInfos:         file_pkg_driver_bacnet_rpc_bacnet_proto_enumTypes,
 		MessageInfos:      file_pkg_driver_bacnet_rpc_bacnet_proto_msgTypes,
 	}.Build()
 	File_pkg_driver_bacnet_rpc_bacnet_proto = out.File
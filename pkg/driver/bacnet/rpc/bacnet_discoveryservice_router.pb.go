@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-router. DO NOT EDIT.
+//lint:file-ignore SA1019 Routers intentionally use a deprecated packaged - they are deprecated themselves
+
+package rpc
+
+import (
+	context "context"
+	fmt "fmt"
+	router "github.com/smart-core-os/sc-bos/pkg/router"
+	grpc "google.golang.org/grpc"
+)
+
+// BacnetDiscoveryServiceRouter is a BacnetDiscoveryServiceServer that allows routing named requests to specific BacnetDiscoveryServiceClient
+// Deprecated: routing is now handled dynamically by [node.Node].
+type BacnetDiscoveryServiceRouter struct {
+	UnimplementedBacnetDiscoveryServiceServer
+
+	router.Router
+}
+
+// compile time check that we implement the interface we need
+var _ BacnetDiscoveryServiceServer = (*BacnetDiscoveryServiceRouter)(nil)
+
+// NewBacnetDiscoveryServiceRouter constructs a new empty BacnetDiscoveryServiceRouter with the provided options.
+// Deprecated: routing is now handled dynamically by [node.Node].
+func NewBacnetDiscoveryServiceRouter(opts ...router.Option) *BacnetDiscoveryServiceRouter {
+	return &BacnetDiscoveryServiceRouter{
+		Router: router.NewRouter(opts...),
+	}
+}
+
+// WithBacnetDiscoveryServiceClientFactory instructs the router to create a new
+// client the first time Get is called for that name.
+func WithBacnetDiscoveryServiceClientFactory(f func(name string) (BacnetDiscoveryServiceClient, error)) router.Option {
+	return router.WithFactory(func(name string) (any, error) {
+		return f(name)
+	})
+}
+
+func (r *BacnetDiscoveryServiceRouter) Register(server grpc.ServiceRegistrar) {
+	RegisterBacnetDiscoveryServiceServer(server, r)
+}
+
+// Add extends Router.Add to panic if client is not of type BacnetDiscoveryServiceClient.
+func (r *BacnetDiscoveryServiceRouter) Add(name string, client any) any {
+	if !r.HoldsType(client) {
+		panic(fmt.Sprintf("not correct type: client of type %T is not a BacnetDiscoveryServiceClient", client))
+	}
+	return r.Router.Add(name, client)
+}
+
+func (r *BacnetDiscoveryServiceRouter) HoldsType(client any) bool {
+	_, ok := client.(BacnetDiscoveryServiceClient)
+	return ok
+}
+
+func (r *BacnetDiscoveryServiceRouter) AddBacnetDiscoveryServiceClient(name string, client BacnetDiscoveryServiceClient) BacnetDiscoveryServiceClient {
+	res := r.Add(name, client)
+	if res == nil {
+		return nil
+	}
+	return res.(BacnetDiscoveryServiceClient)
+}
+
+func (r *BacnetDiscoveryServiceRouter) RemoveBacnetDiscoveryServiceClient(name string) BacnetDiscoveryServiceClient {
+	res := r.Remove(name)
+	if res == nil {
+		return nil
+	}
+	return res.(BacnetDiscoveryServiceClient)
+}
+
+func (r *BacnetDiscoveryServiceRouter) GetBacnetDiscoveryServiceClient(name string) (BacnetDiscoveryServiceClient, error) {
+	res, err := r.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(BacnetDiscoveryServiceClient), nil
+}
+
+func (r *BacnetDiscoveryServiceRouter) StartDiscovery(ctx context.Context, request *StartDiscoveryRequest) (*DiscoveryJob, error) {
+	child, err := r.GetBacnetDiscoveryServiceClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.StartDiscovery(ctx, request)
+}
+
+func (r *BacnetDiscoveryServiceRouter) GetDiscovery(ctx context.Context, request *GetDiscoveryRequest) (*DiscoveryJob, error) {
+	child, err := r.GetBacnetDiscoveryServiceClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.GetDiscovery(ctx, request)
+}
+
+func (r *BacnetDiscoveryServiceRouter) AcceptDiscovery(ctx context.Context, request *AcceptDiscoveryRequest) (*AcceptDiscoveryResponse, error) {
+	child, err := r.GetBacnetDiscoveryServiceClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.AcceptDiscovery(ctx, request)
+}
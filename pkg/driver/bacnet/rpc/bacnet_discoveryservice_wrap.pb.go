@@ -0,0 +1,43 @@
+// Code generated by protoc-gen-wrapper. DO NOT EDIT.
+
+package rpc
+
+import (
+	wrap "github.com/smart-core-os/sc-bos/pkg/wrap"
+	grpc "google.golang.org/grpc"
+)
+
+// WrapBacnetDiscoveryService	adapts a BacnetDiscoveryServiceServer	and presents it as a BacnetDiscoveryServiceClient
+// Deprecated: for client use, use [wrap.ServerToClient]; for server registration, use [github.com/smart-core-os/sc-bos/pkg/node.HasServer].
+func WrapBacnetDiscoveryService(server BacnetDiscoveryServiceServer) *BacnetDiscoveryServiceWrapper {
+	conn := wrap.ServerToClient(BacnetDiscoveryService_ServiceDesc, server)
+	client := NewBacnetDiscoveryServiceClient(conn)
+	return &BacnetDiscoveryServiceWrapper{
+		BacnetDiscoveryServiceClient: client,
+		server:                       server,
+		conn:                         conn,
+		desc:                         BacnetDiscoveryService_ServiceDesc,
+	}
+}
+
+type BacnetDiscoveryServiceWrapper struct {
+	BacnetDiscoveryServiceClient
+
+	server BacnetDiscoveryServiceServer
+	conn   grpc.ClientConnInterface
+	desc   grpc.ServiceDesc
+}
+
+// UnwrapServer returns the underlying server instance.
+func (w *BacnetDiscoveryServiceWrapper) UnwrapServer() BacnetDiscoveryServiceServer {
+	return w.server
+}
+
+// Unwrap implements wrap.Unwrapper and returns the underlying server instance as an unknown type.
+func (w *BacnetDiscoveryServiceWrapper) Unwrap() any {
+	return w.UnwrapServer()
+}
+
+func (w *BacnetDiscoveryServiceWrapper) UnwrapService() (grpc.ClientConnInterface, grpc.ServiceDesc) {
+	return w.conn, w.desc
+}
@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.5.1
-// - protoc             v7.34.0
+// - protoc             v7.35.1
 // source: pkg/driver/bacnet/rpc/bacnet.proto
 
 package rpc
@@ -281,3 +281,202 @@ var BacnetDriverService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/driver/bacnet/rpc/bacnet.proto",
 }
+
+const (
+	BacnetDiscoveryService_StartDiscovery_FullMethodName  = "/smartcore.bos.driver.bacnet.v1.BacnetDiscoveryService/StartDiscovery"
+	BacnetDiscoveryService_GetDiscovery_FullMethodName    = "/smartcore.bos.driver.bacnet.v1.BacnetDiscoveryService/GetDiscovery"
+	BacnetDiscoveryService_AcceptDiscovery_FullMethodName = "/smartcore.bos.driver.bacnet.v1.BacnetDiscoveryService/AcceptDiscovery"
+)
+
+// BacnetDiscoveryServiceClient is the client API for BacnetDiscoveryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Discovers BACnet devices on the network and proposes driver config for them.
+// The service is announced using the name of the driver.
+type BacnetDiscoveryServiceClient interface {
+	// Starts a discovery job, returning as soon as it has started.
+	// The job sends WhoIs requests for the requested device id ranges then reads the objects of each device that replies,
+	// suggesting traits for them.
+	// Only one job runs at a time, starting a job cancels any job that is still running.
+	StartDiscovery(ctx context.Context, in *StartDiscoveryRequest, opts ...grpc.CallOption) (*DiscoveryJob, error)
+	// Returns the most recent discovery job, including any results found so far.
+	GetDiscovery(ctx context.Context, in *GetDiscoveryRequest, opts ...grpc.CallOption) (*DiscoveryJob, error)
+	// Returns driver config that adds the chosen devices and trait suggestions of a discovery job to the current config.
+	// The config is not applied, review it then apply it using ServicesApi.ConfigureService.
+	AcceptDiscovery(ctx context.Context, in *AcceptDiscoveryRequest, opts ...grpc.CallOption) (*AcceptDiscoveryResponse, error)
+}
+
+type bacnetDiscoveryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBacnetDiscoveryServiceClient(cc grpc.ClientConnInterface) BacnetDiscoveryServiceClient {
+	return &bacnetDiscoveryServiceClient{cc}
+}
+
+func (c *bacnetDiscoveryServiceClient) StartDiscovery(ctx context.Context, in *StartDiscoveryRequest, opts ...grpc.CallOption) (*DiscoveryJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscoveryJob)
+	err := c.cc.Invoke(ctx, BacnetDiscoveryService_StartDiscovery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bacnetDiscoveryServiceClient) GetDiscovery(ctx context.Context, in *GetDiscoveryRequest, opts ...grpc.CallOption) (*DiscoveryJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscoveryJob)
+	err := c.cc.Invoke(ctx, BacnetDiscoveryService_GetDiscovery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bacnetDiscoveryServiceClient) AcceptDiscovery(ctx context.Context, in *AcceptDiscoveryRequest, opts ...grpc.CallOption) (*AcceptDiscoveryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptDiscoveryResponse)
+	err := c.cc.Invoke(ctx, BacnetDiscoveryService_AcceptDiscovery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BacnetDiscoveryServiceServer is the server API for BacnetDiscoveryService service.
+// All implementations must embed UnimplementedBacnetDiscoveryServiceServer
+// for forward compatibility.
+//
+// Discovers BACnet devices on the network and proposes driver config for them.
+// The service is announced using the name of the driver.
+type BacnetDiscoveryServiceServer interface {
+	// Starts a discovery job, returning as soon as it has started.
+	// The job sends WhoIs requests for the requested device id ranges then reads the objects of each device that replies,
+	// suggesting traits for them.
+	// Only one job runs at a time, starting a job cancels any job that is still running.
+	StartDiscovery(context.Context, *StartDiscoveryRequest) (*DiscoveryJob, error)
+	// Returns the most recent discovery job, including any results found so far.
+	GetDiscovery(context.Context, *GetDiscoveryRequest) (*DiscoveryJob, error)
+	// Returns driver config that adds the chosen devices and trait suggestions of a discovery job to the current config.
+	// The config is not applied, review it then apply it using ServicesApi.ConfigureService.
+	AcceptDiscovery(context.Context, *AcceptDiscoveryRequest) (*AcceptDiscoveryResponse, error)
+	mustEmbedUnimplementedBacnetDiscoveryServiceServer()
+}
+
+// UnimplementedBacnetDiscoveryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBacnetDiscoveryServiceServer struct{}
+
+func (UnimplementedBacnetDiscoveryServiceServer) StartDiscovery(context.Context, *StartDiscoveryRequest) (*DiscoveryJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartDiscovery not implemented")
+}
+func (UnimplementedBacnetDiscoveryServiceServer) GetDiscovery(context.Context, *GetDiscoveryRequest) (*DiscoveryJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiscovery not implemented")
+}
+func (UnimplementedBacnetDiscoveryServiceServer) AcceptDiscovery(context.Context, *AcceptDiscoveryRequest) (*AcceptDiscoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptDiscovery not implemented")
+}
+func (UnimplementedBacnetDiscoveryServiceServer) mustEmbedUnimplementedBacnetDiscoveryServiceServer() {
+}
+func (UnimplementedBacnetDiscoveryServiceServer) testEmbeddedByValue() {}
+
+// UnsafeBacnetDiscoveryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BacnetDiscoveryServiceServer will
+// result in compilation errors.
+type UnsafeBacnetDiscoveryServiceServer interface {
+	mustEmbedUnimplementedBacnetDiscoveryServiceServer()
+}
+
+func RegisterBacnetDiscoveryServiceServer(s grpc.ServiceRegistrar, srv BacnetDiscoveryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBacnetDiscoveryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BacnetDiscoveryService_ServiceDesc, srv)
+}
+
+func _BacnetDiscoveryService_StartDiscovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartDiscoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacnetDiscoveryServiceServer).StartDiscovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BacnetDiscoveryService_StartDiscovery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacnetDiscoveryServiceServer).StartDiscovery(ctx, req.(*StartDiscoveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BacnetDiscoveryService_GetDiscovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiscoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacnetDiscoveryServiceServer).GetDiscovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BacnetDiscoveryService_GetDiscovery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacnetDiscoveryServiceServer).GetDiscovery(ctx, req.(*GetDiscoveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BacnetDiscoveryService_AcceptDiscovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptDiscoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacnetDiscoveryServiceServer).AcceptDiscovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BacnetDiscoveryService_AcceptDiscovery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacnetDiscoveryServiceServer).AcceptDiscovery(ctx, req.(*AcceptDiscoveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BacnetDiscoveryService_ServiceDesc is the grpc.ServiceDesc for BacnetDiscoveryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BacnetDiscoveryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "smartcore.bos.driver.bacnet.v1.BacnetDiscoveryService",
+	HandlerType: (*BacnetDiscoveryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartDiscovery",
+			Handler:    _BacnetDiscoveryService_StartDiscovery_Handler,
+		},
+		{
+			MethodName: "GetDiscovery",
+			Handler:    _BacnetDiscoveryService_GetDiscovery_Handler,
+		},
+		{
+			MethodName: "AcceptDiscovery",
+			Handler:    _BacnetDiscoveryService_AcceptDiscovery_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/driver/bacnet/rpc/bacnet.proto",
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/timshannon/bolthold"
 	"go.uber.org/zap"
 
+	"github.com/smart-core-os/sc-bos/internal/download"
 	"github.com/smart-core-os/sc-bos/pkg/node"
 	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
 	"github.com/smart-core-os/sc-bos/pkg/task/service"
@@ -17,6 +18,7 @@ type Services struct {
 	Node            *node.Node  // for advertising devices
 	ClientTLSConfig *tls.Config // for connecting to other smartcore nodes
 	HTTPMux         *http.ServeMux
+	DownloadRouter  *download.Router // shared signed-URL router for drivers that serve file downloads, may be nil
 	Config          service.ConfigUpdater
 	Database        *bolthold.Store
 	Health          *healthpb.Checks
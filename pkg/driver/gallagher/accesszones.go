@@ -5,17 +5,23 @@ import (
 	"encoding/json"
 	"path"
 	"slices"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/smart-core-os/sc-bos/pkg/driver/gallagher/config"
 	"github.com/smart-core-os/sc-bos/pkg/node"
 	"github.com/smart-core-os/sc-bos/pkg/proto/accesspb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/actorpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/lockunlockpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/openclosepb"
 	"github.com/smart-core-os/sc-bos/pkg/resource"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
 	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
 )
 
@@ -35,6 +41,8 @@ type AccessZonePayload struct {
 	StatusFlags []string `json:"statusFlags,omitempty"`
 	Status      string   `json:"status,omitempty"`
 	ZoneCount   int      `json:"zoneCount,omitempty"`
+
+	Commands map[string]Command `json:"commands,omitempty"`
 }
 
 type AccessZone struct {
@@ -43,6 +51,37 @@ type AccessZone struct {
 	AccessZonePayload
 	lastAccessAttempt *resource.Value // of *accesspb.AccessAttempt
 	undo              []node.Undo
+
+	mu         sync.Mutex // guards AccessZonePayload after the zone is announced
+	lockUnlock *lockunlockpb.Model
+	openClose  *openclosepb.Model
+}
+
+func (z *AccessZone) payload() AccessZonePayload {
+	z.mu.Lock()
+	defer z.mu.Unlock()
+	return z.AccessZonePayload
+}
+
+// setPayload updates the zone and its trait models with the latest details from Gallagher.
+func (z *AccessZone) setPayload(payload AccessZonePayload) {
+	z.mu.Lock()
+	z.AccessZonePayload = payload
+	z.mu.Unlock()
+
+	position := lockunlockpb.LockUnlock_UNLOCKED
+	if slices.Contains(payload.StatusFlags, "lockDown") {
+		position = lockunlockpb.LockUnlock_LOCKED
+	}
+	_, _ = z.lockUnlock.UpdateLockUnlock(&lockunlockpb.LockUnlock{Position: position})
+
+	var open float32
+	if slices.Contains(payload.StatusFlags, "free") {
+		open = 100
+	}
+	_, _ = z.openClose.UpdatePositions(&openclosepb.OpenClosePositions{
+		States: []*openclosepb.OpenClosePosition{{OpenPercent: open}},
+	})
 }
 
 type AccessZoneController struct {
@@ -93,24 +132,26 @@ func (azc *AccessZoneController) getAccessZones() (map[string]*AccessZone, error
 
 // getAccessZoneDetails fetches and populates full details for the given access zone.
 func (azc *AccessZoneController) getAccessZoneDetails(zone *AccessZone) {
-	resp, err := azc.client.doRequest(zone.Href)
+	details := zone.payload()
+	resp, err := azc.client.doRequest(details.Href)
 	if err != nil {
-		azc.logger.Error("failed to get access zone details", zap.Error(err), zap.String("href", zone.Href))
+		azc.logger.Error("failed to get access zone details", zap.Error(err), zap.String("href", details.Href))
 		return
 	}
 
-	if err = json.Unmarshal(resp, zone); err != nil {
+	if err = json.Unmarshal(resp, &details); err != nil {
 		azc.logger.Error("failed to decode access zone details", zap.Error(err))
 		return
 	}
+	zone.setPayload(details)
 
 	attempt := &accesspb.AccessAttempt{
-		Grant:             statusFlagsToGrant(zone.StatusFlags),
-		Reason:            zone.Status,
+		Grant:             statusFlagsToGrant(details.StatusFlags),
+		Reason:            details.Status,
 		AccessAttemptTime: timestamppb.Now(),
 	}
 
-	if ch := azc.cc.lastCardholderForZoneHref(zone.Href); ch != nil {
+	if ch := azc.cc.lastCardholderForZoneHref(details.Href); ch != nil {
 		if t, err := time.Parse(time.RFC3339, ch.LastSuccessfulAccessTime); err == nil {
 			attempt.AccessAttemptTime = timestamppb.New(t)
 		}
@@ -143,6 +184,8 @@ func (azc *AccessZoneController) refreshAccessZones(announcer node.Announcer, sc
 	for id, z := range zones {
 		if _, ok := azc.zones[id]; !ok {
 			z.lastAccessAttempt = resource.NewValue(resource.WithInitialValue(&accesspb.AccessAttempt{}), resource.WithNoDuplicates())
+			z.lockUnlock = lockunlockpb.NewModel()
+			z.openClose = openclosepb.NewModel()
 			z.ScName = path.Join(scNamePrefix, "access_zones", z.Id)
 			z.Meta = &metadatapb.Metadata{
 				Appearance: &metadatapb.Metadata_Appearance{
@@ -157,6 +200,22 @@ func (azc *AccessZoneController) refreshAccessZones(announcer node.Announcer, sc
 				node.HasServer(accesspb.RegisterAccessApiServer, accesspb.AccessApiServer(z)),
 				node.HasTrait(accesspb.TraitName),
 			))
+			z.undo = append(z.undo, announcer.Announce(z.ScName,
+				node.HasServer(lockunlockpb.RegisterLockUnlockApiServer, lockunlockpb.LockUnlockApiServer(&zoneLockUnlock{
+					LockUnlockApiServer: lockunlockpb.NewModelServer(z.lockUnlock),
+					zone:                z,
+					azc:                 azc,
+				})),
+				node.HasTrait(trait.LockUnlock),
+			))
+			z.undo = append(z.undo, announcer.Announce(z.ScName,
+				node.HasServer(openclosepb.RegisterOpenCloseApiServer, openclosepb.OpenCloseApiServer(&zoneOpenClose{
+					OpenCloseApiServer: openclosepb.NewModelServer(z.openClose),
+					zone:               z,
+					azc:                azc,
+				})),
+				node.HasTrait(trait.OpenClose),
+			))
 			z.undo = append(z.undo, announcer.Announce(z.ScName, node.HasMetadata(z.Meta), node.HasDeviceType(metadatapb.Metadata_VIRTUAL)))
 			azc.zones[id] = z
 		}
@@ -213,3 +272,60 @@ func (z *AccessZone) PullAccessAttempts(_ *accesspb.PullAccessAttemptsRequest, s
 	}
 	return nil
 }
+
+// zoneCommand runs the named command on zone, then refreshes its status.
+func (azc *AccessZoneController) zoneCommand(ctx context.Context, zone *AccessZone, name string) error {
+	if err := azc.client.runCommand(ctx, zone.payload().Commands, name); err != nil {
+		return err
+	}
+	azc.getAccessZoneDetails(zone)
+	return nil
+}
+
+// zoneLockUnlock implements the LockUnlock trait for an access zone.
+// Locking the zone puts it into lockdown, denying access to everyone, unlocking cancels the lockdown.
+type zoneLockUnlock struct {
+	lockunlockpb.LockUnlockApiServer
+	zone *AccessZone
+	azc  *AccessZoneController
+}
+
+func (s *zoneLockUnlock) UpdateLockUnlock(ctx context.Context, req *lockunlockpb.UpdateLockUnlockRequest) (*lockunlockpb.LockUnlock, error) {
+	var cmd string
+	switch req.GetLockUnlock().GetPosition() {
+	case lockunlockpb.LockUnlock_LOCKED:
+		cmd = "lockDown"
+	case lockunlockpb.LockUnlock_UNLOCKED:
+		cmd = "cancelLockDown"
+	default:
+		return nil, status.Error(codes.InvalidArgument, "position must be LOCKED or UNLOCKED")
+	}
+	if err := s.azc.zoneCommand(ctx, s.zone, cmd); err != nil {
+		return nil, err
+	}
+	return s.zone.lockUnlock.GetLockUnlock()
+}
+
+// zoneOpenClose implements the OpenClose trait for an access zone.
+// Opening the zone puts it into free access mode, where doors don't need a card,
+// closing it returns the zone to its scheduled mode.
+type zoneOpenClose struct {
+	openclosepb.OpenCloseApiServer
+	zone *AccessZone
+	azc  *AccessZoneController
+}
+
+func (s *zoneOpenClose) UpdatePositions(ctx context.Context, req *openclosepb.UpdateOpenClosePositionsRequest) (*openclosepb.OpenClosePositions, error) {
+	states := req.GetStates().GetStates()
+	if len(states) != 1 {
+		return nil, status.Error(codes.InvalidArgument, "exactly one position is required")
+	}
+	cmd := "cancel"
+	if states[0].GetOpenPercent() > 0 {
+		cmd = "free"
+	}
+	if err := s.azc.zoneCommand(ctx, s.zone, cmd); err != nil {
+		return nil, err
+	}
+	return s.zone.openClose.GetPositions()
+}
@@ -0,0 +1,325 @@
+package gallagher
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/internal/util/rpcutil"
+	"github.com/smart-core-os/sc-bos/pkg/driver/gallagher/config"
+	"github.com/smart-core-os/sc-bos/pkg/proto/accesspb"
+	"github.com/smart-core-os/sc-bos/pkg/util/masks"
+)
+
+type hrefName struct {
+	Href string `json:"href"`
+	Name string `json:"name,omitempty"`
+}
+
+type CardPayload struct {
+	Href   string    `json:"href,omitempty"`
+	Number string    `json:"number,omitempty"`
+	Type   *hrefName `json:"type,omitempty"`
+	Status *struct {
+		Value string `json:"value"`
+		Type  string `json:"type"`
+	} `json:"status,omitempty"`
+	From  *time.Time `json:"from,omitempty"`
+	Until *time.Time `json:"until,omitempty"`
+}
+
+type AccessGroupMembership struct {
+	// Href identifies the membership, not the access group.
+	Href        string     `json:"href,omitempty"`
+	AccessGroup hrefName   `json:"accessGroup"`
+	From        *time.Time `json:"from,omitempty"`
+	Until       *time.Time `json:"until,omitempty"`
+}
+
+// CardholderDetails are the parts of a Gallagher cardholder managed via the AccessApi.
+type CardholderDetails struct {
+	Id           string                  `json:"id,omitempty"`
+	Href         string                  `json:"href,omitempty"`
+	FirstName    string                  `json:"firstName,omitempty"`
+	LastName     string                  `json:"lastName,omitempty"`
+	Description  string                  `json:"description,omitempty"`
+	Authorised   bool                    `json:"authorised"`
+	Division     *hrefName               `json:"division,omitempty"`
+	Cards        []CardPayload           `json:"cards,omitempty"`
+	AccessGroups []AccessGroupMembership `json:"accessGroups,omitempty"`
+}
+
+// cardholderServer implements the cardholder lifecycle parts of the AccessApi,
+// creating, updating, and disabling Gallagher cardholders and assigning them cards.
+type cardholderServer struct {
+	accesspb.UnimplementedAccessApiServer
+	client *Client
+	cfg    config.Cardholders
+	logger *zap.Logger
+}
+
+func newCardholderServer(client *Client, cfg *config.Cardholders, logger *zap.Logger) *cardholderServer {
+	s := &cardholderServer{client: client, logger: logger}
+	if cfg != nil {
+		s.cfg = *cfg
+	}
+	return s
+}
+
+var cardholderWritableFields = &fieldmaskpb.FieldMask{Paths: []string{
+	"first_name", "last_name", "description", "disabled", "access_groups",
+}}
+
+func (s *cardholderServer) CreateCardholder(ctx context.Context, req *accesspb.CreateCardholderRequest) (*accesspb.Cardholder, error) {
+	if s.cfg.DivisionId == "" {
+		return nil, status.Error(codes.FailedPrecondition, "cardholders.divisionId is not configured")
+	}
+	ch := req.GetCardholder()
+	if ch.GetId() != "" {
+		return nil, status.Error(codes.InvalidArgument, "id must not be set")
+	}
+	if ch.GetFirstName() == "" && ch.GetLastName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "first_name or last_name is required")
+	}
+
+	body := CardholderDetails{
+		FirstName:   ch.GetFirstName(),
+		LastName:    ch.GetLastName(),
+		Description: ch.GetDescription(),
+		Authorised:  !ch.GetDisabled(),
+		Division:    &hrefName{Href: s.client.getUrl("divisions/" + url.PathEscape(s.cfg.DivisionId))},
+	}
+	for _, group := range ch.GetAccessGroups() {
+		body.AccessGroups = append(body.AccessGroups, s.membership(group))
+	}
+	for _, card := range ch.GetCards() {
+		c, err := s.cardPayload(card)
+		if err != nil {
+			return nil, err
+		}
+		body.Cards = append(body.Cards, c)
+	}
+
+	location, err := s.client.postRequest(ctx, s.client.getUrl("cardholders"), body)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	if location == "" {
+		return nil, status.Error(codes.Internal, "gallagher didn't return the new cardholder location")
+	}
+	s.logger.Info("created cardholder", zap.String("href", location))
+	return s.getCardholder(ctx, path.Base(location), nil)
+}
+
+func (s *cardholderServer) GetCardholder(ctx context.Context, req *accesspb.GetCardholderRequest) (*accesspb.Cardholder, error) {
+	return s.getCardholder(ctx, req.GetCardholderId(), req.GetReadMask())
+}
+
+func (s *cardholderServer) UpdateCardholder(ctx context.Context, req *accesspb.UpdateCardholderRequest) (*accesspb.Cardholder, error) {
+	ch := req.GetCardholder()
+	if ch == nil {
+		return nil, status.Error(codes.InvalidArgument, "cardholder is required")
+	}
+	updater := masks.NewFieldUpdater(
+		masks.WithUpdateMask(req.GetUpdateMask()),
+		masks.WithWritableFields(cardholderWritableFields),
+	)
+	if err := updater.Validate(ch); err != nil {
+		return nil, err
+	}
+	current, err := s.getDetails(ctx, ch.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	mask := req.GetUpdateMask()
+	if mask == nil {
+		mask = cardholderWritableFields
+	}
+	body := map[string]any{}
+	if rpcutil.MaskContains(mask, "first_name") {
+		body["firstName"] = ch.GetFirstName()
+	}
+	if rpcutil.MaskContains(mask, "last_name") {
+		body["lastName"] = ch.GetLastName()
+	}
+	if rpcutil.MaskContains(mask, "description") {
+		body["description"] = ch.GetDescription()
+	}
+	if rpcutil.MaskContains(mask, "disabled") {
+		body["authorised"] = !ch.GetDisabled()
+	}
+	if rpcutil.MaskContains(mask, "access_groups") {
+		if groups := s.accessGroupChanges(current, ch.GetAccessGroups()); groups != nil {
+			body["accessGroups"] = groups
+		}
+	}
+
+	if len(body) > 0 {
+		if err := s.client.patchRequest(ctx, current.Href, body); err != nil {
+			return nil, grpcError(err)
+		}
+	}
+	return s.getCardholder(ctx, ch.GetId(), nil)
+}
+
+func (s *cardholderServer) DisableCardholder(ctx context.Context, req *accesspb.DisableCardholderRequest) (*accesspb.Cardholder, error) {
+	current, err := s.getDetails(ctx, req.GetCardholderId())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.patchRequest(ctx, current.Href, map[string]any{"authorised": false}); err != nil {
+		return nil, grpcError(err)
+	}
+	s.logger.Info("disabled cardholder", zap.String("href", current.Href))
+	return s.getCardholder(ctx, req.GetCardholderId(), nil)
+}
+
+func (s *cardholderServer) AssignCard(ctx context.Context, req *accesspb.AssignCardRequest) (*accesspb.Cardholder, error) {
+	if req.GetCard() == nil {
+		return nil, status.Error(codes.InvalidArgument, "card is required")
+	}
+	card, err := s.cardPayload(req.GetCard())
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.getDetails(ctx, req.GetCardholderId())
+	if err != nil {
+		return nil, err
+	}
+	body := map[string]any{"cards": map[string]any{"add": []CardPayload{card}}}
+	if err := s.client.patchRequest(ctx, current.Href, body); err != nil {
+		return nil, grpcError(err)
+	}
+	return s.getCardholder(ctx, req.GetCardholderId(), nil)
+}
+
+func (s *cardholderServer) getCardholder(ctx context.Context, id string, readMask *fieldmaskpb.FieldMask) (*accesspb.Cardholder, error) {
+	details, err := s.getDetails(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	ch := cardholderToProto(details)
+	masks.NewResponseFilter(masks.WithFieldMask(readMask)).Filter(ch)
+	return ch, nil
+}
+
+func (s *cardholderServer) getDetails(ctx context.Context, id string) (CardholderDetails, error) {
+	if id == "" {
+		return CardholderDetails{}, status.Error(codes.InvalidArgument, "cardholder id is required")
+	}
+	body, _, err := s.client.send(ctx, http.MethodGet, s.client.getUrl("cardholders/"+url.PathEscape(id)), nil)
+	if err != nil {
+		return CardholderDetails{}, grpcError(err)
+	}
+	var details CardholderDetails
+	if err := json.Unmarshal(body, &details); err != nil {
+		return CardholderDetails{}, status.Errorf(codes.Internal, "decode cardholder: %v", err)
+	}
+	return details, nil
+}
+
+func (s *cardholderServer) membership(accessGroupId string) AccessGroupMembership {
+	return AccessGroupMembership{AccessGroup: hrefName{Href: s.client.getUrl("access_groups/" + url.PathEscape(accessGroupId))}}
+}
+
+// accessGroupChanges returns the Gallagher patch to make the access groups of current match want,
+// or nil if they already match.
+func (s *cardholderServer) accessGroupChanges(current CardholderDetails, want []string) map[string]any {
+	wantHrefs := make(map[string]bool, len(want))
+	for _, id := range want {
+		wantHrefs[s.membership(id).AccessGroup.Href] = true
+	}
+	var add []AccessGroupMembership
+	var remove []map[string]string
+	for _, m := range current.AccessGroups {
+		if wantHrefs[m.AccessGroup.Href] {
+			delete(wantHrefs, m.AccessGroup.Href)
+			continue
+		}
+		remove = append(remove, map[string]string{"href": m.Href})
+	}
+	for _, id := range want {
+		m := s.membership(id)
+		if wantHrefs[m.AccessGroup.Href] {
+			add = append(add, m)
+			delete(wantHrefs, m.AccessGroup.Href)
+		}
+	}
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+	changes := map[string]any{}
+	if len(add) > 0 {
+		changes["add"] = add
+	}
+	if len(remove) > 0 {
+		changes["remove"] = remove
+	}
+	return changes
+}
+
+func (s *cardholderServer) cardPayload(card *accesspb.Card) (CardPayload, error) {
+	cardType := card.GetType()
+	if cardType == "" {
+		cardType = s.cfg.CardTypeId
+	}
+	if cardType == "" {
+		return CardPayload{}, status.Error(codes.InvalidArgument, "card type is required, or configure cardholders.cardTypeId")
+	}
+	c := CardPayload{
+		Number: card.GetNumber(),
+		Type:   &hrefName{Href: s.client.getUrl("card_types/" + url.PathEscape(cardType))},
+	}
+	if card.StartTime != nil {
+		t := card.StartTime.AsTime()
+		c.From = &t
+	}
+	if card.EndTime != nil {
+		t := card.EndTime.AsTime()
+		c.Until = &t
+	}
+	return c, nil
+}
+
+func cardholderToProto(details CardholderDetails) *accesspb.Cardholder {
+	ch := &accesspb.Cardholder{
+		Id:          details.Id,
+		FirstName:   details.FirstName,
+		LastName:    details.LastName,
+		Description: details.Description,
+		Disabled:    !details.Authorised,
+	}
+	for _, m := range details.AccessGroups {
+		ch.AccessGroups = append(ch.AccessGroups, path.Base(m.AccessGroup.Href))
+	}
+	for _, c := range details.Cards {
+		card := &accesspb.Card{
+			Id:     path.Base(c.Href),
+			Number: c.Number,
+		}
+		if c.Type != nil {
+			card.Type = path.Base(c.Type.Href)
+		}
+		if c.Status != nil {
+			card.State = c.Status.Type
+		}
+		if c.From != nil {
+			card.StartTime = timestamppb.New(*c.From)
+		}
+		if c.Until != nil {
+			card.EndTime = timestamppb.New(*c.Until)
+		}
+		ch.Cards = append(ch.Cards, card)
+	}
+	return ch
+}
@@ -1,14 +1,21 @@
 package gallagher
 
 import (
+	"bytes"
+	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/smart-core-os/sc-bos/pkg/task/service"
 )
 
@@ -64,32 +71,127 @@ func (c *Client) getUrl(p string) string {
 }
 
 func (c *Client) doRequest(url string) ([]byte, error) {
-	req, err := http.NewRequest("GET", url, nil)
+	body, _, err := c.send(context.Background(), http.MethodGet, url, nil)
+	return body, err
+}
+
+// postRequest POSTs body, encoded as JSON if not nil, to url.
+// Returns the Location header of the response, which Gallagher sets to the href of created items.
+func (c *Client) postRequest(ctx context.Context, url string, body any) (string, error) {
+	_, location, err := c.send(ctx, http.MethodPost, url, body)
+	return location, err
+}
+
+// patchRequest PATCHes url with body encoded as JSON.
+func (c *Client) patchRequest(ctx context.Context, url string, body any) error {
+	_, _, err := c.send(ctx, http.MethodPatch, url, body)
+	return err
+}
+
+// Command links to an action that can be performed on a Gallagher item, like opening a door.
+// Gallagher only lists the commands the REST operator has privileges for.
+type Command struct {
+	Href string `json:"href"`
+}
 
+// runCommand performs the named command from commands, returning a gRPC status error on failure.
+func (c *Client) runCommand(ctx context.Context, commands map[string]Command, name string) error {
+	cmd, ok := commands[name]
+	if !ok || cmd.Href == "" {
+		return status.Errorf(codes.FailedPrecondition, "%s command not available, check the REST operator privileges", name)
+	}
+	_, err := c.postRequest(ctx, cmd.Href, nil)
+	return grpcError(err)
+}
+
+func (c *Client) send(ctx context.Context, method, url string, body any) ([]byte, string, error) {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, "", err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	req.Header.Set("Authorization", "GGL-API-KEY "+c.ApiKey)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
 		service.UpdateSystemCheck(c.systemCheck, err)
-		return nil, err
+		return nil, "", err
 	}
 	defer resp.Body.Close()
 
-	bytes, err := io.ReadAll(resp.Body)
+	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
 		service.UpdateSystemCheck(c.systemCheck, err)
-		return nil, err
+		return nil, "", err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		err = fmt.Errorf("response status: %d %s", resp.StatusCode, resp.Status)
-		service.UpdateSystemCheck(c.systemCheck, err)
-		return nil, err
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		err := &statusError{StatusCode: resp.StatusCode, Status: resp.Status, Message: errorMessage(respBody)}
+		// Gallagher rejecting a command or update, say for a bad card number, doesn't mean it's unhealthy
+		if method == http.MethodGet || resp.StatusCode >= 500 || resp.StatusCode == http.StatusUnauthorized {
+			service.UpdateSystemCheck(c.systemCheck, err)
+		}
+		return nil, "", err
 	}
 	service.UpdateSystemCheck(c.systemCheck, nil)
-	return bytes, nil
+	return respBody, resp.Header.Get("Location"), nil
+}
+
+// statusError is returned when the Gallagher API responds with a non-2xx status.
+type statusError struct {
+	StatusCode int
+	Status     string
+	Message    string
+}
+
+func (e *statusError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("response status: %s: %s", e.Status, e.Message)
+	}
+	return fmt.Sprintf("response status: %s", e.Status)
+}
+
+// errorMessage returns the message from a Gallagher error response body, if there is one.
+func errorMessage(body []byte) string {
+	var res struct {
+		Message string `json:"message"`
+	}
+	_ = json.Unmarshal(body, &res)
+	return res.Message
+}
+
+// grpcError converts errors from the Gallagher API into gRPC status errors.
+func grpcError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var se *statusError
+	if !errors.As(err, &se) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	switch se.StatusCode {
+	case http.StatusBadRequest:
+		return status.Error(codes.InvalidArgument, se.Error())
+	case http.StatusUnauthorized:
+		return status.Error(codes.Unauthenticated, se.Error())
+	case http.StatusForbidden:
+		return status.Error(codes.PermissionDenied, se.Error())
+	case http.StatusNotFound:
+		return status.Error(codes.NotFound, se.Error())
+	case http.StatusConflict:
+		return status.Error(codes.AlreadyExists, se.Error())
+	default:
+		return status.Error(codes.Unavailable, se.Error())
+	}
 }
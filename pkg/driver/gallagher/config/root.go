@@ -31,6 +31,8 @@ type Root struct {
 	RefreshAlarms *jsontypes.Schedule `json:"refreshAlerts,omitempty"`
 	// poll the doors on this schedule, defaults to once per day
 	RefreshDoors *jsontypes.Schedule `json:"refreshDoors,omitempty"`
+	// poll the status of known doors, whether they are open or locked, on this schedule, defaults to once per minute
+	RefreshDoorStatus *jsontypes.Schedule `json:"refreshDoorStatus,omitempty"`
 	// poll the access zones API for updates on this schedule, defaults to once per minute
 	RefreshAccessZones *jsontypes.Schedule `json:"refreshAccessZones,omitempty"`
 	UdmiExportInterval jsontypes.Duration  `json:"udmiExportInterval"`
@@ -41,6 +43,16 @@ type Root struct {
 	// number of security events to store, defaults to 200 if not set
 	NumSecurityEvents     int  `json:"numSecurityEvents,omitempty"`
 	OccupancyCountEnabled bool `json:"occupancyCountEnabled,omitempty"`
+
+	// Cardholders configures creating cardholders and assigning cards via the AccessApi.
+	Cardholders *Cardholders `json:"cardholders,omitempty"`
+}
+
+type Cardholders struct {
+	// DivisionId is the id of the Gallagher division new cardholders are created in, required to create cardholders.
+	DivisionId string `json:"divisionId,omitempty"`
+	// CardTypeId is the id of the Gallagher card type used when assigning cards that don't specify a type.
+	CardTypeId string `json:"cardTypeId,omitempty"`
 }
 
 type HTTP struct {
@@ -89,6 +101,10 @@ func (cfg *Root) ApplyDefaults() {
 		cfg.RefreshDoors = jsontypes.MustParseSchedule("0 0 * * *")
 	}
 
+	if cfg.RefreshDoorStatus == nil {
+		cfg.RefreshDoorStatus = jsontypes.MustParseSchedule("* * * * *")
+	}
+
 	if cfg.RefreshAccessZones == nil {
 		cfg.RefreshAccessZones = jsontypes.MustParseSchedule("* * * * *")
 	}
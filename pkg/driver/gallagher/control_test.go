@@ -0,0 +1,382 @@
+package gallagher
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/gallagher/config"
+	"github.com/smart-core-os/sc-bos/pkg/proto/accesspb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/lockunlockpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/openclosepb"
+	"github.com/smart-core-os/sc-bos/pkg/resource"
+)
+
+func TestDoorControl(t *testing.T) {
+	gal := newFakeGallagher(t)
+	dc := newDoorController(gal.client, "", zap.NewNop())
+	door := newDoor(DoorPayload{Id: "10", Href: gal.url("doors/10")})
+	door.setPayload(dc.getDoorDetails(door.DoorPayload))
+
+	lu := &doorLockUnlock{LockUnlockApiServer: lockunlockpb.NewModelServer(door.lockUnlock), door: door, dc: dc}
+	got, err := lu.GetLockUnlock(context.Background(), &lockunlockpb.GetLockUnlockRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Position != lockunlockpb.LockUnlock_LOCKED {
+		t.Fatalf("initial position = %v, want LOCKED", got.Position)
+	}
+
+	got, err = lu.UpdateLockUnlock(context.Background(), &lockunlockpb.UpdateLockUnlockRequest{
+		LockUnlock: &lockunlockpb.LockUnlock{Position: lockunlockpb.LockUnlock_UNLOCKED},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Position != lockunlockpb.LockUnlock_UNLOCKED {
+		t.Errorf("position = %v, want UNLOCKED", got.Position)
+	}
+	gal.wantCommands(t, "POST /api/doors/10/open")
+
+	_, err = lu.UpdateLockUnlock(context.Background(), &lockunlockpb.UpdateLockUnlockRequest{
+		LockUnlock: &lockunlockpb.LockUnlock{Position: lockunlockpb.LockUnlock_LOCKED},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("locking: got %v, want InvalidArgument", err)
+	}
+
+	oc := &doorOpenClose{OpenCloseApiServer: openclosepb.NewModelServer(door.openClose), door: door, dc: dc}
+	_, err = oc.UpdatePositions(context.Background(), &openclosepb.UpdateOpenClosePositionsRequest{
+		States: &openclosepb.OpenClosePositions{States: []*openclosepb.OpenClosePosition{{OpenPercent: 100}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	gal.wantCommands(t, "POST /api/doors/10/open")
+
+	// the operator has no privileges to open this door
+	door.setPayload(DoorPayload{Id: "11", Href: gal.url("doors/11")})
+	_, err = lu.UpdateLockUnlock(context.Background(), &lockunlockpb.UpdateLockUnlockRequest{
+		LockUnlock: &lockunlockpb.LockUnlock{Position: lockunlockpb.LockUnlock_UNLOCKED},
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("no open command: got %v, want FailedPrecondition", err)
+	}
+}
+
+func TestAccessZoneControl(t *testing.T) {
+	gal := newFakeGallagher(t)
+	azc := newAccessZoneController(gal.client, newCardholderController(gal.client, "", zap.NewNop()), zap.NewNop())
+	zone := &AccessZone{
+		AccessZonePayload: AccessZonePayload{Id: "20", Href: gal.url("access_zones/20")},
+		lockUnlock:        lockunlockpb.NewModel(),
+		openClose:         openclosepb.NewModel(),
+	}
+	zone.lastAccessAttempt = resource.NewValue(resource.WithInitialValue(&accesspb.AccessAttempt{}))
+	azc.getAccessZoneDetails(zone)
+
+	lu := &zoneLockUnlock{LockUnlockApiServer: lockunlockpb.NewModelServer(zone.lockUnlock), zone: zone, azc: azc}
+	got, err := lu.UpdateLockUnlock(context.Background(), &lockunlockpb.UpdateLockUnlockRequest{
+		LockUnlock: &lockunlockpb.LockUnlock{Position: lockunlockpb.LockUnlock_LOCKED},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Position != lockunlockpb.LockUnlock_LOCKED {
+		t.Errorf("lockdown position = %v, want LOCKED", got.Position)
+	}
+	_, err = lu.UpdateLockUnlock(context.Background(), &lockunlockpb.UpdateLockUnlockRequest{
+		LockUnlock: &lockunlockpb.LockUnlock{Position: lockunlockpb.LockUnlock_UNLOCKED},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	gal.wantCommands(t, "POST /api/access_zones/20/lockDown", "POST /api/access_zones/20/cancelLockDown")
+
+	oc := &zoneOpenClose{OpenCloseApiServer: openclosepb.NewModelServer(zone.openClose), zone: zone, azc: azc}
+	res, err := oc.UpdatePositions(context.Background(), &openclosepb.UpdateOpenClosePositionsRequest{
+		States: &openclosepb.OpenClosePositions{States: []*openclosepb.OpenClosePosition{{OpenPercent: 100}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.States[0].OpenPercent != 100 {
+		t.Errorf("free access open percent = %v, want 100", res.States[0].OpenPercent)
+	}
+	_, err = oc.UpdatePositions(context.Background(), &openclosepb.UpdateOpenClosePositionsRequest{
+		States: &openclosepb.OpenClosePositions{States: []*openclosepb.OpenClosePosition{{OpenPercent: 0}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	gal.wantCommands(t, "POST /api/access_zones/20/free", "POST /api/access_zones/20/cancel")
+}
+
+func TestCardholderLifecycle(t *testing.T) {
+	ctx := context.Background()
+	gal := newFakeGallagher(t)
+	s := newCardholderServer(gal.client, &config.Cardholders{DivisionId: "2", CardTypeId: "600"}, zap.NewNop())
+
+	created, err := s.CreateCardholder(ctx, &accesspb.CreateCardholderRequest{Cardholder: &accesspb.Cardholder{
+		FirstName:    "Ada",
+		LastName:     "Lovelace",
+		Description:  "Acme Ltd",
+		AccessGroups: []string{"100", "101"},
+		Cards:        []*accesspb.Card{{Number: "1234"}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &accesspb.Cardholder{
+		Id:           "1",
+		FirstName:    "Ada",
+		LastName:     "Lovelace",
+		Description:  "Acme Ltd",
+		AccessGroups: []string{"100", "101"},
+		Cards:        []*accesspb.Card{{Id: "c3", Number: "1234", Type: "600", State: "active"}},
+	}
+	if diff := cmp.Diff(want, created, protocmp.Transform()); diff != "" {
+		t.Fatalf("created (-want,+got)\n%s", diff)
+	}
+	if div := gal.cardholders["1"].Division; div == nil || div.Href != gal.url("divisions/2") {
+		t.Errorf("division = %+v", div)
+	}
+
+	updated, err := s.UpdateCardholder(ctx, &accesspb.UpdateCardholderRequest{
+		Cardholder: &accesspb.Cardholder{Id: "1", LastName: "King", AccessGroups: []string{"101", "102"}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"last_name", "access_groups"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.FirstName != "Ada" || updated.LastName != "King" {
+		t.Errorf("name = %q %q, want Ada King", updated.FirstName, updated.LastName)
+	}
+	if diff := cmp.Diff([]string{"101", "102"}, updated.AccessGroups); diff != "" {
+		t.Errorf("access groups (-want,+got)\n%s", diff)
+	}
+
+	_, err = s.UpdateCardholder(ctx, &accesspb.UpdateCardholderRequest{
+		Cardholder: &accesspb.Cardholder{Id: "1"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"cards"}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("updating cards: got %v, want InvalidArgument", err)
+	}
+
+	assigned, err := s.AssignCard(ctx, &accesspb.AssignCardRequest{CardholderId: "1", Card: &accesspb.Card{Number: "5678", Type: "601"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assigned.Cards) != 2 || assigned.Cards[1].Number != "5678" || assigned.Cards[1].Type != "601" {
+		t.Errorf("cards = %v", assigned.Cards)
+	}
+
+	disabled, err := s.DisableCardholder(ctx, &accesspb.DisableCardholderRequest{CardholderId: "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !disabled.Disabled {
+		t.Error("cardholder not disabled")
+	}
+
+	_, err = s.GetCardholder(ctx, &accesspb.GetCardholderRequest{CardholderId: "404"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("unknown cardholder: got %v, want NotFound", err)
+	}
+}
+
+func TestCardholderLifecycle_notConfigured(t *testing.T) {
+	gal := newFakeGallagher(t)
+	s := newCardholderServer(gal.client, nil, zap.NewNop())
+	_, err := s.CreateCardholder(context.Background(), &accesspb.CreateCardholderRequest{Cardholder: &accesspb.Cardholder{FirstName: "Ada"}})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("create: got %v, want FailedPrecondition", err)
+	}
+}
+
+// fakeGallagher is a minimal Gallagher REST API for the doors, access zones, and cardholders used in tests.
+type fakeGallagher struct {
+	t      *testing.T
+	srv    *httptest.Server
+	client *Client
+
+	mu          sync.Mutex
+	doorFlags   []string
+	zoneFlags   []string
+	commands    []string
+	cardholders map[string]*CardholderDetails
+	nextId      int // of cardholders
+	nextItemId  int // of cards and access group memberships
+}
+
+func newFakeGallagher(t *testing.T) *fakeGallagher {
+	f := &fakeGallagher{
+		t:           t,
+		doorFlags:   []string{"closed", "locked"},
+		zoneFlags:   []string{"secure"},
+		cardholders: map[string]*CardholderDetails{},
+	}
+	f.srv = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	t.Cleanup(f.srv.Close)
+	f.client = &Client{BaseURL: f.srv.URL + "/api", HTTPClient: f.srv.Client()}
+	return f
+}
+
+func (f *fakeGallagher) url(p string) string {
+	return f.srv.URL + "/api/" + p
+}
+
+func (f *fakeGallagher) wantCommands(t *testing.T, want ...string) {
+	t.Helper()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if diff := cmp.Diff(want, f.commands); diff != "" {
+		t.Errorf("commands (-want,+got)\n%s", diff)
+	}
+	f.commands = nil
+}
+
+func (f *fakeGallagher) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	p := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/"), "/")
+	switch {
+	case r.Method == http.MethodGet && len(p) == 2 && p[0] == "doors":
+		door := DoorPayload{Id: p[1], Href: f.url("doors/" + p[1]), StatusFlags: f.doorFlags}
+		if p[1] == "10" {
+			door.Commands = map[string]Command{"open": {Href: f.url("doors/10/open")}}
+		}
+		f.writeJSON(w, door)
+	case r.Method == http.MethodPost && len(p) == 3 && p[0] == "doors" && p[2] == "open":
+		f.commands = append(f.commands, r.Method+" "+r.URL.Path)
+		f.doorFlags = []string{"closed", "unlocked"}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet && len(p) == 2 && p[0] == "access_zones":
+		zone := AccessZonePayload{Id: p[1], Href: f.url("access_zones/" + p[1]), StatusFlags: f.zoneFlags, Commands: map[string]Command{}}
+		for _, cmd := range []string{"free", "secure", "lockDown", "cancelLockDown", "cancel"} {
+			zone.Commands[cmd] = Command{Href: f.url("access_zones/" + p[1] + "/" + cmd)}
+		}
+		f.writeJSON(w, zone)
+	case r.Method == http.MethodPost && len(p) == 3 && p[0] == "access_zones":
+		f.commands = append(f.commands, r.Method+" "+r.URL.Path)
+		switch p[2] {
+		case "lockDown":
+			f.zoneFlags = []string{"lockDown"}
+		case "free":
+			f.zoneFlags = []string{"free"}
+		default:
+			f.zoneFlags = []string{"secure"}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && len(p) == 1 && p[0] == "cardholders":
+		var ch CardholderDetails
+		if !f.readJSON(w, r, &ch) {
+			return
+		}
+		f.nextId++
+		ch.Id = strconv.Itoa(f.nextId)
+		ch.Href = f.url("cardholders/" + ch.Id)
+		ch.AccessGroups = f.memberships(ch.Id, nil, ch.AccessGroups)
+		ch.Cards = f.cards(ch.Id, nil, ch.Cards)
+		f.cardholders[ch.Id] = &ch
+		w.Header().Set("Location", ch.Href)
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodGet && len(p) == 2 && p[0] == "cardholders":
+		ch, ok := f.cardholders[p[1]]
+		if !ok {
+			http.Error(w, `{"message": "not found"}`, http.StatusNotFound)
+			return
+		}
+		f.writeJSON(w, ch)
+	case r.Method == http.MethodPatch && len(p) == 2 && p[0] == "cardholders":
+		ch, ok := f.cardholders[p[1]]
+		if !ok {
+			http.Error(w, `{"message": "not found"}`, http.StatusNotFound)
+			return
+		}
+		var patch struct {
+			LastName     *string `json:"lastName"`
+			Authorised   *bool   `json:"authorised"`
+			AccessGroups struct {
+				Add    []AccessGroupMembership `json:"add"`
+				Remove []struct {
+					Href string `json:"href"`
+				} `json:"remove"`
+			} `json:"accessGroups"`
+			Cards struct {
+				Add []CardPayload `json:"add"`
+			} `json:"cards"`
+		}
+		if !f.readJSON(w, r, &patch) {
+			return
+		}
+		if patch.LastName != nil {
+			ch.LastName = *patch.LastName
+		}
+		if patch.Authorised != nil {
+			ch.Authorised = *patch.Authorised
+		}
+		ch.AccessGroups = slices.DeleteFunc(ch.AccessGroups, func(m AccessGroupMembership) bool {
+			return slices.ContainsFunc(patch.AccessGroups.Remove, func(r struct {
+				Href string `json:"href"`
+			}) bool {
+				return r.Href == m.Href
+			})
+		})
+		ch.AccessGroups = f.memberships(ch.Id, ch.AccessGroups, patch.AccessGroups.Add)
+		ch.Cards = f.cards(ch.Id, ch.Cards, patch.Cards.Add)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		f.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		http.Error(w, "not found", http.StatusNotFound)
+	}
+}
+
+func (f *fakeGallagher) memberships(cardholderId string, existing, add []AccessGroupMembership) []AccessGroupMembership {
+	for _, m := range add {
+		f.nextItemId++
+		m.Href = f.url("cardholders/" + cardholderId + "/access_groups/m" + strconv.Itoa(f.nextItemId))
+		existing = append(existing, m)
+	}
+	return existing
+}
+
+func (f *fakeGallagher) cards(cardholderId string, existing, add []CardPayload) []CardPayload {
+	for _, c := range add {
+		f.nextItemId++
+		c.Href = f.url("cardholders/" + cardholderId + "/cards/c" + strconv.Itoa(f.nextItemId))
+		c.Status = &struct {
+			Value string `json:"value"`
+			Type  string `json:"type"`
+		}{Value: "Active", Type: "active"}
+		existing = append(existing, c)
+	}
+	return existing
+}
+
+func (f *fakeGallagher) readJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func (f *fakeGallagher) writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
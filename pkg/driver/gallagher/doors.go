@@ -4,13 +4,20 @@ import (
 	"context"
 	"encoding/json"
 	"path"
+	"slices"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/smart-core-os/sc-bos/pkg/driver/gallagher/config"
 	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/proto/lockunlockpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/openclosepb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
 	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
 )
 
@@ -28,21 +35,81 @@ type DoorUdmiInfo struct {
 }
 
 type DoorPayload struct {
-	Id          string `json:"id"`
-	Href        string `json:"href"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+	Id          string             `json:"id"`
+	Href        string             `json:"href"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	StatusFlags []string           `json:"statusFlags,omitempty"`
+	Status      string             `json:"status,omitempty"`
+	Commands    map[string]Command `json:"commands,omitempty"`
 }
 
 type Door struct {
 	config.ScDevice
 	DoorPayload
 	Undo []node.Undo
+
+	mu         sync.Mutex // guards DoorPayload after the door is announced
+	lockUnlock *lockunlockpb.Model
+	openClose  *openclosepb.Model
+}
+
+func newDoor(payload DoorPayload) *Door {
+	return &Door{
+		DoorPayload: payload,
+		lockUnlock:  lockunlockpb.NewModel(),
+		openClose:   openclosepb.NewModel(),
+	}
+}
+
+// setPayload updates the door and its trait models with the latest details from Gallagher.
+func (d *Door) setPayload(payload DoorPayload) {
+	d.mu.Lock()
+	d.DoorPayload = payload
+	d.mu.Unlock()
+
+	_, _ = d.lockUnlock.UpdateLockUnlock(&lockunlockpb.LockUnlock{Position: doorLockPosition(payload.StatusFlags)})
+	if open, ok := doorOpenPercent(payload.StatusFlags); ok {
+		_, _ = d.openClose.UpdatePositions(&openclosepb.OpenClosePositions{
+			States: []*openclosepb.OpenClosePosition{{OpenPercent: open}},
+		})
+	}
+}
+
+func (d *Door) payload() DoorPayload {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.DoorPayload
+}
+
+// doorLockPosition maps Gallagher door status flags to a lock position.
+func doorLockPosition(flags []string) lockunlockpb.LockUnlock_Position {
+	switch {
+	case slices.Contains(flags, "unlocked"):
+		return lockunlockpb.LockUnlock_UNLOCKED
+	case slices.Contains(flags, "locked"):
+		return lockunlockpb.LockUnlock_LOCKED
+	default:
+		return lockunlockpb.LockUnlock_POSITION_UNSPECIFIED
+	}
+}
+
+// doorOpenPercent maps Gallagher door status flags to an open percent, returning false if the door doesn't report it.
+func doorOpenPercent(flags []string) (float32, bool) {
+	switch {
+	case slices.Contains(flags, "open"):
+		return 100, true
+	case slices.Contains(flags, "closed"):
+		return 0, true
+	default:
+		return 0, false
+	}
 }
 
 type DoorController struct {
 	client      *Client
 	topicPrefix string
+	mu          sync.Mutex // guards doors
 	doors       map[string]*Door
 	logger      *zap.Logger
 }
@@ -75,10 +142,7 @@ func (dc *DoorController) getDoors() (map[string]*Door, error) {
 		}
 
 		for _, door := range resultsList.Results {
-			result[door.Id] = &Door{
-				DoorPayload: door,
-			}
-			dc.getDoorDetails(result[door.Id])
+			result[door.Id] = newDoor(dc.getDoorDetails(door))
 		}
 
 		if resultsList.Next == nil || resultsList.Next.Href == "" {
@@ -91,19 +155,22 @@ func (dc *DoorController) getDoors() (map[string]*Door, error) {
 	return result, nil
 }
 
-// getDoorDetails gets the full details for each door
-func (dc *DoorController) getDoorDetails(door *Door) {
+// getDoorDetails gets the full details for door, returning door as is if they can't be fetched.
+func (dc *DoorController) getDoorDetails(door DoorPayload) DoorPayload {
 
 	resp, err := dc.client.doRequest(door.Href)
 	if err != nil {
 		dc.logger.Error("failed to get door", zap.Error(err))
-		return
+		return door
 	}
 
-	err = json.Unmarshal(resp, door)
+	details := door
+	err = json.Unmarshal(resp, &details)
 	if err != nil {
 		dc.logger.Error("failed to decode door", zap.Error(err))
+		return door
 	}
+	return details
 }
 
 // refreshDoors get the list of doors and compare it to the previous list. Announce any new doors
@@ -115,9 +182,13 @@ func (dc *DoorController) refreshDoors(announcer node.Announcer, scNamePrefix st
 		return err
 	}
 
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
 	// look for new doors, add & announce them
 	for id, d := range doors {
-		if _, ok := dc.doors[id]; !ok {
+		if existing, ok := dc.doors[id]; ok {
+			existing.setPayload(d.DoorPayload)
+		} else {
 
 			d.ScName = path.Join(scNamePrefix, "doors", d.Id)
 			d.Meta = &metadatapb.Metadata{
@@ -130,7 +201,24 @@ func (dc *DoorController) refreshDoors(announcer node.Announcer, scNamePrefix st
 				},
 			}
 
+			d.setPayload(d.DoorPayload)
 			d.Undo = append(d.Undo, announcer.Announce(d.ScName, node.HasMetadata(d.Meta), node.HasDeviceType(metadatapb.Metadata_DEVICE)))
+			d.Undo = append(d.Undo, announcer.Announce(d.ScName,
+				node.HasServer(lockunlockpb.RegisterLockUnlockApiServer, lockunlockpb.LockUnlockApiServer(&doorLockUnlock{
+					LockUnlockApiServer: lockunlockpb.NewModelServer(d.lockUnlock),
+					door:                d,
+					dc:                  dc,
+				})),
+				node.HasTrait(trait.LockUnlock),
+			))
+			d.Undo = append(d.Undo, announcer.Announce(d.ScName,
+				node.HasServer(openclosepb.RegisterOpenCloseApiServer, openclosepb.OpenCloseApiServer(&doorOpenClose{
+					OpenCloseApiServer: openclosepb.NewModelServer(d.openClose),
+					door:               d,
+					dc:                 dc,
+				})),
+				node.HasTrait(trait.OpenClose),
+			))
 			dc.doors[id] = d
 		}
 	}
@@ -167,3 +255,73 @@ func (dc *DoorController) run(ctx context.Context, schedule *jsontypes.Schedule,
 		}
 	}
 }
+
+// refreshDoorStatus updates the status of all known doors.
+func (dc *DoorController) refreshDoorStatus() {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	for _, d := range dc.doors {
+		d.setPayload(dc.getDoorDetails(d.payload()))
+	}
+}
+
+// runStatus refreshes the status of the doors on a schedule.
+func (dc *DoorController) runStatus(ctx context.Context, schedule *jsontypes.Schedule) error {
+	t := time.Now()
+	for {
+		next := schedule.Next(t)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(time.Until(next)):
+			t = next
+		}
+		dc.refreshDoorStatus()
+	}
+}
+
+// openDoor momentarily unlocks door, for the unlock time configured in Gallagher, then refreshes its status.
+func (dc *DoorController) openDoor(ctx context.Context, door *Door) error {
+	if err := dc.client.runCommand(ctx, door.payload().Commands, "open"); err != nil {
+		return err
+	}
+	door.setPayload(dc.getDoorDetails(door.payload()))
+	return nil
+}
+
+// doorLockUnlock implements the LockUnlock trait for a door.
+// Unlocking the door is momentary, the door locks again by itself so it can't be locked.
+type doorLockUnlock struct {
+	lockunlockpb.LockUnlockApiServer
+	door *Door
+	dc   *DoorController
+}
+
+func (s *doorLockUnlock) UpdateLockUnlock(ctx context.Context, req *lockunlockpb.UpdateLockUnlockRequest) (*lockunlockpb.LockUnlock, error) {
+	if req.GetLockUnlock().GetPosition() != lockunlockpb.LockUnlock_UNLOCKED {
+		return nil, status.Error(codes.InvalidArgument, "only UNLOCKED is supported, doors lock again by themselves")
+	}
+	if err := s.dc.openDoor(ctx, s.door); err != nil {
+		return nil, err
+	}
+	return s.door.lockUnlock.GetLockUnlock()
+}
+
+// doorOpenClose implements the OpenClose trait for a door.
+// Opening the door momentarily unlocks it so it can be opened, doors can't be closed.
+type doorOpenClose struct {
+	openclosepb.OpenCloseApiServer
+	door *Door
+	dc   *DoorController
+}
+
+func (s *doorOpenClose) UpdatePositions(ctx context.Context, req *openclosepb.UpdateOpenClosePositionsRequest) (*openclosepb.OpenClosePositions, error) {
+	states := req.GetStates().GetStates()
+	if len(states) != 1 || states[0].GetOpenPercent() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "only opening is supported, doors close by themselves")
+	}
+	if err := s.dc.openDoor(ctx, s.door); err != nil {
+		return nil, err
+	}
+	return s.door.openClose.GetPositions()
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/smart-core-os/sc-bos/pkg/driver"
 	"github.com/smart-core-os/sc-bos/pkg/driver/gallagher/config"
 	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/proto/accesspb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/occupancysensorpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/securityeventpb"
@@ -83,6 +84,9 @@ func (d *Driver) applyConfig(ctx context.Context, cfg config.Root) error {
 	grp.Go(func() error {
 		return dc.run(ctx, cfg.RefreshDoors, announcer, cfg.ScNamePrefix)
 	})
+	grp.Go(func() error {
+		return dc.runStatus(ctx, cfg.RefreshDoorStatus)
+	})
 
 	azc := newAccessZoneController(client, cc, d.logger)
 	_ = azc.refreshAccessZones(announcer, cfg.ScNamePrefix) // blocking initial fetch
@@ -100,6 +104,12 @@ func (d *Driver) applyConfig(ctx context.Context, cfg config.Root) error {
 		return sc.run(ctx, cfg.RefreshAlarms)
 	})
 
+	chs := newCardholderServer(client, cfg.Cardholders, d.logger)
+	announcer.Announce(cfg.ScNamePrefix,
+		node.HasServer(accesspb.RegisterAccessApiServer, accesspb.AccessApiServer(chs)),
+		node.HasTrait(accesspb.TraitName),
+	)
+
 	if cfg.OccupancyCountEnabled {
 		occupancyCtrl := newOccupancyEventController(client, d.logger, cfg.RefreshOccupancyInterval.Or(defaultOccupancyRefreshInterval))
 		announcer.Announce(path.Join(cfg.ScNamePrefix, "occupancy"),
@@ -27,6 +27,16 @@ func changeDeviceLevel(addr string, level int) string {
 	return fmt.Sprintf(">V:1,C:14,@%s,L:%d#", addr, level)
 }
 
+// store a level as a scene for a group, the O:1 force store flag overwrites any existing level for the scene.
+func storeGroupScene(group int, block string, scene string, level int) string {
+	return fmt.Sprintf(">V:1,C:201,O:1,G:%d,B:%s,S:%s,L:%d#", group, block, scene, level)
+}
+
+// store a level as a scene for a load (channel), the O:1 force store flag overwrites any existing level for the scene.
+func storeDeviceScene(addr string, block string, scene string, level int) string {
+	return fmt.Sprintf(">V:1,C:202,O:1,@%s,B:%s,S:%s,L:%d#", addr, block, scene, level)
+}
+
 // query last scene in group
 func queryLastSceneInGroup(group int) string {
 	return fmt.Sprintf(">V:2,C:109,G:%d#", group)
@@ -74,6 +84,24 @@ func deviceEmergencyDurationTest(addr string) string {
 	return fmt.Sprintf(">V:1,C:22,@%s#", addr)
 }
 
+// Emergency Function Test (Group)
+// Request an Emergency Function Test to all emergency lighting ballasts in a group.
+func groupEmergencyFunctionTest(group int) string {
+	return fmt.Sprintf(">V:1,C:19,G:%d#", group)
+}
+
+// Emergency Duration Test (Group)
+// Request an Emergency Duration Test to all emergency lighting ballasts in a group.
+func groupEmergencyDurationTest(group int) string {
+	return fmt.Sprintf(">V:1,C:21,G:%d#", group)
+}
+
+// Stop Emergency Tests (Group)
+// Stop any Emergency Tests running in the emergency ballasts of a group.
+func groupStopEmergencyTests(group int) string {
+	return fmt.Sprintf(">V:1,C:23,G:%d#", group)
+}
+
 // Stop Emergency Tests (Device)
 // Stop any Emergency Test running in an emergency ballast.
 func deviceStopEmergencyTests(addr string) string {
@@ -75,14 +75,19 @@ type Root struct {
 // Meta contains additional metadata for the device.
 // DurationTestLength is the length of the duration test for emergency lights, if known.
 // TopicPrefix is the topic prefix to use for the UDMI automation, without the trailing '/'. If empty, the device name will be used.
+// Scenes are scenes available to recall or store via the Mode trait, in addition to any named scenes the router reports for groups.
+// FunctionTestSchedule and DurationTestSchedule are cron schedules to run emergency tests on, for emergency lights and lighting groups.
 type Device struct {
-	Name               string               `json:"name,omitempty"`
-	Address            string               `json:"address,omitempty"`
-	GroupNumber        *int                 `json:"groupNumber,omitempty"`
-	IpAddress          string               `json:"ipAddress,omitempty"`
-	Meta               *metadatapb.Metadata `json:"meta,omitempty"`
-	DurationTestLength *jsontypes.Duration  `json:"durationTestLength,omitempty,omitzero"`
-	TopicPrefix        string               `json:"topicPrefix,omitempty"`
+	Name                 string               `json:"name,omitempty"`
+	Address              string               `json:"address,omitempty"`
+	GroupNumber          *int                 `json:"groupNumber,omitempty"`
+	IpAddress            string               `json:"ipAddress,omitempty"`
+	Meta                 *metadatapb.Metadata `json:"meta,omitempty"`
+	DurationTestLength   *jsontypes.Duration  `json:"durationTestLength,omitempty,omitzero"`
+	TopicPrefix          string               `json:"topicPrefix,omitempty"`
+	Scenes               []Scene              `json:"scenes,omitempty"`
+	FunctionTestSchedule *jsontypes.Schedule  `json:"functionTestSchedule,omitempty"`
+	DurationTestSchedule *jsontypes.Schedule  `json:"durationTestSchedule,omitempty"`
 }
 
 // Scene represents a HelvarNet lighting scene, which is a combination of a block (address), scene, and title.
@@ -17,6 +17,7 @@ import (
 	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/lightpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/modepb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/occupancysensorpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/udmipb"
 	"github.com/smart-core-os/sc-bos/pkg/task/service"
@@ -122,12 +123,21 @@ func (d *Driver) applyConfig(ctx context.Context, cfg config.Root) error {
 		if err != nil {
 			d.logger.Error("getSceneNames error", zap.Error(err))
 		}
+		groupTests := &groupEmergencyTests{lg: lightingGroup}
 		rootAnnouncer.Announce(l.Name,
 			node.HasServer(lightpb.RegisterLightApiServer, lightpb.LightApiServer(lightingGroup)),
 			node.HasServer(lightpb.RegisterLightInfoServer, lightpb.LightInfoServer(lightingGroup)),
 			node.HasTrait(trait.Light),
+			node.HasServer(modepb.RegisterModeApiServer, modepb.ModeApiServer(lightingGroup.modes)),
+			node.HasServer(modepb.RegisterModeInfoServer, modepb.ModeInfoServer(lightingGroup.modes)),
+			node.HasTrait(trait.Mode),
+			// groups can start tests but results are per device, so the group doesn't have the emergency light trait
+			node.HasServer(emergencylightpb.RegisterEmergencyLightApiServer, emergencylightpb.EmergencyLightApiServer(groupTests)),
 			node.HasMetadata(l.Meta),
 			node.HasDeviceType(metadatapb.Metadata_GROUP))
+		grp.Go(func() error {
+			return runTestSchedules(ctx, l.FunctionTestSchedule, l.DurationTestSchedule, groupTests, d.logger.With(zap.String("name", l.Name)))
+		})
 	}
 
 	for _, l := range cfg.Lights {
@@ -150,6 +160,9 @@ func (d *Driver) applyConfig(ctx context.Context, cfg config.Root) error {
 		rootAnnouncer.Announce(l.Name,
 			node.HasServer(lightpb.RegisterLightApiServer, lightpb.LightApiServer(lum)),
 			node.HasTrait(trait.Light),
+			node.HasServer(modepb.RegisterModeApiServer, modepb.ModeApiServer(lum.modes)),
+			node.HasServer(modepb.RegisterModeInfoServer, modepb.ModeInfoServer(lum.modes)),
+			node.HasTrait(trait.Mode),
 			node.HasServer(udmipb.RegisterUdmiServiceServer, udmipb.UdmiServiceServer(lum)),
 			node.HasTrait(udmipb.TraitName),
 			node.HasMetadata(l.Meta),
@@ -204,6 +217,9 @@ func (d *Driver) applyConfig(ctx context.Context, cfg config.Root) error {
 		rootAnnouncer.Announce(em.Name,
 			node.HasServer(lightpb.RegisterLightApiServer, lightpb.LightApiServer(emergencyLight)),
 			node.HasTrait(trait.Light),
+			node.HasServer(modepb.RegisterModeApiServer, modepb.ModeApiServer(emergencyLight.modes)),
+			node.HasServer(modepb.RegisterModeInfoServer, modepb.ModeInfoServer(emergencyLight.modes)),
+			node.HasTrait(trait.Mode),
 			node.HasServer(emergencylightpb.RegisterEmergencyLightApiServer, emergencylightpb.EmergencyLightApiServer(emergencyLight)),
 			node.HasTrait(emergencylightpb.TraitName),
 			node.HasServer(udmipb.RegisterUdmiServiceServer, udmipb.UdmiServiceServer(emergencyLight)),
@@ -213,6 +229,9 @@ func (d *Driver) applyConfig(ctx context.Context, cfg config.Root) error {
 		grp.Go(func() error {
 			return emergencyLight.queryDevice(ctx, cfg.RefreshStatus.Duration, faultCheck, ctrlHealth)
 		})
+		grp.Go(func() error {
+			return runTestSchedules(ctx, em.FunctionTestSchedule, em.DurationTestSchedule, emergencyLight, d.logger.With(zap.String("name", em.Name)))
+		})
 	}
 
 	go func() {
@@ -0,0 +1,112 @@
+package helvarnet
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/emergencylightpb"
+	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
+)
+
+// groupEmergencyTests starts and stops emergency tests for all emergency lights in a lighting group.
+// Results are reported per device, so the group has no test results of its own.
+type groupEmergencyTests struct {
+	emergencylightpb.UnimplementedEmergencyLightApiServer
+
+	lg *LightGroup
+}
+
+func (g *groupEmergencyTests) send(ctx context.Context, command, test string) error {
+	g.lg.logger.Info("Starting "+test+" for group", zap.String("name", g.lg.conf.Name), zap.Int("group", g.lg.number))
+	_, err := g.lg.client.sendAndReceive(ctx, command, "")
+	if err != nil {
+		g.lg.logger.Error("Failed to start "+test, zap.String("name", g.lg.conf.Name), zap.Error(err))
+		return status.Error(codes.Internal, "failed to start "+test)
+	}
+	return nil
+}
+
+func (g *groupEmergencyTests) StartFunctionTest(ctx context.Context, _ *emergencylightpb.StartEmergencyTestRequest) (*emergencylightpb.StartEmergencyTestResponse, error) {
+	if err := g.send(ctx, groupEmergencyFunctionTest(g.lg.number), "function test"); err != nil {
+		return nil, err
+	}
+	return &emergencylightpb.StartEmergencyTestResponse{
+		StartTime: timestamppb.Now(),
+	}, nil
+}
+
+func (g *groupEmergencyTests) StartDurationTest(ctx context.Context, _ *emergencylightpb.StartEmergencyTestRequest) (*emergencylightpb.StartEmergencyTestResponse, error) {
+	if err := g.send(ctx, groupEmergencyDurationTest(g.lg.number), "duration test"); err != nil {
+		return nil, err
+	}
+	var duration *durationpb.Duration
+	if g.lg.conf.DurationTestLength != nil {
+		duration = durationpb.New(g.lg.conf.DurationTestLength.Duration)
+	}
+	return &emergencylightpb.StartEmergencyTestResponse{
+		StartTime: timestamppb.Now(),
+		Duration:  duration,
+	}, nil
+}
+
+func (g *groupEmergencyTests) StopEmergencyTest(ctx context.Context, _ *emergencylightpb.StopEmergencyTestsRequest) (*emergencylightpb.StopEmergencyTestsResponse, error) {
+	g.lg.logger.Info("Stopping tests for group", zap.String("name", g.lg.conf.Name), zap.Int("group", g.lg.number))
+	_, err := g.lg.client.sendAndReceive(ctx, groupStopEmergencyTests(g.lg.number), "")
+	if err != nil {
+		g.lg.logger.Error("Failed to stop tests", zap.String("name", g.lg.conf.Name), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to stop tests")
+	}
+	return &emergencylightpb.StopEmergencyTestsResponse{}, nil
+}
+
+// emergencyTestStarter can start emergency tests, either for a single light or a group.
+type emergencyTestStarter interface {
+	StartFunctionTest(context.Context, *emergencylightpb.StartEmergencyTestRequest) (*emergencylightpb.StartEmergencyTestResponse, error)
+	StartDurationTest(context.Context, *emergencylightpb.StartEmergencyTestRequest) (*emergencylightpb.StartEmergencyTestResponse, error)
+}
+
+// runTestSchedules starts function and duration tests on their schedules, if any, until ctx is done.
+// A duration test due at the same time as a function test is run instead of it, the duration test covers the function test.
+func runTestSchedules(ctx context.Context, function, duration *jsontypes.Schedule, tests emergencyTestStarter, logger *zap.Logger) error {
+	if function == nil && duration == nil {
+		return nil
+	}
+	t := time.Now()
+	for {
+		var nextFunction, nextDuration time.Time
+		if function != nil {
+			nextFunction = function.Next(t)
+		}
+		if duration != nil {
+			nextDuration = duration.Next(t)
+		}
+		next, runDuration := nextFunction, false
+		if !nextDuration.IsZero() && (next.IsZero() || !nextDuration.After(next)) {
+			next, runDuration = nextDuration, true
+		}
+		if next.IsZero() {
+			return nil // schedules never fire again
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(time.Until(next)):
+		}
+		var err error
+		if runDuration {
+			_, err = tests.StartDurationTest(ctx, &emergencylightpb.StartEmergencyTestRequest{})
+		} else {
+			_, err = tests.StartFunctionTest(ctx, &emergencylightpb.StartEmergencyTestRequest{})
+		}
+		if err != nil {
+			logger.Warn("scheduled emergency test failed to start, will try again on next schedule", zap.Bool("duration", runDuration), zap.Error(err))
+		}
+		t = next
+	}
+}
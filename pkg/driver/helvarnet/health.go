@@ -2,8 +2,11 @@ package helvarnet
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 
+	"github.com/smart-core-os/sc-bos/pkg/proto/emergencylightpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
 )
 
@@ -110,13 +113,38 @@ func updateDeviceFaults(ctx context.Context, status int64, fc *healthpb.FaultChe
 
 	} else {
 
-		statuses := getStatusListFromFlag(status)
+		// setDeviceFaults removes status faults that have cleared, leaving emergency test faults alone
+		setDeviceFaults(getStatusListFromFlag(status), fc)
+	}
+}
 
-		if len(statuses) == 0 {
-			fc.ClearFaults()
-		} else {
-			setDeviceFaults(statuses, fc)
-		}
+const (
+	functionTestFaultCode = "FunctionTest"
+	durationTestFaultCode = "DurationTest"
+)
+
+// updateTestResultFaults adds a fault for each failed emergency test result, removing it once the test passes.
+func updateTestResultFaults(results *emergencylightpb.TestResultSet, fc *healthpb.FaultCheck) {
+	if fc == nil {
+		return
+	}
+	setTestResultFault(functionTestFaultCode, "Function", results.GetFunctionTest(), fc)
+	setTestResultFault(durationTestFaultCode, "Duration", results.GetDurationTest(), fc)
+}
+
+func setTestResultFault(code, test string, result *emergencylightpb.EmergencyTestResult, fc *healthpb.FaultCheck) {
+	fault := &healthpb.HealthCheck_Error{
+		Code: &healthpb.HealthCheck_Error_Code{Code: code, System: SystemName},
+	}
+	switch result.GetResult() {
+	case emergencylightpb.EmergencyTestResult_TEST_RESULT_UNSPECIFIED,
+		emergencylightpb.EmergencyTestResult_TEST_RESULT_PENDING,
+		emergencylightpb.EmergencyTestResult_TEST_PASSED:
+		fc.RemoveFault(fault)
+	default:
+		fault.SummaryText = "Emergency " + test + " Test Failed"
+		fault.DetailsText = fmt.Sprintf("The last emergency %s test reported %s", strings.ToLower(test), result.GetResult())
+		fc.AddOrUpdateFault(fault)
 	}
 }
 
@@ -13,6 +13,7 @@ import (
 
 	"github.com/smart-core-os/sc-bos/internal/manage/devices"
 	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/emergencylightpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
 	"github.com/smart-core-os/sc-bos/pkg/resource"
 	"github.com/smart-core-os/sc-bos/pkg/util/masks"
@@ -382,6 +383,47 @@ func TestDriverRebootClearsFaults(t *testing.T) {
 	}
 }
 
+func TestTestResultFaults(t *testing.T) {
+	h := setupTestHarness(t)
+	results := func(function, duration emergencylightpb.EmergencyTestResult_Result) *emergencylightpb.TestResultSet {
+		return &emergencylightpb.TestResultSet{
+			FunctionTest: &emergencylightpb.EmergencyTestResult{Result: function},
+			DurationTest: &emergencylightpb.EmergencyTestResult{Result: duration},
+		}
+	}
+	faultCodes := func() []string {
+		checks := h.getHealthChecks(t)
+		require.Len(t, checks, 1)
+		var codes []string
+		for _, f := range checks[0].GetFaults().GetCurrentFaults() {
+			codes = append(codes, f.Code.Code)
+		}
+		return codes
+	}
+
+	updateTestResultFaults(results(emergencylightpb.EmergencyTestResult_LAMP_FAILURE, emergencylightpb.EmergencyTestResult_TEST_PASSED), h.fc)
+	if diff := cmp.Diff([]string{functionTestFaultCode}, faultCodes()); diff != "" {
+		t.Errorf("failed function test faults (-want +got):\n%s", diff)
+	}
+
+	// device status updates shouldn't clear test faults
+	h.updateStatus(0x00000002) // LampFailure
+	h.updateStatus(0)
+	if diff := cmp.Diff([]string{functionTestFaultCode}, faultCodes()); diff != "" {
+		t.Errorf("faults after status update (-want +got):\n%s", diff)
+	}
+
+	updateTestResultFaults(results(emergencylightpb.EmergencyTestResult_TEST_RESULT_PENDING, emergencylightpb.EmergencyTestResult_BATTERY_DURATION_FAILURE), h.fc)
+	if diff := cmp.Diff([]string{durationTestFaultCode}, faultCodes()); diff != "" {
+		t.Errorf("failed duration test faults (-want +got):\n%s", diff)
+	}
+
+	updateTestResultFaults(results(emergencylightpb.EmergencyTestResult_TEST_PASSED, emergencylightpb.EmergencyTestResult_TEST_PASSED), h.fc)
+	if diff := cmp.Diff([]string(nil), faultCodes()); diff != "" {
+		t.Errorf("faults after tests pass (-want +got):\n%s", diff)
+	}
+}
+
 type devicesServerModel struct {
 	devices.Collection
 }
@@ -55,10 +55,11 @@ type Light struct {
 	isEm          bool
 	// location is the timezone the device's clock is set to, used to correct device-reported times to UTC
 	location *time.Location
+	modes    *sceneModes
 }
 
 func newLight(client *tcpClient, l *zap.Logger, conf *config.Device, db *bolthold.Store, em bool, loc *time.Location) *Light {
-	return &Light{
+	light := &Light{
 		brightness: resource.NewValue(resource.WithInitialValue(&lightpb.Brightness{}), resource.WithNoDuplicates()),
 		client:     client,
 		conf:       conf,
@@ -71,6 +72,38 @@ func newLight(client *tcpClient, l *zap.Logger, conf *config.Device, db *bolthol
 			FunctionTest: &emergencylightpb.EmergencyTestResult{},
 		}), resource.WithNoDuplicates()),
 	}
+	// there is no command to query scene names for devices, so only configured scenes are described
+	light.modes = newSceneModes(conf.Name, func() []config.Scene { return conf.Scenes }, light.recallScene, light.storeScene)
+	return light
+}
+
+// recallScene recalls the scene for the device, updating the brightness preset to match.
+func (l *Light) recallScene(ctx context.Context, block, scene string) error {
+	if err := l.setScene(ctx, block, scene, "0"); err != nil {
+		l.logger.Error("failed to recall scene", zap.String("name", l.conf.Name), zap.Error(err))
+		return status.Error(codes.DeadlineExceeded, "failed to set scene")
+	}
+	_, _ = l.brightness.Set(&lightpb.Brightness{
+		Preset: &lightpb.LightPreset{
+			Name: block + ":" + scene,
+		},
+	})
+	return nil
+}
+
+// storeScene stores the device's current level as the scene.
+func (l *Light) storeScene(ctx context.Context, block, scene string) error {
+	if err := l.refreshBrightness(ctx); err != nil {
+		l.logger.Error("failed to refresh brightness", zap.String("name", l.conf.Name), zap.Error(err))
+		return status.Error(codes.DeadlineExceeded, "failed to get brightness")
+	}
+	level := l.brightness.Get().(*lightpb.Brightness).LevelPercent
+	command := storeDeviceScene(l.conf.Address, block, scene, int(level))
+	if _, err := l.client.sendAndReceive(ctx, command, ""); err != nil {
+		l.logger.Error("failed to store scene", zap.String("name", l.conf.Name), zap.Error(err))
+		return status.Error(codes.DeadlineExceeded, "failed to store scene")
+	}
+	return nil
 }
 
 // setScene sets the lighting scene for the device
@@ -177,6 +210,7 @@ func (l *Light) UpdateBrightness(ctx context.Context, req *lightpb.UpdateBrightn
 				Name: req.Brightness.Preset.Name,
 			},
 		})
+		l.modes.setMode(sceneMode, block+":"+scene)
 	} else {
 		level := req.Brightness.LevelPercent
 		err := l.setLevel(ctx, int(level))
@@ -315,6 +349,9 @@ func (l *Light) queryDevice(ctx context.Context, t time.Duration, fc *healthpb.F
 			}
 			l.helvarnetStatus = s
 			updateDeviceFaults(ctx, l.helvarnetStatus, fc)
+			if l.isEm {
+				updateTestResultFaults(l.testResultSet.Get().(*emergencylightpb.TestResultSet), fc)
+			}
 			if ctrlHealth != nil {
 				if l.helvarnetStatus < 0 {
 					ctrlHealth.SetFailing(ctx, l.conf.Name)
@@ -27,16 +27,53 @@ type LightGroup struct {
 	number     int
 	logger     *zap.Logger
 	scenes     []config.Scene
+	modes      *sceneModes
 }
 
 func newLightingGroup(client *tcpClient, l *zap.Logger, conf *config.Device, n int) *LightGroup {
-	return &LightGroup{
+	lg := &LightGroup{
 		brightness: resource.NewValue(resource.WithInitialValue(&lightpb.Brightness{}), resource.WithNoDuplicates()),
 		client:     client,
 		conf:       conf,
 		logger:     l,
 		number:     n,
 	}
+	lg.modes = newSceneModes(conf.Name, lg.allScenes, lg.recallScene, lg.storeScene)
+	return lg
+}
+
+// allScenes returns the scenes reported by the router along with any configured scenes.
+func (lg *LightGroup) allScenes() []config.Scene {
+	return mergeScenes(lg.scenes, lg.conf.Scenes)
+}
+
+// recallScene recalls the scene for the group, updating the brightness preset to match.
+func (lg *LightGroup) recallScene(ctx context.Context, block, scene string) error {
+	if err := lg.setScene(ctx, block, scene, "0"); err != nil {
+		lg.logger.Error("failed to recall scene", zap.Int("group", lg.number), zap.Error(err))
+		return status.Error(codes.DeadlineExceeded, "failed to set scene")
+	}
+	_, _ = lg.brightness.Set(&lightpb.Brightness{
+		Preset: &lightpb.LightPreset{
+			Name: block + ":" + scene,
+		},
+	})
+	return nil
+}
+
+// storeScene stores the group's current level as the scene.
+// Only a level set via this driver is known, after a scene recall the group level isn't known so can't be stored.
+func (lg *LightGroup) storeScene(ctx context.Context, block, scene string) error {
+	brightness := lg.brightness.Get().(*lightpb.Brightness)
+	if brightness.Preset != nil {
+		return status.Error(codes.FailedPrecondition, "group level is unknown, set a level before storing a scene")
+	}
+	command := storeGroupScene(lg.number, block, scene, int(brightness.LevelPercent))
+	if _, err := lg.client.sendAndReceive(ctx, command, ""); err != nil {
+		lg.logger.Error("failed to store scene", zap.Int("group", lg.number), zap.Error(err))
+		return status.Error(codes.DeadlineExceeded, "failed to store scene")
+	}
+	return nil
 }
 
 // sends the query commands to get the last known scene for this group
@@ -61,6 +98,9 @@ func (lg *LightGroup) getLastScene(ctx context.Context) error {
 			Name: sceneNumber,
 		},
 	})
+	if _, _, err := parseSceneAddress(sceneNumber); err == nil {
+		lg.modes.setMode(sceneMode, sceneNumber)
+	}
 	lg.logger.Info(fmt.Sprintf("last scene for %s was %s", lg.conf.Name, sceneNumber))
 	return nil
 }
@@ -172,6 +212,7 @@ func (lg *LightGroup) UpdateBrightness(ctx context.Context, req *lightpb.UpdateB
 				Name: req.Brightness.Preset.Name,
 			},
 		})
+		lg.modes.setMode(sceneMode, block+":"+scene)
 	} else {
 		lg.logger.Debug(fmt.Sprintf("setting level %f for device %s", req.Brightness.LevelPercent, lg.conf.Name))
 		level := req.Brightness.LevelPercent
@@ -212,7 +253,7 @@ func (lg *LightGroup) PullBrightness(_ *lightpb.PullBrightnessRequest, server li
 
 func (lg *LightGroup) DescribeBrightness(context.Context, *lightpb.DescribeBrightnessRequest) (*lightpb.BrightnessSupport, error) {
 	result := &lightpb.BrightnessSupport{}
-	for _, scene := range lg.scenes {
+	for _, scene := range lg.allScenes() {
 		result.Presets = append(result.Presets, &lightpb.LightPreset{
 			Title: scene.Title,
 			Name:  scene.Block + ":" + scene.Scene,
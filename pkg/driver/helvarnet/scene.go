@@ -0,0 +1,150 @@
+package helvarnet
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/helvarnet/config"
+	"github.com/smart-core-os/sc-bos/pkg/proto/modepb"
+	"github.com/smart-core-os/sc-bos/pkg/resource"
+)
+
+const (
+	// sceneMode recalls the scene it is set to.
+	sceneMode = "scene"
+	// storeSceneMode stores the current light level as the scene it is set to.
+	storeSceneMode = "storeScene"
+)
+
+// sceneModes exposes recalling and storing Helvar scenes via the Mode trait.
+// Mode values are scene addresses in the form <block>:<scene>, the same as light presets.
+type sceneModes struct {
+	modepb.UnimplementedModeApiServer
+	modepb.UnimplementedModeInfoServer
+
+	name   string
+	scenes func() []config.Scene // the known scenes, used to describe mode values
+	recall func(ctx context.Context, block, scene string) error
+	store  func(ctx context.Context, block, scene string) error
+	value  *resource.Value // *modepb.ModeValues
+}
+
+func newSceneModes(name string, scenes func() []config.Scene, recall, store func(ctx context.Context, block, scene string) error) *sceneModes {
+	return &sceneModes{
+		name:   name,
+		scenes: scenes,
+		recall: recall,
+		store:  store,
+		value:  resource.NewValue(resource.WithInitialValue(&modepb.ModeValues{}), resource.WithNoDuplicates()),
+	}
+}
+
+// mergeScenes returns the scenes in a followed by those in b that aren't already in a.
+// Scenes are the same if they have the same block and scene.
+func mergeScenes(a, b []config.Scene) []config.Scene {
+	res := slices.Clone(a)
+	for _, scene := range b {
+		if !slices.ContainsFunc(res, func(s config.Scene) bool { return s.Block == scene.Block && s.Scene == scene.Scene }) {
+			res = append(res, scene)
+		}
+	}
+	return res
+}
+
+// parseSceneAddress parses a scene address of the form <block>:<scene>.
+// Helvar scenes are in 8 blocks of 16 scenes.
+func parseSceneAddress(addr string) (block, scene string, err error) {
+	block, scene, ok := strings.Cut(addr, ":")
+	if !ok {
+		return "", "", fmt.Errorf("invalid scene %q, must be <block>:<scene>", addr)
+	}
+	if b, err := strconv.Atoi(block); err != nil || b < 1 || b > 8 {
+		return "", "", fmt.Errorf("invalid scene %q, block must be [1,8]", addr)
+	}
+	if s, err := strconv.Atoi(scene); err != nil || s < 1 || s > 16 {
+		return "", "", fmt.Errorf("invalid scene %q, scene must be [1,16]", addr)
+	}
+	return block, scene, nil
+}
+
+func (m *sceneModes) GetModeValues(_ context.Context, req *modepb.GetModeValuesRequest) (*modepb.ModeValues, error) {
+	return m.value.Get(resource.WithReadMask(req.GetReadMask())).(*modepb.ModeValues), nil
+}
+
+func (m *sceneModes) PullModeValues(req *modepb.PullModeValuesRequest, server modepb.ModeApi_PullModeValuesServer) error {
+	for value := range m.value.Pull(server.Context(), resource.WithReadMask(req.GetReadMask()), resource.WithUpdatesOnly(req.GetUpdatesOnly())) {
+		err := server.Send(&modepb.PullModeValuesResponse{Changes: []*modepb.PullModeValuesResponse_Change{
+			{
+				Name:       m.name,
+				ChangeTime: timestamppb.New(value.ChangeTime),
+				ModeValues: value.Value.(*modepb.ModeValues),
+			},
+		}})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateModeValues stores then recalls scenes, so a scene can be stored and recalled in one request.
+func (m *sceneModes) UpdateModeValues(ctx context.Context, req *modepb.UpdateModeValuesRequest) (*modepb.ModeValues, error) {
+	if len(req.GetRelative().GetValues()) > 0 {
+		return nil, status.Error(codes.Unimplemented, "relative mode updates are not supported")
+	}
+	values := req.GetModeValues().GetValues()
+	for name, val := range values {
+		if name != sceneMode && name != storeSceneMode {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown mode %q", name)
+		}
+		if _, _, err := parseSceneAddress(val); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if val, ok := values[storeSceneMode]; ok {
+		block, scene, _ := parseSceneAddress(val)
+		if err := m.store(ctx, block, scene); err != nil {
+			return nil, err
+		}
+		m.setMode(storeSceneMode, val)
+	}
+	if val, ok := values[sceneMode]; ok {
+		block, scene, _ := parseSceneAddress(val)
+		if err := m.recall(ctx, block, scene); err != nil {
+			return nil, err
+		}
+		m.setMode(sceneMode, val)
+	}
+	return m.value.Get().(*modepb.ModeValues), nil
+}
+
+func (m *sceneModes) DescribeModes(context.Context, *modepb.DescribeModesRequest) (*modepb.ModesSupport, error) {
+	var values []*modepb.Modes_Value
+	for _, scene := range m.scenes() {
+		values = append(values, &modepb.Modes_Value{Name: scene.Block + ":" + scene.Scene})
+	}
+	return &modepb.ModesSupport{AvailableModes: &modepb.Modes{Modes: []*modepb.Modes_Mode{
+		{Name: sceneMode, Values: values},
+		{Name: storeSceneMode, Values: values},
+	}}}, nil
+}
+
+// setMode records the value of the named mode, keeping the values of other modes.
+func (m *sceneModes) setMode(name, val string) {
+	_, _ = m.value.Set(&modepb.ModeValues{Values: map[string]string{name: val}}, resource.InterceptBefore(func(old, new proto.Message) {
+		change := new.(*modepb.ModeValues)
+		for k, v := range old.(*modepb.ModeValues).GetValues() {
+			if _, ok := change.Values[k]; !ok {
+				change.Values[k] = v
+			}
+		}
+	}))
+}
@@ -0,0 +1,120 @@
+package helvarnet
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/helvarnet/config"
+	"github.com/smart-core-os/sc-bos/pkg/proto/modepb"
+)
+
+func Test_parseSceneAddress(t *testing.T) {
+	tests := []struct {
+		addr    string
+		block   string
+		scene   string
+		wantErr bool
+	}{
+		{addr: "1:1", block: "1", scene: "1"},
+		{addr: "8:16", block: "8", scene: "16"},
+		{addr: "1", wantErr: true},
+		{addr: "0:1", wantErr: true},
+		{addr: "9:1", wantErr: true},
+		{addr: "1:17", wantErr: true},
+		{addr: "a:b", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			block, scene, err := parseSceneAddress(tt.addr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseSceneAddress() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if block != tt.block || scene != tt.scene {
+				t.Fatalf("parseSceneAddress() = %s, %s, want %s, %s", block, scene, tt.block, tt.scene)
+			}
+		})
+	}
+}
+
+func Test_mergeScenes(t *testing.T) {
+	reported := []config.Scene{{Block: "1", Scene: "1", Title: "On"}, {Block: "1", Scene: "2", Title: "Off"}}
+	configured := []config.Scene{{Block: "1", Scene: "2", Title: "Dim"}, {Block: "2", Scene: "1", Title: "Cleaners"}}
+	want := []config.Scene{{Block: "1", Scene: "1", Title: "On"}, {Block: "1", Scene: "2", Title: "Off"}, {Block: "2", Scene: "1", Title: "Cleaners"}}
+	if diff := cmp.Diff(want, mergeScenes(reported, configured)); diff != "" {
+		t.Errorf("mergeScenes() (-want +got):\n%s", diff)
+	}
+}
+
+func TestSceneModes_UpdateModeValues(t *testing.T) {
+	var calls []string
+	record := func(op string) func(ctx context.Context, block, scene string) error {
+		return func(ctx context.Context, block, scene string) error {
+			calls = append(calls, op+" "+block+":"+scene)
+			return nil
+		}
+	}
+	m := newSceneModes("light", func() []config.Scene { return nil }, record("recall"), record("store"))
+	ctx := context.Background()
+
+	got, err := m.UpdateModeValues(ctx, &modepb.UpdateModeValuesRequest{ModeValues: &modepb.ModeValues{
+		Values: map[string]string{sceneMode: "1:3", storeSceneMode: "1:2"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// store happens first so the current level is stored before a scene is recalled
+	if diff := cmp.Diff([]string{"store 1:2", "recall 1:3"}, calls); diff != "" {
+		t.Errorf("calls (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{sceneMode: "1:3", storeSceneMode: "1:2"}, got.Values); diff != "" {
+		t.Errorf("mode values (-want +got):\n%s", diff)
+	}
+
+	// other modes keep their value
+	calls = nil
+	got, err = m.UpdateModeValues(ctx, &modepb.UpdateModeValuesRequest{ModeValues: &modepb.ModeValues{
+		Values: map[string]string{sceneMode: "2:1"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(map[string]string{sceneMode: "2:1", storeSceneMode: "1:2"}, got.Values); diff != "" {
+		t.Errorf("mode values (-want +got):\n%s", diff)
+	}
+
+	for _, values := range []map[string]string{{"other": "1:1"}, {sceneMode: "9:1"}} {
+		calls = nil
+		_, err = m.UpdateModeValues(ctx, &modepb.UpdateModeValuesRequest{ModeValues: &modepb.ModeValues{Values: values}})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("UpdateModeValues(%v) error = %v, want InvalidArgument", values, err)
+		}
+		if len(calls) > 0 {
+			t.Errorf("UpdateModeValues(%v) made calls %v", values, calls)
+		}
+	}
+}
+
+func TestSceneModes_UpdateModeValues_error(t *testing.T) {
+	wantErr := errors.New("store failed")
+	recalled := false
+	m := newSceneModes("light", func() []config.Scene { return nil },
+		func(context.Context, string, string) error { recalled = true; return nil },
+		func(context.Context, string, string) error { return wantErr })
+	_, err := m.UpdateModeValues(context.Background(), &modepb.UpdateModeValuesRequest{ModeValues: &modepb.ModeValues{
+		Values: map[string]string{sceneMode: "1:3", storeSceneMode: "1:2"},
+	}})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("UpdateModeValues() error = %v, want %v", err, wantErr)
+	}
+	if recalled {
+		t.Fatal("scene recalled after store failed")
+	}
+	if got := m.value.Get().(*modepb.ModeValues).GetValues(); len(got) > 0 {
+		t.Fatalf("mode values changed after store failed: %v", got)
+	}
+}
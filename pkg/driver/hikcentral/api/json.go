@@ -173,3 +173,32 @@ type PtzRequest struct {
 
 type PtzResponse struct {
 }
+
+type ManualCaptureRequest struct {
+	CameraIndexCode string `json:"cameraIndexCode,omitempty"`
+}
+
+type ManualCaptureResponse struct {
+	PicUrl string `json:"picUrl,omitempty"`
+}
+
+type PlaybackRequest struct {
+	CameraIndexCode string `json:"cameraIndexCode,omitempty"`
+	RecordLocation  int    `json:"recordLocation,omitempty"`
+	Protocol        string `json:"protocol,omitempty"`
+	TransMode       int    `json:"transmode,omitempty"`
+	BeginTime       string `json:"beginTime,omitempty"`
+	EndTime         string `json:"endTime,omitempty"`
+}
+
+type PlaybackResponse struct {
+	List []RecordSegment `json:"list,omitempty"`
+	Url  string          `json:"url,omitempty"`
+	Uuid string          `json:"uuid,omitempty"`
+}
+
+type RecordSegment struct {
+	BeginTime string `json:"beginTime,omitempty"`
+	EndTime   string `json:"endTime,omitempty"`
+	Size      int    `json:"size,omitempty"`
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/mqttpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/ptzpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/securityeventpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/udmipb"
 )
 
@@ -39,15 +40,20 @@ type Camera struct {
 	state      *CameraState
 	bus        minibus.Bus[*CameraState]
 	faultCheck *healthpb.FaultCheck
+
+	securityEvents *securityEvents
+	watchingSince  time.Time // alarms starting after this are live
 }
 
-func NewCamera(client *client, logger *zap.Logger, conf *config.Camera, fc *healthpb.FaultCheck) *Camera {
+func NewCamera(client *client, logger *zap.Logger, conf *config.Camera, fc *healthpb.FaultCheck, events *securityEvents) *Camera {
 	return &Camera{
-		client:     client,
-		conf:       conf,
-		faultCheck: fc,
-		logger:     logger,
-		state:      &CameraState{},
+		client:         client,
+		conf:           conf,
+		faultCheck:     fc,
+		logger:         logger,
+		state:          &CameraState{},
+		securityEvents: events,
+		watchingSince:  time.Now(),
 	}
 }
 
@@ -253,6 +259,7 @@ func (c *Camera) getEvents(ctx context.Context) {
 			break
 		} else {
 			c.processEventRecords(ctx, res.List)
+			c.processSecurityEvents(ctx, res.List)
 			if len(res.List) < pageSize {
 				// no more pages, exit
 				break
@@ -300,6 +307,40 @@ func (c *Camera) processEventRecords(ctx context.Context, records []api.EventRec
 	c.updateFault(ctx, fault)
 }
 
+// processSecurityEvents adds a security event for each new alarm in records, linking any media for it.
+func (c *Camera) processSecurityEvents(ctx context.Context, records []api.EventRecord) {
+	for _, record := range records {
+		if record.LinkCameraIndexCode != c.conf.IndexCode {
+			continue // not for this camera
+		}
+		eventType, ok := alarmEventTypes[record.EventType]
+		if !ok || record.EventIndexCode == "" || c.securityEvents.seen(record.EventIndexCode) {
+			continue
+		}
+		start, err := time.Parse(RFC3339NumericZone, record.StartTime)
+		if err != nil {
+			c.logger.Debug("alarm has invalid start time", zap.String("startTime", record.StartTime), zap.Error(err))
+			start = c.now()
+		}
+		description := record.Description
+		if description == "" {
+			description = getFaultSummary(record.EventType)
+		}
+		e := &securityEvent{event: newCameraSecurityEvent(start, record.EventIndexCode, description, eventType, c)}
+		if m := c.securityEvents.media; m != nil {
+			e.media = m.capture(ctx, c, record, start, !start.Before(c.watchingSince))
+		}
+		c.securityEvents.add(ctx, e)
+	}
+}
+
+// alarmEventTypes maps the alarms that raise security events to their type.
+var alarmEventTypes = map[string]securityeventpb.SecurityEvent_EventType{
+	api.VideoLossAlarm:                securityeventpb.SecurityEvent_DEVICE_OFFLINE,
+	api.VideoTamperingAlarm:           securityeventpb.SecurityEvent_TAMPER,
+	api.CameraRecordingExceptionAlarm: securityeventpb.SecurityEvent_MAINTENANCE_ERROR,
+}
+
 func (c *Camera) getOcc(ctx context.Context) {
 	now := c.now()
 	start := now.Truncate(time.Hour)
@@ -99,6 +99,46 @@ func (c *client) listEvents(ctx context.Context, req *api.EventsRequest, fc *hea
 	return makeReqWrapper[api.EventsRequest, api.EventsResponse](ctx, c, "/artemis/api/eventService/v1/eventRecords/page", req, fc)
 }
 
+func (c *client) captureCameraPicture(ctx context.Context, req *api.ManualCaptureRequest, fc *healthpb.FaultCheck) (*api.ManualCaptureResponse, error) {
+	return makeReqWrapper[api.ManualCaptureRequest, api.ManualCaptureResponse](ctx, c, "/artemis/api/video/v1/manualCapture", req, fc)
+}
+
+// getCameraPlaybackUrl doesn't update camera reliability as it's called when serving downloads, not polling.
+func (c *client) getCameraPlaybackUrl(ctx context.Context, req *api.PlaybackRequest) (*api.PlaybackResponse, error) {
+	res, err := makeReq[api.PlaybackRequest, api.PlaybackResponse](ctx, c, "/artemis/api/video/v1/cameras/playbackURLs", req)
+	c.updateSystemCheck(err)
+	return res, err
+}
+
+// getPicture fetches the picture at uri, which may be relative to the OpenAPI address.
+// The caller must close the returned response body.
+func (c *client) getPicture(ctx context.Context, uri string) (*http.Response, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("parse: %w", err)
+	}
+	if !u.IsAbs() {
+		base, err := url.Parse(c.address)
+		if err != nil {
+			return nil, fmt.Errorf("parse address: %w", err)
+		}
+		u = base.ResolveReference(u)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("newRequest: %w", err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("req.do: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, &badStatusError{statusCode: resp.StatusCode, status: resp.Status}
+	}
+	return resp, nil
+}
+
 func makeReqWrapper[R any, T any](ctx context.Context, client *client, path string, r *R, fc *healthpb.FaultCheck) (*T, error) {
 	t, err := makeReq[R, T](ctx, client, path, r)
 	updateReliability(ctx, err, fc)
@@ -16,6 +16,8 @@ type Root struct {
 
 	API      *API      `json:"api,omitempty"`
 	Settings *Settings `json:"settings,omitempty"`
+	// SecurityEvents configures the security events raised for camera alarms.
+	SecurityEvents *SecurityEvents `json:"securityEvents,omitempty"`
 
 	// Metadata applied to all cameras
 	Metadata *metadatapb.Metadata `json:"metadata,omitempty"`
@@ -37,6 +39,17 @@ type Settings struct {
 	StreamPoll    *jsontypes.Duration `json:"streamPoll,omitempty"`    // How often to poll for stream updates. Defaults to 1 minute
 }
 
+type SecurityEvents struct {
+	Size       int  `json:"size,omitempty"`       // How many events to keep per camera. Defaults to 100
+	NoSnapshot bool `json:"noSnapshot,omitempty"` // Don't link a snapshot to events
+	NoClip     bool `json:"noClip,omitempty"`     // Don't link a recorded clip to events
+	// How much recording before and after the alarm the clip covers. Default to 10 and 20 seconds
+	ClipPreEvent  *jsontypes.Duration `json:"clipPreEvent,omitempty"`
+	ClipPostEvent *jsontypes.Duration `json:"clipPostEvent,omitempty"`
+	// The protocol of the clip playback url, one of rtsp, rtmp, hls. Defaults to hls
+	ClipProtocol string `json:"clipProtocol,omitempty"`
+}
+
 type Camera struct {
 	Name      string `json:"name,omitempty"`
 	Topic     string `json:"topic,omitempty"`
@@ -69,6 +82,21 @@ func ReadBytes(raw []byte) (dst Root, err error) {
 			StreamPoll:    &jsontypes.Duration{Duration: 1 * time.Minute},
 		}
 	}
+	if dst.SecurityEvents == nil {
+		dst.SecurityEvents = &SecurityEvents{}
+	}
+	if dst.SecurityEvents.Size == 0 {
+		dst.SecurityEvents.Size = 100
+	}
+	if dst.SecurityEvents.ClipPreEvent == nil {
+		dst.SecurityEvents.ClipPreEvent = &jsontypes.Duration{Duration: 10 * time.Second}
+	}
+	if dst.SecurityEvents.ClipPostEvent == nil {
+		dst.SecurityEvents.ClipPostEvent = &jsontypes.Duration{Duration: 20 * time.Second}
+	}
+	if dst.SecurityEvents.ClipProtocol == "" {
+		dst.SecurityEvents.ClipProtocol = "hls"
+	}
 
 	return
 }
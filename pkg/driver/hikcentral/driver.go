@@ -11,6 +11,7 @@ import (
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/smart-core-os/sc-bos/internal/download"
 	"github.com/smart-core-os/sc-bos/pkg/driver"
 	"github.com/smart-core-os/sc-bos/pkg/driver/hikcentral/config"
 	"github.com/smart-core-os/sc-bos/pkg/node"
@@ -18,6 +19,7 @@ import (
 	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/mqttpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/ptzpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/securityeventpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/udmipb"
 	"github.com/smart-core-os/sc-bos/pkg/task/service"
 	"github.com/smart-core-os/sc-bos/pkg/trait"
@@ -31,10 +33,11 @@ type factory struct{}
 
 func (f factory) New(services driver.Services) service.Lifecycle {
 	d := &Driver{
-		announcer:   node.NewReplaceAnnouncer(services.Node),
-		health:      services.Health,
-		logger:      services.Logger.Named(DriverName),
-		systemCheck: services.SystemCheck,
+		announcer:      node.NewReplaceAnnouncer(services.Node),
+		downloadRouter: services.DownloadRouter,
+		health:         services.Health,
+		logger:         services.Logger.Named(DriverName),
+		systemCheck:    services.SystemCheck,
 	}
 
 	d.Service = service.New(
@@ -56,10 +59,11 @@ func (f factory) New(services driver.Services) service.Lifecycle {
 type Driver struct {
 	*service.Service[config.Root]
 
-	announcer   *node.ReplaceAnnouncer
-	health      *healthpb.Checks
-	logger      *zap.Logger
-	systemCheck service.SystemCheck
+	announcer      *node.ReplaceAnnouncer
+	downloadRouter *download.Router // nil if media isn't served
+	health         *healthpb.Checks
+	logger         *zap.Logger
+	systemCheck    service.SystemCheck
 }
 
 func (d *Driver) applyConfig(ctx context.Context, cfg config.Root) error {
@@ -74,6 +78,12 @@ func (d *Driver) applyConfig(ctx context.Context, cfg config.Root) error {
 		},
 	}
 
+	var eventMedia *media
+	if d.downloadRouter != nil {
+		eventMedia = newMedia(client, d.downloadRouter, cfg.Name, *cfg.SecurityEvents, logger)
+		d.downloadRouter.Handle(eventMedia.typ, eventMedia)
+	}
+
 	grp, ctx := errgroup.WithContext(ctx)
 	var cameras []*Camera
 	var faultChecks []*healthpb.FaultCheck
@@ -86,7 +96,7 @@ func (d *Driver) applyConfig(ctx context.Context, cfg config.Root) error {
 		}
 		faultChecks = append(faultChecks, faultCheck)
 
-		cam := NewCamera(client, logger, camera, faultCheck)
+		cam := NewCamera(client, logger, camera, faultCheck, newSecurityEvents(cfg.SecurityEvents.Size, eventMedia))
 		rootAnnouncer.Announce(camera.Name,
 			node.HasMetadata(camera.Metadata),
 			node.HasServer(mqttpb.RegisterMqttServiceServer, mqttpb.MqttServiceServer(cam)),
@@ -94,6 +104,8 @@ func (d *Driver) applyConfig(ctx context.Context, cfg config.Root) error {
 			node.HasTrait(trait.Ptz),
 			node.HasServer(udmipb.RegisterUdmiServiceServer, udmipb.UdmiServiceServer(cam)),
 			node.HasTrait(udmipb.TraitName),
+			node.HasServer(securityeventpb.RegisterSecurityEventApiServer, securityeventpb.SecurityEventApiServer(cam.securityEvents)),
+			node.HasTrait(securityeventpb.TraitName),
 			node.HasDeviceType(metadatapb.Metadata_DEVICE),
 		)
 		cameras = append(cameras, cam)
@@ -0,0 +1,168 @@
+package hikcentral
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/internal/download"
+	"github.com/smart-core-os/sc-bos/pkg/driver/hikcentral/api"
+	"github.com/smart-core-os/sc-bos/pkg/driver/hikcentral/config"
+	"github.com/smart-core-os/sc-bos/pkg/proto/securityeventpb"
+)
+
+// mediaDownloadType is the download type prefix for media served by this driver,
+// the driver name is appended so multiple hikcentral drivers don't replace each others handler.
+const mediaDownloadType = "hikcentral-media"
+
+// mediaRef records where to find media for a security event.
+// It is the signed payload of download urls, so is trusted when served.
+type mediaRef struct {
+	Type       securityeventpb.SecurityEvent_Media_Type `json:"type"`
+	Camera     string                                   `json:"camera"`           // camera index code
+	CameraName string                                   `json:"cameraName"`       // smart core name of the camera
+	PicUri     string                                   `json:"picUri,omitempty"` // for snapshots
+	BeginTime  time.Time                                `json:"beginTime,omitempty"`
+	EndTime    time.Time                                `json:"endTime,omitempty"`
+}
+
+// media captures evidence for camera alarms and serves it via the download router.
+type media struct {
+	client *client
+	router *download.Router
+	typ    string
+	cfg    config.SecurityEvents
+	logger *zap.Logger
+}
+
+func newMedia(client *client, router *download.Router, name string, cfg config.SecurityEvents, logger *zap.Logger) *media {
+	return &media{
+		client: client,
+		router: router,
+		typ:    mediaDownloadType + ":" + name,
+		cfg:    cfg,
+		logger: logger,
+	}
+}
+
+// capture returns references to media for an alarm that started at start on the camera.
+// A snapshot is only captured if live, the alarm started since we started watching,
+// otherwise it would show the camera now and not what triggered the alarm.
+func (m *media) capture(ctx context.Context, cam *Camera, record api.EventRecord, start time.Time, live bool) []mediaRef {
+	var refs []mediaRef
+	if !m.cfg.NoSnapshot {
+		picUri := record.EventPicUri
+		if picUri == "" && live {
+			res, err := m.client.captureCameraPicture(ctx, &api.ManualCaptureRequest{CameraIndexCode: cam.conf.IndexCode}, cam.faultCheck)
+			if err != nil {
+				cam.logger.Warn("failed to capture alarm snapshot", zap.String("event", record.EventIndexCode), zap.Error(err))
+			} else {
+				picUri = res.PicUrl
+			}
+		}
+		if picUri != "" {
+			refs = append(refs, mediaRef{
+				Type:       securityeventpb.SecurityEvent_Media_SNAPSHOT,
+				Camera:     cam.conf.IndexCode,
+				CameraName: cam.conf.Name,
+				PicUri:     picUri,
+			})
+		}
+	}
+	if !m.cfg.NoClip {
+		refs = append(refs, mediaRef{
+			Type:       securityeventpb.SecurityEvent_Media_CLIP,
+			Camera:     cam.conf.IndexCode,
+			CameraName: cam.conf.Name,
+			BeginTime:  start.Add(-m.cfg.ClipPreEvent.Duration),
+			EndTime:    start.Add(m.cfg.ClipPostEvent.Duration),
+		})
+	}
+	return refs
+}
+
+// toProto returns media for the refs with freshly signed download urls.
+func (m *media) toProto(refs []mediaRef) []*securityeventpb.SecurityEvent_Media {
+	var res []*securityeventpb.SecurityEvent_Media
+	for _, ref := range refs {
+		payload, err := json.Marshal(ref)
+		if err != nil {
+			m.logger.Warn("failed to encode media payload", zap.Error(err))
+			continue
+		}
+		u, expiry, err := m.router.GenerateURL(m.typ, payload)
+		if err != nil {
+			m.logger.Warn("failed to generate media url", zap.Error(err))
+			continue
+		}
+		pb := &securityeventpb.SecurityEvent_Media{
+			Type:            ref.Type,
+			Url:             u,
+			ExpireAfterTime: timestamppb.New(expiry),
+			Source:          ref.CameraName,
+		}
+		switch ref.Type {
+		case securityeventpb.SecurityEvent_Media_SNAPSHOT:
+			pb.MediaType = "image/jpeg"
+		case securityeventpb.SecurityEvent_Media_CLIP:
+			pb.StartTime = timestamppb.New(ref.BeginTime)
+			pb.EndTime = timestamppb.New(ref.EndTime)
+		}
+		res = append(res, pb)
+	}
+	return res
+}
+
+// ServeHTTP serves the media referenced by the verified download payload.
+// Snapshots are proxied, as the picture server often isn't reachable by clients.
+// Clips redirect to a playback url, which HikCentral only issues for a limited time so is fetched on each request.
+func (m *media) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var ref mediaRef
+	if err := json.Unmarshal(download.PayloadFromContext(r.Context()), &ref); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	logger := m.logger.With(zap.String("camera", ref.CameraName))
+	switch ref.Type {
+	case securityeventpb.SecurityEvent_Media_SNAPSHOT:
+		resp, err := m.client.getPicture(r.Context(), ref.PicUri)
+		if err != nil {
+			logger.Warn("failed to fetch snapshot", zap.Error(err))
+			http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+			return
+		}
+		defer resp.Body.Close()
+		contentType := resp.Header.Get("Content-Type")
+		if contentType == "" {
+			contentType = "image/jpeg"
+		}
+		w.Header().Set("Content-Type", contentType)
+		if _, err := io.Copy(w, resp.Body); err != nil {
+			logger.Debug("failed to copy snapshot", zap.Error(err))
+		}
+	case securityeventpb.SecurityEvent_Media_CLIP:
+		res, err := m.client.getCameraPlaybackUrl(r.Context(), &api.PlaybackRequest{
+			CameraIndexCode: ref.Camera,
+			Protocol:        m.cfg.ClipProtocol,
+			BeginTime:       formatTime(ref.BeginTime),
+			EndTime:         formatTime(ref.EndTime),
+		})
+		if err != nil {
+			logger.Warn("failed to get clip playback url", zap.Error(err))
+			http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+			return
+		}
+		if res.Url == "" {
+			http.Error(w, "no recording for clip", http.StatusNotFound)
+			return
+		}
+		http.Redirect(w, r, res.Url, http.StatusFound)
+	default:
+		http.Error(w, "unknown media type", http.StatusBadRequest)
+	}
+}
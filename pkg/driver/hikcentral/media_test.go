@@ -0,0 +1,160 @@
+package hikcentral
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/smart-core-os/sc-bos/internal/download"
+	"github.com/smart-core-os/sc-bos/pkg/driver/hikcentral/api"
+	"github.com/smart-core-os/sc-bos/pkg/driver/hikcentral/config"
+	"github.com/smart-core-os/sc-bos/pkg/proto/securityeventpb"
+)
+
+// fakeOpenAPI serves the media parts of the HikCentral OpenAPI.
+type fakeOpenAPI struct {
+	mu       sync.Mutex
+	captures int
+	playback *api.PlaybackRequest
+}
+
+func (f *fakeOpenAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var data any
+	switch r.URL.Path {
+	case "/pic/1.jpg":
+		w.Header().Set("Content-Type", "image/jpeg")
+		_, _ = w.Write([]byte("jpeg data"))
+		return
+	case "/artemis/api/video/v1/manualCapture":
+		f.captures++
+		data = api.ManualCaptureResponse{PicUrl: "/pic/1.jpg"}
+	case "/artemis/api/video/v1/cameras/playbackURLs":
+		f.playback = &api.PlaybackRequest{}
+		if err := json.NewDecoder(r.Body).Decode(f.playback); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		data = api.PlaybackResponse{Url: "rtsp://hik/playback/1"}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(api.Response{Code: "0", Msg: "success", Data: data})
+}
+
+func TestCamera_processSecurityEvents(t *testing.T) {
+	fake := &fakeOpenAPI{}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	cfg, err := config.ReadBytes([]byte(`{"name":"hik","api":{"address":"` + srv.URL + `"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := newClient(cfg.API, nil)
+	router := download.NewRouter(download.NewHMACSigner(download.GenerateHMACKey()), download.WithBaseURL("/download"))
+	m := newMedia(client, router, cfg.Name, *cfg.SecurityEvents, zap.NewNop())
+	router.Handle(m.typ, m)
+
+	cam := NewCamera(client, zap.NewNop(), &config.Camera{Name: "cam1", IndexCode: "1"}, setupTestHarness(t).fc, newSecurityEvents(10, m))
+	start := cam.watchingSince.Add(time.Minute).Truncate(time.Second)
+	old := cam.watchingSince.Add(-time.Hour).Truncate(time.Second)
+	records := []api.EventRecord{
+		{EventIndexCode: "e1", EventType: api.VideoTamperingAlarm, StartTime: formatTime(start), LinkCameraIndexCode: "1"},
+		{EventIndexCode: "e2", EventType: api.VideoLossAlarm, StartTime: formatTime(old), LinkCameraIndexCode: "1"},
+		{EventIndexCode: "e3", EventType: api.CameraRecordingRecovered, StartTime: formatTime(start), LinkCameraIndexCode: "1"},
+		{EventIndexCode: "e4", EventType: api.VideoLossAlarm, StartTime: formatTime(start), LinkCameraIndexCode: "2"},
+	}
+	ctx := context.Background()
+	cam.processSecurityEvents(ctx, records)
+	cam.processSecurityEvents(ctx, records) // events are only added once
+
+	res, err := cam.securityEvents.ListSecurityEvents(ctx, &securityeventpb.ListSecurityEventsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.SecurityEvents) != 2 {
+		t.Fatalf("want 2 events, got %d", len(res.SecurityEvents))
+	}
+	if fake.captures != 1 {
+		t.Fatalf("want 1 snapshot captured, got %d", fake.captures)
+	}
+
+	// newest first
+	tamper, loss := res.SecurityEvents[1], res.SecurityEvents[0]
+	if tamper.Id != "e1" || tamper.EventType != securityeventpb.SecurityEvent_TAMPER || tamper.Description != "Video Tampering" {
+		t.Fatalf("unexpected tamper event %v", tamper)
+	}
+	if loss.Id != "e2" || loss.EventType != securityeventpb.SecurityEvent_DEVICE_OFFLINE {
+		t.Fatalf("unexpected loss event %v", loss)
+	}
+	// the loss alarm started before the camera was watched, so has no snapshot
+	if len(loss.Media) != 1 || loss.Media[0].Type != securityeventpb.SecurityEvent_Media_CLIP {
+		t.Fatalf("want only a clip for old alarm, got %v", loss.Media)
+	}
+	if len(tamper.Media) != 2 {
+		t.Fatalf("want snapshot and clip, got %v", tamper.Media)
+	}
+	snapshot, clip := tamper.Media[0], tamper.Media[1]
+	if snapshot.Type != securityeventpb.SecurityEvent_Media_SNAPSHOT || !strings.HasPrefix(snapshot.Url, "/download/") || snapshot.Source != "cam1" {
+		t.Fatalf("unexpected snapshot %v", snapshot)
+	}
+	if got, want := clip.StartTime.AsTime(), start.Add(-10*time.Second); !got.Equal(want) {
+		t.Fatalf("clip start got %v, want %v", got, want)
+	}
+	if got, want := clip.EndTime.AsTime(), start.Add(20*time.Second); !got.Equal(want) {
+		t.Fatalf("clip end got %v, want %v", got, want)
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, snapshot.Url, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("snapshot status %d: %s", rec.Code, rec.Body)
+	}
+	if body, _ := io.ReadAll(rec.Body); string(body) != "jpeg data" || rec.Header().Get("Content-Type") != "image/jpeg" {
+		t.Fatalf("unexpected snapshot response %q %s", body, rec.Header().Get("Content-Type"))
+	}
+
+	rec = httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, clip.Url, nil))
+	if rec.Code != http.StatusFound || rec.Header().Get("Location") != "rtsp://hik/playback/1" {
+		t.Fatalf("unexpected clip response %d %s", rec.Code, rec.Header().Get("Location"))
+	}
+	want := api.PlaybackRequest{
+		CameraIndexCode: "1",
+		Protocol:        "hls",
+		BeginTime:       formatTime(start.Add(-10 * time.Second)),
+		EndTime:         formatTime(start.Add(20 * time.Second)),
+	}
+	if *fake.playback != want {
+		t.Fatalf("playback request got %+v, want %+v", *fake.playback, want)
+	}
+}
+
+func TestSecurityEvents_overwrite(t *testing.T) {
+	events := newSecurityEvents(2, nil)
+	ctx := context.Background()
+	for _, id := range []string{"a", "b", "c"} {
+		events.add(ctx, &securityEvent{event: &securityeventpb.SecurityEvent{Id: id}})
+	}
+	if events.seen("a") || !events.seen("b") || !events.seen("c") {
+		t.Fatalf("unexpected seen ids %v", events.ids)
+	}
+	res, err := events.ListSecurityEvents(ctx, &securityeventpb.ListSecurityEventsRequest{PageSize: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.SecurityEvents) != 1 || res.SecurityEvents[0].Id != "c" || res.NextPageToken != "1" || res.TotalSize != 2 {
+		t.Fatalf("unexpected page %v", res)
+	}
+}
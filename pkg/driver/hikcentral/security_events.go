@@ -0,0 +1,176 @@
+package hikcentral
+
+import (
+	"container/ring"
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/pkg/minibus"
+	"github.com/smart-core-os/sc-bos/pkg/proto/securityeventpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/typespb"
+	"github.com/smart-core-os/sc-bos/pkg/util/masks"
+)
+
+// securityEvent is a recorded alarm, media urls are signed when the event is read.
+type securityEvent struct {
+	event *securityeventpb.SecurityEvent
+	media []mediaRef
+}
+
+// securityEvents keeps the most recent security events raised for a camera.
+type securityEvents struct {
+	securityeventpb.UnimplementedSecurityEventApiServer
+
+	media *media // nil if media isn't served
+
+	mu sync.Mutex
+	// events is a circular buffer, it always points to the oldest event
+	events  *ring.Ring      // of *securityEvent
+	ids     map[string]bool // ids of events in the buffer
+	updates minibus.Bus[*securityEvent]
+}
+
+func newSecurityEvents(n int, media *media) *securityEvents {
+	return &securityEvents{
+		media:  media,
+		events: ring.New(n),
+		ids:    make(map[string]bool),
+	}
+}
+
+// seen reports whether an event with id has been added.
+func (s *securityEvents) seen(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ids[id]
+}
+
+func (s *securityEvents) add(ctx context.Context, e *securityEvent) {
+	s.mu.Lock()
+	if old, ok := s.events.Value.(*securityEvent); ok {
+		delete(s.ids, old.event.GetId())
+	}
+	s.events.Value = e
+	s.events = s.events.Next()
+	s.ids[e.event.GetId()] = true
+	s.mu.Unlock()
+	s.updates.Send(ctx, e)
+}
+
+// all returns the events, newest first.
+func (s *securityEvents) all() []*securityEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var res []*securityEvent
+	// The head is the oldest slot, unwritten slots are nil and sit before the oldest event.
+	node := s.events
+	for range s.events.Len() {
+		node = node.Prev()
+		e, ok := node.Value.(*securityEvent)
+		if !ok {
+			break
+		}
+		res = append(res, e)
+	}
+	return res
+}
+
+func (s *securityEvents) toProto(e *securityEvent) *securityeventpb.SecurityEvent {
+	if s.media == nil || len(e.media) == 0 {
+		return e.event
+	}
+	pb := proto.Clone(e.event).(*securityeventpb.SecurityEvent)
+	pb.Media = s.media.toProto(e.media)
+	return pb
+}
+
+// maxSecurityEventPageSize caps how many events a single ListSecurityEvents
+// page may return, regardless of the requested page size.
+const maxSecurityEventPageSize = 1000
+
+func (s *securityEvents) ListSecurityEvents(_ context.Context, req *securityeventpb.ListSecurityEventsRequest) (*securityeventpb.ListSecurityEventsResponse, error) {
+	if req.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid page size")
+	}
+	pageSize := int(req.PageSize)
+	if pageSize == 0 {
+		pageSize = 50
+	}
+	pageSize = min(pageSize, maxSecurityEventPageSize)
+	start := 0
+	if req.PageToken != "" {
+		s, err := strconv.Atoi(req.PageToken)
+		if err != nil || s < 0 {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+		start = s
+	}
+
+	all := s.all()
+	filter := masks.NewResponseFilter(masks.WithFieldMask(req.ReadMask))
+	res := &securityeventpb.ListSecurityEventsResponse{TotalSize: int32(len(all))}
+	for _, e := range all[min(start, len(all)):min(start+pageSize, len(all))] {
+		res.SecurityEvents = append(res.SecurityEvents, filter.FilterClone(s.toProto(e)).(*securityeventpb.SecurityEvent))
+	}
+	if next := start + len(res.SecurityEvents); next < len(all) {
+		res.NextPageToken = strconv.Itoa(next)
+	}
+	return res, nil
+}
+
+func (s *securityEvents) PullSecurityEvents(req *securityeventpb.PullSecurityEventsRequest, server grpc.ServerStreamingServer[securityeventpb.PullSecurityEventsResponse]) error {
+	filter := masks.NewResponseFilter(masks.WithFieldMask(req.ReadMask))
+	send := func(e *securityEvent) error {
+		return server.Send(&securityeventpb.PullSecurityEventsResponse{Changes: []*securityeventpb.PullSecurityEventsResponse_Change{{
+			Name:       req.Name,
+			ChangeTime: e.event.GetSecurityEventTime(),
+			NewValue:   filter.FilterClone(s.toProto(e)).(*securityeventpb.SecurityEvent),
+			Type:       typespb.ChangeType_ADD,
+		}}})
+	}
+
+	// listen before reading existing events so none are missed
+	updates := s.updates.Listen(server.Context())
+	sent := make(map[*securityEvent]bool)
+	if !req.UpdatesOnly {
+		all := s.all()
+		for i := len(all) - 1; i >= 0; i-- {
+			if err := send(all[i]); err != nil {
+				return err
+			}
+			sent[all[i]] = true
+		}
+	}
+	for e := range updates {
+		if sent[e] {
+			continue
+		}
+		if err := send(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newCameraSecurityEvent(t time.Time, id, description string, eventType securityeventpb.SecurityEvent_EventType, cam *Camera) *securityeventpb.SecurityEvent {
+	return &securityeventpb.SecurityEvent{
+		SecurityEventTime: timestamppb.New(t),
+		Description:       description,
+		Id:                id,
+		Source: &securityeventpb.SecurityEvent_Source{
+			Id:        cam.conf.IndexCode,
+			Name:      cam.conf.Name,
+			Subsystem: "cctv",
+		},
+		State:     securityeventpb.SecurityEvent_UNACKNOWLEDGED,
+		EventType: eventType,
+	}
+}
@@ -0,0 +1,65 @@
+package knx
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/dpt"
+	"github.com/smart-core-os/sc-bos/pkg/proto/airtemperaturepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/typespb"
+	"github.com/smart-core-os/sc-bos/pkg/resource"
+)
+
+type airTemperature struct {
+	airtemperaturepb.AirTemperatureApiServer
+	model *airtemperaturepb.Model
+	bus   *bus
+	cfg   *config.AirTemperatureConfig
+}
+
+func newAirTemperature(b *bus, cfg *config.AirTemperatureConfig) *airTemperature {
+	model := airtemperaturepb.NewModel()
+	t := &airTemperature{AirTemperatureApiServer: airtemperaturepb.NewModelServer(model), model: model, bus: b, cfg: cfg}
+	listenFloat := func(p *config.Point, path string, fn func(v float64) *airtemperaturepb.AirTemperature) {
+		b.listen(p, func(data []byte) error {
+			v, err := dpt.DecodeFloat(p.DPT, data)
+			if err != nil {
+				return err
+			}
+			_, err = model.UpdateAirTemperature(fn(v), resource.WithUpdatePaths(path))
+			return err
+		})
+	}
+	listenFloat(cfg.AmbientTemperature, "ambient_temperature", func(v float64) *airtemperaturepb.AirTemperature {
+		return &airtemperaturepb.AirTemperature{AmbientTemperature: &typespb.Temperature{ValueCelsius: v}}
+	})
+	listenFloat(cfg.SetPoint, "temperature_set_point", func(v float64) *airtemperaturepb.AirTemperature {
+		return &airtemperaturepb.AirTemperature{TemperatureGoal: &airtemperaturepb.AirTemperature_TemperatureSetPoint{
+			TemperatureSetPoint: &typespb.Temperature{ValueCelsius: v},
+		}}
+	})
+	listenFloat(cfg.Humidity, "ambient_humidity", func(v float64) *airtemperaturepb.AirTemperature {
+		h := float32(v)
+		return &airtemperaturepb.AirTemperature{AmbientHumidity: &h}
+	})
+	return t
+}
+
+func (t *airTemperature) UpdateAirTemperature(ctx context.Context, req *airtemperaturepb.UpdateAirTemperatureRequest) (*airtemperaturepb.AirTemperature, error) {
+	sp := req.GetState().GetTemperatureSetPoint()
+	if sp == nil {
+		return nil, status.Error(codes.InvalidArgument, "only temperatureSetPoint can be updated")
+	}
+	if t.cfg.SetPoint == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no set point configured")
+	}
+	if err := t.bus.write(ctx, t.cfg.SetPoint, sp.ValueCelsius); err != nil {
+		return nil, err
+	}
+	return t.model.UpdateAirTemperature(&airtemperaturepb.AirTemperature{
+		TemperatureGoal: &airtemperaturepb.AirTemperature_TemperatureSetPoint{TemperatureSetPoint: sp},
+	}, resource.WithUpdatePaths("temperature_set_point"))
+}
@@ -0,0 +1,265 @@
+package knx
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/dpt"
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/knxnet"
+	"github.com/smart-core-os/sc-bos/pkg/task/service"
+)
+
+const (
+	minReconnectDelay = time.Second
+	maxReconnectDelay = 30 * time.Second
+	// how long to wait for devices to report their status after a write
+	statusTimeout = 2 * time.Second
+)
+
+// bus routes telegrams between the KNX bus and the traits using it.
+// Traits register the group addresses they listen to before run is called.
+type bus struct {
+	logger *zap.Logger
+
+	listeners map[knxnet.GroupAddress][]listener
+	// group addresses to read when connected, in the order they were registered
+	reads []knxnet.GroupAddress
+
+	mu   sync.Mutex
+	conn knxnet.Conn // nil when not connected
+	// closed when a value for the group address is next received, see writeStatus
+	waiters map[knxnet.GroupAddress]map[chan struct{}]struct{}
+}
+
+type listener struct {
+	point *config.Point
+	fn    func(data []byte) error
+}
+
+func newBus(logger *zap.Logger) *bus {
+	return &bus{
+		logger:    logger,
+		listeners: make(map[knxnet.GroupAddress][]listener),
+		waiters:   make(map[knxnet.GroupAddress]map[chan struct{}]struct{}),
+	}
+}
+
+// listen calls fn with the data of each GroupValueWrite or GroupValueResponse for the status address of p.
+// The status address is read each time the bus connects.
+// Does nothing if p is nil.
+func (b *bus) listen(p *config.Point, fn func(data []byte) error) {
+	if p == nil {
+		return
+	}
+	ga := p.StatusAddress()
+	if !slices.Contains(b.reads, ga) {
+		b.reads = append(b.reads, ga)
+	}
+	b.listeners[ga] = append(b.listeners[ga], listener{point: p, fn: fn})
+}
+
+// write encodes v using the DPT of p and writes it to the address of p.
+// Errors are gRPC status errors.
+func (b *bus) write(ctx context.Context, p *config.Point, v any) error {
+	if p == nil || p.Address == 0 {
+		return status.Error(codes.FailedPrecondition, "value is read only")
+	}
+	data, err := dpt.Encode(p.DPT, v)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	b.mu.Lock()
+	conn := b.conn
+	b.mu.Unlock()
+	if conn == nil {
+		return status.Error(codes.Unavailable, "not connected to the KNX bus")
+	}
+	if err := knxnet.Write(ctx, conn, p.Address, data); err != nil {
+		return status.Errorf(codes.Unavailable, "write %s: %v", p.Address, err)
+	}
+	return nil
+}
+
+// writeStatus writes v to p like write, then reads the status address of each of statuses and waits for the values to
+// be received by listeners, so traits report the state devices are in rather than the value that was written.
+// If devices don't respond within statusTimeout the write still succeeds and traits keep their last known values.
+func (b *bus) writeStatus(ctx context.Context, p *config.Point, v any, statuses ...*config.Point) error {
+	type waiter struct {
+		ga knxnet.GroupAddress
+		ch chan struct{}
+	}
+	var waiters []waiter
+	b.mu.Lock()
+	for _, s := range statuses {
+		if s == nil {
+			continue
+		}
+		w := waiter{ga: s.StatusAddress(), ch: make(chan struct{})}
+		if b.waiters[w.ga] == nil {
+			b.waiters[w.ga] = make(map[chan struct{}]struct{})
+		}
+		b.waiters[w.ga][w.ch] = struct{}{}
+		waiters = append(waiters, w)
+	}
+	b.mu.Unlock()
+	defer func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		for _, w := range waiters {
+			delete(b.waiters[w.ga], w.ch)
+		}
+	}()
+
+	if err := b.write(ctx, p, v); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	conn := b.conn
+	b.mu.Unlock()
+	if conn == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, statusTimeout)
+	defer cancel()
+	for _, w := range waiters {
+		if err := knxnet.Read(ctx, conn, w.ga); err != nil {
+			b.logger.Debug("failed to read status", zap.Stringer("address", w.ga), zap.Error(err))
+		}
+	}
+	for _, w := range waiters {
+		select {
+		case <-w.ch:
+		case <-ctx.Done():
+			b.logger.Debug("no status after write", zap.Stringer("address", w.ga))
+			return nil
+		}
+	}
+	return nil
+}
+
+// received wakes anything waiting for a value for ga, see writeStatus.
+func (b *bus) received(ga knxnet.GroupAddress) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.waiters[ga] {
+		close(ch)
+	}
+	delete(b.waiters, ga)
+}
+
+// run connects to the bus and dispatches telegrams to listeners, reconnecting as needed, until ctx is done.
+func (b *bus) run(ctx context.Context, cfg config.Conn, check service.SystemCheck) {
+	delay := minReconnectDelay
+	for {
+		conn, err := dial(ctx, cfg)
+		if err == nil {
+			service.UpdateSystemCheck(check, nil)
+			delay = minReconnectDelay
+			err = b.serve(ctx, conn, cfg.ReadInterval.Duration)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		b.logger.Warn("KNX connection failed", zap.Error(err), zap.Duration("retryIn", delay))
+		service.UpdateSystemCheck(check, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+
+// serve dispatches telegrams from conn until it fails or ctx is done.
+func (b *bus) serve(ctx context.Context, conn knxnet.Conn, readInterval time.Duration) error {
+	b.mu.Lock()
+	b.conn = conn
+	b.mu.Unlock()
+	defer func() {
+		b.mu.Lock()
+		b.conn = nil
+		b.mu.Unlock()
+		_ = conn.Close()
+	}()
+
+	go b.readAll(ctx, conn, readInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case f, ok := <-conn.Inbound():
+			if !ok {
+				return conn.Err()
+			}
+			if f.APCI != knxnet.GroupValueWrite && f.APCI != knxnet.GroupValueResponse {
+				continue
+			}
+			for _, l := range b.listeners[f.Destination] {
+				if err := l.fn(f.Data); err != nil {
+					b.logger.Debug("bad telegram", zap.Stringer("address", f.Destination),
+						zap.Stringer("dpt", l.point.DPT), zap.Binary("data", f.Data), zap.Error(err))
+				}
+			}
+			b.received(f.Destination)
+		}
+	}
+}
+
+// readAll sends a GroupValueRead for each status address, so traits have values without waiting for them to change.
+func (b *bus) readAll(ctx context.Context, conn knxnet.Conn, interval time.Duration) {
+	for _, ga := range b.reads {
+		if err := knxnet.Read(ctx, conn, ga); err != nil {
+			if ctx.Err() == nil {
+				b.logger.Debug("failed to read initial value", zap.Stringer("address", ga), zap.Error(err))
+			}
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-conn.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+func dial(ctx context.Context, cfg config.Conn) (knxnet.Conn, error) {
+	switch cfg.Mode {
+	case config.ModeRouting:
+		addr := knxnet.DefaultMulticastAddress
+		if cfg.MulticastAddress != "" {
+			var err error
+			if addr, err = netip.ParseAddrPort(cfg.MulticastAddress); err != nil {
+				return nil, err
+			}
+		}
+		var ifi *net.Interface
+		if cfg.Interface != "" {
+			var err error
+			if ifi, err = net.InterfaceByName(cfg.Interface); err != nil {
+				return nil, err
+			}
+		}
+		ia := config.DefaultRoutingAddress
+		if cfg.IndividualAddress != nil {
+			ia = *cfg.IndividualAddress
+		}
+		return knxnet.ListenRouting(addr, ifi, ia)
+	case config.ModeTunnel:
+		return knxnet.DialTunnel(ctx, cfg.Gateway, knxnet.TunnelOptions{})
+	default:
+		return nil, fmt.Errorf("unknown mode %q", cfg.Mode)
+	}
+}
@@ -0,0 +1,120 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"time"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver"
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/knxnet"
+	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
+)
+
+const (
+	ModeTunnel  = "tunnel"
+	ModeRouting = "routing"
+
+	DefaultReadInterval = 50 * time.Millisecond
+)
+
+// DefaultRoutingAddress is the individual address used as the source of routed telegrams if none is configured.
+const DefaultRoutingAddress = knxnet.IndividualAddress(0xFFFE) // 15.15.254
+
+type Root struct {
+	driver.BaseConfig
+
+	Meta    *metadatapb.Metadata `json:"meta,omitempty"`
+	Conn    Conn                 `json:"conn"`
+	Devices []Device             `json:"devices,omitempty"`
+}
+
+// Conn config related to communicating with the KNX bus.
+type Conn struct {
+	// Mode is how to connect to the bus, either ModeTunnel (the default) or ModeRouting.
+	Mode string `json:"mode,omitempty"`
+	// Gateway is the host:port of the KNXnet/IP tunnelling gateway, the port defaults to 3671.
+	// Required in tunnel mode.
+	Gateway string `json:"gateway,omitempty"`
+	// MulticastAddress is the routing multicast group, defaults to 224.0.23.12:3671.
+	MulticastAddress string `json:"multicastAddress,omitempty"`
+	// Interface is the name of the network interface used for routing, defaults to the system default.
+	Interface string `json:"interface,omitempty"`
+	// IndividualAddress is the source address of telegrams sent in routing mode, defaults to 15.15.254.
+	// In tunnel mode the gateway assigns the address.
+	IndividualAddress *knxnet.IndividualAddress `json:"individualAddress,omitempty"`
+	// ReadInterval is the delay between each GroupValueRead sent to fetch initial values, to avoid flooding the bus.
+	// Defaults to DefaultReadInterval.
+	ReadInterval *jsontypes.Duration `json:"readInterval,omitempty"`
+}
+
+// Device represents a Smart Core device.
+type Device struct {
+	// Name the Smart Core device name
+	Name string `json:"name,omitempty"`
+	// Meta the Smart Core device metadata
+	Meta *metadatapb.Metadata `json:"meta,omitempty"`
+	// Traits the Smart Core traits the device implements
+	Traits []RawTrait `json:"traits,omitempty"`
+}
+
+func ParseConfig(data []byte) (cfg Root, err error) {
+	err = json.Unmarshal(data, &cfg)
+	if err != nil {
+		return cfg, err
+	}
+
+	switch cfg.Conn.Mode {
+	case "":
+		cfg.Conn.Mode = ModeTunnel
+		fallthrough
+	case ModeTunnel:
+		if cfg.Conn.Gateway == "" {
+			return cfg, fmt.Errorf("conn.gateway is required in %s mode", ModeTunnel)
+		}
+	case ModeRouting:
+		if cfg.Conn.MulticastAddress != "" {
+			if _, err := netip.ParseAddrPort(cfg.Conn.MulticastAddress); err != nil {
+				return cfg, fmt.Errorf("conn.multicastAddress: %w", err)
+			}
+		}
+	default:
+		return cfg, fmt.Errorf("conn.mode %q: must be %q or %q", cfg.Conn.Mode, ModeTunnel, ModeRouting)
+	}
+	if cfg.Conn.ReadInterval == nil {
+		cfg.Conn.ReadInterval = &jsontypes.Duration{Duration: DefaultReadInterval}
+	}
+
+	for _, d := range cfg.Devices {
+		for _, t := range d.Traits {
+			if err := validateTrait(t); err != nil {
+				return cfg, fmt.Errorf("device '%s': %w", d.Name, err)
+			}
+		}
+	}
+	return cfg, nil
+}
+
+func validateTrait(t RawTrait) error {
+	switch t.Kind {
+	case trait.OnOff:
+		_, err := ParseTrait[OnOffConfig](t)
+		return err
+	case trait.Light:
+		_, err := ParseTrait[LightConfig](t)
+		return err
+	case trait.OpenClose:
+		_, err := ParseTrait[OpenCloseConfig](t)
+		return err
+	case trait.AirTemperature:
+		_, err := ParseTrait[AirTemperatureConfig](t)
+		return err
+	case trait.OccupancySensor:
+		_, err := ParseTrait[OccupancySensorConfig](t)
+		return err
+	default:
+		return fmt.Errorf("unknown trait kind '%s'", t.Kind)
+	}
+}
@@ -0,0 +1,66 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/dpt"
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/knxnet"
+)
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := ParseConfig([]byte(`{
+		"name": "knx",
+		"type": "knx",
+		"conn": {"gateway": "192.168.1.10"},
+		"devices": [{
+			"name": "light-1",
+			"traits": [{"kind": "smartcore.traits.Light", "brightness": {"address": "1/1/3", "status": "1/1/4"}}]
+		}]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Conn.Mode != ModeTunnel {
+		t.Errorf("mode = %q, want %q", cfg.Conn.Mode, ModeTunnel)
+	}
+	if cfg.Conn.ReadInterval == nil || cfg.Conn.ReadInterval.Duration != DefaultReadInterval {
+		t.Errorf("readInterval = %v, want %v", cfg.Conn.ReadInterval, DefaultReadInterval)
+	}
+
+	light, err := ParseTrait[LightConfig](cfg.Devices[0].Traits[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Point{Address: 0x0903, Status: 0x0904, DPT: dpt.Scaling}
+	if *light.Brightness != want {
+		t.Errorf("brightness = %+v, want %+v", *light.Brightness, want)
+	}
+	if light.Brightness.StatusAddress() != knxnet.GroupAddress(0x0904) {
+		t.Errorf("status address = %v", light.Brightness.StatusAddress())
+	}
+}
+
+func TestParseConfig_errors(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  string
+		err  string
+	}{
+		{"no gateway", `{"conn": {}}`, "conn.gateway is required"},
+		{"bad mode", `{"conn": {"mode": "serial"}}`, "conn.mode"},
+		{"bad multicast", `{"conn": {"mode": "routing", "multicastAddress": "224.0.23.12"}}`, "conn.multicastAddress"},
+		{"bad address", `{"conn": {"gateway": "gw"}, "devices": [{"name": "d", "traits": [{"kind": "smartcore.traits.OnOff", "onOff": {"address": "32/0/0"}}]}]}`, "32/0/0"},
+		{"missing point", `{"conn": {"gateway": "gw"}, "devices": [{"name": "d", "traits": [{"kind": "smartcore.traits.OnOff"}]}]}`, "onOff is required"},
+		{"no addresses", `{"conn": {"gateway": "gw"}, "devices": [{"name": "d", "traits": [{"kind": "smartcore.traits.OpenClose", "position": {}}]}]}`, "position: address or status is required"},
+		{"unknown trait", `{"conn": {"gateway": "gw"}, "devices": [{"name": "d", "traits": [{"kind": "smartcore.traits.Meter"}]}]}`, "unknown trait kind"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseConfig([]byte(tt.cfg))
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("got error %v, want containing %q", err, tt.err)
+			}
+		})
+	}
+}
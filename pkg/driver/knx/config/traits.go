@@ -0,0 +1,170 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/dpt"
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/knxnet"
+	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+)
+
+type Trait struct {
+	Name     string               `json:"name,omitempty"`
+	Kind     trait.Name           `json:"kind,omitempty"`
+	Metadata *metadatapb.Metadata `json:"metadata,omitempty"`
+}
+
+type RawTrait struct {
+	Trait
+	Raw json.RawMessage `json:"-"`
+}
+
+func (c RawTrait) MarshalJSON() ([]byte, error) {
+	return c.Raw, nil
+}
+
+func (c *RawTrait) UnmarshalJSON(buf []byte) error {
+	c.Raw = buf
+	return json.Unmarshal(buf, &c.Trait)
+}
+
+// ParseTrait parses and validates the config of t, filling in defaults.
+func ParseTrait[T any, P interface {
+	*T
+	Validate() error
+}](t RawTrait) (*T, error) {
+	cfg := new(T)
+	if err := json.Unmarshal(t.Raw, cfg); err != nil {
+		return nil, fmt.Errorf("trait '%s': %w", t.Name, err)
+	}
+	if err := P(cfg).Validate(); err != nil {
+		return nil, fmt.Errorf("trait '%s': %w", t.Name, err)
+	}
+	return cfg, nil
+}
+
+// Point is a value on the KNX bus, made up of one or two group addresses.
+type Point struct {
+	// Address is the group address written to when the value changes.
+	Address knxnet.GroupAddress `json:"address,omitempty"`
+	// Status is the group address devices report the current value on, if different to Address.
+	// KNX actuators typically have a separate status object, for example a light might be switched using 1/1/1
+	// and report whether it's on using 1/1/2.
+	Status knxnet.GroupAddress `json:"status,omitempty"`
+	// DPT is the datapoint type of the value, each trait has a sensible default.
+	DPT dpt.DPT `json:"dpt,omitzero"`
+}
+
+// StatusAddress returns the group address to read and listen to for the current value.
+func (p *Point) StatusAddress() knxnet.GroupAddress {
+	if p.Status != 0 {
+		return p.Status
+	}
+	return p.Address
+}
+
+func (p *Point) validate(field string, def dpt.DPT) error {
+	if p == nil {
+		return nil
+	}
+	if p.Address == 0 && p.Status == 0 {
+		return fmt.Errorf("%s: address or status is required", field)
+	}
+	if p.DPT.IsZero() {
+		p.DPT = def
+	}
+	return nil
+}
+
+// OnOffConfig configures the OnOff trait using a switch, typically DPT 1.001.
+type OnOffConfig struct {
+	Trait
+	OnOff *Point `json:"onOff,omitempty"`
+}
+
+func (c *OnOffConfig) Validate() error {
+	if c.OnOff == nil {
+		return errors.New("onOff is required")
+	}
+	return c.OnOff.validate("onOff", dpt.Switch)
+}
+
+// LightConfig configures the Light trait.
+// Dimmable lights have a Brightness, DPT 5.001, and may also have an OnOff switch.
+// Lights that can only be switched have only OnOff, on is 100% and off 0%.
+type LightConfig struct {
+	Trait
+	Brightness *Point `json:"brightness,omitempty"`
+	OnOff      *Point `json:"onOff,omitempty"`
+}
+
+func (c *LightConfig) Validate() error {
+	if c.Brightness == nil && c.OnOff == nil {
+		return errors.New("brightness or onOff is required")
+	}
+	return errors.Join(
+		c.Brightness.validate("brightness", dpt.Scaling),
+		c.OnOff.validate("onOff", dpt.Switch),
+	)
+}
+
+// OpenCloseConfig configures the OpenClose trait for blinds and shutters.
+//
+// Position is the blind position, DPT 5.001, where KNX uses 0% for fully open and 100% for closed.
+// This is inverted for the OpenClose trait, where 100% is open.
+// UpDown, DPT 1.008, moves the blind fully up (open) or down (closed), and is used when Position is absent.
+// Stop, typically DPT 1.007 or 1.017, stops the blind moving.
+type OpenCloseConfig struct {
+	Trait
+	Position *Point `json:"position,omitempty"`
+	UpDown   *Point `json:"upDown,omitempty"`
+	Stop     *Point `json:"stop,omitempty"`
+}
+
+func (c *OpenCloseConfig) Validate() error {
+	if c.Position == nil && c.UpDown == nil {
+		return errors.New("position or upDown is required")
+	}
+	return errors.Join(
+		c.Position.validate("position", dpt.Scaling),
+		c.UpDown.validate("upDown", dpt.UpDown),
+		c.Stop.validate("stop", dpt.Step),
+	)
+}
+
+// AirTemperatureConfig configures the AirTemperature trait.
+// Temperatures default to DPT 9.001 (°C) and humidity to 9.007 (%).
+// Only SetPoint can be written.
+type AirTemperatureConfig struct {
+	Trait
+	AmbientTemperature *Point `json:"ambientTemperature,omitempty"`
+	SetPoint           *Point `json:"setPoint,omitempty"`
+	Humidity           *Point `json:"humidity,omitempty"`
+}
+
+func (c *AirTemperatureConfig) Validate() error {
+	if c.AmbientTemperature == nil && c.SetPoint == nil && c.Humidity == nil {
+		return errors.New("one of ambientTemperature, setPoint, or humidity is required")
+	}
+	return errors.Join(
+		c.AmbientTemperature.validate("ambientTemperature", dpt.Temperature),
+		c.SetPoint.validate("setPoint", dpt.Temperature),
+		c.Humidity.validate("humidity", dpt.Humidity),
+	)
+}
+
+// OccupancySensorConfig configures the OccupancySensor trait using a presence object, typically DPT 1.018.
+type OccupancySensorConfig struct {
+	Trait
+	Occupancy *Point `json:"occupancy,omitempty"`
+}
+
+func (c *OccupancySensorConfig) Validate() error {
+	if c.Occupancy == nil {
+		return errors.New("occupancy is required")
+	}
+	return c.Occupancy.validate("occupancy", dpt.Occupancy)
+}
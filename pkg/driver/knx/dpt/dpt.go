@@ -0,0 +1,317 @@
+// Package dpt encodes and decodes KNX datapoint types.
+//
+// Encoded data is in the form used by knxnet.LData: the first byte holds values of 6 bits or fewer,
+// larger values follow a zero first byte.
+package dpt
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// DPT identifies a KNX datapoint type, like 9.001 for temperature in °C.
+// Sub is zero when only the main type is known.
+type DPT struct {
+	Main, Sub int
+}
+
+// Common datapoint types.
+var (
+	Switch       = DPT{1, 1}
+	UpDown       = DPT{1, 8}
+	Step         = DPT{1, 7}
+	Occupancy    = DPT{1, 18}
+	Scaling      = DPT{5, 1}
+	Angle        = DPT{5, 3}
+	Temperature  = DPT{9, 1}
+	Humidity     = DPT{9, 7}
+	ActiveEnergy = DPT{13, 10}
+	HVACMode     = DPT{20, 102}
+)
+
+// Parse parses a datapoint type in one of the forms "9.001", "9", "DPT-9", or "DPST-9-1".
+// The last two are used by ETS project files.
+func Parse(s string) (DPT, error) {
+	orig := s
+	s = strings.TrimSpace(strings.ToUpper(s))
+	var sep string
+	switch {
+	case strings.HasPrefix(s, "DPST-"):
+		s, sep = strings.TrimPrefix(s, "DPST-"), "-"
+	case strings.HasPrefix(s, "DPT-"):
+		s, sep = strings.TrimPrefix(s, "DPT-"), "-"
+	default:
+		s, sep = strings.TrimPrefix(s, "DPT"), "."
+	}
+	mainStr, subStr, hasSub := strings.Cut(s, sep)
+	var d DPT
+	var err error
+	if d.Main, err = strconv.Atoi(mainStr); err != nil || d.Main <= 0 {
+		return DPT{}, fmt.Errorf("datapoint type %q: bad main number", orig)
+	}
+	if hasSub {
+		if d.Sub, err = strconv.Atoi(subStr); err != nil || d.Sub < 0 {
+			return DPT{}, fmt.Errorf("datapoint type %q: bad sub number", orig)
+		}
+	}
+	return d, nil
+}
+
+// IsZero returns whether d is unset.
+func (d DPT) IsZero() bool {
+	return d.Main == 0
+}
+
+// String returns d in the form "9.001", or "9" if there is no sub number.
+// The zero DPT is the empty string.
+func (d DPT) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	if d.Sub == 0 {
+		return strconv.Itoa(d.Main)
+	}
+	return fmt.Sprintf("%d.%03d", d.Main, d.Sub)
+}
+
+func (d DPT) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *DPT) UnmarshalText(text []byte) error {
+	p, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*d = p
+	return nil
+}
+
+// Encode encodes v as d.
+// Boolean types (DPT 1) accept a bool, all other types accept any Go number.
+// DPT 5.001 is a percentage, 0-100, and 5.003 an angle, 0-360 degrees.
+func Encode(d DPT, v any) ([]byte, error) {
+	if d.Main == 1 {
+		b, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("DPT %s: want bool, got %T", d, v)
+		}
+		if b {
+			return []byte{1}, nil
+		}
+		return []byte{0}, nil
+	}
+
+	f, ok := toFloat(v)
+	if !ok {
+		return nil, fmt.Errorf("DPT %s: want number, got %T", d, v)
+	}
+	var (
+		n   int64
+		err error
+	)
+	switch d.Main {
+	case 5, 20:
+		switch d {
+		case Scaling:
+			f = f * 255 / 100
+		case Angle:
+			f = f * 255 / 360
+		}
+		if n, err = intInRange(d, f, 0, math.MaxUint8); err != nil {
+			return nil, err
+		}
+		return []byte{0, byte(n)}, nil
+	case 6:
+		if n, err = intInRange(d, f, math.MinInt8, math.MaxInt8); err != nil {
+			return nil, err
+		}
+		return []byte{0, byte(int8(n))}, nil
+	case 7:
+		if n, err = intInRange(d, f, 0, math.MaxUint16); err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint16([]byte{0}, uint16(n)), nil
+	case 8:
+		if n, err = intInRange(d, f, math.MinInt16, math.MaxInt16); err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint16([]byte{0}, uint16(int16(n))), nil
+	case 9:
+		raw, err := encodeFloat16(f)
+		if err != nil {
+			return nil, fmt.Errorf("DPT %s: %w", d, err)
+		}
+		return binary.BigEndian.AppendUint16([]byte{0}, raw), nil
+	case 12:
+		if n, err = intInRange(d, f, 0, math.MaxUint32); err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint32([]byte{0}, uint32(n)), nil
+	case 13:
+		if n, err = intInRange(d, f, math.MinInt32, math.MaxInt32); err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint32([]byte{0}, uint32(int32(n))), nil
+	case 14:
+		return binary.BigEndian.AppendUint32([]byte{0}, math.Float32bits(float32(f))), nil
+	}
+	return nil, fmt.Errorf("DPT %s: not supported", d)
+}
+
+// Decode decodes data as d.
+// Boolean types (DPT 1) decode to bool, all other types to float64.
+func Decode(d DPT, data []byte) (any, error) {
+	if d.Main == 1 {
+		if len(data) < 1 {
+			return nil, fmt.Errorf("DPT %s: no data", d)
+		}
+		return data[0]&0x01 == 1, nil
+	}
+
+	size, ok := sizes[d.Main]
+	if !ok {
+		return nil, fmt.Errorf("DPT %s: not supported", d)
+	}
+	if len(data) != size+1 {
+		return nil, fmt.Errorf("DPT %s: want %d bytes, got %d", d, size, len(data)-1)
+	}
+	data = data[1:]
+	switch d.Main {
+	case 5, 20:
+		f := float64(data[0])
+		switch d {
+		case Scaling:
+			f = f * 100 / 255
+		case Angle:
+			f = f * 360 / 255
+		}
+		return f, nil
+	case 6:
+		return float64(int8(data[0])), nil
+	case 7:
+		return float64(binary.BigEndian.Uint16(data)), nil
+	case 8:
+		return float64(int16(binary.BigEndian.Uint16(data))), nil
+	case 9:
+		raw := binary.BigEndian.Uint16(data)
+		if raw == 0x7FFF {
+			return nil, fmt.Errorf("DPT %s: invalid data", d)
+		}
+		return decodeFloat16(raw), nil
+	case 12:
+		return float64(binary.BigEndian.Uint32(data)), nil
+	case 13:
+		return float64(int32(binary.BigEndian.Uint32(data))), nil
+	case 14:
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(data))), nil
+	}
+	return nil, fmt.Errorf("DPT %s: not supported", d)
+}
+
+// DecodeFloat is like Decode but always returns a float64, booleans are 0 or 1.
+func DecodeFloat(d DPT, data []byte) (float64, error) {
+	v, err := Decode(d, data)
+	if err != nil {
+		return 0, err
+	}
+	if b, ok := v.(bool); ok {
+		if b {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	return v.(float64), nil
+}
+
+// DecodeBool is like Decode but always returns a bool, numbers are true when not zero.
+func DecodeBool(d DPT, data []byte) (bool, error) {
+	v, err := Decode(d, data)
+	if err != nil {
+		return false, err
+	}
+	if f, ok := v.(float64); ok {
+		return f != 0, nil
+	}
+	return v.(bool), nil
+}
+
+// sizes is the number of bytes of data, after the first byte, used by each main type.
+var sizes = map[int]int{
+	5: 1, 6: 1, 7: 2, 8: 2, 9: 2, 12: 4, 13: 4, 14: 4, 20: 1,
+}
+
+// encodeFloat16 encodes f as a KNX 2-byte float, MEEEEMMM MMMMMMMM, with value 0.01*M*2^E.
+func encodeFloat16(f float64) (uint16, error) {
+	if math.IsNaN(f) || f < -671088.64 || f > 670760.96 {
+		return 0, fmt.Errorf("%v out of range", f)
+	}
+	m := f * 100
+	e := 0
+	for m < -2048 || m > 2047 {
+		m /= 2
+		e++
+	}
+	mi := int(math.Round(m))
+	mi = min(max(mi, -2048), 2047)
+	var raw uint16
+	if mi < 0 {
+		raw = 0x8000
+	}
+	return raw | uint16(e)<<11 | uint16(mi)&0x07FF, nil
+}
+
+func decodeFloat16(raw uint16) float64 {
+	m := int(raw & 0x07FF)
+	if raw&0x8000 != 0 {
+		m -= 2048
+	}
+	e := int(raw>>11) & 0x0F
+	return math.Round(float64(m)*float64(int(1)<<e)) / 100
+}
+
+func intInRange(d DPT, f float64, lo, hi float64) (int64, error) {
+	f = math.Round(f)
+	if f < lo || f > hi {
+		return 0, fmt.Errorf("DPT %s: %v out of range %v-%v", d, f, lo, hi)
+	}
+	return int64(f), nil
+}
+
+func toFloat(v any) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
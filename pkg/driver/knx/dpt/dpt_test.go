@@ -0,0 +1,117 @@
+package dpt
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want DPT
+	}{
+		{"9.001", DPT{9, 1}},
+		{"9", DPT{9, 0}},
+		{"DPT-9", DPT{9, 0}},
+		{"DPST-9-1", DPT{9, 1}},
+		{"dpst-1-18", DPT{1, 18}},
+		{"20.102", DPT{20, 102}},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if err != nil {
+			t.Errorf("Parse(%q) error = %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Parse(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+
+	for _, in := range []string{"", "x", "9.x", "DPST-", "-1"} {
+		if _, err := Parse(in); err == nil {
+			t.Errorf("Parse(%q) expected error", in)
+		}
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	tests := []struct {
+		dpt  DPT
+		v    any
+		data []byte
+		// the decoded value if different to v
+		want any
+	}{
+		{Switch, true, []byte{1}, nil},
+		{Switch, false, []byte{0}, nil},
+		{Scaling, 100.0, []byte{0, 255}, nil},
+		{Scaling, 50.0, []byte{0, 128}, 50.19607843137255},
+		{Angle, 360.0, []byte{0, 255}, nil},
+		{DPT{5, 10}, 42.0, []byte{0, 42}, nil},
+		{DPT{6, 1}, -5.0, []byte{0, 0xFB}, nil},
+		{DPT{7, 1}, 1000.0, []byte{0, 0x03, 0xE8}, nil},
+		{DPT{8, 1}, -1000.0, []byte{0, 0xFC, 0x18}, nil},
+		{Temperature, 21.0, []byte{0, 0x0C, 0x1A}, nil},
+		{Temperature, -30.0, []byte{0, 0x8A, 0x24}, nil},
+		{Temperature, 0.0, []byte{0, 0, 0}, nil},
+		{Temperature, 19.5, []byte{0, 0x07, 0x9E}, nil},
+		{DPT{12, 1}, 70000.0, []byte{0, 0, 0x01, 0x11, 0x70}, nil},
+		{ActiveEnergy, -2.0, []byte{0, 0xFF, 0xFF, 0xFF, 0xFE}, nil},
+		{DPT{14, 56}, 1.5, []byte{0, 0x3F, 0xC0, 0, 0}, nil},
+		{HVACMode, 1.0, []byte{0, 1}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dpt.String(), func(t *testing.T) {
+			data, err := Encode(tt.dpt, tt.v)
+			if err != nil {
+				t.Fatalf("Encode(%v) error = %v", tt.v, err)
+			}
+			if !bytes.Equal(data, tt.data) {
+				t.Fatalf("Encode(%v) = % x, want % x", tt.v, data, tt.data)
+			}
+			got, err := Decode(tt.dpt, data)
+			if err != nil {
+				t.Fatalf("Decode(% x) error = %v", data, err)
+			}
+			want := tt.want
+			if want == nil {
+				want = tt.v
+			}
+			if got != want {
+				t.Fatalf("Decode(% x) = %v, want %v", data, got, want)
+			}
+		})
+	}
+}
+
+func TestEncode_errors(t *testing.T) {
+	tests := []struct {
+		dpt DPT
+		v   any
+	}{
+		{Switch, 1.0},
+		{Scaling, "50"},
+		{Scaling, 101.0},
+		{DPT{6, 1}, 200},
+		{Temperature, 700000.0},
+		{DPT{16, 0}, 1},
+	}
+	for _, tt := range tests {
+		if _, err := Encode(tt.dpt, tt.v); err == nil {
+			t.Errorf("Encode(%v, %v) expected error", tt.dpt, tt.v)
+		}
+	}
+}
+
+func TestDecode_errors(t *testing.T) {
+	if _, err := Decode(Temperature, []byte{0, 0x7F, 0xFF}); err == nil {
+		t.Errorf("expected error for invalid DPT 9 data")
+	}
+	if _, err := Decode(Temperature, []byte{0, 0x0C}); err == nil {
+		t.Errorf("expected error for short DPT 9 data")
+	}
+	if _, err := Decode(Switch, nil); err == nil {
+		t.Errorf("expected error for empty DPT 1 data")
+	}
+}
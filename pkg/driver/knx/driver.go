@@ -0,0 +1,121 @@
+// Package knx implements a Smart Core driver for KNX installations, talking KNXnet/IP directly.
+// The driver connects to the bus via a tunnelling gateway or IP routing, reading and writing group addresses
+// to implement the Light, OnOff, OpenClose, AirTemperature, and OccupancySensor traits.
+//
+// Values are read from the bus when the driver connects, then kept up to date by listening for group telegrams.
+// Driver config can be generated from an ETS project export, see package ets and cmd/tools/knxproj-import.
+package knx
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver"
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/config"
+	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/proto/airtemperaturepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/lightpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/occupancysensorpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/onoffpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/openclosepb"
+	"github.com/smart-core-os/sc-bos/pkg/task/service"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+)
+
+const DriverName = "knx"
+
+var Factory driver.Factory = factory{}
+
+type factory struct{}
+
+func (f factory) New(services driver.Services) service.Lifecycle {
+	logger := services.Logger.Named(DriverName)
+	d := &Driver{
+		announcer:   node.NewReplaceAnnouncer(services.Node),
+		logger:      logger,
+		systemCheck: services.SystemCheck,
+	}
+	d.Service = service.New(
+		service.MonoApply(d.applyConfig),
+		service.WithParser(config.ParseConfig),
+		service.WithRetry[config.Root](
+			service.RetryWithLogger(func(logContext service.RetryContext) {
+				logContext.LogTo("applyConfig", logger)
+			}),
+			service.RetryWithInitialDelay(2*time.Second),
+			service.RetryWithMinDelay(2*time.Second),
+			service.RetryWithMaxDelay(30*time.Second),
+		),
+	)
+	return d
+}
+
+type Driver struct {
+	*service.Service[config.Root]
+	announcer *node.ReplaceAnnouncer
+	logger    *zap.Logger
+
+	systemCheck service.SystemCheck
+}
+
+func (d *Driver) applyConfig(ctx context.Context, cfg config.Root) error {
+	a := d.announcer.Replace(ctx)
+	b := newBus(d.logger)
+
+	for _, dev := range cfg.Devices {
+		a.Announce(dev.Name, node.HasMetadata(dev.Meta), node.HasDeviceType(metadatapb.Metadata_DEVICE))
+		for _, t := range dev.Traits {
+			features, err := newTrait(b, t)
+			if err != nil {
+				return fmt.Errorf("device '%s': %w", dev.Name, err)
+			}
+			a.Announce(cmp.Or(t.Name, dev.Name), append(features, node.HasTrait(t.Kind))...)
+		}
+	}
+
+	go b.run(ctx, cfg.Conn, d.systemCheck)
+	return nil
+}
+
+// newTrait creates the trait t using b, returning the features to announce it with.
+func newTrait(b *bus, t config.RawTrait) ([]node.Feature, error) {
+	switch t.Kind {
+	case trait.OnOff:
+		cfg, err := config.ParseTrait[config.OnOffConfig](t)
+		if err != nil {
+			return nil, err
+		}
+		return []node.Feature{node.HasServer(onoffpb.RegisterOnOffApiServer, onoffpb.OnOffApiServer(newOnOff(b, cfg)))}, nil
+	case trait.Light:
+		cfg, err := config.ParseTrait[config.LightConfig](t)
+		if err != nil {
+			return nil, err
+		}
+		return []node.Feature{node.HasServer(lightpb.RegisterLightApiServer, lightpb.LightApiServer(newLight(b, cfg)))}, nil
+	case trait.OpenClose:
+		cfg, err := config.ParseTrait[config.OpenCloseConfig](t)
+		if err != nil {
+			return nil, err
+		}
+		return []node.Feature{node.HasServer(openclosepb.RegisterOpenCloseApiServer, openclosepb.OpenCloseApiServer(newOpenClose(b, cfg)))}, nil
+	case trait.AirTemperature:
+		cfg, err := config.ParseTrait[config.AirTemperatureConfig](t)
+		if err != nil {
+			return nil, err
+		}
+		return []node.Feature{node.HasServer(airtemperaturepb.RegisterAirTemperatureApiServer, airtemperaturepb.AirTemperatureApiServer(newAirTemperature(b, cfg)))}, nil
+	case trait.OccupancySensor:
+		cfg, err := config.ParseTrait[config.OccupancySensorConfig](t)
+		if err != nil {
+			return nil, err
+		}
+		return []node.Feature{node.HasServer(occupancysensorpb.RegisterOccupancySensorApiServer, occupancysensorpb.OccupancySensorApiServer(newOccupancySensor(b, cfg)))}, nil
+	default:
+		return nil, fmt.Errorf("unknown trait kind '%s'", t.Kind)
+	}
+}
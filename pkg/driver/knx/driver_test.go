@@ -0,0 +1,210 @@
+package knx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/knxnet"
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/knxtest"
+	"github.com/smart-core-os/sc-bos/pkg/proto/airtemperaturepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/lightpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/occupancysensorpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/onoffpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/openclosepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/typespb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
+)
+
+func TestTraits(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	gw, err := knxtest.NewGateway()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { gw.Close() })
+
+	// values devices on the bus already have, read when the driver connects
+	gw.Set(ga(t, "1/1/2"), []byte{1})
+	gw.Set(ga(t, "1/1/4"), []byte{0, 128})
+	gw.Set(ga(t, "3/1/1"), []byte{0, 0x0C, 0x1A}) // 21°C
+	gw.Set(ga(t, "2/1/4"), []byte{0, 128})        // the blind is half closed
+	// the light actuator reports changes via its status addresses, the blind doesn't
+	gw.Link(ga(t, "1/1/1"), ga(t, "1/1/2"))
+	gw.Link(ga(t, "1/1/3"), ga(t, "1/1/4"))
+
+	b := newBus(zaptest.NewLogger(t))
+	sw := newOnOff(b, parseTrait[config.OnOffConfig](t, trait.OnOff, `{"onOff": {"address": "1/0/1"}}`))
+	lt := newLight(b, parseTrait[config.LightConfig](t, trait.Light, `{
+		"onOff": {"address": "1/1/1", "status": "1/1/2"},
+		"brightness": {"address": "1/1/3", "status": "1/1/4"}
+	}`))
+	blind := newOpenClose(b, parseTrait[config.OpenCloseConfig](t, trait.OpenClose, `{
+		"position": {"address": "2/1/3", "status": "2/1/4"},
+		"upDown": {"address": "2/1/1"},
+		"stop": {"address": "2/1/2"}
+	}`))
+	temp := newAirTemperature(b, parseTrait[config.AirTemperatureConfig](t, trait.AirTemperature, `{
+		"ambientTemperature": {"address": "3/1/1"},
+		"setPoint": {"address": "3/1/2"}
+	}`))
+	occ := newOccupancySensor(b, parseTrait[config.OccupancySensorConfig](t, trait.OccupancySensor, `{"occupancy": {"address": "3/1/3"}}`))
+
+	go b.run(ctx, config.Conn{Mode: config.ModeTunnel, Gateway: gw.Addr(), ReadInterval: &jsontypes.Duration{Duration: time.Millisecond}}, nil)
+
+	// initial values are read from the bus
+	eventually(t, "initial brightness", func() bool {
+		v, _ := lt.model.GetBrightness()
+		return v.LevelPercent > 50 && v.LevelPercent < 51
+	})
+	eventually(t, "initial temperature", func() bool {
+		v, _ := temp.model.GetAirTemperature()
+		return v.GetAmbientTemperature().GetValueCelsius() == 21
+	})
+
+	t.Run("onOff", func(t *testing.T) {
+		_, err := sw.UpdateOnOff(ctx, &onoffpb.UpdateOnOffRequest{OnOff: &onoffpb.OnOff{State: onoffpb.OnOff_ON}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantValue(t, gw, "1/0/1", []byte{1})
+		gw.Write(ga(t, "1/0/1"), []byte{0})
+		eventually(t, "switched off", func() bool {
+			v, _ := sw.model.GetOnOff()
+			return v.State == onoffpb.OnOff_OFF
+		})
+	})
+
+	t.Run("light", func(t *testing.T) {
+		res, err := lt.UpdateBrightness(ctx, &lightpb.UpdateBrightnessRequest{Brightness: &lightpb.Brightness{LevelPercent: 100}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.LevelPercent != 100 {
+			t.Fatalf("level = %v", res.LevelPercent)
+		}
+		wantValue(t, gw, "1/1/3", []byte{0, 255})
+		// the actuator reports it was switched off by something else
+		gw.Write(ga(t, "1/1/2"), []byte{0})
+		eventually(t, "light off", func() bool {
+			v, _ := lt.model.GetBrightness()
+			return v.LevelPercent == 0
+		})
+	})
+
+	t.Run("openClose", func(t *testing.T) {
+		res, err := blind.UpdatePositions(ctx, &openclosepb.UpdateOpenClosePositionsRequest{States: &openclosepb.OpenClosePositions{
+			States: []*openclosepb.OpenClosePosition{{OpenPercent: 100}},
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantValue(t, gw, "2/1/3", []byte{0, 0}) // KNX 0% is open
+		// the blind still reports its old position, which is what the trait reports
+		if got := res.GetStates()[0].GetOpenPercent(); got < 49 || got > 50 {
+			t.Errorf("open percent = %v, want the reported ~49.8", got)
+		}
+		if _, err := blind.Stop(ctx, &openclosepb.StopOpenCloseRequest{}); err != nil {
+			t.Fatal(err)
+		}
+		wantValue(t, gw, "2/1/2", []byte{1})
+		gw.Write(ga(t, "2/1/4"), []byte{0, 255})
+		eventually(t, "blind closed", func() bool {
+			v, _ := blind.model.GetPositions()
+			return len(v.States) == 1 && v.States[0].OpenPercent == 0
+		})
+	})
+
+	t.Run("airTemperature", func(t *testing.T) {
+		_, err := temp.UpdateAirTemperature(ctx, &airtemperaturepb.UpdateAirTemperatureRequest{State: &airtemperaturepb.AirTemperature{
+			TemperatureGoal: &airtemperaturepb.AirTemperature_TemperatureSetPoint{TemperatureSetPoint: &typespb.Temperature{ValueCelsius: 19.5}},
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantValue(t, gw, "3/1/2", []byte{0, 0x07, 0x9E})
+
+		_, err = temp.UpdateAirTemperature(ctx, &airtemperaturepb.UpdateAirTemperatureRequest{State: &airtemperaturepb.AirTemperature{
+			AmbientTemperature: &typespb.Temperature{ValueCelsius: 10},
+		}})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("updating ambient temperature: got %v, want InvalidArgument", err)
+		}
+	})
+
+	t.Run("occupancy", func(t *testing.T) {
+		gw.Write(ga(t, "3/1/3"), []byte{1})
+		eventually(t, "occupied", func() bool {
+			v, _ := occ.model.GetOccupancy()
+			return v.State == occupancysensorpb.Occupancy_OCCUPIED && v.StateChangeTime != nil
+		})
+	})
+}
+
+func TestBus_notConnected(t *testing.T) {
+	b := newBus(zaptest.NewLogger(t))
+	sw := newOnOff(b, parseTrait[config.OnOffConfig](t, trait.OnOff, `{"onOff": {"address": "1/0/1"}}`))
+	_, err := sw.UpdateOnOff(context.Background(), &onoffpb.UpdateOnOffRequest{OnOff: &onoffpb.OnOff{State: onoffpb.OnOff_ON}})
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("got %v, want Unavailable", err)
+	}
+}
+
+func parseTrait[T any, P interface {
+	*T
+	Validate() error
+}](t *testing.T, kind trait.Name, raw string) *T {
+	t.Helper()
+	var m map[string]any
+	if err := json.Unmarshal([]byte(raw), &m); err != nil {
+		t.Fatal(err)
+	}
+	m["kind"] = kind
+	data, _ := json.Marshal(m)
+	var rt config.RawTrait
+	if err := json.Unmarshal(data, &rt); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := config.ParseTrait[T, P](rt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
+
+func ga(t *testing.T, s string) knxnet.GroupAddress {
+	t.Helper()
+	a, err := knxnet.ParseGroupAddress(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func wantValue(t *testing.T, gw *knxtest.Gateway, addr string, want []byte) {
+	t.Helper()
+	got, _ := gw.Value(ga(t, addr))
+	if !bytes.Equal(got, want) {
+		t.Fatalf("%s = % x, want % x", addr, got, want)
+	}
+}
+
+func eventually(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timeout waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,210 @@
+// Package ets reads group addresses from ETS project exports (.knxproj files) and proposes driver config for them.
+package ets
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/dpt"
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/knxnet"
+)
+
+// ErrPasswordProtected is returned when the project is encrypted.
+// Projects can be exported without a password from ETS.
+var ErrPasswordProtected = errors.New("password protected projects are not supported, export the project without a password")
+
+// Project is the part of an ETS project used by the driver.
+type Project struct {
+	Name           string
+	GroupAddresses []GroupAddress
+}
+
+// GroupAddress is a group address defined in an ETS project.
+type GroupAddress struct {
+	Address     knxnet.GroupAddress
+	Name        string
+	Description string
+	// DPT is the datapoint type assigned in ETS, zero if none was assigned.
+	DPT dpt.DPT
+	// Ranges are the names of the group ranges the address is in, outermost first.
+	// For 3-level addresses this is the main and middle group names.
+	Ranges []string
+}
+
+// ReadProjectFile reads the .knxproj file at name.
+func ReadProjectFile(name string) (*Project, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return ReadProject(f, info.Size())
+}
+
+// ReadProject reads a .knxproj file, which is a zip archive.
+func ReadProject(r io.ReaderAt, size int64) (*Project, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("not a knxproj file: %w", err)
+	}
+
+	var data, info *zip.File
+	var nested []*zip.File
+	for _, f := range zr.File {
+		dir, base := path.Split(f.Name)
+		if dir == "" && strings.HasPrefix(base, "P-") && strings.HasSuffix(base, ".zip") {
+			nested = append(nested, f)
+			continue
+		}
+		if !strings.HasPrefix(dir, "P-") {
+			continue
+		}
+		switch base {
+		case "0.xml":
+			data = f
+		case "project.xml":
+			info = f
+		}
+	}
+	if data == nil {
+		if len(nested) > 0 {
+			// newer versions of ETS put the project in a nested zip, which is encrypted when a password is set
+			return readNested(nested[0])
+		}
+		return nil, errors.New("no project data found")
+	}
+	return readProject(data, info)
+}
+
+func readNested(f *zip.File) (*Project, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	buf, err := io.ReadAll(rc)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf), int64(len(buf)))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", f.Name, err)
+	}
+	var data, info *zip.File
+	for _, f := range zr.File {
+		if f.Flags&0x1 != 0 {
+			return nil, ErrPasswordProtected
+		}
+		switch path.Base(f.Name) {
+		case "0.xml":
+			data = f
+		case "project.xml":
+			info = f
+		}
+	}
+	if data == nil {
+		return nil, errors.New("no project data found")
+	}
+	return readProject(data, info)
+}
+
+func readProject(data, info *zip.File) (*Project, error) {
+	var doc etsDoc
+	if err := decodeXML(data, &doc); err != nil {
+		return nil, err
+	}
+	p := &Project{}
+	if info != nil {
+		var infoDoc etsDoc
+		if err := decodeXML(info, &infoDoc); err == nil {
+			p.Name = infoDoc.Project.Information.Name
+		}
+	}
+	for _, inst := range doc.Project.Installations {
+		for _, r := range inst.GroupRanges {
+			if err := p.addRange(r, nil); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return p, nil
+}
+
+func (p *Project) addRange(r etsGroupRange, parents []string) error {
+	ranges := append(parents[:len(parents):len(parents)], r.Name)
+	for _, ga := range r.Addresses {
+		addr, err := knxnet.ParseGroupAddress(ga.Address)
+		if err != nil {
+			return fmt.Errorf("group address %q: %w", ga.Name, err)
+		}
+		res := GroupAddress{
+			Address:     addr,
+			Name:        ga.Name,
+			Description: ga.Description,
+			Ranges:      ranges,
+		}
+		if ga.DatapointType != "" {
+			// multiple types can be assigned, the first is the one used on the bus
+			first, _, _ := strings.Cut(ga.DatapointType, " ")
+			if d, err := dpt.Parse(first); err == nil {
+				res.DPT = d
+			}
+		}
+		p.GroupAddresses = append(p.GroupAddresses, res)
+	}
+	for _, child := range r.Ranges {
+		if err := p.addRange(child, ranges); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeXML(f *zip.File, v any) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	if err := xml.NewDecoder(rc).Decode(v); err != nil {
+		return fmt.Errorf("%s: %w", f.Name, err)
+	}
+	return nil
+}
+
+// etsDoc is the root of the project xml files.
+// Tags have no namespace so they match any version of the ETS project schema.
+type etsDoc struct {
+	Project struct {
+		Information struct {
+			Name string `xml:"Name,attr"`
+		} `xml:"ProjectInformation"`
+		Installations []struct {
+			GroupRanges []etsGroupRange `xml:"GroupAddresses>GroupRanges>GroupRange"`
+		} `xml:"Installations>Installation"`
+	} `xml:"Project"`
+}
+
+type etsGroupRange struct {
+	Name      string            `xml:"Name,attr"`
+	Ranges    []etsGroupRange   `xml:"GroupRange"`
+	Addresses []etsGroupAddress `xml:"GroupAddress"`
+}
+
+type etsGroupAddress struct {
+	Address       string `xml:"Address,attr"`
+	Name          string `xml:"Name,attr"`
+	Description   string `xml:"Description,attr"`
+	DatapointType string `xml:"DatapointType,attr"`
+}
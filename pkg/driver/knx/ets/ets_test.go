@@ -0,0 +1,243 @@
+package ets
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/dpt"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+)
+
+const projectXML = `<?xml version="1.0" encoding="utf-8"?>
+<KNX xmlns="http://knx.org/xml/project/21">
+  <Project Id="P-0001">
+    <ProjectInformation Name="Test Building" />
+  </Project>
+</KNX>`
+
+const dataXML = `<?xml version="1.0" encoding="utf-8"?>
+<KNX xmlns="http://knx.org/xml/project/21">
+  <Project Id="P-0001">
+    <Installations>
+      <Installation Name="" InstallationId="0">
+        <GroupAddresses>
+          <GroupRanges>
+            <GroupRange Id="P-0001-0_GR-1" RangeStart="2048" RangeEnd="4095" Name="Lighting">
+              <GroupRange Id="P-0001-0_GR-2" RangeStart="2304" RangeEnd="2559" Name="Ground Floor">
+                <GroupAddress Id="P-0001-0_GA-1" Address="2305" Name="Kitchen light switch" DatapointType="DPST-1-1" />
+                <GroupAddress Id="P-0001-0_GA-2" Address="2306" Name="Kitchen light switch status" DatapointType="DPST-1-1" />
+                <GroupAddress Id="P-0001-0_GA-3" Address="2307" Name="Kitchen light dimming value" DatapointType="DPST-5-1" />
+                <GroupAddress Id="P-0001-0_GA-4" Address="2308" Name="Kitchen light dimming status" DatapointType="DPST-5-1" />
+                <GroupAddress Id="P-0001-0_GA-5" Address="2309" Name="Hall light on/off" DatapointType="DPST-1-1" />
+                <GroupAddress Id="P-0001-0_GA-6" Address="2310" Name="Extract fan" DatapointType="DPST-1-1" />
+                <GroupAddress Id="P-0001-0_GA-7" Address="2311" Name="Kitchen light dimming" DatapointType="DPST-3-7" />
+                <GroupAddress Id="P-0001-0_GA-8" Address="2312" Name="Unassigned" />
+              </GroupRange>
+            </GroupRange>
+            <GroupRange Id="P-0001-0_GR-3" RangeStart="4096" RangeEnd="6143" Name="Blinds">
+              <GroupRange Id="P-0001-0_GR-4" RangeStart="4352" RangeEnd="4607" Name="Ground Floor">
+                <GroupAddress Id="P-0001-0_GA-9" Address="4353" Name="Kitchen blind up/down" DatapointType="DPST-1-8" />
+                <GroupAddress Id="P-0001-0_GA-10" Address="4354" Name="Kitchen blind stop" DatapointType="DPST-1-7" />
+                <GroupAddress Id="P-0001-0_GA-11" Address="4355" Name="Kitchen blind position" DatapointType="DPST-5-1" />
+                <GroupAddress Id="P-0001-0_GA-12" Address="4356" Name="Kitchen blind position status" DatapointType="DPST-5-1" />
+              </GroupRange>
+            </GroupRange>
+            <GroupRange Id="P-0001-0_GR-5" RangeStart="6144" RangeEnd="8191" Name="HVAC">
+              <GroupRange Id="P-0001-0_GR-6" RangeStart="6400" RangeEnd="6655" Name="Ground Floor">
+                <GroupAddress Id="P-0001-0_GA-13" Address="6401" Name="Kitchen actual temperature" DatapointType="DPST-9-1" />
+                <GroupAddress Id="P-0001-0_GA-14" Address="6402" Name="Kitchen setpoint" DatapointType="DPST-9-1 DPST-9-2" />
+                <GroupAddress Id="P-0001-0_GA-15" Address="6403" Name="Kitchen presence" DatapointType="DPST-1-18" />
+              </GroupRange>
+            </GroupRange>
+          </GroupRanges>
+        </GroupAddresses>
+      </Installation>
+    </Installations>
+  </Project>
+</KNX>`
+
+func TestReadProject(t *testing.T) {
+	data := zipFiles(t, map[string]string{
+		"knx_master.xml":     "<KNX/>",
+		"P-0001/project.xml": projectXML,
+		"P-0001/0.xml":       dataXML,
+	})
+	p, err := ReadProject(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "Test Building" {
+		t.Errorf("Name = %q", p.Name)
+	}
+	if len(p.GroupAddresses) != 15 {
+		t.Fatalf("got %d group addresses, want 15", len(p.GroupAddresses))
+	}
+	want := GroupAddress{Address: 2305, Name: "Kitchen light switch", DPT: dpt.Switch, Ranges: []string{"Lighting", "Ground Floor"}}
+	if diff := cmp.Diff(want, p.GroupAddresses[0]); diff != "" {
+		t.Errorf("first group address (-want,+got)\n%s", diff)
+	}
+	if got := p.GroupAddresses[0].Address.String(); got != "1/1/1" {
+		t.Errorf("address = %s, want 1/1/1", got)
+	}
+	if got := p.GroupAddresses[13].DPT; got != dpt.Temperature {
+		t.Errorf("first of multiple DPTs = %v, want 9.001", got)
+	}
+}
+
+func TestReadProject_nested(t *testing.T) {
+	nested := zipFiles(t, map[string]string{"0.xml": dataXML})
+	data := zipFiles(t, map[string]string{"P-0001.zip": string(nested)})
+	p, err := ReadProject(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.GroupAddresses) != 15 {
+		t.Fatalf("got %d group addresses, want 15", len(p.GroupAddresses))
+	}
+}
+
+func TestReadProject_passwordProtected(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, err := zw.CreateHeader(&zip.FileHeader{Name: "0.xml", Flags: 0x1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = w.Write([]byte("encrypted"))
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	data := zipFiles(t, map[string]string{"P-0001.zip": buf.String()})
+	_, err = ReadProject(bytes.NewReader(data), int64(len(data)))
+	if !errors.Is(err, ErrPasswordProtected) {
+		t.Fatalf("got error %v, want ErrPasswordProtected", err)
+	}
+}
+
+func TestProposeDevices(t *testing.T) {
+	data := zipFiles(t, map[string]string{"P-0001/0.xml": dataXML})
+	p, err := ReadProject(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := ProposeDevices(p, "floors/ground/")
+
+	type traitSummary struct {
+		Name string
+		Kind trait.Name
+		Cfg  map[string]any
+	}
+	got := make(map[string][]traitSummary)
+	for _, d := range res.Devices {
+		for _, tr := range d.Traits {
+			var m map[string]any
+			if err := json.Unmarshal(tr.Raw, &m); err != nil {
+				t.Fatal(err)
+			}
+			got[d.Name] = append(got[d.Name], traitSummary{Name: tr.Name, Kind: tr.Kind, Cfg: m})
+		}
+	}
+	want := map[string][]traitSummary{
+		"floors/ground/ground-floor/kitchen-light": {{
+			Name: "floors/ground/ground-floor/kitchen-light", Kind: trait.Light,
+			Cfg: map[string]any{
+				"name": "floors/ground/ground-floor/kitchen-light", "kind": string(trait.Light),
+				"brightness": map[string]any{"address": "1/1/3", "status": "1/1/4", "dpt": "5.001"},
+				"onOff":      map[string]any{"address": "1/1/1", "status": "1/1/2", "dpt": "1.001"},
+			},
+		}},
+		"floors/ground/ground-floor/hall-light": {{
+			Name: "floors/ground/ground-floor/hall-light", Kind: trait.Light,
+			Cfg: map[string]any{
+				"name": "floors/ground/ground-floor/hall-light", "kind": string(trait.Light),
+				"onOff": map[string]any{"address": "1/1/5", "dpt": "1.001"},
+			},
+		}},
+		"floors/ground/ground-floor/extract-fan": {{
+			Name: "floors/ground/ground-floor/extract-fan", Kind: trait.OnOff,
+			Cfg: map[string]any{
+				"name": "floors/ground/ground-floor/extract-fan", "kind": string(trait.OnOff),
+				"onOff": map[string]any{"address": "1/1/6", "dpt": "1.001"},
+			},
+		}},
+		"floors/ground/ground-floor/kitchen-blind": {{
+			Name: "floors/ground/ground-floor/kitchen-blind", Kind: trait.OpenClose,
+			Cfg: map[string]any{
+				"name": "floors/ground/ground-floor/kitchen-blind", "kind": string(trait.OpenClose),
+				"upDown":   map[string]any{"address": "2/1/1", "dpt": "1.008"},
+				"stop":     map[string]any{"address": "2/1/2", "dpt": "1.007"},
+				"position": map[string]any{"address": "2/1/3", "status": "2/1/4", "dpt": "5.001"},
+			},
+		}},
+		"floors/ground/ground-floor/kitchen": {
+			{
+				Name: "floors/ground/ground-floor/kitchen", Kind: trait.AirTemperature,
+				Cfg: map[string]any{
+					"name": "floors/ground/ground-floor/kitchen", "kind": string(trait.AirTemperature),
+					"ambientTemperature": map[string]any{"address": "3/1/1", "dpt": "9.001"},
+					"setPoint":           map[string]any{"address": "3/1/2", "dpt": "9.001"},
+				},
+			},
+			{
+				Name: "floors/ground/ground-floor/kitchen", Kind: trait.OccupancySensor,
+				Cfg: map[string]any{
+					"name": "floors/ground/ground-floor/kitchen", "kind": string(trait.OccupancySensor),
+					"occupancy": map[string]any{"address": "3/1/3", "dpt": "1.018"},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("proposed devices (-want,+got)\n%s", diff)
+	}
+	var skipped []string
+	for _, ga := range res.Skipped {
+		skipped = append(skipped, ga.Name)
+	}
+	if diff := cmp.Diff([]string{"Kitchen light dimming", "Unassigned"}, skipped); diff != "" {
+		t.Errorf("skipped (-want,+got)\n%s", diff)
+	}
+
+	// proposed traits must be valid driver config
+	for _, d := range res.Devices {
+		for _, tr := range d.Traits {
+			if err := validateTrait(tr); err != nil {
+				t.Errorf("%s %s: %v", d.Name, tr.Kind, err)
+			}
+		}
+	}
+}
+
+func validateTrait(t config.RawTrait) error {
+	raw, err := json.Marshal(config.Root{Conn: config.Conn{Gateway: "localhost"}, Devices: []config.Device{{Name: "d", Traits: []config.RawTrait{t}}}})
+	if err != nil {
+		return err
+	}
+	_, err = config.ParseConfig(raw)
+	return err
+}
+
+func zipFiles(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,280 @@
+package ets
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/dpt"
+	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+)
+
+// role is what a group address is used for in a device.
+type role int
+
+const (
+	roleNone role = iota
+	roleSwitch
+	roleSwitchStatus
+	roleBrightness
+	roleBrightnessStatus
+	rolePosition
+	rolePositionStatus
+	roleUpDown
+	roleStop
+	roleTemperature
+	roleSetPoint
+	roleHumidity
+	roleOccupancy
+)
+
+var (
+	statusWords    = []string{"status", "state", "feedback", "fb", "rm", "response"}
+	blindWords     = []string{"blind", "blinds", "shutter", "shutters", "jalousie", "shade", "curtain", "position"}
+	setPointWords  = []string{"setpoint", "sp", "set"}
+	occupancyWords = []string{"occupancy", "occupied", "presence", "pir"}
+	lightWords     = []string{"light", "lights", "lighting", "lamp", "luminaire"}
+	// functionWords, along with the status, set point, and occupancy words, describe what a group address does
+	// rather than what it belongs to, they are removed from names to find the device an address belongs to.
+	functionWords = []string{
+		"switch", "switching", "on", "off", "onoff", "value", "dim", "dimming", "dimmer", "brightness", "level",
+		"position", "pos", "updown", "up", "down", "move", "stop", "step", "actual", "point", "temp", "temperature",
+		"humidity", "rh", "command", "cmd", "control", "absolute", "abs", "percent",
+	}
+)
+
+// Proposal is driver config proposed for a project.
+type Proposal struct {
+	Devices []config.Device
+	// Skipped are the group addresses that weren't used, because their purpose couldn't be guessed.
+	Skipped []GroupAddress
+}
+
+// ProposeDevices proposes driver config for the group addresses in p, naming devices with the given prefix.
+//
+// Each group address is assigned a role based on its datapoint type and name, addresses without a datapoint type
+// are skipped.
+// Addresses are grouped into devices by their middle group, or main group for 2-level addresses, and their name
+// with words describing the function of the address removed.
+// For example "Kitchen light switch" and "Kitchen light status" in the middle group "Ground floor"
+// become the device "ground-floor/kitchen-light".
+func ProposeDevices(p *Project, prefix string) Proposal {
+	var res Proposal
+	var order []string
+	devices := make(map[string]*proposedDevice)
+	for _, ga := range p.GroupAddresses {
+		r := classify(ga)
+		if r == roleNone {
+			res.Skipped = append(res.Skipped, ga)
+			continue
+		}
+		key, title := deviceKey(ga)
+		d, ok := devices[key]
+		if !ok {
+			d = &proposedDevice{name: prefix + key, title: title, points: make(map[role]*config.Point)}
+			devices[key] = d
+			order = append(order, key)
+		}
+		if !d.add(r, ga) {
+			res.Skipped = append(res.Skipped, ga)
+		}
+	}
+	for _, key := range order {
+		res.Devices = append(res.Devices, devices[key].config())
+	}
+	return res
+}
+
+type proposedDevice struct {
+	name, title string
+	light       bool
+	// keyed by the command role, status roles are recorded as the Point.Status
+	points map[role]*config.Point
+}
+
+// add records ga as the address for r, returning false if d already has an address for r.
+func (d *proposedDevice) add(r role, ga GroupAddress) bool {
+	cmd, isStatus := r, false
+	switch r {
+	case roleSwitchStatus:
+		cmd, isStatus = roleSwitch, true
+	case roleBrightnessStatus:
+		cmd, isStatus = roleBrightness, true
+	case rolePositionStatus:
+		cmd, isStatus = rolePosition, true
+	}
+	p, ok := d.points[cmd]
+	if !ok {
+		p = &config.Point{DPT: ga.DPT}
+		d.points[cmd] = p
+	}
+	if isStatus {
+		if p.Status != 0 {
+			return false
+		}
+		p.Status = ga.Address
+		return true
+	}
+	if p.Address != 0 {
+		return false
+	}
+	p.Address, p.DPT = ga.Address, ga.DPT
+	if hasWord(ga, lightWords...) {
+		d.light = true
+	}
+	return true
+}
+
+func (d *proposedDevice) config() config.Device {
+	res := config.Device{
+		Name: d.name,
+		Meta: &metadatapb.Metadata{Appearance: &metadatapb.Metadata_Appearance{Title: d.title}},
+	}
+	add := func(kind trait.Name, cfg any) {
+		res.Traits = append(res.Traits, rawTrait(config.Trait{Name: d.name, Kind: kind}, cfg))
+	}
+	pt := d.points
+	switch {
+	case pt[rolePosition] != nil || pt[roleUpDown] != nil:
+		add(trait.OpenClose, config.OpenCloseConfig{Position: pt[rolePosition], UpDown: pt[roleUpDown], Stop: pt[roleStop]})
+	case pt[roleBrightness] != nil:
+		add(trait.Light, config.LightConfig{Brightness: pt[roleBrightness], OnOff: pt[roleSwitch]})
+	case pt[roleSwitch] != nil && d.light:
+		add(trait.Light, config.LightConfig{OnOff: pt[roleSwitch]})
+	case pt[roleSwitch] != nil:
+		add(trait.OnOff, config.OnOffConfig{OnOff: pt[roleSwitch]})
+	}
+	if pt[roleTemperature] != nil || pt[roleSetPoint] != nil || pt[roleHumidity] != nil {
+		add(trait.AirTemperature, config.AirTemperatureConfig{
+			AmbientTemperature: pt[roleTemperature],
+			SetPoint:           pt[roleSetPoint],
+			Humidity:           pt[roleHumidity],
+		})
+	}
+	if pt[roleOccupancy] != nil {
+		add(trait.OccupancySensor, config.OccupancySensorConfig{Occupancy: pt[roleOccupancy]})
+	}
+	return res
+}
+
+// rawTrait returns t with config cfg, which is a trait config struct without its Trait set.
+func rawTrait(t config.Trait, cfg any) config.RawTrait {
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		panic(err) // all trait configs marshal
+	}
+	var m map[string]any
+	if err := json.Unmarshal(data, &m); err != nil {
+		panic(err)
+	}
+	m["name"], m["kind"] = t.Name, t.Kind
+	raw, err := json.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	return config.RawTrait{Trait: t, Raw: raw}
+}
+
+// classify guesses the role of ga from its datapoint type and name.
+func classify(ga GroupAddress) role {
+	d := ga.DPT
+	status := hasWord(ga, statusWords...)
+	switch d.Main {
+	case 1:
+		switch {
+		case d == dpt.UpDown:
+			return roleUpDown
+		case d == dpt.Step || d == (dpt.DPT{Main: 1, Sub: 10}) || d == (dpt.DPT{Main: 1, Sub: 17}):
+			return roleStop
+		case d == dpt.Occupancy || hasWord(ga, occupancyWords...):
+			return roleOccupancy
+		case status:
+			return roleSwitchStatus
+		default:
+			return roleSwitch
+		}
+	case 5:
+		if d != dpt.Scaling && d.Sub != 0 {
+			return roleNone
+		}
+		switch {
+		case hasWord(ga, blindWords...) && status:
+			return rolePositionStatus
+		case hasWord(ga, blindWords...):
+			return rolePosition
+		case status:
+			return roleBrightnessStatus
+		default:
+			return roleBrightness
+		}
+	case 9:
+		switch {
+		case d == dpt.Humidity:
+			return roleHumidity
+		case d != dpt.Temperature && d.Sub != 0:
+			return roleNone
+		case hasWord(ga, setPointWords...):
+			return roleSetPoint
+		default:
+			return roleTemperature
+		}
+	}
+	return roleNone
+}
+
+// deviceKey returns the name of the device ga belongs to, relative to the config prefix, and a title for it.
+func deviceKey(ga GroupAddress) (key, title string) {
+	var group string
+	if len(ga.Ranges) > 0 {
+		group = slug(words(ga.Ranges[len(ga.Ranges)-1]))
+	}
+	var stem []string
+	for _, w := range words(ga.Name) {
+		if !isFunctionWord(w) {
+			stem = append(stem, w)
+		}
+	}
+	title = strings.Join(stem, " ")
+	if len(stem) > 0 {
+		title = strings.ToUpper(title[:1]) + title[1:]
+	}
+	switch {
+	case group == "":
+		return slug(stem), title
+	case len(stem) == 0:
+		return group, ga.Ranges[len(ga.Ranges)-1]
+	default:
+		return group + "/" + slug(stem), title
+	}
+}
+
+func isFunctionWord(w string) bool {
+	return slices.Contains(functionWords, w) || slices.Contains(statusWords, w) ||
+		slices.Contains(setPointWords, w) || slices.Contains(occupancyWords, w)
+}
+
+func hasWord(ga GroupAddress, ws ...string) bool {
+	for _, w := range words(ga.Name) {
+		if slices.Contains(ws, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// words splits s into lower case words, splitting on anything other than letters and digits.
+// Some common compound words are kept together, like "on/off".
+func words(s string) []string {
+	s = strings.ToLower(s)
+	s = strings.NewReplacer("on/off", "onoff", "up/down", "updown", "set point", "setpoint", "set-point", "setpoint").Replace(s)
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func slug(words []string) string {
+	return strings.Join(words, "-")
+}
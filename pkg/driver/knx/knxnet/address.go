@@ -0,0 +1,102 @@
+package knxnet
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GroupAddress is a KNX group address, the destination of group telegrams.
+// The zero value, 0/0/0, is the broadcast address and is not used for group communication.
+type GroupAddress uint16
+
+// ParseGroupAddress parses a group address in 3-level "main/middle/sub", 2-level "main/sub", or raw "1234" form.
+func ParseGroupAddress(s string) (GroupAddress, error) {
+	parts := strings.Split(s, "/")
+	var (
+		limits []uint64
+		shifts []int
+	)
+	switch len(parts) {
+	case 1:
+		limits, shifts = []uint64{0xFFFF}, []int{0}
+	case 2:
+		limits, shifts = []uint64{31, 2047}, []int{11, 0}
+	case 3:
+		limits, shifts = []uint64{31, 7, 255}, []int{11, 8, 0}
+	default:
+		return 0, fmt.Errorf("group address %q: too many parts", s)
+	}
+	var ga uint16
+	for i, p := range parts {
+		n, err := strconv.ParseUint(strings.TrimSpace(p), 10, 16)
+		if err != nil {
+			return 0, fmt.Errorf("group address %q: %w", s, err)
+		}
+		if n > limits[i] {
+			return 0, fmt.Errorf("group address %q: part %d out of range 0-%d", s, i+1, limits[i])
+		}
+		ga |= uint16(n) << shifts[i]
+	}
+	return GroupAddress(ga), nil
+}
+
+// String returns the 3-level form of g, like "1/2/3".
+func (g GroupAddress) String() string {
+	return fmt.Sprintf("%d/%d/%d", g>>11, (g>>8)&0x07, g&0xFF)
+}
+
+func (g GroupAddress) MarshalText() ([]byte, error) {
+	return []byte(g.String()), nil
+}
+
+func (g *GroupAddress) UnmarshalText(text []byte) error {
+	ga, err := ParseGroupAddress(string(text))
+	if err != nil {
+		return err
+	}
+	*g = ga
+	return nil
+}
+
+// IndividualAddress is the address of a device on the KNX bus, like 1.1.10.
+type IndividualAddress uint16
+
+// ParseIndividualAddress parses an individual address in "area.line.device" form.
+func ParseIndividualAddress(s string) (IndividualAddress, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("individual address %q: want area.line.device", s)
+	}
+	limits, shifts := []uint64{15, 15, 255}, []int{12, 8, 0}
+	var ia uint16
+	for i, p := range parts {
+		n, err := strconv.ParseUint(strings.TrimSpace(p), 10, 8)
+		if err != nil {
+			return 0, fmt.Errorf("individual address %q: %w", s, err)
+		}
+		if n > limits[i] {
+			return 0, fmt.Errorf("individual address %q: part %d out of range 0-%d", s, i+1, limits[i])
+		}
+		ia |= uint16(n) << shifts[i]
+	}
+	return IndividualAddress(ia), nil
+}
+
+// String returns ia in "area.line.device" form.
+func (ia IndividualAddress) String() string {
+	return fmt.Sprintf("%d.%d.%d", ia>>12, (ia>>8)&0x0F, ia&0xFF)
+}
+
+func (ia IndividualAddress) MarshalText() ([]byte, error) {
+	return []byte(ia.String()), nil
+}
+
+func (ia *IndividualAddress) UnmarshalText(text []byte) error {
+	a, err := ParseIndividualAddress(string(text))
+	if err != nil {
+		return err
+	}
+	*ia = a
+	return nil
+}
@@ -0,0 +1,117 @@
+package knxnet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// MessageCode identifies the kind of cEMI message.
+type MessageCode byte
+
+const (
+	LDataReq MessageCode = 0x11 // request to send a telegram on the bus
+	LDataInd MessageCode = 0x29 // a telegram received from the bus
+	LDataCon MessageCode = 0x2E // confirmation that a requested telegram was sent
+)
+
+// APCI is the application layer service of a group telegram.
+type APCI uint16
+
+const (
+	GroupValueRead     APCI = 0x000
+	GroupValueResponse APCI = 0x040
+	GroupValueWrite    APCI = 0x080
+)
+
+func (a APCI) String() string {
+	switch a {
+	case GroupValueRead:
+		return "GroupValueRead"
+	case GroupValueResponse:
+		return "GroupValueResponse"
+	case GroupValueWrite:
+		return "GroupValueWrite"
+	default:
+		return fmt.Sprintf("APCI(%#03x)", uint16(a))
+	}
+}
+
+const (
+	// standard frame, no repeat, broadcast, low priority
+	ctrl1 = 0xBC
+	// group destination, hop count 6
+	ctrl2 = 0xE0
+	// the bit in ctrl2 that says the destination is a group address
+	ctrl2Group = 0x80
+	// the minimum size of an L_Data cEMI frame: code, add info len, ctrl1, ctrl2, src, dst, npdu len, tpci, apci
+	minLDataLen = 11
+)
+
+// LData is a cEMI L_Data message carrying a group telegram.
+//
+// Data holds the telegram payload as it is encoded by package dpt.
+// The first byte of Data is packed into the low 6 bits of the APCI, so 1 bit values like DPT 1 use a single byte
+// and larger values have a leading zero byte, for example DPT 9 is {0, hi, lo}.
+// Data may be empty for GroupValueRead.
+type LData struct {
+	Code        MessageCode
+	Source      IndividualAddress
+	Destination GroupAddress
+	APCI        APCI
+	Data        []byte
+}
+
+func (f LData) MarshalBinary() ([]byte, error) {
+	data := f.Data
+	if len(data) == 0 {
+		data = []byte{0}
+	}
+	if data[0] > 0x3F {
+		return nil, fmt.Errorf("first data byte %#02x does not fit in 6 bits", data[0])
+	}
+	if len(data) > 255 {
+		return nil, errors.New("data too long")
+	}
+	b := make([]byte, 0, minLDataLen+len(data)-1)
+	b = append(b, byte(f.Code), 0, ctrl1, ctrl2)
+	b = binary.BigEndian.AppendUint16(b, uint16(f.Source))
+	b = binary.BigEndian.AppendUint16(b, uint16(f.Destination))
+	b = append(b, byte(len(data)))
+	b = append(b, byte(f.APCI>>8)&0x03, byte(f.APCI)&0xC0|data[0])
+	b = append(b, data[1:]...)
+	return b, nil
+}
+
+func (f *LData) UnmarshalBinary(b []byte) error {
+	if len(b) < 2 {
+		return errors.New("cEMI frame too short")
+	}
+	f.Code = MessageCode(b[0])
+	switch f.Code {
+	case LDataReq, LDataInd, LDataCon:
+	default:
+		return fmt.Errorf("unsupported cEMI message code %#02x", b[0])
+	}
+	// skip additional info
+	if len(b) < 2+int(b[1])+minLDataLen-2 {
+		return errors.New("cEMI frame too short")
+	}
+	b = b[2+int(b[1]):]
+	if b[1]&ctrl2Group == 0 {
+		return errors.New("not a group telegram")
+	}
+	f.Source = IndividualAddress(binary.BigEndian.Uint16(b[2:]))
+	f.Destination = GroupAddress(binary.BigEndian.Uint16(b[4:]))
+	n := int(b[6])
+	apdu := b[7:]
+	if n < 1 {
+		return errors.New("not a group value telegram")
+	}
+	if len(apdu) < n+1 {
+		return fmt.Errorf("cEMI frame has %d APDU bytes, want %d", len(apdu), n+1)
+	}
+	f.APCI = APCI(apdu[0]&0x03)<<8 | APCI(apdu[1]&0xC0)
+	f.Data = append([]byte{apdu[1] & 0x3F}, apdu[2:n+1]...)
+	return nil
+}
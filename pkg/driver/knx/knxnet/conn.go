@@ -0,0 +1,43 @@
+// Package knxnet implements the parts of KNXnet/IP needed to read and write KNX group addresses.
+//
+// Two kinds of connection are supported:
+//   - Tunnel, a KNXnet/IP tunnelling connection to a gateway over UDP, see DialTunnel
+//   - Router, KNXnet/IP routing using multicast, see ListenRouting
+//
+// Both implement Conn, which sends and receives group telegrams as cEMI LData messages.
+package knxnet
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrClosed is returned when using a Conn that has been closed.
+var ErrClosed = errors.New("connection closed")
+
+// Conn is a connection to a KNX bus.
+type Conn interface {
+	// Send sends a group telegram to the bus.
+	// The Code and Source of f are filled in by the Conn.
+	Send(ctx context.Context, f LData) error
+	// Inbound returns telegrams received from the bus.
+	// The channel is closed when the Conn is closed.
+	Inbound() <-chan LData
+	// Done is closed when the Conn is closed, either by calling Close or because the connection failed.
+	Done() <-chan struct{}
+	// Err returns why the Conn was closed, or nil if it is still open.
+	Err() error
+	// Close closes the connection.
+	Close() error
+}
+
+// Write sends a GroupValueWrite telegram to ga containing data.
+func Write(ctx context.Context, c Conn, ga GroupAddress, data []byte) error {
+	return c.Send(ctx, LData{Destination: ga, APCI: GroupValueWrite, Data: data})
+}
+
+// Read sends a GroupValueRead telegram to ga.
+// Any response is received via Conn.Inbound as a GroupValueResponse.
+func Read(ctx context.Context, c Conn, ga GroupAddress) error {
+	return c.Send(ctx, LData{Destination: ga, APCI: GroupValueRead})
+}
@@ -0,0 +1,172 @@
+package knxnet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/netip"
+)
+
+// ServiceType identifies the kind of KNXnet/IP frame.
+type ServiceType uint16
+
+const (
+	ConnectRequest          ServiceType = 0x0205
+	ConnectResponse         ServiceType = 0x0206
+	ConnectionStateRequest  ServiceType = 0x0207
+	ConnectionStateResponse ServiceType = 0x0208
+	DisconnectRequest       ServiceType = 0x0209
+	DisconnectResponse      ServiceType = 0x020A
+	TunnellingRequest       ServiceType = 0x0420
+	TunnellingAck           ServiceType = 0x0421
+	RoutingIndication       ServiceType = 0x0530
+)
+
+// Status is the status code included in KNXnet/IP responses.
+type Status byte
+
+const (
+	StatusOK                Status = 0x00
+	StatusConnectionID      Status = 0x21
+	StatusConnectionType    Status = 0x22
+	StatusConnectionOption  Status = 0x23
+	StatusNoMoreConnections Status = 0x24
+	StatusDataConnection    Status = 0x26
+	StatusKNXConnection     Status = 0x27
+	StatusTunnellingLayer   Status = 0x29
+)
+
+func (s Status) Error() string {
+	switch s {
+	case StatusOK:
+		return "ok"
+	case StatusConnectionID:
+		return "unknown connection id"
+	case StatusConnectionType:
+		return "connection type not supported"
+	case StatusConnectionOption:
+		return "connection option not supported"
+	case StatusNoMoreConnections:
+		return "no more connections"
+	case StatusDataConnection:
+		return "data connection error"
+	case StatusKNXConnection:
+		return "KNX connection error"
+	case StatusTunnellingLayer:
+		return "tunnelling layer not supported"
+	default:
+		return fmt.Sprintf("status %#02x", byte(s))
+	}
+}
+
+const (
+	headerLen      = 6
+	headerVersion  = 0x10
+	hpaiLen        = 8
+	hpaiUDP        = 0x01
+	connHeaderLen  = 4
+	criLen         = 4
+	connTunnel     = 0x04
+	tunnelLinkLyr  = 0x02
+	DefaultPort    = 3671
+	maxFrameLength = 512
+)
+
+// DefaultMulticastAddress is the standard multicast group and port for KNXnet/IP routing.
+var DefaultMulticastAddress = netip.AddrPortFrom(netip.AddrFrom4([4]byte{224, 0, 23, 12}), DefaultPort)
+
+// EncodeFrame returns a KNXnet/IP frame of type st with the given body.
+func EncodeFrame(st ServiceType, body []byte) []byte {
+	b := make([]byte, 0, headerLen+len(body))
+	b = append(b, headerLen, headerVersion)
+	b = binary.BigEndian.AppendUint16(b, uint16(st))
+	b = binary.BigEndian.AppendUint16(b, uint16(headerLen+len(body)))
+	return append(b, body...)
+}
+
+// DecodeFrame returns the service type and body of the KNXnet/IP frame b.
+func DecodeFrame(b []byte) (ServiceType, []byte, error) {
+	if len(b) < headerLen {
+		return 0, nil, errors.New("frame too short")
+	}
+	if b[0] != headerLen || b[1] != headerVersion {
+		return 0, nil, fmt.Errorf("unsupported header %#02x %#02x", b[0], b[1])
+	}
+	st := ServiceType(binary.BigEndian.Uint16(b[2:]))
+	n := int(binary.BigEndian.Uint16(b[4:]))
+	if n < headerLen || n > len(b) {
+		return 0, nil, fmt.Errorf("frame length %d, have %d bytes", n, len(b))
+	}
+	return st, b[headerLen:n], nil
+}
+
+// AppendHPAI appends a host protocol address information structure for addr.
+// The zero addr means "reply to the address the request came from", which works through NAT.
+func AppendHPAI(b []byte, addr netip.AddrPort) []byte {
+	ip := addr.Addr().Unmap()
+	if !ip.Is4() {
+		ip = netip.IPv4Unspecified()
+	}
+	a4 := ip.As4()
+	b = append(b, hpaiLen, hpaiUDP)
+	b = append(b, a4[:]...)
+	return binary.BigEndian.AppendUint16(b, addr.Port())
+}
+
+// ConnHeader is the connection header of tunnelling requests and acks.
+type ConnHeader struct {
+	Channel byte
+	Seq     byte
+	Status  Status
+}
+
+func (h ConnHeader) Append(b []byte) []byte {
+	return append(b, connHeaderLen, h.Channel, h.Seq, byte(h.Status))
+}
+
+// DecodeConnHeader returns the connection header at the start of b and the rest of b.
+func DecodeConnHeader(b []byte) (ConnHeader, []byte, error) {
+	if len(b) < connHeaderLen || b[0] != connHeaderLen {
+		return ConnHeader{}, nil, errors.New("bad connection header")
+	}
+	return ConnHeader{Channel: b[1], Seq: b[2], Status: Status(b[3])}, b[connHeaderLen:], nil
+}
+
+// TunnelCRI is the connection request information requesting a link layer tunnel.
+func TunnelCRI() []byte {
+	return []byte{criLen, connTunnel, tunnelLinkLyr, 0}
+}
+
+// ConnectResponseBody is the body of a ConnectResponse frame.
+type ConnectResponseBody struct {
+	Channel byte
+	Status  Status
+	// the individual address assigned to the tunnel
+	Address IndividualAddress
+}
+
+func (r ConnectResponseBody) Append(b []byte) []byte {
+	b = append(b, r.Channel, byte(r.Status))
+	if r.Status != StatusOK {
+		return b
+	}
+	b = AppendHPAI(b, netip.AddrPort{})
+	b = append(b, criLen, connTunnel)
+	return binary.BigEndian.AppendUint16(b, uint16(r.Address))
+}
+
+func DecodeConnectResponse(b []byte) (ConnectResponseBody, error) {
+	if len(b) < 2 {
+		return ConnectResponseBody{}, errors.New("connect response too short")
+	}
+	r := ConnectResponseBody{Channel: b[0], Status: Status(b[1])}
+	if r.Status != StatusOK {
+		return r, nil
+	}
+	if len(b) < 2+hpaiLen+criLen {
+		return r, errors.New("connect response too short")
+	}
+	crd := b[2+hpaiLen:]
+	r.Address = IndividualAddress(binary.BigEndian.Uint16(crd[2:]))
+	return r, nil
+}
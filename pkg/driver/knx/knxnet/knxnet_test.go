@@ -0,0 +1,295 @@
+package knxnet
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestParseGroupAddress(t *testing.T) {
+	tests := []struct {
+		in   string
+		want GroupAddress
+		str  string
+	}{
+		{"1/2/3", 0x0A03, "1/2/3"},
+		{"31/7/255", 0xFFFF, "31/7/255"},
+		{"1/515", 0x0A03, "1/2/3"},
+		{"2563", 0x0A03, "1/2/3"},
+	}
+	for _, tt := range tests {
+		got, err := ParseGroupAddress(tt.in)
+		if err != nil {
+			t.Errorf("ParseGroupAddress(%q) error = %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseGroupAddress(%q) = %#04x, want %#04x", tt.in, uint16(got), uint16(tt.want))
+		}
+		if got.String() != tt.str {
+			t.Errorf("String() = %q, want %q", got.String(), tt.str)
+		}
+	}
+	for _, in := range []string{"", "32/0/0", "1/8/0", "1/2/256", "1/2/3/4", "a/b/c"} {
+		if _, err := ParseGroupAddress(in); err == nil {
+			t.Errorf("ParseGroupAddress(%q) expected error", in)
+		}
+	}
+}
+
+func TestParseIndividualAddress(t *testing.T) {
+	got, err := ParseIndividualAddress("1.1.10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 0x110A {
+		t.Fatalf("got %#04x, want 0x110a", uint16(got))
+	}
+	if got.String() != "1.1.10" {
+		t.Fatalf("String() = %q", got.String())
+	}
+	for _, in := range []string{"1.1", "16.0.0", "1.1.256"} {
+		if _, err := ParseIndividualAddress(in); err == nil {
+			t.Errorf("ParseIndividualAddress(%q) expected error", in)
+		}
+	}
+}
+
+func TestLData(t *testing.T) {
+	tests := []struct {
+		name string
+		f    LData
+		want []byte
+	}{
+		{
+			name: "write bool",
+			f:    LData{Code: LDataReq, Source: 0x1101, Destination: 0x0A03, APCI: GroupValueWrite, Data: []byte{1}},
+			want: []byte{0x11, 0, 0xBC, 0xE0, 0x11, 0x01, 0x0A, 0x03, 0x01, 0x00, 0x81},
+		},
+		{
+			name: "write float16",
+			f:    LData{Code: LDataInd, Source: 0x1101, Destination: 0x0A03, APCI: GroupValueWrite, Data: []byte{0, 0x0C, 0x1A}},
+			want: []byte{0x29, 0, 0xBC, 0xE0, 0x11, 0x01, 0x0A, 0x03, 0x03, 0x00, 0x80, 0x0C, 0x1A},
+		},
+		{
+			name: "read",
+			f:    LData{Code: LDataReq, Destination: 0x0A03, APCI: GroupValueRead, Data: []byte{0}},
+			want: []byte{0x11, 0, 0xBC, 0xE0, 0x00, 0x00, 0x0A, 0x03, 0x01, 0x00, 0x00},
+		},
+		{
+			name: "response",
+			f:    LData{Code: LDataInd, Source: 0x1101, Destination: 0x0A03, APCI: GroupValueResponse, Data: []byte{0, 128}},
+			want: []byte{0x29, 0, 0xBC, 0xE0, 0x11, 0x01, 0x0A, 0x03, 0x02, 0x00, 0x40, 0x80},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.f.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Fatalf("MarshalBinary() = % x, want % x", got, tt.want)
+			}
+			var f LData
+			if err := f.UnmarshalBinary(got); err != nil {
+				t.Fatal(err)
+			}
+			if f.Code != tt.f.Code || f.Source != tt.f.Source || f.Destination != tt.f.Destination ||
+				f.APCI != tt.f.APCI || !bytes.Equal(f.Data, tt.f.Data) {
+				t.Fatalf("UnmarshalBinary() = %+v, want %+v", f, tt.f)
+			}
+		})
+	}
+}
+
+func TestLData_UnmarshalBinary_additionalInfo(t *testing.T) {
+	b := []byte{0x29, 2, 0xAA, 0xBB, 0xBC, 0xE0, 0x11, 0x01, 0x0A, 0x03, 0x01, 0x00, 0x80}
+	var f LData
+	if err := f.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+	if f.Destination != 0x0A03 || f.APCI != GroupValueWrite || !bytes.Equal(f.Data, []byte{0}) {
+		t.Fatalf("got %+v", f)
+	}
+	for _, b := range [][]byte{nil, {0x29}, {0x29, 9, 0}, {0x29, 0, 0xBC, 0x60, 0, 0, 0, 0, 1, 0, 0x80}} {
+		if err := f.UnmarshalBinary(b); err == nil {
+			t.Errorf("UnmarshalBinary(% x) expected error", b)
+		}
+	}
+}
+
+func TestFrame(t *testing.T) {
+	frame := EncodeFrame(TunnellingAck, ConnHeader{Channel: 3, Seq: 7}.Append(nil))
+	want := []byte{0x06, 0x10, 0x04, 0x21, 0x00, 0x0A, 0x04, 0x03, 0x07, 0x00}
+	if !bytes.Equal(frame, want) {
+		t.Fatalf("EncodeFrame() = % x, want % x", frame, want)
+	}
+	st, body, err := DecodeFrame(frame)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st != TunnellingAck {
+		t.Fatalf("service type = %#04x", uint16(st))
+	}
+	h, _, err := DecodeConnHeader(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.Channel != 3 || h.Seq != 7 || h.Status != StatusOK {
+		t.Fatalf("conn header = %+v", h)
+	}
+}
+
+func TestTunnel_timeout(t *testing.T) {
+	// nothing is listening, so the connect request is never answered
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := DialTunnel(ctx, "127.0.0.1:1", TunnelOptions{})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+// dialTestGateway returns a Tunnel connected, on channel 1, to a fake gateway, and the client address the gateway
+// sends to.
+func dialTestGateway(t *testing.T) (*Tunnel, *net.UDPConn, *net.UDPAddr) {
+	t.Helper()
+	gw, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = gw.Close() })
+	connected := make(chan *net.UDPAddr, 1)
+	go func() {
+		buf := make([]byte, maxFrameLength)
+		n, client, err := gw.ReadFromUDP(buf)
+		if err != nil {
+			return
+		}
+		if st, _, _ := DecodeFrame(buf[:n]); st == ConnectRequest {
+			_, _ = gw.WriteToUDP(EncodeFrame(ConnectResponse, ConnectResponseBody{Channel: 1, Address: 0x1101}.Append(nil)), client)
+			connected <- client
+		}
+	}()
+	tun, err := DialTunnel(t.Context(), gw.LocalAddr().String(), TunnelOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = tun.Close() })
+	return tun, gw, <-connected
+}
+
+func TestTunnel_inboundSequence(t *testing.T) {
+	tun, gw, client := dialTestGateway(t)
+
+	// send a telegram with seq, returning the seq of the ack, or -1 if there wasn't one
+	send := func(seq byte, dest GroupAddress) int {
+		t.Helper()
+		cemi, err := LData{Code: LDataInd, Source: 0x1102, Destination: dest, APCI: GroupValueWrite, Data: []byte{1}}.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		frame := EncodeFrame(TunnellingRequest, append(ConnHeader{Channel: 1, Seq: seq}.Append(nil), cemi...))
+		if _, err := gw.WriteToUDP(frame, client); err != nil {
+			t.Fatal(err)
+		}
+		buf := make([]byte, maxFrameLength)
+		_ = gw.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+		n, _, err := gw.ReadFromUDP(buf)
+		if err != nil {
+			return -1
+		}
+		st, body, err := DecodeFrame(buf[:n])
+		if err != nil || st != TunnellingAck {
+			t.Fatalf("gateway received %v, want TunnellingAck", st)
+		}
+		h, _, _ := DecodeConnHeader(body)
+		return int(h.Seq)
+	}
+
+	if got := send(0, 1); got != 0 {
+		t.Errorf("first request acked with %d, want 0", got)
+	}
+	if got := send(0, 2); got != 0 {
+		t.Errorf("repeated request acked with %d, want 0", got)
+	}
+	if got := send(5, 3); got != -1 {
+		t.Errorf("out of sequence request acked with %d, want no ack", got)
+	}
+	if got := send(1, 4); got != 1 {
+		t.Errorf("next request acked with %d, want 1", got)
+	}
+
+	// only the in sequence telegrams are delivered
+	for _, want := range []GroupAddress{1, 4} {
+		select {
+		case f := <-tun.Inbound():
+			if f.Destination != want {
+				t.Errorf("inbound destination = %v, want %v", f.Destination, want)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("no inbound telegram for %v", want)
+		}
+	}
+	select {
+	case f := <-tun.Inbound():
+		t.Errorf("unexpected inbound telegram %+v", f)
+	default:
+	}
+}
+
+// A Send cancelled while waiting for the ack may have been accepted by the gateway, the next Send must not reuse
+// its sequence number or the gateway would discard it as a repeat.
+func TestTunnel_sendCancelled(t *testing.T) {
+	tun, gw, client := dialTestGateway(t)
+
+	// receive returns the seq of the next TunnellingRequest the gateway receives
+	receive := func() byte {
+		t.Helper()
+		buf := make([]byte, maxFrameLength)
+		for {
+			_ = gw.SetReadDeadline(time.Now().Add(time.Second))
+			n, _, err := gw.ReadFromUDP(buf)
+			if err != nil {
+				t.Fatalf("gateway receive: %v", err)
+			}
+			st, body, err := DecodeFrame(buf[:n])
+			if err != nil || st != TunnellingRequest {
+				continue
+			}
+			h, _, err := DecodeConnHeader(body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return h.Seq
+		}
+	}
+	telegram := LData{Destination: 1, APCI: GroupValueWrite, Data: []byte{1}}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	sent := make(chan error, 1)
+	go func() { sent <- tun.Send(ctx, telegram) }()
+	if seq := receive(); seq != 0 {
+		t.Fatalf("first request seq = %d, want 0", seq)
+	}
+	cancel() // before the gateway acks
+	if err := <-sent; !errors.Is(err, context.Canceled) {
+		t.Fatalf("cancelled Send() = %v, want context.Canceled", err)
+	}
+
+	go func() { sent <- tun.Send(t.Context(), telegram) }()
+	seq := receive()
+	if seq != 1 {
+		t.Errorf("request after cancel seq = %d, want 1", seq)
+	}
+	if _, err := gw.WriteToUDP(EncodeFrame(TunnellingAck, ConnHeader{Channel: 1, Seq: seq}.Append(nil)), client); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-sent; err != nil {
+		t.Errorf("Send() after cancel: %v", err)
+	}
+}
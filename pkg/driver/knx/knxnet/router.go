@@ -0,0 +1,115 @@
+package knxnet
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"sync"
+)
+
+// Router sends and receives group telegrams using KNXnet/IP routing, via IP multicast.
+// Routing has no connection or acknowledgements, telegrams are sent and received by all routers in the multicast group.
+type Router struct {
+	recv    *net.UDPConn
+	send    *net.UDPConn
+	address IndividualAddress
+	inbound chan LData
+
+	closeOnce sync.Once
+	done      chan struct{}
+	err       error
+}
+
+// ListenRouting joins the multicast group addr, using the network interface ifi if not nil.
+// Telegrams sent by the Router use address as their source, and telegrams received from address are ignored.
+func ListenRouting(addr netip.AddrPort, ifi *net.Interface, address IndividualAddress) (*Router, error) {
+	gaddr := net.UDPAddrFromAddrPort(addr)
+	recv, err := net.ListenMulticastUDP("udp4", ifi, gaddr)
+	if err != nil {
+		return nil, err
+	}
+	send, err := net.DialUDP("udp4", nil, gaddr)
+	if err != nil {
+		_ = recv.Close()
+		return nil, err
+	}
+	r := &Router{
+		recv:    recv,
+		send:    send,
+		address: address,
+		inbound: make(chan LData, inboundBuffer),
+		done:    make(chan struct{}),
+	}
+	go r.readLoop()
+	return r, nil
+}
+
+func (r *Router) Send(_ context.Context, f LData) error {
+	f.Code = LDataInd
+	f.Source = r.address
+	cemi, err := f.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	if _, err := r.send.Write(EncodeFrame(RoutingIndication, cemi)); err != nil {
+		return r.fail(err)
+	}
+	return nil
+}
+
+func (r *Router) Inbound() <-chan LData {
+	return r.inbound
+}
+
+func (r *Router) Done() <-chan struct{} {
+	return r.done
+}
+
+func (r *Router) Err() error {
+	select {
+	case <-r.done:
+		return r.err
+	default:
+		return nil
+	}
+}
+
+func (r *Router) Close() error {
+	r.fail(ErrClosed)
+	return nil
+}
+
+func (r *Router) fail(err error) error {
+	r.closeOnce.Do(func() {
+		r.err = err
+		close(r.done)
+		_ = r.recv.Close()
+		_ = r.send.Close()
+	})
+	return err
+}
+
+func (r *Router) readLoop() {
+	defer close(r.inbound)
+	buf := make([]byte, maxFrameLength)
+	for {
+		n, _, err := r.recv.ReadFromUDP(buf)
+		if err != nil {
+			r.fail(err)
+			return
+		}
+		st, body, err := DecodeFrame(buf[:n])
+		if err != nil || st != RoutingIndication {
+			continue
+		}
+		var f LData
+		if err := f.UnmarshalBinary(body); err != nil || f.Code != LDataInd || f.Source == r.address {
+			continue
+		}
+		select {
+		case r.inbound <- f:
+		case <-r.done:
+			return
+		}
+	}
+}
@@ -0,0 +1,348 @@
+package knxnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"sync"
+	"time"
+)
+
+const (
+	// how long to wait for the gateway to reply to connection requests
+	connectTimeout = 10 * time.Second
+	// how long to wait for a TunnellingAck before resending, see KNXnet/IP spec 3.8.4
+	tunnelAckTimeout = time.Second
+	// how often to check the connection is still alive
+	heartbeatInterval = 60 * time.Second
+	// how many times to send a ConnectionStateRequest before giving up
+	heartbeatAttempts = 3
+	inboundBuffer     = 100
+)
+
+// TunnelOptions configure a Tunnel.
+type TunnelOptions struct {
+	// HeartbeatInterval is how often a ConnectionStateRequest is sent, defaults to 60 seconds.
+	HeartbeatInterval time.Duration
+	// AckTimeout is how long to wait for the gateway to ack a telegram, defaults to 1 second.
+	AckTimeout time.Duration
+}
+
+// Tunnel is a KNXnet/IP tunnelling connection to a gateway.
+type Tunnel struct {
+	conn    *net.UDPConn
+	opts    TunnelOptions
+	channel byte
+	address IndividualAddress
+
+	sendMu sync.Mutex // serialises Send, only one unacked request is allowed
+	txSeq  byte
+	acks   chan ConnHeader
+	states chan Status
+
+	rxSeq   byte
+	inbound chan LData
+
+	closeOnce sync.Once
+	done      chan struct{}
+	err       error
+}
+
+// DialTunnel connects to the KNXnet/IP gateway at addr, like "192.168.1.10:3671".
+// The port defaults to DefaultPort.
+func DialTunnel(ctx context.Context, addr string, opts TunnelOptions) (*Tunnel, error) {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, fmt.Sprint(DefaultPort))
+	}
+	raddr, err := net.ResolveUDPAddr("udp4", addr)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.DialUDP("udp4", nil, raddr)
+	if err != nil {
+		return nil, err
+	}
+	if opts.HeartbeatInterval <= 0 {
+		opts.HeartbeatInterval = heartbeatInterval
+	}
+	if opts.AckTimeout <= 0 {
+		opts.AckTimeout = tunnelAckTimeout
+	}
+
+	res, err := connect(ctx, conn)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	t := &Tunnel{
+		conn:    conn,
+		opts:    opts,
+		channel: res.Channel,
+		address: res.Address,
+		acks:    make(chan ConnHeader, 1),
+		states:  make(chan Status, 1),
+		inbound: make(chan LData, inboundBuffer),
+		done:    make(chan struct{}),
+	}
+	go t.readLoop()
+	go t.heartbeat()
+	return t, nil
+}
+
+func connect(ctx context.Context, conn *net.UDPConn) (ConnectResponseBody, error) {
+	body := AppendHPAI(nil, netip.AddrPort{}) // control endpoint
+	body = AppendHPAI(body, netip.AddrPort{}) // data endpoint
+	body = append(body, TunnelCRI()...)
+	if _, err := conn.Write(EncodeFrame(ConnectRequest, body)); err != nil {
+		return ConnectResponseBody{}, err
+	}
+
+	deadline := time.Now().Add(connectTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := conn.SetReadDeadline(deadline); err != nil {
+		return ConnectResponseBody{}, err
+	}
+	defer conn.SetReadDeadline(time.Time{})
+	stop := context.AfterFunc(ctx, func() { _ = conn.SetReadDeadline(time.Now()) })
+	defer stop()
+
+	buf := make([]byte, maxFrameLength)
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ConnectResponseBody{}, ctx.Err()
+			}
+			return ConnectResponseBody{}, fmt.Errorf("connect: %w", err)
+		}
+		st, body, err := DecodeFrame(buf[:n])
+		if err != nil || st != ConnectResponse {
+			continue
+		}
+		res, err := DecodeConnectResponse(body)
+		if err != nil {
+			return res, fmt.Errorf("connect: %w", err)
+		}
+		if res.Status != StatusOK {
+			return res, fmt.Errorf("connect: %w", res.Status)
+		}
+		return res, nil
+	}
+}
+
+// Address returns the individual address the gateway assigned to the tunnel.
+func (t *Tunnel) Address() IndividualAddress {
+	return t.address
+}
+
+func (t *Tunnel) Send(ctx context.Context, f LData) error {
+	f.Code = LDataReq
+	f.Source = t.address
+	cemi, err := f.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	t.sendMu.Lock()
+	defer t.sendMu.Unlock()
+	// drop any late ack from a previous request
+	select {
+	case <-t.acks:
+	default:
+	}
+	seq := t.txSeq
+	frame := EncodeFrame(TunnellingRequest, append(ConnHeader{Channel: t.channel, Seq: seq}.Append(nil), cemi...))
+	for attempt := 0; attempt < 2; attempt++ {
+		if _, err := t.conn.Write(frame); err != nil {
+			return t.fail(err)
+		}
+		ack, err := t.waitAck(ctx, seq)
+		switch {
+		case errors.Is(err, errAckTimeout):
+			continue
+		case err != nil && ctx.Err() != nil:
+			// The gateway may have accepted the request, reusing seq would have it discard the next as a repeat.
+			// If it didn't, the next request is out of sequence so won't be acked, failing the tunnel.
+			t.txSeq++
+			return err
+		case err != nil:
+			return err
+		case ack.Status != StatusOK:
+			return fmt.Errorf("tunnelling ack: %w", ack.Status)
+		}
+		t.txSeq++
+		return nil
+	}
+	// the spec says the connection should be closed if a request isn't acked after one retry
+	return t.fail(errors.New("no tunnelling ack from gateway"))
+}
+
+var errAckTimeout = errors.New("ack timeout")
+
+func (t *Tunnel) waitAck(ctx context.Context, seq byte) (ConnHeader, error) {
+	timer := time.NewTimer(t.opts.AckTimeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ConnHeader{}, ctx.Err()
+		case <-t.done:
+			return ConnHeader{}, t.Err()
+		case <-timer.C:
+			return ConnHeader{}, errAckTimeout
+		case ack := <-t.acks:
+			if ack.Seq == seq {
+				return ack, nil
+			}
+		}
+	}
+}
+
+func (t *Tunnel) Inbound() <-chan LData {
+	return t.inbound
+}
+
+func (t *Tunnel) Done() <-chan struct{} {
+	return t.done
+}
+
+func (t *Tunnel) Err() error {
+	select {
+	case <-t.done:
+		return t.err
+	default:
+		return nil
+	}
+}
+
+// Close disconnects from the gateway.
+func (t *Tunnel) Close() error {
+	select {
+	case <-t.done:
+	default:
+		body := append([]byte{t.channel, 0}, AppendHPAI(nil, netip.AddrPort{})...)
+		_, _ = t.conn.Write(EncodeFrame(DisconnectRequest, body))
+	}
+	t.fail(ErrClosed)
+	return nil
+}
+
+// fail closes the tunnel recording err as the reason, returning err.
+func (t *Tunnel) fail(err error) error {
+	t.closeOnce.Do(func() {
+		t.err = err
+		close(t.done)
+		_ = t.conn.Close()
+	})
+	return err
+}
+
+func (t *Tunnel) readLoop() {
+	defer close(t.inbound)
+	buf := make([]byte, maxFrameLength)
+	for {
+		n, err := t.conn.Read(buf)
+		if err != nil {
+			t.fail(err)
+			return
+		}
+		st, body, err := DecodeFrame(buf[:n])
+		if err != nil {
+			continue
+		}
+		switch st {
+		case TunnellingRequest:
+			h, cemi, err := DecodeConnHeader(body)
+			if err != nil || h.Channel != t.channel {
+				continue
+			}
+			switch h.Seq {
+			case t.rxSeq:
+				t.rxSeq++
+			case t.rxSeq - 1:
+				// a repeat of a request we've already seen, our ack was lost
+				_, _ = t.conn.Write(EncodeFrame(TunnellingAck, ConnHeader{Channel: t.channel, Seq: h.Seq}.Append(nil)))
+				continue
+			default:
+				// out of sequence, not acking means the gateway will repeat or reconnect
+				continue
+			}
+			_, _ = t.conn.Write(EncodeFrame(TunnellingAck, ConnHeader{Channel: t.channel, Seq: h.Seq}.Append(nil)))
+			var f LData
+			if err := f.UnmarshalBinary(cemi); err != nil || f.Code != LDataInd {
+				continue
+			}
+			select {
+			case t.inbound <- f:
+			case <-t.done:
+				return
+			}
+		case TunnellingAck:
+			h, _, err := DecodeConnHeader(body)
+			if err != nil || h.Channel != t.channel {
+				continue
+			}
+			select {
+			case t.acks <- h:
+			default:
+			}
+		case ConnectionStateResponse:
+			if len(body) < 2 || body[0] != t.channel {
+				continue
+			}
+			select {
+			case t.states <- Status(body[1]):
+			default:
+			}
+		case DisconnectRequest:
+			if len(body) < 1 || body[0] != t.channel {
+				continue
+			}
+			_, _ = t.conn.Write(EncodeFrame(DisconnectResponse, []byte{t.channel, byte(StatusOK)}))
+			t.fail(errors.New("gateway closed the connection"))
+			return
+		}
+	}
+}
+
+// heartbeat checks the connection is alive by sending ConnectionStateRequests, failing the tunnel if not.
+func (t *Tunnel) heartbeat() {
+	ticker := time.NewTicker(t.opts.HeartbeatInterval)
+	defer ticker.Stop()
+	body := append([]byte{t.channel, 0}, AppendHPAI(nil, netip.AddrPort{})...)
+	frame := EncodeFrame(ConnectionStateRequest, body)
+	for {
+		select {
+		case <-t.done:
+			return
+		case <-ticker.C:
+		}
+		if err := t.checkState(frame); err != nil {
+			t.fail(err)
+			return
+		}
+	}
+}
+
+func (t *Tunnel) checkState(frame []byte) error {
+	for attempt := 0; attempt < heartbeatAttempts; attempt++ {
+		if _, err := t.conn.Write(frame); err != nil {
+			return err
+		}
+		select {
+		case <-t.done:
+			return nil
+		case st := <-t.states:
+			if st != StatusOK {
+				return fmt.Errorf("connection state: %w", st)
+			}
+			return nil
+		case <-time.After(connectTimeout):
+		}
+	}
+	return errors.New("no connection state response from gateway")
+}
@@ -0,0 +1,209 @@
+// Package knxtest provides a fake KNXnet/IP tunnelling gateway for tests.
+package knxtest
+
+import (
+	"net"
+	"slices"
+	"sync"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/knxnet"
+)
+
+// Gateway is a fake KNXnet/IP tunnelling gateway with a single tunnel, the most recent client to connect.
+// It acts as if every group address has a device that remembers the last value written to it and responds to reads.
+type Gateway struct {
+	conn *net.UDPConn
+
+	mu      sync.Mutex
+	client  *net.UDPAddr
+	channel byte
+	txSeq   byte
+	values  map[knxnet.GroupAddress][]byte
+	links   map[knxnet.GroupAddress]knxnet.GroupAddress // command address -> status address
+	writes  []knxnet.LData
+	changed chan struct{} // closed and replaced each time the client sends a telegram
+}
+
+// NewGateway starts a Gateway listening on a random localhost port.
+func NewGateway() (*Gateway, error) {
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		return nil, err
+	}
+	g := &Gateway{
+		conn:    conn,
+		values:  make(map[knxnet.GroupAddress][]byte),
+		links:   make(map[knxnet.GroupAddress]knxnet.GroupAddress),
+		changed: make(chan struct{}),
+	}
+	go g.serve()
+	return g, nil
+}
+
+// Addr returns the host:port clients should connect to.
+func (g *Gateway) Addr() string {
+	return g.conn.LocalAddr().String()
+}
+
+func (g *Gateway) Close() error {
+	return g.conn.Close()
+}
+
+// Set sets the value of ga without telling the client, as if a device had been changed locally.
+// The value is returned when the client reads ga.
+func (g *Gateway) Set(ga knxnet.GroupAddress, data []byte) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.values[ga] = slices.Clone(data)
+}
+
+// Write sets the value of ga and sends a GroupValueWrite to the client, as if a device on the bus had sent it.
+func (g *Gateway) Write(ga knxnet.GroupAddress, data []byte) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.values[ga] = slices.Clone(data)
+	g.sendLocked(knxnet.LData{Code: knxnet.LDataInd, Destination: ga, APCI: knxnet.GroupValueWrite, Data: data})
+}
+
+// Link makes writes by the client to cmd also set the value of status, like an actuator with a separate status object.
+// Without a link, the status of an actuator doesn't change when it is written to.
+func (g *Gateway) Link(cmd, status knxnet.GroupAddress) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.links[cmd] = status
+}
+
+// Value returns the value of ga, as last set or written by the client.
+func (g *Gateway) Value(ga knxnet.GroupAddress) ([]byte, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	v, ok := g.values[ga]
+	return slices.Clone(v), ok
+}
+
+// Writes returns the GroupValueWrite telegrams sent by the client, in order.
+func (g *Gateway) Writes() []knxnet.LData {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return slices.Clone(g.writes)
+}
+
+// Changed returns a channel that is closed the next time the client sends a telegram.
+func (g *Gateway) Changed() <-chan struct{} {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.changed
+}
+
+// Connected returns whether a client has an open tunnel.
+func (g *Gateway) Connected() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.client != nil
+}
+
+func (g *Gateway) serve() {
+	buf := make([]byte, 512)
+	for {
+		n, addr, err := g.conn.ReadFromUDP(buf)
+		if err != nil {
+			return
+		}
+		st, body, err := knxnet.DecodeFrame(buf[:n])
+		if err != nil {
+			continue
+		}
+		g.handle(addr, st, body)
+	}
+}
+
+func (g *Gateway) handle(addr *net.UDPAddr, st knxnet.ServiceType, body []byte) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if len(body) < 1 {
+		return
+	}
+	switch st {
+	case knxnet.ConnectRequest:
+		// a new tunnel replaces any existing one, as if it had timed out
+		g.channel++
+		g.client, g.txSeq = addr, 0
+		res := knxnet.ConnectResponseBody{Channel: g.channel, Status: knxnet.StatusOK, Address: 0x11FF}
+		g.reply(addr, knxnet.ConnectResponse, res.Append(nil))
+	case knxnet.ConnectionStateRequest:
+		g.reply(addr, knxnet.ConnectionStateResponse, []byte{body[0], byte(g.status(addr, body[0]))})
+	case knxnet.DisconnectRequest:
+		status := g.status(addr, body[0])
+		if status == knxnet.StatusOK {
+			g.client = nil
+		}
+		g.reply(addr, knxnet.DisconnectResponse, []byte{body[0], byte(status)})
+	case knxnet.TunnellingRequest:
+		h, cemi, err := knxnet.DecodeConnHeader(body)
+		if err != nil {
+			return
+		}
+		g.reply(addr, knxnet.TunnellingAck, knxnet.ConnHeader{Channel: h.Channel, Seq: h.Seq, Status: g.status(addr, h.Channel)}.Append(nil))
+		if g.status(addr, h.Channel) != knxnet.StatusOK {
+			return
+		}
+		var f knxnet.LData
+		if err := f.UnmarshalBinary(cemi); err != nil || f.Code != knxnet.LDataReq {
+			return
+		}
+		g.telegram(f)
+	}
+}
+
+func (g *Gateway) status(addr *net.UDPAddr, channel byte) knxnet.Status {
+	if g.client == nil || g.client.String() != addr.String() || channel != g.channel {
+		return knxnet.StatusConnectionID
+	}
+	return knxnet.StatusOK
+}
+
+// telegram acts on a telegram sent by the client.
+func (g *Gateway) telegram(f knxnet.LData) {
+	defer func() {
+		close(g.changed)
+		g.changed = make(chan struct{})
+	}()
+	// confirm the telegram was sent on the bus
+	con := f
+	con.Code = knxnet.LDataCon
+	g.sendLocked(con)
+
+	switch f.APCI {
+	case knxnet.GroupValueWrite:
+		g.values[f.Destination] = slices.Clone(f.Data)
+		if status, ok := g.links[f.Destination]; ok {
+			g.values[status] = slices.Clone(f.Data)
+		}
+		g.writes = append(g.writes, f)
+	case knxnet.GroupValueRead:
+		v, ok := g.values[f.Destination]
+		if !ok {
+			return
+		}
+		g.sendLocked(knxnet.LData{Code: knxnet.LDataInd, Source: 0x1101, Destination: f.Destination, APCI: knxnet.GroupValueResponse, Data: v})
+	}
+}
+
+// sendLocked sends f to the client in a TunnellingRequest, if there is one.
+// Acks from the client are not checked.
+func (g *Gateway) sendLocked(f knxnet.LData) {
+	if g.client == nil {
+		return
+	}
+	cemi, err := f.MarshalBinary()
+	if err != nil {
+		return
+	}
+	body := knxnet.ConnHeader{Channel: g.channel, Seq: g.txSeq}.Append(nil)
+	g.txSeq++
+	g.reply(g.client, knxnet.TunnellingRequest, append(body, cemi...))
+}
+
+func (g *Gateway) reply(addr *net.UDPAddr, st knxnet.ServiceType, body []byte) {
+	_, _ = g.conn.WriteToUDP(knxnet.EncodeFrame(st, body), addr)
+}
@@ -0,0 +1,106 @@
+package knxtest
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/knxnet"
+)
+
+func TestGateway_tunnel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	gw, err := NewGateway()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { gw.Close() })
+
+	tunnel, err := knxnet.DialTunnel(ctx, gw.Addr(), knxnet.TunnelOptions{HeartbeatInterval: 50 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { tunnel.Close() })
+	if tunnel.Address() != 0x11FF {
+		t.Fatalf("tunnel address = %v", tunnel.Address())
+	}
+
+	ga := knxnet.GroupAddress(0x0A03)
+	if err := knxnet.Write(ctx, tunnel, ga, []byte{0, 0x0C, 0x1A}); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := gw.Value(ga); !bytes.Equal(got, []byte{0, 0x0C, 0x1A}) {
+		t.Fatalf("gateway value = % x", got)
+	}
+
+	// reads are answered with a GroupValueResponse
+	if err := knxnet.Read(ctx, tunnel, ga); err != nil {
+		t.Fatal(err)
+	}
+	f := receive(t, tunnel)
+	if f.APCI != knxnet.GroupValueResponse || f.Destination != ga || !bytes.Equal(f.Data, []byte{0, 0x0C, 0x1A}) {
+		t.Fatalf("response = %+v", f)
+	}
+
+	// telegrams from the bus are passed on
+	gw.Write(0x0001, []byte{1})
+	f = receive(t, tunnel)
+	if f.APCI != knxnet.GroupValueWrite || f.Destination != 0x0001 || !bytes.Equal(f.Data, []byte{1}) {
+		t.Fatalf("write = %+v", f)
+	}
+
+	// wait for a few heartbeats
+	time.Sleep(200 * time.Millisecond)
+	if err := tunnel.Err(); err != nil {
+		t.Fatalf("tunnel failed: %v", err)
+	}
+
+	if err := tunnel.Close(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-tunnel.Done():
+	case <-ctx.Done():
+		t.Fatal("tunnel not done after close")
+	}
+	if err := knxnet.Write(ctx, tunnel, ga, []byte{1}); err == nil {
+		t.Fatal("expected error writing to closed tunnel")
+	}
+}
+
+func TestGateway_closed(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	gw, err := NewGateway()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tunnel, err := knxnet.DialTunnel(ctx, gw.Addr(), knxnet.TunnelOptions{AckTimeout: 50 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { tunnel.Close() })
+
+	gw.Close()
+	if err := knxnet.Write(ctx, tunnel, 1, []byte{1}); err == nil {
+		t.Fatal("expected error writing without a gateway")
+	}
+	select {
+	case <-tunnel.Done():
+	case <-ctx.Done():
+		t.Fatal("tunnel not done after unacked request")
+	}
+}
+
+func receive(t *testing.T, c knxnet.Conn) knxnet.LData {
+	t.Helper()
+	select {
+	case f := <-c.Inbound():
+		return f
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for telegram")
+		return knxnet.LData{}
+	}
+}
@@ -0,0 +1,67 @@
+package knx
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/dpt"
+	"github.com/smart-core-os/sc-bos/pkg/proto/lightpb"
+	"github.com/smart-core-os/sc-bos/pkg/resource"
+)
+
+type light struct {
+	lightpb.LightApiServer
+	model *lightpb.Model
+	bus   *bus
+	cfg   *config.LightConfig
+}
+
+func newLight(b *bus, cfg *config.LightConfig) *light {
+	model := lightpb.NewModel()
+	t := &light{LightApiServer: lightpb.NewModelServer(model), model: model, bus: b, cfg: cfg}
+	b.listen(cfg.Brightness, func(data []byte) error {
+		level, err := dpt.DecodeFloat(cfg.Brightness.DPT, data)
+		if err != nil {
+			return err
+		}
+		_, err = model.UpdateBrightness(&lightpb.Brightness{LevelPercent: float32(level)}, resource.WithUpdatePaths("level_percent"))
+		return err
+	})
+	b.listen(cfg.OnOff, func(data []byte) error {
+		on, err := dpt.DecodeBool(cfg.OnOff.DPT, data)
+		if err != nil {
+			return err
+		}
+		switch {
+		case !on:
+			_, err = model.UpdateBrightness(&lightpb.Brightness{LevelPercent: 0}, resource.WithUpdatePaths("level_percent"))
+		case cfg.Brightness == nil:
+			_, err = model.UpdateBrightness(&lightpb.Brightness{LevelPercent: 100}, resource.WithUpdatePaths("level_percent"))
+		}
+		// when on, dimmable lights report their level via the brightness status
+		return err
+	})
+	return t
+}
+
+func (t *light) UpdateBrightness(ctx context.Context, req *lightpb.UpdateBrightnessRequest) (*lightpb.Brightness, error) {
+	if req.GetBrightness().GetPreset() != nil {
+		return nil, status.Error(codes.InvalidArgument, "presets are not supported")
+	}
+	level := req.GetBrightness().GetLevelPercent()
+	// the model is updated when the actuator reports its status
+	if b := t.cfg.Brightness; b != nil && (b.Address != 0 || t.cfg.OnOff == nil) {
+		if err := t.bus.writeStatus(ctx, b, float64(level), b); err != nil {
+			return nil, err
+		}
+	} else {
+		// dimmable lights report their level via the brightness status when switched on
+		if err := t.bus.writeStatus(ctx, t.cfg.OnOff, level > 0, t.cfg.OnOff, t.cfg.Brightness); err != nil {
+			return nil, err
+		}
+	}
+	return t.model.GetBrightness()
+}
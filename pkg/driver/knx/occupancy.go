@@ -0,0 +1,39 @@
+package knx
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/dpt"
+	"github.com/smart-core-os/sc-bos/pkg/proto/occupancysensorpb"
+)
+
+type occupancySensor struct {
+	occupancysensorpb.OccupancySensorApiServer
+	model *occupancysensorpb.Model
+}
+
+func newOccupancySensor(b *bus, cfg *config.OccupancySensorConfig) *occupancySensor {
+	model := occupancysensorpb.NewModel()
+	t := &occupancySensor{OccupancySensorApiServer: occupancysensorpb.NewModelServer(model), model: model}
+	b.listen(cfg.Occupancy, func(data []byte) error {
+		occupied, err := dpt.DecodeBool(cfg.Occupancy.DPT, data)
+		if err != nil {
+			return err
+		}
+		state := occupancysensorpb.Occupancy_UNOCCUPIED
+		if occupied {
+			state = occupancysensorpb.Occupancy_OCCUPIED
+		}
+		old, err := model.GetOccupancy()
+		if err != nil {
+			return err
+		}
+		if old.State == state {
+			return nil
+		}
+		_, err = model.SetOccupancy(&occupancysensorpb.Occupancy{State: state, StateChangeTime: timestamppb.Now()})
+		return err
+	})
+	return t
+}
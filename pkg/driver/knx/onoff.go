@@ -0,0 +1,46 @@
+package knx
+
+import (
+	"context"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/dpt"
+	"github.com/smart-core-os/sc-bos/pkg/proto/onoffpb"
+	"github.com/smart-core-os/sc-bos/pkg/resource"
+)
+
+type onOff struct {
+	onoffpb.OnOffApiServer
+	model *onoffpb.Model
+	bus   *bus
+	cfg   *config.OnOffConfig
+}
+
+func newOnOff(b *bus, cfg *config.OnOffConfig) *onOff {
+	model := onoffpb.NewModel()
+	t := &onOff{OnOffApiServer: onoffpb.NewModelServer(model), model: model, bus: b, cfg: cfg}
+	b.listen(cfg.OnOff, func(data []byte) error {
+		on, err := dpt.DecodeBool(cfg.OnOff.DPT, data)
+		if err != nil {
+			return err
+		}
+		_, err = model.UpdateOnOff(&onoffpb.OnOff{State: onOffState(on)})
+		return err
+	})
+	return t
+}
+
+func (t *onOff) UpdateOnOff(ctx context.Context, req *onoffpb.UpdateOnOffRequest) (*onoffpb.OnOff, error) {
+	on := req.GetOnOff().GetState() == onoffpb.OnOff_ON
+	if err := t.bus.write(ctx, t.cfg.OnOff, on); err != nil {
+		return nil, err
+	}
+	return t.model.UpdateOnOff(&onoffpb.OnOff{State: onOffState(on)}, resource.WithUpdateMask(req.GetUpdateMask()))
+}
+
+func onOffState(on bool) onoffpb.OnOff_State {
+	if on {
+		return onoffpb.OnOff_ON
+	}
+	return onoffpb.OnOff_OFF
+}
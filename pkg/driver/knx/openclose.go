@@ -0,0 +1,81 @@
+package knx
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/knx/dpt"
+	"github.com/smart-core-os/sc-bos/pkg/proto/openclosepb"
+)
+
+// KNX blind positions are 0% when fully open, the opposite of the OpenClose trait.
+
+type openClose struct {
+	openclosepb.OpenCloseApiServer
+	model *openclosepb.Model
+	bus   *bus
+	cfg   *config.OpenCloseConfig
+}
+
+func newOpenClose(b *bus, cfg *config.OpenCloseConfig) *openClose {
+	model := openclosepb.NewModel()
+	t := &openClose{OpenCloseApiServer: openclosepb.NewModelServer(model), model: model, bus: b, cfg: cfg}
+	b.listen(cfg.Position, func(data []byte) error {
+		closed, err := dpt.DecodeFloat(cfg.Position.DPT, data)
+		if err != nil {
+			return err
+		}
+		_, err = model.UpdatePositions(&openclosepb.OpenClosePositions{
+			States: []*openclosepb.OpenClosePosition{{OpenPercent: float32(100 - closed)}},
+		})
+		return err
+	})
+	return t
+}
+
+func (t *openClose) UpdatePositions(ctx context.Context, req *openclosepb.UpdateOpenClosePositionsRequest) (*openclosepb.OpenClosePositions, error) {
+	if req.GetStates().GetPreset() != nil {
+		return nil, status.Error(codes.InvalidArgument, "presets are not supported")
+	}
+	states := req.GetStates().GetStates()
+	if len(states) != 1 {
+		return nil, status.Error(codes.InvalidArgument, "exactly one position is required")
+	}
+	open := states[0].GetOpenPercent()
+	// the model is updated when the actuator reports its position
+	if p := t.cfg.Position; p != nil && (p.Address != 0 || t.cfg.UpDown == nil) {
+		if err := t.bus.writeStatus(ctx, p, float64(100-open), p); err != nil {
+			return nil, err
+		}
+		return t.model.GetPositions()
+	}
+	// without a position the blind can only be moved fully up or down
+	down := open < 50
+	if err := t.bus.writeStatus(ctx, t.cfg.UpDown, down, t.cfg.Position); err != nil {
+		return nil, err
+	}
+	if t.cfg.Position != nil {
+		return t.model.GetPositions()
+	}
+	// nothing reports the position, assume the blind moves all the way
+	open = 100
+	if down {
+		open = 0
+	}
+	return t.model.UpdatePositions(&openclosepb.OpenClosePositions{
+		States: []*openclosepb.OpenClosePosition{{OpenPercent: open}},
+	})
+}
+
+func (t *openClose) Stop(ctx context.Context, _ *openclosepb.StopOpenCloseRequest) (*openclosepb.OpenClosePositions, error) {
+	if t.cfg.Stop == nil {
+		return nil, status.Error(codes.Unimplemented, "no stop address configured")
+	}
+	if err := t.bus.write(ctx, t.cfg.Stop, true); err != nil {
+		return nil, err
+	}
+	return t.model.GetPositions()
+}
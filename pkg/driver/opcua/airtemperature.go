@@ -0,0 +1,163 @@
+package opcua
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gopcua/opcua/ua"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/conv"
+	"github.com/smart-core-os/sc-bos/pkg/proto/airtemperaturepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/typespb"
+	"github.com/smart-core-os/sc-bos/pkg/resource"
+)
+
+// AirTemperature implements the Smart Core AirTemperature trait for OPC UA devices.
+// It maps OPC UA variable nodes to ambient conditions, set point, and mode.
+// The set point and mode are written back to the device when updated.
+type AirTemperature struct {
+	airtemperaturepb.UnimplementedAirTemperatureApiServer
+
+	cfg    config.AirTemperatureConfig
+	logger *zap.Logger
+	scName string
+	value  *resource.Value // *airtemperaturepb.AirTemperature
+	writer *writer
+}
+
+func readAirTemperatureConfig(raw []byte) (cfg config.AirTemperatureConfig, err error) {
+	err = json.Unmarshal(raw, &cfg)
+	return
+}
+
+func newAirTemperature(n string, config config.RawTrait, l *zap.Logger, w *writer) (*AirTemperature, error) {
+	cfg, err := readAirTemperatureConfig(config.Raw)
+	if err != nil {
+		return nil, err
+	}
+	return &AirTemperature{
+		cfg:    cfg,
+		logger: l,
+		scName: n,
+		value:  resource.NewValue(resource.WithInitialValue(&airtemperaturepb.AirTemperature{}), resource.WithNoDuplicates()),
+		writer: w,
+	}, nil
+}
+
+func (a *AirTemperature) GetAirTemperature(_ context.Context, req *airtemperaturepb.GetAirTemperatureRequest) (*airtemperaturepb.AirTemperature, error) {
+	return a.value.Get(resource.WithReadMask(req.GetReadMask())).(*airtemperaturepb.AirTemperature), nil
+}
+
+func (a *AirTemperature) PullAirTemperature(req *airtemperaturepb.PullAirTemperatureRequest, server airtemperaturepb.AirTemperatureApi_PullAirTemperatureServer) error {
+	for value := range a.value.Pull(server.Context(), resource.WithReadMask(req.GetReadMask()), resource.WithUpdatesOnly(req.GetUpdatesOnly())) {
+		err := server.Send(&airtemperaturepb.PullAirTemperatureResponse{Changes: []*airtemperaturepb.PullAirTemperatureResponse_Change{
+			{
+				Name:           a.scName,
+				ChangeTime:     timestamppb.New(value.ChangeTime),
+				AirTemperature: value.Value.(*airtemperaturepb.AirTemperature),
+			},
+		}})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateAirTemperature writes the set point and/or mode to the device.
+// Only single set points are supported, not ranges.
+func (a *AirTemperature) UpdateAirTemperature(ctx context.Context, req *airtemperaturepb.UpdateAirTemperatureRequest) (*airtemperaturepb.AirTemperature, error) {
+	state, mask := req.GetState(), req.GetUpdateMask()
+	if state == nil {
+		return nil, status.Error(codes.InvalidArgument, "state is required")
+	}
+	if shouldUpdate(mask, "temperature_range", state.GetTemperatureRange() != nil) {
+		return nil, status.Error(codes.Unimplemented, "temperature ranges are not supported")
+	}
+
+	if shouldUpdate(mask, "temperature_set_point", state.GetTemperatureSetPoint() != nil) {
+		if a.cfg.TemperatureSetPoint == nil {
+			return nil, status.Error(codes.FailedPrecondition, "temperature set point is not configured")
+		}
+		src := a.cfg.TemperatureSetPoint
+		got, err := a.writer.write(ctx, src, src.Unscaled(state.GetTemperatureSetPoint().GetValueCelsius()))
+		if err != nil {
+			return nil, err
+		}
+		a.setSetPoint(got)
+	}
+
+	if shouldUpdate(mask, "mode", state.Mode != airtemperaturepb.AirTemperature_MODE_UNSPECIFIED) {
+		if a.cfg.Mode == nil {
+			return nil, status.Error(codes.FailedPrecondition, "mode is not configured")
+		}
+		raw, ok := a.cfg.Mode.GetIntKeyFromValue(state.Mode.String())
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "mode %s is not supported", state.Mode)
+		}
+		got, err := a.writer.write(ctx, a.cfg.Mode, raw)
+		if err != nil {
+			return nil, err
+		}
+		a.setMode(got)
+	}
+
+	return a.value.Get().(*airtemperaturepb.AirTemperature), nil
+}
+
+func (a *AirTemperature) handleEvent(_ context.Context, node *ua.NodeID, value any) {
+	switch {
+	case a.cfg.AmbientTemperature != nil && nodeIdsAreEqual(a.cfg.AmbientTemperature.NodeId, node):
+		v, ok := a.scaledFloat(value, a.cfg.AmbientTemperature, "ambientTemperature")
+		if !ok {
+			return
+		}
+		_, _ = a.value.Set(&airtemperaturepb.AirTemperature{AmbientTemperature: &typespb.Temperature{ValueCelsius: v}},
+			resource.WithUpdatePaths("ambient_temperature"))
+	case a.cfg.AmbientHumidity != nil && nodeIdsAreEqual(a.cfg.AmbientHumidity.NodeId, node):
+		v, ok := a.scaledFloat(value, a.cfg.AmbientHumidity, "ambientHumidity")
+		if !ok {
+			return
+		}
+		h := float32(v)
+		_, _ = a.value.Set(&airtemperaturepb.AirTemperature{AmbientHumidity: &h},
+			resource.WithUpdatePaths("ambient_humidity"))
+	case a.cfg.TemperatureSetPoint != nil && nodeIdsAreEqual(a.cfg.TemperatureSetPoint.NodeId, node):
+		a.setSetPoint(value)
+	case a.cfg.Mode != nil && nodeIdsAreEqual(a.cfg.Mode.NodeId, node):
+		a.setMode(value)
+	}
+}
+
+func (a *AirTemperature) setSetPoint(value any) {
+	v, ok := a.scaledFloat(value, a.cfg.TemperatureSetPoint, "temperatureSetPoint")
+	if !ok {
+		return
+	}
+	_, _ = a.value.Set(&airtemperaturepb.AirTemperature{
+		TemperatureGoal: &airtemperaturepb.AirTemperature_TemperatureSetPoint{TemperatureSetPoint: &typespb.Temperature{ValueCelsius: v}},
+	}, resource.WithUpdatePaths("temperature_set_point"))
+}
+
+func (a *AirTemperature) setMode(value any) {
+	mode, err := conv.ToTraitEnum[airtemperaturepb.AirTemperature_Mode](value, a.cfg.Mode.Enum, airtemperaturepb.AirTemperature_Mode_value)
+	if err != nil {
+		a.logger.Warn("failed to convert mode", zap.String("device", a.scName), zap.Error(err))
+		return
+	}
+	_, _ = a.value.Set(&airtemperaturepb.AirTemperature{Mode: mode}, resource.WithUpdatePaths("mode"))
+}
+
+func (a *AirTemperature) scaledFloat(value any, src *config.ValueSource, field string) (float64, bool) {
+	v, err := conv.Float64Value(value)
+	if err != nil {
+		a.logger.Warn("error reading float64", zap.String("device", a.scName), zap.String("field", field), zap.Error(err))
+		return 0, false
+	}
+	return src.Scaled(v).(float64), true
+}
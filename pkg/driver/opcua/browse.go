@@ -0,0 +1,365 @@
+package opcua
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gopcua/opcua"
+	"github.com/gopcua/opcua/id"
+	"github.com/gopcua/opcua/ua"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/conv"
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/rpc"
+)
+
+const (
+	// DefaultBrowseDepth is how many levels below the root Browse visits if BrowseOptions.MaxDepth is not set.
+	DefaultBrowseDepth = 10
+	// DefaultBrowseMaxNodes is how many nodes Browse returns if BrowseOptions.MaxNodes is not set.
+	DefaultBrowseMaxNodes = 10000
+)
+
+// BrowseOptions configure Browse.
+type BrowseOptions struct {
+	// Root is where browsing starts, defaults to the Objects folder.
+	Root *ua.NodeID
+	// MaxDepth is how many levels below Root to browse, defaults to DefaultBrowseDepth.
+	MaxDepth int
+	// Namespaces limits browsing to nodes in these namespaces, Root is always included.
+	// Empty means all namespaces.
+	Namespaces []uint16
+	// MaxNodes stops browsing once this many nodes have been found, defaults to DefaultBrowseMaxNodes.
+	MaxNodes int
+}
+
+// Browse walks the address space of the server c is connected to, returning a tree of Objects, Variables, and Methods.
+// Only Objects are browsed into, Variables are returned with their data type, engineering units, and whether they can be written.
+// If browsing stopped early because MaxNodes was reached, truncated will be true.
+func Browse(ctx context.Context, c *opcua.Client, opts BrowseOptions) (root *rpc.Node, truncated bool, err error) {
+	if opts.Root == nil {
+		opts.Root = ua.NewNumericNodeID(0, id.ObjectsFolder)
+	}
+	if opts.MaxDepth <= 0 {
+		opts.MaxDepth = DefaultBrowseDepth
+	}
+	if opts.MaxNodes <= 0 {
+		opts.MaxNodes = DefaultBrowseMaxNodes
+	}
+	b := &browser{client: c, opts: opts, visited: map[string]bool{opts.Root.String(): true}}
+	root, err = b.readRoot(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if root.NodeClass == rpc.Node_OBJECT {
+		err = b.walk(ctx, root, opts.Root, 1)
+	}
+	return root, b.truncated, err
+}
+
+type browser struct {
+	client *opcua.Client
+	opts   BrowseOptions
+
+	count     int
+	truncated bool
+	// visited records the nodes already in the tree, by NodeId.
+	// Nodes with more than one parent, or that reference their ancestors, are only included once.
+	visited map[string]bool
+}
+
+// readRoot describes the root node, which unlike its children doesn't come with a reference description.
+func (b *browser) readRoot(ctx context.Context) (*rpc.Node, error) {
+	attrs := []ua.AttributeID{ua.AttributeIDNodeClass, ua.AttributeIDBrowseName, ua.AttributeIDDisplayName}
+	res, err := b.read(ctx, []*ua.NodeID{b.opts.Root}, attrs...)
+	if err != nil {
+		return nil, err
+	}
+	if res[0].Status != ua.StatusOK {
+		return nil, fmt.Errorf("root %s: %w", b.opts.Root, res[0].Status)
+	}
+	n := &rpc.Node{NodeId: b.opts.Root.String()}
+	if v, err := conv.IntValue(res[0].Value.Value()); err == nil {
+		n.NodeClass = nodeClassToProto(ua.NodeClass(v))
+	}
+	if v, ok := res[1].Value.Value().(*ua.QualifiedName); ok {
+		n.BrowseName = v.Name
+	}
+	if v, ok := res[2].Value.Value().(*ua.LocalizedText); ok {
+		n.DisplayName = v.Text
+	}
+	if n.NodeClass == rpc.Node_VARIABLE {
+		if err := b.describeVariables(ctx, []*rpc.Node{n}); err != nil {
+			return nil, err
+		}
+	}
+	return n, nil
+}
+
+// walk adds the children of node to it, recursing into child Objects.
+func (b *browser) walk(ctx context.Context, node *rpc.Node, nodeId *ua.NodeID, depth int) error {
+	if depth > b.opts.MaxDepth || b.truncated {
+		return nil
+	}
+	desc := &ua.BrowseDescription{
+		NodeID:          nodeId,
+		BrowseDirection: ua.BrowseDirectionForward,
+		ReferenceTypeID: ua.NewNumericNodeID(0, id.HierarchicalReferences),
+		IncludeSubtypes: true,
+		NodeClassMask:   uint32(ua.NodeClassObject | ua.NodeClassVariable | ua.NodeClassMethod),
+		ResultMask:      uint32(ua.BrowseResultMaskAll),
+	}
+	refs, err := b.browseOne(ctx, desc)
+	if err != nil {
+		return fmt.Errorf("browse %s: %w", nodeId, err)
+	}
+
+	type object struct {
+		node *rpc.Node
+		id   *ua.NodeID
+	}
+	var vars []*rpc.Node
+	var objects []object
+	for _, ref := range refs {
+		childId := ref.NodeID.NodeID
+		if !b.inNamespace(childId) || b.visited[childId.String()] {
+			continue
+		}
+		if b.count >= b.opts.MaxNodes {
+			b.truncated = true
+			break
+		}
+		b.count++
+		b.visited[childId.String()] = true
+		child := &rpc.Node{
+			NodeId:    childId.String(),
+			NodeClass: nodeClassToProto(ref.NodeClass),
+		}
+		if ref.BrowseName != nil {
+			child.BrowseName = ref.BrowseName.Name
+		}
+		if ref.DisplayName != nil {
+			child.DisplayName = ref.DisplayName.Text
+		}
+		node.Children = append(node.Children, child)
+		switch child.NodeClass {
+		case rpc.Node_VARIABLE:
+			vars = append(vars, child)
+		case rpc.Node_OBJECT:
+			objects = append(objects, object{child, childId})
+		}
+	}
+
+	if err := b.describeVariables(ctx, vars); err != nil {
+		return err
+	}
+	for _, o := range objects {
+		if err := b.walk(ctx, o.node, o.id, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// describeVariables fills in the data type, description, writability, and engineering units of vars.
+func (b *browser) describeVariables(ctx context.Context, vars []*rpc.Node) error {
+	if len(vars) == 0 {
+		return nil
+	}
+	ids := make([]*ua.NodeID, len(vars))
+	for i, v := range vars {
+		ids[i] = ua.MustParseNodeID(v.NodeId)
+	}
+	attrs := []ua.AttributeID{ua.AttributeIDDataType, ua.AttributeIDDescription, ua.AttributeIDAccessLevel, ua.AttributeIDUserAccessLevel}
+	res, err := b.read(ctx, ids, attrs...)
+	if err != nil {
+		return err
+	}
+	for i, v := range vars {
+		vals := res[i*len(attrs) : (i+1)*len(attrs)]
+		switch dt := vals[0].Value.Value().(type) {
+		case *ua.NodeID:
+			v.DataType = dataTypeName(dt)
+		case *ua.ExpandedNodeID: // some servers get this wrong
+			v.DataType = dataTypeName(dt.NodeID)
+		}
+		if d, ok := vals[1].Value.Value().(*ua.LocalizedText); ok {
+			v.Description = d.Text
+		}
+		access, _ := vals[2].Value.Value().(uint8)
+		v.Writable = ua.AccessLevelType(access)&ua.AccessLevelTypeCurrentWrite != 0
+		if userAccess, ok := vals[3].Value.Value().(uint8); ok && vals[3].Status == ua.StatusOK {
+			v.Writable = v.Writable && ua.AccessLevelType(userAccess)&ua.AccessLevelTypeCurrentWrite != 0
+		}
+	}
+	return b.readEngineeringUnits(ctx, vars, ids)
+}
+
+// readEngineeringUnits finds the EngineeringUnits property of each variable, and reads its value.
+func (b *browser) readEngineeringUnits(ctx context.Context, vars []*rpc.Node, ids []*ua.NodeID) error {
+	descs := make([]*ua.BrowseDescription, len(ids))
+	for i, nodeId := range ids {
+		descs[i] = &ua.BrowseDescription{
+			NodeID:          nodeId,
+			BrowseDirection: ua.BrowseDirectionForward,
+			ReferenceTypeID: ua.NewNumericNodeID(0, id.HasProperty),
+			IncludeSubtypes: true,
+			NodeClassMask:   uint32(ua.NodeClassVariable),
+			ResultMask:      uint32(ua.BrowseResultMaskAll),
+		}
+	}
+	allRefs, _, err := b.browse(ctx, descs...)
+	if err != nil {
+		// not all servers support browsing variables, units are optional anyway
+		return nil
+	}
+	var euIds []*ua.NodeID
+	var euVars []*rpc.Node
+	for i, v := range vars {
+		// allRefs[i] is empty if browsing the variable failed
+		for _, ref := range allRefs[i] {
+			if ref.BrowseName != nil && ref.BrowseName.Name == "EngineeringUnits" {
+				euIds = append(euIds, ref.NodeID.NodeID)
+				euVars = append(euVars, v)
+				break
+			}
+		}
+	}
+	if len(euIds) == 0 {
+		return nil
+	}
+	res, err := b.read(ctx, euIds, ua.AttributeIDValue)
+	if err != nil {
+		return err
+	}
+	for i, v := range euVars {
+		v.EngineeringUnits = engineeringUnits(res[i].Value.Value())
+	}
+	return nil
+}
+
+// browseOne browses a single node, see browse.
+func (b *browser) browseOne(ctx context.Context, desc *ua.BrowseDescription) ([]*ua.ReferenceDescription, error) {
+	refs, errs, err := b.browse(ctx, desc)
+	if err != nil {
+		return nil, err
+	}
+	return refs[0], errs[0]
+}
+
+// browse browses all descs using one request, following continuation points until all references have been returned.
+// The references of descs[i] are refs[i], or errs[i] is set if the server couldn't browse that node.
+func (b *browser) browse(ctx context.Context, descs ...*ua.BrowseDescription) (refs [][]*ua.ReferenceDescription, errs []error, err error) {
+	res, err := b.client.Browse(ctx, &ua.BrowseRequest{
+		View:          &ua.ViewDescription{ViewID: ua.NewTwoByteNodeID(0)},
+		NodesToBrowse: descs,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(res.Results) != len(descs) {
+		return nil, nil, fmt.Errorf("expected %d results, got %d", len(descs), len(res.Results))
+	}
+	refs = make([][]*ua.ReferenceDescription, len(descs))
+	errs = make([]error, len(descs))
+	var next []int // indexes of descs with more references to come
+	var points [][]byte
+	add := func(i int, result *ua.BrowseResult) {
+		if result.StatusCode != ua.StatusOK {
+			errs[i] = result.StatusCode
+			refs[i] = nil
+			return
+		}
+		refs[i] = append(refs[i], result.References...)
+		if len(result.ContinuationPoint) > 0 {
+			next = append(next, i)
+			points = append(points, result.ContinuationPoint)
+		}
+	}
+	for i, result := range res.Results {
+		add(i, result)
+	}
+	for len(points) > 0 {
+		res, err := b.client.BrowseNext(ctx, &ua.BrowseNextRequest{ContinuationPoints: points})
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(res.Results) != len(points) {
+			return nil, nil, fmt.Errorf("expected %d results, got %d", len(points), len(res.Results))
+		}
+		indexes := next
+		next, points = nil, nil
+		for j, result := range res.Results {
+			add(indexes[j], result)
+		}
+	}
+	return refs, errs, nil
+}
+
+// read reads attrs of each node, returning the results in node then attribute order.
+// Values in the results are never nil.
+func (b *browser) read(ctx context.Context, nodes []*ua.NodeID, attrs ...ua.AttributeID) ([]*ua.DataValue, error) {
+	req := &ua.ReadRequest{TimestampsToReturn: ua.TimestampsToReturnNeither}
+	for _, n := range nodes {
+		for _, a := range attrs {
+			req.NodesToRead = append(req.NodesToRead, &ua.ReadValueID{NodeID: n, AttributeID: a})
+		}
+	}
+	res, err := b.client.Read(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Results) != len(req.NodesToRead) {
+		return nil, errors.New("server returned the wrong number of results")
+	}
+	for _, r := range res.Results {
+		if r.Value == nil {
+			r.Value = &ua.Variant{}
+		}
+	}
+	return res.Results, nil
+}
+
+func (b *browser) inNamespace(n *ua.NodeID) bool {
+	return len(b.opts.Namespaces) == 0 || slices.Contains(b.opts.Namespaces, n.Namespace())
+}
+
+func nodeClassToProto(c ua.NodeClass) rpc.Node_NodeClass {
+	switch c {
+	case ua.NodeClassObject:
+		return rpc.Node_OBJECT
+	case ua.NodeClassVariable:
+		return rpc.Node_VARIABLE
+	case ua.NodeClassMethod:
+		return rpc.Node_METHOD
+	}
+	return rpc.Node_NODE_CLASS_UNSPECIFIED
+}
+
+// dataTypeName returns the name of built-in types, like "Double", which can be used as config.ValueSource.DataType.
+// Other types are returned as their NodeId.
+func dataTypeName(dt *ua.NodeID) string {
+	if dt.Namespace() == 0 && dt.Type() != ua.NodeIDTypeString && dt.IntID() > 0 && dt.IntID() <= uint32(ua.TypeIDDiagnosticInfo) {
+		return strings.TrimPrefix(ua.TypeID(dt.IntID()).String(), "TypeID")
+	}
+	return dt.String()
+}
+
+func engineeringUnits(v any) string {
+	if eo, ok := v.(*ua.ExtensionObject); ok {
+		v = eo.Value
+	}
+	switch eu := v.(type) {
+	case *ua.EUInformation:
+		if eu.DisplayName != nil {
+			return eu.DisplayName.Text
+		}
+	case ua.EUInformation:
+		if eu.DisplayName != nil {
+			return eu.DisplayName.Text
+		}
+	}
+	return ""
+}
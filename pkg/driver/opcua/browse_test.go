@@ -0,0 +1,171 @@
+package opcua
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gopcua/opcua/id"
+	"github.com/gopcua/opcua/server"
+	"github.com/gopcua/opcua/server/attrs"
+	"github.com/gopcua/opcua/ua"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/rpc"
+	"github.com/smart-core-os/sc-bos/pkg/proto/meterpb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+)
+
+func TestBrowse(t *testing.T) {
+	ts := newTestServer(t)
+	ahu := ts.addObject(ts.ns.Objects(), "AHU 1")
+	ts.addVariable(ahu, "SupplyTemp", 18.5, ua.TypeIDDouble, "°C", false)
+	ts.addVariable(ahu, "Zone SP", 21.0, ua.TypeIDDouble, "°C", true)
+	ts.addVariable(ahu, "Run", false, ua.TypeIDBoolean, "", true)
+	meters := ts.addObject(ts.ns.Objects(), "Meters")
+	main := ts.addObject(meters, "Main")
+	ts.addVariable(main, "Energy", 1200.0, ua.TypeIDDouble, "kWh", false)
+	ts.start(t)
+
+	svc := &driverService{client: ts.client.client, cfg: config.Root{}}
+	svc.cfg.Name = "opcua"
+	svc.cfg.Type = DriverName
+
+	res, err := svc.Browse(t.Context(), &rpc.BrowseRequest{RootNodeId: ts.ns.Objects().ID().String()})
+	require.NoError(t, err)
+	require.False(t, res.Truncated)
+
+	root := res.Root
+	require.Equal(t, rpc.Node_OBJECT, root.NodeClass)
+	ahuNode := findChild(t, root, "AHU 1")
+	require.Equal(t, rpc.Node_OBJECT, ahuNode.NodeClass)
+	sp := findChild(t, ahuNode, "Zone SP")
+	require.Equal(t, rpc.Node_VARIABLE, sp.NodeClass)
+	require.Equal(t, ts.nodeId("Zone SP"), sp.NodeId)
+	require.Equal(t, "Double", sp.DataType)
+	require.Equal(t, "°C", sp.EngineeringUnits)
+	require.True(t, sp.Writable)
+	require.False(t, findChild(t, ahuNode, "SupplyTemp").Writable)
+	energy := findChild(t, findChild(t, findChild(t, root, "Meters"), "Main"), "Energy")
+	require.Equal(t, "kWh", energy.EngineeringUnits)
+
+	t.Run("depth", func(t *testing.T) {
+		res, err := svc.Browse(t.Context(), &rpc.BrowseRequest{RootNodeId: ts.ns.Objects().ID().String(), MaxDepth: 1})
+		require.NoError(t, err)
+		require.Empty(t, findChild(t, res.Root, "Meters").Children)
+	})
+
+	t.Run("namespaces", func(t *testing.T) {
+		res, err := svc.Browse(t.Context(), &rpc.BrowseRequest{RootNodeId: ts.ns.Objects().ID().String(), Namespaces: []uint32{0}})
+		require.NoError(t, err)
+		require.Empty(t, res.Root.Children)
+	})
+
+	t.Run("ProposeConfig", func(t *testing.T) {
+		res, err := svc.ProposeConfig(t.Context(), &rpc.ProposeConfigRequest{RootNodeId: ts.ns.Objects().ID().String()})
+		require.NoError(t, err)
+		require.EqualValues(t, 2, res.DeviceCount)
+
+		// the config is valid driver config
+		cfg, err := config.ParseConfig([]byte(res.Config))
+		require.NoError(t, err)
+		require.Equal(t, "opcua", cfg.Name)
+		require.Equal(t, DriverName, cfg.Type)
+		require.Len(t, cfg.Devices, 2)
+
+		ahu := cfg.Devices[0]
+		require.Equal(t, "opcua/AHU-1", ahu.Name)
+		require.Len(t, ahu.Variables, 3)
+		traits := map[trait.Name]json.RawMessage{}
+		for _, tr := range ahu.Traits {
+			traits[tr.Kind] = tr.Raw
+		}
+		require.Contains(t, traits, trait.AirTemperature)
+		var at config.AirTemperatureConfig
+		require.NoError(t, json.Unmarshal(traits[trait.AirTemperature], &at))
+		require.Equal(t, ts.nodeId("SupplyTemp"), at.AmbientTemperature.NodeId)
+		require.Equal(t, ts.nodeId("Zone SP"), at.TemperatureSetPoint.NodeId)
+		require.Contains(t, traits, trait.OnOff)
+
+		meter := cfg.Devices[1]
+		require.Equal(t, "opcua/Meters/Main", meter.Name)
+		require.Len(t, meter.Traits, 1)
+		require.Equal(t, meterpb.TraitName, meter.Traits[0].Kind)
+	})
+}
+
+func TestBrowse_sharedNodes(t *testing.T) {
+	ts := newTestServer(t)
+	site := ts.addObject(ts.ns.Objects(), "Site")
+	ahu := ts.addObject(site, "AHU 1")
+	ts.addVariable(ahu, "SupplyTemp", 18.5, ua.TypeIDDouble, "°C", false)
+	plant := ts.addObject(site, "Plant")
+	// AHU 1 is organised under both Site and Plant, and refers back to Site
+	plant.AddRef(ahu, server.RefTypeIDOrganizes, true)
+	ahu.AddRef(site, server.RefTypeIDOrganizes, true)
+	ts.start(t)
+
+	svc := &driverService{client: ts.client.client, cfg: config.Root{}}
+	res, err := svc.Browse(t.Context(), &rpc.BrowseRequest{RootNodeId: site.ID().String()})
+	require.NoError(t, err)
+
+	var count func(n *rpc.Node, name string) int
+	count = func(n *rpc.Node, name string) int {
+		c := 0
+		if n.BrowseName == name {
+			c++
+		}
+		for _, child := range n.Children {
+			c += count(child, name)
+		}
+		return c
+	}
+	require.Equal(t, 1, count(res.Root, "AHU 1"))
+	require.Equal(t, 1, count(res.Root, "SupplyTemp"))
+	require.Equal(t, 1, count(res.Root, "Site"))
+	require.Equal(t, "°C", findChild(t, findChild(t, res.Root, "AHU 1"), "SupplyTemp").EngineeringUnits)
+}
+
+func findChild(t *testing.T, n *rpc.Node, name string) *rpc.Node {
+	t.Helper()
+	for _, c := range n.Children {
+		if c.BrowseName == name {
+			return c
+		}
+	}
+	t.Fatalf("%s has no child %q", n.BrowseName, name)
+	return nil
+}
+
+func (ts *testServer) addObject(parent *server.Node, name string) *server.Node {
+	// server.NewFolderNode panics creating the node class attribute
+	n := ts.ns.AddNode(server.NewNode(ua.NewStringNodeID(ts.ns.ID(), name), map[ua.AttributeID]*ua.DataValue{
+		ua.AttributeIDNodeClass:   server.DataValueFromValue(uint32(ua.NodeClassObject)),
+		ua.AttributeIDBrowseName:  server.DataValueFromValue(attrs.BrowseName(name)),
+		ua.AttributeIDDisplayName: server.DataValueFromValue(attrs.DisplayName(name, name)),
+		ua.AttributeIDDataType:    server.DataValueFromValue(ua.NewNumericExpandedNodeID(0, id.FolderType)),
+	}, nil, nil))
+	parent.AddRef(n, server.RefTypeIDOrganizes, true)
+	return n
+}
+
+func (ts *testServer) addVariable(parent *server.Node, name string, value any, typ ua.TypeID, units string, writable bool) *server.Node {
+	n := ts.ns.AddNewVariableStringNode(name, value)
+	parent.AddRef(n, server.RefTypeIDHasComponent, true)
+	// the server also uses the data type when browsing, and expects it to be an ExpandedNodeID
+	_ = n.SetAttribute(ua.AttributeIDDataType, server.DataValueFromValue(ua.NewNumericExpandedNodeID(0, uint32(typ))))
+	access := ua.AccessLevelTypeCurrentRead
+	if writable {
+		access |= ua.AccessLevelTypeCurrentWrite
+	}
+	_ = n.SetAttribute(ua.AttributeIDAccessLevel, server.DataValueFromValue(uint8(access)))
+	if units != "" {
+		eu := ts.ns.AddNewVariableStringNode(name+".EngineeringUnits", ua.NewExtensionObject(&ua.EUInformation{
+			DisplayName: &ua.LocalizedText{EncodingMask: ua.LocalizedTextText, Text: units},
+			Description: &ua.LocalizedText{},
+		}))
+		eu.SetBrowseName("EngineeringUnits")
+		n.AddRef(eu, server.RefType(id.HasProperty), true)
+	}
+	return n
+}
@@ -55,3 +55,62 @@ func (c *Client) Subscribe(ctx context.Context, nodeId *ua.NodeID) (<-chan *opcu
 	}
 	return notifyCh, nil
 }
+
+// Read reads the value attribute of the specified node.
+// A non-OK status for the value is returned as the error, alongside the value.
+func (c *Client) Read(ctx context.Context, nodeId *ua.NodeID) (*ua.DataValue, error) {
+	res, err := c.client.Read(ctx, &ua.ReadRequest{
+		NodesToRead:        []*ua.ReadValueID{{NodeID: nodeId, AttributeID: ua.AttributeIDValue}},
+		TimestampsToReturn: ua.TimestampsToReturnNeither,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Results) != 1 {
+		return nil, fmt.Errorf("expected one result, got %d", len(res.Results))
+	}
+	v := res.Results[0]
+	if !errors.Is(v.Status, ua.StatusOK) {
+		return v, v.Status
+	}
+	return v, nil
+}
+
+// Write writes value to the value attribute of the specified node.
+// A non-OK status for the write is returned as the error.
+func (c *Client) Write(ctx context.Context, nodeId *ua.NodeID, value *ua.Variant) error {
+	res, err := c.client.Write(ctx, &ua.WriteRequest{
+		NodesToWrite: []*ua.WriteValue{{
+			NodeID:      nodeId,
+			AttributeID: ua.AttributeIDValue,
+			Value:       &ua.DataValue{EncodingMask: ua.DataValueValue, Value: value},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	if len(res.Results) != 1 {
+		return fmt.Errorf("expected one result, got %d", len(res.Results))
+	}
+	if !errors.Is(res.Results[0], ua.StatusOK) {
+		return res.Results[0]
+	}
+	return nil
+}
+
+// Call calls the method on the object, passing args as input arguments.
+// A non-OK status for the call is returned as the error.
+func (c *Client) Call(ctx context.Context, objectId, methodId *ua.NodeID, args ...*ua.Variant) error {
+	res, err := c.client.Call(ctx, &ua.CallMethodRequest{
+		ObjectID:       objectId,
+		MethodID:       methodId,
+		InputArguments: args,
+	})
+	if err != nil {
+		return err
+	}
+	if !errors.Is(res.StatusCode, ua.StatusOK) {
+		return res.StatusCode
+	}
+	return nil
+}
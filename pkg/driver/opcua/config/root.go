@@ -21,6 +21,8 @@ import (
 
 const (
 	PointsEventTopicSuffix = "/event/pointset"
+
+	DefaultWriteConfirmTimeout = 5 * time.Second
 )
 
 // valueSourceField represents a ValueSource field with its description for validation.
@@ -100,6 +102,9 @@ type Conn struct {
 	// ClientId is the ID of the client that will be used to connect to the OPC UA server.
 	// Should be unique within the context of a server. If not set, a random ID will be generated.
 	ClientId uint32 `json:"clientId,omitempty,omitzero"`
+	// WriteConfirmTimeout is how long to wait for a written value to be read back from the server
+	// before the write is considered failed. Defaults to DefaultWriteConfirmTimeout.
+	WriteConfirmTimeout *jsontypes.Duration `json:"writeConfirmTimeout,omitempty,omitzero"`
 }
 
 // Variable is an OPC UA VariableNode, which is essentially a data point which we can read/write to (with permission).
@@ -107,7 +112,7 @@ type Variable struct {
 	// NodeId identifies the VariableNode in the OPC UA server.
 	NodeId string `json:"nodeId,omitempty"`
 	// ParsedNodeId is the parsed ua.NodeID.
-	ParsedNodeId *ua.NodeID
+	ParsedNodeId *ua.NodeID `json:"-"`
 }
 
 // Device represents a smart core device.
@@ -147,6 +152,9 @@ func ParseConfig(data []byte) (cfg Root, err error) {
 	if cfg.Conn.SubscriptionInterval == nil {
 		cfg.Conn.SubscriptionInterval = &jsontypes.Duration{Duration: 5 * time.Second}
 	}
+	if cfg.Conn.WriteConfirmTimeout == nil {
+		cfg.Conn.WriteConfirmTimeout = &jsontypes.Duration{Duration: DefaultWriteConfirmTimeout}
+	}
 	if cfg.Conn.ClientId == 0 {
 		cfg.Conn.ClientId = rand.Uint32()
 	}
@@ -189,6 +197,14 @@ func validateDeviceTraits(device *Device) error {
 			valueSources, err = getValueSourcesForTrait[*TransportConfig](device.Name, t.Raw)
 		case udmipb.TraitName:
 			valueSources, err = getValueSourcesForTrait[*UdmiConfig](device.Name, t.Raw)
+		case trait.AirTemperature:
+			valueSources, err = getValueSourcesForTrait[*AirTemperatureConfig](device.Name, t.Raw)
+		case trait.OnOff:
+			valueSources, err = getValueSourcesForTrait[*OnOffConfig](device.Name, t.Raw)
+		case trait.FanSpeed:
+			valueSources, err = getValueSourcesForTrait[*FanSpeedConfig](device.Name, t.Raw)
+		case trait.Mode:
+			valueSources, err = getValueSourcesForTrait[*ModeConfig](device.Name, t.Raw)
 		default:
 			return fmt.Errorf("device '%s': unknown trait kind '%s'", device.Name, t.Kind)
 		}
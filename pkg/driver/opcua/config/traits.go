@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/gopcua/opcua/ua"
+
 	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/conv"
 	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
 	"github.com/smart-core-os/sc-bos/pkg/trait"
@@ -48,6 +50,22 @@ type ValueSource struct {
 	// OPC UA value as the key and the element from the generated <EnumName>_value field in the trait pb file.
 	// The key needs to be an integer, it is defined as a string here for JSON marshaling.
 	Enum map[string]string `json:"enum,omitempty"`
+
+	// Optional. WriteNodeId is the Variable written to when the trait value is updated.
+	// Defaults to NodeId. Useful when a device exposes separate command and feedback points,
+	// in which case NodeId is the feedback point and is read to confirm the write.
+	WriteNodeId string `json:"writeNodeId,omitempty"`
+	// Optional. Method is called, with the new value as its only input argument, instead of writing a Variable.
+	Method *Method `json:"method,omitempty"`
+	// Optional. DataType is the OPC UA built-in type written to the node, like "Double", "Int32", or "Boolean".
+	// Defaults to the type of the last value received from NodeId, or Double if no value has been received.
+	DataType string `json:"dataType,omitempty"`
+}
+
+// Method identifies an OPC UA Method Node and the Object Node it is called on.
+type Method struct {
+	ObjectId string `json:"objectId,omitempty"`
+	MethodId string `json:"methodId,omitempty"`
 }
 
 // Validate checks that the ValueSource has a valid NodeId.
@@ -58,9 +76,55 @@ func (v *ValueSource) Validate(fieldName string) error {
 	if v.NodeId == "" {
 		return fmt.Errorf("%s: nodeId is required", fieldName)
 	}
+	if v.WriteNodeId != "" {
+		if _, err := ua.ParseNodeID(v.WriteNodeId); err != nil {
+			return fmt.Errorf("%s: writeNodeId: %w", fieldName, err)
+		}
+	}
+	if v.Method != nil {
+		if v.Method.ObjectId == "" || v.Method.MethodId == "" {
+			return fmt.Errorf("%s: method objectId and methodId are required", fieldName)
+		}
+		if _, err := ua.ParseNodeID(v.Method.ObjectId); err != nil {
+			return fmt.Errorf("%s: method objectId: %w", fieldName, err)
+		}
+		if _, err := ua.ParseNodeID(v.Method.MethodId); err != nil {
+			return fmt.Errorf("%s: method methodId: %w", fieldName, err)
+		}
+	}
+	if v.DataType != "" {
+		if _, err := conv.ParseTypeID(v.DataType); err != nil {
+			return fmt.Errorf("%s: %w", fieldName, err)
+		}
+	}
 	return nil
 }
 
+// GetIntKeyFromValue is the reverse of GetValueFromIntKey.
+// It returns the OPC UA value whose enum entry is val, or false if there is no such entry.
+func (v *ValueSource) GetIntKeyFromValue(val string) (int, bool) {
+	for k, s := range v.Enum {
+		if s != val {
+			continue
+		}
+		i, err := strconv.Atoi(k)
+		if err != nil {
+			continue
+		}
+		return i, true
+	}
+	return 0, false
+}
+
+// Unscaled returns val divided by the Scale factor, the reverse of Scaled.
+// Used when writing a trait value to the OPC UA server.
+func (v *ValueSource) Unscaled(val float64) float64 {
+	if v.Scale == 0 || v.Scale == 1 {
+		return val
+	}
+	return val / v.Scale
+}
+
 // GetValueFromIntKey get the value from the enum map given an integer OPC UA value
 func (v *ValueSource) GetValueFromIntKey(val any) any {
 	if v.Enum != nil {
@@ -406,6 +470,142 @@ func (c *ElectricPhaseConfig) valueSources(prefix string) []valueSourceField {
 	}
 }
 
+// AirTemperatureConfig is configured by a Device that wants to implement the AirTemperature trait.
+// TemperatureSetPoint and Mode can be written to.
+type AirTemperatureConfig struct {
+	Trait
+	AmbientTemperature  *ValueSource `json:"ambientTemperature,omitempty"`
+	AmbientHumidity     *ValueSource `json:"ambientHumidity,omitempty"`
+	TemperatureSetPoint *ValueSource `json:"temperatureSetPoint,omitempty"`
+	// Mode maps OPC UA values to AirTemperature.Mode names, like "HEAT" or "COOL", via Enum.
+	Mode *ValueSource `json:"mode,omitempty"`
+}
+
+// Validate checks that the AirTemperature config has at least one field configured.
+func (c *AirTemperatureConfig) Validate() error {
+	if c.AmbientTemperature == nil && c.AmbientHumidity == nil && c.TemperatureSetPoint == nil && c.Mode == nil {
+		return fmt.Errorf("air temperature trait: at least one field must be configured")
+	}
+	for _, f := range c.valueSources() {
+		if err := f.value.Validate(f.desc); err != nil {
+			return err
+		}
+	}
+	if c.Mode != nil && len(c.Mode.Enum) == 0 {
+		return fmt.Errorf("air temperature trait mode: enum is required")
+	}
+	return nil
+}
+
+// valueSources returns all ValueSource fields in the AirTemperatureConfig for validation.
+func (c *AirTemperatureConfig) valueSources() []valueSourceField {
+	return []valueSourceField{
+		{"air temperature trait ambientTemperature", c.AmbientTemperature},
+		{"air temperature trait ambientHumidity", c.AmbientHumidity},
+		{"air temperature trait temperatureSetPoint", c.TemperatureSetPoint},
+		{"air temperature trait mode", c.Mode},
+	}
+}
+
+// OnOffConfig is configured by a Device that wants to implement the OnOff trait.
+type OnOffConfig struct {
+	Trait
+	// State is the on/off state of the device, and can be written to.
+	// Without an Enum, true or non-zero values are ON.
+	// With an Enum, OPC UA values are mapped to "ON" or "OFF".
+	State *ValueSource `json:"state,omitempty"`
+}
+
+// Validate checks that the OnOff config has state configured.
+func (c *OnOffConfig) Validate() error {
+	if c.State == nil {
+		return fmt.Errorf("on off trait: state is required")
+	}
+	return c.State.Validate("on off state")
+}
+
+// valueSources returns all ValueSource fields in the OnOffConfig for validation.
+func (c *OnOffConfig) valueSources() []valueSourceField {
+	return []valueSourceField{
+		{"on off trait state", c.State},
+	}
+}
+
+// FanSpeedConfig is configured by a Device that wants to implement the FanSpeed trait.
+type FanSpeedConfig struct {
+	Trait
+	// Percentage is the speed of the fan, and can be written to.
+	// Use Scale to convert from other units, for example a Scale of 100 for a 0-1 device value.
+	Percentage *ValueSource `json:"percentage,omitempty"`
+	// Presets are named speeds, in order from slowest to fastest.
+	Presets []FanSpeedPreset `json:"presets,omitempty"`
+}
+
+type FanSpeedPreset struct {
+	Name       string  `json:"name,omitempty"`
+	Percentage float32 `json:"percentage"`
+}
+
+// Validate checks that the FanSpeed config has percentage configured.
+func (c *FanSpeedConfig) Validate() error {
+	if c.Percentage == nil {
+		return fmt.Errorf("fan speed trait: percentage is required")
+	}
+	for i, p := range c.Presets {
+		if p.Name == "" {
+			return fmt.Errorf("fan speed trait preset[%d]: name is required", i)
+		}
+	}
+	return c.Percentage.Validate("fan speed percentage")
+}
+
+// valueSources returns all ValueSource fields in the FanSpeedConfig for validation.
+func (c *FanSpeedConfig) valueSources() []valueSourceField {
+	return []valueSourceField{
+		{"fan speed trait percentage", c.Percentage},
+	}
+}
+
+// ModeConfig is configured by a Device that wants to implement the Mode trait.
+type ModeConfig struct {
+	Trait
+	// Modes maps mode names to the node holding the mode value.
+	// The Enum of each ValueSource maps OPC UA values to mode value names, and lists the available values.
+	// All modes can be written to.
+	Modes map[string]*ValueSource `json:"modes,omitempty"`
+}
+
+// Validate checks that the Mode config has at least one mode, each with an enum.
+func (c *ModeConfig) Validate() error {
+	if len(c.Modes) == 0 {
+		return fmt.Errorf("mode trait: at least one mode must be configured")
+	}
+	for name, m := range c.Modes {
+		if m == nil {
+			return fmt.Errorf("mode trait mode '%s': source is required", name)
+		}
+		if err := m.Validate(fmt.Sprintf("mode trait mode '%s'", name)); err != nil {
+			return err
+		}
+		if len(m.Enum) == 0 {
+			return fmt.Errorf("mode trait mode '%s': enum is required", name)
+		}
+	}
+	return nil
+}
+
+// valueSources returns all ValueSource fields in the ModeConfig for validation.
+func (c *ModeConfig) valueSources() []valueSourceField {
+	fields := make([]valueSourceField, 0, len(c.Modes))
+	for name, m := range c.Modes {
+		fields = append(fields, valueSourceField{
+			desc:  fmt.Sprintf("mode trait mode '%s'", name),
+			value: m,
+		})
+	}
+	return fields
+}
+
 type HealthCheck struct {
 	Health
 	ValueSource
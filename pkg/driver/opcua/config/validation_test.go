@@ -409,6 +409,134 @@ func TestValidateDeviceTraits(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "valid air temperature with write node and data type",
+			device: Device{
+				Name: "test-device",
+				Variables: []*Variable{
+					{NodeId: "ns=2;s=Fb"},
+					{NodeId: "ns=2;s=Mode"},
+				},
+				Traits: []RawTrait{
+					{
+						Trait: Trait{Kind: "smartcore.traits.AirTemperature"},
+						Raw:   []byte(`{"temperatureSetPoint":{"nodeId":"ns=2;s=Fb","writeNodeId":"ns=2;s=Cmd","dataType":"Float"},"mode":{"nodeId":"ns=2;s=Mode","enum":{"0":"OFF","1":"HEAT"}}}`),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "air temperature mode without enum",
+			device: Device{
+				Name: "test-device",
+				Variables: []*Variable{
+					{NodeId: "ns=2;s=Mode"},
+				},
+				Traits: []RawTrait{
+					{
+						Trait: Trait{Kind: "smartcore.traits.AirTemperature"},
+						Raw:   []byte(`{"mode":{"nodeId":"ns=2;s=Mode"}}`),
+					},
+				},
+			},
+			wantErr: true,
+			errMsg:  "enum is required",
+		},
+		{
+			name: "invalid data type",
+			device: Device{
+				Name: "test-device",
+				Variables: []*Variable{
+					{NodeId: "ns=2;s=Fb"},
+				},
+				Traits: []RawTrait{
+					{
+						Trait: Trait{Kind: "smartcore.traits.AirTemperature"},
+						Raw:   []byte(`{"temperatureSetPoint":{"nodeId":"ns=2;s=Fb","dataType":"DateTime"}}`),
+					},
+				},
+			},
+			wantErr: true,
+			errMsg:  "unsupported data type",
+		},
+		{
+			name: "valid on off with method",
+			device: Device{
+				Name: "test-device",
+				Variables: []*Variable{
+					{NodeId: "ns=2;s=Run"},
+				},
+				Traits: []RawTrait{
+					{
+						Trait: Trait{Kind: "smartcore.traits.OnOff"},
+						Raw:   []byte(`{"state":{"nodeId":"ns=2;s=Run","method":{"objectId":"ns=2;s=FCU","methodId":"ns=2;s=SetRun"}}}`),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "on off method without object",
+			device: Device{
+				Name: "test-device",
+				Variables: []*Variable{
+					{NodeId: "ns=2;s=Run"},
+				},
+				Traits: []RawTrait{
+					{
+						Trait: Trait{Kind: "smartcore.traits.OnOff"},
+						Raw:   []byte(`{"state":{"nodeId":"ns=2;s=Run","method":{"methodId":"ns=2;s=SetRun"}}}`),
+					},
+				},
+			},
+			wantErr: true,
+			errMsg:  "objectId",
+		},
+		{
+			name: "fan speed without percentage",
+			device: Device{
+				Name: "test-device",
+				Traits: []RawTrait{
+					{
+						Trait: Trait{Kind: "smartcore.traits.FanSpeed"},
+						Raw:   []byte(`{"presets":[{"name":"low","percentage":30}]}`),
+					},
+				},
+			},
+			wantErr: true,
+			errMsg:  "percentage is required",
+		},
+		{
+			name: "valid mode",
+			device: Device{
+				Name: "test-device",
+				Variables: []*Variable{
+					{NodeId: "ns=2;s=Occ"},
+				},
+				Traits: []RawTrait{
+					{
+						Trait: Trait{Kind: "smartcore.traits.Mode"},
+						Raw:   []byte(`{"modes":{"occupancy":{"nodeId":"ns=2;s=Occ","enum":{"0":"unoccupied","1":"occupied"}}}}`),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "mode without modes",
+			device: Device{
+				Name: "test-device",
+				Traits: []RawTrait{
+					{
+						Trait: Trait{Kind: "smartcore.traits.Mode"},
+						Raw:   []byte(`{}`),
+					},
+				},
+			},
+			wantErr: true,
+			errMsg:  "at least one mode",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,152 @@
+package conv
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/gopcua/opcua/ua"
+)
+
+// typeIDs are the OPC UA built-in types that values can be written as, keyed by lower case name.
+var typeIDs = map[string]ua.TypeID{
+	"boolean": ua.TypeIDBoolean,
+	"sbyte":   ua.TypeIDSByte,
+	"byte":    ua.TypeIDByte,
+	"int16":   ua.TypeIDInt16,
+	"uint16":  ua.TypeIDUint16,
+	"int32":   ua.TypeIDInt32,
+	"uint32":  ua.TypeIDUint32,
+	"int64":   ua.TypeIDInt64,
+	"uint64":  ua.TypeIDUint64,
+	"float":   ua.TypeIDFloat,
+	"double":  ua.TypeIDDouble,
+	"string":  ua.TypeIDString,
+}
+
+// ParseTypeID returns the OPC UA built-in type with the given name, like "Double" or "Int32".
+// Names are case-insensitive.
+func ParseTypeID(name string) (ua.TypeID, error) {
+	if t, ok := typeIDs[strings.ToLower(name)]; ok {
+		return t, nil
+	}
+	return 0, fmt.Errorf("unsupported data type %q", name)
+}
+
+// ToVariant converts data, a bool, number, or string, into a variant of the given OPC UA type.
+// Numbers are rounded when converting to integer types and must fit within the range of the type.
+func ToVariant(data any, typ ua.TypeID) (*ua.Variant, error) {
+	var v any
+	switch typ {
+	case ua.TypeIDBoolean:
+		b, err := toBool(data)
+		if err != nil {
+			return nil, err
+		}
+		v = b
+	case ua.TypeIDString:
+		v = fmt.Sprint(data)
+	case ua.TypeIDFloat, ua.TypeIDDouble:
+		f, err := toFloat64(data)
+		if err != nil {
+			return nil, err
+		}
+		if typ == ua.TypeIDFloat {
+			v = float32(f)
+		} else {
+			v = f
+		}
+	default:
+		f, err := toFloat64(data)
+		if err != nil {
+			return nil, err
+		}
+		i, err := toInt(math.Round(f), typ)
+		if err != nil {
+			return nil, err
+		}
+		v = i
+	}
+	return ua.NewVariant(v)
+}
+
+func toInt(f float64, typ ua.TypeID) (any, error) {
+	inRange := func(lo, hi float64) error {
+		if f < lo || f > hi {
+			return fmt.Errorf("value %v out of range for %s", f, typ)
+		}
+		return nil
+	}
+	switch typ {
+	case ua.TypeIDSByte:
+		return int8(f), inRange(math.MinInt8, math.MaxInt8)
+	case ua.TypeIDByte:
+		return uint8(f), inRange(0, math.MaxUint8)
+	case ua.TypeIDInt16:
+		return int16(f), inRange(math.MinInt16, math.MaxInt16)
+	case ua.TypeIDUint16:
+		return uint16(f), inRange(0, math.MaxUint16)
+	case ua.TypeIDInt32:
+		return int32(f), inRange(math.MinInt32, math.MaxInt32)
+	case ua.TypeIDUint32:
+		return uint32(f), inRange(0, math.MaxUint32)
+	case ua.TypeIDInt64:
+		return int64(f), inRange(math.MinInt64, math.MaxInt64)
+	case ua.TypeIDUint64:
+		return uint64(f), inRange(0, math.MaxUint64)
+	}
+	return nil, fmt.Errorf("unsupported data type %s", typ)
+}
+
+func toFloat64(data any) (float64, error) {
+	switch v := data.(type) {
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case uint64:
+		return float64(v), nil
+	case string:
+		return strconv.ParseFloat(v, 64)
+	}
+	return Float64Value(data)
+}
+
+func toBool(data any) (bool, error) {
+	switch v := data.(type) {
+	case bool:
+		return v, nil
+	case string:
+		return strconv.ParseBool(v)
+	}
+	f, err := toFloat64(data)
+	if err != nil {
+		return false, err
+	}
+	return f != 0, nil
+}
+
+// ToBool converts an OPC UA value to a bool.
+// Numbers are true if they are non-zero.
+func ToBool(data any) (bool, error) {
+	return toBool(data)
+}
+
+// Equal reports whether two OPC UA values are the same, allowing for numbers of different types
+// and for the precision lost when converting between float types.
+func Equal(a, b any) bool {
+	if af, err := toFloat64(a); err == nil {
+		if bf, err := toFloat64(b); err == nil {
+			return math.Abs(af-bf) <= 1e-6*math.Max(1, math.Max(math.Abs(af), math.Abs(bf)))
+		}
+	}
+	as, aErr := ToString(a)
+	bs, bErr := ToString(b)
+	return aErr == nil && bErr == nil && as == bs
+}
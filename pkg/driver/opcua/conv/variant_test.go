@@ -0,0 +1,75 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/gopcua/opcua/ua"
+)
+
+func TestToVariant(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    any
+		typ     ua.TypeID
+		want    any
+		wantErr bool
+	}{
+		{name: "double", data: 21.5, typ: ua.TypeIDDouble, want: 21.5},
+		{name: "float", data: 21.5, typ: ua.TypeIDFloat, want: float32(21.5)},
+		{name: "int32 rounds", data: 2.6, typ: ua.TypeIDInt32, want: int32(3)},
+		{name: "int to uint16", data: 7, typ: ua.TypeIDUint16, want: uint16(7)},
+		{name: "bool to byte", data: true, typ: ua.TypeIDByte, want: uint8(1)},
+		{name: "number to bool", data: 1, typ: ua.TypeIDBoolean, want: true},
+		{name: "string to bool", data: "false", typ: ua.TypeIDBoolean, want: false},
+		{name: "string to double", data: "1.5", typ: ua.TypeIDDouble, want: 1.5},
+		{name: "number to string", data: 4, typ: ua.TypeIDString, want: "4"},
+		{name: "byte out of range", data: 300, typ: ua.TypeIDByte, wantErr: true},
+		{name: "negative uint", data: -1, typ: ua.TypeIDUint32, wantErr: true},
+		{name: "unsupported type", data: 1, typ: ua.TypeIDDateTime, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToVariant(tt.data, tt.typ)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ToVariant() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.Value() != tt.want {
+				t.Errorf("ToVariant() = %T(%v), want %T(%v)", got.Value(), got.Value(), tt.want, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTypeID(t *testing.T) {
+	if got, err := ParseTypeID("int32"); err != nil || got != ua.TypeIDInt32 {
+		t.Errorf("ParseTypeID(int32) = %v, %v", got, err)
+	}
+	if got, err := ParseTypeID("Double"); err != nil || got != ua.TypeIDDouble {
+		t.Errorf("ParseTypeID(Double) = %v, %v", got, err)
+	}
+	if _, err := ParseTypeID("DateTime"); err == nil {
+		t.Error("ParseTypeID(DateTime) expected error")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		a, b any
+		want bool
+	}{
+		{a: float32(21.1), b: 21.1, want: true},
+		{a: int32(3), b: 3, want: true},
+		{a: uint8(1), b: true, want: true},
+		{a: 21.0, b: 21.5, want: false},
+		{a: "on", b: "on", want: true},
+		{a: "on", b: "off", want: false},
+	}
+	for _, tt := range tests {
+		if got := Equal(tt.a, tt.b); got != tt.want {
+			t.Errorf("Equal(%T(%v), %T(%v)) = %v, want %v", tt.a, tt.a, tt.b, tt.b, got, tt.want)
+		}
+	}
+}
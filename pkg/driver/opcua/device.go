@@ -23,20 +23,24 @@ type device struct {
 	client *Client
 
 	eventHandlers []EventHandler
+	writer        *writer
 
-	faultCheck  *healthpb.FaultCheck
-	systemCheck service.SystemCheck
+	faultCheck   *healthpb.FaultCheck
+	statusFaults *pointStatusFaults
+	systemCheck  service.SystemCheck
 }
 
 // newDevice creates a new device instance for the given configuration.
-// Trait implementations (Electric, Meter, Transport, udmi) must be assigned separately before calling run.
+// Trait implementations (Electric, Meter, Transport, udmi, etc) must be assigned separately before calling run.
+// Traits that write to the device should use the devices writer.
 func newDevice(conf *config.Device, logger *zap.Logger, client *Client, check *healthpb.FaultCheck, systemCheck service.SystemCheck) *device {
 	return &device{
-		client:      client,
-		conf:        conf,
-		faultCheck:  check,
-		logger:      logger,
-		systemCheck: systemCheck,
+		client:       client,
+		conf:         conf,
+		faultCheck:   check,
+		statusFaults: newPointStatusFaults(check),
+		logger:       logger,
+		systemCheck:  systemCheck,
 	}
 }
 
@@ -73,6 +77,7 @@ func (d *device) subscribe(ctx context.Context) error {
 // handleEvent processes OPC UA subscription events and routes them to trait handlers.
 // It handles both DataChangeNotification (variable value changes) and EventNotificationList (OPC UA events).
 // Values with non-OK status codes are logged as warnings and not passed to trait handlers.
+// Bad and Uncertain status codes raise faults on the device health check until the point reports OK again.
 func (d *device) handleEvent(ctx context.Context, event *opcua.PublishNotificationData, node *ua.NodeID) {
 	if event.Error != nil {
 		d.faultCheck.UpdateReliability(ctx, healthpb.ReliabilityFromErr(event.Error))
@@ -89,9 +94,11 @@ func (d *device) handleEvent(ctx context.Context, event *opcua.PublishNotificati
 				continue
 			}
 
+			d.statusFaults.update(node.String(), item.Value.Status)
 			if errors.Is(item.Value.Status, ua.StatusOK) {
 				d.faultCheck.UpdateReliability(ctx, healthpb.ReliabilityFromErr(nil))
 				service.UpdateSystemCheck(d.systemCheck, nil)
+				d.writer.observe(node, item.Value.Value)
 				value := item.Value.Value.Value()
 				d.handleTraitEvent(ctx, node, value)
 			} else {
@@ -103,6 +110,7 @@ func (d *device) handleEvent(ctx context.Context, event *opcua.PublishNotificati
 	case *ua.EventNotificationList:
 		for _, item := range x.Events {
 			for _, field := range item.EventFields {
+				d.statusFaults.update(node.String(), field.StatusCode())
 				if errors.Is(field.StatusCode(), ua.StatusOK) {
 					value := field.Value()
 					d.faultCheck.UpdateReliability(ctx, healthpb.ReliabilityFromErr(nil))
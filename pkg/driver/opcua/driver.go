@@ -1,6 +1,11 @@
 // Package opcua implements a Smart Core driver for OPC UA servers.
 // It subscribes to OPC UA variable nodes and exposes their values through Smart Core traits
 // including Meter, Electric, Transport, and UDMI.
+// The AirTemperature, OnOff, FanSpeed, and Mode traits can also be controlled, writing to nodes or calling methods
+// and confirming each write by reading the value back.
+//
+// The driver also exposes rpc.OpcuaDriverService using its own name, to browse the address space of the server
+// and propose device config.
 //
 // The driver creates an internal device instance for each configured device, which manages
 // OPC UA subscriptions and routes value changes to the appropriate trait handlers.
@@ -18,11 +23,16 @@ import (
 
 	"github.com/smart-core-os/sc-bos/pkg/driver"
 	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/rpc"
 	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/proto/airtemperaturepb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/electricpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/fanspeedpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/meterpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/modepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/onoffpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/transportpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/udmipb"
 	"github.com/smart-core-os/sc-bos/pkg/task/service"
@@ -87,7 +97,8 @@ func (d *Driver) applyConfig(ctx context.Context, cfg config.Root) error {
 
 	client := NewClient(opcClient, d.logger, cfg.Conn.SubscriptionInterval.Duration, cfg.Conn.ClientId)
 
-	a.Announce(cfg.Name, node.HasMetadata(cfg.Meta))
+	a.Announce(cfg.Name, node.HasMetadata(cfg.Meta),
+		node.HasServer(rpc.RegisterOpcuaDriverServiceServer, rpc.OpcuaDriverServiceServer(&driverService{client: opcClient, cfg: cfg})))
 
 	grp, ctx := errgroup.WithContext(ctx)
 	for _, dev := range cfg.Devices {
@@ -101,6 +112,7 @@ func (d *Driver) applyConfig(ctx context.Context, cfg config.Root) error {
 		d.checks = append(d.checks, faultCheck)
 
 		opcDev := newDevice(&dev, d.logger, client, faultCheck, d.systemCheck)
+		opcDev.writer = newWriter(client, d.logger, faultCheck, cfg.Conn.WriteConfirmTimeout.Duration)
 
 		for _, t := range dev.Traits {
 			switch t.Kind {
@@ -154,6 +166,56 @@ func (d *Driver) applyConfig(ctx context.Context, cfg config.Root) error {
 					node.HasTrait(trait.Electric),
 				)
 
+			case trait.AirTemperature:
+				at, err := newAirTemperature(dev.Name, t, d.logger, opcDev.writer)
+				if err != nil {
+					d.logger.Error("failed to add trait, invalid config", zap.String("device", dev.Name), zap.Stringer("trait", trait.AirTemperature), zap.Error(err))
+					return err
+				}
+				opcDev.eventHandlers = append(opcDev.eventHandlers, at)
+				allFeatures = append(allFeatures,
+					node.HasServer(airtemperaturepb.RegisterAirTemperatureApiServer, airtemperaturepb.AirTemperatureApiServer(at)),
+					node.HasTrait(trait.AirTemperature),
+				)
+
+			case trait.OnOff:
+				o, err := newOnOff(dev.Name, t, d.logger, opcDev.writer)
+				if err != nil {
+					d.logger.Error("failed to add trait, invalid config", zap.String("device", dev.Name), zap.Stringer("trait", trait.OnOff), zap.Error(err))
+					return err
+				}
+				opcDev.eventHandlers = append(opcDev.eventHandlers, o)
+				allFeatures = append(allFeatures,
+					node.HasServer(onoffpb.RegisterOnOffApiServer, onoffpb.OnOffApiServer(o)),
+					node.HasTrait(trait.OnOff),
+				)
+
+			case trait.FanSpeed:
+				fs, err := newFanSpeed(dev.Name, t, d.logger, opcDev.writer)
+				if err != nil {
+					d.logger.Error("failed to add trait, invalid config", zap.String("device", dev.Name), zap.Stringer("trait", trait.FanSpeed), zap.Error(err))
+					return err
+				}
+				opcDev.eventHandlers = append(opcDev.eventHandlers, fs)
+				allFeatures = append(allFeatures,
+					node.HasServer(fanspeedpb.RegisterFanSpeedApiServer, fanspeedpb.FanSpeedApiServer(fs)),
+					node.HasServer(fanspeedpb.RegisterFanSpeedInfoServer, fanspeedpb.FanSpeedInfoServer(fs)),
+					node.HasTrait(trait.FanSpeed),
+				)
+
+			case trait.Mode:
+				m, err := newMode(dev.Name, t, d.logger, opcDev.writer)
+				if err != nil {
+					d.logger.Error("failed to add trait, invalid config", zap.String("device", dev.Name), zap.Stringer("trait", trait.Mode), zap.Error(err))
+					return err
+				}
+				opcDev.eventHandlers = append(opcDev.eventHandlers, m)
+				allFeatures = append(allFeatures,
+					node.HasServer(modepb.RegisterModeApiServer, modepb.ModeApiServer(m)),
+					node.HasServer(modepb.RegisterModeInfoServer, modepb.ModeInfoServer(m)),
+					node.HasTrait(trait.Mode),
+				)
+
 			case healthpb.TraitName:
 				h, err := newHealth(t, d.logger)
 				if err != nil {
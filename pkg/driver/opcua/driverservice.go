@@ -0,0 +1,82 @@
+package opcua
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gopcua/opcua"
+	"github.com/gopcua/opcua/ua"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/rpc"
+)
+
+// driverService implements rpc.OpcuaDriverServiceServer for the server the driver is connected to.
+type driverService struct {
+	rpc.UnimplementedOpcuaDriverServiceServer
+
+	client *opcua.Client
+	cfg    config.Root
+}
+
+func (s *driverService) Browse(ctx context.Context, req *rpc.BrowseRequest) (*rpc.BrowseResponse, error) {
+	opts, err := browseOptions(req.GetRootNodeId(), req.GetMaxDepth(), req.GetNamespaces())
+	if err != nil {
+		return nil, err
+	}
+	root, truncated, err := Browse(ctx, s.client, opts)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "browse: %v", err)
+	}
+	return &rpc.BrowseResponse{Root: root, Truncated: truncated}, nil
+}
+
+func (s *driverService) ProposeConfig(ctx context.Context, req *rpc.ProposeConfigRequest) (*rpc.ProposeConfigResponse, error) {
+	opts, err := browseOptions(req.GetRootNodeId(), req.GetMaxDepth(), req.GetNamespaces())
+	if err != nil {
+		return nil, err
+	}
+	root, truncated, err := Browse(ctx, s.client, opts)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "browse: %v", err)
+	}
+	prefix := req.GetDeviceNamePrefix()
+	if prefix == "" {
+		prefix = s.cfg.Name
+	}
+	cfg := config.Root{
+		BaseConfig: s.cfg.BaseConfig,
+		Meta:       s.cfg.Meta,
+		Conn:       s.cfg.Conn,
+		Devices:    ProposeDevices(prefix, root),
+	}
+	data, err := json.MarshalIndent(cfg, "", "  ")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encode config: %v", err)
+	}
+	return &rpc.ProposeConfigResponse{
+		Config:      string(data),
+		DeviceCount: int32(len(cfg.Devices)),
+		Truncated:   truncated,
+	}, nil
+}
+
+func browseOptions(rootNodeId string, maxDepth int32, namespaces []uint32) (BrowseOptions, error) {
+	opts := BrowseOptions{MaxDepth: int(maxDepth)}
+	if rootNodeId != "" {
+		root, err := ua.ParseNodeID(rootNodeId)
+		if err != nil {
+			return opts, status.Errorf(codes.InvalidArgument, "root_node_id: %v", err)
+		}
+		opts.Root = root
+	}
+	for _, ns := range namespaces {
+		if ns > 0xFFFF {
+			return opts, status.Errorf(codes.InvalidArgument, "namespace %d out of range", ns)
+		}
+		opts.Namespaces = append(opts.Namespaces, uint16(ns))
+	}
+	return opts, nil
+}
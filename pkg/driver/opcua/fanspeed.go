@@ -0,0 +1,168 @@
+package opcua
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+
+	"github.com/gopcua/opcua/ua"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/conv"
+	"github.com/smart-core-os/sc-bos/pkg/proto/fanspeedpb"
+	"github.com/smart-core-os/sc-bos/pkg/resource"
+)
+
+// FanSpeed implements the Smart Core FanSpeed trait for OPC UA devices.
+// Presets are mapped to configured percentages, the percentage is written back to the device when updated.
+type FanSpeed struct {
+	fanspeedpb.UnimplementedFanSpeedApiServer
+	fanspeedpb.UnimplementedFanSpeedInfoServer
+
+	cfg    config.FanSpeedConfig
+	logger *zap.Logger
+	scName string
+	value  *resource.Value // *fanspeedpb.FanSpeed
+	writer *writer
+}
+
+func readFanSpeedConfig(raw []byte) (cfg config.FanSpeedConfig, err error) {
+	err = json.Unmarshal(raw, &cfg)
+	return
+}
+
+func newFanSpeed(n string, config config.RawTrait, l *zap.Logger, w *writer) (*FanSpeed, error) {
+	cfg, err := readFanSpeedConfig(config.Raw)
+	if err != nil {
+		return nil, err
+	}
+	return &FanSpeed{
+		cfg:    cfg,
+		logger: l,
+		scName: n,
+		value:  resource.NewValue(resource.WithInitialValue(&fanspeedpb.FanSpeed{}), resource.WithNoDuplicates()),
+		writer: w,
+	}, nil
+}
+
+func (f *FanSpeed) GetFanSpeed(_ context.Context, req *fanspeedpb.GetFanSpeedRequest) (*fanspeedpb.FanSpeed, error) {
+	return f.value.Get(resource.WithReadMask(req.GetReadMask())).(*fanspeedpb.FanSpeed), nil
+}
+
+func (f *FanSpeed) PullFanSpeed(req *fanspeedpb.PullFanSpeedRequest, server fanspeedpb.FanSpeedApi_PullFanSpeedServer) error {
+	for value := range f.value.Pull(server.Context(), resource.WithReadMask(req.GetReadMask()), resource.WithUpdatesOnly(req.GetUpdatesOnly())) {
+		err := server.Send(&fanspeedpb.PullFanSpeedResponse{Changes: []*fanspeedpb.PullFanSpeedResponse_Change{
+			{
+				Name:       f.scName,
+				ChangeTime: timestamppb.New(value.ChangeTime),
+				FanSpeed:   value.Value.(*fanspeedpb.FanSpeed),
+			},
+		}})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateFanSpeed writes a new percentage to the device.
+// The percentage can be given directly, or chosen via preset or preset_index.
+// Without an update mask, preset_index is only used if it is non-zero; include it in the mask to select the first preset.
+func (f *FanSpeed) UpdateFanSpeed(ctx context.Context, req *fanspeedpb.UpdateFanSpeedRequest) (*fanspeedpb.FanSpeed, error) {
+	want, mask := req.GetFanSpeed(), req.GetUpdateMask()
+	if want == nil {
+		return nil, status.Error(codes.InvalidArgument, "fan_speed is required")
+	}
+	current := f.value.Get().(*fanspeedpb.FanSpeed)
+
+	var percentage float32
+	switch {
+	case shouldUpdate(mask, "preset", want.Preset != ""):
+		if req.Relative {
+			return nil, status.Error(codes.InvalidArgument, "preset cannot be set relatively, use preset_index")
+		}
+		i := f.presetIndex(want.Preset)
+		if i < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown preset %q", want.Preset)
+		}
+		percentage = f.cfg.Presets[i].Percentage
+	case shouldUpdate(mask, "preset_index", want.PresetIndex != 0):
+		if len(f.cfg.Presets) == 0 {
+			return nil, status.Error(codes.FailedPrecondition, "no presets are configured")
+		}
+		i := int(want.PresetIndex)
+		if req.Relative {
+			i += int(current.PresetIndex)
+		}
+		i = max(0, min(i, len(f.cfg.Presets)-1))
+		percentage = f.cfg.Presets[i].Percentage
+	case shouldUpdate(mask, "percentage", true):
+		percentage = want.Percentage
+		if req.Relative {
+			percentage += current.Percentage
+		}
+	default:
+		return current, nil
+	}
+	percentage = max(0, min(percentage, 100))
+
+	got, err := f.writer.write(ctx, f.cfg.Percentage, f.cfg.Percentage.Unscaled(float64(percentage)))
+	if err != nil {
+		return nil, err
+	}
+	f.setPercentage(got)
+	return f.value.Get().(*fanspeedpb.FanSpeed), nil
+}
+
+func (f *FanSpeed) DescribeFanSpeed(context.Context, *fanspeedpb.DescribeFanSpeedRequest) (*fanspeedpb.FanSpeedSupport, error) {
+	support := &fanspeedpb.FanSpeedSupport{}
+	for _, p := range f.cfg.Presets {
+		support.Presets = append(support.Presets, p.Name)
+	}
+	return support, nil
+}
+
+func (f *FanSpeed) handleEvent(_ context.Context, node *ua.NodeID, value any) {
+	if nodeIdsAreEqual(f.cfg.Percentage.NodeId, node) {
+		f.setPercentage(value)
+	}
+}
+
+func (f *FanSpeed) setPercentage(value any) {
+	v, err := conv.Float64Value(value)
+	if err != nil {
+		f.logger.Warn("error reading float64", zap.String("device", f.scName), zap.String("field", "percentage"), zap.Error(err))
+		return
+	}
+	percentage := float32(f.cfg.Percentage.Scaled(v).(float64))
+	fs := &fanspeedpb.FanSpeed{Percentage: percentage}
+	if i := f.closestPreset(percentage); i >= 0 {
+		fs.Preset = f.cfg.Presets[i].Name
+		fs.PresetIndex = int32(i)
+	}
+	_, _ = f.value.Set(fs, resource.WithUpdatePaths("percentage", "preset", "preset_index"))
+}
+
+func (f *FanSpeed) presetIndex(name string) int {
+	for i, p := range f.cfg.Presets {
+		if p.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// closestPreset returns the index of the preset whose percentage is nearest to percentage, or -1 if there are none.
+func (f *FanSpeed) closestPreset(percentage float32) int {
+	best, bestDiff := -1, math.Inf(1)
+	for i, p := range f.cfg.Presets {
+		if d := math.Abs(float64(p.Percentage - percentage)); d < bestDiff {
+			best, bestDiff = i, d
+		}
+	}
+	return best
+}
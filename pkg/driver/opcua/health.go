@@ -4,7 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/gopcua/opcua/ua"
 	"go.uber.org/zap"
@@ -19,6 +22,7 @@ const (
 	ServerUnreachable = "ServerUnreachable"
 
 	DeviceConfigError = "DeviceConfig"
+	WritePointError   = "WritePoint"
 
 	SystemName = "OPCUA"
 
@@ -72,6 +76,86 @@ func setPointReadNotOk(ctx context.Context, nodeId string, status ua.StatusCode,
 	})
 }
 
+// pointStatusFaults raises a fault on a device health check for each distinct Bad or Uncertain StatusCode
+// reported for its points, removing the fault once no points report that status.
+type pointStatusFaults struct {
+	fc *healthpb.FaultCheck
+
+	mu    sync.Mutex
+	nodes map[string]ua.StatusCode // nodeId -> the status that node is reporting
+}
+
+func newPointStatusFaults(fc *healthpb.FaultCheck) *pointStatusFaults {
+	return &pointStatusFaults{fc: fc, nodes: make(map[string]ua.StatusCode)}
+}
+
+// update records the latest status for the node, raising or clearing faults as needed.
+func (p *pointStatusFaults) update(nodeId string, code ua.StatusCode) {
+	if p == nil {
+		return
+	}
+	if !isFaultStatus(code) {
+		code = ua.StatusOK
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	old := p.nodes[nodeId]
+	if old == code {
+		return
+	}
+	if code == ua.StatusOK {
+		delete(p.nodes, nodeId)
+	} else {
+		p.nodes[nodeId] = code
+	}
+
+	if old != ua.StatusOK {
+		var nodes []string
+		for n, c := range p.nodes {
+			if c == old {
+				nodes = append(nodes, n)
+			}
+		}
+		if len(nodes) == 0 {
+			p.fc.RemoveFault(&healthpb.HealthCheck_Error{Code: statusToHealthCode(statusName(old))})
+		} else {
+			p.fc.AddOrUpdateFault(pointStatusFault(old, nodes))
+		}
+	}
+	if code != ua.StatusOK {
+		var nodes []string
+		for n, c := range p.nodes {
+			if c == code {
+				nodes = append(nodes, n)
+			}
+		}
+		p.fc.AddOrUpdateFault(pointStatusFault(code, nodes))
+	}
+}
+
+func pointStatusFault(code ua.StatusCode, nodes []string) *healthpb.HealthCheck_Error {
+	slices.Sort(nodes)
+	return &healthpb.HealthCheck_Error{
+		SummaryText: fmt.Sprintf("Device points report status %s", statusName(code)),
+		DetailsText: fmt.Sprintf("NodeIDs: %s, Status: %s", strings.Join(nodes, ", "), code.Error()),
+		Code:        statusToHealthCode(statusName(code)),
+	}
+}
+
+// isFaultStatus returns true if code has Bad or Uncertain severity.
+func isFaultStatus(code ua.StatusCode) bool {
+	const severityMask = 0xC0000000
+	return uint32(code)&severityMask != 0
+}
+
+// statusName returns the symbolic name of code, like "BadSensorFailure".
+func statusName(code ua.StatusCode) string {
+	if d, ok := ua.StatusCodes[code]; ok {
+		return strings.TrimPrefix(d.Name, "Status") // the name used by the OPC UA spec, like BadSensorFailure
+	}
+	return fmt.Sprintf("0x%X", uint32(code))
+}
+
 type Health struct {
 	cfg    config.HealthConfig
 	logger *zap.Logger
@@ -0,0 +1,141 @@
+package opcua
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+
+	"github.com/gopcua/opcua/ua"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/conv"
+	"github.com/smart-core-os/sc-bos/pkg/proto/modepb"
+	"github.com/smart-core-os/sc-bos/pkg/resource"
+)
+
+// Mode implements the Smart Core Mode trait for OPC UA devices.
+// Each mode is backed by a single node, whose values are mapped to mode value names using the configured enum.
+type Mode struct {
+	modepb.UnimplementedModeApiServer
+	modepb.UnimplementedModeInfoServer
+
+	cfg    config.ModeConfig
+	logger *zap.Logger
+	scName string
+	value  *resource.Value // *modepb.ModeValues
+	writer *writer
+}
+
+func readModeConfig(raw []byte) (cfg config.ModeConfig, err error) {
+	err = json.Unmarshal(raw, &cfg)
+	return
+}
+
+func newMode(n string, config config.RawTrait, l *zap.Logger, w *writer) (*Mode, error) {
+	cfg, err := readModeConfig(config.Raw)
+	if err != nil {
+		return nil, err
+	}
+	return &Mode{
+		cfg:    cfg,
+		logger: l,
+		scName: n,
+		value:  resource.NewValue(resource.WithInitialValue(&modepb.ModeValues{}), resource.WithNoDuplicates()),
+		writer: w,
+	}, nil
+}
+
+func (m *Mode) GetModeValues(_ context.Context, req *modepb.GetModeValuesRequest) (*modepb.ModeValues, error) {
+	return m.value.Get(resource.WithReadMask(req.GetReadMask())).(*modepb.ModeValues), nil
+}
+
+func (m *Mode) PullModeValues(req *modepb.PullModeValuesRequest, server modepb.ModeApi_PullModeValuesServer) error {
+	for value := range m.value.Pull(server.Context(), resource.WithReadMask(req.GetReadMask()), resource.WithUpdatesOnly(req.GetUpdatesOnly())) {
+		err := server.Send(&modepb.PullModeValuesResponse{Changes: []*modepb.PullModeValuesResponse_Change{
+			{
+				Name:       m.scName,
+				ChangeTime: timestamppb.New(value.ChangeTime),
+				ModeValues: value.Value.(*modepb.ModeValues),
+			},
+		}})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateModeValues writes each requested mode value to the device, in mode name order.
+// Relative updates are not supported as the enum values have no defined order.
+func (m *Mode) UpdateModeValues(ctx context.Context, req *modepb.UpdateModeValuesRequest) (*modepb.ModeValues, error) {
+	if len(req.GetRelative().GetValues()) > 0 {
+		return nil, status.Error(codes.Unimplemented, "relative mode updates are not supported")
+	}
+	values := req.GetModeValues().GetValues()
+	// validate everything before writing anything
+	raws := make(map[string]int, len(values))
+	for name, val := range values {
+		src, ok := m.cfg.Modes[name]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown mode %q", name)
+		}
+		raw, ok := src.GetIntKeyFromValue(val)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "mode %q does not support value %q", name, val)
+		}
+		raws[name] = raw
+	}
+	for _, name := range slices.Sorted(maps.Keys(raws)) {
+		got, err := m.writer.write(ctx, m.cfg.Modes[name], raws[name])
+		if err != nil {
+			return nil, err
+		}
+		m.setMode(name, got)
+	}
+	return m.value.Get().(*modepb.ModeValues), nil
+}
+
+func (m *Mode) DescribeModes(context.Context, *modepb.DescribeModesRequest) (*modepb.ModesSupport, error) {
+	modes := &modepb.Modes{}
+	for _, name := range slices.Sorted(maps.Keys(m.cfg.Modes)) {
+		src := m.cfg.Modes[name]
+		mode := &modepb.Modes_Mode{Name: name}
+		for _, v := range slices.Sorted(maps.Values(src.Enum)) {
+			mode.Values = append(mode.Values, &modepb.Modes_Value{Name: v})
+		}
+		mode.Values = slices.CompactFunc(mode.Values, func(a, b *modepb.Modes_Value) bool { return a.Name == b.Name })
+		modes.Modes = append(modes.Modes, mode)
+	}
+	return &modepb.ModesSupport{AvailableModes: modes}, nil
+}
+
+func (m *Mode) handleEvent(_ context.Context, node *ua.NodeID, value any) {
+	for name, src := range m.cfg.Modes {
+		if nodeIdsAreEqual(src.NodeId, node) {
+			m.setMode(name, value)
+		}
+	}
+}
+
+func (m *Mode) setMode(name string, value any) {
+	val, err := conv.ToString(m.cfg.Modes[name].GetValueFromIntKey(value))
+	if err != nil {
+		m.logger.Warn("failed to convert mode value", zap.String("device", m.scName), zap.String("mode", name), zap.Error(err))
+		return
+	}
+	_, _ = m.value.Set(&modepb.ModeValues{Values: map[string]string{name: val}}, resource.InterceptBefore(func(old, new proto.Message) {
+		// keep the values of the other modes
+		change := new.(*modepb.ModeValues)
+		for k, v := range old.(*modepb.ModeValues).GetValues() {
+			if _, ok := change.Values[k]; !ok {
+				change.Values[k] = v
+			}
+		}
+	}))
+}
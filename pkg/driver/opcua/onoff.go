@@ -0,0 +1,117 @@
+package opcua
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gopcua/opcua/ua"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/conv"
+	"github.com/smart-core-os/sc-bos/pkg/proto/onoffpb"
+	"github.com/smart-core-os/sc-bos/pkg/resource"
+)
+
+// OnOff implements the Smart Core OnOff trait for OPC UA devices.
+// The state is written back to the device when updated.
+type OnOff struct {
+	onoffpb.UnimplementedOnOffApiServer
+
+	cfg    config.OnOffConfig
+	logger *zap.Logger
+	scName string
+	value  *resource.Value // *onoffpb.OnOff
+	writer *writer
+}
+
+func readOnOffConfig(raw []byte) (cfg config.OnOffConfig, err error) {
+	err = json.Unmarshal(raw, &cfg)
+	return
+}
+
+func newOnOff(n string, config config.RawTrait, l *zap.Logger, w *writer) (*OnOff, error) {
+	cfg, err := readOnOffConfig(config.Raw)
+	if err != nil {
+		return nil, err
+	}
+	return &OnOff{
+		cfg:    cfg,
+		logger: l,
+		scName: n,
+		value:  resource.NewValue(resource.WithInitialValue(&onoffpb.OnOff{}), resource.WithNoDuplicates()),
+		writer: w,
+	}, nil
+}
+
+func (o *OnOff) GetOnOff(_ context.Context, req *onoffpb.GetOnOffRequest) (*onoffpb.OnOff, error) {
+	return o.value.Get(resource.WithReadMask(req.GetReadMask())).(*onoffpb.OnOff), nil
+}
+
+func (o *OnOff) PullOnOff(req *onoffpb.PullOnOffRequest, server onoffpb.OnOffApi_PullOnOffServer) error {
+	for value := range o.value.Pull(server.Context(), resource.WithReadMask(req.GetReadMask()), resource.WithUpdatesOnly(req.GetUpdatesOnly())) {
+		err := server.Send(&onoffpb.PullOnOffResponse{Changes: []*onoffpb.PullOnOffResponse_Change{
+			{
+				Name:       o.scName,
+				ChangeTime: timestamppb.New(value.ChangeTime),
+				OnOff:      value.Value.(*onoffpb.OnOff),
+			},
+		}})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *OnOff) UpdateOnOff(ctx context.Context, req *onoffpb.UpdateOnOffRequest) (*onoffpb.OnOff, error) {
+	state := req.GetOnOff().GetState()
+	if state == onoffpb.OnOff_STATE_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "state is required")
+	}
+	var raw any = state == onoffpb.OnOff_ON
+	if len(o.cfg.State.Enum) > 0 {
+		i, ok := o.cfg.State.GetIntKeyFromValue(state.String())
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "state %s is not supported", state)
+		}
+		raw = i
+	}
+	got, err := o.writer.write(ctx, o.cfg.State, raw)
+	if err != nil {
+		return nil, err
+	}
+	o.handleEvent(ctx, nil, got)
+	return o.value.Get().(*onoffpb.OnOff), nil
+}
+
+// handleEvent updates the state from the value of the state node.
+// A nil node is used internally to update from a value known to be for the state node.
+func (o *OnOff) handleEvent(_ context.Context, node *ua.NodeID, value any) {
+	if node != nil && !nodeIdsAreEqual(o.cfg.State.NodeId, node) {
+		return
+	}
+	var state onoffpb.OnOff_State
+	if len(o.cfg.State.Enum) > 0 {
+		s, err := conv.ToTraitEnum[onoffpb.OnOff_State](value, o.cfg.State.Enum, onoffpb.OnOff_State_value)
+		if err != nil {
+			o.logger.Warn("failed to convert state", zap.String("device", o.scName), zap.Error(err))
+			return
+		}
+		state = s
+	} else {
+		on, err := conv.ToBool(value)
+		if err != nil {
+			o.logger.Warn("failed to convert state", zap.String("device", o.scName), zap.Error(err))
+			return
+		}
+		state = onoffpb.OnOff_OFF
+		if on {
+			state = onoffpb.OnOff_ON
+		}
+	}
+	_, _ = o.value.Set(&onoffpb.OnOff{State: state})
+}
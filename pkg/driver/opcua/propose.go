@@ -0,0 +1,166 @@
+package opcua
+
+import (
+	"encoding/json"
+	"path"
+	"strings"
+	"unicode"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/rpc"
+	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/meterpb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+)
+
+// ProposeDevices returns a device config for each Object in the tree that has Variables as direct children.
+// Devices are named using prefix and the browse names of the Objects between root and the device.
+// Traits are proposed for Variables whose engineering units, data type, and name suggest what they are,
+// all Variables are added to the device so they can be mapped to traits by hand.
+func ProposeDevices(prefix string, root *rpc.Node) []config.Device {
+	var devices []config.Device
+	var visit func(n *rpc.Node, names []string)
+	visit = func(n *rpc.Node, names []string) {
+		var vars []*rpc.Node
+		for _, c := range n.Children {
+			if c.NodeClass == rpc.Node_VARIABLE {
+				vars = append(vars, c)
+			}
+		}
+		if len(vars) > 0 {
+			devices = append(devices, proposeDevice(path.Join(append([]string{prefix}, names...)...), n, vars))
+		}
+		for _, c := range n.Children {
+			if c.NodeClass == rpc.Node_OBJECT {
+				visit(c, append(names[:len(names):len(names)], deviceNameSegment(c)))
+			}
+		}
+	}
+	visit(root, nil)
+	return devices
+}
+
+func proposeDevice(name string, obj *rpc.Node, vars []*rpc.Node) config.Device {
+	dev := config.Device{Name: name}
+	if title := obj.DisplayName; title != "" {
+		dev.Meta = &metadatapb.Metadata{Appearance: &metadatapb.Metadata_Appearance{Title: title, Description: obj.Description}}
+	}
+	for _, v := range vars {
+		dev.Variables = append(dev.Variables, &config.Variable{NodeId: v.NodeId})
+	}
+
+	var (
+		meter   *config.MeterConfig
+		airTemp *config.AirTemperatureConfig
+		onOff   *config.OnOffConfig
+	)
+	for _, v := range vars {
+		src := &config.ValueSource{NodeId: v.NodeId, Name: v.DisplayName}
+		switch {
+		case meter == nil && isEnergyUnit(v.EngineeringUnits):
+			meter = &config.MeterConfig{Unit: v.EngineeringUnits, Usage: src}
+		case isTemperatureUnit(v.EngineeringUnits):
+			if airTemp == nil {
+				airTemp = &config.AirTemperatureConfig{}
+			}
+			switch {
+			case nameContains(v, "setpoint", "set point", "sp"):
+				if airTemp.TemperatureSetPoint == nil && v.Writable {
+					src.DataType = v.DataType
+					airTemp.TemperatureSetPoint = src
+				}
+			case airTemp.AmbientTemperature == nil:
+				airTemp.AmbientTemperature = src
+			}
+		case isHumidityUnit(v.EngineeringUnits):
+			if airTemp == nil {
+				airTemp = &config.AirTemperatureConfig{}
+			}
+			if airTemp.AmbientHumidity == nil {
+				airTemp.AmbientHumidity = src
+			}
+		case onOff == nil && v.DataType == "Boolean" && v.Writable && nameContains(v, "onoff", "on/off", "run", "enable", "start", "power"):
+			src.DataType = v.DataType
+			onOff = &config.OnOffConfig{State: src}
+		}
+	}
+
+	if meter != nil {
+		meter.Trait = config.Trait{Name: string(meterpb.TraitName), Kind: meterpb.TraitName}
+		dev.Traits = append(dev.Traits, rawTrait(meter.Trait, meter))
+	}
+	if airTemp != nil && (airTemp.AmbientTemperature != nil || airTemp.AmbientHumidity != nil || airTemp.TemperatureSetPoint != nil) {
+		airTemp.Trait = config.Trait{Name: string(trait.AirTemperature), Kind: trait.AirTemperature}
+		dev.Traits = append(dev.Traits, rawTrait(airTemp.Trait, airTemp))
+	}
+	if onOff != nil {
+		onOff.Trait = config.Trait{Name: string(trait.OnOff), Kind: trait.OnOff}
+		dev.Traits = append(dev.Traits, rawTrait(onOff.Trait, onOff))
+	}
+	return dev
+}
+
+func rawTrait(t config.Trait, cfg any) config.RawTrait {
+	raw, err := json.Marshal(cfg)
+	if err != nil {
+		panic(err) // the config types always marshal
+	}
+	return config.RawTrait{Trait: t, Raw: raw}
+}
+
+// deviceNameSegment returns the browse name of n, made safe for use in a device name.
+func deviceNameSegment(n *rpc.Node) string {
+	name := n.BrowseName
+	if name == "" {
+		name = n.NodeId
+	}
+	return strings.Map(func(r rune) rune {
+		if r == '/' || unicode.IsSpace(r) {
+			return '-'
+		}
+		return r
+	}, name)
+}
+
+func nameContains(v *rpc.Node, words ...string) bool {
+	name := strings.ToLower(v.BrowseName + " " + v.DisplayName)
+	for _, w := range words {
+		if len(w) <= 2 {
+			// short words must be whole words, like "SP" in "Zone_SP"
+			for _, f := range strings.FieldsFunc(name, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }) {
+				if f == w {
+					return true
+				}
+			}
+			continue
+		}
+		if strings.Contains(name, w) {
+			return true
+		}
+	}
+	return false
+}
+
+func isEnergyUnit(u string) bool {
+	switch strings.ToLower(u) {
+	case "wh", "kwh", "mwh", "kw·h", "kw h":
+		return true
+	}
+	return false
+}
+
+func isTemperatureUnit(u string) bool {
+	switch strings.ToLower(u) {
+	case "°c", "℃", "degc", "deg c", "celsius":
+		return true
+	}
+	return false
+}
+
+func isHumidityUnit(u string) bool {
+	switch strings.ToLower(u) {
+	case "%rh", "% rh", "rh%":
+		return true
+	}
+	return false
+}
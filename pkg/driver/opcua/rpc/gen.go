@@ -0,0 +1,3 @@
+package rpc
+
+//go:generate bash gen.sh
@@ -0,0 +1,572 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.35.1
+// source: pkg/driver/opcua/rpc/opcua.proto
+
+package rpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Node_NodeClass int32
+
+const (
+	Node_NODE_CLASS_UNSPECIFIED Node_NodeClass = 0
+	Node_OBJECT                 Node_NodeClass = 1
+	Node_VARIABLE               Node_NodeClass = 2
+	Node_METHOD                 Node_NodeClass = 3
+)
+
+// Enum value maps for Node_NodeClass.
+var (
+	Node_NodeClass_name = map[int32]string{
+		0: "NODE_CLASS_UNSPECIFIED",
+		1: "OBJECT",
+		2: "VARIABLE",
+		3: "METHOD",
+	}
+	Node_NodeClass_value = map[string]int32{
+		"NODE_CLASS_UNSPECIFIED": 0,
+		"OBJECT":                 1,
+		"VARIABLE":               2,
+		"METHOD":                 3,
+	}
+)
+
+func (x Node_NodeClass) Enum() *Node_NodeClass {
+	p := new(Node_NodeClass)
+	*p = x
+	return p
+}
+
+func (x Node_NodeClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Node_NodeClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_driver_opcua_rpc_opcua_proto_enumTypes[0].Descriptor()
+}
+
+func (Node_NodeClass) Type() protoreflect.EnumType {
+	return &file_pkg_driver_opcua_rpc_opcua_proto_enumTypes[0]
+}
+
+func (x Node_NodeClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Node_NodeClass.Descriptor instead.
+func (Node_NodeClass) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_driver_opcua_rpc_opcua_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// A node in the address space of the OPC UA server.
+type Node struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The NodeId, in the form used by driver config, like "ns=2;s=Tag1".
+	NodeId      string         `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	BrowseName  string         `protobuf:"bytes,2,opt,name=browse_name,json=browseName,proto3" json:"browse_name,omitempty"`
+	DisplayName string         `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Description string         `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	NodeClass   Node_NodeClass `protobuf:"varint,5,opt,name=node_class,json=nodeClass,proto3,enum=smartcore.bos.driver.opcua.v1.Node_NodeClass" json:"node_class,omitempty"`
+	// The built-in type of a Variables value, like "Double", or the NodeId of the data type for other types.
+	DataType string `protobuf:"bytes,6,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	// The display name of the EngineeringUnits property of a Variable, like "kWh", if it has one.
+	EngineeringUnits string `protobuf:"bytes,7,opt,name=engineering_units,json=engineeringUnits,proto3" json:"engineering_units,omitempty"`
+	// Whether the Variable can be written to by the driver.
+	Writable bool `protobuf:"varint,8,opt,name=writable,proto3" json:"writable,omitempty"`
+	// Children of Objects, in the order the server returned them.
+	// Properties of Variables are not included.
+	Children      []*Node `protobuf:"bytes,9,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Node) Reset() {
+	*x = Node{}
+	mi := &file_pkg_driver_opcua_rpc_opcua_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node) ProtoMessage() {}
+
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_driver_opcua_rpc_opcua_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_pkg_driver_opcua_rpc_opcua_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Node) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *Node) GetBrowseName() string {
+	if x != nil {
+		return x.BrowseName
+	}
+	return ""
+}
+
+func (x *Node) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Node) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Node) GetNodeClass() Node_NodeClass {
+	if x != nil {
+		return x.NodeClass
+	}
+	return Node_NODE_CLASS_UNSPECIFIED
+}
+
+func (x *Node) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *Node) GetEngineeringUnits() string {
+	if x != nil {
+		return x.EngineeringUnits
+	}
+	return ""
+}
+
+func (x *Node) GetWritable() bool {
+	if x != nil {
+		return x.Writable
+	}
+	return false
+}
+
+func (x *Node) GetChildren() []*Node {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type BrowseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the driver.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Where to start browsing, defaults to the Objects folder "i=85".
+	RootNodeId string `protobuf:"bytes,2,opt,name=root_node_id,json=rootNodeId,proto3" json:"root_node_id,omitempty"`
+	// How many levels below the root to browse, defaults to 10.
+	MaxDepth int32 `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	// Only nodes in these namespace indexes are returned or browsed into, the root is always returned.
+	// Empty means all namespaces.
+	Namespaces    []uint32 `protobuf:"varint,4,rep,packed,name=namespaces,proto3" json:"namespaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrowseRequest) Reset() {
+	*x = BrowseRequest{}
+	mi := &file_pkg_driver_opcua_rpc_opcua_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrowseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrowseRequest) ProtoMessage() {}
+
+func (x *BrowseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_driver_opcua_rpc_opcua_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrowseRequest.ProtoReflect.Descriptor instead.
+func (*BrowseRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_driver_opcua_rpc_opcua_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BrowseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BrowseRequest) GetRootNodeId() string {
+	if x != nil {
+		return x.RootNodeId
+	}
+	return ""
+}
+
+func (x *BrowseRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *BrowseRequest) GetNamespaces() []uint32 {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+type BrowseResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Root  *Node                  `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	// True if browsing stopped early because the address space is too large.
+	Truncated     bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BrowseResponse) Reset() {
+	*x = BrowseResponse{}
+	mi := &file_pkg_driver_opcua_rpc_opcua_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BrowseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BrowseResponse) ProtoMessage() {}
+
+func (x *BrowseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_driver_opcua_rpc_opcua_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BrowseResponse.ProtoReflect.Descriptor instead.
+func (*BrowseResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_driver_opcua_rpc_opcua_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BrowseResponse) GetRoot() *Node {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *BrowseResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type ProposeConfigRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the driver.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// See BrowseRequest.
+	RootNodeId string   `protobuf:"bytes,2,opt,name=root_node_id,json=rootNodeId,proto3" json:"root_node_id,omitempty"`
+	MaxDepth   int32    `protobuf:"varint,3,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	Namespaces []uint32 `protobuf:"varint,4,rep,packed,name=namespaces,proto3" json:"namespaces,omitempty"`
+	// Prefix for proposed device names, defaults to the name of the driver.
+	DeviceNamePrefix string `protobuf:"bytes,5,opt,name=device_name_prefix,json=deviceNamePrefix,proto3" json:"device_name_prefix,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ProposeConfigRequest) Reset() {
+	*x = ProposeConfigRequest{}
+	mi := &file_pkg_driver_opcua_rpc_opcua_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposeConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeConfigRequest) ProtoMessage() {}
+
+func (x *ProposeConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_driver_opcua_rpc_opcua_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeConfigRequest.ProtoReflect.Descriptor instead.
+func (*ProposeConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_driver_opcua_rpc_opcua_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProposeConfigRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProposeConfigRequest) GetRootNodeId() string {
+	if x != nil {
+		return x.RootNodeId
+	}
+	return ""
+}
+
+func (x *ProposeConfigRequest) GetMaxDepth() int32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *ProposeConfigRequest) GetNamespaces() []uint32 {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *ProposeConfigRequest) GetDeviceNamePrefix() string {
+	if x != nil {
+		return x.DeviceNamePrefix
+	}
+	return ""
+}
+
+type ProposeConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Driver config encoded as JSON.
+	// Contains the current name, type, and connection config of the driver, and the proposed devices.
+	Config string `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	// The number of devices in config.
+	DeviceCount int32 `protobuf:"varint,2,opt,name=device_count,json=deviceCount,proto3" json:"device_count,omitempty"`
+	// True if browsing stopped early because the address space is too large, some devices may be missing.
+	Truncated     bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposeConfigResponse) Reset() {
+	*x = ProposeConfigResponse{}
+	mi := &file_pkg_driver_opcua_rpc_opcua_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposeConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeConfigResponse) ProtoMessage() {}
+
+func (x *ProposeConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_driver_opcua_rpc_opcua_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeConfigResponse.ProtoReflect.Descriptor instead.
+func (*ProposeConfigResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_driver_opcua_rpc_opcua_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProposeConfigResponse) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+func (x *ProposeConfigResponse) GetDeviceCount() int32 {
+	if x != nil {
+		return x.DeviceCount
+	}
+	return 0
+}
+
+func (x *ProposeConfigResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+var File_pkg_driver_opcua_rpc_opcua_proto protoreflect.FileDescriptor
+
+const file_pkg_driver_opcua_rpc_opcua_proto_rawDesc = "" +
+	"\n" +
+	" pkg/driver/opcua/rpc/opcua.proto\x12\x1dsmartcore.bos.driver.opcua.v1\"\xc9\x03\n" +
+	"\x04Node\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x1f\n" +
+	"\vbrowse_name\x18\x02 \x01(\tR\n" +
+	"browseName\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12L\n" +
+	"\n" +
+	"node_class\x18\x05 \x01(\x0e2-.smartcore.bos.driver.opcua.v1.Node.NodeClassR\tnodeClass\x12\x1b\n" +
+	"\tdata_type\x18\x06 \x01(\tR\bdataType\x12+\n" +
+	"\x11engineering_units\x18\a \x01(\tR\x10engineeringUnits\x12\x1a\n" +
+	"\bwritable\x18\b \x01(\bR\bwritable\x12?\n" +
+	"\bchildren\x18\t \x03(\v2#.smartcore.bos.driver.opcua.v1.NodeR\bchildren\"M\n" +
+	"\tNodeClass\x12\x1a\n" +
+	"\x16NODE_CLASS_UNSPECIFIED\x10\x00\x12\n" +
+	"\n" +
+	"\x06OBJECT\x10\x01\x12\f\n" +
+	"\bVARIABLE\x10\x02\x12\n" +
+	"\n" +
+	"\x06METHOD\x10\x03\"\x82\x01\n" +
+	"\rBrowseRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\froot_node_id\x18\x02 \x01(\tR\n" +
+	"rootNodeId\x12\x1b\n" +
+	"\tmax_depth\x18\x03 \x01(\x05R\bmaxDepth\x12\x1e\n" +
+	"\n" +
+	"namespaces\x18\x04 \x03(\rR\n" +
+	"namespaces\"g\n" +
+	"\x0eBrowseResponse\x127\n" +
+	"\x04root\x18\x01 \x01(\v2#.smartcore.bos.driver.opcua.v1.NodeR\x04root\x12\x1c\n" +
+	"\ttruncated\x18\x02 \x01(\bR\ttruncated\"\xb7\x01\n" +
+	"\x14ProposeConfigRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\froot_node_id\x18\x02 \x01(\tR\n" +
+	"rootNodeId\x12\x1b\n" +
+	"\tmax_depth\x18\x03 \x01(\x05R\bmaxDepth\x12\x1e\n" +
+	"\n" +
+	"namespaces\x18\x04 \x03(\rR\n" +
+	"namespaces\x12,\n" +
+	"\x12device_name_prefix\x18\x05 \x01(\tR\x10deviceNamePrefix\"p\n" +
+	"\x15ProposeConfigResponse\x12\x16\n" +
+	"\x06config\x18\x01 \x01(\tR\x06config\x12!\n" +
+	"\fdevice_count\x18\x02 \x01(\x05R\vdeviceCount\x12\x1c\n" +
+	"\ttruncated\x18\x03 \x01(\bR\ttruncated2\xf7\x01\n" +
+	"\x12OpcuaDriverService\x12e\n" +
+	"\x06Browse\x12,.smartcore.bos.driver.opcua.v1.BrowseRequest\x1a-.smartcore.bos.driver.opcua.v1.BrowseResponse\x12z\n" +
+	"\rProposeConfig\x123.smartcore.bos.driver.opcua.v1.ProposeConfigRequest\x1a4.smartcore.bos.driver.opcua.v1.ProposeConfigResponseB6Z4github.com/smart-core-os/sc-bos/pkg/driver/opcua/rpcb\x06proto3"
+
+var (
+	file_pkg_driver_opcua_rpc_opcua_proto_rawDescOnce sync.Once
+	file_pkg_driver_opcua_rpc_opcua_proto_rawDescData []byte
+)
+
+func file_pkg_driver_opcua_rpc_opcua_proto_rawDescGZIP() []byte {
+	file_pkg_driver_opcua_rpc_opcua_proto_rawDescOnce.Do(func() {
+		file_pkg_driver_opcua_rpc_opcua_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pkg_driver_opcua_rpc_opcua_proto_rawDesc), len(file_pkg_driver_opcua_rpc_opcua_proto_rawDesc)))
+	})
+	return file_pkg_driver_opcua_rpc_opcua_proto_rawDescData
+}
+
+var file_pkg_driver_opcua_rpc_opcua_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pkg_driver_opcua_rpc_opcua_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pkg_driver_opcua_rpc_opcua_proto_goTypes = []any{
+	(Node_NodeClass)(0),           // 0: smartcore.bos.driver.opcua.v1.Node.NodeClass
+	(*Node)(nil),                  // 1: smartcore.bos.driver.opcua.v1.Node
+	(*BrowseRequest)(nil),         // 2: smartcore.bos.driver.opcua.v1.BrowseRequest
+	(*BrowseResponse)(nil),        // 3: smartcore.bos.driver.opcua.v1.BrowseResponse
+	(*ProposeConfigRequest)(nil),  // 4: smartcore.bos.driver.opcua.v1.ProposeConfigRequest
+	(*ProposeConfigResponse)(nil), // 5: smartcore.bos.driver.opcua.v1.ProposeConfigResponse
+}
+var file_pkg_driver_opcua_rpc_opcua_proto_depIdxs = []int32{
+	0, // 0: smartcore.bos.driver.opcua.v1.Node.node_class:type_name -> smartcore.bos.driver.opcua.v1.Node.NodeClass
+	1, // 1: smartcore.bos.driver.opcua.v1.Node.children:type_name -> smartcore.bos.driver.opcua.v1.Node
+	1, // 2: smartcore.bos.driver.opcua.v1.BrowseResponse.root:type_name -> smartcore.bos.driver.opcua.v1.Node
+	2, // 3: smartcore.bos.driver.opcua.v1.OpcuaDriverService.Browse:input_type -> smartcore.bos.driver.opcua.v1.BrowseRequest
+	4, // 4: smartcore.bos.driver.opcua.v1.OpcuaDriverService.ProposeConfig:input_type -> smartcore.bos.driver.opcua.v1.ProposeConfigRequest
+	3, // 5: smartcore.bos.driver.opcua.v1.OpcuaDriverService.Browse:output_type -> smartcore.bos.driver.opcua.v1.BrowseResponse
+	5, // 6: smartcore.bos.driver.opcua.v1.OpcuaDriverService.ProposeConfig:output_type -> smartcore.bos.driver.opcua.v1.ProposeConfigResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_pkg_driver_opcua_rpc_opcua_proto_init() }
+func file_pkg_driver_opcua_rpc_opcua_proto_init() {
+	if File_pkg_driver_opcua_rpc_opcua_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_driver_opcua_rpc_opcua_proto_rawDesc), len(file_pkg_driver_opcua_rpc_opcua_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_driver_opcua_rpc_opcua_proto_goTypes,
+		DependencyIndexes: file_pkg_driver_opcua_rpc_opcua_proto_depIdxs,
+		EnumInfos:         file_pkg_driver_opcua_rpc_opcua_proto_enumTypes,
+		MessageInfos:      file_pkg_driver_opcua_rpc_opcua_proto_msgTypes,
+	}.Build()
+	File_pkg_driver_opcua_rpc_opcua_proto = out.File
+	file_pkg_driver_opcua_rpc_opcua_proto_goTypes = nil
+	file_pkg_driver_opcua_rpc_opcua_proto_depIdxs = nil
+}
@@ -0,0 +1,100 @@
+// Code generated by protoc-gen-router. DO NOT EDIT.
+//lint:file-ignore SA1019 Routers intentionally use a deprecated packaged - they are deprecated themselves
+
+package rpc
+
+import (
+	context "context"
+	fmt "fmt"
+	router "github.com/smart-core-os/sc-bos/pkg/router"
+	grpc "google.golang.org/grpc"
+)
+
+// OpcuaDriverServiceRouter is a OpcuaDriverServiceServer that allows routing named requests to specific OpcuaDriverServiceClient
+// Deprecated: routing is now handled dynamically by [node.Node].
+type OpcuaDriverServiceRouter struct {
+	UnimplementedOpcuaDriverServiceServer
+
+	router.Router
+}
+
+// compile time check that we implement the interface we need
+var _ OpcuaDriverServiceServer = (*OpcuaDriverServiceRouter)(nil)
+
+// NewOpcuaDriverServiceRouter constructs a new empty OpcuaDriverServiceRouter with the provided options.
+// Deprecated: routing is now handled dynamically by [node.Node].
+func NewOpcuaDriverServiceRouter(opts ...router.Option) *OpcuaDriverServiceRouter {
+	return &OpcuaDriverServiceRouter{
+		Router: router.NewRouter(opts...),
+	}
+}
+
+// WithOpcuaDriverServiceClientFactory instructs the router to create a new
+// client the first time Get is called for that name.
+func WithOpcuaDriverServiceClientFactory(f func(name string) (OpcuaDriverServiceClient, error)) router.Option {
+	return router.WithFactory(func(name string) (any, error) {
+		return f(name)
+	})
+}
+
+func (r *OpcuaDriverServiceRouter) Register(server grpc.ServiceRegistrar) {
+	RegisterOpcuaDriverServiceServer(server, r)
+}
+
+// Add extends Router.Add to panic if client is not of type OpcuaDriverServiceClient.
+func (r *OpcuaDriverServiceRouter) Add(name string, client any) any {
+	if !r.HoldsType(client) {
+		panic(fmt.Sprintf("not correct type: client of type %T is not a OpcuaDriverServiceClient", client))
+	}
+	return r.Router.Add(name, client)
+}
+
+func (r *OpcuaDriverServiceRouter) HoldsType(client any) bool {
+	_, ok := client.(OpcuaDriverServiceClient)
+	return ok
+}
+
+func (r *OpcuaDriverServiceRouter) AddOpcuaDriverServiceClient(name string, client OpcuaDriverServiceClient) OpcuaDriverServiceClient {
+	res := r.Add(name, client)
+	if res == nil {
+		return nil
+	}
+	return res.(OpcuaDriverServiceClient)
+}
+
+func (r *OpcuaDriverServiceRouter) RemoveOpcuaDriverServiceClient(name string) OpcuaDriverServiceClient {
+	res := r.Remove(name)
+	if res == nil {
+		return nil
+	}
+	return res.(OpcuaDriverServiceClient)
+}
+
+func (r *OpcuaDriverServiceRouter) GetOpcuaDriverServiceClient(name string) (OpcuaDriverServiceClient, error) {
+	res, err := r.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(OpcuaDriverServiceClient), nil
+}
+
+func (r *OpcuaDriverServiceRouter) Browse(ctx context.Context, request *BrowseRequest) (*BrowseResponse, error) {
+	child, err := r.GetOpcuaDriverServiceClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.Browse(ctx, request)
+}
+
+func (r *OpcuaDriverServiceRouter) ProposeConfig(ctx context.Context, request *ProposeConfigRequest) (*ProposeConfigResponse, error) {
+	child, err := r.GetOpcuaDriverServiceClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.ProposeConfig(ctx, request)
+}
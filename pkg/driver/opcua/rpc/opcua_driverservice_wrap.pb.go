@@ -0,0 +1,43 @@
+// Code generated by protoc-gen-wrapper. DO NOT EDIT.
+
+package rpc
+
+import (
+	wrap "github.com/smart-core-os/sc-bos/pkg/wrap"
+	grpc "google.golang.org/grpc"
+)
+
+// WrapOpcuaDriverService	adapts a OpcuaDriverServiceServer	and presents it as a OpcuaDriverServiceClient
+// Deprecated: for client use, use [wrap.ServerToClient]; for server registration, use [github.com/smart-core-os/sc-bos/pkg/node.HasServer].
+func WrapOpcuaDriverService(server OpcuaDriverServiceServer) *OpcuaDriverServiceWrapper {
+	conn := wrap.ServerToClient(OpcuaDriverService_ServiceDesc, server)
+	client := NewOpcuaDriverServiceClient(conn)
+	return &OpcuaDriverServiceWrapper{
+		OpcuaDriverServiceClient: client,
+		server:                   server,
+		conn:                     conn,
+		desc:                     OpcuaDriverService_ServiceDesc,
+	}
+}
+
+type OpcuaDriverServiceWrapper struct {
+	OpcuaDriverServiceClient
+
+	server OpcuaDriverServiceServer
+	conn   grpc.ClientConnInterface
+	desc   grpc.ServiceDesc
+}
+
+// UnwrapServer returns the underlying server instance.
+func (w *OpcuaDriverServiceWrapper) UnwrapServer() OpcuaDriverServiceServer {
+	return w.server
+}
+
+// Unwrap implements wrap.Unwrapper and returns the underlying server instance as an unknown type.
+func (w *OpcuaDriverServiceWrapper) Unwrap() any {
+	return w.UnwrapServer()
+}
+
+func (w *OpcuaDriverServiceWrapper) UnwrapService() (grpc.ClientConnInterface, grpc.ServiceDesc) {
+	return w.conn, w.desc
+}
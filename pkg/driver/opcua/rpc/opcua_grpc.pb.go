@@ -0,0 +1,171 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v7.35.1
+// source: pkg/driver/opcua/rpc/opcua.proto
+
+package rpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	OpcuaDriverService_Browse_FullMethodName        = "/smartcore.bos.driver.opcua.v1.OpcuaDriverService/Browse"
+	OpcuaDriverService_ProposeConfig_FullMethodName = "/smartcore.bos.driver.opcua.v1.OpcuaDriverService/ProposeConfig"
+)
+
+// OpcuaDriverServiceClient is the client API for OpcuaDriverService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Exposes tools for exploring the address space of the OPC UA server the driver is connected to.
+// Requests use the name of the driver.
+type OpcuaDriverServiceClient interface {
+	// Walks the address space of the server, returning a tree of Objects, Variables, and Methods.
+	Browse(ctx context.Context, in *BrowseRequest, opts ...grpc.CallOption) (*BrowseResponse, error)
+	// Browses the server and proposes driver config, with a device for each Object that has Variables.
+	// The returned config can be passed to ServicesApi.ConfigureService as is, or edited first.
+	ProposeConfig(ctx context.Context, in *ProposeConfigRequest, opts ...grpc.CallOption) (*ProposeConfigResponse, error)
+}
+
+type opcuaDriverServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOpcuaDriverServiceClient(cc grpc.ClientConnInterface) OpcuaDriverServiceClient {
+	return &opcuaDriverServiceClient{cc}
+}
+
+func (c *opcuaDriverServiceClient) Browse(ctx context.Context, in *BrowseRequest, opts ...grpc.CallOption) (*BrowseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BrowseResponse)
+	err := c.cc.Invoke(ctx, OpcuaDriverService_Browse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *opcuaDriverServiceClient) ProposeConfig(ctx context.Context, in *ProposeConfigRequest, opts ...grpc.CallOption) (*ProposeConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProposeConfigResponse)
+	err := c.cc.Invoke(ctx, OpcuaDriverService_ProposeConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OpcuaDriverServiceServer is the server API for OpcuaDriverService service.
+// All implementations must embed UnimplementedOpcuaDriverServiceServer
+// for forward compatibility.
+//
+// Exposes tools for exploring the address space of the OPC UA server the driver is connected to.
+// Requests use the name of the driver.
+type OpcuaDriverServiceServer interface {
+	// Walks the address space of the server, returning a tree of Objects, Variables, and Methods.
+	Browse(context.Context, *BrowseRequest) (*BrowseResponse, error)
+	// Browses the server and proposes driver config, with a device for each Object that has Variables.
+	// The returned config can be passed to ServicesApi.ConfigureService as is, or edited first.
+	ProposeConfig(context.Context, *ProposeConfigRequest) (*ProposeConfigResponse, error)
+	mustEmbedUnimplementedOpcuaDriverServiceServer()
+}
+
+// UnimplementedOpcuaDriverServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOpcuaDriverServiceServer struct{}
+
+func (UnimplementedOpcuaDriverServiceServer) Browse(context.Context, *BrowseRequest) (*BrowseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Browse not implemented")
+}
+func (UnimplementedOpcuaDriverServiceServer) ProposeConfig(context.Context, *ProposeConfigRequest) (*ProposeConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeConfig not implemented")
+}
+func (UnimplementedOpcuaDriverServiceServer) mustEmbedUnimplementedOpcuaDriverServiceServer() {}
+func (UnimplementedOpcuaDriverServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeOpcuaDriverServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OpcuaDriverServiceServer will
+// result in compilation errors.
+type UnsafeOpcuaDriverServiceServer interface {
+	mustEmbedUnimplementedOpcuaDriverServiceServer()
+}
+
+func RegisterOpcuaDriverServiceServer(s grpc.ServiceRegistrar, srv OpcuaDriverServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOpcuaDriverServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OpcuaDriverService_ServiceDesc, srv)
+}
+
+func _OpcuaDriverService_Browse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrowseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpcuaDriverServiceServer).Browse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpcuaDriverService_Browse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpcuaDriverServiceServer).Browse(ctx, req.(*BrowseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OpcuaDriverService_ProposeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposeConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OpcuaDriverServiceServer).ProposeConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OpcuaDriverService_ProposeConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OpcuaDriverServiceServer).ProposeConfig(ctx, req.(*ProposeConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OpcuaDriverService_ServiceDesc is the grpc.ServiceDesc for OpcuaDriverService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OpcuaDriverService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "smartcore.bos.driver.opcua.v1.OpcuaDriverService",
+	HandlerType: (*OpcuaDriverServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Browse",
+			Handler:    _OpcuaDriverService_Browse_Handler,
+		},
+		{
+			MethodName: "ProposeConfig",
+			Handler:    _OpcuaDriverService_ProposeConfig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/driver/opcua/rpc/opcua.proto",
+}
@@ -0,0 +1,235 @@
+package opcua
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gopcua/opcua/ua"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/conv"
+	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
+)
+
+// confirmPollInterval is how often a written node is read while waiting for the write to be confirmed.
+const confirmPollInterval = 250 * time.Millisecond
+
+// writer writes trait values to OPC UA nodes for a single device.
+// Every write is confirmed by reading the value back from the node the trait reads from.
+// Failed writes raise a fault on the device health check, listing each node that failed.
+// A node is removed from the fault by the next successful write to it, the fault is cleared once no nodes are listed.
+type writer struct {
+	client         *Client
+	logger         *zap.Logger
+	faultCheck     *healthpb.FaultCheck
+	confirmTimeout time.Duration
+
+	mu     sync.Mutex
+	types  map[string]ua.TypeID // the type of the last value seen for each node
+	failed map[string]error     // nodeId -> the error from the last write to that node, if it failed
+}
+
+func newWriter(client *Client, logger *zap.Logger, faultCheck *healthpb.FaultCheck, confirmTimeout time.Duration) *writer {
+	return &writer{
+		client:         client,
+		logger:         logger,
+		faultCheck:     faultCheck,
+		confirmTimeout: confirmTimeout,
+		types:          make(map[string]ua.TypeID),
+		failed:         make(map[string]error),
+	}
+}
+
+// observe records the type of a value received for node, used to pick the type of values written to it.
+func (w *writer) observe(node *ua.NodeID, v *ua.Variant) {
+	if w == nil || node == nil || v == nil {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.types[node.String()] = v.Type()
+}
+
+// write writes value, in device units, to the node described by src and waits for it to be read back.
+// Returns the value read back from src.NodeId.
+// Errors are returned as gRPC status errors suitable for returning from trait servers.
+func (w *writer) write(ctx context.Context, src *config.ValueSource, value any) (any, error) {
+	if w == nil || w.client == nil {
+		return nil, status.Error(codes.Unimplemented, "writing is not supported")
+	}
+	readId, err := ua.ParseNodeID(src.NodeId)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "nodeId: %v", err)
+	}
+	variant, err := conv.ToVariant(value, w.typeFor(src))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	target := src.NodeId
+	if src.Method != nil {
+		target = src.Method.MethodId
+		err = w.call(ctx, src.Method, variant)
+	} else {
+		writeId := readId
+		if src.WriteNodeId != "" {
+			target = src.WriteNodeId
+			writeId, err = ua.ParseNodeID(src.WriteNodeId)
+			if err != nil {
+				return nil, status.Errorf(codes.FailedPrecondition, "writeNodeId: %v", err)
+			}
+		}
+		err = w.client.Write(ctx, writeId, variant)
+	}
+	if err != nil {
+		w.raiseWriteFault(target, err)
+		return nil, writeErrToStatus(target, err)
+	}
+
+	got, err := w.confirm(ctx, readId, variant.Value())
+	if err != nil {
+		w.raiseWriteFault(target, err)
+		return got, writeErrToStatus(target, err)
+	}
+	w.clearWriteFault(target)
+	return got, nil
+}
+
+func (w *writer) call(ctx context.Context, m *config.Method, arg *ua.Variant) error {
+	objectId, err := ua.ParseNodeID(m.ObjectId)
+	if err != nil {
+		return err
+	}
+	methodId, err := ua.ParseNodeID(m.MethodId)
+	if err != nil {
+		return err
+	}
+	return w.client.Call(ctx, objectId, methodId, arg)
+}
+
+// confirm reads node until its value equals want, or the confirm timeout passes.
+func (w *writer) confirm(ctx context.Context, node *ua.NodeID, want any) (any, error) {
+	ctx, cancel := context.WithTimeout(ctx, w.confirmTimeout)
+	defer cancel()
+	ticker := time.NewTicker(confirmPollInterval)
+	defer ticker.Stop()
+	var got any
+	for {
+		v, err := w.client.Read(ctx, node)
+		switch {
+		case err == nil && v.Value != nil:
+			got = v.Value.Value()
+			if conv.Equal(got, want) {
+				return got, nil
+			}
+		case err != nil && !errors.Is(err, ctx.Err()):
+			var code ua.StatusCode
+			if errors.As(err, &code) {
+				return nil, err // the server told us the value is bad
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return got, fmt.Errorf("%w: read back %v, want %v", errNotConfirmed, got, want)
+		case <-ticker.C:
+		}
+	}
+}
+
+// typeFor returns the OPC UA type values written for src should have.
+func (w *writer) typeFor(src *config.ValueSource) ua.TypeID {
+	if src.DataType != "" {
+		if t, err := conv.ParseTypeID(src.DataType); err == nil {
+			return t
+		}
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if t, ok := w.types[src.NodeId]; ok && t != ua.TypeIDNull {
+		return t
+	}
+	return ua.TypeIDDouble
+}
+
+func (w *writer) raiseWriteFault(nodeId string, err error) {
+	w.logger.Warn("failed to write point", zap.String("nodeId", nodeId), zap.Error(err))
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.failed[nodeId] = err
+	w.faultCheck.AddOrUpdateFault(w.writeFault())
+}
+
+// clearWriteFault removes nodeId from the write fault, clearing the fault if no other nodes are failing.
+func (w *writer) clearWriteFault(nodeId string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if _, ok := w.failed[nodeId]; !ok {
+		return
+	}
+	delete(w.failed, nodeId)
+	if len(w.failed) == 0 {
+		w.faultCheck.RemoveFault(&healthpb.HealthCheck_Error{Code: statusToHealthCode(WritePointError)})
+		return
+	}
+	w.faultCheck.AddOrUpdateFault(w.writeFault())
+}
+
+// writeFault returns the fault describing the failed writes. Must be called with w.mu held.
+func (w *writer) writeFault() *healthpb.HealthCheck_Error {
+	details := make([]string, 0, len(w.failed))
+	for nodeId, err := range w.failed {
+		details = append(details, fmt.Sprintf("NodeID: %s, Error: %s", nodeId, err.Error()))
+	}
+	slices.Sort(details)
+	return &healthpb.HealthCheck_Error{
+		SummaryText: "Failed to write to device point",
+		DetailsText: strings.Join(details, "; "),
+		Code:        statusToHealthCode(WritePointError),
+	}
+}
+
+var errNotConfirmed = errors.New("write not confirmed")
+
+// writeErrToStatus converts an error from writing nodeId to a gRPC status error.
+func writeErrToStatus(nodeId string, err error) error {
+	var code ua.StatusCode
+	switch {
+	case errors.Is(err, errNotConfirmed):
+		return status.Errorf(codes.Aborted, "%s: %v", nodeId, err)
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return status.FromContextError(err).Err()
+	case errors.As(err, &code):
+		switch code {
+		case ua.StatusBadUserAccessDenied, ua.StatusBadNotWritable, ua.StatusBadNotExecutable:
+			return status.Errorf(codes.PermissionDenied, "%s: %v", nodeId, err)
+		case ua.StatusBadTypeMismatch, ua.StatusBadOutOfRange, ua.StatusBadInvalidArgument, ua.StatusBadArgumentsMissing:
+			return status.Errorf(codes.InvalidArgument, "%s: %v", nodeId, err)
+		case ua.StatusBadNodeIDUnknown, ua.StatusBadNodeIDInvalid, ua.StatusBadMethodInvalid:
+			return status.Errorf(codes.FailedPrecondition, "%s: %v", nodeId, err)
+		}
+	}
+	return status.Errorf(codes.Unavailable, "%s: %v", nodeId, err)
+}
+
+// shouldUpdate returns true if the field at path should be written for an update request with mask.
+// Without a mask, fields are written if they are present in the request.
+func shouldUpdate(mask *fieldmaskpb.FieldMask, path string, present bool) bool {
+	if len(mask.GetPaths()) == 0 {
+		return present
+	}
+	for _, p := range mask.GetPaths() {
+		if p == path || strings.HasPrefix(p, path+".") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,354 @@
+package opcua
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gopcua/opcua"
+	"github.com/gopcua/opcua/id"
+	"github.com/gopcua/opcua/server"
+	"github.com/gopcua/opcua/ua"
+	"github.com/gopcua/opcua/uasc"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/opcua/config"
+	"github.com/smart-core-os/sc-bos/pkg/proto/airtemperaturepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/fanspeedpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/modepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/onoffpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/typespb"
+)
+
+func TestWriter_write(t *testing.T) {
+	ts := newTestServer(t)
+	ts.ns.AddNewVariableStringNode("SetPoint", 21.0)
+	ts.ns.AddNewVariableStringNode("Cmd", int32(0))
+	// Fb follows Cmd, like a device with separate command and feedback points
+	ts.ns.AddNewVariableStringNode("Fb", func() *ua.DataValue {
+		return ts.ns.Node(ua.NewStringNodeID(ts.ns.ID(), "Cmd")).Value()
+	})
+	ts.ns.AddNewVariableStringNode("NoFb", int32(0))
+	ts.ns.AddNewVariableStringNode("NoFbCmd", int32(0))
+	ro := ts.ns.AddNewVariableStringNode("ReadOnly", 1.0)
+	_ = ro.SetAttribute(ua.AttributeIDAccessLevel, server.DataValueFromValue(uint8(ua.AccessLevelTypeCurrentRead)))
+	ts.start(t)
+
+	h := setupTestHarness(t)
+	w := newWriter(ts.client, zaptest.NewLogger(t), h.fc, 500*time.Millisecond)
+	ctx := t.Context()
+
+	t.Run("value", func(t *testing.T) {
+		got, err := w.write(ctx, &config.ValueSource{NodeId: ts.nodeId("SetPoint")}, 23.5)
+		require.NoError(t, err)
+		require.Equal(t, 23.5, got)
+	})
+
+	t.Run("writeNodeId", func(t *testing.T) {
+		src := &config.ValueSource{NodeId: ts.nodeId("Fb"), WriteNodeId: ts.nodeId("Cmd"), DataType: "Int32"}
+		got, err := w.write(ctx, src, 3)
+		require.NoError(t, err)
+		require.Equal(t, int32(3), got)
+	})
+
+	t.Run("not confirmed", func(t *testing.T) {
+		src := &config.ValueSource{NodeId: ts.nodeId("NoFb"), WriteNodeId: ts.nodeId("NoFbCmd"), DataType: "Int32"}
+		_, err := w.write(ctx, src, 3)
+		require.Equal(t, codes.Aborted, status.Code(err), "err = %v", err)
+		requireWriteFault(t, h, true)
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		_, err := w.write(ctx, &config.ValueSource{NodeId: ts.nodeId("ReadOnly")}, 2.0)
+		require.Equal(t, codes.PermissionDenied, status.Code(err), "err = %v", err)
+		requireWriteFault(t, h, true)
+
+		// a successful write to another node doesn't clear the fault
+		_, err = w.write(ctx, &config.ValueSource{NodeId: ts.nodeId("SetPoint")}, 20.0)
+		require.NoError(t, err)
+		requireWriteFault(t, h, true)
+
+		// the fault is cleared once every failed node has been written successfully
+		_, err = w.write(ctx, &config.ValueSource{NodeId: ts.nodeId("NoFbCmd"), DataType: "Int32"}, 3)
+		require.NoError(t, err)
+		requireWriteFault(t, h, true)
+		_ = ro.SetAttribute(ua.AttributeIDAccessLevel, server.DataValueFromValue(uint8(ua.AccessLevelTypeCurrentRead|ua.AccessLevelTypeCurrentWrite)))
+		_, err = w.write(ctx, &config.ValueSource{NodeId: ts.nodeId("ReadOnly")}, 2.0)
+		require.NoError(t, err)
+		requireWriteFault(t, h, false)
+	})
+
+	t.Run("observed type", func(t *testing.T) {
+		node := mustParseNodeID(ts.nodeId("Cmd"))
+		w.observe(node, ua.MustVariant(int32(1)))
+		require.Equal(t, ua.TypeIDInt32, w.typeFor(&config.ValueSource{NodeId: node.String()}))
+		require.Equal(t, ua.TypeIDDouble, w.typeFor(&config.ValueSource{NodeId: ts.nodeId("Unknown")}))
+	})
+}
+
+func TestWriter_write_Method(t *testing.T) {
+	ts := newTestServer(t)
+	ts.ns.AddNewVariableStringNode("Run", false)
+	ts.onCall = func(req *ua.CallMethodRequest) ua.StatusCode {
+		if req.MethodID.StringID() != "SetRun" || len(req.InputArguments) != 1 {
+			return ua.StatusBadMethodInvalid
+		}
+		ts.ns.SetAttribute(ua.NewStringNodeID(ts.ns.ID(), "Run"), ua.AttributeIDValue, &ua.DataValue{
+			EncodingMask: ua.DataValueValue,
+			Value:        req.InputArguments[0],
+		})
+		return ua.StatusOK
+	}
+	ts.start(t)
+
+	h := setupTestHarness(t)
+	w := newWriter(ts.client, zaptest.NewLogger(t), h.fc, time.Second)
+
+	src := &config.ValueSource{
+		NodeId:   ts.nodeId("Run"),
+		DataType: "Boolean",
+		Method:   &config.Method{ObjectId: ts.nodeId("FCU"), MethodId: ts.nodeId("SetRun")},
+	}
+	got, err := w.write(t.Context(), src, true)
+	require.NoError(t, err)
+	require.Equal(t, true, got)
+
+	src.Method.MethodId = ts.nodeId("Other")
+	_, err = w.write(t.Context(), src, false)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err), "err = %v", err)
+	requireWriteFault(t, h, true)
+}
+
+func TestTraits_Update(t *testing.T) {
+	ts := newTestServer(t)
+	ts.ns.AddNewVariableStringNode("SetPoint", float32(21))
+	ts.ns.AddNewVariableStringNode("Mode", int32(0))
+	ts.ns.AddNewVariableStringNode("Run", int32(0))
+	ts.ns.AddNewVariableStringNode("Fan", 0.0)
+	ts.ns.AddNewVariableStringNode("Occ", int32(0))
+	ts.start(t)
+
+	logger := zaptest.NewLogger(t)
+	w := newWriter(ts.client, logger, newSimpleFaultCheck(t), time.Second)
+	// types are normally learnt from the subscription
+	for _, n := range []string{"SetPoint", "Mode", "Run", "Occ"} {
+		v, err := ts.client.Read(t.Context(), mustParseNodeID(ts.nodeId(n)))
+		require.NoError(t, err)
+		w.observe(mustParseNodeID(ts.nodeId(n)), v.Value)
+	}
+	ctx := t.Context()
+
+	t.Run("AirTemperature", func(t *testing.T) {
+		at, err := newAirTemperature("fcu", config.RawTrait{Raw: []byte(`{
+			"temperatureSetPoint": {"nodeId": "` + ts.nodeId("SetPoint") + `", "scale": 0.1},
+			"mode": {"nodeId": "` + ts.nodeId("Mode") + `", "enum": {"0": "OFF", "1": "HEAT", "2": "COOL"}}
+		}`)}, logger, w)
+		require.NoError(t, err)
+
+		res, err := at.UpdateAirTemperature(ctx, &airtemperaturepb.UpdateAirTemperatureRequest{
+			State: &airtemperaturepb.AirTemperature{
+				TemperatureGoal: &airtemperaturepb.AirTemperature_TemperatureSetPoint{TemperatureSetPoint: &typespb.Temperature{ValueCelsius: 22.5}},
+				Mode:            airtemperaturepb.AirTemperature_COOL,
+			},
+		})
+		require.NoError(t, err)
+		require.InDelta(t, 22.5, res.GetTemperatureSetPoint().GetValueCelsius(), 1e-6)
+		require.Equal(t, airtemperaturepb.AirTemperature_COOL, res.Mode)
+		requireRead(t, ts, "SetPoint", float32(225))
+		requireRead(t, ts, "Mode", int32(2))
+
+		_, err = at.UpdateAirTemperature(ctx, &airtemperaturepb.UpdateAirTemperatureRequest{
+			State: &airtemperaturepb.AirTemperature{Mode: airtemperaturepb.AirTemperature_AUTO},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), "err = %v", err)
+
+		_, err = at.UpdateAirTemperature(ctx, &airtemperaturepb.UpdateAirTemperatureRequest{
+			State: &airtemperaturepb.AirTemperature{
+				TemperatureGoal: &airtemperaturepb.AirTemperature_TemperatureRange{TemperatureRange: &airtemperaturepb.TemperatureRange{}},
+			},
+		})
+		require.Equal(t, codes.Unimplemented, status.Code(err), "err = %v", err)
+	})
+
+	t.Run("OnOff", func(t *testing.T) {
+		o, err := newOnOff("fcu", config.RawTrait{Raw: []byte(`{
+			"state": {"nodeId": "` + ts.nodeId("Run") + `", "enum": {"0": "OFF", "1": "ON"}}
+		}`)}, logger, w)
+		require.NoError(t, err)
+
+		res, err := o.UpdateOnOff(ctx, &onoffpb.UpdateOnOffRequest{OnOff: &onoffpb.OnOff{State: onoffpb.OnOff_ON}})
+		require.NoError(t, err)
+		require.Equal(t, onoffpb.OnOff_ON, res.State)
+		requireRead(t, ts, "Run", int32(1))
+	})
+
+	t.Run("FanSpeed", func(t *testing.T) {
+		fs, err := newFanSpeed("fcu", config.RawTrait{Raw: []byte(`{
+			"percentage": {"nodeId": "` + ts.nodeId("Fan") + `", "scale": 100},
+			"presets": [{"name": "off", "percentage": 0}, {"name": "low", "percentage": 30}, {"name": "high", "percentage": 100}]
+		}`)}, logger, w)
+		require.NoError(t, err)
+
+		res, err := fs.UpdateFanSpeed(ctx, &fanspeedpb.UpdateFanSpeedRequest{FanSpeed: &fanspeedpb.FanSpeed{Preset: "low"}})
+		require.NoError(t, err)
+		require.InDelta(t, 30, res.Percentage, 1e-4)
+		require.Equal(t, "low", res.Preset)
+		require.EqualValues(t, 1, res.PresetIndex)
+		requireRead(t, ts, "Fan", 0.3)
+
+		res, err = fs.UpdateFanSpeed(ctx, &fanspeedpb.UpdateFanSpeedRequest{FanSpeed: &fanspeedpb.FanSpeed{PresetIndex: 1}, Relative: true})
+		require.NoError(t, err)
+		require.Equal(t, "high", res.Preset)
+
+		res, err = fs.UpdateFanSpeed(ctx, &fanspeedpb.UpdateFanSpeedRequest{
+			FanSpeed:   &fanspeedpb.FanSpeed{Percentage: 45},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"percentage"}},
+		})
+		require.NoError(t, err)
+		require.InDelta(t, 45, res.Percentage, 1e-4)
+		require.Equal(t, "low", res.Preset)
+
+		support, err := fs.DescribeFanSpeed(ctx, &fanspeedpb.DescribeFanSpeedRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []string{"off", "low", "high"}, support.Presets)
+	})
+
+	t.Run("Mode", func(t *testing.T) {
+		m, err := newMode("fcu", config.RawTrait{Raw: []byte(`{
+			"modes": {"occupancy": {"nodeId": "` + ts.nodeId("Occ") + `", "enum": {"0": "unoccupied", "1": "occupied", "2": "standby"}}}
+		}`)}, logger, w)
+		require.NoError(t, err)
+
+		res, err := m.UpdateModeValues(ctx, &modepb.UpdateModeValuesRequest{
+			ModeValues: &modepb.ModeValues{Values: map[string]string{"occupancy": "standby"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"occupancy": "standby"}, res.Values)
+		requireRead(t, ts, "Occ", int32(2))
+
+		_, err = m.UpdateModeValues(ctx, &modepb.UpdateModeValuesRequest{
+			ModeValues: &modepb.ModeValues{Values: map[string]string{"occupancy": "away"}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), "err = %v", err)
+
+		support, err := m.DescribeModes(ctx, &modepb.DescribeModesRequest{})
+		require.NoError(t, err)
+		require.Len(t, support.AvailableModes.Modes, 1)
+		require.Len(t, support.AvailableModes.Modes[0].Values, 3)
+	})
+}
+
+func TestPointStatusFaults(t *testing.T) {
+	h := setupTestHarness(t)
+	f := newPointStatusFaults(h.fc)
+
+	faultCodes := func() []string {
+		var res []string
+		for _, fault := range h.getHealthChecks(t)[0].GetFaults().GetCurrentFaults() {
+			res = append(res, fault.GetCode().GetCode())
+		}
+		return res
+	}
+
+	f.update("ns=2;s=Tag1", ua.StatusBadSensorFailure)
+	f.update("ns=2;s=Tag2", ua.StatusBadSensorFailure)
+	f.update("ns=2;s=Tag3", ua.StatusUncertainLastUsableValue)
+	require.ElementsMatch(t, []string{"BadSensorFailure", "UncertainLastUsableValue"}, faultCodes())
+
+	f.update("ns=2;s=Tag1", ua.StatusOK)
+	require.ElementsMatch(t, []string{"BadSensorFailure", "UncertainLastUsableValue"}, faultCodes())
+	f.update("ns=2;s=Tag2", ua.StatusOK)
+	f.update("ns=2;s=Tag3", ua.StatusOK)
+	require.Empty(t, faultCodes())
+}
+
+// testServer is an in-process OPC UA server.
+// Add nodes to ns before calling start.
+type testServer struct {
+	srv    *server.Server
+	ns     *server.NodeNameSpace
+	client *Client
+	onCall func(req *ua.CallMethodRequest) ua.StatusCode
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := l.Addr().(*net.TCPAddr).Port
+	require.NoError(t, l.Close())
+
+	srv := server.New(
+		server.EndPoint("127.0.0.1", port),
+		server.EnableSecurity("None", ua.MessageSecurityModeNone),
+		server.EnableAuthMode(ua.UserTokenTypeAnonymous),
+	)
+	return &testServer{srv: srv, ns: server.NewNodeNameSpace(srv, "test")}
+}
+
+func (ts *testServer) start(t *testing.T) {
+	t.Helper()
+	// the default server doesn't support method calls
+	ts.srv.RegisterHandler(id.CallRequest_Encoding_DefaultBinary, func(_ *uasc.SecureChannel, r ua.Request, reqID uint32) (ua.Response, error) {
+		req := r.(*ua.CallRequest)
+		res := &ua.CallResponse{ResponseHeader: &ua.ResponseHeader{
+			Timestamp:          time.Now(),
+			RequestHandle:      req.RequestHeader.RequestHandle,
+			ServiceDiagnostics: &ua.DiagnosticInfo{},
+			StringTable:        []string{},
+			AdditionalHeader:   ua.NewExtensionObject(nil),
+		}}
+		for _, m := range req.MethodsToCall {
+			code := ua.StatusBadMethodInvalid
+			if ts.onCall != nil {
+				code = ts.onCall(m)
+			}
+			res.Results = append(res.Results, &ua.CallMethodResult{StatusCode: code})
+		}
+		return res, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	require.NoError(t, ts.srv.Start(ctx))
+	t.Cleanup(func() { _ = ts.srv.Close() })
+
+	c, err := opcua.NewClient(ts.srv.URLs()[0], opcua.SecurityMode(ua.MessageSecurityModeNone), opcua.AuthAnonymous())
+	require.NoError(t, err)
+	require.NoError(t, c.Connect(ctx))
+	t.Cleanup(func() { _ = c.Close(context.Background()) })
+	ts.client = NewClient(c, zaptest.NewLogger(t), time.Second, 1)
+}
+
+func (ts *testServer) nodeId(name string) string {
+	return ua.NewStringNodeID(ts.ns.ID(), name).String()
+}
+
+func requireRead(t *testing.T, ts *testServer, name string, want any) {
+	t.Helper()
+	v, err := ts.client.Read(t.Context(), mustParseNodeID(ts.nodeId(name)))
+	require.NoError(t, err)
+	require.Equal(t, want, v.Value.Value())
+}
+
+func requireWriteFault(t *testing.T, h *testHarness, want bool) {
+	t.Helper()
+	var found bool
+	for _, check := range h.getHealthChecks(t) {
+		for _, fault := range check.GetFaults().GetCurrentFaults() {
+			if fault.GetCode().GetCode() == WritePointError {
+				found = true
+			}
+		}
+	}
+	require.Equal(t, want, found, "WritePoint fault")
+	if want {
+		require.Equal(t, healthpb.HealthCheck_ABNORMAL, h.getHealthChecks(t)[0].Normality)
+	}
+}
@@ -0,0 +1,56 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/replay/recording"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+)
+
+// capture records the values of proxied device traits to a capture file.
+type capture struct {
+	ctx    context.Context
+	w      *recording.Writer
+	traits []trait.Name // if empty all supported traits are captured
+	conn   grpc.ClientConnInterface
+	now    func() time.Time
+	logger *zap.Logger
+}
+
+// start records the values of the named device's trait until the returned func is called.
+// start does nothing if the trait isn't captured.
+func (c *capture) start(name string, tn trait.Name) func() {
+	t, ok := recording.Lookup(tn)
+	if !ok || (len(c.traits) > 0 && !slices.Contains(c.traits, tn)) {
+		return func() {}
+	}
+	ctx, cancel := context.WithCancel(c.ctx)
+	logger := c.logger.With(zap.String("name", name), zap.Stringer("trait", tn))
+	payloads := make(chan []byte)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case payload := <-payloads:
+				err := c.w.Write(recording.Record{Time: c.now(), Name: name, Trait: tn, Payload: payload})
+				if err != nil {
+					logger.Warn("failed to write capture record", zap.Error(err))
+				}
+			}
+		}
+	}()
+	go func() {
+		err := t.Capture(ctx, c.conn, name, payloads, logger)
+		if err != nil && !errors.Is(err, context.Canceled) {
+			logger.Warn("capture stopped", zap.Error(err))
+		}
+	}()
+	return cancel
+}
@@ -0,0 +1,78 @@
+package proxy
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/replay/recording"
+	"github.com/smart-core-os/sc-bos/pkg/proto/onoffpb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+	"github.com/smart-core-os/sc-bos/pkg/wrap"
+)
+
+func TestCapture_start(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "capture.jsonl")
+	w, err := recording.Create(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+
+	model := onoffpb.NewModel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := &capture{
+		ctx:    ctx,
+		w:      w,
+		traits: []trait.Name{trait.OnOff},
+		conn:   wrap.ServerToClient(onoffpb.OnOffApi_ServiceDesc, onoffpb.NewModelServer(model)),
+		now:    time.Now,
+		logger: zap.NewNop(),
+	}
+	// not in the traits allow-list, this would fail if it tried to capture anything
+	defer c.start("light1", trait.Light)()
+	stop := c.start("light1", trait.OnOff)
+	defer stop()
+
+	waitForRecords := func(n int) []recording.Record {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for {
+			records, err := recording.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(records) >= n {
+				return records
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("got %d records, want %d", len(records), n)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	waitForRecords(1)
+	if _, err := model.UpdateOnOff(&onoffpb.OnOff{State: onoffpb.OnOff_ON}); err != nil {
+		t.Fatal(err)
+	}
+	records := waitForRecords(2)
+	if len(records) != 2 {
+		t.Fatalf("got %d records, want 2", len(records))
+	}
+	r := records[1]
+	if r.Name != "light1" || r.Trait != trait.OnOff {
+		t.Errorf("record for %s[%s], want light1[%s]", r.Name, r.Trait, trait.OnOff)
+	}
+	got := &onoffpb.OnOff{}
+	if err := proto.Unmarshal(r.Payload, got); err != nil {
+		t.Fatal(err)
+	}
+	if got.State != onoffpb.OnOff_ON {
+		t.Errorf("captured state %v, want %v", got.State, onoffpb.OnOff_ON)
+	}
+}
@@ -1,8 +1,13 @@
 package config
 
 import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
 	"github.com/smart-core-os/sc-bos/pkg/driver"
 	"github.com/smart-core-os/sc-bos/pkg/trait"
+	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
 )
 
 // Root describes the configuration available to the proxy driver.
@@ -40,13 +45,91 @@ type Node struct {
 	// this name from working.
 	SkipDevice bool `json:"skipDevice,omitempty"`
 
-	// Traits defines the exact named traits we proxy for this remote.
-	// If absent or empty the remote will be inspected using the Parent trait including all found traits.
-	// Traits []Trait `json:"traits,omitempty"` // todo: support manual traits
+	// Include limits the devices imported from the node to those matching any of these queries.
+	// If empty all devices are imported, subject to Exclude.
+	// Queries are matched against the name and metadata of remote devices, before renaming.
+	// Not used when Devices are listed explicitly.
+	Include []*jsontypes.DeviceQuery `json:"include,omitempty"`
+	// Exclude skips importing devices that match any of these queries.
+	// Not used when Devices are listed explicitly.
+	Exclude []*jsontypes.DeviceQuery `json:"exclude,omitempty"`
+
+	// Rename rules change the name of remote devices when they are announced on this node.
+	// Rules are applied in order, each to the result of the previous rule.
+	// Requests to the renamed device are sent to the node using the remote name.
+	Rename []Rename `json:"rename,omitempty"`
+
+	// Traits limits which traits are proxied for each device, other traits the device has are ignored.
+	// If empty all traits the device has are proxied.
+	Traits []trait.Name `json:"traits,omitempty"`
+
+	// Capture records the values of the proxied devices to a file, which can be played back by the replay driver.
+	Capture *Capture `json:"capture,omitempty"`
 
 	OAuth2 *OAuth2 `json:"oauth2,omitempty"`
 }
 
+// Capture configures recording the values of proxied devices.
+type Capture struct {
+	// File is the path of the capture file, records are appended if the file already exists.
+	File string `json:"file,omitempty"`
+	// Traits limits which traits are recorded.
+	// If empty all proxied traits that can be replayed are recorded.
+	Traits []trait.Name `json:"traits,omitempty"`
+}
+
+// Rename describes how to change the name of a remote device.
+// Only the set fields are applied, in the order StripPrefix, Match/Replace, AddPrefix.
+type Rename struct {
+	// StripPrefix removes this prefix from the name, if present.
+	StripPrefix string `json:"stripPrefix,omitempty"`
+	// Match is a regular expression, matches in the name are replaced by Replace.
+	// Replace may refer to submatches as described by regexp.Regexp.Expand, for example "$1".
+	Match   *Regexp `json:"match,omitempty"`
+	Replace string  `json:"replace,omitempty"`
+	// AddPrefix adds this prefix to the name.
+	AddPrefix string `json:"addPrefix,omitempty"`
+}
+
+// Apply returns name renamed using r.
+func (r Rename) Apply(name string) string {
+	name = strings.TrimPrefix(name, r.StripPrefix)
+	if r.Match != nil {
+		name = r.Match.ReplaceAllString(name, r.Replace)
+	}
+	return r.AddPrefix + name
+}
+
+// Regexp is a regexp.Regexp encoded as a json string.
+type Regexp struct {
+	*regexp.Regexp
+}
+
+func (r *Regexp) UnmarshalJSON(bytes []byte) error {
+	var str string
+	if err := json.Unmarshal(bytes, &str); err != nil {
+		return err
+	}
+	re, err := regexp.Compile(str)
+	if err != nil {
+		return err
+	}
+	r.Regexp = re
+	return nil
+}
+
+func (r *Regexp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+// RenameDevice returns the local name for the remote device name, applying each of the Rename rules.
+func (n Node) RenameDevice(name string) string {
+	for _, r := range n.Rename {
+		name = r.Apply(name)
+	}
+	return name
+}
+
 // GetDevices returns the list of devices to proxy for this node.
 // Use Devices if set, otherwise fall back to Children for backward compatibility.
 func (n Node) GetDevices() []Device {
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
@@ -13,8 +14,10 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/smart-core-os/sc-bos/internal/tracing"
 	"github.com/smart-core-os/sc-bos/pkg/driver"
 	"github.com/smart-core-os/sc-bos/pkg/driver/proxy/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/replay/recording"
 	"github.com/smart-core-os/sc-bos/pkg/node"
 	"github.com/smart-core-os/sc-bos/pkg/node/alltraits"
 	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
@@ -69,9 +72,22 @@ func (d *Driver) applyConfig(ctx context.Context, cfg config.Root) error {
 
 	// For each node we create a proxy instance which manages the discovery of devices exposed by that node.
 	for _, n := range cfg.Nodes {
+		proxy := &proxy{
+			config:     n,
+			announcer:  d.announcer,
+			skipDevice: n.ShouldSkipDevice(),
+			logger:     d.logger.Named(n.Host),
+		}
 		tlsConfig := proxyTLSConfig(d.clientTLSConfig, n)
 		dialOpts := []grpc.DialOption{
 			grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+			tracing.DialOption(),
+		}
+		if len(n.Rename) > 0 {
+			dialOpts = append(dialOpts,
+				grpc.WithChainUnaryInterceptor(proxy.names.unaryInterceptor),
+				grpc.WithChainStreamInterceptor(proxy.names.streamInterceptor),
+			)
 		}
 		if n.OAuth2 != nil {
 			httpClient := &http.Client{
@@ -94,16 +110,27 @@ func (d *Driver) applyConfig(ctx context.Context, cfg config.Root) error {
 		}
 
 		ctx, shutdown := context.WithCancel(ctx)
-		proxy := &proxy{
-			config:     n,
-			conn:       conn,
-			announcer:  d.announcer,
-			skipDevice: n.ShouldSkipDevice(),
-			logger:     d.logger.Named(n.Host),
-			shutdown:   shutdown,
-		}
+		proxy.conn = conn
+		proxy.shutdown = shutdown
 		d.proxies = append(d.proxies, proxy)
 
+		if n.Capture != nil && n.Capture.File != "" {
+			w, err := recording.Create(n.Capture.File)
+			if err != nil {
+				// devices are still proxied, they just aren't captured
+				allErrs = multierr.Append(allErrs, fmt.Errorf("capture file for %s: %w", n.Host, err))
+			} else {
+				proxy.capture = &capture{
+					ctx:    ctx,
+					w:      w,
+					traits: n.Capture.Traits,
+					conn:   conn,
+					now:    time.Now,
+					logger: proxy.logger.Named("capture"),
+				}
+			}
+		}
+
 		// list, announce, and subscribe to updates to the list of devices on the server
 		if len(n.GetDevices()) > 0 {
 			proxy.announceExplicitDevices(n.GetDevices())
@@ -148,6 +175,8 @@ type proxy struct {
 	conn       *grpc.ClientConn // used if the proxy updates its devices
 	skipDevice bool             // if true we don't announce the device trait on this node
 	announcer  node.Announcer
+	names      deviceNames // local and remote names of imported devices
+	capture    *capture    // nil if the node's devices aren't captured
 
 	logger   *zap.Logger
 	shutdown context.CancelFunc
@@ -170,7 +199,12 @@ func (p *proxy) AnnounceDevices(ctx context.Context) error {
 
 func (p *proxy) announceExplicitDevices(devices []config.Device) {
 	for _, c := range devices {
-		p.announceTraits(nil, c.Name, c.Traits)
+		name, traits := p.importExplicitDevice(c)
+		if !p.names.add(name, c.Name) {
+			p.logger.Warn("devices renamed to the same name, ignoring device", zap.String("name", name), zap.String("remote", c.Name))
+			continue
+		}
+		p.announceTraits(nil, name, traits)
 	}
 }
 
@@ -180,7 +214,7 @@ func (p *proxy) announceChanges(changes <-chan *devicespb.PullDevicesResponse_Ch
 	defer announced.deleteAll()
 	defer metadata.deleteAll()
 	for change := range changes {
-		p.announceChange(announced, metadata, change)
+		p.announceChange(announced, metadata, p.importChange(change))
 	}
 }
 
@@ -236,6 +270,9 @@ func (p *proxy) announceTraits(announced announcedTraits, deviceName string, tra
 		}
 
 		undo := p.announcer.Announce(deviceName, features...)
+		if p.capture != nil {
+			undo = node.UndoAll(p.capture.start(deviceName, tn), undo)
+		}
 		if announced != nil {
 			announced.add(deviceName, tn, undo)
 		}
@@ -244,7 +281,11 @@ func (p *proxy) announceTraits(announced announcedTraits, deviceName string, tra
 
 func (p *proxy) Close() error {
 	p.shutdown()
-	return p.conn.Close()
+	var err error
+	if p.capture != nil {
+		err = p.capture.w.Close()
+	}
+	return multierr.Append(err, p.conn.Close())
 }
 
 // announcedMetadata tracks the metadata announcement made per device name so it can be updated
@@ -0,0 +1,89 @@
+package proxy
+
+import (
+	"slices"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smart-core-os/sc-bos/internal/manage/devices"
+	"github.com/smart-core-os/sc-bos/pkg/driver/proxy/config"
+	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
+)
+
+// importChange converts a change to the remote node's devices into a change to the devices this node announces.
+// Devices that aren't imported are nil in the returned change, remote names are replaced with local names.
+func (p *proxy) importChange(change *devicespb.PullDevicesResponse_Change) *devicespb.PullDevicesResponse_Change {
+	res := &devicespb.PullDevicesResponse_Change{
+		Type:       change.Type,
+		ChangeTime: change.ChangeTime,
+		OldValue:   p.importDevice(change.OldValue),
+		NewValue:   p.importDevice(change.NewValue),
+	}
+	if res.NewValue != nil {
+		if !p.names.add(res.NewValue.Name, change.NewValue.Name) {
+			remote, _ := p.names.remote(res.NewValue.Name)
+			p.logger.Warn("remote devices renamed to the same name, ignoring device",
+				zap.String("name", res.NewValue.Name), zap.String("remote", change.NewValue.Name), zap.String("existing", remote))
+			res.NewValue = nil
+		}
+	}
+	if res.OldValue != nil {
+		if remote, _ := p.names.remote(res.OldValue.Name); remote != change.OldValue.Name {
+			res.OldValue = nil // the old device was never announced, its name is used by a different device
+		} else if res.NewValue == nil {
+			p.names.remove(res.OldValue.Name)
+		}
+	}
+	return res
+}
+
+// importDevice returns the device as it should be announced on this node, or nil if it shouldn't be imported.
+func (p *proxy) importDevice(device *devicespb.Device) *devicespb.Device {
+	if device == nil || !p.shouldImport(device) {
+		return nil
+	}
+	name := p.config.RenameDevice(device.Name)
+	if name == device.Name && len(p.config.Traits) == 0 {
+		return device
+	}
+	device = proto.Clone(device).(*devicespb.Device)
+	device.Name = name
+	if md := device.Metadata; md != nil {
+		if md.Name != "" {
+			md.Name = name
+		}
+		if len(p.config.Traits) > 0 {
+			md.Traits = slices.DeleteFunc(md.Traits, func(t *metadatapb.TraitMetadata) bool {
+				return !slices.Contains(p.config.Traits, trait.Name(t.Name))
+			})
+		}
+	}
+	return device
+}
+
+// shouldImport returns whether the remote device matches the nodes include and exclude queries.
+func (p *proxy) shouldImport(device *devicespb.Device) bool {
+	matches := func(q *jsontypes.DeviceQuery) bool {
+		return devices.MatchesQuery(q.Pb(), device)
+	}
+	if len(p.config.Include) > 0 && !slices.ContainsFunc(p.config.Include, matches) {
+		return false
+	}
+	return !slices.ContainsFunc(p.config.Exclude, matches)
+}
+
+// importExplicitDevice returns the name and traits to announce for an explicitly configured device.
+func (p *proxy) importExplicitDevice(device config.Device) (string, []trait.Name) {
+	name := p.config.RenameDevice(device.Name)
+	traits := device.Traits
+	if len(p.config.Traits) > 0 {
+		traits = slices.DeleteFunc(slices.Clone(traits), func(tn trait.Name) bool {
+			return !slices.Contains(p.config.Traits, tn)
+		})
+	}
+	return name, traits
+}
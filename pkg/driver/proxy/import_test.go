@@ -0,0 +1,99 @@
+package proxy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/proxy/config"
+	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+)
+
+func Test_proxy_importChange(t *testing.T) {
+	var cfg config.Node
+	err := json.Unmarshal([]byte(`{
+		"include": [{"conditions": [{"field": "metadata.membership.subsystem", "stringEqual": "lighting"}]}],
+		"exclude": [{"conditions": [{"field": "name", "stringContains": "private"}]}],
+		"rename": [{"stripPrefix": "siteB/"}, {"match": "^floor(\\d+)/", "replace": "L$1/"}, {"addPrefix": "neighbour/"}],
+		"traits": ["smartcore.traits.OnOff"]
+	}`), &cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &proxy{config: cfg, logger: zap.NewNop()}
+
+	dev := func(name, subsystem string) *devicespb.Device {
+		return &devicespb.Device{Name: name, Metadata: &metadatapb.Metadata{
+			Name:       name,
+			Membership: &metadatapb.Metadata_Membership{Subsystem: subsystem},
+			Traits:     []*metadatapb.TraitMetadata{{Name: trait.OnOff.String()}, {Name: trait.Light.String()}},
+		}}
+	}
+	add := func(d *devicespb.Device) *devicespb.Device {
+		return p.importChange(&devicespb.PullDevicesResponse_Change{NewValue: d}).NewValue
+	}
+
+	got := add(dev("siteB/floor1/light1", "lighting"))
+	if got == nil {
+		t.Fatal("expected device to be imported")
+	}
+	if got.Name != "neighbour/L1/light1" || got.Metadata.Name != "neighbour/L1/light1" {
+		t.Errorf("device renamed to %q (metadata %q), want neighbour/L1/light1", got.Name, got.Metadata.Name)
+	}
+	if len(got.Metadata.Traits) != 1 || got.Metadata.Traits[0].Name != trait.OnOff.String() {
+		t.Errorf("traits not filtered, got %v", got.Metadata.Traits)
+	}
+	if remote, _ := p.names.remote("neighbour/L1/light1"); remote != "siteB/floor1/light1" {
+		t.Errorf("remote name %q, want siteB/floor1/light1", remote)
+	}
+
+	if got := add(dev("siteB/floor1/ahu1", "hvac")); got != nil {
+		t.Errorf("expected device not matching include to be skipped, got %v", got)
+	}
+	if got := add(dev("siteB/floor1/private1", "lighting")); got != nil {
+		t.Errorf("expected excluded device to be skipped, got %v", got)
+	}
+	// renamed to the same name as light1
+	if got := add(dev("floor1/light1", "lighting")); got != nil {
+		t.Errorf("expected device with colliding name to be skipped, got %v", got)
+	}
+
+	// removing the colliding device shouldn't remove the original
+	removed := p.importChange(&devicespb.PullDevicesResponse_Change{OldValue: dev("floor1/light1", "lighting")})
+	if removed.OldValue != nil {
+		t.Errorf("expected colliding device removal to be ignored, got %v", removed.OldValue)
+	}
+	removed = p.importChange(&devicespb.PullDevicesResponse_Change{OldValue: dev("siteB/floor1/light1", "lighting")})
+	if removed.OldValue.GetName() != "neighbour/L1/light1" {
+		t.Errorf("expected removal of neighbour/L1/light1, got %v", removed.OldValue)
+	}
+	if _, ok := p.names.remote("neighbour/L1/light1"); ok {
+		t.Error("expected name to be forgotten after removal")
+	}
+}
+
+func Test_proxy_importChange_noRules(t *testing.T) {
+	p := &proxy{logger: zap.NewNop()}
+	d := &devicespb.Device{Name: "device01", Metadata: &metadatapb.Metadata{Traits: []*metadatapb.TraitMetadata{{Name: trait.OnOff.String()}}}}
+	got := p.importChange(&devicespb.PullDevicesResponse_Change{NewValue: d})
+	if got.NewValue != d {
+		t.Errorf("expected device to be imported unchanged, got %v", got.NewValue)
+	}
+}
+
+func Test_proxy_importExplicitDevice(t *testing.T) {
+	p := &proxy{config: config.Node{
+		Rename: []config.Rename{{AddPrefix: "neighbour/"}},
+		Traits: []trait.Name{trait.OnOff},
+	}}
+	name, traits := p.importExplicitDevice(config.Device{Name: "light1", Traits: []trait.Name{trait.Light, trait.OnOff}})
+	if name != "neighbour/light1" {
+		t.Errorf("name %q, want neighbour/light1", name)
+	}
+	if !traitNamesEqual(traits, []trait.Name{trait.OnOff}) {
+		t.Errorf("traits %v, want [OnOff]", traits)
+	}
+}
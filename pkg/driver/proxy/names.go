@@ -0,0 +1,151 @@
+package proxy
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// deviceNames tracks the local and remote names of renamed devices.
+// Requests for a local name are sent to the remote node using the remote name,
+// and names in responses are changed back to the local name.
+type deviceNames struct {
+	mu       sync.RWMutex
+	toRemote map[string]string
+	toLocal  map[string]string
+}
+
+// add records that the local name refers to the remote name.
+// add returns false if local already refers to a different remote name.
+func (n *deviceNames) add(local, remote string) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if old, ok := n.toRemote[local]; ok {
+		return old == remote
+	}
+	if n.toRemote == nil {
+		n.toRemote = make(map[string]string)
+		n.toLocal = make(map[string]string)
+	}
+	n.toRemote[local] = remote
+	n.toLocal[remote] = local
+	return true
+}
+
+func (n *deviceNames) remove(local string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if remote, ok := n.toRemote[local]; ok {
+		delete(n.toRemote, local)
+		delete(n.toLocal, remote)
+	}
+}
+
+// remote returns the remote name for local, if known.
+func (n *deviceNames) remote(local string) (string, bool) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	remote, ok := n.toRemote[local]
+	return remote, ok
+}
+
+func (n *deviceNames) local(remote string) (string, bool) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	local, ok := n.toLocal[remote]
+	return local, ok
+}
+
+// renameRequest returns msg with the remote name in place of the local name.
+// msg is cloned before being changed, as the caller may still be using it.
+func (n *deviceNames) renameRequest(msg any) any {
+	pm, ok := msg.(proto.Message)
+	if !ok {
+		return msg
+	}
+	fd := nameField(pm.ProtoReflect())
+	if fd == nil {
+		return msg
+	}
+	remote, ok := n.remote(pm.ProtoReflect().Get(fd).String())
+	if !ok {
+		return msg
+	}
+	pm = proto.Clone(pm)
+	pm.ProtoReflect().Set(fd, protoreflect.ValueOfString(remote))
+	return pm
+}
+
+// renameResponse replaces remote names in msg with their local names.
+// Both the name of msg and names of any changes, as found in Pull responses, are replaced.
+func (n *deviceNames) renameResponse(msg any) {
+	pm, ok := msg.(proto.Message)
+	if !ok {
+		return
+	}
+	m := pm.ProtoReflect()
+	n.renameMessage(m)
+	changes := m.Descriptor().Fields().ByName("changes")
+	if changes == nil || !changes.IsList() || changes.Message() == nil {
+		return
+	}
+	list := m.Get(changes).List()
+	for i := range list.Len() {
+		n.renameMessage(list.Get(i).Message())
+	}
+}
+
+func (n *deviceNames) renameMessage(m protoreflect.Message) {
+	fd := nameField(m)
+	if fd == nil {
+		return
+	}
+	if local, ok := n.local(m.Get(fd).String()); ok {
+		m.Set(fd, protoreflect.ValueOfString(local))
+	}
+}
+
+// nameField returns the singular string name field of m, or nil if m has no such field.
+func nameField(m protoreflect.Message) protoreflect.FieldDescriptor {
+	fd := m.Descriptor().Fields().ByName("name")
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+		return nil
+	}
+	return fd
+}
+
+func (n *deviceNames) unaryInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if err := invoker(ctx, method, n.renameRequest(req), reply, cc, opts...); err != nil {
+		return err
+	}
+	n.renameResponse(reply)
+	return nil
+}
+
+func (n *deviceNames) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &renamingStream{ClientStream: stream, names: n}, nil
+}
+
+type renamingStream struct {
+	grpc.ClientStream
+	names *deviceNames
+}
+
+func (s *renamingStream) SendMsg(m any) error {
+	return s.ClientStream.SendMsg(s.names.renameRequest(m))
+}
+
+func (s *renamingStream) RecvMsg(m any) error {
+	if err := s.ClientStream.RecvMsg(m); err != nil {
+		return err
+	}
+	s.names.renameResponse(m)
+	return nil
+}
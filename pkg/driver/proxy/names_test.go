@@ -0,0 +1,94 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/onoffpb"
+)
+
+func TestDeviceNames_unaryInterceptor(t *testing.T) {
+	var names deviceNames
+	names.add("neighbour/light1", "light1")
+
+	req := &onoffpb.GetOnOffRequest{Name: "neighbour/light1"}
+	var sentName string
+	err := names.unaryInterceptor(context.Background(), "/test", req, &onoffpb.OnOff{}, nil,
+		func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			sentName = req.(*onoffpb.GetOnOffRequest).Name
+			return nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sentName != "light1" {
+		t.Errorf("sent name %q, want light1", sentName)
+	}
+	if req.Name != "neighbour/light1" {
+		t.Errorf("callers request was modified, name is %q", req.Name)
+	}
+
+	// unknown names are sent as is
+	err = names.unaryInterceptor(context.Background(), "/test", &onoffpb.GetOnOffRequest{Name: "other"}, &onoffpb.OnOff{}, nil,
+		func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			sentName = req.(*onoffpb.GetOnOffRequest).Name
+			return nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sentName != "other" {
+		t.Errorf("sent name %q, want other", sentName)
+	}
+}
+
+func TestDeviceNames_streamInterceptor(t *testing.T) {
+	var names deviceNames
+	names.add("neighbour/light1", "light1")
+
+	fake := &fakeClientStream{recv: &onoffpb.PullOnOffResponse{Changes: []*onoffpb.PullOnOffResponse_Change{
+		{Name: "light1"}, {Name: "other"},
+	}}}
+	stream, err := names.streamInterceptor(context.Background(), &grpc.StreamDesc{}, nil, "/test",
+		func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return fake, nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stream.SendMsg(&onoffpb.PullOnOffRequest{Name: "neighbour/light1"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := fake.sent.(*onoffpb.PullOnOffRequest).Name; got != "light1" {
+		t.Errorf("sent name %q, want light1", got)
+	}
+	res := &onoffpb.PullOnOffResponse{}
+	if err := stream.RecvMsg(res); err != nil {
+		t.Fatal(err)
+	}
+	if got := res.Changes[0].Name; got != "neighbour/light1" {
+		t.Errorf("received change name %q, want neighbour/light1", got)
+	}
+	if got := res.Changes[1].Name; got != "other" {
+		t.Errorf("received change name %q, want other", got)
+	}
+}
+
+type fakeClientStream struct {
+	grpc.ClientStream
+	sent any
+	recv proto.Message
+}
+
+func (f *fakeClientStream) SendMsg(m any) error {
+	f.sent = m
+	return nil
+}
+
+func (f *fakeClientStream) RecvMsg(m any) error {
+	proto.Merge(m.(proto.Message), f.recv)
+	return nil
+}
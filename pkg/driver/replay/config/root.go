@@ -0,0 +1,119 @@
+// Package config defines the configuration schema for the replay driver.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/smart-core-os/sc-bos/pkg/block"
+	"github.com/smart-core-os/sc-bos/pkg/block/mdblock"
+	"github.com/smart-core-os/sc-bos/pkg/driver"
+	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+)
+
+// Root is the top-level configuration for the replay driver.
+//
+// Records are read from either a capture File, recorded by the proxy driver,
+// or from History, for the traits of each configured device.
+type Root struct {
+	driver.BaseConfig
+
+	// Speed accelerates the replay relative to the recorded time.
+	// 1 (default) replays in real time, 60 replays an hour every minute.
+	Speed float64 `json:"speed,omitempty"`
+	// From and To limit the recorded period that is replayed, from inclusive and to exclusive.
+	// From defaults to the first record, To to the last.
+	From *time.Time `json:"from,omitempty"`
+	To   *time.Time `json:"to,omitempty"`
+	// Loop replays the recording again from the start when the end is reached.
+	Loop bool `json:"loop,omitempty"`
+
+	// File is the path of a capture file to replay.
+	// All devices and traits in the file are replayed, in addition to any configured Devices.
+	File string `json:"file,omitempty"`
+	// History reads records from a history store.
+	History *History `json:"history,omitempty"`
+
+	Devices []Device `json:"devices,omitempty"`
+}
+
+// History configures reading records recorded by history automations.
+type History struct {
+	// Type is "api" (default) to read using the HistoryAdminApi, as used by history automations with
+	// storage type postgres, sqlite, or api, or "bolt" to read from this controllers bolt database.
+	Type string `json:"type,omitempty"`
+	// Name is the name of the HistoryAdminApi to read from, when Type is "api".
+	Name string `json:"name,omitempty"`
+}
+
+// Device is a device to announce and replay.
+type Device struct {
+	Name string `json:"name,omitempty"`
+	// Traits are the traits of the device to replay.
+	// When reading from History, records are read from the source "name[trait]", as recorded by history automations.
+	Traits   []trait.Name         `json:"traits,omitempty"`
+	Metadata *metadatapb.Metadata `json:"metadata,omitempty"`
+}
+
+const (
+	HistoryTypeAPI  = "api"
+	HistoryTypeBolt = "bolt"
+
+	DefaultSpeed = 1.0
+)
+
+// Normalise fills in defaults on a parsed Root.
+func (r *Root) Normalise() {
+	if r.Speed <= 0 {
+		r.Speed = DefaultSpeed
+	}
+	if r.History != nil && r.History.Type == "" {
+		r.History.Type = HistoryTypeAPI
+	}
+}
+
+// Validate checks the config is usable. Call it after Normalise.
+func (r *Root) Validate() error {
+	if r.File == "" && r.History == nil {
+		return errors.New("one of file or history is required")
+	}
+	if r.From != nil && r.To != nil && !r.From.Before(*r.To) {
+		return fmt.Errorf("from %s must be before to %s", r.From, r.To)
+	}
+	if r.History != nil {
+		switch r.History.Type {
+		case HistoryTypeAPI:
+			if r.History.Name == "" {
+				return errors.New("history.name is required when history.type is \"api\"")
+			}
+		case HistoryTypeBolt:
+		default:
+			return fmt.Errorf("unknown history.type %q", r.History.Type)
+		}
+		if len(r.Devices) == 0 {
+			return errors.New("devices are required when reading from history")
+		}
+	}
+	for i, d := range r.Devices {
+		if d.Name == "" {
+			return fmt.Errorf("devices[%d] has no name", i)
+		}
+	}
+	return nil
+}
+
+// Blocks describes the config structure for the configuration UI.
+var Blocks = []block.Block{
+	{
+		Path: []string{"devices"},
+		Key:  "name",
+		Blocks: []block.Block{
+			{
+				Path:   []string{"metadata"},
+				Blocks: mdblock.Categories,
+			},
+		},
+	},
+}
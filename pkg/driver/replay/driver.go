@@ -0,0 +1,293 @@
+// Package replay implements the "replay" driver, which announces devices and plays back their recorded values.
+//
+// Values are read from history stores, as recorded by history automations, or from capture files recorded by the
+// proxy driver, and are replayed at real or accelerated time.
+// This allows automations to be tested against the recorded behaviour of a real site.
+package replay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/timshannon/bolthold"
+	"go.uber.org/zap"
+
+	"github.com/smart-core-os/sc-bos/pkg/block"
+	"github.com/smart-core-os/sc-bos/pkg/driver"
+	"github.com/smart-core-os/sc-bos/pkg/driver/replay/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/replay/recording"
+	"github.com/smart-core-os/sc-bos/pkg/history"
+	"github.com/smart-core-os/sc-bos/pkg/history/apistore"
+	"github.com/smart-core-os/sc-bos/pkg/history/boltstore"
+	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/proto/historypb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
+	"github.com/smart-core-os/sc-bos/pkg/task/service"
+	"github.com/smart-core-os/sc-bos/pkg/util/time/clock"
+)
+
+const DriverName = "replay"
+
+var Factory driver.Factory = factory{}
+
+type factory struct{}
+
+func (factory) New(services driver.Services) service.Lifecycle {
+	d := &Driver{
+		announcer:   services.Node,
+		clients:     services.Node,
+		db:          services.Database,
+		systemCheck: services.SystemCheck,
+		clk:         clock.Real(),
+		logger:      services.Logger.Named(DriverName),
+	}
+	d.Service = service.New(d.applyConfig, service.WithOnStop[config.Root](func() {
+		d.stop()
+		if d.systemCheck != nil {
+			d.systemCheck.Dispose()
+		}
+	}))
+	return d
+}
+
+func (factory) ConfigBlocks() []block.Block {
+	return config.Blocks
+}
+
+type Driver struct {
+	*service.Service[config.Root]
+
+	logger      *zap.Logger
+	announcer   node.Announcer
+	clients     node.ClientConner
+	db          *bolthold.Store
+	systemCheck service.SystemCheck
+	clk         clock.Clock // swappable in tests
+
+	mu     sync.Mutex
+	cancel context.CancelFunc // stops the running replay
+	undo   []node.Undo        // reverses the current announcements
+}
+
+func (d *Driver) applyConfig(ctx context.Context, cfg config.Root) error {
+	cfg.Normalise()
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	d.stop()
+
+	sources, deviceTraits, err := d.sources(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	var undos []node.Undo
+	updates := make(map[deviceTrait]func([]byte) error)
+	for _, dt := range deviceTraits {
+		t, ok := recording.Lookup(dt.trait)
+		if !ok {
+			d.logger.Warn("trait can't be replayed, skipping", zap.String("name", dt.name), zap.Stringer("trait", dt.trait))
+			continue
+		}
+		features, update := t.Serve()
+		features = append(features, node.HasTrait(dt.trait))
+		undos = append(undos, d.announcer.Announce(dt.name, features...))
+		updates[dt] = update
+	}
+	for _, dev := range cfg.Devices {
+		if dev.Metadata != nil {
+			undos = append(undos, d.announcer.Announce(dev.Name, node.HasMetadata(dev.Metadata), node.HasDeviceType(metadatapb.Metadata_DEVICE)))
+		}
+	}
+	d.logger.Info("announced replayed devices", zap.Int("traits", len(updates)))
+
+	runCtx, cancel := context.WithCancel(ctx)
+	d.mu.Lock()
+	d.cancel = cancel
+	d.undo = undos
+	d.mu.Unlock()
+
+	p := &player{
+		clk:     d.clk,
+		speed:   cfg.Speed,
+		loop:    cfg.Loop,
+		sources: sources,
+		updates: updates,
+		logger:  d.logger,
+	}
+	if cfg.From != nil {
+		p.from = *cfg.From
+	}
+	if cfg.To != nil {
+		p.to = *cfg.To
+	}
+	if d.systemCheck != nil {
+		d.systemCheck.MarkRunning()
+	}
+	go func() {
+		err := p.run(runCtx)
+		switch {
+		case err == nil:
+			d.logger.Info("replay finished")
+		case errors.Is(err, context.Canceled):
+		default:
+			d.logger.Warn("replay stopped", zap.Error(err))
+			if d.systemCheck != nil {
+				d.systemCheck.MarkFailed(err)
+			}
+		}
+	}()
+	return nil
+}
+
+// sources returns the sources of records for cfg, and the device traits those sources replay.
+func (d *Driver) sources(ctx context.Context, cfg config.Root) ([]source, []deviceTrait, error) {
+	var sources []source
+	var deviceTraits []deviceTrait
+	seen := make(map[deviceTrait]bool)
+	addDeviceTrait := func(dt deviceTrait) bool {
+		if seen[dt] {
+			return false
+		}
+		seen[dt] = true
+		deviceTraits = append(deviceTraits, dt)
+		return true
+	}
+
+	if cfg.File != "" {
+		records, err := recording.ReadFile(cfg.File)
+		if err != nil {
+			return nil, nil, fmt.Errorf("capture file: %w", err)
+		}
+		src := newFileSource(records)
+		sources = append(sources, src)
+		for _, dt := range src.deviceTraits() {
+			addDeviceTrait(dt)
+		}
+	}
+	for _, dev := range cfg.Devices {
+		for _, tn := range dev.Traits {
+			dt := deviceTrait{name: dev.Name, trait: tn}
+			if !addDeviceTrait(dt) || cfg.History == nil {
+				continue
+			}
+			store, err := d.historyStore(ctx, *cfg.History, fmt.Sprintf("%s[%s]", dt.name, dt.trait))
+			if err != nil {
+				return nil, nil, err
+			}
+			sources = append(sources, &historySource{deviceTrait: dt, store: store})
+		}
+	}
+	return sources, deviceTraits, nil
+}
+
+// historyStore returns the store a history automation writes the records of source to.
+func (d *Driver) historyStore(ctx context.Context, cfg config.History, source string) (history.Slice, error) {
+	switch cfg.Type {
+	case config.HistoryTypeAPI:
+		client := historypb.NewHistoryAdminApiClient(d.clients.ClientConn())
+		return apistore.New(client, cfg.Name, source), nil
+	case config.HistoryTypeBolt:
+		if d.db == nil {
+			return nil, errors.New("history.type \"bolt\" requires a database")
+		}
+		return boltstore.NewFromDb(ctx, d.db, source, boltstore.WithLogger(d.logger))
+	}
+	return nil, fmt.Errorf("unknown history.type %q", cfg.Type)
+}
+
+// stop cancels the running replay and reverses all announcements.
+func (d *Driver) stop() {
+	d.mu.Lock()
+	cancel, undo := d.cancel, d.undo
+	d.cancel, d.undo = nil, nil
+	d.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+	for _, u := range undo {
+		u()
+	}
+}
+
+// player replays the records of sources on the updates for each device trait.
+type player struct {
+	clk      clock.Clock
+	speed    float64
+	loop     bool
+	from, to time.Time // zero if unbounded
+	sources  []source
+	updates  map[deviceTrait]func([]byte) error
+	logger   *zap.Logger
+}
+
+// run replays the records until the last record has been replayed, or until ctx is done if looping.
+// Each record is replayed after the time since the start of the replayed period, divided by speed, has elapsed.
+func (p *player) run(ctx context.Context) error {
+	for ctx.Err() == nil {
+		n, err := p.play(ctx)
+		if err != nil {
+			return err
+		}
+		if !p.loop || n == 0 {
+			return nil
+		}
+	}
+	return ctx.Err()
+}
+
+// play replays the recorded period once, returning the number of records replayed.
+func (p *player) play(ctx context.Context) (int, error) {
+	var cursors []cursor
+	for _, src := range p.sources {
+		prime, c, err := src.open(ctx, p.from, p.to)
+		if err != nil {
+			return 0, err
+		}
+		// values as they were at the start of the period are available immediately
+		for _, r := range prime {
+			p.apply(r)
+		}
+		cursors = append(cursors, c)
+	}
+	records := newMergeCursor(cursors...)
+
+	startWall := p.clk.Now()
+	startRecord := p.from
+	var n int
+	for ctx.Err() == nil {
+		r, ok, err := records.next(ctx)
+		if err != nil {
+			return n, err
+		}
+		if !ok {
+			return n, nil
+		}
+		if startRecord.IsZero() {
+			startRecord = r.Time
+		}
+		due := startWall.Add(time.Duration(float64(r.Time.Sub(startRecord)) / p.speed))
+		select {
+		case <-ctx.Done():
+			return n, ctx.Err()
+		case <-p.clk.At(due):
+		}
+		p.apply(r)
+		n++
+	}
+	return n, ctx.Err()
+}
+
+func (p *player) apply(r recording.Record) {
+	update, ok := p.updates[deviceTrait{name: r.Name, trait: r.Trait}]
+	if !ok {
+		return
+	}
+	if err := update(r.Payload); err != nil {
+		p.logger.Debug("failed to replay record", zap.String("name", r.Name), zap.Stringer("trait", r.Trait), zap.Error(err))
+	}
+}
@@ -0,0 +1,111 @@
+package replay
+
+import (
+	"context"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/replay/recording"
+	"github.com/smart-core-os/sc-bos/pkg/history/memstore"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+	"github.com/smart-core-os/sc-bos/pkg/util/time/clock"
+)
+
+func TestPlayer_run(t *testing.T) {
+	t0 := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	light := deviceTrait{name: "light1", trait: trait.OnOff}
+	temp := deviceTrait{name: "temp1", trait: trait.AirTemperature}
+
+	file := newFileSource([]recording.Record{
+		{Time: t0.Add(3 * time.Minute), Name: light.name, Trait: light.trait, Payload: []byte("off")},
+		{Time: t0.Add(-time.Hour), Name: light.name, Trait: light.trait, Payload: []byte("prime")},
+		{Time: t0.Add(time.Minute), Name: light.name, Trait: light.trait, Payload: []byte("on")},
+	})
+	var now time.Time
+	store := memstore.New(memstore.WithNow(func() time.Time { return now }))
+	for _, r := range []struct {
+		at      time.Duration
+		payload string
+	}{{-10 * time.Minute, "19"}, {2 * time.Minute, "20"}, {5 * time.Minute, "21"}} {
+		now = t0.Add(r.at)
+		if _, err := store.Append(context.Background(), []byte(r.payload)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	type replayed struct {
+		At      time.Duration // wall time since the replay started
+		Name    string
+		Payload string
+	}
+	synctest.Test(t, func(t *testing.T) {
+		start := time.Now()
+		var got []replayed
+		record := func(dt deviceTrait) func([]byte) error {
+			return func(payload []byte) error {
+				got = append(got, replayed{At: time.Since(start), Name: dt.name, Payload: string(payload)})
+				return nil
+			}
+		}
+		p := &player{
+			clk:     clock.Real(),
+			speed:   60, // a recorded minute every second
+			from:    t0,
+			to:      t0.Add(4 * time.Minute),
+			sources: []source{file, &historySource{deviceTrait: temp, store: store}},
+			updates: map[deviceTrait]func([]byte) error{light: record(light), temp: record(temp)},
+			logger:  zap.NewNop(),
+		}
+		if err := p.run(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		want := []replayed{
+			{At: 0, Name: "light1", Payload: "prime"},
+			{At: 0, Name: "temp1", Payload: "19"},
+			{At: time.Second, Name: "light1", Payload: "on"},
+			{At: 2 * time.Second, Name: "temp1", Payload: "20"},
+			{At: 3 * time.Second, Name: "light1", Payload: "off"},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("replayed (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestPlayer_loop(t *testing.T) {
+	t0 := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	light := deviceTrait{name: "light1", trait: trait.OnOff}
+	file := newFileSource([]recording.Record{
+		{Time: t0, Name: light.name, Trait: light.trait, Payload: []byte("on")},
+		{Time: t0.Add(time.Minute), Name: light.name, Trait: light.trait, Payload: []byte("off")},
+	})
+	synctest.Test(t, func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		var got []string
+		p := &player{
+			clk:     clock.Real(),
+			speed:   1,
+			loop:    true,
+			sources: []source{file},
+			updates: map[deviceTrait]func([]byte) error{light: func(payload []byte) error {
+				got = append(got, string(payload))
+				if len(got) == 4 {
+					cancel()
+				}
+				return nil
+			}},
+			logger: zap.NewNop(),
+		}
+		err := p.run(ctx)
+		if err != context.Canceled {
+			t.Fatalf("run() error = %v, want %v", err, context.Canceled)
+		}
+		if diff := cmp.Diff([]string{"on", "off", "on", "off"}, got); diff != "" {
+			t.Errorf("replayed (-want +got):\n%s", diff)
+		}
+	})
+}
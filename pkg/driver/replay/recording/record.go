@@ -0,0 +1,98 @@
+// Package recording defines the capture file format shared by the proxy driver, which records trait values,
+// and the replay driver, which plays them back.
+//
+// A capture file has one JSON encoded Record per line, in the order the values were received.
+// Payloads are proto encoded trait values, the same encoding used by history records,
+// so records read from a history.Store and from a capture file are interchangeable.
+package recording
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+)
+
+// Record is a value of a device trait at a point in time.
+type Record struct {
+	Time    time.Time  `json:"time"`
+	Name    string     `json:"name"`
+	Trait   trait.Name `json:"trait"`
+	Payload []byte     `json:"payload"`
+}
+
+// Writer appends records to a capture file.
+// Writer is safe for concurrent use.
+type Writer struct {
+	mu  sync.Mutex
+	enc *json.Encoder
+	c   io.Closer
+}
+
+// Create opens the capture file at path for writing, appending to the file if it already exists.
+func Create(path string) (*Writer, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	w := NewWriter(f)
+	w.c = f
+	return w, nil
+}
+
+// NewWriter returns a Writer that writes records to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{enc: json.NewEncoder(w)}
+}
+
+// Write appends r to the capture.
+func (w *Writer) Write(r Record) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.enc.Encode(r)
+}
+
+// Close closes the underlying file, if the Writer was created using Create.
+func (w *Writer) Close() error {
+	if w.c == nil {
+		return nil
+	}
+	return w.c.Close()
+}
+
+// ReadFile reads all the records in the capture file at path.
+func ReadFile(path string) ([]Record, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Read(f)
+}
+
+// Read reads all the records from r.
+// A trailing partial line, as left if the writer was stopped mid write, is ignored.
+func Read(r io.Reader) ([]Record, error) {
+	var records []Record
+	br := bufio.NewReader(r)
+	for line := 1; ; line++ {
+		data, err := br.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return records, err
+		}
+		var rec Record
+		if err := json.Unmarshal(data, &rec); err != nil {
+			return records, fmt.Errorf("line %d: %w", line, err)
+		}
+		records = append(records, rec)
+	}
+}
@@ -0,0 +1,84 @@
+package recording
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/onoffpb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+	"github.com/smart-core-os/sc-bos/pkg/wrap"
+)
+
+func TestWriter_Read(t *testing.T) {
+	t0 := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	records := []Record{
+		{Time: t0, Name: "light1", Trait: trait.OnOff, Payload: []byte{1, 2}},
+		{Time: t0.Add(time.Second), Name: "light2", Trait: trait.OnOff, Payload: []byte{3}},
+	}
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for _, r := range records {
+		if err := w.Write(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// a partial record, as if the writer was stopped while writing
+	buf.WriteString(`{"time":"2026-10-18T09:00:02Z","na`)
+
+	got, err := Read(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(records, got); diff != "" {
+		t.Errorf("Read() (-want +got):\n%s", diff)
+	}
+}
+
+func TestTrait_CaptureServe(t *testing.T) {
+	onOff, ok := Lookup(trait.OnOff)
+	if !ok {
+		t.Fatal("OnOff not supported")
+	}
+	model := onoffpb.NewModel()
+	conn := wrap.ServerToClient(onoffpb.OnOffApi_ServiceDesc, onoffpb.NewModelServer(model))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	payloads := make(chan []byte)
+	go onOff.Capture(ctx, conn, "light1", payloads, zap.NewNop())
+
+	features, update := onOff.Serve()
+	if len(features) == 0 {
+		t.Fatal("Serve() returned no features")
+	}
+	for _, want := range []onoffpb.OnOff_State{onoffpb.OnOff_STATE_UNSPECIFIED, onoffpb.OnOff_ON, onoffpb.OnOff_OFF} {
+		if want != onoffpb.OnOff_STATE_UNSPECIFIED {
+			if _, err := model.UpdateOnOff(&onoffpb.OnOff{State: want}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		var payload []byte
+		select {
+		case payload = <-payloads:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timeout waiting for %v", want)
+		}
+		got := &onoffpb.OnOff{}
+		if err := proto.Unmarshal(payload, got); err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(&onoffpb.OnOff{State: want}, got, protocmp.Transform()); diff != "" {
+			t.Errorf("captured value (-want +got):\n%s", diff)
+		}
+		if err := update(payload); err != nil {
+			t.Errorf("update(%v) error = %v", want, err)
+		}
+	}
+}
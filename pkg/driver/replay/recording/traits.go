@@ -0,0 +1,362 @@
+package recording
+
+import (
+	"context"
+	"slices"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/proto/airqualitysensorpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/airtemperaturepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/brightnesssensorpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/electricpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/enterleavesensorpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/fanspeedpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/lightpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/meterpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/motionsensorpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/occupancysensorpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/onoffpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/soundsensorpb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+	"github.com/smart-core-os/sc-bos/pkg/util/pull"
+)
+
+// Trait describes how to capture and replay the values of a trait.
+type Trait struct {
+	Name trait.Name
+	// Capture sends the proto encoded values of the named device's trait to payloads until ctx is done.
+	// Values are pulled, falling back to polling if the device doesn't support pull, retrying on error.
+	// Consecutive equal values are only sent once.
+	Capture func(ctx context.Context, conn grpc.ClientConnInterface, name string, payloads chan<- []byte, logger *zap.Logger) error
+	// Serve returns the features that serve the trait from recorded values,
+	// and a function that updates the served value from a recorded payload.
+	Serve func() (features []node.Feature, update func(payload []byte) error)
+}
+
+var traits = make(map[trait.Name]Trait)
+
+// Lookup returns how to capture and replay the named trait, if the trait is supported.
+func Lookup(tn trait.Name) (Trait, bool) {
+	t, ok := traits[tn]
+	return t, ok
+}
+
+// Supported returns the names of all traits that can be captured and replayed, sorted.
+func Supported() []trait.Name {
+	names := make([]trait.Name, 0, len(traits))
+	for tn := range traits {
+		names = append(names, tn)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// traitFuncs are the trait specific parts of a Trait, M is the type of recorded value.
+type traitFuncs[M proto.Message] struct {
+	name  trait.Name
+	get   func(ctx context.Context, conn grpc.ClientConnInterface, name string) (M, error)
+	pull  func(ctx context.Context, conn grpc.ClientConnInterface, name string, send func(M) error) error
+	serve func() ([]node.Feature, func(M) error)
+}
+
+func register[M proto.Message](t traitFuncs[M]) {
+	traits[t.name] = Trait{
+		Name: t.name,
+		Capture: func(ctx context.Context, conn grpc.ClientConnInterface, name string, payloads chan<- []byte, logger *zap.Logger) error {
+			var last M
+			var sent bool
+			send := func(ctx context.Context, changes chan<- []byte, v M) error {
+				if sent && proto.Equal(last, v) {
+					return nil
+				}
+				payload, err := proto.Marshal(v)
+				if err != nil {
+					return err
+				}
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case changes <- payload:
+				}
+				last, sent = v, true
+				return nil
+			}
+			fetcher := pull.NewFetcher(
+				func(ctx context.Context, changes chan<- []byte) error {
+					return t.pull(ctx, conn, name, func(v M) error { return send(ctx, changes, v) })
+				},
+				func(ctx context.Context, changes chan<- []byte) error {
+					v, err := t.get(ctx, conn, name)
+					if err != nil {
+						return err
+					}
+					return send(ctx, changes, v)
+				},
+			)
+			return pull.Changes(ctx, fetcher, payloads, pull.WithLogger(logger))
+		},
+		Serve: func() ([]node.Feature, func([]byte) error) {
+			features, update := t.serve()
+			return features, func(payload []byte) error {
+				var zero M
+				v := zero.ProtoReflect().New().Interface().(M)
+				if err := proto.Unmarshal(payload, v); err != nil {
+					return err
+				}
+				return update(v)
+			}
+		},
+	}
+}
+
+// recvAll calls send with the value of each change received on stream, until stream or send fail.
+func recvAll[R, C, M any](stream grpc.ServerStreamingClient[R], err error, changes func(*R) []C, value func(C) M, send func(M) error) error {
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		for _, c := range changes(res) {
+			if err := send(value(c)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func init() {
+	register(traitFuncs[*airqualitysensorpb.AirQuality]{
+		name: trait.AirQualitySensor,
+		get: func(ctx context.Context, conn grpc.ClientConnInterface, name string) (*airqualitysensorpb.AirQuality, error) {
+			return airqualitysensorpb.NewAirQualitySensorApiClient(conn).GetAirQuality(ctx, &airqualitysensorpb.GetAirQualityRequest{Name: name})
+		},
+		pull: func(ctx context.Context, conn grpc.ClientConnInterface, name string, send func(*airqualitysensorpb.AirQuality) error) error {
+			stream, err := airqualitysensorpb.NewAirQualitySensorApiClient(conn).PullAirQuality(ctx, &airqualitysensorpb.PullAirQualityRequest{Name: name})
+			return recvAll(stream, err, (*airqualitysensorpb.PullAirQualityResponse).GetChanges, (*airqualitysensorpb.PullAirQualityResponse_Change).GetAirQuality, send)
+		},
+		serve: func() ([]node.Feature, func(*airqualitysensorpb.AirQuality) error) {
+			model := airqualitysensorpb.NewModel()
+			return []node.Feature{
+				node.HasServer(airqualitysensorpb.RegisterAirQualitySensorApiServer, airqualitysensorpb.AirQualitySensorApiServer(airqualitysensorpb.NewModelServer(model))),
+			}, func(v *airqualitysensorpb.AirQuality) error {
+				_, err := model.UpdateAirQuality(v)
+				return err
+			}
+		},
+	})
+	register(traitFuncs[*airtemperaturepb.AirTemperature]{
+		name: trait.AirTemperature,
+		get: func(ctx context.Context, conn grpc.ClientConnInterface, name string) (*airtemperaturepb.AirTemperature, error) {
+			return airtemperaturepb.NewAirTemperatureApiClient(conn).GetAirTemperature(ctx, &airtemperaturepb.GetAirTemperatureRequest{Name: name})
+		},
+		pull: func(ctx context.Context, conn grpc.ClientConnInterface, name string, send func(*airtemperaturepb.AirTemperature) error) error {
+			stream, err := airtemperaturepb.NewAirTemperatureApiClient(conn).PullAirTemperature(ctx, &airtemperaturepb.PullAirTemperatureRequest{Name: name})
+			return recvAll(stream, err, (*airtemperaturepb.PullAirTemperatureResponse).GetChanges, (*airtemperaturepb.PullAirTemperatureResponse_Change).GetAirTemperature, send)
+		},
+		serve: func() ([]node.Feature, func(*airtemperaturepb.AirTemperature) error) {
+			model := airtemperaturepb.NewModel()
+			return []node.Feature{
+				node.HasServer(airtemperaturepb.RegisterAirTemperatureApiServer, airtemperaturepb.AirTemperatureApiServer(airtemperaturepb.NewModelServer(model))),
+			}, func(v *airtemperaturepb.AirTemperature) error {
+				_, err := model.UpdateAirTemperature(v)
+				return err
+			}
+		},
+	})
+	register(traitFuncs[*brightnesssensorpb.AmbientBrightness]{
+		name: trait.BrightnessSensor,
+		get: func(ctx context.Context, conn grpc.ClientConnInterface, name string) (*brightnesssensorpb.AmbientBrightness, error) {
+			return brightnesssensorpb.NewBrightnessSensorApiClient(conn).GetAmbientBrightness(ctx, &brightnesssensorpb.GetAmbientBrightnessRequest{Name: name})
+		},
+		pull: func(ctx context.Context, conn grpc.ClientConnInterface, name string, send func(*brightnesssensorpb.AmbientBrightness) error) error {
+			stream, err := brightnesssensorpb.NewBrightnessSensorApiClient(conn).PullAmbientBrightness(ctx, &brightnesssensorpb.PullAmbientBrightnessRequest{Name: name})
+			return recvAll(stream, err, (*brightnesssensorpb.PullAmbientBrightnessResponse).GetChanges, (*brightnesssensorpb.PullAmbientBrightnessResponse_Change).GetAmbientBrightness, send)
+		},
+		serve: func() ([]node.Feature, func(*brightnesssensorpb.AmbientBrightness) error) {
+			model := brightnesssensorpb.NewModel()
+			return []node.Feature{
+				node.HasServer(brightnesssensorpb.RegisterBrightnessSensorApiServer, brightnesssensorpb.BrightnessSensorApiServer(brightnesssensorpb.NewModelServer(model))),
+			}, func(v *brightnesssensorpb.AmbientBrightness) error {
+				_, err := model.UpdateAmbientBrightness(v)
+				return err
+			}
+		},
+	})
+	register(traitFuncs[*electricpb.ElectricDemand]{
+		name: trait.Electric,
+		get: func(ctx context.Context, conn grpc.ClientConnInterface, name string) (*electricpb.ElectricDemand, error) {
+			return electricpb.NewElectricApiClient(conn).GetDemand(ctx, &electricpb.GetDemandRequest{Name: name})
+		},
+		pull: func(ctx context.Context, conn grpc.ClientConnInterface, name string, send func(*electricpb.ElectricDemand) error) error {
+			stream, err := electricpb.NewElectricApiClient(conn).PullDemand(ctx, &electricpb.PullDemandRequest{Name: name})
+			return recvAll(stream, err, (*electricpb.PullDemandResponse).GetChanges, (*electricpb.PullDemandResponse_Change).GetDemand, send)
+		},
+		serve: func() ([]node.Feature, func(*electricpb.ElectricDemand) error) {
+			model := electricpb.NewModel()
+			return []node.Feature{
+				node.HasServer(electricpb.RegisterElectricApiServer, electricpb.ElectricApiServer(electricpb.NewModelServer(model))),
+			}, func(v *electricpb.ElectricDemand) error {
+				_, err := model.UpdateDemand(v)
+				return err
+			}
+		},
+	})
+	register(traitFuncs[*enterleavesensorpb.EnterLeaveEvent]{
+		name: trait.EnterLeaveSensor,
+		get: func(ctx context.Context, conn grpc.ClientConnInterface, name string) (*enterleavesensorpb.EnterLeaveEvent, error) {
+			return enterleavesensorpb.NewEnterLeaveSensorApiClient(conn).GetEnterLeaveEvent(ctx, &enterleavesensorpb.GetEnterLeaveEventRequest{Name: name})
+		},
+		pull: func(ctx context.Context, conn grpc.ClientConnInterface, name string, send func(*enterleavesensorpb.EnterLeaveEvent) error) error {
+			stream, err := enterleavesensorpb.NewEnterLeaveSensorApiClient(conn).PullEnterLeaveEvents(ctx, &enterleavesensorpb.PullEnterLeaveEventsRequest{Name: name})
+			return recvAll(stream, err, (*enterleavesensorpb.PullEnterLeaveEventsResponse).GetChanges, (*enterleavesensorpb.PullEnterLeaveEventsResponse_Change).GetEnterLeaveEvent, send)
+		},
+		serve: func() ([]node.Feature, func(*enterleavesensorpb.EnterLeaveEvent) error) {
+			model := enterleavesensorpb.NewModel()
+			return []node.Feature{
+				node.HasServer(enterleavesensorpb.RegisterEnterLeaveSensorApiServer, enterleavesensorpb.EnterLeaveSensorApiServer(enterleavesensorpb.NewModelServer(model))),
+			}, func(v *enterleavesensorpb.EnterLeaveEvent) error {
+				return model.CreateEnterLeaveEvent(v)
+			}
+		},
+	})
+	register(traitFuncs[*fanspeedpb.FanSpeed]{
+		name: trait.FanSpeed,
+		get: func(ctx context.Context, conn grpc.ClientConnInterface, name string) (*fanspeedpb.FanSpeed, error) {
+			return fanspeedpb.NewFanSpeedApiClient(conn).GetFanSpeed(ctx, &fanspeedpb.GetFanSpeedRequest{Name: name})
+		},
+		pull: func(ctx context.Context, conn grpc.ClientConnInterface, name string, send func(*fanspeedpb.FanSpeed) error) error {
+			stream, err := fanspeedpb.NewFanSpeedApiClient(conn).PullFanSpeed(ctx, &fanspeedpb.PullFanSpeedRequest{Name: name})
+			return recvAll(stream, err, (*fanspeedpb.PullFanSpeedResponse).GetChanges, (*fanspeedpb.PullFanSpeedResponse_Change).GetFanSpeed, send)
+		},
+		serve: func() ([]node.Feature, func(*fanspeedpb.FanSpeed) error) {
+			model := fanspeedpb.NewModel()
+			return []node.Feature{
+				node.HasServer(fanspeedpb.RegisterFanSpeedApiServer, fanspeedpb.FanSpeedApiServer(fanspeedpb.NewModelServer(model))),
+			}, func(v *fanspeedpb.FanSpeed) error {
+				_, err := model.UpdateFanSpeed(v)
+				return err
+			}
+		},
+	})
+	register(traitFuncs[*lightpb.Brightness]{
+		name: trait.Light,
+		get: func(ctx context.Context, conn grpc.ClientConnInterface, name string) (*lightpb.Brightness, error) {
+			return lightpb.NewLightApiClient(conn).GetBrightness(ctx, &lightpb.GetBrightnessRequest{Name: name})
+		},
+		pull: func(ctx context.Context, conn grpc.ClientConnInterface, name string, send func(*lightpb.Brightness) error) error {
+			stream, err := lightpb.NewLightApiClient(conn).PullBrightness(ctx, &lightpb.PullBrightnessRequest{Name: name})
+			return recvAll(stream, err, (*lightpb.PullBrightnessResponse).GetChanges, (*lightpb.PullBrightnessResponse_Change).GetBrightness, send)
+		},
+		serve: func() ([]node.Feature, func(*lightpb.Brightness) error) {
+			model := lightpb.NewModel()
+			return []node.Feature{
+				node.HasServer(lightpb.RegisterLightApiServer, lightpb.LightApiServer(lightpb.NewModelServer(model))),
+			}, func(v *lightpb.Brightness) error {
+				_, err := model.UpdateBrightness(v)
+				return err
+			}
+		},
+	})
+	register(traitFuncs[*meterpb.MeterReading]{
+		name: meterpb.TraitName,
+		get: func(ctx context.Context, conn grpc.ClientConnInterface, name string) (*meterpb.MeterReading, error) {
+			return meterpb.NewMeterApiClient(conn).GetMeterReading(ctx, &meterpb.GetMeterReadingRequest{Name: name})
+		},
+		pull: func(ctx context.Context, conn grpc.ClientConnInterface, name string, send func(*meterpb.MeterReading) error) error {
+			stream, err := meterpb.NewMeterApiClient(conn).PullMeterReadings(ctx, &meterpb.PullMeterReadingsRequest{Name: name})
+			return recvAll(stream, err, (*meterpb.PullMeterReadingsResponse).GetChanges, (*meterpb.PullMeterReadingsResponse_Change).GetMeterReading, send)
+		},
+		serve: func() ([]node.Feature, func(*meterpb.MeterReading) error) {
+			model := meterpb.NewModel()
+			return []node.Feature{
+				node.HasServer(meterpb.RegisterMeterApiServer, meterpb.MeterApiServer(meterpb.NewModelServer(model))),
+			}, func(v *meterpb.MeterReading) error {
+				_, err := model.UpdateMeterReading(v)
+				return err
+			}
+		},
+	})
+	register(traitFuncs[*motionsensorpb.MotionDetection]{
+		name: trait.MotionSensor,
+		get: func(ctx context.Context, conn grpc.ClientConnInterface, name string) (*motionsensorpb.MotionDetection, error) {
+			return motionsensorpb.NewMotionSensorApiClient(conn).GetMotionDetection(ctx, &motionsensorpb.GetMotionDetectionRequest{Name: name})
+		},
+		pull: func(ctx context.Context, conn grpc.ClientConnInterface, name string, send func(*motionsensorpb.MotionDetection) error) error {
+			stream, err := motionsensorpb.NewMotionSensorApiClient(conn).PullMotionDetections(ctx, &motionsensorpb.PullMotionDetectionRequest{Name: name})
+			return recvAll(stream, err, (*motionsensorpb.PullMotionDetectionResponse).GetChanges, (*motionsensorpb.PullMotionDetectionResponse_Change).GetMotionDetection, send)
+		},
+		serve: func() ([]node.Feature, func(*motionsensorpb.MotionDetection) error) {
+			model := motionsensorpb.NewModel()
+			return []node.Feature{
+				node.HasServer(motionsensorpb.RegisterMotionSensorApiServer, motionsensorpb.MotionSensorApiServer(motionsensorpb.NewModelServer(model))),
+			}, func(v *motionsensorpb.MotionDetection) error {
+				_, err := model.SetMotionDetection(v)
+				return err
+			}
+		},
+	})
+	register(traitFuncs[*occupancysensorpb.Occupancy]{
+		name: trait.OccupancySensor,
+		get: func(ctx context.Context, conn grpc.ClientConnInterface, name string) (*occupancysensorpb.Occupancy, error) {
+			return occupancysensorpb.NewOccupancySensorApiClient(conn).GetOccupancy(ctx, &occupancysensorpb.GetOccupancyRequest{Name: name})
+		},
+		pull: func(ctx context.Context, conn grpc.ClientConnInterface, name string, send func(*occupancysensorpb.Occupancy) error) error {
+			stream, err := occupancysensorpb.NewOccupancySensorApiClient(conn).PullOccupancy(ctx, &occupancysensorpb.PullOccupancyRequest{Name: name})
+			return recvAll(stream, err, (*occupancysensorpb.PullOccupancyResponse).GetChanges, (*occupancysensorpb.PullOccupancyResponse_Change).GetOccupancy, send)
+		},
+		serve: func() ([]node.Feature, func(*occupancysensorpb.Occupancy) error) {
+			model := occupancysensorpb.NewModel()
+			return []node.Feature{
+				node.HasServer(occupancysensorpb.RegisterOccupancySensorApiServer, occupancysensorpb.OccupancySensorApiServer(occupancysensorpb.NewModelServer(model))),
+			}, func(v *occupancysensorpb.Occupancy) error {
+				_, err := model.SetOccupancy(v)
+				return err
+			}
+		},
+	})
+	register(traitFuncs[*onoffpb.OnOff]{
+		name: trait.OnOff,
+		get: func(ctx context.Context, conn grpc.ClientConnInterface, name string) (*onoffpb.OnOff, error) {
+			return onoffpb.NewOnOffApiClient(conn).GetOnOff(ctx, &onoffpb.GetOnOffRequest{Name: name})
+		},
+		pull: func(ctx context.Context, conn grpc.ClientConnInterface, name string, send func(*onoffpb.OnOff) error) error {
+			stream, err := onoffpb.NewOnOffApiClient(conn).PullOnOff(ctx, &onoffpb.PullOnOffRequest{Name: name})
+			return recvAll(stream, err, (*onoffpb.PullOnOffResponse).GetChanges, (*onoffpb.PullOnOffResponse_Change).GetOnOff, send)
+		},
+		serve: func() ([]node.Feature, func(*onoffpb.OnOff) error) {
+			model := onoffpb.NewModel()
+			return []node.Feature{
+				node.HasServer(onoffpb.RegisterOnOffApiServer, onoffpb.OnOffApiServer(onoffpb.NewModelServer(model))),
+			}, func(v *onoffpb.OnOff) error {
+				_, err := model.UpdateOnOff(v)
+				return err
+			}
+		},
+	})
+	register(traitFuncs[*soundsensorpb.SoundLevel]{
+		name: soundsensorpb.TraitName,
+		get: func(ctx context.Context, conn grpc.ClientConnInterface, name string) (*soundsensorpb.SoundLevel, error) {
+			return soundsensorpb.NewSoundSensorApiClient(conn).GetSoundLevel(ctx, &soundsensorpb.GetSoundLevelRequest{Name: name})
+		},
+		pull: func(ctx context.Context, conn grpc.ClientConnInterface, name string, send func(*soundsensorpb.SoundLevel) error) error {
+			stream, err := soundsensorpb.NewSoundSensorApiClient(conn).PullSoundLevel(ctx, &soundsensorpb.PullSoundLevelRequest{Name: name})
+			return recvAll(stream, err, (*soundsensorpb.PullSoundLevelResponse).GetChanges, (*soundsensorpb.PullSoundLevelResponse_Change).GetSoundLevel, send)
+		},
+		serve: func() ([]node.Feature, func(*soundsensorpb.SoundLevel) error) {
+			model := soundsensorpb.NewModel()
+			return []node.Feature{
+				node.HasServer(soundsensorpb.RegisterSoundSensorApiServer, soundsensorpb.SoundSensorApiServer(soundsensorpb.NewModelServer(model))),
+			}, func(v *soundsensorpb.SoundLevel) error {
+				_, err := model.UpdateSoundLevel(v)
+				return err
+			}
+		},
+	})
+}
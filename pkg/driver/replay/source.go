@@ -0,0 +1,213 @@
+package replay
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/replay/recording"
+	"github.com/smart-core-os/sc-bos/pkg/history"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+)
+
+// source is a time ordered sequence of recorded values.
+type source interface {
+	// open returns the latest record of each device trait before from,
+	// and a cursor over the records >= from and < to.
+	// A zero from or to leaves that end of the period unbounded.
+	open(ctx context.Context, from, to time.Time) (prime []recording.Record, c cursor, err error)
+}
+
+// cursor reads records in time order.
+type cursor interface {
+	// next returns the next record, or false if there are no more records.
+	next(ctx context.Context) (recording.Record, bool, error)
+}
+
+// fileSource replays the records of a capture file, which are held in memory.
+type fileSource struct {
+	records []recording.Record // sorted by time
+}
+
+func newFileSource(records []recording.Record) *fileSource {
+	// values are written to capture files as they are received, which may be slightly out of order
+	records = slices.Clone(records)
+	slices.SortStableFunc(records, func(a, b recording.Record) int {
+		return a.Time.Compare(b.Time)
+	})
+	return &fileSource{records: records}
+}
+
+func (s *fileSource) open(_ context.Context, from, to time.Time) ([]recording.Record, cursor, error) {
+	start, _ := slices.BinarySearchFunc(s.records, from, func(r recording.Record, t time.Time) int {
+		return r.Time.Compare(t)
+	})
+	end := len(s.records)
+	if !to.IsZero() {
+		end, _ = slices.BinarySearchFunc(s.records, to, func(r recording.Record, t time.Time) int {
+			return r.Time.Compare(t)
+		})
+	}
+	latest := make(map[deviceTrait]recording.Record)
+	for _, r := range s.records[:start] {
+		latest[deviceTrait{r.Name, r.Trait}] = r
+	}
+	var prime []recording.Record
+	for _, r := range latest {
+		prime = append(prime, r)
+	}
+	return prime, &sliceCursor{records: s.records[start:max(start, end)]}, nil
+}
+
+// deviceTraits returns the distinct device traits recorded in the file, in the order they first appear.
+func (s *fileSource) deviceTraits() []deviceTrait {
+	seen := make(map[deviceTrait]bool)
+	var res []deviceTrait
+	for _, r := range s.records {
+		dt := deviceTrait{r.Name, r.Trait}
+		if !seen[dt] {
+			seen[dt] = true
+			res = append(res, dt)
+		}
+	}
+	return res
+}
+
+type sliceCursor struct {
+	records []recording.Record
+}
+
+func (c *sliceCursor) next(context.Context) (recording.Record, bool, error) {
+	if len(c.records) == 0 {
+		return recording.Record{}, false, nil
+	}
+	r := c.records[0]
+	c.records = c.records[1:]
+	return r, true, nil
+}
+
+// historySource replays the records of a single device trait from a history store.
+type historySource struct {
+	deviceTrait
+	store history.Slice
+}
+
+func (s *historySource) open(ctx context.Context, from, to time.Time) ([]recording.Record, cursor, error) {
+	fromRecord, toRecord := history.Record{CreateTime: from}, history.Record{CreateTime: to}
+	var prime []recording.Record
+	if !from.IsZero() {
+		last := make([]history.Record, 1)
+		n, err := s.store.Slice(history.Record{}, fromRecord).ReadDesc(ctx, last)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s[%s]: %w", s.name, s.trait, err)
+		}
+		if n > 0 {
+			prime = append(prime, s.record(last[0]))
+		}
+	}
+	return prime, &historyCursor{src: s, slice: s.store.Slice(fromRecord, toRecord), to: toRecord}, nil
+}
+
+func (s *historySource) record(r history.Record) recording.Record {
+	return recording.Record{Time: r.CreateTime, Name: s.name, Trait: s.trait, Payload: r.Payload}
+}
+
+// historyPageSize is the number of history records read at a time.
+const historyPageSize = 100
+
+// historyCursor reads records from a history store a page at a time.
+type historyCursor struct {
+	src   *historySource
+	slice history.Slice // the records not yet read
+	to    history.Record
+	page  []history.Record
+	done  bool
+}
+
+func (c *historyCursor) next(ctx context.Context) (recording.Record, bool, error) {
+	if len(c.page) == 0 && !c.done {
+		// Read one more than we need, the extra record is the start of the next page.
+		page := make([]history.Record, historyPageSize+1)
+		n, err := c.slice.Read(ctx, page)
+		if err != nil {
+			return recording.Record{}, false, fmt.Errorf("%s[%s]: %w", c.src.name, c.src.trait, err)
+		}
+		if n <= historyPageSize {
+			c.page, c.done = page[:n], true
+		} else {
+			c.page = page[:historyPageSize]
+			c.slice = c.src.store.Slice(page[historyPageSize], c.to)
+		}
+	}
+	if len(c.page) == 0 {
+		return recording.Record{}, false, nil
+	}
+	r := c.page[0]
+	c.page = c.page[1:]
+	return c.src.record(r), true, nil
+}
+
+// mergeCursor merges the records of many cursors into a single time ordered sequence.
+type mergeCursor struct {
+	heads cursorHeap
+	init  []cursor // cursors that haven't been read from yet
+}
+
+func newMergeCursor(cursors ...cursor) *mergeCursor {
+	return &mergeCursor{init: cursors}
+}
+
+func (m *mergeCursor) next(ctx context.Context) (recording.Record, bool, error) {
+	for _, c := range m.init {
+		if err := m.push(ctx, c); err != nil {
+			return recording.Record{}, false, err
+		}
+	}
+	m.init = nil
+	if len(m.heads) == 0 {
+		return recording.Record{}, false, nil
+	}
+	h := heap.Pop(&m.heads).(cursorHead)
+	if err := m.push(ctx, h.c); err != nil {
+		return recording.Record{}, false, err
+	}
+	return h.r, true, nil
+}
+
+// push reads the next record from c onto the heap, if c has more records.
+func (m *mergeCursor) push(ctx context.Context, c cursor) error {
+	r, ok, err := c.next(ctx)
+	if err != nil {
+		return err
+	}
+	if ok {
+		heap.Push(&m.heads, cursorHead{r: r, c: c})
+	}
+	return nil
+}
+
+type cursorHead struct {
+	r recording.Record
+	c cursor
+}
+
+type cursorHeap []cursorHead
+
+func (h cursorHeap) Len() int           { return len(h) }
+func (h cursorHeap) Less(i, j int) bool { return h[i].r.Time.Before(h[j].r.Time) }
+func (h cursorHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *cursorHeap) Push(x any)        { *h = append(*h, x.(cursorHead)) }
+func (h *cursorHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// deviceTrait identifies a replayed trait of a device.
+type deviceTrait struct {
+	name  string
+	trait trait.Name
+}
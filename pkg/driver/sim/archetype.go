@@ -54,6 +54,9 @@ type Device struct {
 	Name     string
 	Metadata *metadatapb.Metadata
 	Features []node.Feature
+
+	typ  string // the archetype type the device was expanded from
+	room *Room  // the room the device is in, nil for building-level devices
 }
 
 // Expand turns the building config into a coupled simulation engine plus the list
@@ -122,6 +125,9 @@ func Expand(cfg config.Root, scaler scale.Time, start time.Time) (*Building, []D
 		}
 		devices = append(devices, ds...)
 	}
+	if err := b.scenarios.configure(cfg, b, devices); err != nil {
+		return nil, nil, err
+	}
 	return b, devices, nil
 }
 
@@ -148,7 +154,7 @@ func expandArchetype(prefix string, b *Building, ctrl *controller, fc config.Flo
 			return nil, err
 		}
 		for _, u := range updaters {
-			b.AddUpdater(u)
+			b.addDeviceUpdater(name, u)
 		}
 		// A forceable device (lighting, fcu, occupancy) exposes the MockDeviceApi so
 		// its room input can be driven live; the engine then responds via the coupling.
@@ -160,6 +166,8 @@ func expandArchetype(prefix string, b *Building, ctrl *controller, fc config.Flo
 			Name:     name,
 			Metadata: deviceMetadata(name, deviceTitle(a, n), subsystem, fc, rc),
 			Features: features,
+			typ:      a.Type,
+			room:     room,
 		})
 	}
 	return devices, nil
@@ -476,7 +484,7 @@ func buildElectric(_ config.Archetype, _ *Room) (features []node.Feature, update
 	voltagePtr, pfPtr := ptr(voltage), ptr(pf)
 	reactiveFactor := float32(math.Sqrt(float64(1 - pf*pf)))
 	updaters = []Updater{updaterFunc(func(now time.Time, b *Building) {
-		real := float32(b.DemandW)
+		real := float32(b.MeteredDemandW())
 		apparent := real / pf
 		current := apparent / voltage
 		reactive := apparent * reactiveFactor
@@ -32,7 +32,13 @@ type Building struct {
 	meterFrom time.Time // start of the metering period
 	day       float64   // outdoor daylight factor (0..1), recomputed each Tick
 
-	updaters []Updater
+	updaters []deviceUpdater
+
+	// Faults injected by running scenarios, recomputed each Tick (see applyScenarios).
+	meterGain     float64         // multiplier applied to metered demand and energy
+	baseUnpowered bool            // the base load is off, as in a building wide power cut
+	stuck         map[string]bool // devices whose published values are frozen
+	scenarios     *scenarios
 
 	// cmds carries override mutations from the MockDeviceApi onto the engine
 	// goroutine; Tick drains them before advancing, so all Room state continues to
@@ -61,6 +67,13 @@ type Room struct {
 	EnterTotal int64
 	LeaveTotal int64
 
+	// Faults injected by running scenarios, recomputed each Tick. Unlike the
+	// overrides below these take precedence over everything else: an evacuated
+	// room stays empty even if its occupancy is forced.
+	fanFailed bool
+	evacuated bool
+	unpowered bool
+
 	// Overrides set via the MockDeviceApi. While non-nil the engine uses the
 	// override in place of the simulated input and the coupled state responds to
 	// it (e.g. a forced occupancy still drives lighting, FCU load, CO2 and power).
@@ -96,8 +109,11 @@ func NewBuilding(start time.Time, scaler scale.Time, baseLoadW float64, seed int
 		rng:       rand.New(rand.NewSource(seed)),
 		Floors:    floors,
 		meterFrom: start,
+		meterGain: 1,
+		stuck:     make(map[string]bool),
 		cmds:      make(chan func(), 64),
 	}
+	b.scenarios = newScenarios(start)
 	work := scaler.At(start)
 	day := daylight(start)
 	b.day = day
@@ -113,7 +129,18 @@ func NewBuilding(start time.Time, scaler scale.Time, baseLoadW float64, seed int
 
 // AddUpdater registers u to be invoked after each Tick.
 func (b *Building) AddUpdater(u Updater) {
-	b.updaters = append(b.updaters, u)
+	b.addDeviceUpdater("", u)
+}
+
+// deviceUpdater is an Updater publishing to the named device.
+type deviceUpdater struct {
+	device string
+	Updater
+}
+
+// addDeviceUpdater registers u to be invoked after each Tick, unless a scenario has the device stuck.
+func (b *Building) addDeviceUpdater(device string, u Updater) {
+	b.updaters = append(b.updaters, deviceUpdater{device: device, Updater: u})
 }
 
 // submit enqueues fn to run on the engine goroutine at the start of the next Tick.
@@ -147,10 +174,14 @@ func (b *Building) drainCommands() {
 // targets are approached by zero and the meter does not accumulate.
 func (b *Building) Tick(now time.Time, dt time.Duration) {
 	b.drainCommands()
+	b.applyScenarios(now)
 	work := b.scaler.At(now)
 	b.day = daylight(now)
 
 	b.DemandW = b.baseLoadW
+	if b.baseUnpowered {
+		b.DemandW = 0
+	}
 	for _, f := range b.Floors {
 		for _, r := range f.Rooms {
 			r.tick(work, b.day, dt, b.rng)
@@ -158,17 +189,29 @@ func (b *Building) Tick(now time.Time, dt time.Duration) {
 		}
 	}
 	if dt > 0 {
-		b.MeterKWh += b.DemandW / 1000 * dt.Hours()
+		b.MeterKWh += b.MeteredDemandW() / 1000 * dt.Hours()
 	}
 }
 
+// MeteredDemandW is the demand as reported by the building's meters,
+// which differs from DemandW while a scenario has the meters drifting.
+func (b *Building) MeteredDemandW() float64 {
+	return b.DemandW * b.meterGain
+}
+
 // Publish invokes every registered updater with the current state.
 func (b *Building) Publish(now time.Time) {
 	for _, u := range b.updaters {
+		if b.stuck[u.device] {
+			continue
+		}
 		u.Update(now, b)
 	}
 }
 
+// unconditionedOffsetC is how far above the set point a room drifts when its FCUs have failed.
+const unconditionedOffsetC = 3
+
 // roomTargets computes the steady-state values a room tends toward for the given
 // time-of-day work factor (0..1), outdoor daylight factor (0..1) and effective
 // temperature set point.
@@ -180,7 +223,7 @@ func roomTargets(r *Room, work, day, setPoint float64) (light, fan, temp, co2 fl
 
 	// Lights: on when the room is occupied or during core hours; dimmer when there's
 	// more daylight coming through the windows.
-	if r.Occupants > 0 || work > 0.3 {
+	if (r.Occupants > 0 || work > 0.3) && !r.unpowered {
 		light = 85 - day*30
 		if light < 20 {
 			light = 20
@@ -188,7 +231,7 @@ func roomTargets(r *Room, work, day, setPoint float64) (light, fan, temp, co2 fl
 	}
 
 	// FCU: idles at a low baseline while the building is open, ramping with occupancy.
-	if work > 0 || r.Occupants > 0 {
+	if (work > 0 || r.Occupants > 0) && !r.fcuFailed() {
 		fan = 20 + occRatio*80
 	}
 
@@ -198,6 +241,10 @@ func roomTargets(r *Room, work, day, setPoint float64) (light, fan, temp, co2 fl
 	heat := occRatio * 4     // occupant heat gain, up to +4°C at full occupancy
 	cooling := fan / 100 * 2 // the fan removes up to 2°C
 	temp = setPoint + max(0, heat-cooling)
+	if r.fcuFailed() {
+		// Without its FCU the room is no longer conditioned and drifts away from the set point.
+		temp = setPoint + unconditionedOffsetC + heat
+	}
 
 	// CO2: ~420ppm baseline outdoors, rising with how full the room is.
 	co2 = 420 + occRatio*1200
@@ -220,7 +267,10 @@ func (r *Room) reset(work, day float64) {
 // the other targets depend on) and tracking enter/leave totals.
 func (r *Room) tick(work, day float64, dt time.Duration, rng *rand.Rand) {
 	prev := r.Occupants
-	if r.occupancyOverride != nil {
+	if r.evacuated {
+		r.Occupants = 0
+		r.occupancyF = 0
+	} else if r.occupancyOverride != nil {
 		// Held occupancy: skip the organic drift and pin the count to the forced
 		// value. The other targets below still derive from it, so the rest of the
 		// room (and the building aggregates) respond to the forced occupancy.
@@ -261,14 +311,20 @@ func (r *Room) tick(work, day float64, dt time.Duration, rng *rand.Rand) {
 	// A forced light/fan pins the actuator directly; otherwise it eases toward the
 	// occupancy-driven target. Either way the loads (and thus building demand and
 	// metered energy) recompute from the resulting levels below.
-	if r.lightOverride != nil {
+	switch {
+	case r.unpowered:
+		r.LightLevel = 0
+	case r.lightOverride != nil:
 		r.LightLevel = *r.lightOverride
-	} else {
+	default:
 		r.LightLevel = approach(r.LightLevel, light, 1.0, dt)
 	}
-	if r.fanOverride != nil {
+	switch {
+	case r.fcuFailed():
+		r.FanPct = 0
+	case r.fanOverride != nil:
 		r.FanPct = *r.fanOverride
-	} else {
+	default:
 		r.FanPct = approach(r.FanPct, fan, 0.5, dt)
 	}
 	r.TempC = approach(r.TempC, temp, 0.3, dt)
@@ -276,6 +332,11 @@ func (r *Room) tick(work, day float64, dt time.Duration, rng *rand.Rand) {
 	r.recomputeLoads()
 }
 
+// fcuFailed reports whether the room's FCUs are stopped by a scenario fault.
+func (r *Room) fcuFailed() bool {
+	return r.fanFailed || r.unpowered
+}
+
 func (r *Room) recomputeLoads() {
 	r.lightsW = r.LightLevel / 100 * r.ratedLightingW
 	r.fcuW = r.FanPct / 100 * r.ratedFcuW
@@ -52,6 +52,10 @@ type Root struct {
 
 	// HealthCheck optionally simulates driver-level connectivity faults.
 	HealthCheck *HealthCheck `json:"healthCheck,omitempty"`
+
+	// Scenarios are scripted sequences of device faults and building events,
+	// started on driver start (AutoStart) or via the SimDriverService.
+	Scenarios []Scenario `json:"scenarios,omitempty"`
 }
 
 // WorkingHours describes the building's occupied period.
@@ -105,6 +109,55 @@ type HealthCheck struct {
 	FaultProbability *float64 `json:"faultProbability,omitempty"`
 }
 
+// Scenario is a named, timed sequence of events injected into the simulation.
+type Scenario struct {
+	Name  string `json:"name"`
+	Title string `json:"title,omitempty"`
+	// AutoStart starts the scenario when the driver starts.
+	AutoStart bool    `json:"autoStart,omitempty"`
+	Events    []Event `json:"events,omitempty"`
+}
+
+// Supported event types.
+const (
+	// EventFanFailure stops the FCU fans in a room, which then drifts away from its set point.
+	// Requires Device (an fcu device) or Floor and Room.
+	EventFanFailure = "fanFailure"
+	// EventMeterDrift makes the meter and electric devices over (or under) report by DriftPctPerHour,
+	// growing for as long as the event lasts.
+	EventMeterDrift = "meterDrift"
+	// EventSensorStuck freezes the values reported by Device.
+	EventSensorStuck = "sensorStuck"
+	// EventEvacuate empties a floor, or a single room if Room is set, as in a fire alarm. Requires Floor.
+	EventEvacuate = "evacuate"
+	// EventPowerCut turns off lighting, FCUs and the base load. Set Floor to cut power to a single floor,
+	// which leaves the base load running.
+	EventPowerCut = "powerCut"
+)
+
+// Event is a single timed change to the simulation.
+type Event struct {
+	Type string `json:"type"`
+	// At is the simulated time after the scenario starts that the event starts. Defaults to 0.
+	At *jsontypes.Duration `json:"at,omitempty"`
+	// AtTime is the simulated time of day the event starts, like "10:00", instead of At.
+	// The event starts the first time the simulated clock reaches AtTime after the scenario starts.
+	AtTime string `json:"atTime,omitempty"`
+	// For is how long, in simulated time, the event lasts.
+	// If absent the event lasts until the scenario is stopped.
+	For *jsontypes.Duration `json:"for,omitempty"`
+
+	// Device is the name of the device the event applies to, with or without the NamePrefix.
+	Device string `json:"device,omitempty"`
+	// Floor and Room select the space the event applies to.
+	Floor string `json:"floor,omitempty"`
+	Room  string `json:"room,omitempty"`
+
+	// DriftPctPerHour is the rate meter readings drift by, for meterDrift events.
+	// Negative values under report.
+	DriftPctPerHour float64 `json:"driftPctPerHour,omitempty"`
+}
+
 // Defaults applied during normalisation.
 const (
 	DefaultTimeMultiplier = 1.0
@@ -174,9 +227,101 @@ func (r *Root) Validate() error {
 			roomNames[room.Name] = true
 		}
 	}
+	scenarioNames := make(map[string]bool, len(r.Scenarios))
+	for si, s := range r.Scenarios {
+		if s.Name == "" {
+			return fmt.Errorf("scenarios[%d] has no name", si)
+		}
+		if scenarioNames[s.Name] {
+			return fmt.Errorf("duplicate scenario name %q", s.Name)
+		}
+		scenarioNames[s.Name] = true
+		for ei, e := range s.Events {
+			if err := r.validateEvent(e); err != nil {
+				return fmt.Errorf("scenario %q events[%d]: %w", s.Name, ei, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *Root) validateEvent(e Event) error {
+	if e.At != nil && e.At.Duration < 0 {
+		return fmt.Errorf("at must not be negative, got %s", e.At.Duration)
+	}
+	if e.AtTime != "" {
+		if e.At != nil {
+			return fmt.Errorf("at and atTime can't both be set")
+		}
+		if _, err := e.TimeOfDay(); err != nil {
+			return err
+		}
+	}
+	if e.For != nil && e.For.Duration <= 0 {
+		return fmt.Errorf("for must be positive, got %s", e.For.Duration)
+	}
+	if e.Room != "" && e.Floor == "" {
+		return fmt.Errorf("room %q needs a floor", e.Room)
+	}
+	if e.Floor != "" && !r.hasSpace(e.Floor, e.Room) {
+		if e.Room != "" {
+			return fmt.Errorf("unknown room %q on floor %q", e.Room, e.Floor)
+		}
+		return fmt.Errorf("unknown floor %q", e.Floor)
+	}
+	switch e.Type {
+	case EventFanFailure:
+		if e.Device == "" && e.Room == "" {
+			return fmt.Errorf("%s needs a device or a floor and room", e.Type)
+		}
+	case EventSensorStuck:
+		if e.Device == "" {
+			return fmt.Errorf("%s needs a device", e.Type)
+		}
+	case EventEvacuate:
+		if e.Floor == "" {
+			return fmt.Errorf("%s needs a floor", e.Type)
+		}
+	case EventMeterDrift:
+		if e.DriftPctPerHour == 0 {
+			return fmt.Errorf("%s needs a driftPctPerHour", e.Type)
+		}
+	case EventPowerCut:
+	case "":
+		return fmt.Errorf("no type")
+	default:
+		return fmt.Errorf("unknown type %q", e.Type)
+	}
 	return nil
 }
 
+// TimeOfDay returns AtTime as the time since midnight.
+func (e Event) TimeOfDay() (time.Duration, error) {
+	t, err := time.Parse("15:04", e.AtTime)
+	if err != nil {
+		return 0, fmt.Errorf("atTime %q is not a time of day like 10:00", e.AtTime)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// hasSpace returns whether the named floor, and room if not empty, is configured.
+func (r *Root) hasSpace(floor, room string) bool {
+	for _, f := range r.Floors {
+		if f.Name != floor {
+			continue
+		}
+		if room == "" {
+			return true
+		}
+		for _, rc := range f.Rooms {
+			if rc.Name == room {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // Weekdays resolves the configured working day names to time.Weekday values.
 // Returns Monday–Friday if none are configured. Unknown names produce an error.
 func (w *WorkingHours) Weekdays() ([]time.Weekday, error) {
@@ -223,6 +368,10 @@ var Blocks = []block.Block{
 		Path: []string{"buildingDevices"},
 		Key:  "type",
 	},
+	{
+		Path: []string{"scenarios"},
+		Key:  "name",
+	},
 	{
 		Path:   []string{"metadata"},
 		Blocks: mdblock.Categories,
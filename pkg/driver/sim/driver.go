@@ -22,6 +22,7 @@ import (
 	"github.com/smart-core-os/sc-bos/pkg/driver/sim/config"
 	"github.com/smart-core-os/sc-bos/pkg/driver/sim/scale"
 	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/proto/driver/simpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
 	"github.com/smart-core-os/sc-bos/pkg/task/service"
 	"github.com/smart-core-os/sc-bos/pkg/util/time/clock"
@@ -98,6 +99,10 @@ func (d *Driver) applyConfig(ctx context.Context, cfg config.Root) error {
 	if cfg.Metadata != nil {
 		undos = append(undos, d.announcer.Announce(cfg.Name, node.HasMetadata(cfg.Metadata)))
 	}
+	if len(cfg.Scenarios) > 0 {
+		undos = append(undos, d.announcer.Announce(cfg.Name,
+			node.HasServer(simpb.RegisterSimDriverServiceServer, simpb.SimDriverServiceServer(building.scenarios))))
+	}
 	for _, dev := range devices {
 		feats := append([]node.Feature{
 			node.HasMetadata(dev.Metadata),
@@ -0,0 +1,317 @@
+package sim
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/sim/config"
+	"github.com/smart-core-os/sc-bos/pkg/proto/driver/simpb"
+)
+
+// scenarios holds the building's scripted scenarios and implements the SimDriverService.
+//
+// Which scenarios are running changes on API request goroutines, so is guarded by mu.
+// The effect of the running scenarios is applied to the building on the engine
+// goroutine by applyScenarios, which recomputes every fault from scratch each tick.
+// Events are timed in simulated time from the tick a scenario starts on, so a
+// scenario plays out the same way whatever the time multiplier.
+type scenarios struct {
+	simpb.UnimplementedSimDriverServiceServer
+
+	list   []*scenario // in config order
+	byName map[string]*scenario
+
+	mu  sync.Mutex
+	now time.Time // the simulated time of the last tick
+}
+
+type scenario struct {
+	cfg    config.Scenario
+	events []*scenarioEvent
+
+	// guarded by scenarios.mu
+	running bool
+	start   time.Time
+}
+
+type scenarioEvent struct {
+	cfg       config.Event
+	target    string        // the resolved device or space the event applies to
+	at        time.Duration // after the scenario starts
+	timeOfDay time.Duration // since midnight, if atTime is set
+	atTime    bool          // whether the event starts at timeOfDay rather than at
+	dur       time.Duration // 0 means until the scenario stops
+	// apply injects the event into the building, elapsed is the time since the event started.
+	apply func(b *Building, elapsed time.Duration)
+
+	active bool // guarded by scenarios.mu
+}
+
+func newScenarios(now time.Time) *scenarios {
+	return &scenarios{byName: make(map[string]*scenario), now: now}
+}
+
+// configure resolves the configured scenarios against the expanded building, starting any that auto start.
+func (s *scenarios) configure(cfg config.Root, b *Building, devices []Device) error {
+	byName := make(map[string]Device, len(devices))
+	for _, d := range devices {
+		byName[d.Name] = d
+	}
+	// Devices can be named with or without the prefix, keeping scenario config short.
+	device := func(name string) (Device, bool) {
+		d, ok := byName[name]
+		if !ok && cfg.NamePrefix != "" {
+			d, ok = byName[cfg.NamePrefix+"/"+name]
+		}
+		return d, ok
+	}
+
+	for _, sc := range cfg.Scenarios {
+		res := &scenario{cfg: sc}
+		for i, ec := range sc.Events {
+			e, err := newScenarioEvent(ec, b, device)
+			if err != nil {
+				return fmt.Errorf("scenario %q events[%d]: %w", sc.Name, i, err)
+			}
+			res.events = append(res.events, e)
+		}
+		if sc.AutoStart {
+			res.running = true
+			res.start = s.now
+		}
+		s.list = append(s.list, res)
+		s.byName[sc.Name] = res
+	}
+	return nil
+}
+
+func newScenarioEvent(cfg config.Event, b *Building, device func(string) (Device, bool)) (*scenarioEvent, error) {
+	e := &scenarioEvent{cfg: cfg}
+	if cfg.At != nil {
+		e.at = cfg.At.Duration
+	}
+	if cfg.AtTime != "" {
+		tod, err := cfg.TimeOfDay()
+		if err != nil {
+			return nil, err
+		}
+		e.timeOfDay, e.atTime = tod, true
+	}
+	if cfg.For != nil {
+		e.dur = cfg.For.Duration
+	}
+
+	var dev Device
+	if cfg.Device != "" {
+		var ok bool
+		dev, ok = device(cfg.Device)
+		if !ok {
+			return nil, fmt.Errorf("unknown device %q", cfg.Device)
+		}
+		e.target = dev.Name
+	}
+	var rooms []*Room
+	for _, f := range b.Floors {
+		if cfg.Floor != "" && f.Name != cfg.Floor {
+			continue
+		}
+		for _, r := range f.Rooms {
+			if cfg.Room == "" || r.Name == cfg.Room {
+				rooms = append(rooms, r)
+			}
+		}
+	}
+	switch {
+	case cfg.Room != "":
+		e.target = cfg.Floor + "/" + cfg.Room
+	case cfg.Floor != "":
+		e.target = cfg.Floor
+	}
+
+	switch cfg.Type {
+	case config.EventFanFailure:
+		if dev.Name != "" {
+			if dev.typ != ArchetypeFCU {
+				return nil, fmt.Errorf("device %q is not an %s", dev.Name, ArchetypeFCU)
+			}
+			// The room is the unit of simulation, so a failed FCU stops all the room's fans.
+			rooms = []*Room{dev.room}
+		}
+		e.apply = func(*Building, time.Duration) {
+			for _, r := range rooms {
+				r.fanFailed = true
+			}
+		}
+	case config.EventEvacuate:
+		e.apply = func(*Building, time.Duration) {
+			for _, r := range rooms {
+				r.evacuated = true
+			}
+		}
+	case config.EventPowerCut:
+		building := cfg.Floor == ""
+		e.apply = func(b *Building, _ time.Duration) {
+			for _, r := range rooms {
+				r.unpowered = true
+			}
+			if building {
+				b.baseUnpowered = true
+			}
+		}
+	case config.EventSensorStuck:
+		e.apply = func(b *Building, _ time.Duration) {
+			b.stuck[dev.Name] = true
+		}
+	case config.EventMeterDrift:
+		rate := cfg.DriftPctPerHour / 100
+		e.apply = func(b *Building, elapsed time.Duration) {
+			b.meterGain = max(0, b.meterGain+rate*elapsed.Hours())
+		}
+	default:
+		return nil, fmt.Errorf("unknown type %q", cfg.Type)
+	}
+	return e, nil
+}
+
+// startTime returns the simulated time the event starts for a scenario started at start.
+func (e *scenarioEvent) startTime(start time.Time) time.Time {
+	if !e.atTime {
+		return start.Add(e.at)
+	}
+	y, m, d := start.Date()
+	t := time.Date(y, m, d, 0, 0, 0, 0, start.Location()).Add(e.timeOfDay)
+	if t.Before(start) {
+		t = time.Date(y, m, d+1, 0, 0, 0, 0, start.Location()).Add(e.timeOfDay)
+	}
+	return t
+}
+
+// isActive returns whether the event applies at now for a scenario started at start, and for how long it has.
+func (e *scenarioEvent) isActive(start, now time.Time) (time.Duration, bool) {
+	eventStart := e.startTime(start)
+	if now.Before(eventStart) {
+		return 0, false
+	}
+	elapsed := now.Sub(eventStart)
+	if e.dur > 0 && elapsed >= e.dur {
+		return 0, false
+	}
+	return elapsed, true
+}
+
+// applyScenarios clears all scenario faults then applies those of the running scenarios' active events.
+// Called on the engine goroutine at the start of each Tick.
+func (b *Building) applyScenarios(now time.Time) {
+	for _, f := range b.Floors {
+		for _, r := range f.Rooms {
+			r.fanFailed, r.evacuated, r.unpowered = false, false, false
+		}
+	}
+	b.meterGain = 1
+	b.baseUnpowered = false
+	clear(b.stuck)
+
+	s := b.scenarios
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = now
+	for _, sc := range s.list {
+		for _, e := range sc.events {
+			elapsed, active := e.isActive(sc.start, now)
+			e.active = sc.running && active
+			if e.active {
+				e.apply(b, elapsed)
+			}
+		}
+	}
+}
+
+func (s *scenarios) ListScenarios(_ context.Context, _ *simpb.ListScenariosRequest) (*simpb.ListScenariosResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &simpb.ListScenariosResponse{}
+	for _, sc := range s.list {
+		res.Scenarios = append(res.Scenarios, sc.toProto())
+	}
+	return res, nil
+}
+
+func (s *scenarios) StartScenario(_ context.Context, req *simpb.StartScenarioRequest) (*simpb.Scenario, error) {
+	sc, err := s.lookup(req.GetScenario())
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Restarting a running scenario starts it again from the beginning,
+	// events become active from the next tick.
+	sc.running = true
+	sc.start = s.now
+	for _, e := range sc.events {
+		e.active = false
+	}
+	return sc.toProto(), nil
+}
+
+func (s *scenarios) StopScenario(_ context.Context, req *simpb.StopScenarioRequest) (*simpb.Scenario, error) {
+	sc, err := s.lookup(req.GetScenario())
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sc.running = false
+	sc.start = time.Time{}
+	for _, e := range sc.events {
+		e.active = false
+	}
+	return sc.toProto(), nil
+}
+
+func (s *scenarios) lookup(name string) (*scenario, error) {
+	sc, ok := s.byName[name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "scenario %q not found", name)
+	}
+	return sc, nil
+}
+
+// toProto converts sc to its proto representation. Must be called with scenarios.mu held.
+func (sc *scenario) toProto() *simpb.Scenario {
+	res := &simpb.Scenario{
+		Name:    sc.cfg.Name,
+		Title:   sc.cfg.Title,
+		Running: sc.running,
+	}
+	if sc.running {
+		res.StartTime = timestamppb.New(sc.start)
+	}
+	for _, e := range sc.events {
+		ev := &simpb.Scenario_Event{
+			Type:   e.cfg.Type,
+			Target: e.target,
+			Active: e.active,
+		}
+		switch {
+		case !e.atTime:
+			ev.StartOffset = durationpb.New(e.at)
+		case sc.running:
+			ev.StartOffset = durationpb.New(e.startTime(sc.start).Sub(sc.start))
+		}
+		if e.atTime {
+			ev.StartTimeOfDay = e.cfg.AtTime
+		}
+		if e.dur > 0 {
+			ev.Duration = durationpb.New(e.dur)
+		}
+		res.Events = append(res.Events, ev)
+	}
+	return res
+}
@@ -0,0 +1,265 @@
+package sim
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smart-core-os/sc-bos/pkg/driver/sim/config"
+	"github.com/smart-core-os/sc-bos/pkg/driver/sim/scale"
+	"github.com/smart-core-os/sc-bos/pkg/proto/driver/simpb"
+	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
+)
+
+func dur(d time.Duration) *jsontypes.Duration {
+	return &jsontypes.Duration{Duration: d}
+}
+
+// newScenarioBuilding expands a two floor building with the given scenarios.
+func newScenarioBuilding(t *testing.T, start time.Time, scenarios ...config.Scenario) *Building {
+	t.Helper()
+	cfg := config.Root{
+		NamePrefix: "sim",
+		Floors: []config.Floor{
+			{Name: "floor1", Rooms: []config.Room{{Name: "office", MaxOccupancy: 40, Archetypes: []config.Archetype{
+				{Type: ArchetypeLighting, Count: 4},
+				{Type: ArchetypeFCU, Count: 2},
+				{Type: ArchetypePIR},
+			}}}},
+			{Name: "floor2", Rooms: []config.Room{{Name: "office", MaxOccupancy: 40, Archetypes: []config.Archetype{
+				{Type: ArchetypeLighting, Count: 4},
+				{Type: ArchetypePIR},
+			}}}},
+		},
+		BuildingDevices: []config.Archetype{{Type: ArchetypeMeter}},
+		Scenarios:       scenarios,
+	}
+	cfg.Normalise()
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	b, _, err := Expand(cfg, scale.WorkingHours(8, 18, nil), start)
+	if err != nil {
+		t.Fatalf("Expand: %v", err)
+	}
+	return b
+}
+
+// runFor ticks b a minute at a time for d, returning the new simulated time.
+func runFor(b *Building, now time.Time, d time.Duration) time.Time {
+	for end := now.Add(d); now.Before(end); {
+		now = now.Add(time.Minute)
+		b.Tick(now, time.Minute)
+	}
+	return now
+}
+
+func TestScenario_FanFailure(t *testing.T) {
+	start := at(monday, 10, 0)
+	b := newScenarioBuilding(t, start, config.Scenario{Name: "fcu", AutoStart: true, Events: []config.Event{
+		{Type: config.EventFanFailure, Device: "floor1/office/fcu-02", At: dur(30 * time.Minute), For: dur(time.Hour)},
+	}})
+	room := b.Floors[0].Rooms[0]
+
+	now := runFor(b, start, 29*time.Minute)
+	if room.FanPct == 0 {
+		t.Fatal("fan stopped before the event")
+	}
+	setPoint := room.setPoint()
+
+	now = runFor(b, now, 45*time.Minute)
+	if room.FanPct != 0 || room.fcuW != 0 {
+		t.Errorf("FanPct = %g, fcuW = %g during fan failure, want 0", room.FanPct, room.fcuW)
+	}
+	if room.TempC < setPoint+unconditionedOffsetC/2 {
+		t.Errorf("TempC = %g during fan failure, want it to drift above the %g set point", room.TempC, setPoint)
+	}
+
+	runFor(b, now, time.Hour)
+	if room.FanPct == 0 {
+		t.Error("fan still stopped after the event ended")
+	}
+}
+
+func TestScenario_AtTime(t *testing.T) {
+	start := at(monday, 11, 0)
+	b := newScenarioBuilding(t, start, config.Scenario{Name: "fcu", AutoStart: true, Events: []config.Event{
+		{Type: config.EventFanFailure, Device: "floor1/office/fcu-02", AtTime: "10:00", For: dur(time.Hour)},
+	}})
+	room := b.Floors[0].Rooms[0]
+
+	// 10:00 has passed today, so the event waits for 10:00 tomorrow
+	now := runFor(b, start, 22*time.Hour+59*time.Minute)
+	if room.FanPct == 0 {
+		t.Fatal("fan stopped before the event")
+	}
+	now = runFor(b, now, 30*time.Minute)
+	if room.FanPct != 0 {
+		t.Errorf("FanPct = %g at %v, want 0", room.FanPct, now)
+	}
+	runFor(b, now, time.Hour)
+	if room.FanPct == 0 {
+		t.Error("fan still stopped after the event ended")
+	}
+}
+
+func TestScenario_MeterDrift(t *testing.T) {
+	start := at(monday, 3, 0)
+	b := newScenarioBuilding(t, start, config.Scenario{Name: "drift", AutoStart: true, Events: []config.Event{
+		{Type: config.EventMeterDrift, DriftPctPerHour: 5},
+	}})
+	now := runFor(b, start, 2*time.Hour)
+	if got, want := b.MeteredDemandW()/b.DemandW, 1.1; got < want-0.001 || got > want+0.001 {
+		t.Errorf("metered/actual demand = %g after 2h at 5%%/h, want %g", got, want)
+	}
+	// the meter reads more than the demand would account for
+	if actual := float64(testBaseLoadW) / 1000 * 2; b.MeterKWh <= actual {
+		t.Errorf("MeterKWh = %g, want more than the actual %g", b.MeterKWh, actual)
+	}
+
+	if _, err := b.scenarios.StopScenario(context.Background(), &simpb.StopScenarioRequest{Scenario: "drift"}); err != nil {
+		t.Fatal(err)
+	}
+	runFor(b, now, time.Minute)
+	if b.MeteredDemandW() != b.DemandW {
+		t.Errorf("metered demand = %g after stopping, want %g", b.MeteredDemandW(), b.DemandW)
+	}
+}
+
+func TestScenario_SensorStuck(t *testing.T) {
+	start := at(monday, 10, 0)
+	b := newScenarioBuilding(t, start, config.Scenario{Name: "stuck", AutoStart: true, Events: []config.Event{
+		{Type: config.EventSensorStuck, Device: "sim/floor1/office/pir-01"},
+	}})
+	var published, other int
+	b.addDeviceUpdater("sim/floor1/office/pir-01", updaterFunc(func(time.Time, *Building) { published++ }))
+	b.addDeviceUpdater("sim/floor1/office/pir-02", updaterFunc(func(time.Time, *Building) { other++ }))
+
+	now := start.Add(time.Minute)
+	b.Tick(now, time.Minute)
+	b.Publish(now)
+	if published != 0 {
+		t.Errorf("stuck device published %d times, want 0", published)
+	}
+	if other != 1 {
+		t.Errorf("other device published %d times, want 1", other)
+	}
+}
+
+func TestScenario_Evacuate(t *testing.T) {
+	start := at(monday, 11, 0)
+	b := newScenarioBuilding(t, start, config.Scenario{Name: "fire", Events: []config.Event{
+		{Type: config.EventEvacuate, Floor: "floor2", For: dur(20 * time.Minute)},
+	}})
+	floor1, floor2 := b.Floors[0].Rooms[0], b.Floors[1].Rooms[0]
+	now := runFor(b, start, time.Minute)
+	if floor2.Occupants == 0 {
+		t.Fatal("precondition: floor2 is empty before the evacuation")
+	}
+
+	if _, err := b.scenarios.StartScenario(context.Background(), &simpb.StartScenarioRequest{Scenario: "fire"}); err != nil {
+		t.Fatal(err)
+	}
+	now = runFor(b, now, time.Minute)
+	if floor2.Occupants != 0 {
+		t.Errorf("floor2 Occupants = %d during evacuation, want 0", floor2.Occupants)
+	}
+	if floor1.Occupants == 0 {
+		t.Error("floor1 was evacuated too")
+	}
+	if floor2.LeaveTotal == 0 {
+		t.Error("evacuation didn't record anyone leaving")
+	}
+
+	runFor(b, now, 30*time.Minute)
+	if floor2.Occupants == 0 {
+		t.Error("floor2 still empty after the evacuation ended")
+	}
+}
+
+func TestScenario_PowerCut(t *testing.T) {
+	start := at(monday, 11, 0)
+	b := newScenarioBuilding(t, start, config.Scenario{Name: "cut", AutoStart: true, Events: []config.Event{
+		{Type: config.EventPowerCut},
+	}})
+	runFor(b, start, time.Minute)
+	if b.DemandW != 0 {
+		t.Errorf("DemandW = %g during power cut, want 0", b.DemandW)
+	}
+	for _, f := range b.Floors {
+		for _, r := range f.Rooms {
+			if r.LightLevel != 0 || r.FanPct != 0 {
+				t.Errorf("%s/%s light = %g, fan = %g during power cut, want 0", f.Name, r.Name, r.LightLevel, r.FanPct)
+			}
+		}
+	}
+}
+
+func TestScenarios_API(t *testing.T) {
+	start := at(monday, 9, 0)
+	b := newScenarioBuilding(t, start, config.Scenario{Name: "fcu", Title: "FCU failure", Events: []config.Event{
+		{Type: config.EventFanFailure, Floor: "floor1", Room: "office", At: dur(10 * time.Minute)},
+	}})
+	s := b.scenarios
+	ctx := context.Background()
+
+	now := runFor(b, start, 5*time.Minute)
+	got, err := s.StartScenario(ctx, &simpb.StartScenarioRequest{Scenario: "fcu"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Running || !got.StartTime.AsTime().Equal(now) {
+		t.Errorf("StartScenario() = %v, want running from %v", got, now)
+	}
+	if got := got.Events[0].Target; got != "floor1/office" {
+		t.Errorf("event target = %q, want floor1/office", got)
+	}
+
+	now = runFor(b, now, 9*time.Minute)
+	list, err := s.ListScenarios(ctx, &simpb.ListScenariosRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.Scenarios[0].Events[0].Active {
+		t.Error("event active before its start offset")
+	}
+	runFor(b, now, time.Minute)
+	list, err = s.ListScenarios(ctx, &simpb.ListScenariosRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !list.Scenarios[0].Events[0].Active {
+		t.Error("event not active after its start offset")
+	}
+
+	got, err = s.StopScenario(ctx, &simpb.StopScenarioRequest{Scenario: "fcu"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Running || got.StartTime != nil || got.Events[0].Active {
+		t.Errorf("StopScenario() = %v, want stopped", got)
+	}
+
+	if _, err := s.StartScenario(ctx, &simpb.StartScenarioRequest{Scenario: "missing"}); err == nil {
+		t.Error("StartScenario(missing) succeeded, want error")
+	}
+}
+
+func TestExpand_ScenarioUnknownDevice(t *testing.T) {
+	cfg := config.Root{
+		Floors: []config.Floor{{Name: "floor1", Rooms: []config.Room{{Name: "office", Archetypes: []config.Archetype{
+			{Type: ArchetypeLighting},
+		}}}}},
+		Scenarios: []config.Scenario{{Name: "s", Events: []config.Event{
+			{Type: config.EventSensorStuck, Device: "floor1/office/pir-01"},
+		}}},
+	}
+	cfg.Normalise()
+	if _, _, err := Expand(cfg, scale.WorkingHours(8, 18, nil), monday); err == nil {
+		t.Error("Expand succeeded with an unknown scenario device, want error")
+	}
+	cfg.Scenarios[0].Events[0] = config.Event{Type: config.EventFanFailure, Device: "floor1/office/lighting-01"}
+	if _, _, err := Expand(cfg, scale.WorkingHours(8, 18, nil), monday); err == nil {
+		t.Error("Expand succeeded with a fanFailure on a light, want error")
+	}
+}
@@ -0,0 +1,34 @@
+// Package config holds the retention rules applied to the shared history stores.
+package config
+
+import (
+	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
+)
+
+// Rule is a retention policy for the history sources it matches.
+// A source is matched if it records Trait for a device matching all the Devices conditions.
+// A rule without Trait or Devices matches every source, so can be used as a catch-all last rule.
+type Rule struct {
+	// Name identifies the rule when reporting the policy in effect for a source.
+	// Defaults to the rule's position, like "retention[0]".
+	Name string `json:"name,omitempty"`
+	// Trait matches sources recording this trait, like "smartcore.bos.Meter".
+	Trait trait.Name `json:"trait,omitempty"`
+	// Devices matches sources recording devices that match all these conditions.
+	Devices []*jsontypes.DeviceCondition `json:"devices,omitempty"`
+
+	// MaxAge removes records older than this. Zero keeps records of any age.
+	MaxAge jsontypes.Duration `json:"maxAge,omitempty"`
+	// MaxCount keeps at most this many of the newest records. Zero keeps any number of records.
+	MaxCount int64 `json:"maxCount,omitempty"`
+}
+
+// Query returns the devices query built from the rule's conditions, or nil if the rule has no conditions.
+func (r Rule) Query() *devicespb.Device_Query {
+	if len(r.Devices) == 0 {
+		return nil
+	}
+	return &devicespb.Device_Query{Conditions: jsontypes.DeviceConditionsPb(r.Devices)}
+}
@@ -8,17 +8,23 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/smart-core-os/sc-bos/pkg/app/stores"
+	"github.com/smart-core-os/sc-bos/pkg/history/dataretention/config"
 	"github.com/smart-core-os/sc-bos/pkg/history/pgxstore"
 	"github.com/smart-core-os/sc-bos/pkg/node"
 	"github.com/smart-core-os/sc-bos/pkg/proto/dataretentionpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
 )
 
-func announcePostgres(ctx context.Context, n *node.Node, name string, s *stores.Stores, checks *healthpb.Checks, highPct float32, maxSizeBytes uint64, logger *zap.Logger) node.Undo {
+func announcePostgres(ctx context.Context, n *node.Node, name string, s *stores.Stores, rules []config.Rule, checks *healthpb.Checks, highPct float32, maxSizeBytes uint64, logger *zap.Logger) node.Undo {
 	model := dataretentionpb.NewModel()
-	server := dataretentionpb.NewModelServer(model, &postgresBackend{stores: s},
-		dataretentionpb.WithItemName("row"),
-	)
+	opts := []dataretentionpb.ModelServerOption{dataretentionpb.WithItemName("row")}
+	retentionUndo := node.NilUndo
+	if len(rules) > 0 {
+		r := newRetention(rules, n, &postgresRetention{stores: s}, logger)
+		opts = append(opts, dataretentionpb.WithRetentionPolicies(r.Policies))
+		retentionUndo = r.start(ctx)
+	}
+	server := dataretentionpb.NewModelServer(model, &postgresBackend{stores: s}, opts...)
 
 	undo := n.Announce(name, node.HasTrait(dataretentionpb.TraitName, node.WithClients(dataretentionpb.WrapApi(server))))
 
@@ -32,7 +38,7 @@ func announcePostgres(ctx context.Context, n *node.Node, name string, s *stores.
 		updatePostgresModel(ctx, s, model, health, maxSizeBytes, logger)
 	})
 
-	return node.UndoAll(undo, pollUndo)
+	return node.UndoAll(undo, pollUndo, retentionUndo)
 }
 
 func updatePostgresModel(ctx context.Context, s *stores.Stores, model *dataretentionpb.Model, health *storageHealth, maxSizeBytes uint64, logger *zap.Logger) {
@@ -92,3 +98,32 @@ func (b *postgresBackend) Compact(ctx context.Context) error {
 	}
 	return pgxstore.Vacuum(ctx, admin)
 }
+
+// postgresRetention implements retentionBackend for the Postgres history store.
+type postgresRetention struct {
+	stores *stores.Stores
+}
+
+func (b *postgresRetention) sources(ctx context.Context) ([]string, error) {
+	r, _, _, err := b.stores.Postgres()
+	if err != nil {
+		return nil, fmt.Errorf("postgres: %w", err)
+	}
+	return pgxstore.Sources(ctx, r)
+}
+
+func (b *postgresRetention) trimTime(ctx context.Context, source string, before time.Time) (uint64, error) {
+	_, w, _, err := b.stores.Postgres()
+	if err != nil {
+		return 0, fmt.Errorf("postgres: %w", err)
+	}
+	return pgxstore.DeleteSourceBefore(ctx, w, source, before)
+}
+
+func (b *postgresRetention) trimCount(ctx context.Context, source string, maxCount int64) (uint64, error) {
+	_, w, _, err := b.stores.Postgres()
+	if err != nil {
+		return 0, fmt.Errorf("postgres: %w", err)
+	}
+	return pgxstore.TrimSourceCount(ctx, w, source, maxCount)
+}
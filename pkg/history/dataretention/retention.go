@@ -0,0 +1,201 @@
+package dataretention
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/smart-core-os/sc-bos/internal/manage/devices"
+	"github.com/smart-core-os/sc-bos/pkg/app/stores"
+	"github.com/smart-core-os/sc-bos/pkg/history/dataretention/config"
+	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/proto/dataretentionpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+)
+
+// retentionInterval is how often the retention rules are applied to a store.
+const retentionInterval = time.Hour
+
+// retentionBackend removes old records from the sources of a history store.
+type retentionBackend interface {
+	sources(ctx context.Context) ([]string, error)
+	trimTime(ctx context.Context, source string, before time.Time) (uint64, error)
+	trimCount(ctx context.Context, source string, maxCount int64) (uint64, error)
+}
+
+// retention applies retention rules to the sources of a history store,
+// remembering the policy in effect for each source so it can be reported by DescribeDataRetention.
+type retention struct {
+	rules   []config.Rule
+	device  func(name string) (*devicespb.Device, bool)
+	backend retentionBackend
+	now     func() time.Time
+	logger  *zap.Logger
+
+	mu       sync.Mutex
+	policies []*dataretentionpb.RetentionPolicy
+}
+
+func newRetention(rules []config.Rule, n *node.Node, backend retentionBackend, logger *zap.Logger) *retention {
+	return &retention{
+		rules: rules,
+		device: func(name string) (*devicespb.Device, bool) {
+			d, err := n.GetDevice(name)
+			return d, err == nil
+		},
+		backend: backend,
+		now:     time.Now,
+		logger:  logger,
+	}
+}
+
+// start applies the rules now and every retentionInterval until the returned undo is called.
+func (r *retention) start(ctx context.Context) node.Undo {
+	ctx, stop := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		tick := time.NewTicker(retentionInterval)
+		defer tick.Stop()
+		for {
+			r.apply(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-tick.C:
+			}
+		}
+	}()
+	return func() {
+		stop()
+		<-done
+	}
+}
+
+// apply removes the records of each source in the store that its policy no longer keeps.
+func (r *retention) apply(ctx context.Context) {
+	sources, err := r.backend.sources(ctx)
+	if err != nil {
+		r.logger.Warn("failed to list history sources for retention", zap.Error(err))
+		return
+	}
+	now := r.now()
+	var policies []*dataretentionpb.RetentionPolicy
+	for _, source := range sources {
+		policy, ok := r.policy(source)
+		if !ok {
+			continue
+		}
+		policies = append(policies, policy)
+		var deleted uint64
+		if maxAge := policy.GetMaxAge(); maxAge != nil {
+			n, err := r.backend.trimTime(ctx, source, now.Add(-maxAge.AsDuration()))
+			if err != nil {
+				r.logger.Warn("failed to remove old history records", zap.String("source", source), zap.Error(err))
+			}
+			deleted += n
+		}
+		if maxCount := policy.GetMaxCount(); maxCount > 0 {
+			n, err := r.backend.trimCount(ctx, source, maxCount)
+			if err != nil {
+				r.logger.Warn("failed to remove excess history records", zap.String("source", source), zap.Error(err))
+			}
+			deleted += n
+		}
+		if deleted > 0 {
+			r.logger.Debug("removed history records", zap.String("source", source), zap.String("rule", policy.Rule), zap.Uint64("count", deleted))
+		}
+	}
+	r.mu.Lock()
+	r.policies = policies
+	r.mu.Unlock()
+}
+
+// Policies returns the policies in effect for the sources in the store, as of the last time the rules were applied.
+func (r *retention) Policies() []*dataretentionpb.RetentionPolicy {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.policies
+}
+
+// policy returns the policy for source from the first rule that matches it.
+func (r *retention) policy(source string) (*dataretentionpb.RetentionPolicy, bool) {
+	name, traitName := parseSource(source)
+	for i, rule := range r.rules {
+		if !r.matches(rule, name, traitName) {
+			continue
+		}
+		policy := &dataretentionpb.RetentionPolicy{
+			Source:   source,
+			Rule:     rule.Name,
+			MaxCount: rule.MaxCount,
+		}
+		if policy.Rule == "" {
+			policy.Rule = fmt.Sprintf("retention[%d]", i)
+		}
+		if rule.MaxAge.Duration > 0 {
+			policy.MaxAge = durationpb.New(rule.MaxAge.Duration)
+		}
+		return policy, true
+	}
+	return nil, false
+}
+
+func (r *retention) matches(rule config.Rule, name string, traitName trait.Name) bool {
+	if rule.Trait != "" && rule.Trait != traitName {
+		return false
+	}
+	query := rule.Query()
+	if query == nil {
+		return true
+	}
+	device, ok := r.device(name)
+	return ok && devices.MatchesQuery(query, device)
+}
+
+// parseSource splits a history source, like "floor-1/meter-1[smartcore.bos.Meter]", into the device name and trait.
+// Sources not in that form are taken to be a device name, recording an unknown trait.
+func parseSource(source string) (string, trait.Name) {
+	i := strings.LastIndexByte(source, '[')
+	if i < 0 || !strings.HasSuffix(source, "]") {
+		return source, ""
+	}
+	return source[:i], trait.Name(source[i+1 : len(source)-1])
+}
+
+// sqliteRetention implements retentionBackend for the SQLite history store.
+type sqliteRetention struct {
+	stores *stores.Stores
+}
+
+func (b *sqliteRetention) sources(ctx context.Context) ([]string, error) {
+	db, err := b.stores.SqliteHistory(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite history: %w", err)
+	}
+	return db.Sources(ctx)
+}
+
+func (b *sqliteRetention) trimTime(ctx context.Context, source string, before time.Time) (uint64, error) {
+	db, err := b.stores.SqliteHistory(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite history: %w", err)
+	}
+	deleted, err := db.TrimTime(ctx, source, before)
+	return uint64(deleted), err
+}
+
+func (b *sqliteRetention) trimCount(ctx context.Context, source string, maxCount int64) (uint64, error) {
+	db, err := b.stores.SqliteHistory(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("sqlite history: %w", err)
+	}
+	deleted, err := db.TrimCount(ctx, source, maxCount)
+	return uint64(deleted), err
+}
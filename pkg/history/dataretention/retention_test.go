@@ -0,0 +1,109 @@
+package dataretention
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/smart-core-os/sc-bos/pkg/app/stores"
+	"github.com/smart-core-os/sc-bos/pkg/history/dataretention/config"
+	"github.com/smart-core-os/sc-bos/pkg/history/sqlitestore"
+	"github.com/smart-core-os/sc-bos/pkg/proto/dataretentionpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
+)
+
+func TestRetention_apply(t *testing.T) {
+	ctx := t.Context()
+	s := stores.New(&stores.Config{DataDir: t.TempDir()})
+	t.Cleanup(func() { _ = s.Close() })
+	db, err := s.SqliteHistory(ctx)
+	if err != nil {
+		t.Fatalf("SqliteHistory: %v", err)
+	}
+
+	var rules []config.Rule
+	err = json.Unmarshal([]byte(`[
+		{"name": "meters", "trait": "smartcore.bos.Meter", "maxAge": "720h"},
+		{"devices": [{"field": "metadata.location.floor", "stringEqual": "3"}], "maxCount": 1},
+		{"name": "occupancy", "trait": "smartcore.traits.OccupancySensor"}
+	]`), &rules)
+	if err != nil {
+		t.Fatalf("unmarshal rules: %v", err)
+	}
+
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	sources := map[string]string{
+		"meter":     "meter-1[smartcore.bos.Meter]",
+		"floor3":    "sensor-3[smartcore.traits.AirTemperature]",
+		"occupancy": "sensor-1[smartcore.traits.OccupancySensor]",
+		"unmatched": "sensor-1[smartcore.traits.AirTemperature]",
+	}
+	var records []sqlitestore.Record
+	for _, source := range sources {
+		for _, age := range []time.Duration{60 * 24 * time.Hour, 24 * time.Hour, time.Hour} {
+			records = append(records, sqlitestore.Record{Source: source, CreateTime: now.Add(-age), Payload: []byte("{}")})
+		}
+	}
+	if err := db.InsertBulk(ctx, records); err != nil {
+		t.Fatalf("InsertBulk: %v", err)
+	}
+
+	r := &retention{
+		rules: rules,
+		device: func(name string) (*devicespb.Device, bool) {
+			if name != "sensor-3" {
+				return &devicespb.Device{Name: name}, true
+			}
+			return &devicespb.Device{Name: name, Metadata: &metadatapb.Metadata{
+				Location: &metadatapb.Metadata_Location{Floor: "3"},
+			}}, true
+		},
+		backend: &sqliteRetention{stores: s},
+		now:     func() time.Time { return now },
+		logger:  zap.NewNop(),
+	}
+	r.apply(ctx)
+
+	wantCounts := map[string]int{"meter": 2, "floor3": 1, "occupancy": 3, "unmatched": 3}
+	for key, want := range wantCounts {
+		got, err := db.Count(ctx, sources[key], 0, 0)
+		if err != nil {
+			t.Fatalf("Count(%s): %v", key, err)
+		}
+		if got != want {
+			t.Errorf("%s has %d records, want %d", key, got, want)
+		}
+	}
+
+	wantPolicies := []*dataretentionpb.RetentionPolicy{
+		{Source: sources["meter"], Rule: "meters", MaxAge: durationpb.New(720 * time.Hour)},
+		{Source: sources["occupancy"], Rule: "occupancy"},
+		{Source: sources["floor3"], Rule: "retention[1]", MaxCount: 1},
+	}
+	if diff := cmp.Diff(wantPolicies, r.Policies(), protocmp.Transform()); diff != "" {
+		t.Errorf("Policies() (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseSource(t *testing.T) {
+	tests := []struct {
+		source, name, trait string
+	}{
+		{"floor-1/meter-1[smartcore.bos.Meter]", "floor-1/meter-1", "smartcore.bos.Meter"},
+		{"a[b][smartcore.bos.Meter]", "a[b]", "smartcore.bos.Meter"},
+		{"meter-1", "meter-1", ""},
+		{"meter-1[unterminated", "meter-1[unterminated", ""},
+	}
+	for _, tt := range tests {
+		name, traitName := parseSource(tt.source)
+		if name != tt.name || string(traitName) != tt.trait {
+			t.Errorf("parseSource(%q) = %q, %q, want %q, %q", tt.source, name, traitName, tt.name, tt.trait)
+		}
+	}
+}
@@ -9,16 +9,22 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/smart-core-os/sc-bos/pkg/app/stores"
+	"github.com/smart-core-os/sc-bos/pkg/history/dataretention/config"
 	"github.com/smart-core-os/sc-bos/pkg/node"
 	"github.com/smart-core-os/sc-bos/pkg/proto/dataretentionpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
 )
 
-func announceSqlite(ctx context.Context, n *node.Node, name string, s *stores.Stores, dataDir string, checks *healthpb.Checks, highPct float32, logger *zap.Logger) node.Undo {
+func announceSqlite(ctx context.Context, n *node.Node, name string, s *stores.Stores, dataDir string, rules []config.Rule, checks *healthpb.Checks, highPct float32, logger *zap.Logger) node.Undo {
 	model := dataretentionpb.NewModel()
-	server := dataretentionpb.NewModelServer(model, &sqliteBackend{stores: s},
-		dataretentionpb.WithItemName("row"),
-	)
+	opts := []dataretentionpb.ModelServerOption{dataretentionpb.WithItemName("row")}
+	retentionUndo := node.NilUndo
+	if len(rules) > 0 {
+		r := newRetention(rules, n, &sqliteRetention{stores: s}, logger)
+		opts = append(opts, dataretentionpb.WithRetentionPolicies(r.Policies))
+		retentionUndo = r.start(ctx)
+	}
+	server := dataretentionpb.NewModelServer(model, &sqliteBackend{stores: s}, opts...)
 
 	undo := n.Announce(name, node.HasTrait(dataretentionpb.TraitName, node.WithClients(dataretentionpb.WrapApi(server))))
 
@@ -31,7 +37,7 @@ func announceSqlite(ctx context.Context, n *node.Node, name string, s *stores.St
 		updateSqliteModel(ctx, s, model, dataDir, health, full, logger)
 	})
 
-	return node.UndoAll(undo, pollUndo)
+	return node.UndoAll(undo, pollUndo, retentionUndo)
 }
 
 func updateSqliteModel(ctx context.Context, s *stores.Stores, model *dataretentionpb.Model, dataDir string, health *storageHealth, full bool, logger *zap.Logger) {
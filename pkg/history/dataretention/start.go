@@ -32,6 +32,10 @@ const defaultStorageHealthHighPercent float32 = 90
 // highPct (see StorageHealthHighPercent). The SQLite store learns its capacity from the
 // filesystem; the Postgres store has no inherent capacity, so its check is only raised when
 // PostgresConfig.MaxSizeBytes is configured.
+//
+// Each store applies the configured retention rules to its sources periodically, removing
+// records the policy of their source no longer keeps. The policy in effect for each source
+// is reported by DescribeDataRetention.
 func Start(ctx context.Context, n *node.Node, nodeName string, s *stores.Stores, cfg *stores.Config, checks *healthpb.Checks, logger *zap.Logger) node.Undo {
 	var undos []node.Undo
 	if cfg == nil {
@@ -45,13 +49,13 @@ func Start(ctx context.Context, n *node.Node, nodeName string, s *stores.Stores,
 
 	if cfg.DataDir != "" {
 		name := path.Join(nodeName, "stores/history")
-		undo := announceSqlite(ctx, n, name, s, cfg.DataDir, checks, highPct, logger.Named("stores.sqlite"))
+		undo := announceSqlite(ctx, n, name, s, cfg.DataDir, cfg.Retention, checks, highPct, logger.Named("stores.sqlite"))
 		undos = append(undos, undo)
 	}
 
 	if cfg.Postgres != nil {
 		name := path.Join(nodeName, "stores/postgres")
-		undo := announcePostgres(ctx, n, name, s, checks, highPct, cfg.Postgres.MaxSizeBytes, logger.Named("stores.postgres"))
+		undo := announcePostgres(ctx, n, name, s, cfg.Retention, checks, highPct, cfg.Postgres.MaxSizeBytes, logger.Named("stores.postgres"))
 		undos = append(undos, undo)
 	}
 
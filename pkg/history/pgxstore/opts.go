@@ -28,3 +28,12 @@ func WithLogger(logger *zap.Logger) Option {
 		s.logger = logger
 	}
 }
+
+// WithAliases is an option to also read records written under the aliases sources,
+// for example by a device before it was renamed.
+// Records are only written to, and trimmed from, the store's own source.
+func WithAliases(aliases ...string) Option {
+	return func(s *Store) {
+		s.aliases = aliases
+	}
+}
@@ -138,27 +138,65 @@ func (s *Store) gc(now time.Time) error {
 	}
 
 	if s.maxAge > 0 {
-		t := now.Add(-s.maxAge)
-		_, err := s.writePool.Exec(context.Background(), "DELETE FROM history WHERE source = $1 AND create_time < $2", s.source, t)
-		if err != nil {
+		if _, err := DeleteSourceBefore(context.Background(), s.writePool, s.source, now.Add(-s.maxAge)); err != nil {
 			return err
 		}
 	}
 	if s.maxCount > 0 {
-		// We use create_time here as a substitute for a strict incremental id.
-		// At most we leak records equal to the collisions of create_time, which should be minimal.
-		sql := fmt.Sprintf(`DELETE FROM history WHERE source = $1 AND create_time < (SELECT create_time FROM history WHERE source = $1 ORDER BY create_time DESC LIMIT 1 OFFSET %d)`, s.maxCount)
-		_, err := s.writePool.Exec(context.Background(), sql, s.source)
-		if err != nil {
+		if _, err := TrimSourceCount(context.Background(), s.writePool, s.source, s.maxCount); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// DeleteSourceBefore removes the history rows of source with create_time < before.
+// Returns the number of deleted rows.
+func DeleteSourceBefore(ctx context.Context, pool *pgxpool.Pool, source string, before time.Time) (uint64, error) {
+	tag, err := pool.Exec(ctx, "DELETE FROM history WHERE source = $1 AND create_time < $2", source, before)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(tag.RowsAffected()), nil
+}
+
+// TrimSourceCount removes the oldest history rows of source so about maxCount rows remain.
+// Returns the number of deleted rows.
+func TrimSourceCount(ctx context.Context, pool *pgxpool.Pool, source string, maxCount int64) (uint64, error) {
+	// We use create_time here as a substitute for a strict incremental id.
+	// At most we leak records equal to the collisions of create_time, which should be minimal.
+	sql := fmt.Sprintf(`DELETE FROM history WHERE source = $1 AND create_time < (SELECT create_time FROM history WHERE source = $1 ORDER BY create_time DESC LIMIT 1 OFFSET %d)`, maxCount)
+	tag, err := pool.Exec(ctx, sql, source)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(tag.RowsAffected()), nil
+}
+
+// sourcesSql finds distinct sources with a loose index scan: each step seeks history_source_create_time_idx to the
+// first source after the previous one, so the cost is one index probe per source rather than a scan of every row.
+const sourcesSql = `WITH RECURSIVE sources AS (
+    (SELECT source FROM history ORDER BY source LIMIT 1)
+    UNION ALL
+    SELECT (SELECT h.source FROM history h WHERE h.source > s.source ORDER BY h.source LIMIT 1)
+    FROM sources s
+    WHERE s.source IS NOT NULL
+)
+SELECT source FROM sources WHERE source IS NOT NULL`
+
+// Sources returns the sources that have history rows, in order.
+func Sources(ctx context.Context, pool *pgxpool.Pool) ([]string, error) {
+	rows, err := pool.Query(ctx, sourcesSql)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[string])
+}
+
 type slice struct {
 	readPool *pgxpool.Pool
-	source   string // distinguishes between this store and other stores that use the same table
+	source   string   // distinguishes between this store and other stores that use the same table
+	aliases  []string // other sources records are read from
 	from, to history.Record
 }
 
@@ -166,6 +204,7 @@ func (s slice) Slice(from, to history.Record) history.Slice {
 	return slice{
 		readPool: s.readPool,
 		source:   s.source,
+		aliases:  s.aliases,
 		from:     from,
 		to:       to,
 	}
@@ -230,7 +269,23 @@ func (s slice) Len(ctx context.Context) (int, error) {
 }
 
 func (s slice) sourceClause(clauses []string, args []any) ([]string, []any) {
-	return append(clauses, fmt.Sprintf("source = $%d", len(args)+1)), append(args, s.source)
+	return append(clauses, s.sourceMatch(len(args)+1)), append(args, s.sourceArg())
+}
+
+// sourceMatch returns an expression that matches rows from the slice's sources, using the sourceArg at idx.
+func (s slice) sourceMatch(idx int) string {
+	if len(s.aliases) == 0 {
+		return fmt.Sprintf("source = $%d", idx)
+	}
+	return fmt.Sprintf("source = ANY($%d)", idx)
+}
+
+// sourceArg returns the argument for sourceMatch.
+func (s slice) sourceArg() any {
+	if len(s.aliases) == 0 {
+		return s.source
+	}
+	return append([]string{s.source}, s.aliases...)
 }
 
 // lenRangeClause returns the where clauses and arguments for the Len operation.
@@ -274,14 +329,15 @@ func (s slice) readRangeClause(clauses []string, args []any) ([]string, []any, e
 		args = append(args, id)
 	case !s.from.CreateTime.IsZero():
 		sourceIdx := len(args) + 1
-		args = append(args, s.source)
+		args = append(args, s.sourceArg())
 		timeIdx := len(args) + 1
 		args = append(args, s.from.CreateTime)
+		source := s.sourceMatch(sourceIdx)
 		clauses = append(clauses, fmt.Sprintf(`id >= (
-	select min(id) from history where source = $%[1]d and create_time = (
-		select min(create_time) from history where source = $%[1]d and create_time >= $%[2]d
+	select min(id) from history where %[1]s and create_time = (
+		select min(create_time) from history where %[1]s and create_time >= $%[2]d
 	)
-)`, sourceIdx, timeIdx))
+)`, source, timeIdx))
 	}
 	switch {
 	case s.to.ID != "":
@@ -293,14 +349,15 @@ func (s slice) readRangeClause(clauses []string, args []any) ([]string, []any, e
 		args = append(args, id)
 	case !s.to.CreateTime.IsZero():
 		sourceIdx := len(args) + 1
-		args = append(args, s.source)
+		args = append(args, s.sourceArg())
 		timeIdx := len(args) + 1
 		args = append(args, s.to.CreateTime)
+		source := s.sourceMatch(sourceIdx)
 		clauses = append(clauses, fmt.Sprintf(`id <= (
-	select max(id) from history where source = $%[1]d and create_time = (
-		select max(create_time) from history where source = $%[1]d and create_time < $%[2]d
+	select max(id) from history where %[1]s and create_time = (
+		select max(create_time) from history where %[1]s and create_time < $%[2]d
 	)
-)`, sourceIdx, timeIdx))
+)`, source, timeIdx))
 	}
 	return clauses, args, nil
 }
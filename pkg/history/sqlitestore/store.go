@@ -89,6 +89,15 @@ func (d *Database) OpenStore(source string, opts ...WriteOption) *Store {
 	}
 }
 
+// OpenAliasedStore is like OpenStore but the store also reads records written under any of the aliases sources,
+// for example by a device before it was renamed.
+// Records are only written to source.
+func (d *Database) OpenAliasedStore(source string, aliases []string, opts ...WriteOption) *Store {
+	s := d.OpenStore(source, opts...)
+	s.aliases = aliases
+	return s
+}
+
 func (d *Database) Close() error {
 	return d.db.Close()
 }
@@ -179,7 +188,7 @@ func (d *Database) InsertBulk(ctx context.Context, records []Record, opts ...Wri
 // Returns number of records read and any error encountered.
 // Size of the slice limits the number of records to read.
 func (d *Database) Read(ctx context.Context, source string, from, to RecordID, desc bool, into []Record) (n int, err error) {
-	err = d.read(ctx, source, from, to, desc, func(record Record) bool {
+	err = d.read(ctx, sourceList(source), from, to, desc, func(record Record) bool {
 		if n >= len(into) {
 			return false
 		}
@@ -190,9 +199,10 @@ func (d *Database) Read(ctx context.Context, source string, from, to RecordID, d
 	return n, err
 }
 
-func (d *Database) read(ctx context.Context, source string, from, to RecordID, desc bool, cb func(Record) bool) error {
+// read calls cb with each record from any of sources, or all sources if sources is empty.
+func (d *Database) read(ctx context.Context, sources []string, from, to RecordID, desc bool, cb func(Record) bool) error {
 	err := d.db.ReadTx(ctx, func(tx *sql.Tx) error {
-		filters, args := buildFilters(source, from, to)
+		filters, args := buildFilters(sources, from, to)
 
 		order := "ASC"
 		if desc {
@@ -236,9 +246,14 @@ func (d *Database) read(ctx context.Context, source string, from, to RecordID, d
 }
 
 func (d *Database) Count(ctx context.Context, source string, from, to RecordID) (int, error) {
+	return d.count(ctx, sourceList(source), from, to)
+}
+
+// count returns the number of records from any of sources, or all sources if sources is empty.
+func (d *Database) count(ctx context.Context, sources []string, from, to RecordID) (int, error) {
 	var count int
 	err := d.db.ReadTx(ctx, func(tx *sql.Tx) error {
-		filters, args := buildFilters(source, from, to)
+		filters, args := buildFilters(sources, from, to)
 
 		query := fmt.Sprintf(`
 			SELECT COUNT(*)
@@ -270,6 +285,28 @@ func (d *Database) TotalCount(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+// Sources returns the sources that records have been written for, in order.
+// Sources may be returned that no longer have any records.
+func (d *Database) Sources(ctx context.Context) ([]string, error) {
+	var sources []string
+	err := d.db.ReadTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, "SELECT source FROM history_sources ORDER BY source")
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var source string
+			if err := rows.Scan(&source); err != nil {
+				return err
+			}
+			sources = append(sources, source)
+		}
+		return rows.Err()
+	})
+	return sources, err
+}
+
 // Clear deletes all history records and sources.
 // Returns the number of rows deleted from the history table.
 func (d *Database) Clear(ctx context.Context) (int64, error) {
@@ -419,6 +456,7 @@ type Record struct {
 type Store struct {
 	database *Database
 	source   string
+	aliases  []string      // other sources records are read from
 	opts     []WriteOption // passed to every write operation
 
 	from, to history.Record
@@ -445,6 +483,7 @@ func (s *Store) Slice(from, to history.Record) history.Slice {
 	return &Store{
 		database: s.database,
 		source:   s.source,
+		aliases:  s.aliases,
 		from:     from,
 		to:       to,
 		opts:     s.opts,
@@ -474,7 +513,7 @@ func (s *Store) read(ctx context.Context, into []history.Record, desc bool) (int
 	}
 
 	var n int
-	err = s.database.read(ctx, s.source, fromBound, toBound, desc, func(record Record) bool {
+	err = s.database.read(ctx, s.sources(), fromBound, toBound, desc, func(record Record) bool {
 		into[n] = history.Record{
 			ID:         record.ID.String(),
 			CreateTime: record.CreateTime,
@@ -496,7 +535,12 @@ func (s *Store) Len(ctx context.Context) (int, error) {
 		return 0, err
 	}
 
-	return s.database.Count(ctx, s.source, fromBound, toBound)
+	return s.database.count(ctx, s.sources(), fromBound, toBound)
+}
+
+// sources returns the sources records are read from.
+func (s *Store) sources() []string {
+	return append(sourceList(s.source), s.aliases...)
 }
 
 func calcBound(limit history.Record) (RecordID, error) {
@@ -517,15 +561,30 @@ func calcBound(limit history.Record) (RecordID, error) {
 	return 0, nil
 }
 
+// sourceList returns source as a list of sources to read from, where an empty list means all sources.
+func sourceList(source string) []string {
+	if source == "" {
+		return nil
+	}
+	return []string{source}
+}
+
 // builds an SQL term for filtering records based on source and ID range.
 // Also returns a slice of parameters to be passed when executing the query.
 // If no filtering is to be performed, returns a dummy condition to maintain valid SQL syntax.
-func buildFilters(source string, from, to RecordID) (string, []any) {
+func buildFilters(sources []string, from, to RecordID) (string, []any) {
 	var filters []string
 	var args []any
-	if source != "" {
+	switch len(sources) {
+	case 0:
+	case 1:
 		filters = []string{"history_sources.source = ?"}
-		args = []any{source}
+		args = []any{sources[0]}
+	default:
+		filters = []string{"history_sources.source IN (?" + strings.Repeat(", ?", len(sources)-1) + ")"}
+		for _, source := range sources {
+			args = append(args, source)
+		}
 	}
 
 	if from != 0 {
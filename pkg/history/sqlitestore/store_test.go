@@ -714,6 +714,42 @@ func TestStore_Append(t *testing.T) {
 	})
 }
 
+func TestDatabase_OpenAliasedStore(t *testing.T) {
+	db := newTestMemDB(t)
+	ctx := t.Context()
+
+	// history recorded before the device was renamed, twice
+	for _, source := range []string{"oldest", "old", "other"} {
+		if _, err := db.OpenStore(source).Append(ctx, []byte(source)); err != nil {
+			t.Fatalf("Append(%s) error: %v", source, err)
+		}
+	}
+	store := db.OpenAliasedStore("new", []string{"old", "oldest"})
+	if _, err := store.Append(ctx, []byte("new")); err != nil {
+		t.Fatalf("Append error: %v", err)
+	}
+
+	got := make([]history.Record, 10)
+	n, err := store.Read(ctx, got)
+	if err != nil {
+		t.Fatalf("Read error: %v", err)
+	}
+	var payloads []string
+	for _, r := range got[:n] {
+		payloads = append(payloads, string(r.Payload))
+	}
+	if diff := cmp.Diff([]string{"oldest", "old", "new"}, payloads); diff != "" {
+		t.Errorf("Read payloads (-want +got):\n%s", diff)
+	}
+	if n, err := store.Len(ctx); err != nil || n != 3 {
+		t.Errorf("Len() = %d, %v, want 3", n, err)
+	}
+	// writes only go to the store's own source
+	if n, err := db.Count(ctx, "new", 0, 0); err != nil || n != 1 {
+		t.Errorf("Count(new) = %d, %v, want 1", n, err)
+	}
+}
+
 func TestDatabase_TotalCount(t *testing.T) {
 	db := newTestMemDB(t)
 	ctx := t.Context()
@@ -745,6 +781,29 @@ func TestDatabase_TotalCount(t *testing.T) {
 	}
 }
 
+func TestDatabase_Sources(t *testing.T) {
+	db := newTestMemDB(t)
+	ctx := t.Context()
+
+	originTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	records := []Record{
+		{Source: "src2", CreateTime: originTime, Payload: []byte("a")},
+		{Source: "src1", CreateTime: originTime.Add(time.Millisecond), Payload: []byte("b")},
+		{Source: "src2", CreateTime: originTime.Add(2 * time.Millisecond), Payload: []byte("c")},
+	}
+	if err := db.InsertBulk(ctx, records); err != nil {
+		t.Fatalf("InsertBulk: %v", err)
+	}
+
+	sources, err := db.Sources(ctx)
+	if err != nil {
+		t.Fatalf("Sources: %v", err)
+	}
+	if diff := cmp.Diff([]string{"src1", "src2"}, sources); diff != "" {
+		t.Errorf("Sources (-want +got):\n%s", diff)
+	}
+}
+
 func TestDatabase_Clear(t *testing.T) {
 	db := newTestMemDB(t)
 	ctx := t.Context()
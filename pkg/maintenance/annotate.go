@@ -0,0 +1,58 @@
+package maintenance
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/alertpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/maintenancepb"
+)
+
+// AnnotateCheck returns c with its maintenance field describing the window the named device is in.
+// The maintenance field is cleared if the device isn't in maintenance.
+// c is cloned if it needs to change.
+func (w *Windows) AnnotateCheck(name string, c *healthpb.HealthCheck) *healthpb.HealthCheck {
+	var m *healthpb.HealthCheck_Maintenance
+	if active := w.Active(name); len(active) > 0 {
+		window := active[0]
+		m = &healthpb.HealthCheck_Maintenance{
+			WindowId: window.Id,
+			Reason:   window.Reason,
+			EndTime:  window.EndTime,
+		}
+	}
+	if proto.Equal(c.Maintenance, m) {
+		return c
+	}
+	c = proto.Clone(c).(*healthpb.HealthCheck)
+	c.Maintenance = m
+	return c
+}
+
+// AnnotateAlert returns the alert to create in place of a, based on the windows the source of a is in.
+// The returned alert is nil if the alert should not be created.
+// a is cloned if it needs to change.
+func (w *Windows) AnnotateAlert(a *alertpb.Alert) *alertpb.Alert {
+	active := w.Active(a.GetSource())
+	if len(active) == 0 {
+		return a
+	}
+	for _, window := range active {
+		if window.AlertMode == maintenancepb.MaintenanceWindow_SUPPRESS_ALERTS {
+			return nil
+		}
+	}
+	a = proto.Clone(a).(*alertpb.Alert)
+	note := "raised during maintenance"
+	if reason := active[0].Reason; reason != "" {
+		note = fmt.Sprintf("%s: %s", note, reason)
+	}
+	if a.Description == "" {
+		a.Description = note
+	} else {
+		a.Description = fmt.Sprintf("%s (%s)", a.Description, note)
+	}
+	return a
+}
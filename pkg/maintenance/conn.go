@@ -0,0 +1,83 @@
+package maintenance
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smart-core-os/sc-bos/pkg/node/priority"
+	"github.com/smart-core-os/sc-bos/pkg/proto/maintenancepb"
+)
+
+// readPrefixes are the method name prefixes of calls that don't change a device.
+var readPrefixes = []string{"Get", "List", "Pull", "Describe"}
+
+// Conn returns a grpc.ClientConnInterface that checks writes made by automations to the named device
+// against the windows the device is in, before passing them to conn.
+//
+// Writes are made by automations if they are at a lower priority than priority.Manual, like priority.Auto,
+// which auto.Services.ClientConn sets on the writes automations make.
+// Writes without priority metadata are manual, and are never blocked.
+func (w *Windows) Conn(name string, conn grpc.ClientConnInterface) grpc.ClientConnInterface {
+	return &guardedConn{windows: w, name: name, conn: conn}
+}
+
+type guardedConn struct {
+	windows *Windows
+	name    string
+	conn    grpc.ClientConnInterface
+}
+
+func (c *guardedConn) Invoke(ctx context.Context, fullMethod string, args any, reply any, opts ...grpc.CallOption) error {
+	if err := c.windows.checkWrite(ctx, c.name, fullMethod); err != nil {
+		return err
+	}
+	return c.conn.Invoke(ctx, fullMethod, args, reply, opts...)
+}
+
+func (c *guardedConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, fullMethod string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if err := c.windows.checkWrite(ctx, c.name, fullMethod); err != nil {
+		return nil, err
+	}
+	return c.conn.NewStream(ctx, desc, fullMethod, opts...)
+}
+
+// checkWrite returns an error if fullMethod is a write by an automation to a device in a window that blocks writes.
+func (w *Windows) checkWrite(ctx context.Context, name, fullMethod string) error {
+	if !isWrite(fullMethod) {
+		return nil
+	}
+	write, err := priority.FromOutgoingContext(ctx)
+	if err != nil || write.Level <= priority.Manual {
+		// writes with bad metadata are rejected by the priority arbiter, if there is one
+		return nil
+	}
+	for _, window := range w.Active(name) {
+		if window.WriteMode == maintenancepb.MaintenanceWindow_WARN_WRITES {
+			w.logger.Warn("automation writing to device in maintenance",
+				zap.String("name", name), zap.String("method", fullMethod), zap.String("owner", write.Owner),
+				zap.String("window", window.Id), zap.String("reason", window.Reason))
+			continue
+		}
+		if window.Reason == "" {
+			return status.Errorf(codes.FailedPrecondition, "%s is in maintenance", name)
+		}
+		return status.Errorf(codes.FailedPrecondition, "%s is in maintenance: %s", name, window.Reason)
+	}
+	return nil
+}
+
+func isWrite(fullMethod string) bool {
+	method := path.Base(fullMethod)
+	for _, prefix := range readPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,340 @@
+// Package maintenance tracks maintenance windows, periods when devices are being worked on.
+//
+// While a device is in an active window:
+//   - alerts raised for it are annotated or suppressed,
+//   - its health checks are marked as in maintenance,
+//   - writes to it made by automations are blocked or logged, see Windows.Conn.
+//
+// Devices are selected by name, by the zone in their metadata, or by a DevicesApi query.
+package maintenance
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/internal/sqlite"
+	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/maintenancepb"
+	"github.com/smart-core-os/sc-bos/pkg/util/masks"
+)
+
+// writableFields are the fields of a window that can be updated.
+var writableFields = &fieldmaskpb.FieldMask{Paths: []string{
+	"reason", "author", "start_time", "end_time", "selector", "alert_mode", "write_mode",
+}}
+
+// Windows holds the maintenance windows of a controller.
+// Windows returned by methods of Windows are shared and must not be modified.
+type Windows struct {
+	db      *sqlite.Database // nil if windows aren't persisted
+	devices *devicespb.Collection
+	logger  *zap.Logger
+	now     func() time.Time
+
+	mu        sync.Mutex
+	windows   map[string]*maintenancepb.MaintenanceWindow
+	timer     *time.Timer // fires at the next start or end of a window
+	listeners map[int]func()
+	nextID    int
+}
+
+// Option configures Windows.
+type Option func(w *Windows)
+
+// WithDevices sets the devices used to select devices by zone and query.
+// Without devices, windows only select devices by name.
+func WithDevices(devices *devicespb.Collection) Option {
+	return func(w *Windows) {
+		w.devices = devices
+	}
+}
+
+// WithLogger sets the logger used to report writes by automations to devices in maintenance.
+func WithLogger(logger *zap.Logger) Option {
+	return func(w *Windows) {
+		w.logger = logger
+	}
+}
+
+// WithNow sets the clock used to decide whether windows are active.
+func WithNow(now func() time.Time) Option {
+	return func(w *Windows) {
+		w.now = now
+	}
+}
+
+// New returns Windows that are kept in memory.
+func New(opts ...Option) *Windows {
+	w := &Windows{
+		logger:    zap.NewNop(),
+		now:       time.Now,
+		windows:   make(map[string]*maintenancepb.MaintenanceWindow),
+		listeners: make(map[int]func()),
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// Open returns Windows that are persisted in the SQLite database at path.
+// Close the Windows to close the database.
+func Open(ctx context.Context, path string, opts ...Option) (*Windows, error) {
+	w := New(opts...)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("mkdir: %w", err)
+	}
+	db, err := sqlite.Open(ctx, path,
+		sqlite.WithLogger(w.logger),
+		sqlite.WithApplicationID(appID),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Migrate(ctx, schema); err != nil {
+		return nil, errors.Join(err, db.Close())
+	}
+	windows, err := loadWindows(ctx, db)
+	if err != nil {
+		return nil, errors.Join(err, db.Close())
+	}
+	w.db = db
+	for _, window := range windows {
+		w.windows[window.Id] = window
+	}
+	w.mu.Lock()
+	w.scheduleLocked()
+	w.mu.Unlock()
+	return w, nil
+}
+
+func (w *Windows) Close() error {
+	w.mu.Lock()
+	if w.timer != nil {
+		w.timer.Stop()
+	}
+	w.mu.Unlock()
+	if w.db == nil {
+		return nil
+	}
+	return w.db.Close()
+}
+
+// List returns all windows ordered by start time.
+// If excludeEnded is true windows that have ended are not returned.
+func (w *Windows) List(excludeEnded bool) []*maintenancepb.MaintenanceWindow {
+	now := w.now()
+	w.mu.Lock()
+	var windows []*maintenancepb.MaintenanceWindow
+	for _, window := range w.windows {
+		if excludeEnded && ended(window, now) {
+			continue
+		}
+		windows = append(windows, window)
+	}
+	w.mu.Unlock()
+	sortWindows(windows)
+	return windows
+}
+
+// Get returns the window with the given id.
+func (w *Windows) Get(id string) (*maintenancepb.MaintenanceWindow, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	window, ok := w.windows[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "maintenance window %q", id)
+	}
+	return window, nil
+}
+
+// Create adds a new window, assigning its id and create time.
+func (w *Windows) Create(ctx context.Context, window *maintenancepb.MaintenanceWindow) (*maintenancepb.MaintenanceWindow, error) {
+	window = proto.Clone(window).(*maintenancepb.MaintenanceWindow)
+	window.Id = uuid.NewString()
+	window.CreateTime = timestamppb.New(w.now())
+	if err := validate(window); err != nil {
+		return nil, err
+	}
+	if err := w.save(ctx, window); err != nil {
+		return nil, err
+	}
+	return window, nil
+}
+
+// Update changes the fields of an existing window, identified by its id, mentioned in mask.
+// A nil mask updates all fields.
+func (w *Windows) Update(ctx context.Context, window *maintenancepb.MaintenanceWindow, mask *fieldmaskpb.FieldMask) (*maintenancepb.MaintenanceWindow, error) {
+	updater := masks.NewFieldUpdater(masks.WithWritableFields(writableFields), masks.WithUpdateMask(mask))
+	if err := updater.Validate(window); err != nil {
+		return nil, err
+	}
+	old, err := w.Get(window.GetId())
+	if err != nil {
+		return nil, err
+	}
+	updated := proto.Clone(old).(*maintenancepb.MaintenanceWindow)
+	updater.Merge(updated, proto.Clone(window))
+	if err := validate(updated); err != nil {
+		return nil, err
+	}
+	if err := w.save(ctx, updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// Delete removes the window with the given id.
+func (w *Windows) Delete(ctx context.Context, id string) error {
+	if _, err := w.Get(id); err != nil {
+		return err
+	}
+	if w.db != nil {
+		if err := deleteWindow(ctx, w.db, id); err != nil {
+			return status.Errorf(codes.Internal, "delete maintenance window: %v", err)
+		}
+	}
+	w.mu.Lock()
+	delete(w.windows, id)
+	w.scheduleLocked()
+	w.mu.Unlock()
+	w.notify()
+	return nil
+}
+
+func (w *Windows) save(ctx context.Context, window *maintenancepb.MaintenanceWindow) error {
+	if w.db != nil {
+		if err := saveWindow(ctx, w.db, window); err != nil {
+			return status.Errorf(codes.Internal, "save maintenance window: %v", err)
+		}
+	}
+	w.mu.Lock()
+	w.windows[window.Id] = window
+	w.scheduleLocked()
+	w.mu.Unlock()
+	w.notify()
+	return nil
+}
+
+// Active returns the windows the named device is in now, ordered by start time.
+func (w *Windows) Active(name string) []*maintenancepb.MaintenanceWindow {
+	now := w.now()
+	var device *devicespb.Device
+	if w.devices != nil {
+		device, _ = w.devices.GetDevice(name)
+	}
+	w.mu.Lock()
+	var windows []*maintenancepb.MaintenanceWindow
+	for _, window := range w.windows {
+		if active(window, now) && selects(window.GetSelector(), name, device) {
+			windows = append(windows, window)
+		}
+	}
+	w.mu.Unlock()
+	sortWindows(windows)
+	return windows
+}
+
+// OnChange calls f whenever the devices in maintenance may have changed:
+// when a window is created, updated, or deleted, and when a window starts or ends.
+// Call stop to stop calling f.
+func (w *Windows) OnChange(f func()) (stop func()) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	id := w.nextID
+	w.nextID++
+	w.listeners[id] = f
+	return func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		delete(w.listeners, id)
+	}
+}
+
+func (w *Windows) notify() {
+	w.mu.Lock()
+	listeners := make([]func(), 0, len(w.listeners))
+	for _, f := range w.listeners {
+		listeners = append(listeners, f)
+	}
+	w.mu.Unlock()
+	for _, f := range listeners {
+		f()
+	}
+}
+
+// scheduleLocked arranges for listeners to be notified at the next start or end of a window.
+func (w *Windows) scheduleLocked() {
+	if w.timer != nil {
+		w.timer.Stop()
+		w.timer = nil
+	}
+	now := w.now()
+	var next time.Time
+	for _, window := range w.windows {
+		for _, t := range []*timestamppb.Timestamp{window.StartTime, window.EndTime} {
+			if t == nil {
+				continue
+			}
+			if at := t.AsTime(); at.After(now) && (next.IsZero() || at.Before(next)) {
+				next = at
+			}
+		}
+	}
+	if next.IsZero() {
+		return
+	}
+	w.timer = time.AfterFunc(next.Sub(now), func() {
+		w.mu.Lock()
+		w.scheduleLocked()
+		w.mu.Unlock()
+		w.notify()
+	})
+}
+
+func validate(window *maintenancepb.MaintenanceWindow) error {
+	sel := window.GetSelector()
+	if len(sel.GetNames()) == 0 && len(sel.GetZones()) == 0 && sel.GetQuery() == nil {
+		return status.Error(codes.InvalidArgument, "selector must select at least one of names, zones, or query")
+	}
+	if window.StartTime != nil && window.EndTime != nil && !window.EndTime.AsTime().After(window.StartTime.AsTime()) {
+		return status.Error(codes.InvalidArgument, "end_time must be after start_time")
+	}
+	return nil
+}
+
+func active(window *maintenancepb.MaintenanceWindow, now time.Time) bool {
+	if window.StartTime != nil && now.Before(window.StartTime.AsTime()) {
+		return false
+	}
+	return !ended(window, now)
+}
+
+func ended(window *maintenancepb.MaintenanceWindow, now time.Time) bool {
+	return window.EndTime != nil && !now.Before(window.EndTime.AsTime())
+}
+
+func sortWindows(windows []*maintenancepb.MaintenanceWindow) {
+	slices.SortFunc(windows, func(a, b *maintenancepb.MaintenanceWindow) int {
+		// windows without a start time started first
+		return cmp.Or(
+			a.StartTime.AsTime().Compare(b.StartTime.AsTime()),
+			cmp.Compare(a.Id, b.Id),
+		)
+	})
+}
@@ -0,0 +1,257 @@
+package maintenance
+
+import (
+	"context"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/pkg/node/priority"
+	"github.com/smart-core-os/sc-bos/pkg/proto/alertpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/lightpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/maintenancepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
+	"github.com/smart-core-os/sc-bos/pkg/resource"
+	"github.com/smart-core-os/sc-bos/pkg/wrap"
+)
+
+func create(t *testing.T, w *Windows, window *maintenancepb.MaintenanceWindow) *maintenancepb.MaintenanceWindow {
+	t.Helper()
+	window, err := w.Create(context.Background(), window)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	return window
+}
+
+func activeIDs(w *Windows, name string) []string {
+	var ids []string
+	for _, window := range w.Active(name) {
+		ids = append(ids, window.Id)
+	}
+	return ids
+}
+
+func TestWindows_Active(t *testing.T) {
+	devices := devicespb.NewCollection()
+	for name, zone := range map[string]string{"ahu-01": "plant-room", "light-01": "floor-3", "light-02": "floor-4"} {
+		_, err := devices.Update(&devicespb.Device{
+			Name:     name,
+			Metadata: &metadatapb.Metadata{Location: &metadatapb.Metadata_Location{Zone: zone}},
+		}, resource.WithCreateIfAbsent())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	w := New(WithDevices(devices), WithNow(func() time.Time { return now }))
+
+	byName := create(t, w, &maintenancepb.MaintenanceWindow{
+		Selector: &maintenancepb.MaintenanceWindow_Selector{Names: []string{"ahu-01", "unknown"}},
+	})
+	byZone := create(t, w, &maintenancepb.MaintenanceWindow{
+		StartTime: timestamppb.New(now.Add(-time.Hour)),
+		EndTime:   timestamppb.New(now.Add(time.Hour)),
+		Selector:  &maintenancepb.MaintenanceWindow_Selector{Zones: []string{"floor-3"}},
+	})
+	byQuery := create(t, w, &maintenancepb.MaintenanceWindow{
+		Selector: &maintenancepb.MaintenanceWindow_Selector{Query: &devicespb.Device_Query{
+			Conditions: []*devicespb.Device_Query_Condition{
+				{Field: "name", Value: &devicespb.Device_Query_Condition_StringContains{StringContains: "light"}},
+			},
+		}},
+	})
+	create(t, w, &maintenancepb.MaintenanceWindow{
+		StartTime: timestamppb.New(now.Add(time.Hour)),
+		Selector:  &maintenancepb.MaintenanceWindow_Selector{Names: []string{"ahu-01"}},
+	})
+	create(t, w, &maintenancepb.MaintenanceWindow{
+		EndTime:  timestamppb.New(now),
+		Selector: &maintenancepb.MaintenanceWindow_Selector{Names: []string{"ahu-01"}},
+	})
+
+	tests := map[string][]string{
+		"ahu-01":   {byName.Id},
+		"unknown":  {byName.Id},
+		"light-01": {byZone.Id, byQuery.Id},
+		"light-02": {byQuery.Id},
+		"other":    nil,
+	}
+	for name, want := range tests {
+		got := activeIDs(w, name)
+		if len(got) != len(want) {
+			t.Errorf("Active(%q) = %v, want %v", name, got, want)
+			continue
+		}
+		for _, id := range want {
+			found := false
+			for _, g := range got {
+				found = found || g == id
+			}
+			if !found {
+				t.Errorf("Active(%q) = %v, want %v", name, got, want)
+				break
+			}
+		}
+	}
+	if got := len(w.List(true)); got != 4 {
+		t.Errorf("List(excludeEnded) has %d windows, want 4", got)
+	}
+}
+
+func TestWindows_validate(t *testing.T) {
+	w := New()
+	ctx := context.Background()
+	_, err := w.Create(ctx, &maintenancepb.MaintenanceWindow{Reason: "selects nothing"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Create() without selector error = %v, want InvalidArgument", err)
+	}
+	now := time.Now()
+	_, err = w.Create(ctx, &maintenancepb.MaintenanceWindow{
+		StartTime: timestamppb.New(now),
+		EndTime:   timestamppb.New(now.Add(-time.Minute)),
+		Selector:  &maintenancepb.MaintenanceWindow_Selector{Names: []string{"ahu-01"}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Create() ending before it starts error = %v, want InvalidArgument", err)
+	}
+
+	window := create(t, w, &maintenancepb.MaintenanceWindow{
+		Selector: &maintenancepb.MaintenanceWindow_Selector{Names: []string{"ahu-01"}},
+	})
+	_, err = w.Update(ctx, &maintenancepb.MaintenanceWindow{Id: window.Id, CreateTime: timestamppb.Now()},
+		&fieldmaskpb.FieldMask{Paths: []string{"create_time"}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Update() create_time error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "maintenance", "windows.sqlite3")
+	w, err := Open(ctx, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	window := create(t, w, &maintenancepb.MaintenanceWindow{
+		Reason:   "replacing filters",
+		Selector: &maintenancepb.MaintenanceWindow_Selector{Names: []string{"ahu-01"}},
+	})
+	_, err = w.Update(ctx, &maintenancepb.MaintenanceWindow{Id: window.Id, Reason: "replacing belts"},
+		&fieldmaskpb.FieldMask{Paths: []string{"reason"}})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	w, err = Open(ctx, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	got, err := w.Get(window.Id)
+	if err != nil {
+		t.Fatalf("Get() after reopening error = %v", err)
+	}
+	if got.Reason != "replacing belts" || got.Selector.GetNames()[0] != "ahu-01" {
+		t.Errorf("Get() after reopening = %v", got)
+	}
+}
+
+func TestWindows_OnChange(t *testing.T) {
+	w := New()
+	var changes atomic.Int32
+	stop := w.OnChange(func() { changes.Add(1) })
+	defer stop()
+
+	create(t, w, &maintenancepb.MaintenanceWindow{
+		StartTime: timestamppb.New(time.Now().Add(50 * time.Millisecond)),
+		Selector:  &maintenancepb.MaintenanceWindow_Selector{Names: []string{"ahu-01"}},
+	})
+	if got := changes.Load(); got != 1 {
+		t.Fatalf("changes after Create() = %d, want 1", got)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for changes.Load() < 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("not notified when the window started")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if len(w.Active("ahu-01")) != 1 {
+		t.Errorf("ahu-01 not in maintenance after the window started")
+	}
+}
+
+func TestWindows_Conn(t *testing.T) {
+	w := New()
+	model := lightpb.NewModel()
+	client := lightpb.NewLightApiClient(w.Conn("light", wrap.ServerToClient(lightpb.LightApi_ServiceDesc, lightpb.NewModelServer(model))))
+	ctx := context.Background()
+	autoCtx := priority.NewOutgoingContext(ctx, priority.Write{Level: priority.Auto, Owner: "lights"})
+	update := func(ctx context.Context) error {
+		_, err := client.UpdateBrightness(ctx, &lightpb.UpdateBrightnessRequest{Name: "light", Brightness: &lightpb.Brightness{LevelPercent: 50}})
+		return err
+	}
+
+	window := create(t, w, &maintenancepb.MaintenanceWindow{
+		Reason:   "replacing drivers",
+		Selector: &maintenancepb.MaintenanceWindow_Selector{Names: []string{"light"}},
+	})
+	if err := update(autoCtx); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("auto write in maintenance error = %v, want FailedPrecondition", err)
+	}
+	if err := update(ctx); err != nil {
+		t.Errorf("manual write in maintenance error = %v", err)
+	}
+	if _, err := client.GetBrightness(autoCtx, &lightpb.GetBrightnessRequest{Name: "light"}); err != nil {
+		t.Errorf("auto read in maintenance error = %v", err)
+	}
+
+	_, err := w.Update(ctx, &maintenancepb.MaintenanceWindow{Id: window.Id, WriteMode: maintenancepb.MaintenanceWindow_WARN_WRITES},
+		&fieldmaskpb.FieldMask{Paths: []string{"write_mode"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := update(autoCtx); err != nil {
+		t.Errorf("auto write in maintenance that warns error = %v", err)
+	}
+}
+
+func TestWindows_AnnotateAlert(t *testing.T) {
+	w := New()
+	alert := &alertpb.Alert{Source: "ahu-01", Description: "filter blocked"}
+	if got := w.AnnotateAlert(alert); got != alert {
+		t.Errorf("AnnotateAlert() not in maintenance = %v, want unchanged", got)
+	}
+
+	window := create(t, w, &maintenancepb.MaintenanceWindow{
+		Reason:   "replacing filters",
+		Selector: &maintenancepb.MaintenanceWindow_Selector{Names: []string{"ahu-01"}},
+	})
+	got := w.AnnotateAlert(alert)
+	if want := "filter blocked (raised during maintenance: replacing filters)"; got.GetDescription() != want {
+		t.Errorf("AnnotateAlert() description = %q, want %q", got.GetDescription(), want)
+	}
+	if alert.Description != "filter blocked" {
+		t.Errorf("AnnotateAlert() modified its argument")
+	}
+
+	_, err := w.Update(context.Background(), &maintenancepb.MaintenanceWindow{Id: window.Id, AlertMode: maintenancepb.MaintenanceWindow_SUPPRESS_ALERTS},
+		&fieldmaskpb.FieldMask{Paths: []string{"alert_mode"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := w.AnnotateAlert(alert); got != nil {
+		t.Errorf("AnnotateAlert() suppressed = %v, want nil", got)
+	}
+}
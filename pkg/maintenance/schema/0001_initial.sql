@@ -0,0 +1,6 @@
+CREATE TABLE maintenance_windows
+(
+    id      TEXT PRIMARY KEY,
+    -- A binary smartcore.bos.maintenance.v1.MaintenanceWindow proto message.
+    payload BLOB NOT NULL
+);
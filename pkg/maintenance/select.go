@@ -0,0 +1,25 @@
+package maintenance
+
+import (
+	"slices"
+
+	"github.com/smart-core-os/sc-bos/internal/manage/devices"
+	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/maintenancepb"
+)
+
+// selects returns whether sel selects the named device.
+// device is nil if the device isn't known, in which case it can only be selected by name.
+func selects(sel *maintenancepb.MaintenanceWindow_Selector, name string, device *devicespb.Device) bool {
+	if slices.Contains(sel.GetNames(), name) {
+		return true
+	}
+	if device == nil {
+		return false
+	}
+	if zone := device.GetMetadata().GetLocation().GetZone(); zone != "" && slices.Contains(sel.GetZones(), zone) {
+		return true
+	}
+	// a nil query matches all devices, but here it means the window doesn't select by query
+	return sel.GetQuery() != nil && devices.MatchesQuery(sel.GetQuery(), device)
+}
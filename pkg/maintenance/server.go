@@ -0,0 +1,67 @@
+package maintenance
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/maintenancepb"
+)
+
+// Server implements the MaintenanceApi using Windows.
+type Server struct {
+	maintenancepb.UnimplementedMaintenanceApiServer
+	windows *Windows
+}
+
+func NewServer(windows *Windows) *Server {
+	return &Server{windows: windows}
+}
+
+func (s *Server) ListMaintenanceWindows(_ context.Context, request *maintenancepb.ListMaintenanceWindowsRequest) (*maintenancepb.ListMaintenanceWindowsResponse, error) {
+	return &maintenancepb.ListMaintenanceWindowsResponse{
+		MaintenanceWindows: s.windows.List(request.ExcludeEnded),
+	}, nil
+}
+
+func (s *Server) GetMaintenanceWindow(_ context.Context, request *maintenancepb.GetMaintenanceWindowRequest) (*maintenancepb.MaintenanceWindow, error) {
+	return s.windows.Get(request.Id)
+}
+
+func (s *Server) CreateMaintenanceWindow(ctx context.Context, request *maintenancepb.CreateMaintenanceWindowRequest) (*maintenancepb.MaintenanceWindow, error) {
+	if request.MaintenanceWindow == nil {
+		return nil, status.Error(codes.InvalidArgument, "maintenance_window is required")
+	}
+	if request.MaintenanceWindow.Id != "" {
+		return nil, status.Error(codes.InvalidArgument, "id must not be set, it is assigned by the server")
+	}
+	return s.windows.Create(ctx, request.MaintenanceWindow)
+}
+
+func (s *Server) UpdateMaintenanceWindow(ctx context.Context, request *maintenancepb.UpdateMaintenanceWindowRequest) (*maintenancepb.MaintenanceWindow, error) {
+	if request.MaintenanceWindow.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "maintenance_window.id is required")
+	}
+	return s.windows.Update(ctx, request.MaintenanceWindow, request.UpdateMask)
+}
+
+func (s *Server) DeleteMaintenanceWindow(ctx context.Context, request *maintenancepb.DeleteMaintenanceWindowRequest) (*maintenancepb.DeleteMaintenanceWindowResponse, error) {
+	err := s.windows.Delete(ctx, request.Id)
+	if status.Code(err) == codes.NotFound && request.AllowMissing {
+		err = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &maintenancepb.DeleteMaintenanceWindowResponse{}, nil
+}
+
+func (s *Server) GetDeviceMaintenance(_ context.Context, request *maintenancepb.GetDeviceMaintenanceRequest) (*maintenancepb.GetDeviceMaintenanceResponse, error) {
+	if request.DeviceName == "" {
+		return nil, status.Error(codes.InvalidArgument, "device_name is required")
+	}
+	return &maintenancepb.GetDeviceMaintenanceResponse{
+		MaintenanceWindows: s.windows.Active(request.DeviceName),
+	}, nil
+}
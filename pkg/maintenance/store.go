@@ -0,0 +1,64 @@
+package maintenance
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smart-core-os/sc-bos/internal/sqlite"
+	"github.com/smart-core-os/sc-bos/pkg/proto/maintenancepb"
+)
+
+const appID = 0x5C0504
+
+//go:embed schema/*.sql
+var schemaVersionsFS embed.FS
+var schema = sqlite.MustLoadVersionedSchema(schemaVersionsFS, "schema")
+
+func loadWindows(ctx context.Context, db *sqlite.Database) ([]*maintenancepb.MaintenanceWindow, error) {
+	var windows []*maintenancepb.MaintenanceWindow
+	err := db.ReadTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, "SELECT id, payload FROM maintenance_windows")
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var id string
+			var payload []byte
+			if err := rows.Scan(&id, &payload); err != nil {
+				return err
+			}
+			w := &maintenancepb.MaintenanceWindow{}
+			if err := proto.Unmarshal(payload, w); err != nil {
+				return fmt.Errorf("window %s: %w", id, err)
+			}
+			windows = append(windows, w)
+		}
+		return rows.Err()
+	})
+	return windows, err
+}
+
+func saveWindow(ctx context.Context, db *sqlite.Database, w *maintenancepb.MaintenanceWindow) error {
+	payload, err := proto.Marshal(w)
+	if err != nil {
+		return err
+	}
+	return db.WriteTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx,
+			"INSERT INTO maintenance_windows (id, payload) VALUES (?, ?) ON CONFLICT (id) DO UPDATE SET payload = excluded.payload",
+			w.Id, payload)
+		return err
+	})
+}
+
+func deleteWindow(ctx context.Context, db *sqlite.Database, id string) error {
+	return db.WriteTx(ctx, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, "DELETE FROM maintenance_windows WHERE id = ?", id)
+		return err
+	})
+}
@@ -0,0 +1,27 @@
+package enrollment
+
+import (
+	"time"
+)
+
+type Option func(server *Server)
+
+// WithRenewBefore configures how long before the enrolled certificate expires that AutoRenew asks the hub to renew it.
+// If d is zero, not set, or not shorter than the certificates validity period,
+// renewal happens after 75% of the certificates validity period has passed.
+func WithRenewBefore(d time.Duration) Option {
+	return func(server *Server) {
+		server.renewBefore = d
+	}
+}
+
+// WithRevocationPollInterval configures how often PollRevocations fetches the list of revoked certificates from the hub.
+// Defaults to DefaultRevocationPollInterval, which is also used if d is not positive.
+func WithRevocationPollInterval(d time.Duration) Option {
+	return func(server *Server) {
+		if d <= 0 {
+			return
+		}
+		server.revocationPollInterval = d
+	}
+}
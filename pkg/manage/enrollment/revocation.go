@@ -0,0 +1,164 @@
+package enrollment
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/smart-core-os/sc-bos/internal/util/pki"
+	"github.com/smart-core-os/sc-bos/pkg/proto/hubpb"
+)
+
+// DefaultRevocationPollInterval is how often PollRevocations fetches revoked certificates from the hub by default.
+const DefaultRevocationPollInterval = 5 * time.Minute
+
+const revocationsFile = "revocations.json"
+
+type revokedCertJSON struct {
+	Issuer       []byte `json:"issuer"`
+	SerialNumber []byte `json:"serial_number"`
+}
+
+// LoadRevocations loads revoked certificates previously saved to dir by SaveRevocations.
+// Returns no certificates and no error if no revocations have been saved.
+func LoadRevocations(dir string) ([]pki.RevokedCert, error) {
+	raw, err := os.ReadFile(filepath.Join(dir, revocationsFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var entries []revokedCertJSON
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		return nil, err
+	}
+	certs := make([]pki.RevokedCert, len(entries))
+	for i, e := range entries {
+		certs[i] = pki.RevokedCert{RawIssuer: e.Issuer, SerialNumber: new(big.Int).SetBytes(e.SerialNumber)}
+	}
+	return certs, nil
+}
+
+// SaveRevocations saves revoked certificates to dir so they are enforced even if the hub can't be reached after a
+// restart.
+func SaveRevocations(dir string, certs []pki.RevokedCert) error {
+	entries := make([]revokedCertJSON, len(certs))
+	for i, c := range certs {
+		entries[i] = revokedCertJSON{Issuer: c.RawIssuer, SerialNumber: c.SerialNumber.Bytes()}
+	}
+	jsonBytes, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, revocationsFile), jsonBytes, 0640)
+}
+
+// Revoked implements pki.Revoker, reporting whether the hub this node is enrolled with has revoked cert.
+// The list of revoked certificates is kept up to date by PollRevocations.
+func (es *Server) Revoked(cert *x509.Certificate) bool {
+	return es.revoked.Revoked(cert)
+}
+
+// PollRevocations periodically fetches the list of revoked certificates from the hub this node is enrolled with.
+// While not enrolled the list of revoked certificates is empty.
+// PollRevocations blocks until ctx is done.
+func (es *Server) PollRevocations(ctx context.Context) error {
+	enrollments := es.Enrollments(ctx)
+	var ticker *time.Ticker
+	var tickerC <-chan time.Time
+	stopTicker := func() {
+		if ticker != nil {
+			ticker.Stop()
+			ticker = nil
+			tickerC = nil
+		}
+	}
+	defer stopTicker()
+
+	var failedAttempts int
+	refresh := func() {
+		err := es.RefreshRevocations(ctx)
+		if err != nil {
+			failedAttempts++
+			if failedAttempts == 1 {
+				es.logger.Warn("Failed to fetch revoked certificates from hub, will retry", zap.Error(err))
+			} else if failedAttempts%20 == 0 {
+				es.logger.Warn("Still failing to fetch revoked certificates from hub", zap.Error(err), zap.Int("attempts", failedAttempts))
+			}
+			return
+		}
+		failedAttempts = 0
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case enrollment, ok := <-enrollments:
+			if !ok {
+				return ctx.Err()
+			}
+			stopTicker()
+			if enrollment.IsZero() {
+				es.revoked.Set()
+				continue
+			}
+			ticker = time.NewTicker(es.revocationPollInterval)
+			tickerC = ticker.C
+			refresh()
+		case <-tickerC:
+			refresh()
+		}
+	}
+}
+
+// RefreshRevocations fetches the list of revoked certificates from the hub this node is enrolled with.
+// Errors if this node is not enrolled with a hub.
+func (es *Server) RefreshRevocations(ctx context.Context) error {
+	conn, err := es.dialManager()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client := hubpb.NewHubApiClient(conn)
+
+	var certs []pki.RevokedCert
+	req := &hubpb.ListRevokedCertificatesRequest{PageSize: 1000}
+	for {
+		res, err := client.ListRevokedCertificates(ctx, req)
+		if err != nil {
+			return err
+		}
+		for _, rc := range res.RevokedCertificates {
+			certs = append(certs, pki.RevokedCert{
+				RawIssuer:    rc.GetIssuer(),
+				SerialNumber: new(big.Int).SetBytes(rc.GetSerialNumber()),
+			})
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+
+	es.m.Lock()
+	defer es.m.Unlock()
+	select {
+	case <-es.done:
+	default:
+		// we were unenrolled while fetching
+		return ErrNotEnrolled
+	}
+	es.revoked.Set(certs...)
+	if err := SaveRevocations(es.dir, certs); err != nil {
+		es.logger.Warn("Failed to save revoked certificates", zap.Error(err))
+	}
+	return nil
+}
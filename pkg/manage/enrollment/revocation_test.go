@@ -0,0 +1,199 @@
+package enrollment
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/hubpb"
+)
+
+func TestServer_renewTime(t *testing.T) {
+	notBefore := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	leaf := &x509.Certificate{NotBefore: notBefore, NotAfter: notBefore.Add(100 * 24 * time.Hour)}
+	tests := []struct {
+		name        string
+		renewBefore time.Duration
+		want        time.Time
+	}{
+		{"default", 0, notBefore.Add(75 * 24 * time.Hour)},
+		{"renewBefore", 10 * 24 * time.Hour, notBefore.Add(90 * 24 * time.Hour)},
+		{"renewBefore longer than validity", 200 * 24 * time.Hour, notBefore.Add(75 * 24 * time.Hour)},
+		{"renewBefore equal to validity", 100 * 24 * time.Hour, notBefore.Add(75 * 24 * time.Hour)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			es := NewServer(t.TempDir(), nil, zap.NewNop(), WithRenewBefore(tt.renewBefore))
+			if got := es.renewTime(leaf); !got.Equal(tt.want) {
+				t.Errorf("renewTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithRevocationPollInterval(t *testing.T) {
+	for _, d := range []time.Duration{0, -time.Minute} {
+		es := NewServer(t.TempDir(), nil, zap.NewNop(), WithRevocationPollInterval(d))
+		if es.revocationPollInterval != DefaultRevocationPollInterval {
+			t.Errorf("WithRevocationPollInterval(%v) interval = %v, want %v", d, es.revocationPollInterval, DefaultRevocationPollInterval)
+		}
+	}
+}
+
+func TestServer_PollRevocations(t *testing.T) {
+	ca := newTestCA(t)
+	revokedCert := ca.issue(t, 42)
+	hub := &fakeHub{revoked: []*hubpb.RevokedCertificate{
+		{Issuer: revokedCert.Leaf.RawIssuer, SerialNumber: revokedCert.Leaf.SerialNumber.Bytes()},
+	}}
+	hubAddr := serveHub(t, ca, hub)
+
+	dir := t.TempDir()
+	es := NewServer(dir, nil, zap.NewNop(), WithRevocationPollInterval(10*time.Millisecond))
+	if es.Revoked(revokedCert.Leaf) {
+		t.Fatal("cert revoked before enrollment")
+	}
+	es.m.Lock()
+	es.enrollment = Enrollment{ManagerAddress: hubAddr, RootCA: ca.cert.Leaf, Cert: *ca.issue(t, 1)}
+	close(es.done)
+	es.m.Unlock()
+
+	ctx, stop := context.WithCancel(t.Context())
+	defer stop()
+	polled := make(chan error, 1)
+	go func() { polled <- es.PollRevocations(ctx) }()
+
+	waitFor(t, func() bool { return es.Revoked(revokedCert.Leaf) })
+	if es.Revoked(ca.issue(t, 43).Leaf) {
+		t.Error("cert not listed by hub should not be revoked")
+	}
+	saved, err := LoadRevocations(dir)
+	if err != nil {
+		t.Fatalf("LoadRevocations: %v", err)
+	}
+	if len(saved) != 1 || saved[0].SerialNumber.Int64() != 42 {
+		t.Errorf("saved revocations = %v, want serial 42", saved)
+	}
+
+	// changes on the hub are picked up by later polls
+	hub.set(nil)
+	waitFor(t, func() bool { return !es.Revoked(revokedCert.Leaf) })
+
+	stop()
+	if err := <-polled; err == nil {
+		t.Error("PollRevocations returned nil after ctx was cancelled")
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+type fakeHub struct {
+	hubpb.UnimplementedHubApiServer
+	mu      sync.Mutex
+	revoked []*hubpb.RevokedCertificate
+}
+
+func (h *fakeHub) set(revoked []*hubpb.RevokedCertificate) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.revoked = revoked
+}
+
+func (h *fakeHub) ListRevokedCertificates(context.Context, *hubpb.ListRevokedCertificatesRequest) (*hubpb.ListRevokedCertificatesResponse, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return &hubpb.ListRevokedCertificatesResponse{RevokedCertificates: h.revoked}, nil
+}
+
+func serveHub(t *testing.T, ca *testCA, hub hubpb.HubApiServer) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{*ca.issue(t, 2)}})))
+	hubpb.RegisterHubApiServer(srv, hub)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
+
+type testCA struct {
+	cert *tls.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: &tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}, key: key}
+}
+
+// issue returns a new certificate signed by the CA with the given serial number.
+func (ca *testCA) issue(t *testing.T, serial int64) *tls.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert.Leaf, key.Public(), ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
@@ -34,6 +34,10 @@ type Server struct {
 	done       chan struct{}
 
 	enrollmentChanged minibus.Bus[Enrollment]
+
+	renewBefore            time.Duration
+	revocationPollInterval time.Duration
+	revoked                pki.RevocationList
 }
 
 func (es *Server) GetEnrollment(_ context.Context, _ *enrollmentpb.GetEnrollmentRequest) (*enrollmentpb.Enrollment, error) {
@@ -58,12 +62,16 @@ func (es *Server) GetEnrollment(_ context.Context, _ *enrollmentpb.GetEnrollment
 // NewServer creates an enrollment server, without attempting to load an existing enrollment.
 // The new server will be in an un-enrolled state.
 // New enrollments will be saved in the provided directory.
-func NewServer(dir string, keyPEM []byte, logger *zap.Logger) *Server {
+func NewServer(dir string, keyPEM []byte, logger *zap.Logger, opts ...Option) *Server {
 	es := &Server{
-		logger: logger,
-		dir:    dir,
-		keyPEM: keyPEM,
-		done:   make(chan struct{}),
+		logger:                 logger,
+		dir:                    dir,
+		keyPEM:                 keyPEM,
+		done:                   make(chan struct{}),
+		revocationPollInterval: DefaultRevocationPollInterval,
+	}
+	for _, opt := range opts {
+		opt(es)
 	}
 	return es
 }
@@ -71,12 +79,17 @@ func NewServer(dir string, keyPEM []byte, logger *zap.Logger) *Server {
 // LoadOrCreateServer will try to load an enrollment from disk. If successful, a server in the enrolled state is
 // returned. Otherwise, a server in the unenrolled state is returned and new enrollments will be saved in the
 // provided directory.
-func LoadOrCreateServer(dir string, keyPEM []byte, logger *zap.Logger) (*Server, error) {
-	es := NewServer(dir, keyPEM, logger)
+func LoadOrCreateServer(dir string, keyPEM []byte, logger *zap.Logger, opts ...Option) (*Server, error) {
+	es := NewServer(dir, keyPEM, logger, opts...)
 	enrollment, err := LoadEnrollment(dir, keyPEM)
 	if err == nil {
 		es.enrollment = enrollment
 		close(es.done)
+		revoked, err := LoadRevocations(dir)
+		if err != nil {
+			logger.Warn("failed to load revoked certificates, will fetch from hub", zap.Error(err))
+		}
+		es.revoked.Set(revoked...)
 		logger.Info("The controller is enrolled with a hub", zap.String("hubAddress", enrollment.ManagerAddress))
 	} else if !errors.Is(err, ErrNotEnrolled) {
 		return nil, err
@@ -323,26 +336,13 @@ func (es *Server) certsLocked() (*tls.Certificate, []*x509.Certificate, error) {
 // Errors if this node is not enrolled with a hub.
 func (es *Server) RequestRenew(ctx context.Context) error {
 	es.m.Lock()
-	clientCert, roots, err := es.certsLocked()
-	hubAddress := es.enrollment.ManagerAddress
 	localAddress := es.enrollment.LocalAddress
 	es.m.Unlock()
-
-	if err != nil {
-		return err
-	}
-	if hubAddress == "" {
-		return errors.New("hub address not known")
-	}
 	if localAddress == "" {
 		return errors.New("local address not known")
 	}
 
-	source := pki.FuncSource(func() (*tls.Certificate, []*x509.Certificate, error) {
-		return clientCert, roots, err
-	})
-	tlsConfig := pki.TLSClientConfig(source)
-	conn, err := grpc.NewClient(hubAddress, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	conn, err := es.dialManager()
 	if err != nil {
 		return err
 	}
@@ -354,9 +354,32 @@ func (es *Server) RequestRenew(ctx context.Context) error {
 	return err
 }
 
-func (es *Server) AutoRenew(ctx context.Context) error {
-	const afterProgress = 0.75
+// dialManager opens a connection to the hub this node is enrolled with, using the enrollment certificate.
+// Errors if this node is not enrolled with a hub.
+func (es *Server) dialManager() (*grpc.ClientConn, error) {
+	es.m.Lock()
+	clientCert, roots, err := es.certsLocked()
+	hubAddress := es.enrollment.ManagerAddress
+	es.m.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+	if hubAddress == "" {
+		return nil, errors.New("hub address not known")
+	}
+
+	source := pki.FuncSource(func() (*tls.Certificate, []*x509.Certificate, error) {
+		return clientCert, roots, nil
+	})
+	tlsConfig := pki.TLSClientConfig(source)
+	return grpc.NewClient(hubAddress, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+}
 
+// AutoRenew asks the hub to renew the enrolled certificate before it expires, retrying on failure.
+// See WithRenewBefore for configuring when renewal happens.
+// AutoRenew blocks until ctx is done.
+func (es *Server) AutoRenew(ctx context.Context) error {
 	enrollments := es.Enrollments(ctx)
 	var renewAfter *time.Timer
 	var timerC <-chan time.Time
@@ -403,9 +426,7 @@ func (es *Server) AutoRenew(ctx context.Context) error {
 				es.logger.Error("Unexpected cert parsing error during auto-renewal check", zap.Error(err))
 				continue
 			}
-			maxAge := leaf.NotAfter.Sub(leaf.NotBefore)
-			renewAge := time.Duration(float64(maxAge) * afterProgress)
-			renewTime := leaf.NotBefore.Add(renewAge)
+			renewTime := es.renewTime(leaf)
 			now := time.Now()
 			renewDelay := renewTime.Sub(now)
 			es.logger.Debug("Auto-renewal of enrolled certificate scheduled", zap.Time("at", renewTime))
@@ -429,6 +450,18 @@ func (es *Server) AutoRenew(ctx context.Context) error {
 	}
 }
 
+// renewTime returns when the enrolled certificate leaf should be renewed.
+func (es *Server) renewTime(leaf *x509.Certificate) time.Time {
+	maxAge := leaf.NotAfter.Sub(leaf.NotBefore)
+	// a lead time that isn't shorter than the cert is valid for would renew every new cert straight away
+	if es.renewBefore > 0 && es.renewBefore < maxAge {
+		return leaf.NotAfter.Add(-es.renewBefore)
+	}
+	const afterProgress = 0.75
+	renewAge := time.Duration(float64(maxAge) * afterProgress)
+	return leaf.NotBefore.Add(renewAge)
+}
+
 // Enrollments returns a chan that emits whenever the enrollment status or properties for this server change.
 func (es *Server) Enrollments(ctx context.Context) <-chan Enrollment {
 	changes := es.enrollmentChanged.Listen(ctx)
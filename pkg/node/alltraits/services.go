@@ -18,6 +18,7 @@ import (
 	"github.com/smart-core-os/sc-bos/pkg/proto/colorpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/countpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/dataretentionpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/demandresponsepb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/driver/dalipb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/electricpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/emergencylightpb"
@@ -98,7 +99,8 @@ var serviceRegistry = map[trait.Name][]grpc.ServiceDesc{
 	buttonpb.TraitName:         {buttonpb.ButtonApi_ServiceDesc},
 	dalipb.TraitName:           {dalipb.DaliApi_ServiceDesc},
 	dataretentionpb.TraitName:  {dataretentionpb.DataRetentionApi_ServiceDesc, dataretentionpb.DataRetentionInfo_ServiceDesc},
-	emergencylightpb.TraitName: {dalipb.DaliApi_ServiceDesc, emergencylightpb.EmergencyLightApi_ServiceDesc},
+	demandresponsepb.TraitName: {demandresponsepb.DemandResponseApi_ServiceDesc},
+	emergencylightpb.TraitName: {dalipb.DaliApi_ServiceDesc, emergencylightpb.EmergencyLightApi_ServiceDesc, emergencylightpb.EmergencyLightHistory_ServiceDesc},
 	healthpb.TraitName:         {healthpb.HealthApi_ServiceDesc, healthpb.HealthHistory_ServiceDesc},
 	meterpb.TraitName:          {meterpb.MeterApi_ServiceDesc, meterpb.MeterInfo_ServiceDesc, meterpb.MeterHistory_ServiceDesc},
 	mqttpb.TraitName:           {mqttpb.MqttService_ServiceDesc},
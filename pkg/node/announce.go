@@ -236,6 +236,7 @@ func HasServer[S any](register func(registrar grpc.ServiceRegistrar, srv S), srv
 
 // HasServices indicates that conn serves the provided name-routable services, and that the name of this announcement
 // is a valid name to use with each service.
+// Writes to conn are not arbitrated by this node, conn is expected to be another node that arbitrates them.
 //
 // Panics if any of the provided services is not registered with the protobuf global registry.
 func HasServices(conn grpc.ClientConnInterface, services ...grpc.ServiceDesc) Feature {
@@ -256,7 +257,7 @@ func HasServices(conn grpc.ClientConnInterface, services ...grpc.ServiceDesc) Fe
 func HasReflectedServices(conn grpc.ClientConnInterface, services ...protoreflect.ServiceDescriptor) Feature {
 	return featureFunc(func(a *announcement) {
 		for _, s := range services {
-			a.services = append(a.services, service{desc: s, conn: conn, nameRouting: true})
+			a.services = append(a.services, service{desc: s, conn: conn, nameRouting: true, remote: true})
 		}
 	})
 }
@@ -360,4 +361,5 @@ type service struct {
 	desc        protoreflect.ServiceDescriptor
 	conn        grpc.ClientConnInterface // if nil, just ensure the service is registered but don't add any routes
 	nameRouting bool
+	remote      bool // conn is served by another node, which arbitrates writes
 }
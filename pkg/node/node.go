@@ -14,6 +14,7 @@ import (
 	"github.com/smart-core-os/sc-bos/pkg/node/alltraits"
 	"github.com/smart-core-os/sc-bos/pkg/node/internal/metadatadevices"
 	"github.com/smart-core-os/sc-bos/pkg/node/internal/parentdevices"
+	"github.com/smart-core-os/sc-bos/pkg/node/priority"
 	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/parentpb"
@@ -29,8 +30,10 @@ import (
 // Call Support to add new features to the Node.
 // Calling Support after Register will not have any effect on the served apis.
 type Node struct {
-	name   string
-	router *router.Router
+	name        string
+	router      *router.Router
+	arbiter     *priority.Arbiter    // nil if writes aren't arbitrated
+	maintenance nodeopts.ConnWrapper // nil if writes aren't checked against maintenance windows
 
 	// mu protects writes to devices and mlLists.
 	// The devices model is consistent when accessed concurrently,
@@ -65,11 +68,13 @@ func New(name string, opts ...Option) *Node {
 	}
 
 	node := &Node{
-		name:    name,
-		router:  cfg.Router,
-		devices: cfg.Store,
-		mlLists: make(map[string]*metadataList),
-		Logger:  zap.NewNop(),
+		name:        name,
+		router:      cfg.Router,
+		arbiter:     cfg.Arbiter,
+		maintenance: cfg.Maintenance,
+		devices:     cfg.Store,
+		mlLists:     make(map[string]*metadataList),
+		Logger:      zap.NewNop(),
 	}
 
 	// nodes implement the MetadataApi without using the router,
@@ -119,6 +124,13 @@ func (n *Node) announceLocked(name string, features ...Feature) Undo {
 	services := allServices(a, n.Logger)
 	for _, s := range services {
 		serviceName := s.desc.FullName()
+		if n.arbiter != nil && s.conn != nil && !s.remote {
+			s.conn = n.arbiter.Conn(name, s.conn)
+		}
+		// checked before arbitration so blocked writes don't occupy a priority level
+		if n.maintenance != nil && s.conn != nil && !s.remote {
+			s.conn = n.maintenance.Conn(name, s.conn)
+		}
 		undoRoute, err := registerDeviceRoute(n.router, name, s)
 		if err != nil {
 			log.Errorf("cannot register service %s for %q: %v", serviceName, name, err)
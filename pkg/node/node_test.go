@@ -1,6 +1,7 @@
 package node
 
 import (
+	"context"
 	"slices"
 	"strings"
 	"testing"
@@ -12,9 +13,15 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/testing/protocmp"
 
+	"github.com/smart-core-os/sc-bos/internal/node/nodeopts"
+	"github.com/smart-core-os/sc-bos/pkg/maintenance"
+	"github.com/smart-core-os/sc-bos/pkg/node/priority"
 	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/maintenancepb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/onoffpb"
 	"github.com/smart-core-os/sc-bos/pkg/trait"
+	"github.com/smart-core-os/sc-bos/pkg/wrap"
 )
 
 func TestNode_Announce_metadata(t *testing.T) {
@@ -261,3 +268,63 @@ func TestNode_ListDevices(t *testing.T) {
 func dev(md *metadatapb.Metadata) *devicespb.Device {
 	return &devicespb.Device{Name: md.Name, Metadata: md}
 }
+
+func TestNode_arbiter(t *testing.T) {
+	arbiter := priority.NewArbiter()
+	n := New("test", nodeopts.WithArbiter(arbiter))
+	local, remote := onoffpb.NewModel(), onoffpb.NewModel()
+	n.Announce("local", HasServer(onoffpb.RegisterOnOffApiServer, onoffpb.OnOffApiServer(onoffpb.NewModelServer(local))))
+	remoteConn := wrap.ServerToClient(onoffpb.OnOffApi_ServiceDesc, onoffpb.NewModelServer(remote))
+	n.Announce("remote", HasServices(remoteConn, onoffpb.OnOffApi_ServiceDesc))
+
+	client := onoffpb.NewOnOffApiClient(n.ClientConn())
+	ctx := context.Background()
+	autoCtx := priority.NewOutgoingContext(ctx, priority.Write{Level: priority.Auto})
+	write := func(ctx context.Context, name string, state onoffpb.OnOff_State) {
+		t.Helper()
+		_, err := client.UpdateOnOff(ctx, &onoffpb.UpdateOnOffRequest{Name: name, OnOff: &onoffpb.OnOff{State: state}})
+		if err != nil {
+			t.Fatalf("UpdateOnOff %s: %v", name, err)
+		}
+	}
+	for _, name := range []string{"local", "remote"} {
+		write(ctx, name, onoffpb.OnOff_ON)
+		write(autoCtx, name, onoffpb.OnOff_OFF)
+	}
+
+	// the manual write to the local device wins, the remote node arbitrates its own writes
+	if got, _ := local.GetOnOff(); got.State != onoffpb.OnOff_ON {
+		t.Errorf("local state = %v, want ON", got.State)
+	}
+	if got, _ := remote.GetOnOff(); got.State != onoffpb.OnOff_OFF {
+		t.Errorf("remote state = %v, want OFF", got.State)
+	}
+	if got := arbiter.Arrays("remote"); len(got) != 0 {
+		t.Errorf("remote has %d priority arrays, want 0", len(got))
+	}
+}
+
+func TestNode_maintenance(t *testing.T) {
+	windows := maintenance.New()
+	arbiter := priority.NewArbiter()
+	n := New("test", nodeopts.WithArbiter(arbiter), nodeopts.WithMaintenance(windows))
+	model := onoffpb.NewModel()
+	n.Announce("local", HasServer(onoffpb.RegisterOnOffApiServer, onoffpb.OnOffApiServer(onoffpb.NewModelServer(model))))
+	_, err := windows.Create(context.Background(), &maintenancepb.MaintenanceWindow{
+		Selector: &maintenancepb.MaintenanceWindow_Selector{Names: []string{"local"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := onoffpb.NewOnOffApiClient(n.ClientConn())
+	autoCtx := priority.NewOutgoingContext(context.Background(), priority.Write{Level: priority.Auto})
+	_, err = client.UpdateOnOff(autoCtx, &onoffpb.UpdateOnOffRequest{Name: "local", OnOff: &onoffpb.OnOff{State: onoffpb.OnOff_ON}})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("auto write in maintenance error = %v, want FailedPrecondition", err)
+	}
+	// blocked writes don't occupy a level
+	if got := arbiter.Arrays("local"); len(got) != 0 {
+		t.Errorf("local has %d priority arrays, want 0", len(got))
+	}
+}
@@ -0,0 +1,441 @@
+package priority
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smart-core-os/sc-bos/pkg/wrap"
+)
+
+// replayTimeout limits how long applying a value after its level is vacated can take.
+const replayTimeout = 30 * time.Second
+
+// Arbiter holds the priority arrays for the devices of a node.
+// Use Conn to arbitrate the writes made to a device.
+type Arbiter struct {
+	defaultLease time.Duration
+	logger       *zap.Logger
+	now          func() time.Time
+
+	mu     sync.Mutex
+	writes map[target]*writes
+}
+
+// Option configures an Arbiter.
+type Option func(a *Arbiter)
+
+// WithDefaultLease sets the lease of writes that don't specify a level, like those from the Ops UI.
+// Defaults to DefaultLease, zero means these writes occupy the Manual level until they are relinquished.
+func WithDefaultLease(d time.Duration) Option {
+	return func(a *Arbiter) {
+		a.defaultLease = d
+	}
+}
+
+// WithLogger sets the logger used to report failures applying values after a level is vacated.
+func WithLogger(logger *zap.Logger) Option {
+	return func(a *Arbiter) {
+		a.logger = logger
+	}
+}
+
+// WithNow sets the clock used to expire leases.
+func WithNow(now func() time.Time) Option {
+	return func(a *Arbiter) {
+		a.now = now
+	}
+}
+
+func NewArbiter(opts ...Option) *Arbiter {
+	a := &Arbiter{
+		defaultLease: DefaultLease,
+		logger:       zap.NewNop(),
+		now:          time.Now,
+		writes:       make(map[target]*writes),
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// Conn returns a grpc.ClientConnInterface that arbitrates writes to the named device before passing them to conn.
+// Writes are unary methods whose name starts with Update, other calls are passed to conn unchanged.
+func (a *Arbiter) Conn(name string, conn grpc.ClientConnInterface) grpc.ClientConnInterface {
+	return &arbitratedConn{arbiter: a, name: name, conn: conn}
+}
+
+// Arrays returns the priority arrays for the named device, sorted by method and field.
+func (a *Arbiter) Arrays(name string) []Array {
+	var all []*writes
+	a.mu.Lock()
+	for t, ws := range a.writes {
+		if t.name == name {
+			all = append(all, ws)
+		}
+	}
+	a.mu.Unlock()
+
+	now := a.now()
+	var arrays []Array
+	for _, ws := range all {
+		ws.mu.Lock()
+		for field, arr := range ws.arrays {
+			if got := arr.snapshot(ws.target, field, now); len(got.Slots) > 0 {
+				arrays = append(arrays, got)
+			}
+		}
+		ws.mu.Unlock()
+	}
+	slices.SortFunc(arrays, func(a, b Array) int {
+		return cmp.Or(cmp.Compare(a.Method, b.Method), cmp.Compare(a.Field, b.Field))
+	})
+	return arrays
+}
+
+// Relinquish removes the write at level from a priority array.
+// If level was the effective level the value at the next occupied level is applied to the device,
+// the level is relinquished even if that fails.
+func (a *Arbiter) Relinquish(ctx context.Context, name, method, field string, level Level) (Array, error) {
+	if !level.Valid() {
+		return Array{}, status.Errorf(codes.InvalidArgument, "level %d: must be between %d and %d", level, Highest, Lowest)
+	}
+	a.mu.Lock()
+	ws, ok := a.writes[target{name: name, method: method}]
+	a.mu.Unlock()
+	if !ok {
+		return Array{}, status.Errorf(codes.NotFound, "%s has no writes to %s", name, method)
+	}
+
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	arr, ok := ws.arrays[field]
+	if !ok || arr[level-1] == nil {
+		return Array{}, status.Errorf(codes.NotFound, "%s %s %q has no write at level %d", name, method, field, level)
+	}
+	now := a.now()
+	err := a.vacateLocked(ctx, ws, now, func(f string, l Level) bool {
+		return f == field && l == level
+	})
+	if arr, ok := ws.arrays[field]; ok {
+		return arr.snapshot(ws.target, field, now), err
+	}
+	return Array{Name: name, Method: method, Field: field}, err
+}
+
+// target identifies the writes made by one method to a device.
+type target struct {
+	name, method string
+}
+
+// writes holds the priority arrays for the fields written by one method of a device.
+type writes struct {
+	target
+	method *method
+
+	// mu is held while writing to the device, so values are applied in the order they're arbitrated
+	mu     sync.Mutex
+	conn   grpc.ClientConnInterface
+	arrays map[string]*array // keyed by field
+	res    proto.Message     // response of the last write applied to the device
+	timer  *time.Timer
+}
+
+func (a *Arbiter) writesFor(name string, m *method) *writes {
+	t := target{name: name, method: m.fullName}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	ws, ok := a.writes[t]
+	if !ok {
+		ws = &writes{target: t, method: m, arrays: make(map[string]*array)}
+		a.writes[t] = ws
+	}
+	return ws
+}
+
+// write arbitrates req, writing the fields it wins to conn and filling res.
+// Values uncovered when a level is vacated are also written to conn.
+func (a *Arbiter) write(ctx context.Context, ws *writes, conn grpc.ClientConnInterface, req, res proto.Message, opts ...grpc.CallOption) error {
+	w, err := FromOutgoingContext(ctx)
+	if err != nil {
+		return err
+	}
+	if err := authorise(ctx, w); err != nil {
+		return err
+	}
+	if w.Level == 0 {
+		w.Level = Manual
+		if w.Lease == 0 {
+			w.Lease = a.defaultLease
+		}
+	}
+
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+	ws.conn = conn
+	now := a.now()
+	// apply any values uncovered by expired leases before arbitrating this write
+	if err := a.vacateLocked(ctx, ws, now, nil); err != nil {
+		a.logger.Warn("failed to apply value after lease expired", zap.String("name", ws.name), zap.String("method", ws.method.fullName), zap.Error(err))
+	}
+
+	m := ws.method
+	fields := m.fields(req.ProtoReflect())
+	if m.isDelta(req.ProtoReflect()) {
+		// deltas don't have a value to hold a level with, they're applied if nothing higher holds the fields
+		for _, f := range fields {
+			if l, s := ws.arrays[f].effective(); s != nil && l < w.Level {
+				return status.Errorf(codes.FailedPrecondition, "%s is held at level %d by %q", fieldOrMethod(m, f), l, s.owner)
+			}
+		}
+		return a.applyLocked(ctx, ws, req, res, opts...)
+	}
+
+	s := slot{owner: w.Owner, writeTime: now}
+	if w.Lease > 0 {
+		s.expireTime = now.Add(w.Lease)
+	}
+	prev := make(map[string]*slot, len(fields))
+	var won []string
+	for _, f := range fields {
+		arr, ok := ws.arrays[f]
+		if !ok {
+			arr = &array{}
+			ws.arrays[f] = arr
+		}
+		prev[f] = arr[w.Level-1]
+		fs := s
+		fs.req = m.withFields(req, f)
+		arr[w.Level-1] = &fs
+		if l, _ := arr.effective(); l == w.Level {
+			won = append(won, f)
+		}
+	}
+	defer a.scheduleLocked(ws, now)
+
+	if len(won) == 0 {
+		// a higher level holds every field, reply with the value the device was last written
+		if ws.res != nil {
+			mergeInto(res, ws.res)
+		}
+		return nil
+	}
+	if len(won) < len(fields) {
+		req = m.withFields(req, won...)
+	}
+	if err := a.applyLocked(ctx, ws, req, res, opts...); err != nil {
+		// the write didn't happen, it shouldn't hold a level
+		for f, s := range prev {
+			ws.arrays[f][w.Level-1] = s
+			if l, _ := ws.arrays[f].effective(); l == 0 {
+				delete(ws.arrays, f)
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+func (a *Arbiter) applyLocked(ctx context.Context, ws *writes, req, res proto.Message, opts ...grpc.CallOption) error {
+	if err := ws.conn.Invoke(ctx, ws.method.fullName, req, res, opts...); err != nil {
+		return err
+	}
+	ws.res = proto.Clone(res)
+	return nil
+}
+
+// vacateLocked removes expired slots and those matching remove, which may be nil,
+// applying the new effective value of any array whose effective level was vacated.
+func (a *Arbiter) vacateLocked(ctx context.Context, ws *writes, now time.Time, remove func(field string, l Level) bool) error {
+	var errs []error
+	for field, arr := range ws.arrays {
+		before, _ := arr.effective()
+		for i, s := range arr {
+			if s != nil && (s.expired(now) || remove != nil && remove(field, Level(i+1))) {
+				arr[i] = nil
+			}
+		}
+		after, s := arr.effective()
+		if s == nil {
+			delete(ws.arrays, field)
+			continue
+		}
+		if after != before {
+			res := ws.method.res.New().Interface()
+			if err := a.applyLocked(ctx, ws, s.req, res); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	a.scheduleLocked(ws, now)
+	return errors.Join(errs...)
+}
+
+// scheduleLocked arranges for expired slots to be vacated once the next lease expires.
+func (a *Arbiter) scheduleLocked(ws *writes, now time.Time) {
+	var next time.Time
+	for _, arr := range ws.arrays {
+		for _, s := range arr {
+			if s != nil && !s.expireTime.IsZero() && (next.IsZero() || s.expireTime.Before(next)) {
+				next = s.expireTime
+			}
+		}
+	}
+	if ws.timer != nil {
+		ws.timer.Stop()
+		ws.timer = nil
+	}
+	if next.IsZero() {
+		return
+	}
+	ws.timer = time.AfterFunc(next.Sub(now), func() {
+		ctx, cancel := context.WithTimeout(context.Background(), replayTimeout)
+		defer cancel()
+		ws.mu.Lock()
+		defer ws.mu.Unlock()
+		if err := a.vacateLocked(ctx, ws, a.now(), nil); err != nil {
+			a.logger.Warn("failed to apply value after lease expired", zap.String("name", ws.name), zap.String("method", ws.method.fullName), zap.Error(err))
+		}
+	})
+}
+
+// array is a priority array, index 0 is the Highest level.
+type array [Levels]*slot
+
+// effective returns the highest occupied level and its slot, or 0 and nil if no levels are occupied.
+func (arr *array) effective() (Level, *slot) {
+	if arr == nil {
+		return 0, nil
+	}
+	for i, s := range arr {
+		if s != nil {
+			return Level(i + 1), s
+		}
+	}
+	return 0, nil
+}
+
+func (arr *array) snapshot(t target, field string, now time.Time) Array {
+	res := Array{Name: t.name, Method: t.method, Field: field}
+	for i, s := range arr {
+		if s == nil || s.expired(now) {
+			continue
+		}
+		res.Slots = append(res.Slots, Slot{
+			Level:      Level(i + 1),
+			Owner:      s.owner,
+			Value:      proto.Clone(s.req),
+			WriteTime:  s.writeTime,
+			ExpireTime: s.expireTime,
+		})
+	}
+	return res
+}
+
+// slot is a write occupying a level of an array.
+type slot struct {
+	owner      string
+	req        proto.Message // only writes the field of the array
+	writeTime  time.Time
+	expireTime time.Time // zero if the write doesn't expire
+}
+
+func (s *slot) expired(now time.Time) bool {
+	return !s.expireTime.IsZero() && !now.Before(s.expireTime)
+}
+
+// Array is a snapshot of the priority array for a field of a device.
+type Array struct {
+	Name string
+	// Method writes the field, like /smartcore.bos.onoff.v1.OnOffApi/UpdateOnOff.
+	Method string
+	// Field of the written resource, empty if the method is arbitrated as a whole.
+	Field string
+	// Slots are the occupied levels, highest priority first.
+	Slots []Slot
+}
+
+// Effective returns the slot whose value is applied to the device.
+func (a Array) Effective() (Slot, bool) {
+	if len(a.Slots) == 0 {
+		return Slot{}, false
+	}
+	return a.Slots[0], true
+}
+
+// Slot is a write occupying a level of an Array.
+type Slot struct {
+	Level Level
+	Owner string
+	// Value is the write request, only writing the field of the array.
+	Value      proto.Message
+	WriteTime  time.Time
+	ExpireTime time.Time // zero if the write doesn't expire
+}
+
+// arbitratedConn passes writes through an Arbiter before they reach conn.
+type arbitratedConn struct {
+	arbiter *Arbiter
+	name    string
+	conn    grpc.ClientConnInterface
+}
+
+func (c *arbitratedConn) Invoke(ctx context.Context, fullMethod string, args any, reply any, opts ...grpc.CallOption) error {
+	m := writeMethod(fullMethod)
+	req, reqOK := args.(proto.Message)
+	res, resOK := reply.(proto.Message)
+	if m == nil || !reqOK || !resOK {
+		return c.conn.Invoke(ctx, fullMethod, args, reply, opts...)
+	}
+	return c.arbiter.write(ctx, c.arbiter.writesFor(c.name, m), c.conn, req, res, opts...)
+}
+
+func (c *arbitratedConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, fullMethod string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	m := writeMethod(fullMethod)
+	if m == nil || desc.ClientStreams || desc.ServerStreams {
+		return c.conn.NewStream(ctx, desc, fullMethod, opts...)
+	}
+	// unary calls routed from the network arrive as streams
+	stream := wrap.NewClientServerStream(ctx)
+	go func() {
+		ss := stream.Server()
+		req := m.req.New().Interface()
+		if err := ss.RecvMsg(req); err != nil {
+			stream.Close(err)
+			return
+		}
+		res := m.res.New().Interface()
+		if err := c.arbiter.write(ctx, c.arbiter.writesFor(c.name, m), c.conn, req, res); err != nil {
+			stream.Close(err)
+			return
+		}
+		stream.Close(ss.SendMsg(res))
+	}()
+	return stream.Client(), nil
+}
+
+func fieldOrMethod(m *method, field string) string {
+	if field == "" {
+		return m.fullName
+	}
+	return field
+}
+
+// mergeInto merges src into dst, which may be different implementations of the same message.
+func mergeInto(dst, src proto.Message) {
+	data, err := proto.Marshal(src)
+	if err != nil {
+		return
+	}
+	_ = proto.UnmarshalOptions{Merge: true}.Unmarshal(data, dst)
+}
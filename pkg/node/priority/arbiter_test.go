@@ -0,0 +1,252 @@
+package priority
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/lightpb"
+	"github.com/smart-core-os/sc-bos/pkg/wrap"
+)
+
+func newTestLight(t *testing.T, opts ...Option) (*Arbiter, lightpb.LightApiClient, *lightpb.Model) {
+	t.Helper()
+	model := lightpb.NewModel()
+	a := NewArbiter(opts...)
+	conn := a.Conn("light", wrap.ServerToClient(lightpb.LightApi_ServiceDesc, lightpb.NewModelServer(model)))
+	return a, lightpb.NewLightApiClient(conn), model
+}
+
+func setLevel(t *testing.T, ctx context.Context, client lightpb.LightApiClient, level float32) *lightpb.Brightness {
+	t.Helper()
+	res, err := client.UpdateBrightness(ctx, &lightpb.UpdateBrightnessRequest{
+		Name:       "light",
+		Brightness: &lightpb.Brightness{LevelPercent: level},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"level_percent"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateBrightness(%v) error = %v", level, err)
+	}
+	return res
+}
+
+func modelLevel(t *testing.T, model *lightpb.Model) float32 {
+	t.Helper()
+	b, err := model.GetBrightness()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b.LevelPercent
+}
+
+func TestArbiter_overrideAndRelinquish(t *testing.T) {
+	a, client, model := newTestLight(t)
+	ctx := context.Background()
+	autoCtx := NewOutgoingContext(ctx, Write{Level: Auto, Owner: "lights"})
+
+	setLevel(t, autoCtx, client, 20)
+	if got := modelLevel(t, model); got != 20 {
+		t.Fatalf("level after auto write = %v, want 20", got)
+	}
+
+	// writes without a level are manual, taking precedence over the auto
+	setLevel(t, ctx, client, 80)
+	res := setLevel(t, autoCtx, client, 30)
+	if got := modelLevel(t, model); got != 80 {
+		t.Errorf("level after shadowed auto write = %v, want 80", got)
+	}
+	if res.LevelPercent != 80 {
+		t.Errorf("shadowed write response level = %v, want 80", res.LevelPercent)
+	}
+
+	arrays := a.Arrays("light")
+	if len(arrays) != 1 {
+		t.Fatalf("Arrays() = %v, want 1 array", arrays)
+	}
+	arr := arrays[0]
+	if arr.Method != "/smartcore.bos.light.v1.LightApi/UpdateBrightness" || arr.Field != "level_percent" {
+		t.Errorf("array for %s %q, want UpdateBrightness level_percent", arr.Method, arr.Field)
+	}
+	var levels []Level
+	for _, s := range arr.Slots {
+		levels = append(levels, s.Level)
+	}
+	if diff := cmp.Diff([]Level{Manual, Auto}, levels); diff != "" {
+		t.Errorf("occupied levels (-want +got):\n%s", diff)
+	}
+	if eff, _ := arr.Effective(); eff.Owner != "" || eff.Level != Manual {
+		t.Errorf("effective slot = level %d by %q, want manual", eff.Level, eff.Owner)
+	}
+
+	// the auto regains control with its latest value
+	arr, err := a.Relinquish(ctx, "light", arr.Method, arr.Field, Manual)
+	if err != nil {
+		t.Fatalf("Relinquish() error = %v", err)
+	}
+	if got := modelLevel(t, model); got != 30 {
+		t.Errorf("level after relinquish = %v, want 30", got)
+	}
+	if eff, _ := arr.Effective(); eff.Owner != "lights" {
+		t.Errorf("effective owner after relinquish = %q, want lights", eff.Owner)
+	}
+	wantValue := &lightpb.UpdateBrightnessRequest{
+		Name:       "light",
+		Brightness: &lightpb.Brightness{LevelPercent: 30},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"level_percent"}},
+	}
+	if diff := cmp.Diff(wantValue, arr.Slots[0].Value, protocmp.Transform()); diff != "" {
+		t.Errorf("effective value (-want +got):\n%s", diff)
+	}
+
+	if _, err := a.Relinquish(ctx, "light", arr.Method, arr.Field, Manual); status.Code(err) != codes.NotFound {
+		t.Errorf("Relinquish() empty level error = %v, want NotFound", err)
+	}
+}
+
+func TestArbiter_leaseExpiry(t *testing.T) {
+	_, client, model := newTestLight(t, WithDefaultLease(50*time.Millisecond))
+	ctx := context.Background()
+	setLevel(t, NewOutgoingContext(ctx, Write{Level: Auto, Owner: "lights"}), client, 20)
+	setLevel(t, ctx, client, 80)
+	if got := modelLevel(t, model); got != 80 {
+		t.Fatalf("level after manual write = %v, want 80", got)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for modelLevel(t, model) != 20 {
+		if time.Now().After(deadline) {
+			t.Fatalf("level = %v after the manual lease expired, want 20", modelLevel(t, model))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestArbiter_fields(t *testing.T) {
+	a, client, model := newTestLight(t)
+	ctx := context.Background()
+	// only the level is overridden, the auto still controls the preset
+	setLevel(t, ctx, client, 80)
+	_, err := client.UpdateBrightness(NewOutgoingContext(ctx, Write{Level: Auto}), &lightpb.UpdateBrightnessRequest{
+		Name:       "light",
+		Brightness: &lightpb.Brightness{LevelPercent: 10, Preset: &lightpb.LightPreset{Name: "low", Title: "Low"}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"level_percent", "preset"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := model.GetBrightness()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.LevelPercent != 80 || b.Preset.GetName() != "low" {
+		t.Errorf("brightness = %v, want level 80 and preset low", b)
+	}
+	if got := len(a.Arrays("light")); got != 2 {
+		t.Errorf("Arrays() has %d arrays, want 2", got)
+	}
+}
+
+func TestArbiter_delta(t *testing.T) {
+	_, client, _ := newTestLight(t)
+	ctx := context.Background()
+	setLevel(t, ctx, client, 80)
+	_, err := client.UpdateBrightness(NewOutgoingContext(ctx, Write{Level: Auto}), &lightpb.UpdateBrightnessRequest{
+		Name:       "light",
+		Brightness: &lightpb.Brightness{LevelPercent: 10},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"level_percent"}},
+		Delta:      true,
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("delta below the effective level error = %v, want FailedPrecondition", err)
+	}
+}
+
+func TestArbiter_stream(t *testing.T) {
+	// unary requests routed from the network arrive as streams
+	model := lightpb.NewModel()
+	a := NewArbiter()
+	conn := a.Conn("light", wrap.ServerToClient(lightpb.LightApi_ServiceDesc, lightpb.NewModelServer(model)))
+	ctx := context.Background()
+	write := func(ctx context.Context, level float32) {
+		t.Helper()
+		stream, err := conn.NewStream(ctx, &grpc.StreamDesc{}, "/smartcore.bos.light.v1.LightApi/UpdateBrightness")
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = stream.SendMsg(&lightpb.UpdateBrightnessRequest{Name: "light", Brightness: &lightpb.Brightness{LevelPercent: level}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := stream.CloseSend(); err != nil {
+			t.Fatal(err)
+		}
+		if err := stream.RecvMsg(&lightpb.Brightness{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(metadata.NewOutgoingContext(ctx, metadata.Pairs(LevelKey, "3")), 50)
+	write(NewOutgoingContext(ctx, Write{Level: Manual}), 60)
+	if got := modelLevel(t, model); got != 50 {
+		t.Errorf("level = %v, want 50 written at level 3", got)
+	}
+}
+
+func TestFromOutgoingContext(t *testing.T) {
+	ctx := NewOutgoingContext(context.Background(), Write{Level: 4, Owner: "bms", Lease: time.Minute})
+	got, err := FromOutgoingContext(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Write{Level: 4, Owner: "bms", Lease: time.Minute}); got != want {
+		t.Errorf("FromOutgoingContext() = %+v, want %+v", got, want)
+	}
+
+	for _, level := range []string{"0", "17", "high"} {
+		ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs(LevelKey, level))
+		if _, err := FromOutgoingContext(ctx); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("FromOutgoingContext() level %q error = %v, want InvalidArgument", level, err)
+		}
+	}
+}
+
+func TestArbiter_networkLevel(t *testing.T) {
+	_, client, model := newTestLight(t)
+	netCtx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 1234}})
+	if _, err := client.UpdateBrightness(NewOutgoingContext(netCtx, Write{Level: LifeSafety}), &lightpb.UpdateBrightnessRequest{
+		Name:       "light",
+		Brightness: &lightpb.Brightness{LevelPercent: 90},
+	}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("network write at level %d error = %v, want PermissionDenied", LifeSafety, err)
+	}
+	if got := modelLevel(t, model); got != 0 {
+		t.Errorf("level = %v after the rejected write, want 0", got)
+	}
+	setLevel(t, NewOutgoingContext(netCtx, Write{Level: Manual}), client, 40)
+	// in process writes, like those from automations, may use any level
+	setLevel(t, NewOutgoingContext(context.Background(), Write{Level: LifeSafety}), client, 90)
+	if got := modelLevel(t, model); got != 90 {
+		t.Errorf("level = %v, want 90 written in process at level %d", got, LifeSafety)
+	}
+}
+
+func TestArbiter_defaultLease(t *testing.T) {
+	a, client, _ := newTestLight(t)
+	setLevel(t, context.Background(), client, 80)
+	arr := a.Arrays("light")[0]
+	if len(arr.Slots) != 1 || arr.Slots[0].ExpireTime.IsZero() {
+		t.Fatalf("manual write slots = %+v, want one with an expiry", arr.Slots)
+	}
+	if got := arr.Slots[0].ExpireTime.Sub(arr.Slots[0].WriteTime); got != DefaultLease {
+		t.Errorf("manual write lease = %v, want %v", got, DefaultLease)
+	}
+}
@@ -0,0 +1,180 @@
+package priority
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// writePrefix is the name prefix of the methods that are arbitrated.
+const writePrefix = "Update"
+
+// method describes how requests to a write method are split into fields.
+type method struct {
+	fullName string
+	req, res protoreflect.MessageType
+	// resource is the message field of the request being written, nil if the request is arbitrated as a whole.
+	resource protoreflect.FieldDescriptor
+	// mask is the update_mask field of the request, always set if resource is.
+	mask protoreflect.FieldDescriptor
+	// delta is the delta field of the request, writes with delta set are relative to the current value.
+	delta protoreflect.FieldDescriptor
+}
+
+var methods sync.Map // of fullMethod string -> *method, nil if not a write
+
+// writeMethod returns the method for fullMethod, or nil if fullMethod is not arbitrated.
+// Only unary methods whose name starts with Update are arbitrated.
+func writeMethod(fullMethod string) *method {
+	if m, ok := methods.Load(fullMethod); ok {
+		return m.(*method)
+	}
+	m := newMethod(fullMethod)
+	methods.Store(fullMethod, m)
+	return m
+}
+
+func newMethod(fullMethod string) *method {
+	serviceName, methodName, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok || !strings.HasPrefix(methodName, writePrefix) {
+		return nil
+	}
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(serviceName))
+	if err != nil {
+		return nil
+	}
+	sd, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil
+	}
+	md := sd.Methods().ByName(protoreflect.Name(methodName))
+	if md == nil || md.IsStreamingClient() || md.IsStreamingServer() {
+		return nil
+	}
+	m := &method{
+		fullName: fullMethod,
+		req:      messageType(md.Input()),
+		res:      messageType(md.Output()),
+	}
+	fields := md.Input().Fields()
+	if fd := fields.ByName("update_mask"); fd != nil && fd.Message() != nil && fd.Message().FullName() == "google.protobuf.FieldMask" {
+		m.mask = fd
+	}
+	if fd := fields.ByName("delta"); fd != nil && fd.Kind() == protoreflect.BoolKind {
+		m.delta = fd
+	}
+	if m.mask != nil {
+		// the resource is the message being written, the first message that isn't a well known type
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if fd.Message() != nil && !fd.IsList() && !fd.IsMap() && fd.Message().FullName().Parent() != "google.protobuf" {
+				m.resource = fd
+				break
+			}
+		}
+	}
+	if m.resource == nil {
+		m.mask = nil
+	}
+	return m
+}
+
+// isDelta returns whether req is relative to the current value.
+func (m *method) isDelta(req protoreflect.Message) bool {
+	return m.delta != nil && req.Get(m.delta).Bool()
+}
+
+// fields returns the fields of the resource written by req, sorted.
+// Without an update mask the populated fields are written, or all fields if none are populated.
+// If the request is arbitrated as a whole the only field is "".
+func (m *method) fields(req protoreflect.Message) []string {
+	if m.resource == nil {
+		return []string{""}
+	}
+	all := m.resource.Message().Fields()
+	var fields []string
+	wildcard := false
+	if req.Has(m.mask) {
+		for _, p := range maskPaths(req.Get(m.mask).Message()) {
+			if p == "*" {
+				wildcard = true
+				break
+			}
+			f, _, _ := strings.Cut(p, ".")
+			if all.ByName(protoreflect.Name(f)) != nil {
+				fields = append(fields, f)
+			}
+		}
+	}
+	if len(fields) == 0 && !wildcard && req.Has(m.resource) {
+		req.Get(m.resource).Message().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			fields = append(fields, string(fd.Name()))
+			return true
+		})
+	}
+	if len(fields) == 0 || wildcard {
+		fields = fields[:0]
+		for i := 0; i < all.Len(); i++ {
+			fields = append(fields, string(all.Get(i).Name()))
+		}
+	}
+	slices.Sort(fields)
+	return slices.Compact(fields)
+}
+
+// withFields returns a copy of req that only writes fields.
+func (m *method) withFields(req proto.Message, fields ...string) proto.Message {
+	req = proto.Clone(req)
+	if m.resource == nil {
+		return req
+	}
+	r := req.ProtoReflect()
+	if r.Has(m.resource) {
+		res := r.Mutable(m.resource).Message()
+		var others []protoreflect.FieldDescriptor
+		res.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			if !slices.Contains(fields, string(fd.Name())) {
+				others = append(others, fd)
+			}
+			return true
+		})
+		for _, fd := range others {
+			res.Clear(fd)
+		}
+	}
+	mask := r.NewField(m.mask).Message()
+	paths := mask.NewField(mask.Descriptor().Fields().ByName("paths")).List()
+	for _, f := range fields {
+		paths.Append(protoreflect.ValueOfString(f))
+	}
+	mask.Set(mask.Descriptor().Fields().ByName("paths"), protoreflect.ValueOfList(paths))
+	r.Set(m.mask, protoreflect.ValueOfMessage(mask))
+	return req
+}
+
+// maskPaths returns the paths of a google.protobuf.FieldMask, which may be a dynamic message.
+func maskPaths(mask protoreflect.Message) []string {
+	fd := mask.Descriptor().Fields().ByName("paths")
+	if fd == nil {
+		return nil
+	}
+	list := mask.Get(fd).List()
+	paths := make([]string, list.Len())
+	for i := range paths {
+		paths[i] = list.Get(i).String()
+	}
+	return paths
+}
+
+// messageType returns the generated type for desc, or a dynamic type if none is registered.
+func messageType(desc protoreflect.MessageDescriptor) protoreflect.MessageType {
+	if mt, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName()); err == nil {
+		return mt
+	}
+	return dynamicpb.NewMessageType(desc)
+}
@@ -0,0 +1,158 @@
+// Package priority arbitrates between writers that target the same device.
+//
+// Each field of a device has a priority array of 16 levels, modelled on BACnet's priority array.
+// Writes occupy a level until they are relinquished or their lease expires,
+// the value at the highest priority (lowest numbered) occupied level is applied to the device.
+// When that level is vacated the value at the next occupied level is applied,
+// so an automation writing at a low priority regains control once a manual override expires.
+//
+// Writers choose their level using request metadata, see NewOutgoingContext.
+// Writes without a level are Manual writes, like those from the Ops UI, held for DefaultLease.
+// Network callers can't write above Manual unless they are another node, see authorise.
+package priority
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/smart-core-os/sc-bos/internal/util/rpcutil"
+)
+
+// Level is a level in a priority array, 1 is the highest priority, 16 the lowest.
+type Level int
+
+const (
+	Highest Level = 1
+	Lowest  Level = 16
+	// LifeSafety is for writes that must not be overridden, like fire alarm interlocks.
+	LifeSafety Level = 1
+	// Manual is for writes made by people, and the level of writes that don't specify one.
+	Manual Level = 8
+	// Auto is for writes made by automations.
+	Auto Level = 16
+)
+
+// DefaultLease is how long writes that don't specify a level hold it, unless configured using WithDefaultLease.
+// A finite lease stops a forgotten manual write from blocking automations forever.
+const DefaultLease = time.Hour
+
+// Levels is the number of levels in a priority array.
+const Levels = int(Lowest)
+
+func (l Level) Valid() bool {
+	return l >= Highest && l <= Lowest
+}
+
+// Request metadata keys used to describe a write.
+const (
+	LevelKey = "sc-priority"
+	OwnerKey = "sc-priority-owner"
+	LeaseKey = "sc-priority-lease"
+)
+
+// Write describes how a write is arbitrated.
+type Write struct {
+	// Level the write occupies.
+	Level Level
+	// Owner identifies the writer, for example the name of an automation.
+	Owner string
+	// Lease is how long the write occupies its level, zero for no expiry.
+	Lease time.Duration
+}
+
+// NewOutgoingContext returns a context whose gRPC requests are written as w.
+func NewOutgoingContext(ctx context.Context, w Write) context.Context {
+	kv := []string{LevelKey, strconv.Itoa(int(w.Level))}
+	if w.Owner != "" {
+		kv = append(kv, OwnerKey, w.Owner)
+	}
+	if w.Lease > 0 {
+		kv = append(kv, LeaseKey, w.Lease.String())
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
+
+// WriteConn returns a grpc.ClientConnInterface whose calls are written as w,
+// unless their context already sets a level using NewOutgoingContext.
+func WriteConn(conn grpc.ClientConnInterface, w Write) grpc.ClientConnInterface {
+	return &writeConn{conn: conn, w: w}
+}
+
+type writeConn struct {
+	conn grpc.ClientConnInterface
+	w    Write
+}
+
+func (c *writeConn) Invoke(ctx context.Context, method string, args any, reply any, opts ...grpc.CallOption) error {
+	return c.conn.Invoke(c.outgoingContext(ctx), method, args, reply, opts...)
+}
+
+func (c *writeConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return c.conn.NewStream(c.outgoingContext(ctx), desc, method, opts...)
+}
+
+func (c *writeConn) outgoingContext(ctx context.Context) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if len(md.Get(LevelKey)) > 0 {
+		return ctx
+	}
+	return NewOutgoingContext(ctx, c.w)
+}
+
+// FromOutgoingContext returns the Write described by the outgoing metadata of ctx.
+// Missing values are left as their zero value.
+func FromOutgoingContext(ctx context.Context) (Write, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	return fromMetadata(md)
+}
+
+func fromMetadata(md metadata.MD) (Write, error) {
+	var w Write
+	if v := lastValue(md, LevelKey); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || !Level(n).Valid() {
+			return w, status.Errorf(codes.InvalidArgument, "%s %q: must be between %d and %d", LevelKey, v, Highest, Lowest)
+		}
+		w.Level = Level(n)
+	}
+	w.Owner = lastValue(md, OwnerKey)
+	if v := lastValue(md, LeaseKey); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return w, status.Errorf(codes.InvalidArgument, "%s %q: not a positive duration", LeaseKey, v)
+		}
+		w.Lease = d
+	}
+	return w, nil
+}
+
+// authorise returns a PermissionDenied error if w is above the Manual level and was requested over the network
+// by a caller other than another node, which presents a verified client certificate.
+// Writes made in process, like those from automations, may use any level.
+func authorise(ctx context.Context, w Write) error {
+	if w.Level == 0 || w.Level >= Manual {
+		return nil
+	}
+	if _, ok := peer.FromContext(ctx); !ok {
+		return nil
+	}
+	if _, valid := rpcutil.CertFromServerContext(ctx); valid {
+		return nil
+	}
+	return status.Errorf(codes.PermissionDenied, "%s %d: writes above level %d are only allowed from other nodes", LevelKey, w.Level, Manual)
+}
+
+func lastValue(md metadata.MD, key string) string {
+	vs := md.Get(key)
+	if len(vs) == 0 {
+		return ""
+	}
+	return vs[len(vs)-1]
+}
@@ -0,0 +1,73 @@
+package priority
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/prioritypb"
+)
+
+// Server implements the PriorityApi using the priority arrays of an Arbiter.
+type Server struct {
+	prioritypb.UnimplementedPriorityApiServer
+	arbiter *Arbiter
+}
+
+func NewServer(arbiter *Arbiter) *Server {
+	return &Server{arbiter: arbiter}
+}
+
+func (s *Server) ListPriorityArrays(_ context.Context, request *prioritypb.ListPriorityArraysRequest) (*prioritypb.ListPriorityArraysResponse, error) {
+	res := &prioritypb.ListPriorityArraysResponse{}
+	for _, arr := range s.arbiter.Arrays(request.Name) {
+		pa, err := arrayToProto(arr)
+		if err != nil {
+			return nil, err
+		}
+		res.PriorityArrays = append(res.PriorityArrays, pa)
+	}
+	return res, nil
+}
+
+func (s *Server) RelinquishPriority(ctx context.Context, request *prioritypb.RelinquishPriorityRequest) (*prioritypb.PriorityArray, error) {
+	if request.Method == "" {
+		return nil, status.Error(codes.InvalidArgument, "method is required")
+	}
+	arr, err := s.arbiter.Relinquish(ctx, request.Name, request.Method, request.Field, Level(request.Level))
+	if err != nil {
+		return nil, err
+	}
+	return arrayToProto(arr)
+}
+
+func arrayToProto(arr Array) (*prioritypb.PriorityArray, error) {
+	pa := &prioritypb.PriorityArray{
+		Name:   arr.Name,
+		Method: arr.Method,
+		Field:  arr.Field,
+	}
+	if s, ok := arr.Effective(); ok {
+		pa.EffectiveLevel = int32(s.Level)
+	}
+	for _, s := range arr.Slots {
+		value, err := anypb.New(s.Value)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "value of level %d: %v", s.Level, err)
+		}
+		ps := &prioritypb.PriorityArray_Slot{
+			Level:     int32(s.Level),
+			Owner:     s.Owner,
+			Value:     value,
+			WriteTime: timestamppb.New(s.WriteTime),
+		}
+		if !s.ExpireTime.IsZero() {
+			ps.ExpireTime = timestamppb.New(s.ExpireTime)
+		}
+		pa.Slots = append(pa.Slots, ps)
+	}
+	return pa, nil
+}
@@ -929,6 +929,499 @@ func (x *ListAccessGrantsResponse) GetTotalSize() int32 {
 	return 0
 }
 
+// Cardholder is a person that can be issued cards to access protected areas.
+type Cardholder struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is a unique identifier for the cardholder native to the access control system.
+	// Output only.
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName   string `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName    string `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// disabled cardholders are denied access, regardless of their access groups or cards.
+	Disabled bool `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// access_groups are the ids of the access groups the cardholder is a member of, native to the access control system.
+	// Access groups determine where and when the cardholder is granted access.
+	AccessGroups []string `protobuf:"bytes,6,rep,name=access_groups,json=accessGroups,proto3" json:"access_groups,omitempty"`
+	// cards assigned to the cardholder.
+	// Use AssignCard to add cards to an existing cardholder.
+	Cards         []*Card `protobuf:"bytes,7,rep,name=cards,proto3" json:"cards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cardholder) Reset() {
+	*x = Cardholder{}
+	mi := &file_smartcore_bos_access_v1_access_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cardholder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cardholder) ProtoMessage() {}
+
+func (x *Cardholder) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_access_v1_access_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cardholder.ProtoReflect.Descriptor instead.
+func (*Cardholder) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_access_v1_access_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Cardholder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Cardholder) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Cardholder) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *Cardholder) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Cardholder) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Cardholder) GetAccessGroups() []string {
+	if x != nil {
+		return x.AccessGroups
+	}
+	return nil
+}
+
+func (x *Cardholder) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+// Card is a credential a cardholder uses to gain access.
+type Card struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is a unique identifier for the card native to the access control system.
+	// Output only.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// number is the card number.
+	// Some card types, like mobile credentials, are numbered by the access control system and need no number.
+	Number string `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	// type is the id of the card type, native to the access control system.
+	// Devices may use a default card type if not set.
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// state describes whether the card can be used, like "active", "disabled", or "lost".
+	// Output only.
+	State string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	// start_time is the time from which the card is valid.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the time after which the card is no longer valid.
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Card) Reset() {
+	*x = Card{}
+	mi := &file_smartcore_bos_access_v1_access_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Card) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Card) ProtoMessage() {}
+
+func (x *Card) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_access_v1_access_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Card.ProtoReflect.Descriptor instead.
+func (*Card) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_access_v1_access_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Card) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Card) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Card) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Card) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Card) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Card) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type CreateCardholderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the device to create the cardholder using.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The cardholder to create, including any cards to assign to them.
+	Cardholder    *Cardholder `protobuf:"bytes,2,opt,name=cardholder,proto3" json:"cardholder,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCardholderRequest) Reset() {
+	*x = CreateCardholderRequest{}
+	mi := &file_smartcore_bos_access_v1_access_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCardholderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCardholderRequest) ProtoMessage() {}
+
+func (x *CreateCardholderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_access_v1_access_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCardholderRequest.ProtoReflect.Descriptor instead.
+func (*CreateCardholderRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_access_v1_access_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateCardholderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCardholderRequest) GetCardholder() *Cardholder {
+	if x != nil {
+		return x.Cardholder
+	}
+	return nil
+}
+
+type GetCardholderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the device to get the cardholder from.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The id of the cardholder to get.
+	CardholderId  string                 `protobuf:"bytes,2,opt,name=cardholder_id,json=cardholderId,proto3" json:"cardholder_id,omitempty"`
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCardholderRequest) Reset() {
+	*x = GetCardholderRequest{}
+	mi := &file_smartcore_bos_access_v1_access_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCardholderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCardholderRequest) ProtoMessage() {}
+
+func (x *GetCardholderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_access_v1_access_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCardholderRequest.ProtoReflect.Descriptor instead.
+func (*GetCardholderRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_access_v1_access_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCardholderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetCardholderRequest) GetCardholderId() string {
+	if x != nil {
+		return x.CardholderId
+	}
+	return ""
+}
+
+func (x *GetCardholderRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type UpdateCardholderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the device the cardholder belongs to.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The cardholder to update.
+	// The id of the cardholder should be set.
+	Cardholder *Cardholder `protobuf:"bytes,2,opt,name=cardholder,proto3" json:"cardholder,omitempty"`
+	// The fields to update, defaults to all writable fields.
+	// Cards can't be updated, use AssignCard to add cards.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCardholderRequest) Reset() {
+	*x = UpdateCardholderRequest{}
+	mi := &file_smartcore_bos_access_v1_access_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCardholderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCardholderRequest) ProtoMessage() {}
+
+func (x *UpdateCardholderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_access_v1_access_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCardholderRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCardholderRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_access_v1_access_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateCardholderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCardholderRequest) GetCardholder() *Cardholder {
+	if x != nil {
+		return x.Cardholder
+	}
+	return nil
+}
+
+func (x *UpdateCardholderRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DisableCardholderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the device the cardholder belongs to.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The id of the cardholder to disable.
+	CardholderId  string `protobuf:"bytes,2,opt,name=cardholder_id,json=cardholderId,proto3" json:"cardholder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableCardholderRequest) Reset() {
+	*x = DisableCardholderRequest{}
+	mi := &file_smartcore_bos_access_v1_access_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableCardholderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableCardholderRequest) ProtoMessage() {}
+
+func (x *DisableCardholderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_access_v1_access_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableCardholderRequest.ProtoReflect.Descriptor instead.
+func (*DisableCardholderRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_access_v1_access_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DisableCardholderRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DisableCardholderRequest) GetCardholderId() string {
+	if x != nil {
+		return x.CardholderId
+	}
+	return ""
+}
+
+type AssignCardRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the device the cardholder belongs to.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The id of the cardholder to assign the card to.
+	CardholderId string `protobuf:"bytes,2,opt,name=cardholder_id,json=cardholderId,proto3" json:"cardholder_id,omitempty"`
+	// The card to assign.
+	Card          *Card `protobuf:"bytes,3,opt,name=card,proto3" json:"card,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignCardRequest) Reset() {
+	*x = AssignCardRequest{}
+	mi := &file_smartcore_bos_access_v1_access_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignCardRequest) ProtoMessage() {}
+
+func (x *AssignCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_access_v1_access_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignCardRequest.ProtoReflect.Descriptor instead.
+func (*AssignCardRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_access_v1_access_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AssignCardRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssignCardRequest) GetCardholderId() string {
+	if x != nil {
+		return x.CardholderId
+	}
+	return ""
+}
+
+func (x *AssignCardRequest) GetCard() *Card {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
 type PullAccessAttemptsResponse_Change struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -940,7 +1433,7 @@ type PullAccessAttemptsResponse_Change struct {
 
 func (x *PullAccessAttemptsResponse_Change) Reset() {
 	*x = PullAccessAttemptsResponse_Change{}
-	mi := &file_smartcore_bos_access_v1_access_proto_msgTypes[12]
+	mi := &file_smartcore_bos_access_v1_access_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -952,7 +1445,7 @@ func (x *PullAccessAttemptsResponse_Change) String() string {
 func (*PullAccessAttemptsResponse_Change) ProtoMessage() {}
 
 func (x *PullAccessAttemptsResponse_Change) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_access_v1_access_proto_msgTypes[12]
+	mi := &file_smartcore_bos_access_v1_access_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1072,7 +1565,49 @@ const file_smartcore_bos_access_v1_access_proto_rawDesc = "" +
 	"\raccess_grants\x18\x01 \x03(\v2$.smartcore.bos.access.v1.AccessGrantR\faccessGrants\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
 	"\n" +
-	"total_size\x18\x03 \x01(\x05R\ttotalSize2\xcb\x06\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"\xf0\x01\n" +
+	"\n" +
+	"Cardholder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bdisabled\x18\x05 \x01(\bR\bdisabled\x12#\n" +
+	"\raccess_groups\x18\x06 \x03(\tR\faccessGroups\x123\n" +
+	"\x05cards\x18\a \x03(\v2\x1d.smartcore.bos.access.v1.CardR\x05cards\"\xca\x01\n" +
+	"\x04Card\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\tR\x06number\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"r\n" +
+	"\x17CreateCardholderRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12C\n" +
+	"\n" +
+	"cardholder\x18\x02 \x01(\v2#.smartcore.bos.access.v1.CardholderR\n" +
+	"cardholder\"\x88\x01\n" +
+	"\x14GetCardholderRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rcardholder_id\x18\x02 \x01(\tR\fcardholderId\x127\n" +
+	"\tread_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"\xaf\x01\n" +
+	"\x17UpdateCardholderRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12C\n" +
+	"\n" +
+	"cardholder\x18\x02 \x01(\v2#.smartcore.bos.access.v1.CardholderR\n" +
+	"cardholder\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"S\n" +
+	"\x18DisableCardholderRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rcardholder_id\x18\x02 \x01(\tR\fcardholderId\"\x7f\n" +
+	"\x11AssignCardRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rcardholder_id\x18\x02 \x01(\tR\fcardholderId\x121\n" +
+	"\x04card\x18\x03 \x01(\v2\x1d.smartcore.bos.access.v1.CardR\x04card2\xdc\n" +
+	"\n" +
 	"\tAccessApi\x12v\n" +
 	"\x14GetLastAccessAttempt\x124.smartcore.bos.access.v1.GetLastAccessAttemptRequest\x1a&.smartcore.bos.access.v1.AccessAttempt\"\x00\x12\x81\x01\n" +
 	"\x12PullAccessAttempts\x122.smartcore.bos.access.v1.PullAccessAttemptsRequest\x1a3.smartcore.bos.access.v1.PullAccessAttemptsResponse\"\x000\x01\x12n\n" +
@@ -1080,7 +1615,13 @@ const file_smartcore_bos_access_v1_access_proto_rawDesc = "" +
 	"\x11UpdateAccessGrant\x121.smartcore.bos.access.v1.UpdateAccessGrantRequest\x1a$.smartcore.bos.access.v1.AccessGrant\"\x00\x12|\n" +
 	"\x11DeleteAccessGrant\x121.smartcore.bos.access.v1.DeleteAccessGrantRequest\x1a2.smartcore.bos.access.v1.DeleteAccessGrantResponse\"\x00\x12i\n" +
 	"\x0eGetAccessGrant\x12/.smartcore.bos.access.v1.GetAccessGrantsRequest\x1a$.smartcore.bos.access.v1.AccessGrant\"\x00\x12y\n" +
-	"\x10ListAccessGrants\x120.smartcore.bos.access.v1.ListAccessGrantsRequest\x1a1.smartcore.bos.access.v1.ListAccessGrantsResponse\"\x00B4Z2github.com/smart-core-os/sc-bos/pkg/proto/accesspbb\x06proto3"
+	"\x10ListAccessGrants\x120.smartcore.bos.access.v1.ListAccessGrantsRequest\x1a1.smartcore.bos.access.v1.ListAccessGrantsResponse\"\x00\x12k\n" +
+	"\x10CreateCardholder\x120.smartcore.bos.access.v1.CreateCardholderRequest\x1a#.smartcore.bos.access.v1.Cardholder\"\x00\x12e\n" +
+	"\rGetCardholder\x12-.smartcore.bos.access.v1.GetCardholderRequest\x1a#.smartcore.bos.access.v1.Cardholder\"\x00\x12k\n" +
+	"\x10UpdateCardholder\x120.smartcore.bos.access.v1.UpdateCardholderRequest\x1a#.smartcore.bos.access.v1.Cardholder\"\x00\x12m\n" +
+	"\x11DisableCardholder\x121.smartcore.bos.access.v1.DisableCardholderRequest\x1a#.smartcore.bos.access.v1.Cardholder\"\x00\x12_\n" +
+	"\n" +
+	"AssignCard\x12*.smartcore.bos.access.v1.AssignCardRequest\x1a#.smartcore.bos.access.v1.Cardholder\"\x00B4Z2github.com/smart-core-os/sc-bos/pkg/proto/accesspbb\x06proto3"
 
 var (
 	file_smartcore_bos_access_v1_access_proto_rawDescOnce sync.Once
@@ -1095,7 +1636,7 @@ func file_smartcore_bos_access_v1_access_proto_rawDescGZIP() []byte {
 }
 
 var file_smartcore_bos_access_v1_access_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_smartcore_bos_access_v1_access_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_smartcore_bos_access_v1_access_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_smartcore_bos_access_v1_access_proto_goTypes = []any{
 	(AccessAttempt_Grant)(0),                  // 0: smartcore.bos.access.v1.AccessAttempt.Grant
 	(*AccessAttempt)(nil),                     // 1: smartcore.bos.access.v1.AccessAttempt
@@ -1110,50 +1651,75 @@ var file_smartcore_bos_access_v1_access_proto_goTypes = []any{
 	(*GetAccessGrantsRequest)(nil),            // 10: smartcore.bos.access.v1.GetAccessGrantsRequest
 	(*ListAccessGrantsRequest)(nil),           // 11: smartcore.bos.access.v1.ListAccessGrantsRequest
 	(*ListAccessGrantsResponse)(nil),          // 12: smartcore.bos.access.v1.ListAccessGrantsResponse
-	(*PullAccessAttemptsResponse_Change)(nil), // 13: smartcore.bos.access.v1.PullAccessAttemptsResponse.Change
-	(*actorpb.Actor)(nil),                     // 14: smartcore.bos.actor.v1.Actor
-	(*timestamppb.Timestamp)(nil),             // 15: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),             // 16: google.protobuf.FieldMask
+	(*Cardholder)(nil),                        // 13: smartcore.bos.access.v1.Cardholder
+	(*Card)(nil),                              // 14: smartcore.bos.access.v1.Card
+	(*CreateCardholderRequest)(nil),           // 15: smartcore.bos.access.v1.CreateCardholderRequest
+	(*GetCardholderRequest)(nil),              // 16: smartcore.bos.access.v1.GetCardholderRequest
+	(*UpdateCardholderRequest)(nil),           // 17: smartcore.bos.access.v1.UpdateCardholderRequest
+	(*DisableCardholderRequest)(nil),          // 18: smartcore.bos.access.v1.DisableCardholderRequest
+	(*AssignCardRequest)(nil),                 // 19: smartcore.bos.access.v1.AssignCardRequest
+	(*PullAccessAttemptsResponse_Change)(nil), // 20: smartcore.bos.access.v1.PullAccessAttemptsResponse.Change
+	(*actorpb.Actor)(nil),                     // 21: smartcore.bos.actor.v1.Actor
+	(*timestamppb.Timestamp)(nil),             // 22: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),             // 23: google.protobuf.FieldMask
 }
 var file_smartcore_bos_access_v1_access_proto_depIdxs = []int32{
 	0,  // 0: smartcore.bos.access.v1.AccessAttempt.grant:type_name -> smartcore.bos.access.v1.AccessAttempt.Grant
-	14, // 1: smartcore.bos.access.v1.AccessAttempt.actor:type_name -> smartcore.bos.actor.v1.Actor
-	15, // 2: smartcore.bos.access.v1.AccessAttempt.access_attempt_time:type_name -> google.protobuf.Timestamp
-	16, // 3: smartcore.bos.access.v1.GetLastAccessAttemptRequest.read_mask:type_name -> google.protobuf.FieldMask
-	16, // 4: smartcore.bos.access.v1.PullAccessAttemptsRequest.read_mask:type_name -> google.protobuf.FieldMask
-	13, // 5: smartcore.bos.access.v1.PullAccessAttemptsResponse.changes:type_name -> smartcore.bos.access.v1.PullAccessAttemptsResponse.Change
-	15, // 6: smartcore.bos.access.v1.AccessGrant.start_time:type_name -> google.protobuf.Timestamp
-	15, // 7: smartcore.bos.access.v1.AccessGrant.end_time:type_name -> google.protobuf.Timestamp
-	14, // 8: smartcore.bos.access.v1.AccessGrant.grantee:type_name -> smartcore.bos.actor.v1.Actor
-	14, // 9: smartcore.bos.access.v1.AccessGrant.granter:type_name -> smartcore.bos.actor.v1.Actor
-	15, // 10: smartcore.bos.access.v1.AccessGrant.created_time:type_name -> google.protobuf.Timestamp
-	15, // 11: smartcore.bos.access.v1.AccessGrant.updated_time:type_name -> google.protobuf.Timestamp
+	21, // 1: smartcore.bos.access.v1.AccessAttempt.actor:type_name -> smartcore.bos.actor.v1.Actor
+	22, // 2: smartcore.bos.access.v1.AccessAttempt.access_attempt_time:type_name -> google.protobuf.Timestamp
+	23, // 3: smartcore.bos.access.v1.GetLastAccessAttemptRequest.read_mask:type_name -> google.protobuf.FieldMask
+	23, // 4: smartcore.bos.access.v1.PullAccessAttemptsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	20, // 5: smartcore.bos.access.v1.PullAccessAttemptsResponse.changes:type_name -> smartcore.bos.access.v1.PullAccessAttemptsResponse.Change
+	22, // 6: smartcore.bos.access.v1.AccessGrant.start_time:type_name -> google.protobuf.Timestamp
+	22, // 7: smartcore.bos.access.v1.AccessGrant.end_time:type_name -> google.protobuf.Timestamp
+	21, // 8: smartcore.bos.access.v1.AccessGrant.grantee:type_name -> smartcore.bos.actor.v1.Actor
+	21, // 9: smartcore.bos.access.v1.AccessGrant.granter:type_name -> smartcore.bos.actor.v1.Actor
+	22, // 10: smartcore.bos.access.v1.AccessGrant.created_time:type_name -> google.protobuf.Timestamp
+	22, // 11: smartcore.bos.access.v1.AccessGrant.updated_time:type_name -> google.protobuf.Timestamp
 	5,  // 12: smartcore.bos.access.v1.CreateAccessGrantRequest.access_grant:type_name -> smartcore.bos.access.v1.AccessGrant
 	5,  // 13: smartcore.bos.access.v1.UpdateAccessGrantRequest.access_grant:type_name -> smartcore.bos.access.v1.AccessGrant
-	16, // 14: smartcore.bos.access.v1.GetAccessGrantsRequest.read_mask:type_name -> google.protobuf.FieldMask
-	16, // 15: smartcore.bos.access.v1.ListAccessGrantsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	23, // 14: smartcore.bos.access.v1.GetAccessGrantsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	23, // 15: smartcore.bos.access.v1.ListAccessGrantsRequest.read_mask:type_name -> google.protobuf.FieldMask
 	5,  // 16: smartcore.bos.access.v1.ListAccessGrantsResponse.access_grants:type_name -> smartcore.bos.access.v1.AccessGrant
-	15, // 17: smartcore.bos.access.v1.PullAccessAttemptsResponse.Change.change_time:type_name -> google.protobuf.Timestamp
-	1,  // 18: smartcore.bos.access.v1.PullAccessAttemptsResponse.Change.access_attempt:type_name -> smartcore.bos.access.v1.AccessAttempt
-	2,  // 19: smartcore.bos.access.v1.AccessApi.GetLastAccessAttempt:input_type -> smartcore.bos.access.v1.GetLastAccessAttemptRequest
-	3,  // 20: smartcore.bos.access.v1.AccessApi.PullAccessAttempts:input_type -> smartcore.bos.access.v1.PullAccessAttemptsRequest
-	6,  // 21: smartcore.bos.access.v1.AccessApi.CreateAccessGrant:input_type -> smartcore.bos.access.v1.CreateAccessGrantRequest
-	7,  // 22: smartcore.bos.access.v1.AccessApi.UpdateAccessGrant:input_type -> smartcore.bos.access.v1.UpdateAccessGrantRequest
-	8,  // 23: smartcore.bos.access.v1.AccessApi.DeleteAccessGrant:input_type -> smartcore.bos.access.v1.DeleteAccessGrantRequest
-	10, // 24: smartcore.bos.access.v1.AccessApi.GetAccessGrant:input_type -> smartcore.bos.access.v1.GetAccessGrantsRequest
-	11, // 25: smartcore.bos.access.v1.AccessApi.ListAccessGrants:input_type -> smartcore.bos.access.v1.ListAccessGrantsRequest
-	1,  // 26: smartcore.bos.access.v1.AccessApi.GetLastAccessAttempt:output_type -> smartcore.bos.access.v1.AccessAttempt
-	4,  // 27: smartcore.bos.access.v1.AccessApi.PullAccessAttempts:output_type -> smartcore.bos.access.v1.PullAccessAttemptsResponse
-	5,  // 28: smartcore.bos.access.v1.AccessApi.CreateAccessGrant:output_type -> smartcore.bos.access.v1.AccessGrant
-	5,  // 29: smartcore.bos.access.v1.AccessApi.UpdateAccessGrant:output_type -> smartcore.bos.access.v1.AccessGrant
-	9,  // 30: smartcore.bos.access.v1.AccessApi.DeleteAccessGrant:output_type -> smartcore.bos.access.v1.DeleteAccessGrantResponse
-	5,  // 31: smartcore.bos.access.v1.AccessApi.GetAccessGrant:output_type -> smartcore.bos.access.v1.AccessGrant
-	12, // 32: smartcore.bos.access.v1.AccessApi.ListAccessGrants:output_type -> smartcore.bos.access.v1.ListAccessGrantsResponse
-	26, // [26:33] is the sub-list for method output_type
-	19, // [19:26] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	14, // 17: smartcore.bos.access.v1.Cardholder.cards:type_name -> smartcore.bos.access.v1.Card
+	22, // 18: smartcore.bos.access.v1.Card.start_time:type_name -> google.protobuf.Timestamp
+	22, // 19: smartcore.bos.access.v1.Card.end_time:type_name -> google.protobuf.Timestamp
+	13, // 20: smartcore.bos.access.v1.CreateCardholderRequest.cardholder:type_name -> smartcore.bos.access.v1.Cardholder
+	23, // 21: smartcore.bos.access.v1.GetCardholderRequest.read_mask:type_name -> google.protobuf.FieldMask
+	13, // 22: smartcore.bos.access.v1.UpdateCardholderRequest.cardholder:type_name -> smartcore.bos.access.v1.Cardholder
+	23, // 23: smartcore.bos.access.v1.UpdateCardholderRequest.update_mask:type_name -> google.protobuf.FieldMask
+	14, // 24: smartcore.bos.access.v1.AssignCardRequest.card:type_name -> smartcore.bos.access.v1.Card
+	22, // 25: smartcore.bos.access.v1.PullAccessAttemptsResponse.Change.change_time:type_name -> google.protobuf.Timestamp
+	1,  // 26: smartcore.bos.access.v1.PullAccessAttemptsResponse.Change.access_attempt:type_name -> smartcore.bos.access.v1.AccessAttempt
+	2,  // 27: smartcore.bos.access.v1.AccessApi.GetLastAccessAttempt:input_type -> smartcore.bos.access.v1.GetLastAccessAttemptRequest
+	3,  // 28: smartcore.bos.access.v1.AccessApi.PullAccessAttempts:input_type -> smartcore.bos.access.v1.PullAccessAttemptsRequest
+	6,  // 29: smartcore.bos.access.v1.AccessApi.CreateAccessGrant:input_type -> smartcore.bos.access.v1.CreateAccessGrantRequest
+	7,  // 30: smartcore.bos.access.v1.AccessApi.UpdateAccessGrant:input_type -> smartcore.bos.access.v1.UpdateAccessGrantRequest
+	8,  // 31: smartcore.bos.access.v1.AccessApi.DeleteAccessGrant:input_type -> smartcore.bos.access.v1.DeleteAccessGrantRequest
+	10, // 32: smartcore.bos.access.v1.AccessApi.GetAccessGrant:input_type -> smartcore.bos.access.v1.GetAccessGrantsRequest
+	11, // 33: smartcore.bos.access.v1.AccessApi.ListAccessGrants:input_type -> smartcore.bos.access.v1.ListAccessGrantsRequest
+	15, // 34: smartcore.bos.access.v1.AccessApi.CreateCardholder:input_type -> smartcore.bos.access.v1.CreateCardholderRequest
+	16, // 35: smartcore.bos.access.v1.AccessApi.GetCardholder:input_type -> smartcore.bos.access.v1.GetCardholderRequest
+	17, // 36: smartcore.bos.access.v1.AccessApi.UpdateCardholder:input_type -> smartcore.bos.access.v1.UpdateCardholderRequest
+	18, // 37: smartcore.bos.access.v1.AccessApi.DisableCardholder:input_type -> smartcore.bos.access.v1.DisableCardholderRequest
+	19, // 38: smartcore.bos.access.v1.AccessApi.AssignCard:input_type -> smartcore.bos.access.v1.AssignCardRequest
+	1,  // 39: smartcore.bos.access.v1.AccessApi.GetLastAccessAttempt:output_type -> smartcore.bos.access.v1.AccessAttempt
+	4,  // 40: smartcore.bos.access.v1.AccessApi.PullAccessAttempts:output_type -> smartcore.bos.access.v1.PullAccessAttemptsResponse
+	5,  // 41: smartcore.bos.access.v1.AccessApi.CreateAccessGrant:output_type -> smartcore.bos.access.v1.AccessGrant
+	5,  // 42: smartcore.bos.access.v1.AccessApi.UpdateAccessGrant:output_type -> smartcore.bos.access.v1.AccessGrant
+	9,  // 43: smartcore.bos.access.v1.AccessApi.DeleteAccessGrant:output_type -> smartcore.bos.access.v1.DeleteAccessGrantResponse
+	5,  // 44: smartcore.bos.access.v1.AccessApi.GetAccessGrant:output_type -> smartcore.bos.access.v1.AccessGrant
+	12, // 45: smartcore.bos.access.v1.AccessApi.ListAccessGrants:output_type -> smartcore.bos.access.v1.ListAccessGrantsResponse
+	13, // 46: smartcore.bos.access.v1.AccessApi.CreateCardholder:output_type -> smartcore.bos.access.v1.Cardholder
+	13, // 47: smartcore.bos.access.v1.AccessApi.GetCardholder:output_type -> smartcore.bos.access.v1.Cardholder
+	13, // 48: smartcore.bos.access.v1.AccessApi.UpdateCardholder:output_type -> smartcore.bos.access.v1.Cardholder
+	13, // 49: smartcore.bos.access.v1.AccessApi.DisableCardholder:output_type -> smartcore.bos.access.v1.Cardholder
+	13, // 50: smartcore.bos.access.v1.AccessApi.AssignCard:output_type -> smartcore.bos.access.v1.Cardholder
+	39, // [39:51] is the sub-list for method output_type
+	27, // [27:39] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_smartcore_bos_access_v1_access_proto_init() }
@@ -1171,7 +1737,7 @@ func file_smartcore_bos_access_v1_access_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_smartcore_bos_access_v1_access_proto_rawDesc), len(file_smartcore_bos_access_v1_access_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   13,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -194,3 +194,48 @@ func (r *ApiRouter) ListAccessGrants(ctx context.Context, request *ListAccessGra
 
 	return child.ListAccessGrants(ctx, request)
 }
+
+func (r *ApiRouter) CreateCardholder(ctx context.Context, request *CreateCardholderRequest) (*Cardholder, error) {
+	child, err := r.GetAccessApiClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.CreateCardholder(ctx, request)
+}
+
+func (r *ApiRouter) GetCardholder(ctx context.Context, request *GetCardholderRequest) (*Cardholder, error) {
+	child, err := r.GetAccessApiClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.GetCardholder(ctx, request)
+}
+
+func (r *ApiRouter) UpdateCardholder(ctx context.Context, request *UpdateCardholderRequest) (*Cardholder, error) {
+	child, err := r.GetAccessApiClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.UpdateCardholder(ctx, request)
+}
+
+func (r *ApiRouter) DisableCardholder(ctx context.Context, request *DisableCardholderRequest) (*Cardholder, error) {
+	child, err := r.GetAccessApiClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.DisableCardholder(ctx, request)
+}
+
+func (r *ApiRouter) AssignCard(ctx context.Context, request *AssignCardRequest) (*Cardholder, error) {
+	child, err := r.GetAccessApiClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.AssignCard(ctx, request)
+}
@@ -26,6 +26,11 @@ const (
 	AccessApi_DeleteAccessGrant_FullMethodName    = "/smartcore.bos.access.v1.AccessApi/DeleteAccessGrant"
 	AccessApi_GetAccessGrant_FullMethodName       = "/smartcore.bos.access.v1.AccessApi/GetAccessGrant"
 	AccessApi_ListAccessGrants_FullMethodName     = "/smartcore.bos.access.v1.AccessApi/ListAccessGrants"
+	AccessApi_CreateCardholder_FullMethodName     = "/smartcore.bos.access.v1.AccessApi/CreateCardholder"
+	AccessApi_GetCardholder_FullMethodName        = "/smartcore.bos.access.v1.AccessApi/GetCardholder"
+	AccessApi_UpdateCardholder_FullMethodName     = "/smartcore.bos.access.v1.AccessApi/UpdateCardholder"
+	AccessApi_DisableCardholder_FullMethodName    = "/smartcore.bos.access.v1.AccessApi/DisableCardholder"
+	AccessApi_AssignCard_FullMethodName           = "/smartcore.bos.access.v1.AccessApi/AssignCard"
 )
 
 // AccessApiClient is the client API for AccessApi service.
@@ -42,6 +47,16 @@ type AccessApiClient interface {
 	DeleteAccessGrant(ctx context.Context, in *DeleteAccessGrantRequest, opts ...grpc.CallOption) (*DeleteAccessGrantResponse, error)
 	GetAccessGrant(ctx context.Context, in *GetAccessGrantsRequest, opts ...grpc.CallOption) (*AccessGrant, error)
 	ListAccessGrants(ctx context.Context, in *ListAccessGrantsRequest, opts ...grpc.CallOption) (*ListAccessGrantsResponse, error)
+	// CreateCardholder adds a new cardholder to the access control system.
+	// Access is granted by the cardholders access groups, using any cards assigned to them.
+	CreateCardholder(ctx context.Context, in *CreateCardholderRequest, opts ...grpc.CallOption) (*Cardholder, error)
+	GetCardholder(ctx context.Context, in *GetCardholderRequest, opts ...grpc.CallOption) (*Cardholder, error)
+	UpdateCardholder(ctx context.Context, in *UpdateCardholderRequest, opts ...grpc.CallOption) (*Cardholder, error)
+	// DisableCardholder revokes all access for the cardholder, without removing them from the access control system.
+	// Use UpdateCardholder to enable the cardholder again.
+	DisableCardholder(ctx context.Context, in *DisableCardholderRequest, opts ...grpc.CallOption) (*Cardholder, error)
+	// AssignCard adds a card to an existing cardholder.
+	AssignCard(ctx context.Context, in *AssignCardRequest, opts ...grpc.CallOption) (*Cardholder, error)
 }
 
 type accessApiClient struct {
@@ -131,6 +146,56 @@ func (c *accessApiClient) ListAccessGrants(ctx context.Context, in *ListAccessGr
 	return out, nil
 }
 
+func (c *accessApiClient) CreateCardholder(ctx context.Context, in *CreateCardholderRequest, opts ...grpc.CallOption) (*Cardholder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cardholder)
+	err := c.cc.Invoke(ctx, AccessApi_CreateCardholder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessApiClient) GetCardholder(ctx context.Context, in *GetCardholderRequest, opts ...grpc.CallOption) (*Cardholder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cardholder)
+	err := c.cc.Invoke(ctx, AccessApi_GetCardholder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessApiClient) UpdateCardholder(ctx context.Context, in *UpdateCardholderRequest, opts ...grpc.CallOption) (*Cardholder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cardholder)
+	err := c.cc.Invoke(ctx, AccessApi_UpdateCardholder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessApiClient) DisableCardholder(ctx context.Context, in *DisableCardholderRequest, opts ...grpc.CallOption) (*Cardholder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cardholder)
+	err := c.cc.Invoke(ctx, AccessApi_DisableCardholder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessApiClient) AssignCard(ctx context.Context, in *AssignCardRequest, opts ...grpc.CallOption) (*Cardholder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cardholder)
+	err := c.cc.Invoke(ctx, AccessApi_AssignCard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccessApiServer is the server API for AccessApi service.
 // All implementations must embed UnimplementedAccessApiServer
 // for forward compatibility.
@@ -145,6 +210,16 @@ type AccessApiServer interface {
 	DeleteAccessGrant(context.Context, *DeleteAccessGrantRequest) (*DeleteAccessGrantResponse, error)
 	GetAccessGrant(context.Context, *GetAccessGrantsRequest) (*AccessGrant, error)
 	ListAccessGrants(context.Context, *ListAccessGrantsRequest) (*ListAccessGrantsResponse, error)
+	// CreateCardholder adds a new cardholder to the access control system.
+	// Access is granted by the cardholders access groups, using any cards assigned to them.
+	CreateCardholder(context.Context, *CreateCardholderRequest) (*Cardholder, error)
+	GetCardholder(context.Context, *GetCardholderRequest) (*Cardholder, error)
+	UpdateCardholder(context.Context, *UpdateCardholderRequest) (*Cardholder, error)
+	// DisableCardholder revokes all access for the cardholder, without removing them from the access control system.
+	// Use UpdateCardholder to enable the cardholder again.
+	DisableCardholder(context.Context, *DisableCardholderRequest) (*Cardholder, error)
+	// AssignCard adds a card to an existing cardholder.
+	AssignCard(context.Context, *AssignCardRequest) (*Cardholder, error)
 	mustEmbedUnimplementedAccessApiServer()
 }
 
@@ -176,6 +251,21 @@ func (UnimplementedAccessApiServer) GetAccessGrant(context.Context, *GetAccessGr
 func (UnimplementedAccessApiServer) ListAccessGrants(context.Context, *ListAccessGrantsRequest) (*ListAccessGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAccessGrants not implemented")
 }
+func (UnimplementedAccessApiServer) CreateCardholder(context.Context, *CreateCardholderRequest) (*Cardholder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCardholder not implemented")
+}
+func (UnimplementedAccessApiServer) GetCardholder(context.Context, *GetCardholderRequest) (*Cardholder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCardholder not implemented")
+}
+func (UnimplementedAccessApiServer) UpdateCardholder(context.Context, *UpdateCardholderRequest) (*Cardholder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCardholder not implemented")
+}
+func (UnimplementedAccessApiServer) DisableCardholder(context.Context, *DisableCardholderRequest) (*Cardholder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableCardholder not implemented")
+}
+func (UnimplementedAccessApiServer) AssignCard(context.Context, *AssignCardRequest) (*Cardholder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignCard not implemented")
+}
 func (UnimplementedAccessApiServer) mustEmbedUnimplementedAccessApiServer() {}
 func (UnimplementedAccessApiServer) testEmbeddedByValue()                   {}
 
@@ -316,6 +406,96 @@ func _AccessApi_ListAccessGrants_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccessApi_CreateCardholder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCardholderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessApiServer).CreateCardholder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessApi_CreateCardholder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessApiServer).CreateCardholder(ctx, req.(*CreateCardholderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessApi_GetCardholder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCardholderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessApiServer).GetCardholder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessApi_GetCardholder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessApiServer).GetCardholder(ctx, req.(*GetCardholderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessApi_UpdateCardholder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCardholderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessApiServer).UpdateCardholder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessApi_UpdateCardholder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessApiServer).UpdateCardholder(ctx, req.(*UpdateCardholderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessApi_DisableCardholder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableCardholderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessApiServer).DisableCardholder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessApi_DisableCardholder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessApiServer).DisableCardholder(ctx, req.(*DisableCardholderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessApi_AssignCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessApiServer).AssignCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccessApi_AssignCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessApiServer).AssignCard(ctx, req.(*AssignCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AccessApi_ServiceDesc is the grpc.ServiceDesc for AccessApi service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -347,6 +527,26 @@ var AccessApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAccessGrants",
 			Handler:    _AccessApi_ListAccessGrants_Handler,
 		},
+		{
+			MethodName: "CreateCardholder",
+			Handler:    _AccessApi_CreateCardholder_Handler,
+		},
+		{
+			MethodName: "GetCardholder",
+			Handler:    _AccessApi_GetCardholder_Handler,
+		},
+		{
+			MethodName: "UpdateCardholder",
+			Handler:    _AccessApi_UpdateCardholder_Handler,
+		},
+		{
+			MethodName: "DisableCardholder",
+			Handler:    _AccessApi_DisableCardholder_Handler,
+		},
+		{
+			MethodName: "AssignCard",
+			Handler:    _AccessApi_AssignCard_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -101,8 +101,11 @@ type AirQuality struct {
 	ParticulateMatter_10 *float32 `protobuf:"fixed32,9,opt,name=particulate_matter_10,json=particulateMatter10,proto3,oneof" json:"particulate_matter_10,omitempty"`
 	// The number of times per hour the air in the area is replaced.
 	AirChangePerHour *float32 `protobuf:"fixed32,10,opt,name=air_change_per_hour,json=airChangePerHour,proto3,oneof" json:"air_change_per_hour,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Optional, read-only. How the values of a group of devices were aggregated to produce this value, keyed by field name.
+	// Present when this value is aggregated from multiple devices, like for a zone.
+	Aggregations  map[string]*typespb.Aggregation `protobuf:"bytes,11,rep,name=aggregations,proto3" json:"aggregations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AirQuality) Reset() {
@@ -205,6 +208,13 @@ func (x *AirQuality) GetAirChangePerHour() float32 {
 	return 0
 }
 
+func (x *AirQuality) GetAggregations() map[string]*typespb.Aggregation {
+	if x != nil {
+		return x.Aggregations
+	}
+	return nil
+}
+
 // AirQualitySupport describes the capabilities of devices implementing this trait
 type AirQualitySupport struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -565,7 +575,7 @@ type PullAirQualityResponse_Change struct {
 
 func (x *PullAirQualityResponse_Change) Reset() {
 	*x = PullAirQualityResponse_Change{}
-	mi := &file_smartcore_bos_airqualitysensor_v1_air_quality_sensor_proto_msgTypes[6]
+	mi := &file_smartcore_bos_airqualitysensor_v1_air_quality_sensor_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -577,7 +587,7 @@ func (x *PullAirQualityResponse_Change) String() string {
 func (*PullAirQualityResponse_Change) ProtoMessage() {}
 
 func (x *PullAirQualityResponse_Change) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_airqualitysensor_v1_air_quality_sensor_proto_msgTypes[6]
+	mi := &file_smartcore_bos_airqualitysensor_v1_air_quality_sensor_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -625,7 +635,7 @@ var File_smartcore_bos_airqualitysensor_v1_air_quality_sensor_proto protoreflect
 
 const file_smartcore_bos_airqualitysensor_v1_air_quality_sensor_proto_rawDesc = "" +
 	"\n" +
-	":smartcore/bos/airqualitysensor/v1/air_quality_sensor.proto\x12!smartcore.bos.airqualitysensor.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a!smartcore/bos/types/v1/info.proto\x1a#smartcore/bos/types/v1/number.proto\"\xb6\x06\n" +
+	":smartcore/bos/airqualitysensor/v1/air_quality_sensor.proto\x12!smartcore.bos.airqualitysensor.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a(smartcore/bos/types/v1/aggregation.proto\x1a!smartcore/bos/types/v1/info.proto\x1a#smartcore/bos/types/v1/number.proto\"\x81\b\n" +
 	"\n" +
 	"AirQuality\x125\n" +
 	"\x14carbon_dioxide_level\x18\x01 \x01(\x02H\x00R\x12carbonDioxideLevel\x88\x01\x01\x12A\n" +
@@ -638,7 +648,11 @@ const file_smartcore_bos_airqualitysensor_v1_air_quality_sensor_proto_rawDesc =
 	"\x15particulate_matter_25\x18\b \x01(\x02H\x06R\x13particulateMatter25\x88\x01\x01\x127\n" +
 	"\x15particulate_matter_10\x18\t \x01(\x02H\aR\x13particulateMatter10\x88\x01\x01\x122\n" +
 	"\x13air_change_per_hour\x18\n" +
-	" \x01(\x02H\bR\x10airChangePerHour\x88\x01\x01\"F\n" +
+	" \x01(\x02H\bR\x10airChangePerHour\x88\x01\x01\x12c\n" +
+	"\faggregations\x18\v \x03(\v2?.smartcore.bos.airqualitysensor.v1.AirQuality.AggregationsEntryR\faggregations\x1ad\n" +
+	"\x11AggregationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x129\n" +
+	"\x05value\x18\x02 \x01(\v2#.smartcore.bos.types.v1.AggregationR\x05value:\x028\x01\"F\n" +
 	"\aComfort\x12\x17\n" +
 	"\x13COMFORT_UNSPECIFIED\x10\x00\x12\x0f\n" +
 	"\vCOMFORTABLE\x10\x01\x12\x11\n" +
@@ -703,7 +717,7 @@ func file_smartcore_bos_airqualitysensor_v1_air_quality_sensor_proto_rawDescGZIP
 }
 
 var file_smartcore_bos_airqualitysensor_v1_air_quality_sensor_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_smartcore_bos_airqualitysensor_v1_air_quality_sensor_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_smartcore_bos_airqualitysensor_v1_air_quality_sensor_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_smartcore_bos_airqualitysensor_v1_air_quality_sensor_proto_goTypes = []any{
 	(AirQuality_Comfort)(0),               // 0: smartcore.bos.airqualitysensor.v1.AirQuality.Comfort
 	(*AirQuality)(nil),                    // 1: smartcore.bos.airqualitysensor.v1.AirQuality
@@ -712,42 +726,46 @@ var file_smartcore_bos_airqualitysensor_v1_air_quality_sensor_proto_goTypes = []
 	(*PullAirQualityRequest)(nil),         // 4: smartcore.bos.airqualitysensor.v1.PullAirQualityRequest
 	(*PullAirQualityResponse)(nil),        // 5: smartcore.bos.airqualitysensor.v1.PullAirQualityResponse
 	(*DescribeAirQualityRequest)(nil),     // 6: smartcore.bos.airqualitysensor.v1.DescribeAirQualityRequest
-	(*PullAirQualityResponse_Change)(nil), // 7: smartcore.bos.airqualitysensor.v1.PullAirQualityResponse.Change
-	(*typespb.ResourceSupport)(nil),       // 8: smartcore.bos.types.v1.ResourceSupport
-	(*typespb.FloatBounds)(nil),           // 9: smartcore.bos.types.v1.FloatBounds
-	(*fieldmaskpb.FieldMask)(nil),         // 10: google.protobuf.FieldMask
-	(*timestamppb.Timestamp)(nil),         // 11: google.protobuf.Timestamp
+	nil,                                   // 7: smartcore.bos.airqualitysensor.v1.AirQuality.AggregationsEntry
+	(*PullAirQualityResponse_Change)(nil), // 8: smartcore.bos.airqualitysensor.v1.PullAirQualityResponse.Change
+	(*typespb.ResourceSupport)(nil),       // 9: smartcore.bos.types.v1.ResourceSupport
+	(*typespb.FloatBounds)(nil),           // 10: smartcore.bos.types.v1.FloatBounds
+	(*fieldmaskpb.FieldMask)(nil),         // 11: google.protobuf.FieldMask
+	(*typespb.Aggregation)(nil),           // 12: smartcore.bos.types.v1.Aggregation
+	(*timestamppb.Timestamp)(nil),         // 13: google.protobuf.Timestamp
 }
 var file_smartcore_bos_airqualitysensor_v1_air_quality_sensor_proto_depIdxs = []int32{
 	0,  // 0: smartcore.bos.airqualitysensor.v1.AirQuality.comfort:type_name -> smartcore.bos.airqualitysensor.v1.AirQuality.Comfort
-	8,  // 1: smartcore.bos.airqualitysensor.v1.AirQualitySupport.resource_support:type_name -> smartcore.bos.types.v1.ResourceSupport
-	9,  // 2: smartcore.bos.airqualitysensor.v1.AirQualitySupport.carbon_dioxide_level:type_name -> smartcore.bos.types.v1.FloatBounds
-	9,  // 3: smartcore.bos.airqualitysensor.v1.AirQualitySupport.volatile_organic_compounds:type_name -> smartcore.bos.types.v1.FloatBounds
-	9,  // 4: smartcore.bos.airqualitysensor.v1.AirQualitySupport.air_pressure:type_name -> smartcore.bos.types.v1.FloatBounds
-	0,  // 5: smartcore.bos.airqualitysensor.v1.AirQualitySupport.comfort:type_name -> smartcore.bos.airqualitysensor.v1.AirQuality.Comfort
-	9,  // 6: smartcore.bos.airqualitysensor.v1.AirQualitySupport.infection_risk:type_name -> smartcore.bos.types.v1.FloatBounds
-	9,  // 7: smartcore.bos.airqualitysensor.v1.AirQualitySupport.score:type_name -> smartcore.bos.types.v1.FloatBounds
-	9,  // 8: smartcore.bos.airqualitysensor.v1.AirQualitySupport.particulate_matter_1:type_name -> smartcore.bos.types.v1.FloatBounds
-	9,  // 9: smartcore.bos.airqualitysensor.v1.AirQualitySupport.particulate_matter_25:type_name -> smartcore.bos.types.v1.FloatBounds
-	9,  // 10: smartcore.bos.airqualitysensor.v1.AirQualitySupport.particulate_matter_10:type_name -> smartcore.bos.types.v1.FloatBounds
-	9,  // 11: smartcore.bos.airqualitysensor.v1.AirQualitySupport.air_change_per_hour:type_name -> smartcore.bos.types.v1.FloatBounds
-	10, // 12: smartcore.bos.airqualitysensor.v1.GetAirQualityRequest.read_mask:type_name -> google.protobuf.FieldMask
-	10, // 13: smartcore.bos.airqualitysensor.v1.PullAirQualityRequest.read_mask:type_name -> google.protobuf.FieldMask
-	7,  // 14: smartcore.bos.airqualitysensor.v1.PullAirQualityResponse.changes:type_name -> smartcore.bos.airqualitysensor.v1.PullAirQualityResponse.Change
-	11, // 15: smartcore.bos.airqualitysensor.v1.PullAirQualityResponse.Change.change_time:type_name -> google.protobuf.Timestamp
-	1,  // 16: smartcore.bos.airqualitysensor.v1.PullAirQualityResponse.Change.air_quality:type_name -> smartcore.bos.airqualitysensor.v1.AirQuality
-	10, // 17: smartcore.bos.airqualitysensor.v1.PullAirQualityResponse.Change.update_mask:type_name -> google.protobuf.FieldMask
-	3,  // 18: smartcore.bos.airqualitysensor.v1.AirQualitySensorApi.GetAirQuality:input_type -> smartcore.bos.airqualitysensor.v1.GetAirQualityRequest
-	4,  // 19: smartcore.bos.airqualitysensor.v1.AirQualitySensorApi.PullAirQuality:input_type -> smartcore.bos.airqualitysensor.v1.PullAirQualityRequest
-	6,  // 20: smartcore.bos.airqualitysensor.v1.AirQualitySensorInfo.DescribeAirQuality:input_type -> smartcore.bos.airqualitysensor.v1.DescribeAirQualityRequest
-	1,  // 21: smartcore.bos.airqualitysensor.v1.AirQualitySensorApi.GetAirQuality:output_type -> smartcore.bos.airqualitysensor.v1.AirQuality
-	5,  // 22: smartcore.bos.airqualitysensor.v1.AirQualitySensorApi.PullAirQuality:output_type -> smartcore.bos.airqualitysensor.v1.PullAirQualityResponse
-	2,  // 23: smartcore.bos.airqualitysensor.v1.AirQualitySensorInfo.DescribeAirQuality:output_type -> smartcore.bos.airqualitysensor.v1.AirQualitySupport
-	21, // [21:24] is the sub-list for method output_type
-	18, // [18:21] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	7,  // 1: smartcore.bos.airqualitysensor.v1.AirQuality.aggregations:type_name -> smartcore.bos.airqualitysensor.v1.AirQuality.AggregationsEntry
+	9,  // 2: smartcore.bos.airqualitysensor.v1.AirQualitySupport.resource_support:type_name -> smartcore.bos.types.v1.ResourceSupport
+	10, // 3: smartcore.bos.airqualitysensor.v1.AirQualitySupport.carbon_dioxide_level:type_name -> smartcore.bos.types.v1.FloatBounds
+	10, // 4: smartcore.bos.airqualitysensor.v1.AirQualitySupport.volatile_organic_compounds:type_name -> smartcore.bos.types.v1.FloatBounds
+	10, // 5: smartcore.bos.airqualitysensor.v1.AirQualitySupport.air_pressure:type_name -> smartcore.bos.types.v1.FloatBounds
+	0,  // 6: smartcore.bos.airqualitysensor.v1.AirQualitySupport.comfort:type_name -> smartcore.bos.airqualitysensor.v1.AirQuality.Comfort
+	10, // 7: smartcore.bos.airqualitysensor.v1.AirQualitySupport.infection_risk:type_name -> smartcore.bos.types.v1.FloatBounds
+	10, // 8: smartcore.bos.airqualitysensor.v1.AirQualitySupport.score:type_name -> smartcore.bos.types.v1.FloatBounds
+	10, // 9: smartcore.bos.airqualitysensor.v1.AirQualitySupport.particulate_matter_1:type_name -> smartcore.bos.types.v1.FloatBounds
+	10, // 10: smartcore.bos.airqualitysensor.v1.AirQualitySupport.particulate_matter_25:type_name -> smartcore.bos.types.v1.FloatBounds
+	10, // 11: smartcore.bos.airqualitysensor.v1.AirQualitySupport.particulate_matter_10:type_name -> smartcore.bos.types.v1.FloatBounds
+	10, // 12: smartcore.bos.airqualitysensor.v1.AirQualitySupport.air_change_per_hour:type_name -> smartcore.bos.types.v1.FloatBounds
+	11, // 13: smartcore.bos.airqualitysensor.v1.GetAirQualityRequest.read_mask:type_name -> google.protobuf.FieldMask
+	11, // 14: smartcore.bos.airqualitysensor.v1.PullAirQualityRequest.read_mask:type_name -> google.protobuf.FieldMask
+	8,  // 15: smartcore.bos.airqualitysensor.v1.PullAirQualityResponse.changes:type_name -> smartcore.bos.airqualitysensor.v1.PullAirQualityResponse.Change
+	12, // 16: smartcore.bos.airqualitysensor.v1.AirQuality.AggregationsEntry.value:type_name -> smartcore.bos.types.v1.Aggregation
+	13, // 17: smartcore.bos.airqualitysensor.v1.PullAirQualityResponse.Change.change_time:type_name -> google.protobuf.Timestamp
+	1,  // 18: smartcore.bos.airqualitysensor.v1.PullAirQualityResponse.Change.air_quality:type_name -> smartcore.bos.airqualitysensor.v1.AirQuality
+	11, // 19: smartcore.bos.airqualitysensor.v1.PullAirQualityResponse.Change.update_mask:type_name -> google.protobuf.FieldMask
+	3,  // 20: smartcore.bos.airqualitysensor.v1.AirQualitySensorApi.GetAirQuality:input_type -> smartcore.bos.airqualitysensor.v1.GetAirQualityRequest
+	4,  // 21: smartcore.bos.airqualitysensor.v1.AirQualitySensorApi.PullAirQuality:input_type -> smartcore.bos.airqualitysensor.v1.PullAirQualityRequest
+	6,  // 22: smartcore.bos.airqualitysensor.v1.AirQualitySensorInfo.DescribeAirQuality:input_type -> smartcore.bos.airqualitysensor.v1.DescribeAirQualityRequest
+	1,  // 23: smartcore.bos.airqualitysensor.v1.AirQualitySensorApi.GetAirQuality:output_type -> smartcore.bos.airqualitysensor.v1.AirQuality
+	5,  // 24: smartcore.bos.airqualitysensor.v1.AirQualitySensorApi.PullAirQuality:output_type -> smartcore.bos.airqualitysensor.v1.PullAirQualityResponse
+	2,  // 25: smartcore.bos.airqualitysensor.v1.AirQualitySensorInfo.DescribeAirQuality:output_type -> smartcore.bos.airqualitysensor.v1.AirQualitySupport
+	23, // [23:26] is the sub-list for method output_type
+	20, // [20:23] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_smartcore_bos_airqualitysensor_v1_air_quality_sensor_proto_init() }
@@ -762,7 +780,7 @@ func file_smartcore_bos_airqualitysensor_v1_air_quality_sensor_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_smartcore_bos_airqualitysensor_v1_air_quality_sensor_proto_rawDesc), len(file_smartcore_bos_airqualitysensor_v1_air_quality_sensor_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
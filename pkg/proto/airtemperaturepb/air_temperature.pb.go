@@ -116,6 +116,73 @@ func (AirTemperature_Mode) EnumDescriptor() ([]byte, []int) {
 	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescGZIP(), []int{0, 0}
 }
 
+// The rules that can produce a temperature goal.
+type TemperatureGoalSource_Rule int32
+
+const (
+	TemperatureGoalSource_RULE_UNSPECIFIED TemperatureGoalSource_Rule = 0
+	// The temperature goal is the most recently requested set point.
+	TemperatureGoalSource_REQUESTED TemperatureGoalSource_Rule = 1
+	// The temperature goal is the default set point,
+	// either because no set point has been requested or because the requested set point expired.
+	TemperatureGoalSource_DEFAULT TemperatureGoalSource_Rule = 2
+	// The requested set point is outside the limits allowed for the tenant of the space,
+	// the temperature goal is the nearest limit.
+	TemperatureGoalSource_TENANT_LIMIT TemperatureGoalSource_Rule = 3
+	// The requested set point is outside the limits allowed for the building,
+	// the temperature goal is the nearest limit.
+	TemperatureGoalSource_BUILDING_LIMIT TemperatureGoalSource_Rule = 4
+	// The space is not expected to be occupied, the temperature goal has been offset from the requested set point.
+	TemperatureGoalSource_UNOCCUPIED_OFFSET TemperatureGoalSource_Rule = 5
+)
+
+// Enum value maps for TemperatureGoalSource_Rule.
+var (
+	TemperatureGoalSource_Rule_name = map[int32]string{
+		0: "RULE_UNSPECIFIED",
+		1: "REQUESTED",
+		2: "DEFAULT",
+		3: "TENANT_LIMIT",
+		4: "BUILDING_LIMIT",
+		5: "UNOCCUPIED_OFFSET",
+	}
+	TemperatureGoalSource_Rule_value = map[string]int32{
+		"RULE_UNSPECIFIED":  0,
+		"REQUESTED":         1,
+		"DEFAULT":           2,
+		"TENANT_LIMIT":      3,
+		"BUILDING_LIMIT":    4,
+		"UNOCCUPIED_OFFSET": 5,
+	}
+)
+
+func (x TemperatureGoalSource_Rule) Enum() *TemperatureGoalSource_Rule {
+	p := new(TemperatureGoalSource_Rule)
+	*p = x
+	return p
+}
+
+func (x TemperatureGoalSource_Rule) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TemperatureGoalSource_Rule) Descriptor() protoreflect.EnumDescriptor {
+	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_enumTypes[1].Descriptor()
+}
+
+func (TemperatureGoalSource_Rule) Type() protoreflect.EnumType {
+	return &file_smartcore_bos_airtemperature_v1_air_temperature_proto_enumTypes[1]
+}
+
+func (x TemperatureGoalSource_Rule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TemperatureGoalSource_Rule.Descriptor instead.
+func (TemperatureGoalSource_Rule) EnumDescriptor() ([]byte, []int) {
+	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescGZIP(), []int{1, 0}
+}
+
 // All the properties of the device
 type AirTemperature struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -132,7 +199,13 @@ type AirTemperature struct {
 	// Optional, read-only. The ambient relative humidity percentage, as read by the device
 	AmbientHumidity *float32 `protobuf:"fixed32,6,opt,name=ambient_humidity,json=ambientHumidity,proto3,oneof" json:"ambient_humidity,omitempty"`
 	// Optional, read-only. The dew-point as read by the device
-	DewPoint      *typespb.Temperature `protobuf:"bytes,7,opt,name=dew_point,json=dewPoint,proto3" json:"dew_point,omitempty"`
+	DewPoint *typespb.Temperature `protobuf:"bytes,7,opt,name=dew_point,json=dewPoint,proto3" json:"dew_point,omitempty"`
+	// Optional, read-only. Describes which rule produced the temperature goal.
+	// Present for devices that arbitrate requested set points against other rules like limits or schedules.
+	TemperatureGoalSource *TemperatureGoalSource `protobuf:"bytes,8,opt,name=temperature_goal_source,json=temperatureGoalSource,proto3" json:"temperature_goal_source,omitempty"`
+	// Optional, read-only. How the values of a group of devices were aggregated to produce this value, keyed by field name.
+	// Present when this value is aggregated from multiple devices, like for a zone.
+	Aggregations  map[string]*typespb.Aggregation `protobuf:"bytes,9,rep,name=aggregations,proto3" json:"aggregations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -229,6 +302,20 @@ func (x *AirTemperature) GetDewPoint() *typespb.Temperature {
 	return nil
 }
 
+func (x *AirTemperature) GetTemperatureGoalSource() *TemperatureGoalSource {
+	if x != nil {
+		return x.TemperatureGoalSource
+	}
+	return nil
+}
+
+func (x *AirTemperature) GetAggregations() map[string]*typespb.Aggregation {
+	if x != nil {
+		return x.Aggregations
+	}
+	return nil
+}
+
 type isAirTemperature_TemperatureGoal interface {
 	isAirTemperature_TemperatureGoal()
 }
@@ -254,6 +341,81 @@ func (*AirTemperature_TemperatureSetPointDelta) isAirTemperature_TemperatureGoal
 
 func (*AirTemperature_TemperatureRange) isAirTemperature_TemperatureGoal() {}
 
+// TemperatureGoalSource describes why a device has the temperature goal it does.
+type TemperatureGoalSource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The rule that produced the temperature goal.
+	Rule TemperatureGoalSource_Rule `protobuf:"varint,1,opt,name=rule,proto3,enum=smartcore.bos.airtemperature.v1.TemperatureGoalSource_Rule" json:"rule,omitempty"`
+	// A human readable explanation of the rule, suitable for showing to occupants.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// The set point that was requested before any rules were applied.
+	// Absent if no set point has been requested.
+	RequestedSetPoint *typespb.Temperature `protobuf:"bytes,3,opt,name=requested_set_point,json=requestedSetPoint,proto3" json:"requested_set_point,omitempty"`
+	// When the requested set point expires and the temperature goal reverts to the default.
+	// Absent if the requested set point does not expire.
+	RequestExpireTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=request_expire_time,json=requestExpireTime,proto3" json:"request_expire_time,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TemperatureGoalSource) Reset() {
+	*x = TemperatureGoalSource{}
+	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemperatureGoalSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemperatureGoalSource) ProtoMessage() {}
+
+func (x *TemperatureGoalSource) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemperatureGoalSource.ProtoReflect.Descriptor instead.
+func (*TemperatureGoalSource) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TemperatureGoalSource) GetRule() TemperatureGoalSource_Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return TemperatureGoalSource_RULE_UNSPECIFIED
+}
+
+func (x *TemperatureGoalSource) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TemperatureGoalSource) GetRequestedSetPoint() *typespb.Temperature {
+	if x != nil {
+		return x.RequestedSetPoint
+	}
+	return nil
+}
+
+func (x *TemperatureGoalSource) GetRequestExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestExpireTime
+	}
+	return nil
+}
+
 // A setting for devices that target a temperature between a range.
 type TemperatureRange struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -269,7 +431,7 @@ type TemperatureRange struct {
 
 func (x *TemperatureRange) Reset() {
 	*x = TemperatureRange{}
-	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[1]
+	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -281,7 +443,7 @@ func (x *TemperatureRange) String() string {
 func (*TemperatureRange) ProtoMessage() {}
 
 func (x *TemperatureRange) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[1]
+	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -294,7 +456,7 @@ func (x *TemperatureRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TemperatureRange.ProtoReflect.Descriptor instead.
 func (*TemperatureRange) Descriptor() ([]byte, []int) {
-	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescGZIP(), []int{1}
+	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *TemperatureRange) GetLow() *typespb.Temperature {
@@ -335,7 +497,7 @@ type AirTemperatureSupport struct {
 
 func (x *AirTemperatureSupport) Reset() {
 	*x = AirTemperatureSupport{}
-	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[2]
+	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -347,7 +509,7 @@ func (x *AirTemperatureSupport) String() string {
 func (*AirTemperatureSupport) ProtoMessage() {}
 
 func (x *AirTemperatureSupport) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[2]
+	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +522,7 @@ func (x *AirTemperatureSupport) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AirTemperatureSupport.ProtoReflect.Descriptor instead.
 func (*AirTemperatureSupport) Descriptor() ([]byte, []int) {
-	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescGZIP(), []int{2}
+	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *AirTemperatureSupport) GetResourceSupport() *typespb.ResourceSupport {
@@ -403,7 +565,7 @@ type GetAirTemperatureRequest struct {
 
 func (x *GetAirTemperatureRequest) Reset() {
 	*x = GetAirTemperatureRequest{}
-	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[3]
+	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -415,7 +577,7 @@ func (x *GetAirTemperatureRequest) String() string {
 func (*GetAirTemperatureRequest) ProtoMessage() {}
 
 func (x *GetAirTemperatureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[3]
+	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -428,7 +590,7 @@ func (x *GetAirTemperatureRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAirTemperatureRequest.ProtoReflect.Descriptor instead.
 func (*GetAirTemperatureRequest) Descriptor() ([]byte, []int) {
-	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescGZIP(), []int{3}
+	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetAirTemperatureRequest) GetName() string {
@@ -458,7 +620,7 @@ type UpdateAirTemperatureRequest struct {
 
 func (x *UpdateAirTemperatureRequest) Reset() {
 	*x = UpdateAirTemperatureRequest{}
-	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[4]
+	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -470,7 +632,7 @@ func (x *UpdateAirTemperatureRequest) String() string {
 func (*UpdateAirTemperatureRequest) ProtoMessage() {}
 
 func (x *UpdateAirTemperatureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[4]
+	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -483,7 +645,7 @@ func (x *UpdateAirTemperatureRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAirTemperatureRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAirTemperatureRequest) Descriptor() ([]byte, []int) {
-	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescGZIP(), []int{4}
+	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *UpdateAirTemperatureRequest) GetName() string {
@@ -522,7 +684,7 @@ type PullAirTemperatureRequest struct {
 
 func (x *PullAirTemperatureRequest) Reset() {
 	*x = PullAirTemperatureRequest{}
-	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[5]
+	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -534,7 +696,7 @@ func (x *PullAirTemperatureRequest) String() string {
 func (*PullAirTemperatureRequest) ProtoMessage() {}
 
 func (x *PullAirTemperatureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[5]
+	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,7 +709,7 @@ func (x *PullAirTemperatureRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullAirTemperatureRequest.ProtoReflect.Descriptor instead.
 func (*PullAirTemperatureRequest) Descriptor() ([]byte, []int) {
-	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescGZIP(), []int{5}
+	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PullAirTemperatureRequest) GetName() string {
@@ -581,7 +743,7 @@ type PullAirTemperatureResponse struct {
 
 func (x *PullAirTemperatureResponse) Reset() {
 	*x = PullAirTemperatureResponse{}
-	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[6]
+	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -593,7 +755,7 @@ func (x *PullAirTemperatureResponse) String() string {
 func (*PullAirTemperatureResponse) ProtoMessage() {}
 
 func (x *PullAirTemperatureResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[6]
+	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -606,7 +768,7 @@ func (x *PullAirTemperatureResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullAirTemperatureResponse.ProtoReflect.Descriptor instead.
 func (*PullAirTemperatureResponse) Descriptor() ([]byte, []int) {
-	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescGZIP(), []int{6}
+	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PullAirTemperatureResponse) GetChanges() []*PullAirTemperatureResponse_Change {
@@ -626,7 +788,7 @@ type DescribeAirTemperatureRequest struct {
 
 func (x *DescribeAirTemperatureRequest) Reset() {
 	*x = DescribeAirTemperatureRequest{}
-	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[7]
+	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -638,7 +800,7 @@ func (x *DescribeAirTemperatureRequest) String() string {
 func (*DescribeAirTemperatureRequest) ProtoMessage() {}
 
 func (x *DescribeAirTemperatureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[7]
+	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -651,7 +813,7 @@ func (x *DescribeAirTemperatureRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeAirTemperatureRequest.ProtoReflect.Descriptor instead.
 func (*DescribeAirTemperatureRequest) Descriptor() ([]byte, []int) {
-	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescGZIP(), []int{7}
+	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DescribeAirTemperatureRequest) GetName() string {
@@ -676,7 +838,7 @@ type PullAirTemperatureResponse_Change struct {
 
 func (x *PullAirTemperatureResponse_Change) Reset() {
 	*x = PullAirTemperatureResponse_Change{}
-	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[8]
+	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -688,7 +850,7 @@ func (x *PullAirTemperatureResponse_Change) String() string {
 func (*PullAirTemperatureResponse_Change) ProtoMessage() {}
 
 func (x *PullAirTemperatureResponse_Change) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[8]
+	mi := &file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -701,7 +863,7 @@ func (x *PullAirTemperatureResponse_Change) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use PullAirTemperatureResponse_Change.ProtoReflect.Descriptor instead.
 func (*PullAirTemperatureResponse_Change) Descriptor() ([]byte, []int) {
-	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescGZIP(), []int{6, 0}
+	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (x *PullAirTemperatureResponse_Change) GetName() string {
@@ -729,7 +891,7 @@ var File_smartcore_bos_airtemperature_v1_air_temperature_proto protoreflect.File
 
 const file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDesc = "" +
 	"\n" +
-	"5smartcore/bos/airtemperature/v1/air_temperature.proto\x12\x1fsmartcore.bos.airtemperature.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a!smartcore/bos/types/v1/info.proto\x1a!smartcore/bos/types/v1/unit.proto\"\x85\x06\n" +
+	"5smartcore/bos/airtemperature/v1/air_temperature.proto\x12\x1fsmartcore.bos.airtemperature.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a(smartcore/bos/types/v1/aggregation.proto\x1a!smartcore/bos/types/v1/info.proto\x1a!smartcore/bos/types/v1/unit.proto\"\xc2\b\n" +
 	"\x0eAirTemperature\x12H\n" +
 	"\x04mode\x18\x01 \x01(\x0e24.smartcore.bos.airtemperature.v1.AirTemperature.ModeR\x04mode\x12Y\n" +
 	"\x15temperature_set_point\x18\x02 \x01(\v2#.smartcore.bos.types.v1.TemperatureH\x00R\x13temperatureSetPoint\x12d\n" +
@@ -737,7 +899,12 @@ const file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDesc = "" +
 	"\x11temperature_range\x18\x04 \x01(\v21.smartcore.bos.airtemperature.v1.TemperatureRangeH\x00R\x10temperatureRange\x12T\n" +
 	"\x13ambient_temperature\x18\x05 \x01(\v2#.smartcore.bos.types.v1.TemperatureR\x12ambientTemperature\x12.\n" +
 	"\x10ambient_humidity\x18\x06 \x01(\x02H\x01R\x0fambientHumidity\x88\x01\x01\x12@\n" +
-	"\tdew_point\x18\a \x01(\v2#.smartcore.bos.types.v1.TemperatureR\bdewPoint\"\x94\x01\n" +
+	"\tdew_point\x18\a \x01(\v2#.smartcore.bos.types.v1.TemperatureR\bdewPoint\x12n\n" +
+	"\x17temperature_goal_source\x18\b \x01(\v26.smartcore.bos.airtemperature.v1.TemperatureGoalSourceR\x15temperatureGoalSource\x12e\n" +
+	"\faggregations\x18\t \x03(\v2A.smartcore.bos.airtemperature.v1.AirTemperature.AggregationsEntryR\faggregations\x1ad\n" +
+	"\x11AggregationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x129\n" +
+	"\x05value\x18\x02 \x01(\v2#.smartcore.bos.types.v1.AggregationR\x05value:\x028\x01\"\x94\x01\n" +
 	"\x04Mode\x12\x14\n" +
 	"\x10MODE_UNSPECIFIED\x10\x00\x12\x06\n" +
 	"\x02ON\x10\x01\x12\a\n" +
@@ -754,7 +921,19 @@ const file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDesc = "" +
 	"\n" +
 	"\x06LOCKED\x10\vB\x12\n" +
 	"\x10temperature_goalB\x13\n" +
-	"\x11_ambient_humidity\"\xbd\x01\n" +
+	"\x11_ambient_humidity\"\xa2\x03\n" +
+	"\x15TemperatureGoalSource\x12O\n" +
+	"\x04rule\x18\x01 \x01(\x0e2;.smartcore.bos.airtemperature.v1.TemperatureGoalSource.RuleR\x04rule\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12S\n" +
+	"\x13requested_set_point\x18\x03 \x01(\v2#.smartcore.bos.types.v1.TemperatureR\x11requestedSetPoint\x12J\n" +
+	"\x13request_expire_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x11requestExpireTime\"u\n" +
+	"\x04Rule\x12\x14\n" +
+	"\x10RULE_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tREQUESTED\x10\x01\x12\v\n" +
+	"\aDEFAULT\x10\x02\x12\x10\n" +
+	"\fTENANT_LIMIT\x10\x03\x12\x12\n" +
+	"\x0eBUILDING_LIMIT\x10\x04\x12\x15\n" +
+	"\x11UNOCCUPIED_OFFSET\x10\x05\"\xbd\x01\n" +
 	"\x10TemperatureRange\x125\n" +
 	"\x03low\x18\x01 \x01(\v2#.smartcore.bos.types.v1.TemperatureR\x03low\x127\n" +
 	"\x04high\x18\x02 \x01(\v2#.smartcore.bos.types.v1.TemperatureR\x04high\x129\n" +
@@ -805,58 +984,68 @@ func file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescGZIP() []
 	return file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDescData
 }
 
-var file_smartcore_bos_airtemperature_v1_air_temperature_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_smartcore_bos_airtemperature_v1_air_temperature_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_smartcore_bos_airtemperature_v1_air_temperature_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_smartcore_bos_airtemperature_v1_air_temperature_proto_goTypes = []any{
 	(AirTemperature_Mode)(0),                  // 0: smartcore.bos.airtemperature.v1.AirTemperature.Mode
-	(*AirTemperature)(nil),                    // 1: smartcore.bos.airtemperature.v1.AirTemperature
-	(*TemperatureRange)(nil),                  // 2: smartcore.bos.airtemperature.v1.TemperatureRange
-	(*AirTemperatureSupport)(nil),             // 3: smartcore.bos.airtemperature.v1.AirTemperatureSupport
-	(*GetAirTemperatureRequest)(nil),          // 4: smartcore.bos.airtemperature.v1.GetAirTemperatureRequest
-	(*UpdateAirTemperatureRequest)(nil),       // 5: smartcore.bos.airtemperature.v1.UpdateAirTemperatureRequest
-	(*PullAirTemperatureRequest)(nil),         // 6: smartcore.bos.airtemperature.v1.PullAirTemperatureRequest
-	(*PullAirTemperatureResponse)(nil),        // 7: smartcore.bos.airtemperature.v1.PullAirTemperatureResponse
-	(*DescribeAirTemperatureRequest)(nil),     // 8: smartcore.bos.airtemperature.v1.DescribeAirTemperatureRequest
-	(*PullAirTemperatureResponse_Change)(nil), // 9: smartcore.bos.airtemperature.v1.PullAirTemperatureResponse.Change
-	(*typespb.Temperature)(nil),               // 10: smartcore.bos.types.v1.Temperature
-	(*typespb.ResourceSupport)(nil),           // 11: smartcore.bos.types.v1.ResourceSupport
-	(typespb.TemperatureUnit)(0),              // 12: smartcore.bos.types.v1.TemperatureUnit
-	(*fieldmaskpb.FieldMask)(nil),             // 13: google.protobuf.FieldMask
+	(TemperatureGoalSource_Rule)(0),           // 1: smartcore.bos.airtemperature.v1.TemperatureGoalSource.Rule
+	(*AirTemperature)(nil),                    // 2: smartcore.bos.airtemperature.v1.AirTemperature
+	(*TemperatureGoalSource)(nil),             // 3: smartcore.bos.airtemperature.v1.TemperatureGoalSource
+	(*TemperatureRange)(nil),                  // 4: smartcore.bos.airtemperature.v1.TemperatureRange
+	(*AirTemperatureSupport)(nil),             // 5: smartcore.bos.airtemperature.v1.AirTemperatureSupport
+	(*GetAirTemperatureRequest)(nil),          // 6: smartcore.bos.airtemperature.v1.GetAirTemperatureRequest
+	(*UpdateAirTemperatureRequest)(nil),       // 7: smartcore.bos.airtemperature.v1.UpdateAirTemperatureRequest
+	(*PullAirTemperatureRequest)(nil),         // 8: smartcore.bos.airtemperature.v1.PullAirTemperatureRequest
+	(*PullAirTemperatureResponse)(nil),        // 9: smartcore.bos.airtemperature.v1.PullAirTemperatureResponse
+	(*DescribeAirTemperatureRequest)(nil),     // 10: smartcore.bos.airtemperature.v1.DescribeAirTemperatureRequest
+	nil,                                       // 11: smartcore.bos.airtemperature.v1.AirTemperature.AggregationsEntry
+	(*PullAirTemperatureResponse_Change)(nil), // 12: smartcore.bos.airtemperature.v1.PullAirTemperatureResponse.Change
+	(*typespb.Temperature)(nil),               // 13: smartcore.bos.types.v1.Temperature
 	(*timestamppb.Timestamp)(nil),             // 14: google.protobuf.Timestamp
+	(*typespb.ResourceSupport)(nil),           // 15: smartcore.bos.types.v1.ResourceSupport
+	(typespb.TemperatureUnit)(0),              // 16: smartcore.bos.types.v1.TemperatureUnit
+	(*fieldmaskpb.FieldMask)(nil),             // 17: google.protobuf.FieldMask
+	(*typespb.Aggregation)(nil),               // 18: smartcore.bos.types.v1.Aggregation
 }
 var file_smartcore_bos_airtemperature_v1_air_temperature_proto_depIdxs = []int32{
 	0,  // 0: smartcore.bos.airtemperature.v1.AirTemperature.mode:type_name -> smartcore.bos.airtemperature.v1.AirTemperature.Mode
-	10, // 1: smartcore.bos.airtemperature.v1.AirTemperature.temperature_set_point:type_name -> smartcore.bos.types.v1.Temperature
-	10, // 2: smartcore.bos.airtemperature.v1.AirTemperature.temperature_set_point_delta:type_name -> smartcore.bos.types.v1.Temperature
-	2,  // 3: smartcore.bos.airtemperature.v1.AirTemperature.temperature_range:type_name -> smartcore.bos.airtemperature.v1.TemperatureRange
-	10, // 4: smartcore.bos.airtemperature.v1.AirTemperature.ambient_temperature:type_name -> smartcore.bos.types.v1.Temperature
-	10, // 5: smartcore.bos.airtemperature.v1.AirTemperature.dew_point:type_name -> smartcore.bos.types.v1.Temperature
-	10, // 6: smartcore.bos.airtemperature.v1.TemperatureRange.low:type_name -> smartcore.bos.types.v1.Temperature
-	10, // 7: smartcore.bos.airtemperature.v1.TemperatureRange.high:type_name -> smartcore.bos.types.v1.Temperature
-	10, // 8: smartcore.bos.airtemperature.v1.TemperatureRange.ideal:type_name -> smartcore.bos.types.v1.Temperature
-	11, // 9: smartcore.bos.airtemperature.v1.AirTemperatureSupport.resource_support:type_name -> smartcore.bos.types.v1.ResourceSupport
-	12, // 10: smartcore.bos.airtemperature.v1.AirTemperatureSupport.native_unit:type_name -> smartcore.bos.types.v1.TemperatureUnit
-	0,  // 11: smartcore.bos.airtemperature.v1.AirTemperatureSupport.supported_modes:type_name -> smartcore.bos.airtemperature.v1.AirTemperature.Mode
-	13, // 12: smartcore.bos.airtemperature.v1.GetAirTemperatureRequest.read_mask:type_name -> google.protobuf.FieldMask
-	1,  // 13: smartcore.bos.airtemperature.v1.UpdateAirTemperatureRequest.state:type_name -> smartcore.bos.airtemperature.v1.AirTemperature
-	13, // 14: smartcore.bos.airtemperature.v1.UpdateAirTemperatureRequest.update_mask:type_name -> google.protobuf.FieldMask
-	13, // 15: smartcore.bos.airtemperature.v1.PullAirTemperatureRequest.read_mask:type_name -> google.protobuf.FieldMask
-	9,  // 16: smartcore.bos.airtemperature.v1.PullAirTemperatureResponse.changes:type_name -> smartcore.bos.airtemperature.v1.PullAirTemperatureResponse.Change
-	14, // 17: smartcore.bos.airtemperature.v1.PullAirTemperatureResponse.Change.change_time:type_name -> google.protobuf.Timestamp
-	1,  // 18: smartcore.bos.airtemperature.v1.PullAirTemperatureResponse.Change.air_temperature:type_name -> smartcore.bos.airtemperature.v1.AirTemperature
-	4,  // 19: smartcore.bos.airtemperature.v1.AirTemperatureApi.GetAirTemperature:input_type -> smartcore.bos.airtemperature.v1.GetAirTemperatureRequest
-	5,  // 20: smartcore.bos.airtemperature.v1.AirTemperatureApi.UpdateAirTemperature:input_type -> smartcore.bos.airtemperature.v1.UpdateAirTemperatureRequest
-	6,  // 21: smartcore.bos.airtemperature.v1.AirTemperatureApi.PullAirTemperature:input_type -> smartcore.bos.airtemperature.v1.PullAirTemperatureRequest
-	8,  // 22: smartcore.bos.airtemperature.v1.AirTemperatureInfo.DescribeAirTemperature:input_type -> smartcore.bos.airtemperature.v1.DescribeAirTemperatureRequest
-	1,  // 23: smartcore.bos.airtemperature.v1.AirTemperatureApi.GetAirTemperature:output_type -> smartcore.bos.airtemperature.v1.AirTemperature
-	1,  // 24: smartcore.bos.airtemperature.v1.AirTemperatureApi.UpdateAirTemperature:output_type -> smartcore.bos.airtemperature.v1.AirTemperature
-	7,  // 25: smartcore.bos.airtemperature.v1.AirTemperatureApi.PullAirTemperature:output_type -> smartcore.bos.airtemperature.v1.PullAirTemperatureResponse
-	3,  // 26: smartcore.bos.airtemperature.v1.AirTemperatureInfo.DescribeAirTemperature:output_type -> smartcore.bos.airtemperature.v1.AirTemperatureSupport
-	23, // [23:27] is the sub-list for method output_type
-	19, // [19:23] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	13, // 1: smartcore.bos.airtemperature.v1.AirTemperature.temperature_set_point:type_name -> smartcore.bos.types.v1.Temperature
+	13, // 2: smartcore.bos.airtemperature.v1.AirTemperature.temperature_set_point_delta:type_name -> smartcore.bos.types.v1.Temperature
+	4,  // 3: smartcore.bos.airtemperature.v1.AirTemperature.temperature_range:type_name -> smartcore.bos.airtemperature.v1.TemperatureRange
+	13, // 4: smartcore.bos.airtemperature.v1.AirTemperature.ambient_temperature:type_name -> smartcore.bos.types.v1.Temperature
+	13, // 5: smartcore.bos.airtemperature.v1.AirTemperature.dew_point:type_name -> smartcore.bos.types.v1.Temperature
+	3,  // 6: smartcore.bos.airtemperature.v1.AirTemperature.temperature_goal_source:type_name -> smartcore.bos.airtemperature.v1.TemperatureGoalSource
+	11, // 7: smartcore.bos.airtemperature.v1.AirTemperature.aggregations:type_name -> smartcore.bos.airtemperature.v1.AirTemperature.AggregationsEntry
+	1,  // 8: smartcore.bos.airtemperature.v1.TemperatureGoalSource.rule:type_name -> smartcore.bos.airtemperature.v1.TemperatureGoalSource.Rule
+	13, // 9: smartcore.bos.airtemperature.v1.TemperatureGoalSource.requested_set_point:type_name -> smartcore.bos.types.v1.Temperature
+	14, // 10: smartcore.bos.airtemperature.v1.TemperatureGoalSource.request_expire_time:type_name -> google.protobuf.Timestamp
+	13, // 11: smartcore.bos.airtemperature.v1.TemperatureRange.low:type_name -> smartcore.bos.types.v1.Temperature
+	13, // 12: smartcore.bos.airtemperature.v1.TemperatureRange.high:type_name -> smartcore.bos.types.v1.Temperature
+	13, // 13: smartcore.bos.airtemperature.v1.TemperatureRange.ideal:type_name -> smartcore.bos.types.v1.Temperature
+	15, // 14: smartcore.bos.airtemperature.v1.AirTemperatureSupport.resource_support:type_name -> smartcore.bos.types.v1.ResourceSupport
+	16, // 15: smartcore.bos.airtemperature.v1.AirTemperatureSupport.native_unit:type_name -> smartcore.bos.types.v1.TemperatureUnit
+	0,  // 16: smartcore.bos.airtemperature.v1.AirTemperatureSupport.supported_modes:type_name -> smartcore.bos.airtemperature.v1.AirTemperature.Mode
+	17, // 17: smartcore.bos.airtemperature.v1.GetAirTemperatureRequest.read_mask:type_name -> google.protobuf.FieldMask
+	2,  // 18: smartcore.bos.airtemperature.v1.UpdateAirTemperatureRequest.state:type_name -> smartcore.bos.airtemperature.v1.AirTemperature
+	17, // 19: smartcore.bos.airtemperature.v1.UpdateAirTemperatureRequest.update_mask:type_name -> google.protobuf.FieldMask
+	17, // 20: smartcore.bos.airtemperature.v1.PullAirTemperatureRequest.read_mask:type_name -> google.protobuf.FieldMask
+	12, // 21: smartcore.bos.airtemperature.v1.PullAirTemperatureResponse.changes:type_name -> smartcore.bos.airtemperature.v1.PullAirTemperatureResponse.Change
+	18, // 22: smartcore.bos.airtemperature.v1.AirTemperature.AggregationsEntry.value:type_name -> smartcore.bos.types.v1.Aggregation
+	14, // 23: smartcore.bos.airtemperature.v1.PullAirTemperatureResponse.Change.change_time:type_name -> google.protobuf.Timestamp
+	2,  // 24: smartcore.bos.airtemperature.v1.PullAirTemperatureResponse.Change.air_temperature:type_name -> smartcore.bos.airtemperature.v1.AirTemperature
+	6,  // 25: smartcore.bos.airtemperature.v1.AirTemperatureApi.GetAirTemperature:input_type -> smartcore.bos.airtemperature.v1.GetAirTemperatureRequest
+	7,  // 26: smartcore.bos.airtemperature.v1.AirTemperatureApi.UpdateAirTemperature:input_type -> smartcore.bos.airtemperature.v1.UpdateAirTemperatureRequest
+	8,  // 27: smartcore.bos.airtemperature.v1.AirTemperatureApi.PullAirTemperature:input_type -> smartcore.bos.airtemperature.v1.PullAirTemperatureRequest
+	10, // 28: smartcore.bos.airtemperature.v1.AirTemperatureInfo.DescribeAirTemperature:input_type -> smartcore.bos.airtemperature.v1.DescribeAirTemperatureRequest
+	2,  // 29: smartcore.bos.airtemperature.v1.AirTemperatureApi.GetAirTemperature:output_type -> smartcore.bos.airtemperature.v1.AirTemperature
+	2,  // 30: smartcore.bos.airtemperature.v1.AirTemperatureApi.UpdateAirTemperature:output_type -> smartcore.bos.airtemperature.v1.AirTemperature
+	9,  // 31: smartcore.bos.airtemperature.v1.AirTemperatureApi.PullAirTemperature:output_type -> smartcore.bos.airtemperature.v1.PullAirTemperatureResponse
+	5,  // 32: smartcore.bos.airtemperature.v1.AirTemperatureInfo.DescribeAirTemperature:output_type -> smartcore.bos.airtemperature.v1.AirTemperatureSupport
+	29, // [29:33] is the sub-list for method output_type
+	25, // [25:29] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_smartcore_bos_airtemperature_v1_air_temperature_proto_init() }
@@ -874,8 +1063,8 @@ func file_smartcore_bos_airtemperature_v1_air_temperature_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDesc), len(file_smartcore_bos_airtemperature_v1_air_temperature_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
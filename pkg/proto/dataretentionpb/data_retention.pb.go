@@ -9,6 +9,7 @@ package dataretentionpb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
@@ -231,9 +232,12 @@ type DataRetentionSupport struct {
 	CanCompact bool `protobuf:"varint,3,opt,name=can_compact,json=canCompact,proto3" json:"can_compact,omitempty"`
 	// Field 4 reserved (was can_spring_clean, removed — clients call Purge then Compact).
 	// Singular name for the item unit when items is populated (e.g. "row", "record", "file").
-	ItemName      string `protobuf:"bytes,5,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	ItemName string `protobuf:"bytes,5,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	// The retention policy in effect for each source of records in the store, ordered by source.
+	// Sources that no retention rule applies to are not included.
+	RetentionPolicies []*RetentionPolicy `protobuf:"bytes,6,rep,name=retention_policies,json=retentionPolicies,proto3" json:"retention_policies,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *DataRetentionSupport) Reset() {
@@ -287,6 +291,86 @@ func (x *DataRetentionSupport) GetItemName() string {
 	return ""
 }
 
+func (x *DataRetentionSupport) GetRetentionPolicies() []*RetentionPolicy {
+	if x != nil {
+		return x.RetentionPolicies
+	}
+	return nil
+}
+
+// RetentionPolicy describes how long records from a single source are kept.
+type RetentionPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The source of the records, e.g. "floor-1/meter-1[smartcore.bos.Meter]".
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// The name of the retention rule the policy comes from.
+	Rule string `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	// Records older than this are removed. Absent if records are not removed by age.
+	MaxAge *durationpb.Duration `protobuf:"bytes,3,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	// Only this many of the newest records are kept. Zero if records are not removed by count.
+	MaxCount      int64 `protobuf:"varint,4,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
+	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionPolicy) ProtoMessage() {}
+
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RetentionPolicy) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *RetentionPolicy) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *RetentionPolicy) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
+func (x *RetentionPolicy) GetMaxCount() int64 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
 type GetDataRetentionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -297,7 +381,7 @@ type GetDataRetentionRequest struct {
 
 func (x *GetDataRetentionRequest) Reset() {
 	*x = GetDataRetentionRequest{}
-	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[4]
+	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -309,7 +393,7 @@ func (x *GetDataRetentionRequest) String() string {
 func (*GetDataRetentionRequest) ProtoMessage() {}
 
 func (x *GetDataRetentionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[4]
+	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -322,7 +406,7 @@ func (x *GetDataRetentionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDataRetentionRequest.ProtoReflect.Descriptor instead.
 func (*GetDataRetentionRequest) Descriptor() ([]byte, []int) {
-	return file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescGZIP(), []int{4}
+	return file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetDataRetentionRequest) GetName() string {
@@ -350,7 +434,7 @@ type PullDataRetentionRequest struct {
 
 func (x *PullDataRetentionRequest) Reset() {
 	*x = PullDataRetentionRequest{}
-	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[5]
+	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -362,7 +446,7 @@ func (x *PullDataRetentionRequest) String() string {
 func (*PullDataRetentionRequest) ProtoMessage() {}
 
 func (x *PullDataRetentionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[5]
+	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -375,7 +459,7 @@ func (x *PullDataRetentionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullDataRetentionRequest.ProtoReflect.Descriptor instead.
 func (*PullDataRetentionRequest) Descriptor() ([]byte, []int) {
-	return file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescGZIP(), []int{5}
+	return file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PullDataRetentionRequest) GetName() string {
@@ -408,7 +492,7 @@ type PullDataRetentionResponse struct {
 
 func (x *PullDataRetentionResponse) Reset() {
 	*x = PullDataRetentionResponse{}
-	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[6]
+	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -420,7 +504,7 @@ func (x *PullDataRetentionResponse) String() string {
 func (*PullDataRetentionResponse) ProtoMessage() {}
 
 func (x *PullDataRetentionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[6]
+	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -433,7 +517,7 @@ func (x *PullDataRetentionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullDataRetentionResponse.ProtoReflect.Descriptor instead.
 func (*PullDataRetentionResponse) Descriptor() ([]byte, []int) {
-	return file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescGZIP(), []int{6}
+	return file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PullDataRetentionResponse) GetChanges() []*PullDataRetentionResponse_Change {
@@ -454,7 +538,7 @@ type PurgeDataRetentionRequest struct {
 
 func (x *PurgeDataRetentionRequest) Reset() {
 	*x = PurgeDataRetentionRequest{}
-	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[7]
+	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -466,7 +550,7 @@ func (x *PurgeDataRetentionRequest) String() string {
 func (*PurgeDataRetentionRequest) ProtoMessage() {}
 
 func (x *PurgeDataRetentionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[7]
+	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -479,7 +563,7 @@ func (x *PurgeDataRetentionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PurgeDataRetentionRequest.ProtoReflect.Descriptor instead.
 func (*PurgeDataRetentionRequest) Descriptor() ([]byte, []int) {
-	return file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescGZIP(), []int{7}
+	return file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PurgeDataRetentionRequest) GetName() string {
@@ -507,7 +591,7 @@ type PurgeDataRetentionResponse struct {
 
 func (x *PurgeDataRetentionResponse) Reset() {
 	*x = PurgeDataRetentionResponse{}
-	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[8]
+	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -519,7 +603,7 @@ func (x *PurgeDataRetentionResponse) String() string {
 func (*PurgeDataRetentionResponse) ProtoMessage() {}
 
 func (x *PurgeDataRetentionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[8]
+	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -532,7 +616,7 @@ func (x *PurgeDataRetentionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PurgeDataRetentionResponse.ProtoReflect.Descriptor instead.
 func (*PurgeDataRetentionResponse) Descriptor() ([]byte, []int) {
-	return file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescGZIP(), []int{8}
+	return file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PurgeDataRetentionResponse) GetFreedItemCount() uint64 {
@@ -565,7 +649,7 @@ type CompactDataRetentionRequest struct {
 
 func (x *CompactDataRetentionRequest) Reset() {
 	*x = CompactDataRetentionRequest{}
-	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[9]
+	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -577,7 +661,7 @@ func (x *CompactDataRetentionRequest) String() string {
 func (*CompactDataRetentionRequest) ProtoMessage() {}
 
 func (x *CompactDataRetentionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[9]
+	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -590,7 +674,7 @@ func (x *CompactDataRetentionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompactDataRetentionRequest.ProtoReflect.Descriptor instead.
 func (*CompactDataRetentionRequest) Descriptor() ([]byte, []int) {
-	return file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescGZIP(), []int{9}
+	return file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CompactDataRetentionRequest) GetName() string {
@@ -610,7 +694,7 @@ type CompactDataRetentionResponse struct {
 
 func (x *CompactDataRetentionResponse) Reset() {
 	*x = CompactDataRetentionResponse{}
-	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[10]
+	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -622,7 +706,7 @@ func (x *CompactDataRetentionResponse) String() string {
 func (*CompactDataRetentionResponse) ProtoMessage() {}
 
 func (x *CompactDataRetentionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[10]
+	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -635,7 +719,7 @@ func (x *CompactDataRetentionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompactDataRetentionResponse.ProtoReflect.Descriptor instead.
 func (*CompactDataRetentionResponse) Descriptor() ([]byte, []int) {
-	return file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescGZIP(), []int{10}
+	return file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CompactDataRetentionResponse) GetFreedByteCount() uint64 {
@@ -661,7 +745,7 @@ type DescribeDataRetentionRequest struct {
 
 func (x *DescribeDataRetentionRequest) Reset() {
 	*x = DescribeDataRetentionRequest{}
-	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[11]
+	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -673,7 +757,7 @@ func (x *DescribeDataRetentionRequest) String() string {
 func (*DescribeDataRetentionRequest) ProtoMessage() {}
 
 func (x *DescribeDataRetentionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[11]
+	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,7 +770,7 @@ func (x *DescribeDataRetentionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DescribeDataRetentionRequest.ProtoReflect.Descriptor instead.
 func (*DescribeDataRetentionRequest) Descriptor() ([]byte, []int) {
-	return file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescGZIP(), []int{11}
+	return file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DescribeDataRetentionRequest) GetName() string {
@@ -707,7 +791,7 @@ type PullDataRetentionResponse_Change struct {
 
 func (x *PullDataRetentionResponse_Change) Reset() {
 	*x = PullDataRetentionResponse_Change{}
-	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[12]
+	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -719,7 +803,7 @@ func (x *PullDataRetentionResponse_Change) String() string {
 func (*PullDataRetentionResponse_Change) ProtoMessage() {}
 
 func (x *PullDataRetentionResponse_Change) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[12]
+	mi := &file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -732,7 +816,7 @@ func (x *PullDataRetentionResponse_Change) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullDataRetentionResponse_Change.ProtoReflect.Descriptor instead.
 func (*PullDataRetentionResponse_Change) Descriptor() ([]byte, []int) {
-	return file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescGZIP(), []int{6, 0}
+	return file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (x *PullDataRetentionResponse_Change) GetName() string {
@@ -760,7 +844,7 @@ var File_smartcore_bos_dataretention_v1_data_retention_proto protoreflect.FileDe
 
 const file_smartcore_bos_dataretention_v1_data_retention_proto_rawDesc = "" +
 	"\n" +
-	"3smartcore/bos/dataretention/v1/data_retention.proto\x12\x1esmartcore.bos.dataretention.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa3\x01\n" +
+	"3smartcore/bos/dataretention/v1/data_retention.proto\x12\x1esmartcore.bos.dataretention.v1\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa3\x01\n" +
 	"\rDataRetention\x12H\n" +
 	"\x05bytes\x18\x01 \x01(\v22.smartcore.bos.dataretention.v1.DataRetentionBytesR\x05bytes\x12H\n" +
 	"\x05items\x18\x02 \x01(\v22.smartcore.bos.dataretention.v1.DataRetentionItemsR\x05items\"\xc8\x01\n" +
@@ -779,12 +863,18 @@ const file_smartcore_bos_dataretention_v1_data_retention_proto_rawDesc = "" +
 	"\x04used\x18\x01 \x01(\x04H\x00R\x04used\x88\x01\x01\x12\x1f\n" +
 	"\bcapacity\x18\x02 \x01(\x04H\x01R\bcapacity\x88\x01\x01B\a\n" +
 	"\x05_usedB\v\n" +
-	"\t_capacity\"q\n" +
+	"\t_capacity\"\xd1\x01\n" +
 	"\x14DataRetentionSupport\x12\x1b\n" +
 	"\tcan_purge\x18\x01 \x01(\bR\bcanPurge\x12\x1f\n" +
 	"\vcan_compact\x18\x03 \x01(\bR\n" +
 	"canCompact\x12\x1b\n" +
-	"\titem_name\x18\x05 \x01(\tR\bitemName\"f\n" +
+	"\titem_name\x18\x05 \x01(\tR\bitemName\x12^\n" +
+	"\x12retention_policies\x18\x06 \x03(\v2/.smartcore.bos.dataretention.v1.RetentionPolicyR\x11retentionPolicies\"\x8e\x01\n" +
+	"\x0fRetentionPolicy\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x12\n" +
+	"\x04rule\x18\x02 \x01(\tR\x04rule\x122\n" +
+	"\amax_age\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x06maxAge\x12\x1b\n" +
+	"\tmax_count\x18\x04 \x01(\x03R\bmaxCount\"f\n" +
 	"\x17GetDataRetentionRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x127\n" +
 	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"\x8a\x01\n" +
@@ -837,48 +927,52 @@ func file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescGZIP() []by
 	return file_smartcore_bos_dataretention_v1_data_retention_proto_rawDescData
 }
 
-var file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_smartcore_bos_dataretention_v1_data_retention_proto_goTypes = []any{
 	(*DataRetention)(nil),                    // 0: smartcore.bos.dataretention.v1.DataRetention
 	(*DataRetentionBytes)(nil),               // 1: smartcore.bos.dataretention.v1.DataRetentionBytes
 	(*DataRetentionItems)(nil),               // 2: smartcore.bos.dataretention.v1.DataRetentionItems
 	(*DataRetentionSupport)(nil),             // 3: smartcore.bos.dataretention.v1.DataRetentionSupport
-	(*GetDataRetentionRequest)(nil),          // 4: smartcore.bos.dataretention.v1.GetDataRetentionRequest
-	(*PullDataRetentionRequest)(nil),         // 5: smartcore.bos.dataretention.v1.PullDataRetentionRequest
-	(*PullDataRetentionResponse)(nil),        // 6: smartcore.bos.dataretention.v1.PullDataRetentionResponse
-	(*PurgeDataRetentionRequest)(nil),        // 7: smartcore.bos.dataretention.v1.PurgeDataRetentionRequest
-	(*PurgeDataRetentionResponse)(nil),       // 8: smartcore.bos.dataretention.v1.PurgeDataRetentionResponse
-	(*CompactDataRetentionRequest)(nil),      // 9: smartcore.bos.dataretention.v1.CompactDataRetentionRequest
-	(*CompactDataRetentionResponse)(nil),     // 10: smartcore.bos.dataretention.v1.CompactDataRetentionResponse
-	(*DescribeDataRetentionRequest)(nil),     // 11: smartcore.bos.dataretention.v1.DescribeDataRetentionRequest
-	(*PullDataRetentionResponse_Change)(nil), // 12: smartcore.bos.dataretention.v1.PullDataRetentionResponse.Change
-	(*fieldmaskpb.FieldMask)(nil),            // 13: google.protobuf.FieldMask
-	(*timestamppb.Timestamp)(nil),            // 14: google.protobuf.Timestamp
+	(*RetentionPolicy)(nil),                  // 4: smartcore.bos.dataretention.v1.RetentionPolicy
+	(*GetDataRetentionRequest)(nil),          // 5: smartcore.bos.dataretention.v1.GetDataRetentionRequest
+	(*PullDataRetentionRequest)(nil),         // 6: smartcore.bos.dataretention.v1.PullDataRetentionRequest
+	(*PullDataRetentionResponse)(nil),        // 7: smartcore.bos.dataretention.v1.PullDataRetentionResponse
+	(*PurgeDataRetentionRequest)(nil),        // 8: smartcore.bos.dataretention.v1.PurgeDataRetentionRequest
+	(*PurgeDataRetentionResponse)(nil),       // 9: smartcore.bos.dataretention.v1.PurgeDataRetentionResponse
+	(*CompactDataRetentionRequest)(nil),      // 10: smartcore.bos.dataretention.v1.CompactDataRetentionRequest
+	(*CompactDataRetentionResponse)(nil),     // 11: smartcore.bos.dataretention.v1.CompactDataRetentionResponse
+	(*DescribeDataRetentionRequest)(nil),     // 12: smartcore.bos.dataretention.v1.DescribeDataRetentionRequest
+	(*PullDataRetentionResponse_Change)(nil), // 13: smartcore.bos.dataretention.v1.PullDataRetentionResponse.Change
+	(*durationpb.Duration)(nil),              // 14: google.protobuf.Duration
+	(*fieldmaskpb.FieldMask)(nil),            // 15: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),            // 16: google.protobuf.Timestamp
 }
 var file_smartcore_bos_dataretention_v1_data_retention_proto_depIdxs = []int32{
 	1,  // 0: smartcore.bos.dataretention.v1.DataRetention.bytes:type_name -> smartcore.bos.dataretention.v1.DataRetentionBytes
 	2,  // 1: smartcore.bos.dataretention.v1.DataRetention.items:type_name -> smartcore.bos.dataretention.v1.DataRetentionItems
-	13, // 2: smartcore.bos.dataretention.v1.GetDataRetentionRequest.read_mask:type_name -> google.protobuf.FieldMask
-	13, // 3: smartcore.bos.dataretention.v1.PullDataRetentionRequest.read_mask:type_name -> google.protobuf.FieldMask
-	12, // 4: smartcore.bos.dataretention.v1.PullDataRetentionResponse.changes:type_name -> smartcore.bos.dataretention.v1.PullDataRetentionResponse.Change
-	14, // 5: smartcore.bos.dataretention.v1.PurgeDataRetentionRequest.before:type_name -> google.protobuf.Timestamp
-	14, // 6: smartcore.bos.dataretention.v1.PullDataRetentionResponse.Change.change_time:type_name -> google.protobuf.Timestamp
-	0,  // 7: smartcore.bos.dataretention.v1.PullDataRetentionResponse.Change.data_retention:type_name -> smartcore.bos.dataretention.v1.DataRetention
-	4,  // 8: smartcore.bos.dataretention.v1.DataRetentionApi.GetDataRetention:input_type -> smartcore.bos.dataretention.v1.GetDataRetentionRequest
-	5,  // 9: smartcore.bos.dataretention.v1.DataRetentionApi.PullDataRetention:input_type -> smartcore.bos.dataretention.v1.PullDataRetentionRequest
-	7,  // 10: smartcore.bos.dataretention.v1.DataRetentionApi.PurgeDataRetention:input_type -> smartcore.bos.dataretention.v1.PurgeDataRetentionRequest
-	9,  // 11: smartcore.bos.dataretention.v1.DataRetentionApi.CompactDataRetention:input_type -> smartcore.bos.dataretention.v1.CompactDataRetentionRequest
-	11, // 12: smartcore.bos.dataretention.v1.DataRetentionInfo.DescribeDataRetention:input_type -> smartcore.bos.dataretention.v1.DescribeDataRetentionRequest
-	0,  // 13: smartcore.bos.dataretention.v1.DataRetentionApi.GetDataRetention:output_type -> smartcore.bos.dataretention.v1.DataRetention
-	6,  // 14: smartcore.bos.dataretention.v1.DataRetentionApi.PullDataRetention:output_type -> smartcore.bos.dataretention.v1.PullDataRetentionResponse
-	8,  // 15: smartcore.bos.dataretention.v1.DataRetentionApi.PurgeDataRetention:output_type -> smartcore.bos.dataretention.v1.PurgeDataRetentionResponse
-	10, // 16: smartcore.bos.dataretention.v1.DataRetentionApi.CompactDataRetention:output_type -> smartcore.bos.dataretention.v1.CompactDataRetentionResponse
-	3,  // 17: smartcore.bos.dataretention.v1.DataRetentionInfo.DescribeDataRetention:output_type -> smartcore.bos.dataretention.v1.DataRetentionSupport
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	4,  // 2: smartcore.bos.dataretention.v1.DataRetentionSupport.retention_policies:type_name -> smartcore.bos.dataretention.v1.RetentionPolicy
+	14, // 3: smartcore.bos.dataretention.v1.RetentionPolicy.max_age:type_name -> google.protobuf.Duration
+	15, // 4: smartcore.bos.dataretention.v1.GetDataRetentionRequest.read_mask:type_name -> google.protobuf.FieldMask
+	15, // 5: smartcore.bos.dataretention.v1.PullDataRetentionRequest.read_mask:type_name -> google.protobuf.FieldMask
+	13, // 6: smartcore.bos.dataretention.v1.PullDataRetentionResponse.changes:type_name -> smartcore.bos.dataretention.v1.PullDataRetentionResponse.Change
+	16, // 7: smartcore.bos.dataretention.v1.PurgeDataRetentionRequest.before:type_name -> google.protobuf.Timestamp
+	16, // 8: smartcore.bos.dataretention.v1.PullDataRetentionResponse.Change.change_time:type_name -> google.protobuf.Timestamp
+	0,  // 9: smartcore.bos.dataretention.v1.PullDataRetentionResponse.Change.data_retention:type_name -> smartcore.bos.dataretention.v1.DataRetention
+	5,  // 10: smartcore.bos.dataretention.v1.DataRetentionApi.GetDataRetention:input_type -> smartcore.bos.dataretention.v1.GetDataRetentionRequest
+	6,  // 11: smartcore.bos.dataretention.v1.DataRetentionApi.PullDataRetention:input_type -> smartcore.bos.dataretention.v1.PullDataRetentionRequest
+	8,  // 12: smartcore.bos.dataretention.v1.DataRetentionApi.PurgeDataRetention:input_type -> smartcore.bos.dataretention.v1.PurgeDataRetentionRequest
+	10, // 13: smartcore.bos.dataretention.v1.DataRetentionApi.CompactDataRetention:input_type -> smartcore.bos.dataretention.v1.CompactDataRetentionRequest
+	12, // 14: smartcore.bos.dataretention.v1.DataRetentionInfo.DescribeDataRetention:input_type -> smartcore.bos.dataretention.v1.DescribeDataRetentionRequest
+	0,  // 15: smartcore.bos.dataretention.v1.DataRetentionApi.GetDataRetention:output_type -> smartcore.bos.dataretention.v1.DataRetention
+	7,  // 16: smartcore.bos.dataretention.v1.DataRetentionApi.PullDataRetention:output_type -> smartcore.bos.dataretention.v1.PullDataRetentionResponse
+	9,  // 17: smartcore.bos.dataretention.v1.DataRetentionApi.PurgeDataRetention:output_type -> smartcore.bos.dataretention.v1.PurgeDataRetentionResponse
+	11, // 18: smartcore.bos.dataretention.v1.DataRetentionApi.CompactDataRetention:output_type -> smartcore.bos.dataretention.v1.CompactDataRetentionResponse
+	3,  // 19: smartcore.bos.dataretention.v1.DataRetentionInfo.DescribeDataRetention:output_type -> smartcore.bos.dataretention.v1.DataRetentionSupport
+	15, // [15:20] is the sub-list for method output_type
+	10, // [10:15] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_smartcore_bos_dataretention_v1_data_retention_proto_init() }
@@ -888,16 +982,16 @@ func file_smartcore_bos_dataretention_v1_data_retention_proto_init() {
 	}
 	file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[1].OneofWrappers = []any{}
 	file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[2].OneofWrappers = []any{}
-	file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[7].OneofWrappers = []any{}
 	file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[8].OneofWrappers = []any{}
-	file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[10].OneofWrappers = []any{}
+	file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[9].OneofWrappers = []any{}
+	file_smartcore_bos_dataretention_v1_data_retention_proto_msgTypes[11].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_smartcore_bos_dataretention_v1_data_retention_proto_rawDesc), len(file_smartcore_bos_dataretention_v1_data_retention_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
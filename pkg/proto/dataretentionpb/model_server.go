@@ -34,6 +34,7 @@ type ModelServer struct {
 	model    *Model
 	backend  Backend
 	itemName string
+	policies func() []*RetentionPolicy
 }
 
 // NewModelServer creates a ModelServer backed by the given Model and Backend.
@@ -55,6 +56,12 @@ func WithItemName(name string) ModelServerOption {
 	return func(s *ModelServer) { s.itemName = name }
 }
 
+// WithRetentionPolicies sets the func reporting the retention policy of each source in the store,
+// returned by DescribeDataRetention.
+func WithRetentionPolicies(policies func() []*RetentionPolicy) ModelServerOption {
+	return func(s *ModelServer) { s.policies = policies }
+}
+
 // Register registers both services on the given gRPC server.
 func (s *ModelServer) Register(server *grpc.Server) {
 	RegisterDataRetentionApiServer(server, s)
@@ -121,9 +128,13 @@ func (s *ModelServer) CompactDataRetention(ctx context.Context, _ *CompactDataRe
 // Capabilities are derived from the backend's interface implementation.
 func (s *ModelServer) DescribeDataRetention(_ context.Context, _ *DescribeDataRetentionRequest) (*DataRetentionSupport, error) {
 	_, canCompact := s.backend.(Compacter)
-	return &DataRetentionSupport{
+	support := &DataRetentionSupport{
 		CanPurge:   s.backend != nil,
 		CanCompact: canCompact,
 		ItemName:   s.itemName,
-	}, nil
+	}
+	if s.policies != nil {
+		support.RetentionPolicies = s.policies()
+	}
+	return support, nil
 }
@@ -261,4 +261,18 @@ func TestModelServer_DescribeDataRetention(t *testing.T) {
 			t.Errorf("mismatch (-want +got):\n%s", diff)
 		}
 	})
+
+	t.Run("retention policies", func(t *testing.T) {
+		policies := []*RetentionPolicy{{Source: "meter-1[smartcore.bos.Meter]", Rule: "meters", MaxCount: 100}}
+		server := NewModelServer(NewModel(), nil, WithRetentionPolicies(func() []*RetentionPolicy { return policies }))
+		conn := wrap.ServerToClient(DataRetentionInfo_ServiceDesc, server)
+		got, err := NewDataRetentionInfoClient(conn).DescribeDataRetention(ctx, &DescribeDataRetentionRequest{})
+		if err != nil {
+			t.Fatalf("DescribeDataRetention: %v", err)
+		}
+		want := &DataRetentionSupport{RetentionPolicies: policies}
+		if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
 }
@@ -0,0 +1,1238 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.35.1
+// source: smartcore/bos/demandresponse/v1/demand_response.proto
+
+package demandresponsepb
+
+import (
+	typespb "github.com/smart-core-os/sc-bos/pkg/proto/typespb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Event_Source int32
+
+const (
+	Event_SOURCE_UNSPECIFIED Event_Source = 0
+	// The event was created via DemandResponseApi.CreateEvent.
+	Event_API Event_Source = 1
+	// The event was received from an OpenADR virtual top node (VTN).
+	Event_OPENADR Event_Source = 2
+	// The event was created from a configured schedule.
+	Event_SCHEDULE Event_Source = 3
+)
+
+// Enum value maps for Event_Source.
+var (
+	Event_Source_name = map[int32]string{
+		0: "SOURCE_UNSPECIFIED",
+		1: "API",
+		2: "OPENADR",
+		3: "SCHEDULE",
+	}
+	Event_Source_value = map[string]int32{
+		"SOURCE_UNSPECIFIED": 0,
+		"API":                1,
+		"OPENADR":            2,
+		"SCHEDULE":           3,
+	}
+)
+
+func (x Event_Source) Enum() *Event_Source {
+	p := new(Event_Source)
+	*p = x
+	return p
+}
+
+func (x Event_Source) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Event_Source) Descriptor() protoreflect.EnumDescriptor {
+	return file_smartcore_bos_demandresponse_v1_demand_response_proto_enumTypes[0].Descriptor()
+}
+
+func (Event_Source) Type() protoreflect.EnumType {
+	return &file_smartcore_bos_demandresponse_v1_demand_response_proto_enumTypes[0]
+}
+
+func (x Event_Source) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Event_Source.Descriptor instead.
+func (Event_Source) EnumDescriptor() ([]byte, []int) {
+	return file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Event_State int32
+
+const (
+	Event_STATE_UNSPECIFIED Event_State = 0
+	// The event has not started yet.
+	Event_PENDING Event_State = 1
+	// Load is being shed for this event.
+	Event_ACTIVE Event_State = 2
+	// The event has ended and any shed load has been restored.
+	Event_COMPLETED Event_State = 3
+	// The event was cancelled before it ended.
+	Event_CANCELLED Event_State = 4
+)
+
+// Enum value maps for Event_State.
+var (
+	Event_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "PENDING",
+		2: "ACTIVE",
+		3: "COMPLETED",
+		4: "CANCELLED",
+	}
+	Event_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"PENDING":           1,
+		"ACTIVE":            2,
+		"COMPLETED":         3,
+		"CANCELLED":         4,
+	}
+)
+
+func (x Event_State) Enum() *Event_State {
+	p := new(Event_State)
+	*p = x
+	return p
+}
+
+func (x Event_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Event_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_smartcore_bos_demandresponse_v1_demand_response_proto_enumTypes[1].Descriptor()
+}
+
+func (Event_State) Type() protoreflect.EnumType {
+	return &file_smartcore_bos_demandresponse_v1_demand_response_proto_enumTypes[1]
+}
+
+func (x Event_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Event_State.Descriptor instead.
+func (Event_State) EnumDescriptor() ([]byte, []int) {
+	return file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescGZIP(), []int{0, 1}
+}
+
+// Event is a period of time during which load should be shed.
+type Event struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A unique id for this event.
+	// Output only.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Where the event came from.
+	// Output only.
+	Source Event_Source `protobuf:"varint,2,opt,name=source,proto3,enum=smartcore.bos.demandresponse.v1.Event_Source" json:"source,omitempty"`
+	// The id of the event in the source system, like the OpenADR event id.
+	// Output only.
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// A human readable description of the event.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// When load shedding should start.
+	// Defaults to now on create.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// When load shedding should end.
+	// Required.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The number of priority tiers to shed, starting at the first tier.
+	// Zero means all tiers.
+	Level int32 `protobuf:"varint,7,opt,name=level,proto3" json:"level,omitempty"`
+	// The target real power of the site, in watts.
+	// If present, tiers are shed one at a time until the site demand is at or below this target, up to level tiers.
+	// Requires the implementation to know the site demand, otherwise up to level tiers are shed immediately.
+	TargetRealPower *float32 `protobuf:"fixed32,8,opt,name=target_real_power,json=targetRealPower,proto3,oneof" json:"target_real_power,omitempty"`
+	// The state of the event.
+	// Output only.
+	State         Event_State `protobuf:"varint,9,opt,name=state,proto3,enum=smartcore.bos.demandresponse.v1.Event_State" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Event) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Event) GetSource() Event_Source {
+	if x != nil {
+		return x.Source
+	}
+	return Event_SOURCE_UNSPECIFIED
+}
+
+func (x *Event) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *Event) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Event) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Event) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Event) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Event) GetTargetRealPower() float32 {
+	if x != nil && x.TargetRealPower != nil {
+		return *x.TargetRealPower
+	}
+	return 0
+}
+
+func (x *Event) GetState() Event_State {
+	if x != nil {
+		return x.State
+	}
+	return Event_STATE_UNSPECIFIED
+}
+
+// ShedStatus describes how much load is currently being shed.
+type ShedStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the event load is being shed for.
+	// Absent if no event is active.
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// The number of tiers currently shed.
+	ShedTiers int32 `protobuf:"varint,2,opt,name=shed_tiers,json=shedTiers,proto3" json:"shed_tiers,omitempty"`
+	// All configured tiers in priority order, the first tier is shed first.
+	Tiers []*ShedStatus_Tier `protobuf:"bytes,3,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	// The most recent real power of the site, in watts.
+	// Absent if the site demand is not known.
+	SiteRealPower *float32 `protobuf:"fixed32,4,opt,name=site_real_power,json=siteRealPower,proto3,oneof" json:"site_real_power,omitempty"`
+	// The target real power of the site during the active event, in watts.
+	TargetRealPower *float32 `protobuf:"fixed32,5,opt,name=target_real_power,json=targetRealPower,proto3,oneof" json:"target_real_power,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ShedStatus) Reset() {
+	*x = ShedStatus{}
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShedStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShedStatus) ProtoMessage() {}
+
+func (x *ShedStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShedStatus.ProtoReflect.Descriptor instead.
+func (*ShedStatus) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ShedStatus) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ShedStatus) GetShedTiers() int32 {
+	if x != nil {
+		return x.ShedTiers
+	}
+	return 0
+}
+
+func (x *ShedStatus) GetTiers() []*ShedStatus_Tier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+func (x *ShedStatus) GetSiteRealPower() float32 {
+	if x != nil && x.SiteRealPower != nil {
+		return *x.SiteRealPower
+	}
+	return 0
+}
+
+func (x *ShedStatus) GetTargetRealPower() float32 {
+	if x != nil && x.TargetRealPower != nil {
+		return *x.TargetRealPower
+	}
+	return 0
+}
+
+type CreateEventRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the device.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Event         *Event `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEventRequest) Reset() {
+	*x = CreateEventRequest{}
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEventRequest) ProtoMessage() {}
+
+func (x *CreateEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEventRequest.ProtoReflect.Descriptor instead.
+func (*CreateEventRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateEventRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateEventRequest) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type CancelEventRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the device.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The id of the event.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelEventRequest) Reset() {
+	*x = CancelEventRequest{}
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelEventRequest) ProtoMessage() {}
+
+func (x *CancelEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelEventRequest.ProtoReflect.Descriptor instead.
+func (*CancelEventRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CancelEventRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CancelEventRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the device.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Fields to fetch relative to the Event type.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest) ProtoMessage() {}
+
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListEventsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type ListEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsResponse) ProtoMessage() {}
+
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListEventsResponse) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type PullEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the device.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Fields to fetch relative to the Event type.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// When true the device will only send changes to the resource value.
+	// The default behaviour is to send the current value immediately followed by any updates as they happen.
+	UpdatesOnly   bool `protobuf:"varint,3,opt,name=updates_only,json=updatesOnly,proto3" json:"updates_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullEventsRequest) Reset() {
+	*x = PullEventsRequest{}
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullEventsRequest) ProtoMessage() {}
+
+func (x *PullEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullEventsRequest.ProtoReflect.Descriptor instead.
+func (*PullEventsRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PullEventsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PullEventsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *PullEventsRequest) GetUpdatesOnly() bool {
+	if x != nil {
+		return x.UpdatesOnly
+	}
+	return false
+}
+
+type PullEventsResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Changes       []*PullEventsResponse_Change `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullEventsResponse) Reset() {
+	*x = PullEventsResponse{}
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullEventsResponse) ProtoMessage() {}
+
+func (x *PullEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullEventsResponse.ProtoReflect.Descriptor instead.
+func (*PullEventsResponse) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PullEventsResponse) GetChanges() []*PullEventsResponse_Change {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type GetShedStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the device.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Fields to fetch relative to the ShedStatus type.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShedStatusRequest) Reset() {
+	*x = GetShedStatusRequest{}
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShedStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShedStatusRequest) ProtoMessage() {}
+
+func (x *GetShedStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShedStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetShedStatusRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetShedStatusRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetShedStatusRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type PullShedStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the device.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Fields to fetch relative to the ShedStatus type.
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// When true the device will only send changes to the resource value.
+	// The default behaviour is to send the current value immediately followed by any updates as they happen.
+	UpdatesOnly   bool `protobuf:"varint,3,opt,name=updates_only,json=updatesOnly,proto3" json:"updates_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullShedStatusRequest) Reset() {
+	*x = PullShedStatusRequest{}
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullShedStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullShedStatusRequest) ProtoMessage() {}
+
+func (x *PullShedStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullShedStatusRequest.ProtoReflect.Descriptor instead.
+func (*PullShedStatusRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PullShedStatusRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PullShedStatusRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *PullShedStatusRequest) GetUpdatesOnly() bool {
+	if x != nil {
+		return x.UpdatesOnly
+	}
+	return false
+}
+
+type PullShedStatusResponse struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Changes       []*PullShedStatusResponse_Change `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullShedStatusResponse) Reset() {
+	*x = PullShedStatusResponse{}
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullShedStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullShedStatusResponse) ProtoMessage() {}
+
+func (x *PullShedStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullShedStatusResponse.ProtoReflect.Descriptor instead.
+func (*PullShedStatusResponse) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PullShedStatusResponse) GetChanges() []*PullShedStatusResponse_Change {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ShedStatus_Tier struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The configured name of the tier.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the tier is currently shed.
+	Shed bool `protobuf:"varint,2,opt,name=shed,proto3" json:"shed,omitempty"`
+	// When the tier was shed.
+	ShedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=shed_time,json=shedTime,proto3" json:"shed_time,omitempty"`
+	// The number of devices in the tier that could not be adjusted.
+	FailedDevices int32 `protobuf:"varint,4,opt,name=failed_devices,json=failedDevices,proto3" json:"failed_devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShedStatus_Tier) Reset() {
+	*x = ShedStatus_Tier{}
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShedStatus_Tier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShedStatus_Tier) ProtoMessage() {}
+
+func (x *ShedStatus_Tier) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShedStatus_Tier.ProtoReflect.Descriptor instead.
+func (*ShedStatus_Tier) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *ShedStatus_Tier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShedStatus_Tier) GetShed() bool {
+	if x != nil {
+		return x.Shed
+	}
+	return false
+}
+
+func (x *ShedStatus_Tier) GetShedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShedTime
+	}
+	return nil
+}
+
+func (x *ShedStatus_Tier) GetFailedDevices() int32 {
+	if x != nil {
+		return x.FailedDevices
+	}
+	return 0
+}
+
+type PullEventsResponse_Change struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the device that issued the change.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The type of change (e.g. ADD, UPDATE, etc...).
+	Type typespb.ChangeType `protobuf:"varint,2,opt,name=type,proto3,enum=smartcore.bos.types.v1.ChangeType" json:"type,omitempty"`
+	// When the change occurred.
+	ChangeTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=change_time,json=changeTime,proto3" json:"change_time,omitempty"`
+	// The new value for the event.
+	// Set for ADD and UPDATE changes.
+	NewValue *Event `protobuf:"bytes,4,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	// The old value for the event.
+	// Set for REMOVE and UPDATE changes.
+	OldValue      *Event `protobuf:"bytes,5,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullEventsResponse_Change) Reset() {
+	*x = PullEventsResponse_Change{}
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullEventsResponse_Change) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullEventsResponse_Change) ProtoMessage() {}
+
+func (x *PullEventsResponse_Change) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullEventsResponse_Change.ProtoReflect.Descriptor instead.
+func (*PullEventsResponse_Change) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *PullEventsResponse_Change) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PullEventsResponse_Change) GetType() typespb.ChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return typespb.ChangeType(0)
+}
+
+func (x *PullEventsResponse_Change) GetChangeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangeTime
+	}
+	return nil
+}
+
+func (x *PullEventsResponse_Change) GetNewValue() *Event {
+	if x != nil {
+		return x.NewValue
+	}
+	return nil
+}
+
+func (x *PullEventsResponse_Change) GetOldValue() *Event {
+	if x != nil {
+		return x.OldValue
+	}
+	return nil
+}
+
+type PullShedStatusResponse_Change struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of the device that issued the change.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// When the change occurred.
+	ChangeTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=change_time,json=changeTime,proto3" json:"change_time,omitempty"`
+	ShedStatus    *ShedStatus            `protobuf:"bytes,3,opt,name=shed_status,json=shedStatus,proto3" json:"shed_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullShedStatusResponse_Change) Reset() {
+	*x = PullShedStatusResponse_Change{}
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullShedStatusResponse_Change) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullShedStatusResponse_Change) ProtoMessage() {}
+
+func (x *PullShedStatusResponse_Change) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullShedStatusResponse_Change.ProtoReflect.Descriptor instead.
+func (*PullShedStatusResponse_Change) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *PullShedStatusResponse_Change) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PullShedStatusResponse_Change) GetChangeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangeTime
+	}
+	return nil
+}
+
+func (x *PullShedStatusResponse_Change) GetShedStatus() *ShedStatus {
+	if x != nil {
+		return x.ShedStatus
+	}
+	return nil
+}
+
+var File_smartcore_bos_demandresponse_v1_demand_response_proto protoreflect.FileDescriptor
+
+const file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDesc = "" +
+	"\n" +
+	"5smartcore/bos/demandresponse/v1/demand_response.proto\x12\x1fsmartcore.bos.demandresponse.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a#smartcore/bos/types/v1/change.proto\"\xd1\x04\n" +
+	"\x05Event\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12E\n" +
+	"\x06source\x18\x02 \x01(\x0e2-.smartcore.bos.demandresponse.v1.Event.SourceR\x06source\x12\x1f\n" +
+	"\vexternal_id\x18\x03 \x01(\tR\n" +
+	"externalId\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x14\n" +
+	"\x05level\x18\a \x01(\x05R\x05level\x12/\n" +
+	"\x11target_real_power\x18\b \x01(\x02H\x00R\x0ftargetRealPower\x88\x01\x01\x12B\n" +
+	"\x05state\x18\t \x01(\x0e2,.smartcore.bos.demandresponse.v1.Event.StateR\x05state\"D\n" +
+	"\x06Source\x12\x16\n" +
+	"\x12SOURCE_UNSPECIFIED\x10\x00\x12\a\n" +
+	"\x03API\x10\x01\x12\v\n" +
+	"\aOPENADR\x10\x02\x12\f\n" +
+	"\bSCHEDULE\x10\x03\"U\n" +
+	"\x05State\x12\x15\n" +
+	"\x11STATE_UNSPECIFIED\x10\x00\x12\v\n" +
+	"\aPENDING\x10\x01\x12\n" +
+	"\n" +
+	"\x06ACTIVE\x10\x02\x12\r\n" +
+	"\tCOMPLETED\x10\x03\x12\r\n" +
+	"\tCANCELLED\x10\x04B\x14\n" +
+	"\x12_target_real_power\"\xa7\x03\n" +
+	"\n" +
+	"ShedStatus\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"shed_tiers\x18\x02 \x01(\x05R\tshedTiers\x12F\n" +
+	"\x05tiers\x18\x03 \x03(\v20.smartcore.bos.demandresponse.v1.ShedStatus.TierR\x05tiers\x12+\n" +
+	"\x0fsite_real_power\x18\x04 \x01(\x02H\x00R\rsiteRealPower\x88\x01\x01\x12/\n" +
+	"\x11target_real_power\x18\x05 \x01(\x02H\x01R\x0ftargetRealPower\x88\x01\x01\x1a\x8e\x01\n" +
+	"\x04Tier\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04shed\x18\x02 \x01(\bR\x04shed\x127\n" +
+	"\tshed_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bshedTime\x12%\n" +
+	"\x0efailed_devices\x18\x04 \x01(\x05R\rfailedDevicesB\x12\n" +
+	"\x10_site_real_powerB\x14\n" +
+	"\x12_target_real_power\"f\n" +
+	"\x12CreateEventRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12<\n" +
+	"\x05event\x18\x02 \x01(\v2&.smartcore.bos.demandresponse.v1.EventR\x05event\"8\n" +
+	"\x12CancelEventRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"`\n" +
+	"\x11ListEventsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"T\n" +
+	"\x12ListEventsResponse\x12>\n" +
+	"\x06events\x18\x01 \x03(\v2&.smartcore.bos.demandresponse.v1.EventR\x06events\"\x83\x01\n" +
+	"\x11PullEventsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x12!\n" +
+	"\fupdates_only\x18\x03 \x01(\bR\vupdatesOnly\"\x88\x03\n" +
+	"\x12PullEventsResponse\x12T\n" +
+	"\achanges\x18\x01 \x03(\v2:.smartcore.bos.demandresponse.v1.PullEventsResponse.ChangeR\achanges\x1a\x9b\x02\n" +
+	"\x06Change\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x126\n" +
+	"\x04type\x18\x02 \x01(\x0e2\".smartcore.bos.types.v1.ChangeTypeR\x04type\x12;\n" +
+	"\vchange_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"changeTime\x12C\n" +
+	"\tnew_value\x18\x04 \x01(\v2&.smartcore.bos.demandresponse.v1.EventR\bnewValue\x12C\n" +
+	"\told_value\x18\x05 \x01(\v2&.smartcore.bos.demandresponse.v1.EventR\boldValue\"c\n" +
+	"\x14GetShedStatusRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"\x87\x01\n" +
+	"\x15PullShedStatusRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x12!\n" +
+	"\fupdates_only\x18\x03 \x01(\bR\vupdatesOnly\"\x9c\x02\n" +
+	"\x16PullShedStatusResponse\x12X\n" +
+	"\achanges\x18\x01 \x03(\v2>.smartcore.bos.demandresponse.v1.PullShedStatusResponse.ChangeR\achanges\x1a\xa7\x01\n" +
+	"\x06Change\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12;\n" +
+	"\vchange_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"changeTime\x12L\n" +
+	"\vshed_status\x18\x03 \x01(\v2+.smartcore.bos.demandresponse.v1.ShedStatusR\n" +
+	"shedStatus2\xd6\x05\n" +
+	"\x11DemandResponseApi\x12j\n" +
+	"\vCreateEvent\x123.smartcore.bos.demandresponse.v1.CreateEventRequest\x1a&.smartcore.bos.demandresponse.v1.Event\x12j\n" +
+	"\vCancelEvent\x123.smartcore.bos.demandresponse.v1.CancelEventRequest\x1a&.smartcore.bos.demandresponse.v1.Event\x12u\n" +
+	"\n" +
+	"ListEvents\x122.smartcore.bos.demandresponse.v1.ListEventsRequest\x1a3.smartcore.bos.demandresponse.v1.ListEventsResponse\x12w\n" +
+	"\n" +
+	"PullEvents\x122.smartcore.bos.demandresponse.v1.PullEventsRequest\x1a3.smartcore.bos.demandresponse.v1.PullEventsResponse0\x01\x12s\n" +
+	"\rGetShedStatus\x125.smartcore.bos.demandresponse.v1.GetShedStatusRequest\x1a+.smartcore.bos.demandresponse.v1.ShedStatus\x12\x83\x01\n" +
+	"\x0ePullShedStatus\x126.smartcore.bos.demandresponse.v1.PullShedStatusRequest\x1a7.smartcore.bos.demandresponse.v1.PullShedStatusResponse0\x01B<Z:github.com/smart-core-os/sc-bos/pkg/proto/demandresponsepbb\x06proto3"
+
+var (
+	file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescOnce sync.Once
+	file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescData []byte
+)
+
+func file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescGZIP() []byte {
+	file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescOnce.Do(func() {
+		file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDesc), len(file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDesc)))
+	})
+	return file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDescData
+}
+
+var file_smartcore_bos_demandresponse_v1_demand_response_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_smartcore_bos_demandresponse_v1_demand_response_proto_goTypes = []any{
+	(Event_Source)(0),                     // 0: smartcore.bos.demandresponse.v1.Event.Source
+	(Event_State)(0),                      // 1: smartcore.bos.demandresponse.v1.Event.State
+	(*Event)(nil),                         // 2: smartcore.bos.demandresponse.v1.Event
+	(*ShedStatus)(nil),                    // 3: smartcore.bos.demandresponse.v1.ShedStatus
+	(*CreateEventRequest)(nil),            // 4: smartcore.bos.demandresponse.v1.CreateEventRequest
+	(*CancelEventRequest)(nil),            // 5: smartcore.bos.demandresponse.v1.CancelEventRequest
+	(*ListEventsRequest)(nil),             // 6: smartcore.bos.demandresponse.v1.ListEventsRequest
+	(*ListEventsResponse)(nil),            // 7: smartcore.bos.demandresponse.v1.ListEventsResponse
+	(*PullEventsRequest)(nil),             // 8: smartcore.bos.demandresponse.v1.PullEventsRequest
+	(*PullEventsResponse)(nil),            // 9: smartcore.bos.demandresponse.v1.PullEventsResponse
+	(*GetShedStatusRequest)(nil),          // 10: smartcore.bos.demandresponse.v1.GetShedStatusRequest
+	(*PullShedStatusRequest)(nil),         // 11: smartcore.bos.demandresponse.v1.PullShedStatusRequest
+	(*PullShedStatusResponse)(nil),        // 12: smartcore.bos.demandresponse.v1.PullShedStatusResponse
+	(*ShedStatus_Tier)(nil),               // 13: smartcore.bos.demandresponse.v1.ShedStatus.Tier
+	(*PullEventsResponse_Change)(nil),     // 14: smartcore.bos.demandresponse.v1.PullEventsResponse.Change
+	(*PullShedStatusResponse_Change)(nil), // 15: smartcore.bos.demandresponse.v1.PullShedStatusResponse.Change
+	(*timestamppb.Timestamp)(nil),         // 16: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),         // 17: google.protobuf.FieldMask
+	(typespb.ChangeType)(0),               // 18: smartcore.bos.types.v1.ChangeType
+}
+var file_smartcore_bos_demandresponse_v1_demand_response_proto_depIdxs = []int32{
+	0,  // 0: smartcore.bos.demandresponse.v1.Event.source:type_name -> smartcore.bos.demandresponse.v1.Event.Source
+	16, // 1: smartcore.bos.demandresponse.v1.Event.start_time:type_name -> google.protobuf.Timestamp
+	16, // 2: smartcore.bos.demandresponse.v1.Event.end_time:type_name -> google.protobuf.Timestamp
+	1,  // 3: smartcore.bos.demandresponse.v1.Event.state:type_name -> smartcore.bos.demandresponse.v1.Event.State
+	13, // 4: smartcore.bos.demandresponse.v1.ShedStatus.tiers:type_name -> smartcore.bos.demandresponse.v1.ShedStatus.Tier
+	2,  // 5: smartcore.bos.demandresponse.v1.CreateEventRequest.event:type_name -> smartcore.bos.demandresponse.v1.Event
+	17, // 6: smartcore.bos.demandresponse.v1.ListEventsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	2,  // 7: smartcore.bos.demandresponse.v1.ListEventsResponse.events:type_name -> smartcore.bos.demandresponse.v1.Event
+	17, // 8: smartcore.bos.demandresponse.v1.PullEventsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	14, // 9: smartcore.bos.demandresponse.v1.PullEventsResponse.changes:type_name -> smartcore.bos.demandresponse.v1.PullEventsResponse.Change
+	17, // 10: smartcore.bos.demandresponse.v1.GetShedStatusRequest.read_mask:type_name -> google.protobuf.FieldMask
+	17, // 11: smartcore.bos.demandresponse.v1.PullShedStatusRequest.read_mask:type_name -> google.protobuf.FieldMask
+	15, // 12: smartcore.bos.demandresponse.v1.PullShedStatusResponse.changes:type_name -> smartcore.bos.demandresponse.v1.PullShedStatusResponse.Change
+	16, // 13: smartcore.bos.demandresponse.v1.ShedStatus.Tier.shed_time:type_name -> google.protobuf.Timestamp
+	18, // 14: smartcore.bos.demandresponse.v1.PullEventsResponse.Change.type:type_name -> smartcore.bos.types.v1.ChangeType
+	16, // 15: smartcore.bos.demandresponse.v1.PullEventsResponse.Change.change_time:type_name -> google.protobuf.Timestamp
+	2,  // 16: smartcore.bos.demandresponse.v1.PullEventsResponse.Change.new_value:type_name -> smartcore.bos.demandresponse.v1.Event
+	2,  // 17: smartcore.bos.demandresponse.v1.PullEventsResponse.Change.old_value:type_name -> smartcore.bos.demandresponse.v1.Event
+	16, // 18: smartcore.bos.demandresponse.v1.PullShedStatusResponse.Change.change_time:type_name -> google.protobuf.Timestamp
+	3,  // 19: smartcore.bos.demandresponse.v1.PullShedStatusResponse.Change.shed_status:type_name -> smartcore.bos.demandresponse.v1.ShedStatus
+	4,  // 20: smartcore.bos.demandresponse.v1.DemandResponseApi.CreateEvent:input_type -> smartcore.bos.demandresponse.v1.CreateEventRequest
+	5,  // 21: smartcore.bos.demandresponse.v1.DemandResponseApi.CancelEvent:input_type -> smartcore.bos.demandresponse.v1.CancelEventRequest
+	6,  // 22: smartcore.bos.demandresponse.v1.DemandResponseApi.ListEvents:input_type -> smartcore.bos.demandresponse.v1.ListEventsRequest
+	8,  // 23: smartcore.bos.demandresponse.v1.DemandResponseApi.PullEvents:input_type -> smartcore.bos.demandresponse.v1.PullEventsRequest
+	10, // 24: smartcore.bos.demandresponse.v1.DemandResponseApi.GetShedStatus:input_type -> smartcore.bos.demandresponse.v1.GetShedStatusRequest
+	11, // 25: smartcore.bos.demandresponse.v1.DemandResponseApi.PullShedStatus:input_type -> smartcore.bos.demandresponse.v1.PullShedStatusRequest
+	2,  // 26: smartcore.bos.demandresponse.v1.DemandResponseApi.CreateEvent:output_type -> smartcore.bos.demandresponse.v1.Event
+	2,  // 27: smartcore.bos.demandresponse.v1.DemandResponseApi.CancelEvent:output_type -> smartcore.bos.demandresponse.v1.Event
+	7,  // 28: smartcore.bos.demandresponse.v1.DemandResponseApi.ListEvents:output_type -> smartcore.bos.demandresponse.v1.ListEventsResponse
+	9,  // 29: smartcore.bos.demandresponse.v1.DemandResponseApi.PullEvents:output_type -> smartcore.bos.demandresponse.v1.PullEventsResponse
+	3,  // 30: smartcore.bos.demandresponse.v1.DemandResponseApi.GetShedStatus:output_type -> smartcore.bos.demandresponse.v1.ShedStatus
+	12, // 31: smartcore.bos.demandresponse.v1.DemandResponseApi.PullShedStatus:output_type -> smartcore.bos.demandresponse.v1.PullShedStatusResponse
+	26, // [26:32] is the sub-list for method output_type
+	20, // [20:26] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_smartcore_bos_demandresponse_v1_demand_response_proto_init() }
+func file_smartcore_bos_demandresponse_v1_demand_response_proto_init() {
+	if File_smartcore_bos_demandresponse_v1_demand_response_proto != nil {
+		return
+	}
+	file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[0].OneofWrappers = []any{}
+	file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDesc), len(file_smartcore_bos_demandresponse_v1_demand_response_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_smartcore_bos_demandresponse_v1_demand_response_proto_goTypes,
+		DependencyIndexes: file_smartcore_bos_demandresponse_v1_demand_response_proto_depIdxs,
+		EnumInfos:         file_smartcore_bos_demandresponse_v1_demand_response_proto_enumTypes,
+		MessageInfos:      file_smartcore_bos_demandresponse_v1_demand_response_proto_msgTypes,
+	}.Build()
+	File_smartcore_bos_demandresponse_v1_demand_response_proto = out.File
+	file_smartcore_bos_demandresponse_v1_demand_response_proto_goTypes = nil
+	file_smartcore_bos_demandresponse_v1_demand_response_proto_depIdxs = nil
+}
@@ -0,0 +1,237 @@
+// Code generated by protoc-gen-router. DO NOT EDIT.
+//lint:file-ignore SA1019 Routers intentionally use a deprecated packaged - they are deprecated themselves
+
+package demandresponsepb
+
+import (
+	context "context"
+	fmt "fmt"
+	router "github.com/smart-core-os/sc-bos/pkg/router"
+	grpc "google.golang.org/grpc"
+	io "io"
+)
+
+// ApiRouter is a DemandResponseApiServer that allows routing named requests to specific DemandResponseApiClient
+// Deprecated: routing is now handled dynamically by [node.Node].
+type ApiRouter struct {
+	UnimplementedDemandResponseApiServer
+
+	router.Router
+}
+
+// compile time check that we implement the interface we need
+var _ DemandResponseApiServer = (*ApiRouter)(nil)
+
+// NewApiRouter constructs a new empty ApiRouter with the provided options.
+// Deprecated: routing is now handled dynamically by [node.Node].
+func NewApiRouter(opts ...router.Option) *ApiRouter {
+	return &ApiRouter{
+		Router: router.NewRouter(opts...),
+	}
+}
+
+// WithDemandResponseApiClientFactory instructs the router to create a new
+// client the first time Get is called for that name.
+func WithDemandResponseApiClientFactory(f func(name string) (DemandResponseApiClient, error)) router.Option {
+	return router.WithFactory(func(name string) (any, error) {
+		return f(name)
+	})
+}
+
+func (r *ApiRouter) Register(server grpc.ServiceRegistrar) {
+	RegisterDemandResponseApiServer(server, r)
+}
+
+// Add extends Router.Add to panic if client is not of type DemandResponseApiClient.
+func (r *ApiRouter) Add(name string, client any) any {
+	if !r.HoldsType(client) {
+		panic(fmt.Sprintf("not correct type: client of type %T is not a DemandResponseApiClient", client))
+	}
+	return r.Router.Add(name, client)
+}
+
+func (r *ApiRouter) HoldsType(client any) bool {
+	_, ok := client.(DemandResponseApiClient)
+	return ok
+}
+
+func (r *ApiRouter) AddDemandResponseApiClient(name string, client DemandResponseApiClient) DemandResponseApiClient {
+	res := r.Add(name, client)
+	if res == nil {
+		return nil
+	}
+	return res.(DemandResponseApiClient)
+}
+
+func (r *ApiRouter) RemoveDemandResponseApiClient(name string) DemandResponseApiClient {
+	res := r.Remove(name)
+	if res == nil {
+		return nil
+	}
+	return res.(DemandResponseApiClient)
+}
+
+func (r *ApiRouter) GetDemandResponseApiClient(name string) (DemandResponseApiClient, error) {
+	res, err := r.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(DemandResponseApiClient), nil
+}
+
+func (r *ApiRouter) CreateEvent(ctx context.Context, request *CreateEventRequest) (*Event, error) {
+	child, err := r.GetDemandResponseApiClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.CreateEvent(ctx, request)
+}
+
+func (r *ApiRouter) CancelEvent(ctx context.Context, request *CancelEventRequest) (*Event, error) {
+	child, err := r.GetDemandResponseApiClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.CancelEvent(ctx, request)
+}
+
+func (r *ApiRouter) ListEvents(ctx context.Context, request *ListEventsRequest) (*ListEventsResponse, error) {
+	child, err := r.GetDemandResponseApiClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.ListEvents(ctx, request)
+}
+
+func (r *ApiRouter) PullEvents(request *PullEventsRequest, server DemandResponseApi_PullEventsServer) error {
+	child, err := r.GetDemandResponseApiClient(request.Name)
+	if err != nil {
+		return err
+	}
+
+	// so we can cancel our forwarding request if we can't send responses to our caller
+	reqCtx, reqDone := context.WithCancel(server.Context())
+	// issue the request
+	stream, err := child.PullEvents(reqCtx, request)
+	if err != nil {
+		return err
+	}
+
+	// send the stream header
+	header, err := stream.Header()
+	if err != nil {
+		return err
+	}
+	if err = server.SendHeader(header); err != nil {
+		return err
+	}
+
+	// send all the messages
+	// false means the error is from the child, true means the error is from the caller
+	var callerError bool
+	for {
+		// Impl note: we could improve throughput here by issuing the Recv and Send in different goroutines, but we're doing
+		// it synchronously until we have a need to change the behaviour
+
+		var msg *PullEventsResponse
+		msg, err = stream.Recv()
+		if err != nil {
+			break
+		}
+
+		err = server.Send(msg)
+		if err != nil {
+			callerError = true
+			break
+		}
+	}
+
+	// err is guaranteed to be non-nil as it's the only way to exit the loop
+	if callerError {
+		// cancel the request
+		reqDone()
+		return err
+	} else {
+		if trailer := stream.Trailer(); trailer != nil {
+			server.SetTrailer(trailer)
+		}
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+}
+
+func (r *ApiRouter) GetShedStatus(ctx context.Context, request *GetShedStatusRequest) (*ShedStatus, error) {
+	child, err := r.GetDemandResponseApiClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.GetShedStatus(ctx, request)
+}
+
+func (r *ApiRouter) PullShedStatus(request *PullShedStatusRequest, server DemandResponseApi_PullShedStatusServer) error {
+	child, err := r.GetDemandResponseApiClient(request.Name)
+	if err != nil {
+		return err
+	}
+
+	// so we can cancel our forwarding request if we can't send responses to our caller
+	reqCtx, reqDone := context.WithCancel(server.Context())
+	// issue the request
+	stream, err := child.PullShedStatus(reqCtx, request)
+	if err != nil {
+		return err
+	}
+
+	// send the stream header
+	header, err := stream.Header()
+	if err != nil {
+		return err
+	}
+	if err = server.SendHeader(header); err != nil {
+		return err
+	}
+
+	// send all the messages
+	// false means the error is from the child, true means the error is from the caller
+	var callerError bool
+	for {
+		// Impl note: we could improve throughput here by issuing the Recv and Send in different goroutines, but we're doing
+		// it synchronously until we have a need to change the behaviour
+
+		var msg *PullShedStatusResponse
+		msg, err = stream.Recv()
+		if err != nil {
+			break
+		}
+
+		err = server.Send(msg)
+		if err != nil {
+			callerError = true
+			break
+		}
+	}
+
+	// err is guaranteed to be non-nil as it's the only way to exit the loop
+	if callerError {
+		// cancel the request
+		reqDone()
+		return err
+	} else {
+		if trailer := stream.Trailer(); trailer != nil {
+			server.SetTrailer(trailer)
+		}
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+}
@@ -0,0 +1,43 @@
+// Code generated by protoc-gen-wrapper. DO NOT EDIT.
+
+package demandresponsepb
+
+import (
+	wrap "github.com/smart-core-os/sc-bos/pkg/wrap"
+	grpc "google.golang.org/grpc"
+)
+
+// WrapApi	adapts a DemandResponseApiServer	and presents it as a DemandResponseApiClient
+// Deprecated: for client use, use [wrap.ServerToClient]; for server registration, use [github.com/smart-core-os/sc-bos/pkg/node.HasServer].
+func WrapApi(server DemandResponseApiServer) *ApiWrapper {
+	conn := wrap.ServerToClient(DemandResponseApi_ServiceDesc, server)
+	client := NewDemandResponseApiClient(conn)
+	return &ApiWrapper{
+		DemandResponseApiClient: client,
+		server:                  server,
+		conn:                    conn,
+		desc:                    DemandResponseApi_ServiceDesc,
+	}
+}
+
+type ApiWrapper struct {
+	DemandResponseApiClient
+
+	server DemandResponseApiServer
+	conn   grpc.ClientConnInterface
+	desc   grpc.ServiceDesc
+}
+
+// UnwrapServer returns the underlying server instance.
+func (w *ApiWrapper) UnwrapServer() DemandResponseApiServer {
+	return w.server
+}
+
+// Unwrap implements wrap.Unwrapper and returns the underlying server instance as an unknown type.
+func (w *ApiWrapper) Unwrap() any {
+	return w.UnwrapServer()
+}
+
+func (w *ApiWrapper) UnwrapService() (grpc.ClientConnInterface, grpc.ServiceDesc) {
+	return w.conn, w.desc
+}
@@ -0,0 +1,344 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v7.35.1
+// source: smartcore/bos/demandresponse/v1/demand_response.proto
+
+package demandresponsepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DemandResponseApi_CreateEvent_FullMethodName    = "/smartcore.bos.demandresponse.v1.DemandResponseApi/CreateEvent"
+	DemandResponseApi_CancelEvent_FullMethodName    = "/smartcore.bos.demandresponse.v1.DemandResponseApi/CancelEvent"
+	DemandResponseApi_ListEvents_FullMethodName     = "/smartcore.bos.demandresponse.v1.DemandResponseApi/ListEvents"
+	DemandResponseApi_PullEvents_FullMethodName     = "/smartcore.bos.demandresponse.v1.DemandResponseApi/PullEvents"
+	DemandResponseApi_GetShedStatus_FullMethodName  = "/smartcore.bos.demandresponse.v1.DemandResponseApi/GetShedStatus"
+	DemandResponseApi_PullShedStatus_FullMethodName = "/smartcore.bos.demandresponse.v1.DemandResponseApi/PullShedStatus"
+)
+
+// DemandResponseApiClient is the client API for DemandResponseApi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DemandResponseApi coordinates reducing the electrical load of a site in response to grid events.
+// Events describe when and how much load should be shed, during an active event the implementation sheds load by
+// adjusting devices in priority order, restoring them when the event ends.
+type DemandResponseApiClient interface {
+	// Create a new event.
+	// The event becomes active at its start time and ends at its end time, unless cancelled.
+	CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*Event, error)
+	// Cancel an event by id.
+	// Cancelling an active event restores any shed load.
+	CancelEvent(ctx context.Context, in *CancelEventRequest, opts ...grpc.CallOption) (*Event, error)
+	// List known events.
+	// Events are removed some time after they end.
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	// Subscribe to changes in the known events.
+	PullEvents(ctx context.Context, in *PullEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PullEventsResponse], error)
+	// Get how much load is currently being shed.
+	GetShedStatus(ctx context.Context, in *GetShedStatusRequest, opts ...grpc.CallOption) (*ShedStatus, error)
+	// Subscribe to changes in how much load is being shed.
+	PullShedStatus(ctx context.Context, in *PullShedStatusRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PullShedStatusResponse], error)
+}
+
+type demandResponseApiClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDemandResponseApiClient(cc grpc.ClientConnInterface) DemandResponseApiClient {
+	return &demandResponseApiClient{cc}
+}
+
+func (c *demandResponseApiClient) CreateEvent(ctx context.Context, in *CreateEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Event)
+	err := c.cc.Invoke(ctx, DemandResponseApi_CreateEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *demandResponseApiClient) CancelEvent(ctx context.Context, in *CancelEventRequest, opts ...grpc.CallOption) (*Event, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Event)
+	err := c.cc.Invoke(ctx, DemandResponseApi_CancelEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *demandResponseApiClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, DemandResponseApi_ListEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *demandResponseApiClient) PullEvents(ctx context.Context, in *PullEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PullEventsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DemandResponseApi_ServiceDesc.Streams[0], DemandResponseApi_PullEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[PullEventsRequest, PullEventsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DemandResponseApi_PullEventsClient = grpc.ServerStreamingClient[PullEventsResponse]
+
+func (c *demandResponseApiClient) GetShedStatus(ctx context.Context, in *GetShedStatusRequest, opts ...grpc.CallOption) (*ShedStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShedStatus)
+	err := c.cc.Invoke(ctx, DemandResponseApi_GetShedStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *demandResponseApiClient) PullShedStatus(ctx context.Context, in *PullShedStatusRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PullShedStatusResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DemandResponseApi_ServiceDesc.Streams[1], DemandResponseApi_PullShedStatus_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[PullShedStatusRequest, PullShedStatusResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DemandResponseApi_PullShedStatusClient = grpc.ServerStreamingClient[PullShedStatusResponse]
+
+// DemandResponseApiServer is the server API for DemandResponseApi service.
+// All implementations must embed UnimplementedDemandResponseApiServer
+// for forward compatibility.
+//
+// DemandResponseApi coordinates reducing the electrical load of a site in response to grid events.
+// Events describe when and how much load should be shed, during an active event the implementation sheds load by
+// adjusting devices in priority order, restoring them when the event ends.
+type DemandResponseApiServer interface {
+	// Create a new event.
+	// The event becomes active at its start time and ends at its end time, unless cancelled.
+	CreateEvent(context.Context, *CreateEventRequest) (*Event, error)
+	// Cancel an event by id.
+	// Cancelling an active event restores any shed load.
+	CancelEvent(context.Context, *CancelEventRequest) (*Event, error)
+	// List known events.
+	// Events are removed some time after they end.
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	// Subscribe to changes in the known events.
+	PullEvents(*PullEventsRequest, grpc.ServerStreamingServer[PullEventsResponse]) error
+	// Get how much load is currently being shed.
+	GetShedStatus(context.Context, *GetShedStatusRequest) (*ShedStatus, error)
+	// Subscribe to changes in how much load is being shed.
+	PullShedStatus(*PullShedStatusRequest, grpc.ServerStreamingServer[PullShedStatusResponse]) error
+	mustEmbedUnimplementedDemandResponseApiServer()
+}
+
+// UnimplementedDemandResponseApiServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDemandResponseApiServer struct{}
+
+func (UnimplementedDemandResponseApiServer) CreateEvent(context.Context, *CreateEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEvent not implemented")
+}
+func (UnimplementedDemandResponseApiServer) CancelEvent(context.Context, *CancelEventRequest) (*Event, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelEvent not implemented")
+}
+func (UnimplementedDemandResponseApiServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedDemandResponseApiServer) PullEvents(*PullEventsRequest, grpc.ServerStreamingServer[PullEventsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method PullEvents not implemented")
+}
+func (UnimplementedDemandResponseApiServer) GetShedStatus(context.Context, *GetShedStatusRequest) (*ShedStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShedStatus not implemented")
+}
+func (UnimplementedDemandResponseApiServer) PullShedStatus(*PullShedStatusRequest, grpc.ServerStreamingServer[PullShedStatusResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method PullShedStatus not implemented")
+}
+func (UnimplementedDemandResponseApiServer) mustEmbedUnimplementedDemandResponseApiServer() {}
+func (UnimplementedDemandResponseApiServer) testEmbeddedByValue()                           {}
+
+// UnsafeDemandResponseApiServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DemandResponseApiServer will
+// result in compilation errors.
+type UnsafeDemandResponseApiServer interface {
+	mustEmbedUnimplementedDemandResponseApiServer()
+}
+
+func RegisterDemandResponseApiServer(s grpc.ServiceRegistrar, srv DemandResponseApiServer) {
+	// If the following call pancis, it indicates UnimplementedDemandResponseApiServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DemandResponseApi_ServiceDesc, srv)
+}
+
+func _DemandResponseApi_CreateEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DemandResponseApiServer).CreateEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DemandResponseApi_CreateEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DemandResponseApiServer).CreateEvent(ctx, req.(*CreateEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DemandResponseApi_CancelEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DemandResponseApiServer).CancelEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DemandResponseApi_CancelEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DemandResponseApiServer).CancelEvent(ctx, req.(*CancelEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DemandResponseApi_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DemandResponseApiServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DemandResponseApi_ListEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DemandResponseApiServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DemandResponseApi_PullEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PullEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DemandResponseApiServer).PullEvents(m, &grpc.GenericServerStream[PullEventsRequest, PullEventsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DemandResponseApi_PullEventsServer = grpc.ServerStreamingServer[PullEventsResponse]
+
+func _DemandResponseApi_GetShedStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShedStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DemandResponseApiServer).GetShedStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DemandResponseApi_GetShedStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DemandResponseApiServer).GetShedStatus(ctx, req.(*GetShedStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DemandResponseApi_PullShedStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PullShedStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DemandResponseApiServer).PullShedStatus(m, &grpc.GenericServerStream[PullShedStatusRequest, PullShedStatusResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DemandResponseApi_PullShedStatusServer = grpc.ServerStreamingServer[PullShedStatusResponse]
+
+// DemandResponseApi_ServiceDesc is the grpc.ServiceDesc for DemandResponseApi service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DemandResponseApi_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "smartcore.bos.demandresponse.v1.DemandResponseApi",
+	HandlerType: (*DemandResponseApiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateEvent",
+			Handler:    _DemandResponseApi_CreateEvent_Handler,
+		},
+		{
+			MethodName: "CancelEvent",
+			Handler:    _DemandResponseApi_CancelEvent_Handler,
+		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _DemandResponseApi_ListEvents_Handler,
+		},
+		{
+			MethodName: "GetShedStatus",
+			Handler:    _DemandResponseApi_GetShedStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "PullEvents",
+			Handler:       _DemandResponseApi_PullEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PullShedStatus",
+			Handler:       _DemandResponseApi_PullShedStatus_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "smartcore/bos/demandresponse/v1/demand_response.proto",
+}
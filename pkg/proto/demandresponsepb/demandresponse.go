@@ -0,0 +1,7 @@
+package demandresponsepb
+
+import (
+	"github.com/smart-core-os/sc-bos/pkg/trait"
+)
+
+const TraitName trait.Name = "smartcore.bos.DemandResponse"
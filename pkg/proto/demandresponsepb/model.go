@@ -0,0 +1,133 @@
+package demandresponsepb
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/typespb"
+	"github.com/smart-core-os/sc-bos/pkg/resource"
+)
+
+// Model describes the data structure needed to implement the DemandResponse trait.
+type Model struct {
+	events     *resource.Collection // of *Event
+	shedStatus *resource.Value      // of *ShedStatus
+}
+
+// NewModel creates a new model.
+func NewModel(opts ...resource.Option) *Model {
+	return &Model{
+		events:     resource.NewCollection(opts...),
+		shedStatus: resource.NewValue(append([]resource.Option{resource.WithInitialValue(&ShedStatus{})}, opts...)...),
+	}
+}
+
+// CreateEvent adds a new event to the model, generating an id.
+func (m *Model) CreateEvent(event *Event) (*Event, error) {
+	return castEvent(m.events.Add("", event, resource.WithGenIDIfAbsent(), resource.WithIDCallback(func(id string) {
+		event.Id = id
+	})))
+}
+
+func (m *Model) GetEvent(id string, opts ...resource.ReadOption) (*Event, bool) {
+	msg, exists := m.events.Get(id, opts...)
+	if msg == nil {
+		return nil, exists
+	}
+	return msg.(*Event), exists
+}
+
+func (m *Model) UpdateEvent(event *Event, opts ...resource.WriteOption) (*Event, error) {
+	if event.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing ID")
+	}
+	return castEvent(m.events.Update(event.Id, event, opts...))
+}
+
+func (m *Model) DeleteEvent(id string, opts ...resource.WriteOption) (*Event, error) {
+	return castEvent(m.events.Delete(id, opts...))
+}
+
+func (m *Model) ListEvents(opts ...resource.ReadOption) []*Event {
+	msgs := m.events.List(opts...)
+	events := make([]*Event, len(msgs))
+	for i, msg := range msgs {
+		events[i] = msg.(*Event)
+	}
+	return events
+}
+
+type EventsChange struct {
+	ChangeType typespb.ChangeType
+	ChangeTime time.Time
+	OldValue   *Event
+	NewValue   *Event
+}
+
+func (m *Model) PullEvents(ctx context.Context, opts ...resource.ReadOption) <-chan EventsChange {
+	send := make(chan EventsChange)
+	go func() {
+		defer close(send)
+		for change := range m.events.Pull(ctx, opts...) {
+			event := EventsChange{
+				ChangeType: change.ChangeType,
+				ChangeTime: change.ChangeTime,
+			}
+			if change.OldValue != nil {
+				event.OldValue = change.OldValue.(*Event)
+			}
+			if change.NewValue != nil {
+				event.NewValue = change.NewValue.(*Event)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case send <- event:
+			}
+		}
+	}()
+	return send
+}
+
+func (m *Model) GetShedStatus(opts ...resource.ReadOption) *ShedStatus {
+	return m.shedStatus.Get(opts...).(*ShedStatus)
+}
+
+func (m *Model) UpdateShedStatus(value *ShedStatus, opts ...resource.WriteOption) (*ShedStatus, error) {
+	res, err := m.shedStatus.Set(value, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ShedStatus), nil
+}
+
+type ShedStatusChange struct {
+	ChangeTime time.Time
+	Value      *ShedStatus
+}
+
+func (m *Model) PullShedStatus(ctx context.Context, opts ...resource.ReadOption) <-chan ShedStatusChange {
+	send := make(chan ShedStatusChange)
+	go func() {
+		defer close(send)
+		for change := range m.shedStatus.Pull(ctx, opts...) {
+			select {
+			case <-ctx.Done():
+				return
+			case send <- ShedStatusChange{ChangeTime: change.ChangeTime, Value: change.Value.(*ShedStatus)}:
+			}
+		}
+	}()
+	return send
+}
+
+func castEvent(msg proto.Message, err error) (*Event, error) {
+	if msg == nil {
+		return nil, err
+	}
+	return msg.(*Event), err
+}
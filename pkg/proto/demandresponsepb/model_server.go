@@ -0,0 +1,102 @@
+package demandresponsepb
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/pkg/resource"
+)
+
+// ModelServer adapts a Model to implement DemandResponseApiServer.
+type ModelServer struct {
+	UnimplementedDemandResponseApiServer
+	model *Model
+}
+
+func NewModelServer(model *Model) *ModelServer {
+	return &ModelServer{model: model}
+}
+
+func (m *ModelServer) Unwrap() any {
+	return m.model
+}
+
+func (m *ModelServer) Register(server grpc.ServiceRegistrar) {
+	RegisterDemandResponseApiServer(server, m)
+}
+
+func (m *ModelServer) CreateEvent(_ context.Context, request *CreateEventRequest) (*Event, error) {
+	if request.GetEvent() == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing event")
+	}
+	event := proto.Clone(request.Event).(*Event) // the request belongs to the caller
+	if event.Id != "" {
+		return nil, status.Error(codes.InvalidArgument, "id must not be set")
+	}
+	if event.EndTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "end_time is required")
+	}
+	if event.StartTime == nil {
+		event.StartTime = timestamppb.Now()
+	}
+	if !event.StartTime.AsTime().Before(event.EndTime.AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "end_time must be after start_time")
+	}
+	if event.Level < 0 {
+		return nil, status.Error(codes.InvalidArgument, "level must not be negative")
+	}
+	event.Source = Event_API
+	event.ExternalId = ""
+	event.State = Event_PENDING
+	return m.model.CreateEvent(event)
+}
+
+func (m *ModelServer) CancelEvent(_ context.Context, request *CancelEventRequest) (*Event, error) {
+	if request.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing id")
+	}
+	return m.model.UpdateEvent(&Event{Id: request.Id, State: Event_CANCELLED}, resource.WithUpdatePaths("state"), resource.WithExpectedCheck(func(msg proto.Message) error {
+		switch msg.(*Event).State {
+		case Event_COMPLETED, Event_CANCELLED:
+			return status.Error(codes.FailedPrecondition, "event has already ended")
+		}
+		return nil
+	}))
+}
+
+func (m *ModelServer) ListEvents(_ context.Context, request *ListEventsRequest) (*ListEventsResponse, error) {
+	return &ListEventsResponse{Events: m.model.ListEvents(resource.WithReadMask(request.ReadMask))}, nil
+}
+
+func (m *ModelServer) PullEvents(request *PullEventsRequest, server DemandResponseApi_PullEventsServer) error {
+	for change := range m.model.PullEvents(server.Context(), resource.WithReadMask(request.ReadMask), resource.WithUpdatesOnly(request.UpdatesOnly)) {
+		err := server.Send(&PullEventsResponse{Changes: []*PullEventsResponse_Change{
+			{Name: request.Name, Type: change.ChangeType, ChangeTime: timestamppb.New(change.ChangeTime), OldValue: change.OldValue, NewValue: change.NewValue},
+		}})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *ModelServer) GetShedStatus(_ context.Context, request *GetShedStatusRequest) (*ShedStatus, error) {
+	return m.model.GetShedStatus(resource.WithReadMask(request.ReadMask)), nil
+}
+
+func (m *ModelServer) PullShedStatus(request *PullShedStatusRequest, server DemandResponseApi_PullShedStatusServer) error {
+	for change := range m.model.PullShedStatus(server.Context(), resource.WithReadMask(request.ReadMask), resource.WithUpdatesOnly(request.UpdatesOnly)) {
+		err := server.Send(&PullShedStatusResponse{Changes: []*PullShedStatusResponse_Change{
+			{Name: request.Name, ChangeTime: timestamppb.New(change.ChangeTime), ShedStatus: change.Value},
+		}})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
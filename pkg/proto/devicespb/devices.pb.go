@@ -39,6 +39,9 @@ const (
 	Device_Query_Condition_ANY Device_Query_Condition_Matcher = 1
 	// The condition matches only when all values in the set match the condition.
 	Device_Query_Condition_ALL Device_Query_Condition_Matcher = 2
+	// The condition matches only when no value in the set matches the condition, including when there are no values.
+	// For example, use NONE with present to match messages where a field is absent.
+	Device_Query_Condition_NONE Device_Query_Condition_Matcher = 3
 )
 
 // Enum value maps for Device_Query_Condition_Matcher.
@@ -47,11 +50,13 @@ var (
 		0: "MATCHER_UNSPECIFIED",
 		1: "ANY",
 		2: "ALL",
+		3: "NONE",
 	}
 	Device_Query_Condition_Matcher_value = map[string]int32{
 		"MATCHER_UNSPECIFIED": 0,
 		"ANY":                 1,
 		"ALL":                 2,
+		"NONE":                3,
 	}
 )
 
@@ -1730,15 +1735,15 @@ var File_smartcore_bos_devices_v1_devices_proto protoreflect.FileDescriptor
 
 const file_smartcore_bos_devices_v1_devices_proto_rawDesc = "" +
 	"\n" +
-	"&smartcore/bos/devices/v1/devices.proto\x12\x18smartcore.bos.devices.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$smartcore/bos/health/v1/health.proto\x1a(smartcore/bos/metadata/v1/metadata.proto\x1a(smartcore/bos/types/time/v1/period.proto\x1a#smartcore/bos/types/v1/change.proto\"\xae\x0e\n" +
+	"&smartcore/bos/devices/v1/devices.proto\x12\x18smartcore.bos.devices.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$smartcore/bos/health/v1/health.proto\x1a(smartcore/bos/metadata/v1/metadata.proto\x1a(smartcore/bos/types/time/v1/period.proto\x1a#smartcore/bos/types/v1/change.proto\"\xb8\x0e\n" +
 	"\x06Device\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12?\n" +
 	"\bmetadata\x18\x02 \x01(\v2#.smartcore.bos.metadata.v1.MetadataR\bmetadata\x12I\n" +
-	"\rhealth_checks\x18\x03 \x03(\v2$.smartcore.bos.health.v1.HealthCheckR\fhealthChecks\x1a\x83\r\n" +
+	"\rhealth_checks\x18\x03 \x03(\v2$.smartcore.bos.health.v1.HealthCheckR\fhealthChecks\x1a\x8d\r\n" +
 	"\x05Query\x12P\n" +
 	"\n" +
 	"conditions\x18\x01 \x03(\v20.smartcore.bos.devices.v1.Device.Query.ConditionR\n" +
-	"conditions\x1a\xb0\v\n" +
+	"conditions\x1a\xba\v\n" +
 	"\tCondition\x12\x14\n" +
 	"\x05field\x18\x01 \x01(\tR\x05field\x12#\n" +
 	"\fstring_equal\x18\x02 \x01(\tH\x00R\vstringEqual\x12,\n" +
@@ -1761,11 +1766,12 @@ const file_smartcore_bos_devices_v1_devices_proto_rawDesc = "" +
 	"\apresent\x18( \x01(\v2\x16.google.protobuf.EmptyH\x00R\apresent\x12B\n" +
 	"\amatches\x182 \x01(\v2&.smartcore.bos.devices.v1.Device.QueryH\x00R\amatches\x12I\n" +
 	"\x06any_of\x183 \x01(\v20.smartcore.bos.devices.v1.Device.Query.QueryListH\x00R\x05anyOf\x12R\n" +
-	"\amatcher\x18d \x01(\x0e28.smartcore.bos.devices.v1.Device.Query.Condition.MatcherR\amatcher\"4\n" +
+	"\amatcher\x18d \x01(\x0e28.smartcore.bos.devices.v1.Device.Query.Condition.MatcherR\amatcher\">\n" +
 	"\aMatcher\x12\x17\n" +
 	"\x13MATCHER_UNSPECIFIED\x10\x00\x12\a\n" +
 	"\x03ANY\x10\x01\x12\a\n" +
-	"\x03ALL\x10\x02B\a\n" +
+	"\x03ALL\x10\x02\x12\b\n" +
+	"\x04NONE\x10\x03B\a\n" +
 	"\x05value\x1a&\n" +
 	"\n" +
 	"StringList\x12\x18\n" +
@@ -0,0 +1,490 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.35.1
+// source: smartcore/bos/driver/sim/v1/sim.proto
+
+package simpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Scenario is a named, timed sequence of events injected into the simulation.
+type Scenario struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Title   string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Running bool                   `protobuf:"varint,3,opt,name=running,proto3" json:"running,omitempty"`
+	// The simulated time the scenario was started, absent if the scenario is not running.
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Events        []*Scenario_Event      `protobuf:"bytes,5,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Scenario) Reset() {
+	*x = Scenario{}
+	mi := &file_smartcore_bos_driver_sim_v1_sim_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Scenario) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scenario) ProtoMessage() {}
+
+func (x *Scenario) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_driver_sim_v1_sim_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scenario.ProtoReflect.Descriptor instead.
+func (*Scenario) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_driver_sim_v1_sim_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Scenario) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Scenario) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Scenario) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *Scenario) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Scenario) GetEvents() []*Scenario_Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ListScenariosRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the driver instance
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScenariosRequest) Reset() {
+	*x = ListScenariosRequest{}
+	mi := &file_smartcore_bos_driver_sim_v1_sim_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScenariosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScenariosRequest) ProtoMessage() {}
+
+func (x *ListScenariosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_driver_sim_v1_sim_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScenariosRequest.ProtoReflect.Descriptor instead.
+func (*ListScenariosRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_driver_sim_v1_sim_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListScenariosRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListScenariosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scenarios     []*Scenario            `protobuf:"bytes,1,rep,name=scenarios,proto3" json:"scenarios,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScenariosResponse) Reset() {
+	*x = ListScenariosResponse{}
+	mi := &file_smartcore_bos_driver_sim_v1_sim_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScenariosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScenariosResponse) ProtoMessage() {}
+
+func (x *ListScenariosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_driver_sim_v1_sim_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScenariosResponse.ProtoReflect.Descriptor instead.
+func (*ListScenariosResponse) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_driver_sim_v1_sim_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListScenariosResponse) GetScenarios() []*Scenario {
+	if x != nil {
+		return x.Scenarios
+	}
+	return nil
+}
+
+type StartScenarioRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the driver instance
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of the scenario to start
+	Scenario      string `protobuf:"bytes,2,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartScenarioRequest) Reset() {
+	*x = StartScenarioRequest{}
+	mi := &file_smartcore_bos_driver_sim_v1_sim_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartScenarioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartScenarioRequest) ProtoMessage() {}
+
+func (x *StartScenarioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_driver_sim_v1_sim_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartScenarioRequest.ProtoReflect.Descriptor instead.
+func (*StartScenarioRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_driver_sim_v1_sim_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StartScenarioRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StartScenarioRequest) GetScenario() string {
+	if x != nil {
+		return x.Scenario
+	}
+	return ""
+}
+
+type StopScenarioRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the driver instance
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of the scenario to stop
+	Scenario      string `protobuf:"bytes,2,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopScenarioRequest) Reset() {
+	*x = StopScenarioRequest{}
+	mi := &file_smartcore_bos_driver_sim_v1_sim_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopScenarioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopScenarioRequest) ProtoMessage() {}
+
+func (x *StopScenarioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_driver_sim_v1_sim_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopScenarioRequest.ProtoReflect.Descriptor instead.
+func (*StopScenarioRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_driver_sim_v1_sim_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StopScenarioRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StopScenarioRequest) GetScenario() string {
+	if x != nil {
+		return x.Scenario
+	}
+	return ""
+}
+
+type Scenario_Event struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The type of event, for example "fanFailure" or "powerCut".
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// The device, floor, or room the event applies to, empty for building wide events.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// The simulated time after the scenario starts that the event starts.
+	// For events that start at a time of day this is only known while the scenario is running.
+	StartOffset *durationpb.Duration `protobuf:"bytes,3,opt,name=start_offset,json=startOffset,proto3" json:"start_offset,omitempty"`
+	// How long, in simulated time, the event lasts. Absent if the event lasts until the scenario stops.
+	Duration *durationpb.Duration `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Whether the event is currently affecting the simulation.
+	Active bool `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	// The simulated time of day the event starts, like "10:00", if it starts at a time of day.
+	StartTimeOfDay string `protobuf:"bytes,6,opt,name=start_time_of_day,json=startTimeOfDay,proto3" json:"start_time_of_day,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Scenario_Event) Reset() {
+	*x = Scenario_Event{}
+	mi := &file_smartcore_bos_driver_sim_v1_sim_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Scenario_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scenario_Event) ProtoMessage() {}
+
+func (x *Scenario_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_driver_sim_v1_sim_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scenario_Event.ProtoReflect.Descriptor instead.
+func (*Scenario_Event) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_driver_sim_v1_sim_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Scenario_Event) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Scenario_Event) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Scenario_Event) GetStartOffset() *durationpb.Duration {
+	if x != nil {
+		return x.StartOffset
+	}
+	return nil
+}
+
+func (x *Scenario_Event) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *Scenario_Event) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Scenario_Event) GetStartTimeOfDay() string {
+	if x != nil {
+		return x.StartTimeOfDay
+	}
+	return ""
+}
+
+var File_smartcore_bos_driver_sim_v1_sim_proto protoreflect.FileDescriptor
+
+const file_smartcore_bos_driver_sim_v1_sim_proto_rawDesc = "" +
+	"\n" +
+	"%smartcore/bos/driver/sim/v1/sim.proto\x12\x1bsmartcore.bos.driver.sim.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbc\x03\n" +
+	"\bScenario\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\arunning\x18\x03 \x01(\bR\arunning\x129\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12C\n" +
+	"\x06events\x18\x05 \x03(\v2+.smartcore.bos.driver.sim.v1.Scenario.EventR\x06events\x1a\xeb\x01\n" +
+	"\x05Event\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12<\n" +
+	"\fstart_offset\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vstartOffset\x125\n" +
+	"\bduration\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\x12)\n" +
+	"\x11start_time_of_day\x18\x06 \x01(\tR\x0estartTimeOfDay\"*\n" +
+	"\x14ListScenariosRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\\\n" +
+	"\x15ListScenariosResponse\x12C\n" +
+	"\tscenarios\x18\x01 \x03(\v2%.smartcore.bos.driver.sim.v1.ScenarioR\tscenarios\"F\n" +
+	"\x14StartScenarioRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bscenario\x18\x02 \x01(\tR\bscenario\"E\n" +
+	"\x13StopScenarioRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bscenario\x18\x02 \x01(\tR\bscenario2\xde\x02\n" +
+	"\x10SimDriverService\x12v\n" +
+	"\rListScenarios\x121.smartcore.bos.driver.sim.v1.ListScenariosRequest\x1a2.smartcore.bos.driver.sim.v1.ListScenariosResponse\x12i\n" +
+	"\rStartScenario\x121.smartcore.bos.driver.sim.v1.StartScenarioRequest\x1a%.smartcore.bos.driver.sim.v1.Scenario\x12g\n" +
+	"\fStopScenario\x120.smartcore.bos.driver.sim.v1.StopScenarioRequest\x1a%.smartcore.bos.driver.sim.v1.ScenarioB8Z6github.com/smart-core-os/sc-bos/pkg/proto/driver/simpbb\x06proto3"
+
+var (
+	file_smartcore_bos_driver_sim_v1_sim_proto_rawDescOnce sync.Once
+	file_smartcore_bos_driver_sim_v1_sim_proto_rawDescData []byte
+)
+
+func file_smartcore_bos_driver_sim_v1_sim_proto_rawDescGZIP() []byte {
+	file_smartcore_bos_driver_sim_v1_sim_proto_rawDescOnce.Do(func() {
+		file_smartcore_bos_driver_sim_v1_sim_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_smartcore_bos_driver_sim_v1_sim_proto_rawDesc), len(file_smartcore_bos_driver_sim_v1_sim_proto_rawDesc)))
+	})
+	return file_smartcore_bos_driver_sim_v1_sim_proto_rawDescData
+}
+
+var file_smartcore_bos_driver_sim_v1_sim_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_smartcore_bos_driver_sim_v1_sim_proto_goTypes = []any{
+	(*Scenario)(nil),              // 0: smartcore.bos.driver.sim.v1.Scenario
+	(*ListScenariosRequest)(nil),  // 1: smartcore.bos.driver.sim.v1.ListScenariosRequest
+	(*ListScenariosResponse)(nil), // 2: smartcore.bos.driver.sim.v1.ListScenariosResponse
+	(*StartScenarioRequest)(nil),  // 3: smartcore.bos.driver.sim.v1.StartScenarioRequest
+	(*StopScenarioRequest)(nil),   // 4: smartcore.bos.driver.sim.v1.StopScenarioRequest
+	(*Scenario_Event)(nil),        // 5: smartcore.bos.driver.sim.v1.Scenario.Event
+	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 7: google.protobuf.Duration
+}
+var file_smartcore_bos_driver_sim_v1_sim_proto_depIdxs = []int32{
+	6, // 0: smartcore.bos.driver.sim.v1.Scenario.start_time:type_name -> google.protobuf.Timestamp
+	5, // 1: smartcore.bos.driver.sim.v1.Scenario.events:type_name -> smartcore.bos.driver.sim.v1.Scenario.Event
+	0, // 2: smartcore.bos.driver.sim.v1.ListScenariosResponse.scenarios:type_name -> smartcore.bos.driver.sim.v1.Scenario
+	7, // 3: smartcore.bos.driver.sim.v1.Scenario.Event.start_offset:type_name -> google.protobuf.Duration
+	7, // 4: smartcore.bos.driver.sim.v1.Scenario.Event.duration:type_name -> google.protobuf.Duration
+	1, // 5: smartcore.bos.driver.sim.v1.SimDriverService.ListScenarios:input_type -> smartcore.bos.driver.sim.v1.ListScenariosRequest
+	3, // 6: smartcore.bos.driver.sim.v1.SimDriverService.StartScenario:input_type -> smartcore.bos.driver.sim.v1.StartScenarioRequest
+	4, // 7: smartcore.bos.driver.sim.v1.SimDriverService.StopScenario:input_type -> smartcore.bos.driver.sim.v1.StopScenarioRequest
+	2, // 8: smartcore.bos.driver.sim.v1.SimDriverService.ListScenarios:output_type -> smartcore.bos.driver.sim.v1.ListScenariosResponse
+	0, // 9: smartcore.bos.driver.sim.v1.SimDriverService.StartScenario:output_type -> smartcore.bos.driver.sim.v1.Scenario
+	0, // 10: smartcore.bos.driver.sim.v1.SimDriverService.StopScenario:output_type -> smartcore.bos.driver.sim.v1.Scenario
+	8, // [8:11] is the sub-list for method output_type
+	5, // [5:8] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_smartcore_bos_driver_sim_v1_sim_proto_init() }
+func file_smartcore_bos_driver_sim_v1_sim_proto_init() {
+	if File_smartcore_bos_driver_sim_v1_sim_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_smartcore_bos_driver_sim_v1_sim_proto_rawDesc), len(file_smartcore_bos_driver_sim_v1_sim_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_smartcore_bos_driver_sim_v1_sim_proto_goTypes,
+		DependencyIndexes: file_smartcore_bos_driver_sim_v1_sim_proto_depIdxs,
+		MessageInfos:      file_smartcore_bos_driver_sim_v1_sim_proto_msgTypes,
+	}.Build()
+	File_smartcore_bos_driver_sim_v1_sim_proto = out.File
+	file_smartcore_bos_driver_sim_v1_sim_proto_goTypes = nil
+	file_smartcore_bos_driver_sim_v1_sim_proto_depIdxs = nil
+}
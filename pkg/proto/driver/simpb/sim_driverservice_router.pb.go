@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-router. DO NOT EDIT.
+//lint:file-ignore SA1019 Routers intentionally use a deprecated packaged - they are deprecated themselves
+
+package simpb
+
+import (
+	context "context"
+	fmt "fmt"
+	router "github.com/smart-core-os/sc-bos/pkg/router"
+	grpc "google.golang.org/grpc"
+)
+
+// DriverServiceRouter is a SimDriverServiceServer that allows routing named requests to specific SimDriverServiceClient
+// Deprecated: routing is now handled dynamically by [node.Node].
+type DriverServiceRouter struct {
+	UnimplementedSimDriverServiceServer
+
+	router.Router
+}
+
+// compile time check that we implement the interface we need
+var _ SimDriverServiceServer = (*DriverServiceRouter)(nil)
+
+// NewDriverServiceRouter constructs a new empty DriverServiceRouter with the provided options.
+// Deprecated: routing is now handled dynamically by [node.Node].
+func NewDriverServiceRouter(opts ...router.Option) *DriverServiceRouter {
+	return &DriverServiceRouter{
+		Router: router.NewRouter(opts...),
+	}
+}
+
+// WithSimDriverServiceClientFactory instructs the router to create a new
+// client the first time Get is called for that name.
+func WithSimDriverServiceClientFactory(f func(name string) (SimDriverServiceClient, error)) router.Option {
+	return router.WithFactory(func(name string) (any, error) {
+		return f(name)
+	})
+}
+
+func (r *DriverServiceRouter) Register(server grpc.ServiceRegistrar) {
+	RegisterSimDriverServiceServer(server, r)
+}
+
+// Add extends Router.Add to panic if client is not of type SimDriverServiceClient.
+func (r *DriverServiceRouter) Add(name string, client any) any {
+	if !r.HoldsType(client) {
+		panic(fmt.Sprintf("not correct type: client of type %T is not a SimDriverServiceClient", client))
+	}
+	return r.Router.Add(name, client)
+}
+
+func (r *DriverServiceRouter) HoldsType(client any) bool {
+	_, ok := client.(SimDriverServiceClient)
+	return ok
+}
+
+func (r *DriverServiceRouter) AddSimDriverServiceClient(name string, client SimDriverServiceClient) SimDriverServiceClient {
+	res := r.Add(name, client)
+	if res == nil {
+		return nil
+	}
+	return res.(SimDriverServiceClient)
+}
+
+func (r *DriverServiceRouter) RemoveSimDriverServiceClient(name string) SimDriverServiceClient {
+	res := r.Remove(name)
+	if res == nil {
+		return nil
+	}
+	return res.(SimDriverServiceClient)
+}
+
+func (r *DriverServiceRouter) GetSimDriverServiceClient(name string) (SimDriverServiceClient, error) {
+	res, err := r.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(SimDriverServiceClient), nil
+}
+
+func (r *DriverServiceRouter) ListScenarios(ctx context.Context, request *ListScenariosRequest) (*ListScenariosResponse, error) {
+	child, err := r.GetSimDriverServiceClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.ListScenarios(ctx, request)
+}
+
+func (r *DriverServiceRouter) StartScenario(ctx context.Context, request *StartScenarioRequest) (*Scenario, error) {
+	child, err := r.GetSimDriverServiceClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.StartScenario(ctx, request)
+}
+
+func (r *DriverServiceRouter) StopScenario(ctx context.Context, request *StopScenarioRequest) (*Scenario, error) {
+	child, err := r.GetSimDriverServiceClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.StopScenario(ctx, request)
+}
@@ -0,0 +1,43 @@
+// Code generated by protoc-gen-wrapper. DO NOT EDIT.
+
+package simpb
+
+import (
+	wrap "github.com/smart-core-os/sc-bos/pkg/wrap"
+	grpc "google.golang.org/grpc"
+)
+
+// WrapDriverService	adapts a SimDriverServiceServer	and presents it as a SimDriverServiceClient
+// Deprecated: for client use, use [wrap.ServerToClient]; for server registration, use [github.com/smart-core-os/sc-bos/pkg/node.HasServer].
+func WrapDriverService(server SimDriverServiceServer) *DriverServiceWrapper {
+	conn := wrap.ServerToClient(SimDriverService_ServiceDesc, server)
+	client := NewSimDriverServiceClient(conn)
+	return &DriverServiceWrapper{
+		SimDriverServiceClient: client,
+		server:                 server,
+		conn:                   conn,
+		desc:                   SimDriverService_ServiceDesc,
+	}
+}
+
+type DriverServiceWrapper struct {
+	SimDriverServiceClient
+
+	server SimDriverServiceServer
+	conn   grpc.ClientConnInterface
+	desc   grpc.ServiceDesc
+}
+
+// UnwrapServer returns the underlying server instance.
+func (w *DriverServiceWrapper) UnwrapServer() SimDriverServiceServer {
+	return w.server
+}
+
+// Unwrap implements wrap.Unwrapper and returns the underlying server instance as an unknown type.
+func (w *DriverServiceWrapper) Unwrap() any {
+	return w.UnwrapServer()
+}
+
+func (w *DriverServiceWrapper) UnwrapService() (grpc.ClientConnInterface, grpc.ServiceDesc) {
+	return w.conn, w.desc
+}
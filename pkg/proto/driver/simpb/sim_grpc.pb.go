@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v7.35.1
+// source: smartcore/bos/driver/sim/v1/sim.proto
+
+package simpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SimDriverService_ListScenarios_FullMethodName = "/smartcore.bos.driver.sim.v1.SimDriverService/ListScenarios"
+	SimDriverService_StartScenario_FullMethodName = "/smartcore.bos.driver.sim.v1.SimDriverService/StartScenario"
+	SimDriverService_StopScenario_FullMethodName  = "/smartcore.bos.driver.sim.v1.SimDriverService/StopScenario"
+)
+
+// SimDriverServiceClient is the client API for SimDriverService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SimDriverService controls the scripted scenarios of a sim driver.
+// Scenarios are defined in the driver config; this service starts and stops them.
+type SimDriverServiceClient interface {
+	// List the scenarios configured on the driver and whether they are running.
+	ListScenarios(ctx context.Context, in *ListScenariosRequest, opts ...grpc.CallOption) (*ListScenariosResponse, error)
+	// Start a scenario. Starting a running scenario restarts it from the beginning.
+	StartScenario(ctx context.Context, in *StartScenarioRequest, opts ...grpc.CallOption) (*Scenario, error)
+	// Stop a scenario, ending all of its events.
+	StopScenario(ctx context.Context, in *StopScenarioRequest, opts ...grpc.CallOption) (*Scenario, error)
+}
+
+type simDriverServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSimDriverServiceClient(cc grpc.ClientConnInterface) SimDriverServiceClient {
+	return &simDriverServiceClient{cc}
+}
+
+func (c *simDriverServiceClient) ListScenarios(ctx context.Context, in *ListScenariosRequest, opts ...grpc.CallOption) (*ListScenariosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScenariosResponse)
+	err := c.cc.Invoke(ctx, SimDriverService_ListScenarios_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simDriverServiceClient) StartScenario(ctx context.Context, in *StartScenarioRequest, opts ...grpc.CallOption) (*Scenario, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scenario)
+	err := c.cc.Invoke(ctx, SimDriverService_StartScenario_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simDriverServiceClient) StopScenario(ctx context.Context, in *StopScenarioRequest, opts ...grpc.CallOption) (*Scenario, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scenario)
+	err := c.cc.Invoke(ctx, SimDriverService_StopScenario_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SimDriverServiceServer is the server API for SimDriverService service.
+// All implementations must embed UnimplementedSimDriverServiceServer
+// for forward compatibility.
+//
+// SimDriverService controls the scripted scenarios of a sim driver.
+// Scenarios are defined in the driver config; this service starts and stops them.
+type SimDriverServiceServer interface {
+	// List the scenarios configured on the driver and whether they are running.
+	ListScenarios(context.Context, *ListScenariosRequest) (*ListScenariosResponse, error)
+	// Start a scenario. Starting a running scenario restarts it from the beginning.
+	StartScenario(context.Context, *StartScenarioRequest) (*Scenario, error)
+	// Stop a scenario, ending all of its events.
+	StopScenario(context.Context, *StopScenarioRequest) (*Scenario, error)
+	mustEmbedUnimplementedSimDriverServiceServer()
+}
+
+// UnimplementedSimDriverServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSimDriverServiceServer struct{}
+
+func (UnimplementedSimDriverServiceServer) ListScenarios(context.Context, *ListScenariosRequest) (*ListScenariosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScenarios not implemented")
+}
+func (UnimplementedSimDriverServiceServer) StartScenario(context.Context, *StartScenarioRequest) (*Scenario, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartScenario not implemented")
+}
+func (UnimplementedSimDriverServiceServer) StopScenario(context.Context, *StopScenarioRequest) (*Scenario, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopScenario not implemented")
+}
+func (UnimplementedSimDriverServiceServer) mustEmbedUnimplementedSimDriverServiceServer() {}
+func (UnimplementedSimDriverServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeSimDriverServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SimDriverServiceServer will
+// result in compilation errors.
+type UnsafeSimDriverServiceServer interface {
+	mustEmbedUnimplementedSimDriverServiceServer()
+}
+
+func RegisterSimDriverServiceServer(s grpc.ServiceRegistrar, srv SimDriverServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSimDriverServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SimDriverService_ServiceDesc, srv)
+}
+
+func _SimDriverService_ListScenarios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScenariosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimDriverServiceServer).ListScenarios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimDriverService_ListScenarios_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimDriverServiceServer).ListScenarios(ctx, req.(*ListScenariosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimDriverService_StartScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartScenarioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimDriverServiceServer).StartScenario(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimDriverService_StartScenario_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimDriverServiceServer).StartScenario(ctx, req.(*StartScenarioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SimDriverService_StopScenario_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopScenarioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimDriverServiceServer).StopScenario(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SimDriverService_StopScenario_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimDriverServiceServer).StopScenario(ctx, req.(*StopScenarioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SimDriverService_ServiceDesc is the grpc.ServiceDesc for SimDriverService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SimDriverService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "smartcore.bos.driver.sim.v1.SimDriverService",
+	HandlerType: (*SimDriverServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListScenarios",
+			Handler:    _SimDriverService_ListScenarios_Handler,
+		},
+		{
+			MethodName: "StartScenario",
+			Handler:    _SimDriverService_StartScenario_Handler,
+		},
+		{
+			MethodName: "StopScenario",
+			Handler:    _SimDriverService_StopScenario_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "smartcore/bos/driver/sim/v1/sim.proto",
+}
@@ -0,0 +1,324 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.35.1
+// source: smartcore/bos/emergencylight/v1/emergency_light_history.proto
+
+package emergencylightpb
+
+import (
+	timepb "github.com/smart-core-os/sc-bos/pkg/proto/timepb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TestResultRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TestResult    *TestResultSet         `protobuf:"bytes,1,opt,name=test_result,json=testResult,proto3" json:"test_result,omitempty"`
+	RecordTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=record_time,json=recordTime,proto3" json:"record_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestResultRecord) Reset() {
+	*x = TestResultRecord{}
+	mi := &file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestResultRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestResultRecord) ProtoMessage() {}
+
+func (x *TestResultRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestResultRecord.ProtoReflect.Descriptor instead.
+func (*TestResultRecord) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TestResultRecord) GetTestResult() *TestResultSet {
+	if x != nil {
+		return x.TestResult
+	}
+	return nil
+}
+
+func (x *TestResultRecord) GetRecordTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordTime
+	}
+	return nil
+}
+
+type ListTestResultHistoryRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Period *timepb.Period         `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	// Fields to fetch relative to the TestResultRecord type
+	ReadMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	// The maximum number of records to return.
+	// The service may return fewer than this value.
+	// If unspecified, at most 50 items will be returned.
+	// The maximum value is 1000; values above 1000 will be coerced to 1000.
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `ListTestResultHistory` call.
+	// Provide this to retrieve the subsequent page.
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Specify the order of the returned records.
+	// The default is `record_time asc` - aka oldest record first.
+	// The format is `field_name [asc|desc]`, with asc being the default.
+	// Only `record_time` is supported.
+	OrderBy       string `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTestResultHistoryRequest) Reset() {
+	*x = ListTestResultHistoryRequest{}
+	mi := &file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTestResultHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTestResultHistoryRequest) ProtoMessage() {}
+
+func (x *ListTestResultHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTestResultHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListTestResultHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListTestResultHistoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListTestResultHistoryRequest) GetPeriod() *timepb.Period {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *ListTestResultHistoryRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+func (x *ListTestResultHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTestResultHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListTestResultHistoryRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListTestResultHistoryResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TestResultRecords []*TestResultRecord    `protobuf:"bytes,1,rep,name=test_result_records,json=testResultRecords,proto3" json:"test_result_records,omitempty"`
+	// A token, which can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// If non-zero this is the total number of records matched by the query.
+	// This may be an estimate.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTestResultHistoryResponse) Reset() {
+	*x = ListTestResultHistoryResponse{}
+	mi := &file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTestResultHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTestResultHistoryResponse) ProtoMessage() {}
+
+func (x *ListTestResultHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTestResultHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListTestResultHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTestResultHistoryResponse) GetTestResultRecords() []*TestResultRecord {
+	if x != nil {
+		return x.TestResultRecords
+	}
+	return nil
+}
+
+func (x *ListTestResultHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListTestResultHistoryResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+var File_smartcore_bos_emergencylight_v1_emergency_light_history_proto protoreflect.FileDescriptor
+
+const file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_rawDesc = "" +
+	"\n" +
+	"=smartcore/bos/emergencylight/v1/emergency_light_history.proto\x12\x1fsmartcore.bos.emergencylight.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a5smartcore/bos/emergencylight/v1/emergency_light.proto\x1a(smartcore/bos/types/time/v1/period.proto\"\xa0\x01\n" +
+	"\x10TestResultRecord\x12O\n" +
+	"\vtest_result\x18\x01 \x01(\v2..smartcore.bos.emergencylight.v1.TestResultSetR\n" +
+	"testResult\x12;\n" +
+	"\vrecord_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"recordTime\"\xff\x01\n" +
+	"\x1cListTestResultHistoryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12;\n" +
+	"\x06period\x18\x02 \x01(\v2#.smartcore.bos.types.time.v1.PeriodR\x06period\x127\n" +
+	"\tread_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x12\x19\n" +
+	"\border_by\x18\x06 \x01(\tR\aorderBy\"\xc9\x01\n" +
+	"\x1dListTestResultHistoryResponse\x12a\n" +
+	"\x13test_result_records\x18\x01 \x03(\v21.smartcore.bos.emergencylight.v1.TestResultRecordR\x11testResultRecords\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize2\xb0\x01\n" +
+	"\x15EmergencyLightHistory\x12\x96\x01\n" +
+	"\x15ListTestResultHistory\x12=.smartcore.bos.emergencylight.v1.ListTestResultHistoryRequest\x1a>.smartcore.bos.emergencylight.v1.ListTestResultHistoryResponseB<Z:github.com/smart-core-os/sc-bos/pkg/proto/emergencylightpbb\x06proto3"
+
+var (
+	file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_rawDescOnce sync.Once
+	file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_rawDescData []byte
+)
+
+func file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_rawDescGZIP() []byte {
+	file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_rawDescOnce.Do(func() {
+		file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_rawDesc), len(file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_rawDesc)))
+	})
+	return file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_rawDescData
+}
+
+var file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_goTypes = []any{
+	(*TestResultRecord)(nil),              // 0: smartcore.bos.emergencylight.v1.TestResultRecord
+	(*ListTestResultHistoryRequest)(nil),  // 1: smartcore.bos.emergencylight.v1.ListTestResultHistoryRequest
+	(*ListTestResultHistoryResponse)(nil), // 2: smartcore.bos.emergencylight.v1.ListTestResultHistoryResponse
+	(*TestResultSet)(nil),                 // 3: smartcore.bos.emergencylight.v1.TestResultSet
+	(*timestamppb.Timestamp)(nil),         // 4: google.protobuf.Timestamp
+	(*timepb.Period)(nil),                 // 5: smartcore.bos.types.time.v1.Period
+	(*fieldmaskpb.FieldMask)(nil),         // 6: google.protobuf.FieldMask
+}
+var file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_depIdxs = []int32{
+	3, // 0: smartcore.bos.emergencylight.v1.TestResultRecord.test_result:type_name -> smartcore.bos.emergencylight.v1.TestResultSet
+	4, // 1: smartcore.bos.emergencylight.v1.TestResultRecord.record_time:type_name -> google.protobuf.Timestamp
+	5, // 2: smartcore.bos.emergencylight.v1.ListTestResultHistoryRequest.period:type_name -> smartcore.bos.types.time.v1.Period
+	6, // 3: smartcore.bos.emergencylight.v1.ListTestResultHistoryRequest.read_mask:type_name -> google.protobuf.FieldMask
+	0, // 4: smartcore.bos.emergencylight.v1.ListTestResultHistoryResponse.test_result_records:type_name -> smartcore.bos.emergencylight.v1.TestResultRecord
+	1, // 5: smartcore.bos.emergencylight.v1.EmergencyLightHistory.ListTestResultHistory:input_type -> smartcore.bos.emergencylight.v1.ListTestResultHistoryRequest
+	2, // 6: smartcore.bos.emergencylight.v1.EmergencyLightHistory.ListTestResultHistory:output_type -> smartcore.bos.emergencylight.v1.ListTestResultHistoryResponse
+	6, // [6:7] is the sub-list for method output_type
+	5, // [5:6] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_init() }
+func file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_init() {
+	if File_smartcore_bos_emergencylight_v1_emergency_light_history_proto != nil {
+		return
+	}
+	file_smartcore_bos_emergencylight_v1_emergency_light_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_rawDesc), len(file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_goTypes,
+		DependencyIndexes: file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_depIdxs,
+		MessageInfos:      file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_msgTypes,
+	}.Build()
+	File_smartcore_bos_emergencylight_v1_emergency_light_history_proto = out.File
+	file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_goTypes = nil
+	file_smartcore_bos_emergencylight_v1_emergency_light_history_proto_depIdxs = nil
+}
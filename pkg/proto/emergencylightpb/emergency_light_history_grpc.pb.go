@@ -0,0 +1,125 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v7.35.1
+// source: smartcore/bos/emergencylight/v1/emergency_light_history.proto
+
+package emergencylightpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EmergencyLightHistory_ListTestResultHistory_FullMethodName = "/smartcore.bos.emergencylight.v1.EmergencyLightHistory/ListTestResultHistory"
+)
+
+// EmergencyLightHistoryClient is the client API for EmergencyLightHistory service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// EmergencyLightHistory provides access to historical test results for smartcore.bos.EmergencyLight resources.
+type EmergencyLightHistoryClient interface {
+	ListTestResultHistory(ctx context.Context, in *ListTestResultHistoryRequest, opts ...grpc.CallOption) (*ListTestResultHistoryResponse, error)
+}
+
+type emergencyLightHistoryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEmergencyLightHistoryClient(cc grpc.ClientConnInterface) EmergencyLightHistoryClient {
+	return &emergencyLightHistoryClient{cc}
+}
+
+func (c *emergencyLightHistoryClient) ListTestResultHistory(ctx context.Context, in *ListTestResultHistoryRequest, opts ...grpc.CallOption) (*ListTestResultHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTestResultHistoryResponse)
+	err := c.cc.Invoke(ctx, EmergencyLightHistory_ListTestResultHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EmergencyLightHistoryServer is the server API for EmergencyLightHistory service.
+// All implementations must embed UnimplementedEmergencyLightHistoryServer
+// for forward compatibility.
+//
+// EmergencyLightHistory provides access to historical test results for smartcore.bos.EmergencyLight resources.
+type EmergencyLightHistoryServer interface {
+	ListTestResultHistory(context.Context, *ListTestResultHistoryRequest) (*ListTestResultHistoryResponse, error)
+	mustEmbedUnimplementedEmergencyLightHistoryServer()
+}
+
+// UnimplementedEmergencyLightHistoryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEmergencyLightHistoryServer struct{}
+
+func (UnimplementedEmergencyLightHistoryServer) ListTestResultHistory(context.Context, *ListTestResultHistoryRequest) (*ListTestResultHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTestResultHistory not implemented")
+}
+func (UnimplementedEmergencyLightHistoryServer) mustEmbedUnimplementedEmergencyLightHistoryServer() {}
+func (UnimplementedEmergencyLightHistoryServer) testEmbeddedByValue()                               {}
+
+// UnsafeEmergencyLightHistoryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EmergencyLightHistoryServer will
+// result in compilation errors.
+type UnsafeEmergencyLightHistoryServer interface {
+	mustEmbedUnimplementedEmergencyLightHistoryServer()
+}
+
+func RegisterEmergencyLightHistoryServer(s grpc.ServiceRegistrar, srv EmergencyLightHistoryServer) {
+	// If the following call pancis, it indicates UnimplementedEmergencyLightHistoryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EmergencyLightHistory_ServiceDesc, srv)
+}
+
+func _EmergencyLightHistory_ListTestResultHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTestResultHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyLightHistoryServer).ListTestResultHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmergencyLightHistory_ListTestResultHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyLightHistoryServer).ListTestResultHistory(ctx, req.(*ListTestResultHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EmergencyLightHistory_ServiceDesc is the grpc.ServiceDesc for EmergencyLightHistory service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EmergencyLightHistory_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "smartcore.bos.emergencylight.v1.EmergencyLightHistory",
+	HandlerType: (*EmergencyLightHistoryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTestResultHistory",
+			Handler:    _EmergencyLightHistory_ListTestResultHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "smartcore/bos/emergencylight/v1/emergency_light_history.proto",
+}
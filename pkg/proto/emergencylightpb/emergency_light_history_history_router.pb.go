@@ -0,0 +1,91 @@
+// Code generated by protoc-gen-router. DO NOT EDIT.
+//lint:file-ignore SA1019 Routers intentionally use a deprecated packaged - they are deprecated themselves
+
+package emergencylightpb
+
+import (
+	context "context"
+	fmt "fmt"
+	router "github.com/smart-core-os/sc-bos/pkg/router"
+	grpc "google.golang.org/grpc"
+)
+
+// HistoryRouter is a EmergencyLightHistoryServer that allows routing named requests to specific EmergencyLightHistoryClient
+// Deprecated: routing is now handled dynamically by [node.Node].
+type HistoryRouter struct {
+	UnimplementedEmergencyLightHistoryServer
+
+	router.Router
+}
+
+// compile time check that we implement the interface we need
+var _ EmergencyLightHistoryServer = (*HistoryRouter)(nil)
+
+// NewHistoryRouter constructs a new empty HistoryRouter with the provided options.
+// Deprecated: routing is now handled dynamically by [node.Node].
+func NewHistoryRouter(opts ...router.Option) *HistoryRouter {
+	return &HistoryRouter{
+		Router: router.NewRouter(opts...),
+	}
+}
+
+// WithEmergencyLightHistoryClientFactory instructs the router to create a new
+// client the first time Get is called for that name.
+func WithEmergencyLightHistoryClientFactory(f func(name string) (EmergencyLightHistoryClient, error)) router.Option {
+	return router.WithFactory(func(name string) (any, error) {
+		return f(name)
+	})
+}
+
+func (r *HistoryRouter) Register(server grpc.ServiceRegistrar) {
+	RegisterEmergencyLightHistoryServer(server, r)
+}
+
+// Add extends Router.Add to panic if client is not of type EmergencyLightHistoryClient.
+func (r *HistoryRouter) Add(name string, client any) any {
+	if !r.HoldsType(client) {
+		panic(fmt.Sprintf("not correct type: client of type %T is not a EmergencyLightHistoryClient", client))
+	}
+	return r.Router.Add(name, client)
+}
+
+func (r *HistoryRouter) HoldsType(client any) bool {
+	_, ok := client.(EmergencyLightHistoryClient)
+	return ok
+}
+
+func (r *HistoryRouter) AddEmergencyLightHistoryClient(name string, client EmergencyLightHistoryClient) EmergencyLightHistoryClient {
+	res := r.Add(name, client)
+	if res == nil {
+		return nil
+	}
+	return res.(EmergencyLightHistoryClient)
+}
+
+func (r *HistoryRouter) RemoveEmergencyLightHistoryClient(name string) EmergencyLightHistoryClient {
+	res := r.Remove(name)
+	if res == nil {
+		return nil
+	}
+	return res.(EmergencyLightHistoryClient)
+}
+
+func (r *HistoryRouter) GetEmergencyLightHistoryClient(name string) (EmergencyLightHistoryClient, error) {
+	res, err := r.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(EmergencyLightHistoryClient), nil
+}
+
+func (r *HistoryRouter) ListTestResultHistory(ctx context.Context, request *ListTestResultHistoryRequest) (*ListTestResultHistoryResponse, error) {
+	child, err := r.GetEmergencyLightHistoryClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.ListTestResultHistory(ctx, request)
+}
@@ -0,0 +1,43 @@
+// Code generated by protoc-gen-wrapper. DO NOT EDIT.
+
+package emergencylightpb
+
+import (
+	wrap "github.com/smart-core-os/sc-bos/pkg/wrap"
+	grpc "google.golang.org/grpc"
+)
+
+// WrapHistory	adapts a EmergencyLightHistoryServer	and presents it as a EmergencyLightHistoryClient
+// Deprecated: for client use, use [wrap.ServerToClient]; for server registration, use [github.com/smart-core-os/sc-bos/pkg/node.HasServer].
+func WrapHistory(server EmergencyLightHistoryServer) *HistoryWrapper {
+	conn := wrap.ServerToClient(EmergencyLightHistory_ServiceDesc, server)
+	client := NewEmergencyLightHistoryClient(conn)
+	return &HistoryWrapper{
+		EmergencyLightHistoryClient: client,
+		server:                      server,
+		conn:                        conn,
+		desc:                        EmergencyLightHistory_ServiceDesc,
+	}
+}
+
+type HistoryWrapper struct {
+	EmergencyLightHistoryClient
+
+	server EmergencyLightHistoryServer
+	conn   grpc.ClientConnInterface
+	desc   grpc.ServiceDesc
+}
+
+// UnwrapServer returns the underlying server instance.
+func (w *HistoryWrapper) UnwrapServer() EmergencyLightHistoryServer {
+	return w.server
+}
+
+// Unwrap implements wrap.Unwrapper and returns the underlying server instance as an unknown type.
+func (w *HistoryWrapper) Unwrap() any {
+	return w.UnwrapServer()
+}
+
+func (w *HistoryWrapper) UnwrapService() (grpc.ClientConnInterface, grpc.ServiceDesc) {
+	return w.conn, w.desc
+}
@@ -410,6 +410,10 @@ type HealthCheck struct {
 	NormalTime *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=normal_time,json=normalTime,proto3" json:"normal_time,omitempty"`
 	// The time when normality last entered a non-NORMAL state.
 	AbnormalTime *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=abnormal_time,json=abnormalTime,proto3" json:"abnormal_time,omitempty"`
+	// Present while the device is in a maintenance window.
+	// The device is being worked on, so an abnormal normality is expected and should not be treated as a failure.
+	// Consumers that count or query for abnormal checks should exclude checks with maintenance set.
+	Maintenance *HealthCheck_Maintenance `protobuf:"bytes,24,opt,name=maintenance,proto3" json:"maintenance,omitempty"`
 	// Details about the check being performed.
 	// Optional, but strongly recommended.
 	// HealthChecks should not change their type of check after creation.
@@ -530,6 +534,13 @@ func (x *HealthCheck) GetAbnormalTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *HealthCheck) GetMaintenance() *HealthCheck_Maintenance {
+	if x != nil {
+		return x.Maintenance
+	}
+	return nil
+}
+
 func (x *HealthCheck) GetCheck() isHealthCheck_Check {
 	if x != nil {
 		return x.Check
@@ -1501,6 +1512,70 @@ func (x *HealthCheck_Values) GetValues() []*HealthCheck_Value {
 	return nil
 }
 
+// Maintenance describes the maintenance window the device of a check is in.
+type HealthCheck_Maintenance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The id of the window, see smartcore.bos.maintenance.v1.MaintenanceApi.
+	WindowId string `protobuf:"bytes,1,opt,name=window_id,json=windowId,proto3" json:"window_id,omitempty"`
+	// Why the device is being worked on.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// When the window ends, absent if it doesn't end until it is deleted.
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheck_Maintenance) Reset() {
+	*x = HealthCheck_Maintenance{}
+	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheck_Maintenance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheck_Maintenance) ProtoMessage() {}
+
+func (x *HealthCheck_Maintenance) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheck_Maintenance.ProtoReflect.Descriptor instead.
+func (*HealthCheck_Maintenance) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_health_v1_health_proto_rawDescGZIP(), []int{0, 6}
+}
+
+func (x *HealthCheck_Maintenance) GetWindowId() string {
+	if x != nil {
+		return x.WindowId
+	}
+	return ""
+}
+
+func (x *HealthCheck_Maintenance) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *HealthCheck_Maintenance) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
 // Bounds describes a check that compares a measured value against expected values or ranges.
 type HealthCheck_Bounds struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1523,7 +1598,7 @@ type HealthCheck_Bounds struct {
 
 func (x *HealthCheck_Bounds) Reset() {
 	*x = HealthCheck_Bounds{}
-	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[14]
+	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1535,7 +1610,7 @@ func (x *HealthCheck_Bounds) String() string {
 func (*HealthCheck_Bounds) ProtoMessage() {}
 
 func (x *HealthCheck_Bounds) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[14]
+	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1548,7 +1623,7 @@ func (x *HealthCheck_Bounds) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheck_Bounds.ProtoReflect.Descriptor instead.
 func (*HealthCheck_Bounds) Descriptor() ([]byte, []int) {
-	return file_smartcore_bos_health_v1_health_proto_rawDescGZIP(), []int{0, 6}
+	return file_smartcore_bos_health_v1_health_proto_rawDescGZIP(), []int{0, 7}
 }
 
 func (x *HealthCheck_Bounds) GetCurrentValue() *HealthCheck_Value {
@@ -1676,7 +1751,7 @@ type HealthCheck_Faults struct {
 
 func (x *HealthCheck_Faults) Reset() {
 	*x = HealthCheck_Faults{}
-	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[15]
+	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1688,7 +1763,7 @@ func (x *HealthCheck_Faults) String() string {
 func (*HealthCheck_Faults) ProtoMessage() {}
 
 func (x *HealthCheck_Faults) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[15]
+	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1701,7 +1776,7 @@ func (x *HealthCheck_Faults) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheck_Faults.ProtoReflect.Descriptor instead.
 func (*HealthCheck_Faults) Descriptor() ([]byte, []int) {
-	return file_smartcore_bos_health_v1_health_proto_rawDescGZIP(), []int{0, 7}
+	return file_smartcore_bos_health_v1_health_proto_rawDescGZIP(), []int{0, 8}
 }
 
 func (x *HealthCheck_Faults) GetCurrentFaults() []*HealthCheck_Error {
@@ -1738,7 +1813,7 @@ type HealthCheck_ComplianceImpact_Standard struct {
 
 func (x *HealthCheck_ComplianceImpact_Standard) Reset() {
 	*x = HealthCheck_ComplianceImpact_Standard{}
-	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[16]
+	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1750,7 +1825,7 @@ func (x *HealthCheck_ComplianceImpact_Standard) String() string {
 func (*HealthCheck_ComplianceImpact_Standard) ProtoMessage() {}
 
 func (x *HealthCheck_ComplianceImpact_Standard) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[16]
+	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1818,7 +1893,7 @@ type HealthCheck_Error_Code struct {
 
 func (x *HealthCheck_Error_Code) Reset() {
 	*x = HealthCheck_Error_Code{}
-	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[17]
+	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1830,7 +1905,7 @@ func (x *HealthCheck_Error_Code) String() string {
 func (*HealthCheck_Error_Code) ProtoMessage() {}
 
 func (x *HealthCheck_Error_Code) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[17]
+	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1878,7 +1953,7 @@ type HealthCheck_Reliability_Cause struct {
 
 func (x *HealthCheck_Reliability_Cause) Reset() {
 	*x = HealthCheck_Reliability_Cause{}
-	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[18]
+	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1890,7 +1965,7 @@ func (x *HealthCheck_Reliability_Cause) String() string {
 func (*HealthCheck_Reliability_Cause) ProtoMessage() {}
 
 func (x *HealthCheck_Reliability_Cause) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[18]
+	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1940,7 +2015,7 @@ type HealthCheck_Reliability_Effects struct {
 
 func (x *HealthCheck_Reliability_Effects) Reset() {
 	*x = HealthCheck_Reliability_Effects{}
-	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[19]
+	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1952,7 +2027,7 @@ func (x *HealthCheck_Reliability_Effects) String() string {
 func (*HealthCheck_Reliability_Effects) ProtoMessage() {}
 
 func (x *HealthCheck_Reliability_Effects) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[19]
+	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1993,7 +2068,7 @@ type PullHealthChecksResponse_Change struct {
 
 func (x *PullHealthChecksResponse_Change) Reset() {
 	*x = PullHealthChecksResponse_Change{}
-	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[20]
+	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2005,7 +2080,7 @@ func (x *PullHealthChecksResponse_Change) String() string {
 func (*PullHealthChecksResponse_Change) ProtoMessage() {}
 
 func (x *PullHealthChecksResponse_Change) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[20]
+	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2070,7 +2145,7 @@ type PullHealthCheckResponse_Change struct {
 
 func (x *PullHealthCheckResponse_Change) Reset() {
 	*x = PullHealthCheckResponse_Change{}
-	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[21]
+	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2082,7 +2157,7 @@ func (x *PullHealthCheckResponse_Change) String() string {
 func (*PullHealthCheckResponse_Change) ProtoMessage() {}
 
 func (x *PullHealthCheckResponse_Change) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[21]
+	mi := &file_smartcore_bos_health_v1_health_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2123,7 +2198,7 @@ var File_smartcore_bos_health_v1_health_proto protoreflect.FileDescriptor
 
 const file_smartcore_bos_health_v1_health_proto_rawDesc = "" +
 	"\n" +
-	"$smartcore/bos/health/v1/health.proto\x12\x17smartcore.bos.health.v1\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a#smartcore/bos/types/v1/change.proto\"\x90\x1f\n" +
+	"$smartcore/bos/health/v1/health.proto\x12\x17smartcore.bos.health.v1\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a#smartcore/bos/types/v1/change.proto\"\xdf \n" +
 	"\vHealthCheck\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12 \n" +
@@ -2138,7 +2213,8 @@ const file_smartcore_bos_health_v1_health_proto_rawDesc = "" +
 	"\tnormality\x18\x15 \x01(\x0e2..smartcore.bos.health.v1.HealthCheck.NormalityR\tnormality\x12;\n" +
 	"\vnormal_time\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"normalTime\x12?\n" +
-	"\rabnormal_time\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\fabnormalTime\x12E\n" +
+	"\rabnormal_time\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\fabnormalTime\x12R\n" +
+	"\vmaintenance\x18\x18 \x01(\v20.smartcore.bos.health.v1.HealthCheck.MaintenanceR\vmaintenance\x12E\n" +
 	"\x06bounds\x18\x1e \x01(\v2+.smartcore.bos.health.v1.HealthCheck.BoundsH\x00R\x06bounds\x12E\n" +
 	"\x06faults\x18\x1f \x01(\v2+.smartcore.bos.health.v1.HealthCheck.FaultsH\x00R\x06faults\x1a\xdb\x03\n" +
 	"\x10ComplianceImpact\x12Z\n" +
@@ -2207,7 +2283,11 @@ const file_smartcore_bos_health_v1_health_proto_rawDesc = "" +
 	"\x04high\x18\x02 \x01(\v2*.smartcore.bos.health.v1.HealthCheck.ValueR\x04high\x12F\n" +
 	"\bdeadband\x18\x03 \x01(\v2*.smartcore.bos.health.v1.HealthCheck.ValueR\bdeadband\x1aL\n" +
 	"\x06Values\x12B\n" +
-	"\x06values\x18\x01 \x03(\v2*.smartcore.bos.health.v1.HealthCheck.ValueR\x06values\x1a\xb0\x04\n" +
+	"\x06values\x18\x01 \x03(\v2*.smartcore.bos.health.v1.HealthCheck.ValueR\x06values\x1ay\n" +
+	"\vMaintenance\x12\x1b\n" +
+	"\twindow_id\x18\x01 \x01(\tR\bwindowId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x1a\xb0\x04\n" +
 	"\x06Bounds\x12O\n" +
 	"\rcurrent_value\x18\x01 \x01(\v2*.smartcore.bos.health.v1.HealthCheck.ValueR\fcurrentValue\x12O\n" +
 	"\fnormal_value\x18\x02 \x01(\v2*.smartcore.bos.health.v1.HealthCheck.ValueH\x00R\vnormalValue\x12S\n" +
@@ -2301,7 +2381,7 @@ func file_smartcore_bos_health_v1_health_proto_rawDescGZIP() []byte {
 }
 
 var file_smartcore_bos_health_v1_health_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_smartcore_bos_health_v1_health_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_smartcore_bos_health_v1_health_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_smartcore_bos_health_v1_health_proto_goTypes = []any{
 	(HealthCheck_OccupantImpact)(0),                // 0: smartcore.bos.health.v1.HealthCheck.OccupantImpact
 	(HealthCheck_EquipmentImpact)(0),               // 1: smartcore.bos.health.v1.HealthCheck.EquipmentImpact
@@ -2322,79 +2402,82 @@ var file_smartcore_bos_health_v1_health_proto_goTypes = []any{
 	(*HealthCheck_Value)(nil),                      // 16: smartcore.bos.health.v1.HealthCheck.Value
 	(*HealthCheck_ValueRange)(nil),                 // 17: smartcore.bos.health.v1.HealthCheck.ValueRange
 	(*HealthCheck_Values)(nil),                     // 18: smartcore.bos.health.v1.HealthCheck.Values
-	(*HealthCheck_Bounds)(nil),                     // 19: smartcore.bos.health.v1.HealthCheck.Bounds
-	(*HealthCheck_Faults)(nil),                     // 20: smartcore.bos.health.v1.HealthCheck.Faults
-	(*HealthCheck_ComplianceImpact_Standard)(nil),  // 21: smartcore.bos.health.v1.HealthCheck.ComplianceImpact.Standard
-	(*HealthCheck_Error_Code)(nil),                 // 22: smartcore.bos.health.v1.HealthCheck.Error.Code
-	(*HealthCheck_Reliability_Cause)(nil),          // 23: smartcore.bos.health.v1.HealthCheck.Reliability.Cause
-	(*HealthCheck_Reliability_Effects)(nil),        // 24: smartcore.bos.health.v1.HealthCheck.Reliability.Effects
-	(*PullHealthChecksResponse_Change)(nil),        // 25: smartcore.bos.health.v1.PullHealthChecksResponse.Change
-	(*PullHealthCheckResponse_Change)(nil),         // 26: smartcore.bos.health.v1.PullHealthCheckResponse.Change
-	(*timestamppb.Timestamp)(nil),                  // 27: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),                  // 28: google.protobuf.FieldMask
-	(*durationpb.Duration)(nil),                    // 29: google.protobuf.Duration
-	(typespb.ChangeType)(0),                        // 30: smartcore.bos.types.v1.ChangeType
+	(*HealthCheck_Maintenance)(nil),                // 19: smartcore.bos.health.v1.HealthCheck.Maintenance
+	(*HealthCheck_Bounds)(nil),                     // 20: smartcore.bos.health.v1.HealthCheck.Bounds
+	(*HealthCheck_Faults)(nil),                     // 21: smartcore.bos.health.v1.HealthCheck.Faults
+	(*HealthCheck_ComplianceImpact_Standard)(nil),  // 22: smartcore.bos.health.v1.HealthCheck.ComplianceImpact.Standard
+	(*HealthCheck_Error_Code)(nil),                 // 23: smartcore.bos.health.v1.HealthCheck.Error.Code
+	(*HealthCheck_Reliability_Cause)(nil),          // 24: smartcore.bos.health.v1.HealthCheck.Reliability.Cause
+	(*HealthCheck_Reliability_Effects)(nil),        // 25: smartcore.bos.health.v1.HealthCheck.Reliability.Effects
+	(*PullHealthChecksResponse_Change)(nil),        // 26: smartcore.bos.health.v1.PullHealthChecksResponse.Change
+	(*PullHealthCheckResponse_Change)(nil),         // 27: smartcore.bos.health.v1.PullHealthCheckResponse.Change
+	(*timestamppb.Timestamp)(nil),                  // 28: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                  // 29: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),                    // 30: google.protobuf.Duration
+	(typespb.ChangeType)(0),                        // 31: smartcore.bos.types.v1.ChangeType
 }
 var file_smartcore_bos_health_v1_health_proto_depIdxs = []int32{
-	27, // 0: smartcore.bos.health.v1.HealthCheck.create_time:type_name -> google.protobuf.Timestamp
+	28, // 0: smartcore.bos.health.v1.HealthCheck.create_time:type_name -> google.protobuf.Timestamp
 	0,  // 1: smartcore.bos.health.v1.HealthCheck.occupant_impact:type_name -> smartcore.bos.health.v1.HealthCheck.OccupantImpact
 	1,  // 2: smartcore.bos.health.v1.HealthCheck.equipment_impact:type_name -> smartcore.bos.health.v1.HealthCheck.EquipmentImpact
 	13, // 3: smartcore.bos.health.v1.HealthCheck.compliance_impacts:type_name -> smartcore.bos.health.v1.HealthCheck.ComplianceImpact
 	15, // 4: smartcore.bos.health.v1.HealthCheck.reliability:type_name -> smartcore.bos.health.v1.HealthCheck.Reliability
 	2,  // 5: smartcore.bos.health.v1.HealthCheck.normality:type_name -> smartcore.bos.health.v1.HealthCheck.Normality
-	27, // 6: smartcore.bos.health.v1.HealthCheck.normal_time:type_name -> google.protobuf.Timestamp
-	27, // 7: smartcore.bos.health.v1.HealthCheck.abnormal_time:type_name -> google.protobuf.Timestamp
-	19, // 8: smartcore.bos.health.v1.HealthCheck.bounds:type_name -> smartcore.bos.health.v1.HealthCheck.Bounds
-	20, // 9: smartcore.bos.health.v1.HealthCheck.faults:type_name -> smartcore.bos.health.v1.HealthCheck.Faults
-	28, // 10: smartcore.bos.health.v1.ListHealthChecksRequest.read_mask:type_name -> google.protobuf.FieldMask
-	5,  // 11: smartcore.bos.health.v1.ListHealthChecksResponse.health_checks:type_name -> smartcore.bos.health.v1.HealthCheck
-	28, // 12: smartcore.bos.health.v1.PullHealthChecksRequest.read_mask:type_name -> google.protobuf.FieldMask
-	25, // 13: smartcore.bos.health.v1.PullHealthChecksResponse.changes:type_name -> smartcore.bos.health.v1.PullHealthChecksResponse.Change
-	28, // 14: smartcore.bos.health.v1.GetHealthCheckRequest.read_mask:type_name -> google.protobuf.FieldMask
-	28, // 15: smartcore.bos.health.v1.PullHealthCheckRequest.read_mask:type_name -> google.protobuf.FieldMask
-	26, // 16: smartcore.bos.health.v1.PullHealthCheckResponse.changes:type_name -> smartcore.bos.health.v1.PullHealthCheckResponse.Change
-	21, // 17: smartcore.bos.health.v1.HealthCheck.ComplianceImpact.standard:type_name -> smartcore.bos.health.v1.HealthCheck.ComplianceImpact.Standard
-	3,  // 18: smartcore.bos.health.v1.HealthCheck.ComplianceImpact.contribution:type_name -> smartcore.bos.health.v1.HealthCheck.ComplianceImpact.Contribution
-	22, // 19: smartcore.bos.health.v1.HealthCheck.Error.code:type_name -> smartcore.bos.health.v1.HealthCheck.Error.Code
-	4,  // 20: smartcore.bos.health.v1.HealthCheck.Reliability.state:type_name -> smartcore.bos.health.v1.HealthCheck.Reliability.State
-	27, // 21: smartcore.bos.health.v1.HealthCheck.Reliability.reliable_time:type_name -> google.protobuf.Timestamp
-	27, // 22: smartcore.bos.health.v1.HealthCheck.Reliability.unreliable_time:type_name -> google.protobuf.Timestamp
-	14, // 23: smartcore.bos.health.v1.HealthCheck.Reliability.last_error:type_name -> smartcore.bos.health.v1.HealthCheck.Error
-	23, // 24: smartcore.bos.health.v1.HealthCheck.Reliability.cause:type_name -> smartcore.bos.health.v1.HealthCheck.Reliability.Cause
-	24, // 25: smartcore.bos.health.v1.HealthCheck.Reliability.effects:type_name -> smartcore.bos.health.v1.HealthCheck.Reliability.Effects
-	27, // 26: smartcore.bos.health.v1.HealthCheck.Value.timestamp_value:type_name -> google.protobuf.Timestamp
-	29, // 27: smartcore.bos.health.v1.HealthCheck.Value.duration_value:type_name -> google.protobuf.Duration
-	16, // 28: smartcore.bos.health.v1.HealthCheck.ValueRange.low:type_name -> smartcore.bos.health.v1.HealthCheck.Value
-	16, // 29: smartcore.bos.health.v1.HealthCheck.ValueRange.high:type_name -> smartcore.bos.health.v1.HealthCheck.Value
-	16, // 30: smartcore.bos.health.v1.HealthCheck.ValueRange.deadband:type_name -> smartcore.bos.health.v1.HealthCheck.Value
-	16, // 31: smartcore.bos.health.v1.HealthCheck.Values.values:type_name -> smartcore.bos.health.v1.HealthCheck.Value
-	16, // 32: smartcore.bos.health.v1.HealthCheck.Bounds.current_value:type_name -> smartcore.bos.health.v1.HealthCheck.Value
-	16, // 33: smartcore.bos.health.v1.HealthCheck.Bounds.normal_value:type_name -> smartcore.bos.health.v1.HealthCheck.Value
-	16, // 34: smartcore.bos.health.v1.HealthCheck.Bounds.abnormal_value:type_name -> smartcore.bos.health.v1.HealthCheck.Value
-	17, // 35: smartcore.bos.health.v1.HealthCheck.Bounds.normal_range:type_name -> smartcore.bos.health.v1.HealthCheck.ValueRange
-	18, // 36: smartcore.bos.health.v1.HealthCheck.Bounds.normal_values:type_name -> smartcore.bos.health.v1.HealthCheck.Values
-	18, // 37: smartcore.bos.health.v1.HealthCheck.Bounds.abnormal_values:type_name -> smartcore.bos.health.v1.HealthCheck.Values
-	14, // 38: smartcore.bos.health.v1.HealthCheck.Faults.current_faults:type_name -> smartcore.bos.health.v1.HealthCheck.Error
-	14, // 39: smartcore.bos.health.v1.HealthCheck.Reliability.Cause.error:type_name -> smartcore.bos.health.v1.HealthCheck.Error
-	30, // 40: smartcore.bos.health.v1.PullHealthChecksResponse.Change.type:type_name -> smartcore.bos.types.v1.ChangeType
-	5,  // 41: smartcore.bos.health.v1.PullHealthChecksResponse.Change.new_value:type_name -> smartcore.bos.health.v1.HealthCheck
-	5,  // 42: smartcore.bos.health.v1.PullHealthChecksResponse.Change.old_value:type_name -> smartcore.bos.health.v1.HealthCheck
-	27, // 43: smartcore.bos.health.v1.PullHealthChecksResponse.Change.change_time:type_name -> google.protobuf.Timestamp
-	5,  // 44: smartcore.bos.health.v1.PullHealthCheckResponse.Change.health_check:type_name -> smartcore.bos.health.v1.HealthCheck
-	27, // 45: smartcore.bos.health.v1.PullHealthCheckResponse.Change.change_time:type_name -> google.protobuf.Timestamp
-	6,  // 46: smartcore.bos.health.v1.HealthApi.ListHealthChecks:input_type -> smartcore.bos.health.v1.ListHealthChecksRequest
-	8,  // 47: smartcore.bos.health.v1.HealthApi.PullHealthChecks:input_type -> smartcore.bos.health.v1.PullHealthChecksRequest
-	10, // 48: smartcore.bos.health.v1.HealthApi.GetHealthCheck:input_type -> smartcore.bos.health.v1.GetHealthCheckRequest
-	11, // 49: smartcore.bos.health.v1.HealthApi.PullHealthCheck:input_type -> smartcore.bos.health.v1.PullHealthCheckRequest
-	7,  // 50: smartcore.bos.health.v1.HealthApi.ListHealthChecks:output_type -> smartcore.bos.health.v1.ListHealthChecksResponse
-	9,  // 51: smartcore.bos.health.v1.HealthApi.PullHealthChecks:output_type -> smartcore.bos.health.v1.PullHealthChecksResponse
-	5,  // 52: smartcore.bos.health.v1.HealthApi.GetHealthCheck:output_type -> smartcore.bos.health.v1.HealthCheck
-	12, // 53: smartcore.bos.health.v1.HealthApi.PullHealthCheck:output_type -> smartcore.bos.health.v1.PullHealthCheckResponse
-	50, // [50:54] is the sub-list for method output_type
-	46, // [46:50] is the sub-list for method input_type
-	46, // [46:46] is the sub-list for extension type_name
-	46, // [46:46] is the sub-list for extension extendee
-	0,  // [0:46] is the sub-list for field type_name
+	28, // 6: smartcore.bos.health.v1.HealthCheck.normal_time:type_name -> google.protobuf.Timestamp
+	28, // 7: smartcore.bos.health.v1.HealthCheck.abnormal_time:type_name -> google.protobuf.Timestamp
+	19, // 8: smartcore.bos.health.v1.HealthCheck.maintenance:type_name -> smartcore.bos.health.v1.HealthCheck.Maintenance
+	20, // 9: smartcore.bos.health.v1.HealthCheck.bounds:type_name -> smartcore.bos.health.v1.HealthCheck.Bounds
+	21, // 10: smartcore.bos.health.v1.HealthCheck.faults:type_name -> smartcore.bos.health.v1.HealthCheck.Faults
+	29, // 11: smartcore.bos.health.v1.ListHealthChecksRequest.read_mask:type_name -> google.protobuf.FieldMask
+	5,  // 12: smartcore.bos.health.v1.ListHealthChecksResponse.health_checks:type_name -> smartcore.bos.health.v1.HealthCheck
+	29, // 13: smartcore.bos.health.v1.PullHealthChecksRequest.read_mask:type_name -> google.protobuf.FieldMask
+	26, // 14: smartcore.bos.health.v1.PullHealthChecksResponse.changes:type_name -> smartcore.bos.health.v1.PullHealthChecksResponse.Change
+	29, // 15: smartcore.bos.health.v1.GetHealthCheckRequest.read_mask:type_name -> google.protobuf.FieldMask
+	29, // 16: smartcore.bos.health.v1.PullHealthCheckRequest.read_mask:type_name -> google.protobuf.FieldMask
+	27, // 17: smartcore.bos.health.v1.PullHealthCheckResponse.changes:type_name -> smartcore.bos.health.v1.PullHealthCheckResponse.Change
+	22, // 18: smartcore.bos.health.v1.HealthCheck.ComplianceImpact.standard:type_name -> smartcore.bos.health.v1.HealthCheck.ComplianceImpact.Standard
+	3,  // 19: smartcore.bos.health.v1.HealthCheck.ComplianceImpact.contribution:type_name -> smartcore.bos.health.v1.HealthCheck.ComplianceImpact.Contribution
+	23, // 20: smartcore.bos.health.v1.HealthCheck.Error.code:type_name -> smartcore.bos.health.v1.HealthCheck.Error.Code
+	4,  // 21: smartcore.bos.health.v1.HealthCheck.Reliability.state:type_name -> smartcore.bos.health.v1.HealthCheck.Reliability.State
+	28, // 22: smartcore.bos.health.v1.HealthCheck.Reliability.reliable_time:type_name -> google.protobuf.Timestamp
+	28, // 23: smartcore.bos.health.v1.HealthCheck.Reliability.unreliable_time:type_name -> google.protobuf.Timestamp
+	14, // 24: smartcore.bos.health.v1.HealthCheck.Reliability.last_error:type_name -> smartcore.bos.health.v1.HealthCheck.Error
+	24, // 25: smartcore.bos.health.v1.HealthCheck.Reliability.cause:type_name -> smartcore.bos.health.v1.HealthCheck.Reliability.Cause
+	25, // 26: smartcore.bos.health.v1.HealthCheck.Reliability.effects:type_name -> smartcore.bos.health.v1.HealthCheck.Reliability.Effects
+	28, // 27: smartcore.bos.health.v1.HealthCheck.Value.timestamp_value:type_name -> google.protobuf.Timestamp
+	30, // 28: smartcore.bos.health.v1.HealthCheck.Value.duration_value:type_name -> google.protobuf.Duration
+	16, // 29: smartcore.bos.health.v1.HealthCheck.ValueRange.low:type_name -> smartcore.bos.health.v1.HealthCheck.Value
+	16, // 30: smartcore.bos.health.v1.HealthCheck.ValueRange.high:type_name -> smartcore.bos.health.v1.HealthCheck.Value
+	16, // 31: smartcore.bos.health.v1.HealthCheck.ValueRange.deadband:type_name -> smartcore.bos.health.v1.HealthCheck.Value
+	16, // 32: smartcore.bos.health.v1.HealthCheck.Values.values:type_name -> smartcore.bos.health.v1.HealthCheck.Value
+	28, // 33: smartcore.bos.health.v1.HealthCheck.Maintenance.end_time:type_name -> google.protobuf.Timestamp
+	16, // 34: smartcore.bos.health.v1.HealthCheck.Bounds.current_value:type_name -> smartcore.bos.health.v1.HealthCheck.Value
+	16, // 35: smartcore.bos.health.v1.HealthCheck.Bounds.normal_value:type_name -> smartcore.bos.health.v1.HealthCheck.Value
+	16, // 36: smartcore.bos.health.v1.HealthCheck.Bounds.abnormal_value:type_name -> smartcore.bos.health.v1.HealthCheck.Value
+	17, // 37: smartcore.bos.health.v1.HealthCheck.Bounds.normal_range:type_name -> smartcore.bos.health.v1.HealthCheck.ValueRange
+	18, // 38: smartcore.bos.health.v1.HealthCheck.Bounds.normal_values:type_name -> smartcore.bos.health.v1.HealthCheck.Values
+	18, // 39: smartcore.bos.health.v1.HealthCheck.Bounds.abnormal_values:type_name -> smartcore.bos.health.v1.HealthCheck.Values
+	14, // 40: smartcore.bos.health.v1.HealthCheck.Faults.current_faults:type_name -> smartcore.bos.health.v1.HealthCheck.Error
+	14, // 41: smartcore.bos.health.v1.HealthCheck.Reliability.Cause.error:type_name -> smartcore.bos.health.v1.HealthCheck.Error
+	31, // 42: smartcore.bos.health.v1.PullHealthChecksResponse.Change.type:type_name -> smartcore.bos.types.v1.ChangeType
+	5,  // 43: smartcore.bos.health.v1.PullHealthChecksResponse.Change.new_value:type_name -> smartcore.bos.health.v1.HealthCheck
+	5,  // 44: smartcore.bos.health.v1.PullHealthChecksResponse.Change.old_value:type_name -> smartcore.bos.health.v1.HealthCheck
+	28, // 45: smartcore.bos.health.v1.PullHealthChecksResponse.Change.change_time:type_name -> google.protobuf.Timestamp
+	5,  // 46: smartcore.bos.health.v1.PullHealthCheckResponse.Change.health_check:type_name -> smartcore.bos.health.v1.HealthCheck
+	28, // 47: smartcore.bos.health.v1.PullHealthCheckResponse.Change.change_time:type_name -> google.protobuf.Timestamp
+	6,  // 48: smartcore.bos.health.v1.HealthApi.ListHealthChecks:input_type -> smartcore.bos.health.v1.ListHealthChecksRequest
+	8,  // 49: smartcore.bos.health.v1.HealthApi.PullHealthChecks:input_type -> smartcore.bos.health.v1.PullHealthChecksRequest
+	10, // 50: smartcore.bos.health.v1.HealthApi.GetHealthCheck:input_type -> smartcore.bos.health.v1.GetHealthCheckRequest
+	11, // 51: smartcore.bos.health.v1.HealthApi.PullHealthCheck:input_type -> smartcore.bos.health.v1.PullHealthCheckRequest
+	7,  // 52: smartcore.bos.health.v1.HealthApi.ListHealthChecks:output_type -> smartcore.bos.health.v1.ListHealthChecksResponse
+	9,  // 53: smartcore.bos.health.v1.HealthApi.PullHealthChecks:output_type -> smartcore.bos.health.v1.PullHealthChecksResponse
+	5,  // 54: smartcore.bos.health.v1.HealthApi.GetHealthCheck:output_type -> smartcore.bos.health.v1.HealthCheck
+	12, // 55: smartcore.bos.health.v1.HealthApi.PullHealthCheck:output_type -> smartcore.bos.health.v1.PullHealthCheckResponse
+	52, // [52:56] is the sub-list for method output_type
+	48, // [48:52] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_smartcore_bos_health_v1_health_proto_init() }
@@ -2415,7 +2498,7 @@ func file_smartcore_bos_health_v1_health_proto_init() {
 		(*HealthCheck_Value_TimestampValue)(nil),
 		(*HealthCheck_Value_DurationValue)(nil),
 	}
-	file_smartcore_bos_health_v1_health_proto_msgTypes[14].OneofWrappers = []any{
+	file_smartcore_bos_health_v1_health_proto_msgTypes[15].OneofWrappers = []any{
 		(*HealthCheck_Bounds_NormalValue)(nil),
 		(*HealthCheck_Bounds_AbnormalValue)(nil),
 		(*HealthCheck_Bounds_NormalRange)(nil),
@@ -2428,7 +2511,7 @@ func file_smartcore_bos_health_v1_health_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_smartcore_bos_health_v1_health_proto_rawDesc), len(file_smartcore_bos_health_v1_health_proto_rawDesc)),
 			NumEnums:      5,
-			NumMessages:   22,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,54 @@
+package historypb
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/pkg/history"
+	"github.com/smart-core-os/sc-bos/pkg/proto/emergencylightpb"
+)
+
+type EmergencyLightServer struct {
+	emergencylightpb.UnimplementedEmergencyLightHistoryServer
+	store history.Store // payloads of *emergencylightpb.TestResultSet
+}
+
+func NewEmergencyLightServer(store history.Store) *EmergencyLightServer {
+	return &EmergencyLightServer{store: store}
+}
+
+func (m *EmergencyLightServer) Register(server *grpc.Server) {
+	emergencylightpb.RegisterEmergencyLightHistoryServer(server, m)
+}
+
+func (m *EmergencyLightServer) Unwrap() any {
+	return m.store
+}
+
+var emergencyLightPager = NewPageReader(func(r history.Record) (*emergencylightpb.TestResultRecord, error) {
+	v := &emergencylightpb.TestResultSet{}
+	err := proto.Unmarshal(r.Payload, v)
+	if err != nil {
+		return nil, err
+	}
+	return &emergencylightpb.TestResultRecord{
+		RecordTime: timestamppb.New(r.CreateTime),
+		TestResult: v,
+	}, nil
+})
+
+func (m *EmergencyLightServer) ListTestResultHistory(ctx context.Context, request *emergencylightpb.ListTestResultHistoryRequest) (*emergencylightpb.ListTestResultHistoryResponse, error) {
+	page, size, nextToken, err := emergencyLightPager.ListRecords(ctx, m.store, request.Period, int(request.PageSize), request.PageToken, request.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emergencylightpb.ListTestResultHistoryResponse{
+		TotalSize:         int32(size),
+		NextPageToken:     nextToken,
+		TestResultRecords: page,
+	}, nil
+}
@@ -707,6 +707,220 @@ func (*ForgetHubNodeResponse) Descriptor() ([]byte, []int) {
 	return file_smartcore_bos_hub_v1_hub_proto_rawDescGZIP(), []int{13}
 }
 
+// A certificate issued by the hub that should no longer be trusted.
+type RevokedCertificate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The serial number of the revoked certificate, as big-endian bytes.
+	SerialNumber []byte `protobuf:"bytes,1,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	// The DER encoded issuer distinguished name of the revoked certificate.
+	Issuer []byte `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// When the certificate was revoked.
+	RevokeTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=revoke_time,json=revokeTime,proto3" json:"revoke_time,omitempty"`
+	// When the certificate would have expired.
+	// The certificate is no longer listed after this time.
+	ExpireTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// The address of the node the certificate was issued to.
+	Address string `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	// The name of the node the certificate was issued to.
+	Name          string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokedCertificate) Reset() {
+	*x = RevokedCertificate{}
+	mi := &file_smartcore_bos_hub_v1_hub_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokedCertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokedCertificate) ProtoMessage() {}
+
+func (x *RevokedCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_hub_v1_hub_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokedCertificate.ProtoReflect.Descriptor instead.
+func (*RevokedCertificate) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_hub_v1_hub_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RevokedCertificate) GetSerialNumber() []byte {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return nil
+}
+
+func (x *RevokedCertificate) GetIssuer() []byte {
+	if x != nil {
+		return x.Issuer
+	}
+	return nil
+}
+
+func (x *RevokedCertificate) GetRevokeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokeTime
+	}
+	return nil
+}
+
+func (x *RevokedCertificate) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *RevokedCertificate) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RevokedCertificate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListRevokedCertificatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of certificates to return.
+	// The service may return fewer than this value.
+	// If unspecified, at most 50 items will be returned.
+	// The maximum value is 1000; values above 1000 will be coerced to 1000.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// A page token, received from a previous `ListRevokedCertificatesResponse` call.
+	// Provide this to retrieve the subsequent page.
+	PageToken     string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRevokedCertificatesRequest) Reset() {
+	*x = ListRevokedCertificatesRequest{}
+	mi := &file_smartcore_bos_hub_v1_hub_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRevokedCertificatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevokedCertificatesRequest) ProtoMessage() {}
+
+func (x *ListRevokedCertificatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_hub_v1_hub_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevokedCertificatesRequest.ProtoReflect.Descriptor instead.
+func (*ListRevokedCertificatesRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_hub_v1_hub_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListRevokedCertificatesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRevokedCertificatesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListRevokedCertificatesResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	RevokedCertificates []*RevokedCertificate  `protobuf:"bytes,1,rep,name=revoked_certificates,json=revokedCertificates,proto3" json:"revoked_certificates,omitempty"`
+	// A token, which can be sent as `page_token` to retrieve the next page.
+	// If this field is omitted, there are no subsequent pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// If non-zero this is the total number of revoked certificates.
+	// This may be an estimate.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRevokedCertificatesResponse) Reset() {
+	*x = ListRevokedCertificatesResponse{}
+	mi := &file_smartcore_bos_hub_v1_hub_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRevokedCertificatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRevokedCertificatesResponse) ProtoMessage() {}
+
+func (x *ListRevokedCertificatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_hub_v1_hub_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRevokedCertificatesResponse.ProtoReflect.Descriptor instead.
+func (*ListRevokedCertificatesResponse) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_hub_v1_hub_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListRevokedCertificatesResponse) GetRevokedCertificates() []*RevokedCertificate {
+	if x != nil {
+		return x.RevokedCertificates
+	}
+	return nil
+}
+
+func (x *ListRevokedCertificatesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListRevokedCertificatesResponse) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
 type PullHubNodesResponse_Change struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The type of change (e.g. ADD, UPDATE, etc...)
@@ -723,7 +937,7 @@ type PullHubNodesResponse_Change struct {
 
 func (x *PullHubNodesResponse_Change) Reset() {
 	*x = PullHubNodesResponse_Change{}
-	mi := &file_smartcore_bos_hub_v1_hub_proto_msgTypes[14]
+	mi := &file_smartcore_bos_hub_v1_hub_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -735,7 +949,7 @@ func (x *PullHubNodesResponse_Change) String() string {
 func (*PullHubNodesResponse_Change) ProtoMessage() {}
 
 func (x *PullHubNodesResponse_Change) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_hub_v1_hub_proto_msgTypes[14]
+	mi := &file_smartcore_bos_hub_v1_hub_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -825,7 +1039,25 @@ const file_smartcore_bos_hub_v1_hub_proto_rawDesc = "" +
 	"\x14ForgetHubNodeRequest\x12\x18\n" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\x12#\n" +
 	"\rallow_missing\x18\x02 \x01(\bR\fallowMissing\"\x17\n" +
-	"\x15ForgetHubNodeResponse2\x9a\x06\n" +
+	"\x15ForgetHubNodeResponse\"\xf9\x01\n" +
+	"\x12RevokedCertificate\x12#\n" +
+	"\rserial_number\x18\x01 \x01(\fR\fserialNumber\x12\x16\n" +
+	"\x06issuer\x18\x02 \x01(\fR\x06issuer\x12;\n" +
+	"\vrevoke_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"revokeTime\x12;\n" +
+	"\vexpire_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04name\"\\\n" +
+	"\x1eListRevokedCertificatesRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\xc5\x01\n" +
+	"\x1fListRevokedCertificatesResponse\x12[\n" +
+	"\x14revoked_certificates\x18\x01 \x03(\v2(.smartcore.bos.hub.v1.RevokedCertificateR\x13revokedCertificates\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize2\xa3\a\n" +
 	"\x06HubApi\x12T\n" +
 	"\n" +
 	"GetHubNode\x12'.smartcore.bos.hub.v1.GetHubNodeRequest\x1a\x1d.smartcore.bos.hub.v1.HubNode\x12e\n" +
@@ -835,7 +1067,8 @@ const file_smartcore_bos_hub_v1_hub_proto_rawDesc = "" +
 	"\rEnrollHubNode\x12*.smartcore.bos.hub.v1.EnrollHubNodeRequest\x1a\x1d.smartcore.bos.hub.v1.HubNode\x12X\n" +
 	"\fRenewHubNode\x12).smartcore.bos.hub.v1.RenewHubNodeRequest\x1a\x1d.smartcore.bos.hub.v1.HubNode\x12b\n" +
 	"\vTestHubNode\x12(.smartcore.bos.hub.v1.TestHubNodeRequest\x1a).smartcore.bos.hub.v1.TestHubNodeResponse\x12h\n" +
-	"\rForgetHubNode\x12*.smartcore.bos.hub.v1.ForgetHubNodeRequest\x1a+.smartcore.bos.hub.v1.ForgetHubNodeResponseB1Z/github.com/smart-core-os/sc-bos/pkg/proto/hubpbb\x06proto3"
+	"\rForgetHubNode\x12*.smartcore.bos.hub.v1.ForgetHubNodeRequest\x1a+.smartcore.bos.hub.v1.ForgetHubNodeResponse\x12\x86\x01\n" +
+	"\x17ListRevokedCertificates\x124.smartcore.bos.hub.v1.ListRevokedCertificatesRequest\x1a5.smartcore.bos.hub.v1.ListRevokedCertificatesResponseB1Z/github.com/smart-core-os/sc-bos/pkg/proto/hubpbb\x06proto3"
 
 var (
 	file_smartcore_bos_hub_v1_hub_proto_rawDescOnce sync.Once
@@ -849,58 +1082,66 @@ func file_smartcore_bos_hub_v1_hub_proto_rawDescGZIP() []byte {
 	return file_smartcore_bos_hub_v1_hub_proto_rawDescData
 }
 
-var file_smartcore_bos_hub_v1_hub_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_smartcore_bos_hub_v1_hub_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_smartcore_bos_hub_v1_hub_proto_goTypes = []any{
-	(*HubNode)(nil),                     // 0: smartcore.bos.hub.v1.HubNode
-	(*HubNodeInspection)(nil),           // 1: smartcore.bos.hub.v1.HubNodeInspection
-	(*GetHubNodeRequest)(nil),           // 2: smartcore.bos.hub.v1.GetHubNodeRequest
-	(*EnrollHubNodeRequest)(nil),        // 3: smartcore.bos.hub.v1.EnrollHubNodeRequest
-	(*RenewHubNodeRequest)(nil),         // 4: smartcore.bos.hub.v1.RenewHubNodeRequest
-	(*ListHubNodesRequest)(nil),         // 5: smartcore.bos.hub.v1.ListHubNodesRequest
-	(*ListHubNodesResponse)(nil),        // 6: smartcore.bos.hub.v1.ListHubNodesResponse
-	(*PullHubNodesRequest)(nil),         // 7: smartcore.bos.hub.v1.PullHubNodesRequest
-	(*PullHubNodesResponse)(nil),        // 8: smartcore.bos.hub.v1.PullHubNodesResponse
-	(*InspectHubNodeRequest)(nil),       // 9: smartcore.bos.hub.v1.InspectHubNodeRequest
-	(*TestHubNodeRequest)(nil),          // 10: smartcore.bos.hub.v1.TestHubNodeRequest
-	(*TestHubNodeResponse)(nil),         // 11: smartcore.bos.hub.v1.TestHubNodeResponse
-	(*ForgetHubNodeRequest)(nil),        // 12: smartcore.bos.hub.v1.ForgetHubNodeRequest
-	(*ForgetHubNodeResponse)(nil),       // 13: smartcore.bos.hub.v1.ForgetHubNodeResponse
-	(*PullHubNodesResponse_Change)(nil), // 14: smartcore.bos.hub.v1.PullHubNodesResponse.Change
-	(*metadatapb.Metadata)(nil),         // 15: smartcore.bos.metadata.v1.Metadata
-	(typespb.ChangeType)(0),             // 16: smartcore.bos.types.v1.ChangeType
-	(*timestamppb.Timestamp)(nil),       // 17: google.protobuf.Timestamp
+	(*HubNode)(nil),                         // 0: smartcore.bos.hub.v1.HubNode
+	(*HubNodeInspection)(nil),               // 1: smartcore.bos.hub.v1.HubNodeInspection
+	(*GetHubNodeRequest)(nil),               // 2: smartcore.bos.hub.v1.GetHubNodeRequest
+	(*EnrollHubNodeRequest)(nil),            // 3: smartcore.bos.hub.v1.EnrollHubNodeRequest
+	(*RenewHubNodeRequest)(nil),             // 4: smartcore.bos.hub.v1.RenewHubNodeRequest
+	(*ListHubNodesRequest)(nil),             // 5: smartcore.bos.hub.v1.ListHubNodesRequest
+	(*ListHubNodesResponse)(nil),            // 6: smartcore.bos.hub.v1.ListHubNodesResponse
+	(*PullHubNodesRequest)(nil),             // 7: smartcore.bos.hub.v1.PullHubNodesRequest
+	(*PullHubNodesResponse)(nil),            // 8: smartcore.bos.hub.v1.PullHubNodesResponse
+	(*InspectHubNodeRequest)(nil),           // 9: smartcore.bos.hub.v1.InspectHubNodeRequest
+	(*TestHubNodeRequest)(nil),              // 10: smartcore.bos.hub.v1.TestHubNodeRequest
+	(*TestHubNodeResponse)(nil),             // 11: smartcore.bos.hub.v1.TestHubNodeResponse
+	(*ForgetHubNodeRequest)(nil),            // 12: smartcore.bos.hub.v1.ForgetHubNodeRequest
+	(*ForgetHubNodeResponse)(nil),           // 13: smartcore.bos.hub.v1.ForgetHubNodeResponse
+	(*RevokedCertificate)(nil),              // 14: smartcore.bos.hub.v1.RevokedCertificate
+	(*ListRevokedCertificatesRequest)(nil),  // 15: smartcore.bos.hub.v1.ListRevokedCertificatesRequest
+	(*ListRevokedCertificatesResponse)(nil), // 16: smartcore.bos.hub.v1.ListRevokedCertificatesResponse
+	(*PullHubNodesResponse_Change)(nil),     // 17: smartcore.bos.hub.v1.PullHubNodesResponse.Change
+	(*metadatapb.Metadata)(nil),             // 18: smartcore.bos.metadata.v1.Metadata
+	(*timestamppb.Timestamp)(nil),           // 19: google.protobuf.Timestamp
+	(typespb.ChangeType)(0),                 // 20: smartcore.bos.types.v1.ChangeType
 }
 var file_smartcore_bos_hub_v1_hub_proto_depIdxs = []int32{
-	15, // 0: smartcore.bos.hub.v1.HubNodeInspection.metadata:type_name -> smartcore.bos.metadata.v1.Metadata
+	18, // 0: smartcore.bos.hub.v1.HubNodeInspection.metadata:type_name -> smartcore.bos.metadata.v1.Metadata
 	0,  // 1: smartcore.bos.hub.v1.EnrollHubNodeRequest.node:type_name -> smartcore.bos.hub.v1.HubNode
 	0,  // 2: smartcore.bos.hub.v1.ListHubNodesResponse.nodes:type_name -> smartcore.bos.hub.v1.HubNode
-	14, // 3: smartcore.bos.hub.v1.PullHubNodesResponse.changes:type_name -> smartcore.bos.hub.v1.PullHubNodesResponse.Change
+	17, // 3: smartcore.bos.hub.v1.PullHubNodesResponse.changes:type_name -> smartcore.bos.hub.v1.PullHubNodesResponse.Change
 	0,  // 4: smartcore.bos.hub.v1.InspectHubNodeRequest.node:type_name -> smartcore.bos.hub.v1.HubNode
-	16, // 5: smartcore.bos.hub.v1.PullHubNodesResponse.Change.type:type_name -> smartcore.bos.types.v1.ChangeType
-	0,  // 6: smartcore.bos.hub.v1.PullHubNodesResponse.Change.new_value:type_name -> smartcore.bos.hub.v1.HubNode
-	0,  // 7: smartcore.bos.hub.v1.PullHubNodesResponse.Change.old_value:type_name -> smartcore.bos.hub.v1.HubNode
-	17, // 8: smartcore.bos.hub.v1.PullHubNodesResponse.Change.change_time:type_name -> google.protobuf.Timestamp
-	2,  // 9: smartcore.bos.hub.v1.HubApi.GetHubNode:input_type -> smartcore.bos.hub.v1.GetHubNodeRequest
-	5,  // 10: smartcore.bos.hub.v1.HubApi.ListHubNodes:input_type -> smartcore.bos.hub.v1.ListHubNodesRequest
-	7,  // 11: smartcore.bos.hub.v1.HubApi.PullHubNodes:input_type -> smartcore.bos.hub.v1.PullHubNodesRequest
-	9,  // 12: smartcore.bos.hub.v1.HubApi.InspectHubNode:input_type -> smartcore.bos.hub.v1.InspectHubNodeRequest
-	3,  // 13: smartcore.bos.hub.v1.HubApi.EnrollHubNode:input_type -> smartcore.bos.hub.v1.EnrollHubNodeRequest
-	4,  // 14: smartcore.bos.hub.v1.HubApi.RenewHubNode:input_type -> smartcore.bos.hub.v1.RenewHubNodeRequest
-	10, // 15: smartcore.bos.hub.v1.HubApi.TestHubNode:input_type -> smartcore.bos.hub.v1.TestHubNodeRequest
-	12, // 16: smartcore.bos.hub.v1.HubApi.ForgetHubNode:input_type -> smartcore.bos.hub.v1.ForgetHubNodeRequest
-	0,  // 17: smartcore.bos.hub.v1.HubApi.GetHubNode:output_type -> smartcore.bos.hub.v1.HubNode
-	6,  // 18: smartcore.bos.hub.v1.HubApi.ListHubNodes:output_type -> smartcore.bos.hub.v1.ListHubNodesResponse
-	8,  // 19: smartcore.bos.hub.v1.HubApi.PullHubNodes:output_type -> smartcore.bos.hub.v1.PullHubNodesResponse
-	1,  // 20: smartcore.bos.hub.v1.HubApi.InspectHubNode:output_type -> smartcore.bos.hub.v1.HubNodeInspection
-	0,  // 21: smartcore.bos.hub.v1.HubApi.EnrollHubNode:output_type -> smartcore.bos.hub.v1.HubNode
-	0,  // 22: smartcore.bos.hub.v1.HubApi.RenewHubNode:output_type -> smartcore.bos.hub.v1.HubNode
-	11, // 23: smartcore.bos.hub.v1.HubApi.TestHubNode:output_type -> smartcore.bos.hub.v1.TestHubNodeResponse
-	13, // 24: smartcore.bos.hub.v1.HubApi.ForgetHubNode:output_type -> smartcore.bos.hub.v1.ForgetHubNodeResponse
-	17, // [17:25] is the sub-list for method output_type
-	9,  // [9:17] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	19, // 5: smartcore.bos.hub.v1.RevokedCertificate.revoke_time:type_name -> google.protobuf.Timestamp
+	19, // 6: smartcore.bos.hub.v1.RevokedCertificate.expire_time:type_name -> google.protobuf.Timestamp
+	14, // 7: smartcore.bos.hub.v1.ListRevokedCertificatesResponse.revoked_certificates:type_name -> smartcore.bos.hub.v1.RevokedCertificate
+	20, // 8: smartcore.bos.hub.v1.PullHubNodesResponse.Change.type:type_name -> smartcore.bos.types.v1.ChangeType
+	0,  // 9: smartcore.bos.hub.v1.PullHubNodesResponse.Change.new_value:type_name -> smartcore.bos.hub.v1.HubNode
+	0,  // 10: smartcore.bos.hub.v1.PullHubNodesResponse.Change.old_value:type_name -> smartcore.bos.hub.v1.HubNode
+	19, // 11: smartcore.bos.hub.v1.PullHubNodesResponse.Change.change_time:type_name -> google.protobuf.Timestamp
+	2,  // 12: smartcore.bos.hub.v1.HubApi.GetHubNode:input_type -> smartcore.bos.hub.v1.GetHubNodeRequest
+	5,  // 13: smartcore.bos.hub.v1.HubApi.ListHubNodes:input_type -> smartcore.bos.hub.v1.ListHubNodesRequest
+	7,  // 14: smartcore.bos.hub.v1.HubApi.PullHubNodes:input_type -> smartcore.bos.hub.v1.PullHubNodesRequest
+	9,  // 15: smartcore.bos.hub.v1.HubApi.InspectHubNode:input_type -> smartcore.bos.hub.v1.InspectHubNodeRequest
+	3,  // 16: smartcore.bos.hub.v1.HubApi.EnrollHubNode:input_type -> smartcore.bos.hub.v1.EnrollHubNodeRequest
+	4,  // 17: smartcore.bos.hub.v1.HubApi.RenewHubNode:input_type -> smartcore.bos.hub.v1.RenewHubNodeRequest
+	10, // 18: smartcore.bos.hub.v1.HubApi.TestHubNode:input_type -> smartcore.bos.hub.v1.TestHubNodeRequest
+	12, // 19: smartcore.bos.hub.v1.HubApi.ForgetHubNode:input_type -> smartcore.bos.hub.v1.ForgetHubNodeRequest
+	15, // 20: smartcore.bos.hub.v1.HubApi.ListRevokedCertificates:input_type -> smartcore.bos.hub.v1.ListRevokedCertificatesRequest
+	0,  // 21: smartcore.bos.hub.v1.HubApi.GetHubNode:output_type -> smartcore.bos.hub.v1.HubNode
+	6,  // 22: smartcore.bos.hub.v1.HubApi.ListHubNodes:output_type -> smartcore.bos.hub.v1.ListHubNodesResponse
+	8,  // 23: smartcore.bos.hub.v1.HubApi.PullHubNodes:output_type -> smartcore.bos.hub.v1.PullHubNodesResponse
+	1,  // 24: smartcore.bos.hub.v1.HubApi.InspectHubNode:output_type -> smartcore.bos.hub.v1.HubNodeInspection
+	0,  // 25: smartcore.bos.hub.v1.HubApi.EnrollHubNode:output_type -> smartcore.bos.hub.v1.HubNode
+	0,  // 26: smartcore.bos.hub.v1.HubApi.RenewHubNode:output_type -> smartcore.bos.hub.v1.HubNode
+	11, // 27: smartcore.bos.hub.v1.HubApi.TestHubNode:output_type -> smartcore.bos.hub.v1.TestHubNodeResponse
+	13, // 28: smartcore.bos.hub.v1.HubApi.ForgetHubNode:output_type -> smartcore.bos.hub.v1.ForgetHubNodeResponse
+	16, // 29: smartcore.bos.hub.v1.HubApi.ListRevokedCertificates:output_type -> smartcore.bos.hub.v1.ListRevokedCertificatesResponse
+	21, // [21:30] is the sub-list for method output_type
+	12, // [12:21] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_smartcore_bos_hub_v1_hub_proto_init() }
@@ -914,7 +1155,7 @@ func file_smartcore_bos_hub_v1_hub_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_smartcore_bos_hub_v1_hub_proto_rawDesc), len(file_smartcore_bos_hub_v1_hub_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
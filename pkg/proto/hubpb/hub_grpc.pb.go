@@ -19,14 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	HubApi_GetHubNode_FullMethodName     = "/smartcore.bos.hub.v1.HubApi/GetHubNode"
-	HubApi_ListHubNodes_FullMethodName   = "/smartcore.bos.hub.v1.HubApi/ListHubNodes"
-	HubApi_PullHubNodes_FullMethodName   = "/smartcore.bos.hub.v1.HubApi/PullHubNodes"
-	HubApi_InspectHubNode_FullMethodName = "/smartcore.bos.hub.v1.HubApi/InspectHubNode"
-	HubApi_EnrollHubNode_FullMethodName  = "/smartcore.bos.hub.v1.HubApi/EnrollHubNode"
-	HubApi_RenewHubNode_FullMethodName   = "/smartcore.bos.hub.v1.HubApi/RenewHubNode"
-	HubApi_TestHubNode_FullMethodName    = "/smartcore.bos.hub.v1.HubApi/TestHubNode"
-	HubApi_ForgetHubNode_FullMethodName  = "/smartcore.bos.hub.v1.HubApi/ForgetHubNode"
+	HubApi_GetHubNode_FullMethodName              = "/smartcore.bos.hub.v1.HubApi/GetHubNode"
+	HubApi_ListHubNodes_FullMethodName            = "/smartcore.bos.hub.v1.HubApi/ListHubNodes"
+	HubApi_PullHubNodes_FullMethodName            = "/smartcore.bos.hub.v1.HubApi/PullHubNodes"
+	HubApi_InspectHubNode_FullMethodName          = "/smartcore.bos.hub.v1.HubApi/InspectHubNode"
+	HubApi_EnrollHubNode_FullMethodName           = "/smartcore.bos.hub.v1.HubApi/EnrollHubNode"
+	HubApi_RenewHubNode_FullMethodName            = "/smartcore.bos.hub.v1.HubApi/RenewHubNode"
+	HubApi_TestHubNode_FullMethodName             = "/smartcore.bos.hub.v1.HubApi/TestHubNode"
+	HubApi_ForgetHubNode_FullMethodName           = "/smartcore.bos.hub.v1.HubApi/ForgetHubNode"
+	HubApi_ListRevokedCertificates_FullMethodName = "/smartcore.bos.hub.v1.HubApi/ListRevokedCertificates"
 )
 
 // HubApiClient is the client API for HubApi service.
@@ -52,7 +53,13 @@ type HubApiClient interface {
 	// by this hub.
 	TestHubNode(ctx context.Context, in *TestHubNodeRequest, opts ...grpc.CallOption) (*TestHubNodeResponse, error)
 	// Forget a node that was previously enrolled with this hub.
+	// The certificate issued to the node is revoked and will appear in ListRevokedCertificates.
 	ForgetHubNode(ctx context.Context, in *ForgetHubNodeRequest, opts ...grpc.CallOption) (*ForgetHubNodeResponse, error)
+	// List certificates issued by this hub that are no longer trusted.
+	// Nodes enrolled with this hub periodically fetch this list and reject any peer that presents a revoked certificate
+	// during TLS handshakes.
+	// Certificates are removed from this list once they expire.
+	ListRevokedCertificates(ctx context.Context, in *ListRevokedCertificatesRequest, opts ...grpc.CallOption) (*ListRevokedCertificatesResponse, error)
 }
 
 type hubApiClient struct {
@@ -152,6 +159,16 @@ func (c *hubApiClient) ForgetHubNode(ctx context.Context, in *ForgetHubNodeReque
 	return out, nil
 }
 
+func (c *hubApiClient) ListRevokedCertificates(ctx context.Context, in *ListRevokedCertificatesRequest, opts ...grpc.CallOption) (*ListRevokedCertificatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRevokedCertificatesResponse)
+	err := c.cc.Invoke(ctx, HubApi_ListRevokedCertificates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HubApiServer is the server API for HubApi service.
 // All implementations must embed UnimplementedHubApiServer
 // for forward compatibility.
@@ -175,7 +192,13 @@ type HubApiServer interface {
 	// by this hub.
 	TestHubNode(context.Context, *TestHubNodeRequest) (*TestHubNodeResponse, error)
 	// Forget a node that was previously enrolled with this hub.
+	// The certificate issued to the node is revoked and will appear in ListRevokedCertificates.
 	ForgetHubNode(context.Context, *ForgetHubNodeRequest) (*ForgetHubNodeResponse, error)
+	// List certificates issued by this hub that are no longer trusted.
+	// Nodes enrolled with this hub periodically fetch this list and reject any peer that presents a revoked certificate
+	// during TLS handshakes.
+	// Certificates are removed from this list once they expire.
+	ListRevokedCertificates(context.Context, *ListRevokedCertificatesRequest) (*ListRevokedCertificatesResponse, error)
 	mustEmbedUnimplementedHubApiServer()
 }
 
@@ -210,6 +233,9 @@ func (UnimplementedHubApiServer) TestHubNode(context.Context, *TestHubNodeReques
 func (UnimplementedHubApiServer) ForgetHubNode(context.Context, *ForgetHubNodeRequest) (*ForgetHubNodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForgetHubNode not implemented")
 }
+func (UnimplementedHubApiServer) ListRevokedCertificates(context.Context, *ListRevokedCertificatesRequest) (*ListRevokedCertificatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevokedCertificates not implemented")
+}
 func (UnimplementedHubApiServer) mustEmbedUnimplementedHubApiServer() {}
 func (UnimplementedHubApiServer) testEmbeddedByValue()                {}
 
@@ -368,6 +394,24 @@ func _HubApi_ForgetHubNode_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HubApi_ListRevokedCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRevokedCertificatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HubApiServer).ListRevokedCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HubApi_ListRevokedCertificates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HubApiServer).ListRevokedCertificates(ctx, req.(*ListRevokedCertificatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HubApi_ServiceDesc is the grpc.ServiceDesc for HubApi service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -403,6 +447,10 @@ var HubApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForgetHubNode",
 			Handler:    _HubApi_ForgetHubNode_Handler,
 		},
+		{
+			MethodName: "ListRevokedCertificates",
+			Handler:    _HubApi_ListRevokedCertificates_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
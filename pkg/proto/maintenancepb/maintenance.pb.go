@@ -0,0 +1,925 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.35.1
+// source: smartcore/bos/maintenance/v1/maintenance.proto
+
+package maintenancepb
+
+import (
+	devicespb "github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MaintenanceWindow_AlertMode int32
+
+const (
+	MaintenanceWindow_ALERT_MODE_UNSPECIFIED MaintenanceWindow_AlertMode = 0 // the same as ANNOTATE_ALERTS
+	// Alerts are created as usual, with a note that the device is in maintenance in their description.
+	MaintenanceWindow_ANNOTATE_ALERTS MaintenanceWindow_AlertMode = 1
+	// Alerts are not created.
+	MaintenanceWindow_SUPPRESS_ALERTS MaintenanceWindow_AlertMode = 2
+)
+
+// Enum value maps for MaintenanceWindow_AlertMode.
+var (
+	MaintenanceWindow_AlertMode_name = map[int32]string{
+		0: "ALERT_MODE_UNSPECIFIED",
+		1: "ANNOTATE_ALERTS",
+		2: "SUPPRESS_ALERTS",
+	}
+	MaintenanceWindow_AlertMode_value = map[string]int32{
+		"ALERT_MODE_UNSPECIFIED": 0,
+		"ANNOTATE_ALERTS":        1,
+		"SUPPRESS_ALERTS":        2,
+	}
+)
+
+func (x MaintenanceWindow_AlertMode) Enum() *MaintenanceWindow_AlertMode {
+	p := new(MaintenanceWindow_AlertMode)
+	*p = x
+	return p
+}
+
+func (x MaintenanceWindow_AlertMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MaintenanceWindow_AlertMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_smartcore_bos_maintenance_v1_maintenance_proto_enumTypes[0].Descriptor()
+}
+
+func (MaintenanceWindow_AlertMode) Type() protoreflect.EnumType {
+	return &file_smartcore_bos_maintenance_v1_maintenance_proto_enumTypes[0]
+}
+
+func (x MaintenanceWindow_AlertMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MaintenanceWindow_AlertMode.Descriptor instead.
+func (MaintenanceWindow_AlertMode) EnumDescriptor() ([]byte, []int) {
+	return file_smartcore_bos_maintenance_v1_maintenance_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type MaintenanceWindow_WriteMode int32
+
+const (
+	MaintenanceWindow_WRITE_MODE_UNSPECIFIED MaintenanceWindow_WriteMode = 0 // the same as BLOCK_WRITES
+	// Writes by automations are applied and logged.
+	MaintenanceWindow_WARN_WRITES MaintenanceWindow_WriteMode = 1
+	// Writes by automations are rejected with FAILED_PRECONDITION.
+	MaintenanceWindow_BLOCK_WRITES MaintenanceWindow_WriteMode = 2
+)
+
+// Enum value maps for MaintenanceWindow_WriteMode.
+var (
+	MaintenanceWindow_WriteMode_name = map[int32]string{
+		0: "WRITE_MODE_UNSPECIFIED",
+		1: "WARN_WRITES",
+		2: "BLOCK_WRITES",
+	}
+	MaintenanceWindow_WriteMode_value = map[string]int32{
+		"WRITE_MODE_UNSPECIFIED": 0,
+		"WARN_WRITES":            1,
+		"BLOCK_WRITES":           2,
+	}
+)
+
+func (x MaintenanceWindow_WriteMode) Enum() *MaintenanceWindow_WriteMode {
+	p := new(MaintenanceWindow_WriteMode)
+	*p = x
+	return p
+}
+
+func (x MaintenanceWindow_WriteMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MaintenanceWindow_WriteMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_smartcore_bos_maintenance_v1_maintenance_proto_enumTypes[1].Descriptor()
+}
+
+func (MaintenanceWindow_WriteMode) Type() protoreflect.EnumType {
+	return &file_smartcore_bos_maintenance_v1_maintenance_proto_enumTypes[1]
+}
+
+func (x MaintenanceWindow_WriteMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MaintenanceWindow_WriteMode.Descriptor instead.
+func (MaintenanceWindow_WriteMode) EnumDescriptor() ([]byte, []int) {
+	return file_smartcore_bos_maintenance_v1_maintenance_proto_rawDescGZIP(), []int{0, 1}
+}
+
+// MaintenanceWindow is a period when some devices are being worked on.
+type MaintenanceWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique id of the window, assigned by the server.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Why the devices are being worked on, for example "Replacing AHU-01 filters".
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Who planned the work, for example the contractor or the person that created the window.
+	Author string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	// When the window starts, absent to start immediately.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// When the window ends, absent if it doesn't end until it is deleted.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The devices in the window.
+	Selector  *MaintenanceWindow_Selector `protobuf:"bytes,6,opt,name=selector,proto3" json:"selector,omitempty"`
+	AlertMode MaintenanceWindow_AlertMode `protobuf:"varint,7,opt,name=alert_mode,json=alertMode,proto3,enum=smartcore.bos.maintenance.v1.MaintenanceWindow_AlertMode" json:"alert_mode,omitempty"`
+	WriteMode MaintenanceWindow_WriteMode `protobuf:"varint,8,opt,name=write_mode,json=writeMode,proto3,enum=smartcore.bos.maintenance.v1.MaintenanceWindow_WriteMode" json:"write_mode,omitempty"`
+	// When the window was created.
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow) ProtoMessage() {}
+
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_maintenance_v1_maintenance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MaintenanceWindow) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *MaintenanceWindow) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetSelector() *MaintenanceWindow_Selector {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow) GetAlertMode() MaintenanceWindow_AlertMode {
+	if x != nil {
+		return x.AlertMode
+	}
+	return MaintenanceWindow_ALERT_MODE_UNSPECIFIED
+}
+
+func (x *MaintenanceWindow) GetWriteMode() MaintenanceWindow_WriteMode {
+	if x != nil {
+		return x.WriteMode
+	}
+	return MaintenanceWindow_WRITE_MODE_UNSPECIFIED
+}
+
+func (x *MaintenanceWindow) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type ListMaintenanceWindowsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the controller.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Only list windows that have not ended.
+	ExcludeEnded  bool `protobuf:"varint,2,opt,name=exclude_ended,json=excludeEnded,proto3" json:"exclude_ended,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMaintenanceWindowsRequest) Reset() {
+	*x = ListMaintenanceWindowsRequest{}
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaintenanceWindowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceWindowsRequest) ProtoMessage() {}
+
+func (x *ListMaintenanceWindowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceWindowsRequest.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_maintenance_v1_maintenance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListMaintenanceWindowsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListMaintenanceWindowsRequest) GetExcludeEnded() bool {
+	if x != nil {
+		return x.ExcludeEnded
+	}
+	return false
+}
+
+type ListMaintenanceWindowsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The windows, ordered by start time.
+	MaintenanceWindows []*MaintenanceWindow `protobuf:"bytes,1,rep,name=maintenance_windows,json=maintenanceWindows,proto3" json:"maintenance_windows,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListMaintenanceWindowsResponse) Reset() {
+	*x = ListMaintenanceWindowsResponse{}
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMaintenanceWindowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMaintenanceWindowsResponse) ProtoMessage() {}
+
+func (x *ListMaintenanceWindowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMaintenanceWindowsResponse.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsResponse) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_maintenance_v1_maintenance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListMaintenanceWindowsResponse) GetMaintenanceWindows() []*MaintenanceWindow {
+	if x != nil {
+		return x.MaintenanceWindows
+	}
+	return nil
+}
+
+type GetMaintenanceWindowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMaintenanceWindowRequest) Reset() {
+	*x = GetMaintenanceWindowRequest{}
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *GetMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_maintenance_v1_maintenance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetMaintenanceWindowRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetMaintenanceWindowRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateMaintenanceWindowRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The window to create, the id and create_time are assigned by the server.
+	MaintenanceWindow *MaintenanceWindow `protobuf:"bytes,2,opt,name=maintenance_window,json=maintenanceWindow,proto3" json:"maintenance_window,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateMaintenanceWindowRequest) Reset() {
+	*x = CreateMaintenanceWindowRequest{}
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *CreateMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*CreateMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_maintenance_v1_maintenance_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateMaintenanceWindowRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateMaintenanceWindowRequest) GetMaintenanceWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.MaintenanceWindow
+	}
+	return nil
+}
+
+type UpdateMaintenanceWindowRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The window to update, identified by its id.
+	MaintenanceWindow *MaintenanceWindow `protobuf:"bytes,2,opt,name=maintenance_window,json=maintenanceWindow,proto3" json:"maintenance_window,omitempty"`
+	// Fields to update, absent to update all fields.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMaintenanceWindowRequest) Reset() {
+	*x = UpdateMaintenanceWindowRequest{}
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *UpdateMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_maintenance_v1_maintenance_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateMaintenanceWindowRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateMaintenanceWindowRequest) GetMaintenanceWindow() *MaintenanceWindow {
+	if x != nil {
+		return x.MaintenanceWindow
+	}
+	return nil
+}
+
+func (x *UpdateMaintenanceWindowRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type DeleteMaintenanceWindowRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id    string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// If true, deleting a window that doesn't exist is not an error.
+	AllowMissing  bool `protobuf:"varint,3,opt,name=allow_missing,json=allowMissing,proto3" json:"allow_missing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMaintenanceWindowRequest) Reset() {
+	*x = DeleteMaintenanceWindowRequest{}
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMaintenanceWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMaintenanceWindowRequest) ProtoMessage() {}
+
+func (x *DeleteMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_maintenance_v1_maintenance_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteMaintenanceWindowRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeleteMaintenanceWindowRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteMaintenanceWindowRequest) GetAllowMissing() bool {
+	if x != nil {
+		return x.AllowMissing
+	}
+	return false
+}
+
+type DeleteMaintenanceWindowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMaintenanceWindowResponse) Reset() {
+	*x = DeleteMaintenanceWindowResponse{}
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMaintenanceWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMaintenanceWindowResponse) ProtoMessage() {}
+
+func (x *DeleteMaintenanceWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMaintenanceWindowResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_maintenance_v1_maintenance_proto_rawDescGZIP(), []int{7}
+}
+
+type GetDeviceMaintenanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The name of the device.
+	DeviceName    string `protobuf:"bytes,2,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeviceMaintenanceRequest) Reset() {
+	*x = GetDeviceMaintenanceRequest{}
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeviceMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceMaintenanceRequest) ProtoMessage() {}
+
+func (x *GetDeviceMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*GetDeviceMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_maintenance_v1_maintenance_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetDeviceMaintenanceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetDeviceMaintenanceRequest) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+type GetDeviceMaintenanceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The active windows the device is in, ordered by start time.
+	// Empty if the device isn't in maintenance.
+	MaintenanceWindows []*MaintenanceWindow `protobuf:"bytes,1,rep,name=maintenance_windows,json=maintenanceWindows,proto3" json:"maintenance_windows,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetDeviceMaintenanceResponse) Reset() {
+	*x = GetDeviceMaintenanceResponse{}
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeviceMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeviceMaintenanceResponse) ProtoMessage() {}
+
+func (x *GetDeviceMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeviceMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*GetDeviceMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_maintenance_v1_maintenance_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetDeviceMaintenanceResponse) GetMaintenanceWindows() []*MaintenanceWindow {
+	if x != nil {
+		return x.MaintenanceWindows
+	}
+	return nil
+}
+
+// Selector identifies devices.
+// A device is selected if it matches any of the names, zones, or the query.
+type MaintenanceWindow_Selector struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Names of devices.
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	// Zones, matching the metadata.location.zone of devices.
+	Zones []string `protobuf:"bytes,2,rep,name=zones,proto3" json:"zones,omitempty"`
+	// Selects devices that match the query, absent to select no devices by query.
+	Query         *devicespb.Device_Query `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MaintenanceWindow_Selector) Reset() {
+	*x = MaintenanceWindow_Selector{}
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceWindow_Selector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceWindow_Selector) ProtoMessage() {}
+
+func (x *MaintenanceWindow_Selector) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceWindow_Selector.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow_Selector) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_maintenance_v1_maintenance_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *MaintenanceWindow_Selector) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow_Selector) GetZones() []string {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+func (x *MaintenanceWindow_Selector) GetQuery() *devicespb.Device_Query {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+var File_smartcore_bos_maintenance_v1_maintenance_proto protoreflect.FileDescriptor
+
+const file_smartcore_bos_maintenance_v1_maintenance_proto_rawDesc = "" +
+	"\n" +
+	".smartcore/bos/maintenance/v1/maintenance.proto\x12\x1csmartcore.bos.maintenance.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a&smartcore/bos/devices/v1/devices.proto\"\xa1\x06\n" +
+	"\x11MaintenanceWindow\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x129\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12T\n" +
+	"\bselector\x18\x06 \x01(\v28.smartcore.bos.maintenance.v1.MaintenanceWindow.SelectorR\bselector\x12X\n" +
+	"\n" +
+	"alert_mode\x18\a \x01(\x0e29.smartcore.bos.maintenance.v1.MaintenanceWindow.AlertModeR\talertMode\x12X\n" +
+	"\n" +
+	"write_mode\x18\b \x01(\x0e29.smartcore.bos.maintenance.v1.MaintenanceWindow.WriteModeR\twriteMode\x12;\n" +
+	"\vcreate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x1at\n" +
+	"\bSelector\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\x12\x14\n" +
+	"\x05zones\x18\x02 \x03(\tR\x05zones\x12<\n" +
+	"\x05query\x18\x03 \x01(\v2&.smartcore.bos.devices.v1.Device.QueryR\x05query\"Q\n" +
+	"\tAlertMode\x12\x1a\n" +
+	"\x16ALERT_MODE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fANNOTATE_ALERTS\x10\x01\x12\x13\n" +
+	"\x0fSUPPRESS_ALERTS\x10\x02\"J\n" +
+	"\tWriteMode\x12\x1a\n" +
+	"\x16WRITE_MODE_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vWARN_WRITES\x10\x01\x12\x10\n" +
+	"\fBLOCK_WRITES\x10\x02\"X\n" +
+	"\x1dListMaintenanceWindowsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rexclude_ended\x18\x02 \x01(\bR\fexcludeEnded\"\x82\x01\n" +
+	"\x1eListMaintenanceWindowsResponse\x12`\n" +
+	"\x13maintenance_windows\x18\x01 \x03(\v2/.smartcore.bos.maintenance.v1.MaintenanceWindowR\x12maintenanceWindows\"A\n" +
+	"\x1bGetMaintenanceWindowRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x94\x01\n" +
+	"\x1eCreateMaintenanceWindowRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12^\n" +
+	"\x12maintenance_window\x18\x02 \x01(\v2/.smartcore.bos.maintenance.v1.MaintenanceWindowR\x11maintenanceWindow\"\xd1\x01\n" +
+	"\x1eUpdateMaintenanceWindowRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12^\n" +
+	"\x12maintenance_window\x18\x02 \x01(\v2/.smartcore.bos.maintenance.v1.MaintenanceWindowR\x11maintenanceWindow\x12;\n" +
+	"\vupdate_mask\x18\x03 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"i\n" +
+	"\x1eDeleteMaintenanceWindowRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12#\n" +
+	"\rallow_missing\x18\x03 \x01(\bR\fallowMissing\"!\n" +
+	"\x1fDeleteMaintenanceWindowResponse\"R\n" +
+	"\x1bGetDeviceMaintenanceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vdevice_name\x18\x02 \x01(\tR\n" +
+	"deviceName\"\x80\x01\n" +
+	"\x1cGetDeviceMaintenanceResponse\x12`\n" +
+	"\x13maintenance_windows\x18\x01 \x03(\v2/.smartcore.bos.maintenance.v1.MaintenanceWindowR\x12maintenanceWindows2\xea\x06\n" +
+	"\x0eMaintenanceApi\x12\x93\x01\n" +
+	"\x16ListMaintenanceWindows\x12;.smartcore.bos.maintenance.v1.ListMaintenanceWindowsRequest\x1a<.smartcore.bos.maintenance.v1.ListMaintenanceWindowsResponse\x12\x82\x01\n" +
+	"\x14GetMaintenanceWindow\x129.smartcore.bos.maintenance.v1.GetMaintenanceWindowRequest\x1a/.smartcore.bos.maintenance.v1.MaintenanceWindow\x12\x88\x01\n" +
+	"\x17CreateMaintenanceWindow\x12<.smartcore.bos.maintenance.v1.CreateMaintenanceWindowRequest\x1a/.smartcore.bos.maintenance.v1.MaintenanceWindow\x12\x88\x01\n" +
+	"\x17UpdateMaintenanceWindow\x12<.smartcore.bos.maintenance.v1.UpdateMaintenanceWindowRequest\x1a/.smartcore.bos.maintenance.v1.MaintenanceWindow\x12\x96\x01\n" +
+	"\x17DeleteMaintenanceWindow\x12<.smartcore.bos.maintenance.v1.DeleteMaintenanceWindowRequest\x1a=.smartcore.bos.maintenance.v1.DeleteMaintenanceWindowResponse\x12\x8d\x01\n" +
+	"\x14GetDeviceMaintenance\x129.smartcore.bos.maintenance.v1.GetDeviceMaintenanceRequest\x1a:.smartcore.bos.maintenance.v1.GetDeviceMaintenanceResponseB9Z7github.com/smart-core-os/sc-bos/pkg/proto/maintenancepbb\x06proto3"
+
+var (
+	file_smartcore_bos_maintenance_v1_maintenance_proto_rawDescOnce sync.Once
+	file_smartcore_bos_maintenance_v1_maintenance_proto_rawDescData []byte
+)
+
+func file_smartcore_bos_maintenance_v1_maintenance_proto_rawDescGZIP() []byte {
+	file_smartcore_bos_maintenance_v1_maintenance_proto_rawDescOnce.Do(func() {
+		file_smartcore_bos_maintenance_v1_maintenance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_smartcore_bos_maintenance_v1_maintenance_proto_rawDesc), len(file_smartcore_bos_maintenance_v1_maintenance_proto_rawDesc)))
+	})
+	return file_smartcore_bos_maintenance_v1_maintenance_proto_rawDescData
+}
+
+var file_smartcore_bos_maintenance_v1_maintenance_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_smartcore_bos_maintenance_v1_maintenance_proto_goTypes = []any{
+	(MaintenanceWindow_AlertMode)(0),        // 0: smartcore.bos.maintenance.v1.MaintenanceWindow.AlertMode
+	(MaintenanceWindow_WriteMode)(0),        // 1: smartcore.bos.maintenance.v1.MaintenanceWindow.WriteMode
+	(*MaintenanceWindow)(nil),               // 2: smartcore.bos.maintenance.v1.MaintenanceWindow
+	(*ListMaintenanceWindowsRequest)(nil),   // 3: smartcore.bos.maintenance.v1.ListMaintenanceWindowsRequest
+	(*ListMaintenanceWindowsResponse)(nil),  // 4: smartcore.bos.maintenance.v1.ListMaintenanceWindowsResponse
+	(*GetMaintenanceWindowRequest)(nil),     // 5: smartcore.bos.maintenance.v1.GetMaintenanceWindowRequest
+	(*CreateMaintenanceWindowRequest)(nil),  // 6: smartcore.bos.maintenance.v1.CreateMaintenanceWindowRequest
+	(*UpdateMaintenanceWindowRequest)(nil),  // 7: smartcore.bos.maintenance.v1.UpdateMaintenanceWindowRequest
+	(*DeleteMaintenanceWindowRequest)(nil),  // 8: smartcore.bos.maintenance.v1.DeleteMaintenanceWindowRequest
+	(*DeleteMaintenanceWindowResponse)(nil), // 9: smartcore.bos.maintenance.v1.DeleteMaintenanceWindowResponse
+	(*GetDeviceMaintenanceRequest)(nil),     // 10: smartcore.bos.maintenance.v1.GetDeviceMaintenanceRequest
+	(*GetDeviceMaintenanceResponse)(nil),    // 11: smartcore.bos.maintenance.v1.GetDeviceMaintenanceResponse
+	(*MaintenanceWindow_Selector)(nil),      // 12: smartcore.bos.maintenance.v1.MaintenanceWindow.Selector
+	(*timestamppb.Timestamp)(nil),           // 13: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),           // 14: google.protobuf.FieldMask
+	(*devicespb.Device_Query)(nil),          // 15: smartcore.bos.devices.v1.Device.Query
+}
+var file_smartcore_bos_maintenance_v1_maintenance_proto_depIdxs = []int32{
+	13, // 0: smartcore.bos.maintenance.v1.MaintenanceWindow.start_time:type_name -> google.protobuf.Timestamp
+	13, // 1: smartcore.bos.maintenance.v1.MaintenanceWindow.end_time:type_name -> google.protobuf.Timestamp
+	12, // 2: smartcore.bos.maintenance.v1.MaintenanceWindow.selector:type_name -> smartcore.bos.maintenance.v1.MaintenanceWindow.Selector
+	0,  // 3: smartcore.bos.maintenance.v1.MaintenanceWindow.alert_mode:type_name -> smartcore.bos.maintenance.v1.MaintenanceWindow.AlertMode
+	1,  // 4: smartcore.bos.maintenance.v1.MaintenanceWindow.write_mode:type_name -> smartcore.bos.maintenance.v1.MaintenanceWindow.WriteMode
+	13, // 5: smartcore.bos.maintenance.v1.MaintenanceWindow.create_time:type_name -> google.protobuf.Timestamp
+	2,  // 6: smartcore.bos.maintenance.v1.ListMaintenanceWindowsResponse.maintenance_windows:type_name -> smartcore.bos.maintenance.v1.MaintenanceWindow
+	2,  // 7: smartcore.bos.maintenance.v1.CreateMaintenanceWindowRequest.maintenance_window:type_name -> smartcore.bos.maintenance.v1.MaintenanceWindow
+	2,  // 8: smartcore.bos.maintenance.v1.UpdateMaintenanceWindowRequest.maintenance_window:type_name -> smartcore.bos.maintenance.v1.MaintenanceWindow
+	14, // 9: smartcore.bos.maintenance.v1.UpdateMaintenanceWindowRequest.update_mask:type_name -> google.protobuf.FieldMask
+	2,  // 10: smartcore.bos.maintenance.v1.GetDeviceMaintenanceResponse.maintenance_windows:type_name -> smartcore.bos.maintenance.v1.MaintenanceWindow
+	15, // 11: smartcore.bos.maintenance.v1.MaintenanceWindow.Selector.query:type_name -> smartcore.bos.devices.v1.Device.Query
+	3,  // 12: smartcore.bos.maintenance.v1.MaintenanceApi.ListMaintenanceWindows:input_type -> smartcore.bos.maintenance.v1.ListMaintenanceWindowsRequest
+	5,  // 13: smartcore.bos.maintenance.v1.MaintenanceApi.GetMaintenanceWindow:input_type -> smartcore.bos.maintenance.v1.GetMaintenanceWindowRequest
+	6,  // 14: smartcore.bos.maintenance.v1.MaintenanceApi.CreateMaintenanceWindow:input_type -> smartcore.bos.maintenance.v1.CreateMaintenanceWindowRequest
+	7,  // 15: smartcore.bos.maintenance.v1.MaintenanceApi.UpdateMaintenanceWindow:input_type -> smartcore.bos.maintenance.v1.UpdateMaintenanceWindowRequest
+	8,  // 16: smartcore.bos.maintenance.v1.MaintenanceApi.DeleteMaintenanceWindow:input_type -> smartcore.bos.maintenance.v1.DeleteMaintenanceWindowRequest
+	10, // 17: smartcore.bos.maintenance.v1.MaintenanceApi.GetDeviceMaintenance:input_type -> smartcore.bos.maintenance.v1.GetDeviceMaintenanceRequest
+	4,  // 18: smartcore.bos.maintenance.v1.MaintenanceApi.ListMaintenanceWindows:output_type -> smartcore.bos.maintenance.v1.ListMaintenanceWindowsResponse
+	2,  // 19: smartcore.bos.maintenance.v1.MaintenanceApi.GetMaintenanceWindow:output_type -> smartcore.bos.maintenance.v1.MaintenanceWindow
+	2,  // 20: smartcore.bos.maintenance.v1.MaintenanceApi.CreateMaintenanceWindow:output_type -> smartcore.bos.maintenance.v1.MaintenanceWindow
+	2,  // 21: smartcore.bos.maintenance.v1.MaintenanceApi.UpdateMaintenanceWindow:output_type -> smartcore.bos.maintenance.v1.MaintenanceWindow
+	9,  // 22: smartcore.bos.maintenance.v1.MaintenanceApi.DeleteMaintenanceWindow:output_type -> smartcore.bos.maintenance.v1.DeleteMaintenanceWindowResponse
+	11, // 23: smartcore.bos.maintenance.v1.MaintenanceApi.GetDeviceMaintenance:output_type -> smartcore.bos.maintenance.v1.GetDeviceMaintenanceResponse
+	18, // [18:24] is the sub-list for method output_type
+	12, // [12:18] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_smartcore_bos_maintenance_v1_maintenance_proto_init() }
+func file_smartcore_bos_maintenance_v1_maintenance_proto_init() {
+	if File_smartcore_bos_maintenance_v1_maintenance_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_smartcore_bos_maintenance_v1_maintenance_proto_rawDesc), len(file_smartcore_bos_maintenance_v1_maintenance_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_smartcore_bos_maintenance_v1_maintenance_proto_goTypes,
+		DependencyIndexes: file_smartcore_bos_maintenance_v1_maintenance_proto_depIdxs,
+		EnumInfos:         file_smartcore_bos_maintenance_v1_maintenance_proto_enumTypes,
+		MessageInfos:      file_smartcore_bos_maintenance_v1_maintenance_proto_msgTypes,
+	}.Build()
+	File_smartcore_bos_maintenance_v1_maintenance_proto = out.File
+	file_smartcore_bos_maintenance_v1_maintenance_proto_goTypes = nil
+	file_smartcore_bos_maintenance_v1_maintenance_proto_depIdxs = nil
+}
@@ -0,0 +1,136 @@
+// Code generated by protoc-gen-router. DO NOT EDIT.
+//lint:file-ignore SA1019 Routers intentionally use a deprecated packaged - they are deprecated themselves
+
+package maintenancepb
+
+import (
+	context "context"
+	fmt "fmt"
+	router "github.com/smart-core-os/sc-bos/pkg/router"
+	grpc "google.golang.org/grpc"
+)
+
+// ApiRouter is a MaintenanceApiServer that allows routing named requests to specific MaintenanceApiClient
+// Deprecated: routing is now handled dynamically by [node.Node].
+type ApiRouter struct {
+	UnimplementedMaintenanceApiServer
+
+	router.Router
+}
+
+// compile time check that we implement the interface we need
+var _ MaintenanceApiServer = (*ApiRouter)(nil)
+
+// NewApiRouter constructs a new empty ApiRouter with the provided options.
+// Deprecated: routing is now handled dynamically by [node.Node].
+func NewApiRouter(opts ...router.Option) *ApiRouter {
+	return &ApiRouter{
+		Router: router.NewRouter(opts...),
+	}
+}
+
+// WithMaintenanceApiClientFactory instructs the router to create a new
+// client the first time Get is called for that name.
+func WithMaintenanceApiClientFactory(f func(name string) (MaintenanceApiClient, error)) router.Option {
+	return router.WithFactory(func(name string) (any, error) {
+		return f(name)
+	})
+}
+
+func (r *ApiRouter) Register(server grpc.ServiceRegistrar) {
+	RegisterMaintenanceApiServer(server, r)
+}
+
+// Add extends Router.Add to panic if client is not of type MaintenanceApiClient.
+func (r *ApiRouter) Add(name string, client any) any {
+	if !r.HoldsType(client) {
+		panic(fmt.Sprintf("not correct type: client of type %T is not a MaintenanceApiClient", client))
+	}
+	return r.Router.Add(name, client)
+}
+
+func (r *ApiRouter) HoldsType(client any) bool {
+	_, ok := client.(MaintenanceApiClient)
+	return ok
+}
+
+func (r *ApiRouter) AddMaintenanceApiClient(name string, client MaintenanceApiClient) MaintenanceApiClient {
+	res := r.Add(name, client)
+	if res == nil {
+		return nil
+	}
+	return res.(MaintenanceApiClient)
+}
+
+func (r *ApiRouter) RemoveMaintenanceApiClient(name string) MaintenanceApiClient {
+	res := r.Remove(name)
+	if res == nil {
+		return nil
+	}
+	return res.(MaintenanceApiClient)
+}
+
+func (r *ApiRouter) GetMaintenanceApiClient(name string) (MaintenanceApiClient, error) {
+	res, err := r.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(MaintenanceApiClient), nil
+}
+
+func (r *ApiRouter) ListMaintenanceWindows(ctx context.Context, request *ListMaintenanceWindowsRequest) (*ListMaintenanceWindowsResponse, error) {
+	child, err := r.GetMaintenanceApiClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.ListMaintenanceWindows(ctx, request)
+}
+
+func (r *ApiRouter) GetMaintenanceWindow(ctx context.Context, request *GetMaintenanceWindowRequest) (*MaintenanceWindow, error) {
+	child, err := r.GetMaintenanceApiClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.GetMaintenanceWindow(ctx, request)
+}
+
+func (r *ApiRouter) CreateMaintenanceWindow(ctx context.Context, request *CreateMaintenanceWindowRequest) (*MaintenanceWindow, error) {
+	child, err := r.GetMaintenanceApiClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.CreateMaintenanceWindow(ctx, request)
+}
+
+func (r *ApiRouter) UpdateMaintenanceWindow(ctx context.Context, request *UpdateMaintenanceWindowRequest) (*MaintenanceWindow, error) {
+	child, err := r.GetMaintenanceApiClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.UpdateMaintenanceWindow(ctx, request)
+}
+
+func (r *ApiRouter) DeleteMaintenanceWindow(ctx context.Context, request *DeleteMaintenanceWindowRequest) (*DeleteMaintenanceWindowResponse, error) {
+	child, err := r.GetMaintenanceApiClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.DeleteMaintenanceWindow(ctx, request)
+}
+
+func (r *ApiRouter) GetDeviceMaintenance(ctx context.Context, request *GetDeviceMaintenanceRequest) (*GetDeviceMaintenanceResponse, error) {
+	child, err := r.GetMaintenanceApiClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.GetDeviceMaintenance(ctx, request)
+}
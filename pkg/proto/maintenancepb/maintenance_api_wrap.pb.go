@@ -0,0 +1,43 @@
+// Code generated by protoc-gen-wrapper. DO NOT EDIT.
+
+package maintenancepb
+
+import (
+	wrap "github.com/smart-core-os/sc-bos/pkg/wrap"
+	grpc "google.golang.org/grpc"
+)
+
+// WrapApi	adapts a MaintenanceApiServer	and presents it as a MaintenanceApiClient
+// Deprecated: for client use, use [wrap.ServerToClient]; for server registration, use [github.com/smart-core-os/sc-bos/pkg/node.HasServer].
+func WrapApi(server MaintenanceApiServer) *ApiWrapper {
+	conn := wrap.ServerToClient(MaintenanceApi_ServiceDesc, server)
+	client := NewMaintenanceApiClient(conn)
+	return &ApiWrapper{
+		MaintenanceApiClient: client,
+		server:               server,
+		conn:                 conn,
+		desc:                 MaintenanceApi_ServiceDesc,
+	}
+}
+
+type ApiWrapper struct {
+	MaintenanceApiClient
+
+	server MaintenanceApiServer
+	conn   grpc.ClientConnInterface
+	desc   grpc.ServiceDesc
+}
+
+// UnwrapServer returns the underlying server instance.
+func (w *ApiWrapper) UnwrapServer() MaintenanceApiServer {
+	return w.server
+}
+
+// Unwrap implements wrap.Unwrapper and returns the underlying server instance as an unknown type.
+func (w *ApiWrapper) Unwrap() any {
+	return w.UnwrapServer()
+}
+
+func (w *ApiWrapper) UnwrapService() (grpc.ClientConnInterface, grpc.ServiceDesc) {
+	return w.conn, w.desc
+}
@@ -0,0 +1,327 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v7.35.1
+// source: smartcore/bos/maintenance/v1/maintenance.proto
+
+package maintenancepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MaintenanceApi_ListMaintenanceWindows_FullMethodName  = "/smartcore.bos.maintenance.v1.MaintenanceApi/ListMaintenanceWindows"
+	MaintenanceApi_GetMaintenanceWindow_FullMethodName    = "/smartcore.bos.maintenance.v1.MaintenanceApi/GetMaintenanceWindow"
+	MaintenanceApi_CreateMaintenanceWindow_FullMethodName = "/smartcore.bos.maintenance.v1.MaintenanceApi/CreateMaintenanceWindow"
+	MaintenanceApi_UpdateMaintenanceWindow_FullMethodName = "/smartcore.bos.maintenance.v1.MaintenanceApi/UpdateMaintenanceWindow"
+	MaintenanceApi_DeleteMaintenanceWindow_FullMethodName = "/smartcore.bos.maintenance.v1.MaintenanceApi/DeleteMaintenanceWindow"
+	MaintenanceApi_GetDeviceMaintenance_FullMethodName    = "/smartcore.bos.maintenance.v1.MaintenanceApi/GetDeviceMaintenance"
+)
+
+// MaintenanceApiClient is the client API for MaintenanceApi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MaintenanceApi manages maintenance windows, periods when devices are being worked on.
+//
+// While a device is in an active maintenance window:
+//   - alerts for the device are annotated or suppressed,
+//   - health checks for the device are marked as in maintenance,
+//   - writes to the device made by automations are blocked or logged.
+type MaintenanceApiClient interface {
+	ListMaintenanceWindows(ctx context.Context, in *ListMaintenanceWindowsRequest, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error)
+	GetMaintenanceWindow(ctx context.Context, in *GetMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindow, error)
+	CreateMaintenanceWindow(ctx context.Context, in *CreateMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindow, error)
+	UpdateMaintenanceWindow(ctx context.Context, in *UpdateMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindow, error)
+	DeleteMaintenanceWindow(ctx context.Context, in *DeleteMaintenanceWindowRequest, opts ...grpc.CallOption) (*DeleteMaintenanceWindowResponse, error)
+	// List the maintenance windows the named device is in now.
+	GetDeviceMaintenance(ctx context.Context, in *GetDeviceMaintenanceRequest, opts ...grpc.CallOption) (*GetDeviceMaintenanceResponse, error)
+}
+
+type maintenanceApiClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMaintenanceApiClient(cc grpc.ClientConnInterface) MaintenanceApiClient {
+	return &maintenanceApiClient{cc}
+}
+
+func (c *maintenanceApiClient) ListMaintenanceWindows(ctx context.Context, in *ListMaintenanceWindowsRequest, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMaintenanceWindowsResponse)
+	err := c.cc.Invoke(ctx, MaintenanceApi_ListMaintenanceWindows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maintenanceApiClient) GetMaintenanceWindow(ctx context.Context, in *GetMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindow, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaintenanceWindow)
+	err := c.cc.Invoke(ctx, MaintenanceApi_GetMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maintenanceApiClient) CreateMaintenanceWindow(ctx context.Context, in *CreateMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindow, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaintenanceWindow)
+	err := c.cc.Invoke(ctx, MaintenanceApi_CreateMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maintenanceApiClient) UpdateMaintenanceWindow(ctx context.Context, in *UpdateMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindow, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaintenanceWindow)
+	err := c.cc.Invoke(ctx, MaintenanceApi_UpdateMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maintenanceApiClient) DeleteMaintenanceWindow(ctx context.Context, in *DeleteMaintenanceWindowRequest, opts ...grpc.CallOption) (*DeleteMaintenanceWindowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, MaintenanceApi_DeleteMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maintenanceApiClient) GetDeviceMaintenance(ctx context.Context, in *GetDeviceMaintenanceRequest, opts ...grpc.CallOption) (*GetDeviceMaintenanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeviceMaintenanceResponse)
+	err := c.cc.Invoke(ctx, MaintenanceApi_GetDeviceMaintenance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MaintenanceApiServer is the server API for MaintenanceApi service.
+// All implementations must embed UnimplementedMaintenanceApiServer
+// for forward compatibility.
+//
+// MaintenanceApi manages maintenance windows, periods when devices are being worked on.
+//
+// While a device is in an active maintenance window:
+//   - alerts for the device are annotated or suppressed,
+//   - health checks for the device are marked as in maintenance,
+//   - writes to the device made by automations are blocked or logged.
+type MaintenanceApiServer interface {
+	ListMaintenanceWindows(context.Context, *ListMaintenanceWindowsRequest) (*ListMaintenanceWindowsResponse, error)
+	GetMaintenanceWindow(context.Context, *GetMaintenanceWindowRequest) (*MaintenanceWindow, error)
+	CreateMaintenanceWindow(context.Context, *CreateMaintenanceWindowRequest) (*MaintenanceWindow, error)
+	UpdateMaintenanceWindow(context.Context, *UpdateMaintenanceWindowRequest) (*MaintenanceWindow, error)
+	DeleteMaintenanceWindow(context.Context, *DeleteMaintenanceWindowRequest) (*DeleteMaintenanceWindowResponse, error)
+	// List the maintenance windows the named device is in now.
+	GetDeviceMaintenance(context.Context, *GetDeviceMaintenanceRequest) (*GetDeviceMaintenanceResponse, error)
+	mustEmbedUnimplementedMaintenanceApiServer()
+}
+
+// UnimplementedMaintenanceApiServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMaintenanceApiServer struct{}
+
+func (UnimplementedMaintenanceApiServer) ListMaintenanceWindows(context.Context, *ListMaintenanceWindowsRequest) (*ListMaintenanceWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMaintenanceWindows not implemented")
+}
+func (UnimplementedMaintenanceApiServer) GetMaintenanceWindow(context.Context, *GetMaintenanceWindowRequest) (*MaintenanceWindow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaintenanceWindow not implemented")
+}
+func (UnimplementedMaintenanceApiServer) CreateMaintenanceWindow(context.Context, *CreateMaintenanceWindowRequest) (*MaintenanceWindow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMaintenanceWindow not implemented")
+}
+func (UnimplementedMaintenanceApiServer) UpdateMaintenanceWindow(context.Context, *UpdateMaintenanceWindowRequest) (*MaintenanceWindow, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaintenanceWindow not implemented")
+}
+func (UnimplementedMaintenanceApiServer) DeleteMaintenanceWindow(context.Context, *DeleteMaintenanceWindowRequest) (*DeleteMaintenanceWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMaintenanceWindow not implemented")
+}
+func (UnimplementedMaintenanceApiServer) GetDeviceMaintenance(context.Context, *GetDeviceMaintenanceRequest) (*GetDeviceMaintenanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceMaintenance not implemented")
+}
+func (UnimplementedMaintenanceApiServer) mustEmbedUnimplementedMaintenanceApiServer() {}
+func (UnimplementedMaintenanceApiServer) testEmbeddedByValue()                        {}
+
+// UnsafeMaintenanceApiServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MaintenanceApiServer will
+// result in compilation errors.
+type UnsafeMaintenanceApiServer interface {
+	mustEmbedUnimplementedMaintenanceApiServer()
+}
+
+func RegisterMaintenanceApiServer(s grpc.ServiceRegistrar, srv MaintenanceApiServer) {
+	// If the following call pancis, it indicates UnimplementedMaintenanceApiServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MaintenanceApi_ServiceDesc, srv)
+}
+
+func _MaintenanceApi_ListMaintenanceWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaintenanceWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceApiServer).ListMaintenanceWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaintenanceApi_ListMaintenanceWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceApiServer).ListMaintenanceWindows(ctx, req.(*ListMaintenanceWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaintenanceApi_GetMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceApiServer).GetMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaintenanceApi_GetMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceApiServer).GetMaintenanceWindow(ctx, req.(*GetMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaintenanceApi_CreateMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceApiServer).CreateMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaintenanceApi_CreateMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceApiServer).CreateMaintenanceWindow(ctx, req.(*CreateMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaintenanceApi_UpdateMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceApiServer).UpdateMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaintenanceApi_UpdateMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceApiServer).UpdateMaintenanceWindow(ctx, req.(*UpdateMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaintenanceApi_DeleteMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceApiServer).DeleteMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaintenanceApi_DeleteMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceApiServer).DeleteMaintenanceWindow(ctx, req.(*DeleteMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MaintenanceApi_GetDeviceMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeviceMaintenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceApiServer).GetDeviceMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MaintenanceApi_GetDeviceMaintenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceApiServer).GetDeviceMaintenance(ctx, req.(*GetDeviceMaintenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MaintenanceApi_ServiceDesc is the grpc.ServiceDesc for MaintenanceApi service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MaintenanceApi_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "smartcore.bos.maintenance.v1.MaintenanceApi",
+	HandlerType: (*MaintenanceApiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListMaintenanceWindows",
+			Handler:    _MaintenanceApi_ListMaintenanceWindows_Handler,
+		},
+		{
+			MethodName: "GetMaintenanceWindow",
+			Handler:    _MaintenanceApi_GetMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "CreateMaintenanceWindow",
+			Handler:    _MaintenanceApi_CreateMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "UpdateMaintenanceWindow",
+			Handler:    _MaintenanceApi_UpdateMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "DeleteMaintenanceWindow",
+			Handler:    _MaintenanceApi_DeleteMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "GetDeviceMaintenance",
+			Handler:    _MaintenanceApi_GetDeviceMaintenance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "smartcore/bos/maintenance/v1/maintenance.proto",
+}
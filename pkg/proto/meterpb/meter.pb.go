@@ -39,7 +39,10 @@ type MeterReading struct {
 	// Produced records the current energy produced value of the meter.
 	// The unit is unspecified, use device documentation or MeterInfo to discover it.
 	// This value is a total recorded between the start and end times.
-	Produced      float32 `protobuf:"fixed32,4,opt,name=produced,proto3" json:"produced,omitempty"`
+	Produced float32 `protobuf:"fixed32,4,opt,name=produced,proto3" json:"produced,omitempty"`
+	// Optional, read-only. How the values of a group of devices were aggregated to produce this value, keyed by field name.
+	// Present when this value is aggregated from multiple devices, like for a zone.
+	Aggregations  map[string]*typespb.Aggregation `protobuf:"bytes,5,rep,name=aggregations,proto3" json:"aggregations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -102,6 +105,13 @@ func (x *MeterReading) GetProduced() float32 {
 	return 0
 }
 
+func (x *MeterReading) GetAggregations() map[string]*typespb.Aggregation {
+	if x != nil {
+		return x.Aggregations
+	}
+	return nil
+}
+
 // MeterReadingSupport describes the capabilities of devices implementing this trait
 type MeterReadingSupport struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -377,7 +387,7 @@ type PullMeterReadingsResponse_Change struct {
 
 func (x *PullMeterReadingsResponse_Change) Reset() {
 	*x = PullMeterReadingsResponse_Change{}
-	mi := &file_smartcore_bos_meter_v1_meter_proto_msgTypes[6]
+	mi := &file_smartcore_bos_meter_v1_meter_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -389,7 +399,7 @@ func (x *PullMeterReadingsResponse_Change) String() string {
 func (*PullMeterReadingsResponse_Change) ProtoMessage() {}
 
 func (x *PullMeterReadingsResponse_Change) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_meter_v1_meter_proto_msgTypes[6]
+	mi := &file_smartcore_bos_meter_v1_meter_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -430,13 +440,17 @@ var File_smartcore_bos_meter_v1_meter_proto protoreflect.FileDescriptor
 
 const file_smartcore_bos_meter_v1_meter_proto_rawDesc = "" +
 	"\n" +
-	"\"smartcore/bos/meter/v1/meter.proto\x12\x16smartcore.bos.meter.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a!smartcore/bos/types/v1/info.proto\"\xb2\x01\n" +
+	"\"smartcore/bos/meter/v1/meter.proto\x12\x16smartcore.bos.meter.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a(smartcore/bos/types/v1/aggregation.proto\x1a!smartcore/bos/types/v1/info.proto\"\xf4\x02\n" +
 	"\fMeterReading\x12\x14\n" +
 	"\x05usage\x18\x01 \x01(\x02R\x05usage\x129\n" +
 	"\n" +
 	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
 	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x1a\n" +
-	"\bproduced\x18\x04 \x01(\x02R\bproduced\"\xad\x01\n" +
+	"\bproduced\x18\x04 \x01(\x02R\bproduced\x12Z\n" +
+	"\faggregations\x18\x05 \x03(\v26.smartcore.bos.meter.v1.MeterReading.AggregationsEntryR\faggregations\x1ad\n" +
+	"\x11AggregationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x129\n" +
+	"\x05value\x18\x02 \x01(\v2#.smartcore.bos.types.v1.AggregationR\x05value:\x028\x01\"\xad\x01\n" +
 	"\x13MeterReadingSupport\x12R\n" +
 	"\x10resource_support\x18\x01 \x01(\v2'.smartcore.bos.types.v1.ResourceSupportR\x0fresourceSupport\x12\x1d\n" +
 	"\n" +
@@ -476,7 +490,7 @@ func file_smartcore_bos_meter_v1_meter_proto_rawDescGZIP() []byte {
 	return file_smartcore_bos_meter_v1_meter_proto_rawDescData
 }
 
-var file_smartcore_bos_meter_v1_meter_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_smartcore_bos_meter_v1_meter_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_smartcore_bos_meter_v1_meter_proto_goTypes = []any{
 	(*MeterReading)(nil),                     // 0: smartcore.bos.meter.v1.MeterReading
 	(*MeterReadingSupport)(nil),              // 1: smartcore.bos.meter.v1.MeterReadingSupport
@@ -484,31 +498,35 @@ var file_smartcore_bos_meter_v1_meter_proto_goTypes = []any{
 	(*PullMeterReadingsRequest)(nil),         // 3: smartcore.bos.meter.v1.PullMeterReadingsRequest
 	(*PullMeterReadingsResponse)(nil),        // 4: smartcore.bos.meter.v1.PullMeterReadingsResponse
 	(*DescribeMeterReadingRequest)(nil),      // 5: smartcore.bos.meter.v1.DescribeMeterReadingRequest
-	(*PullMeterReadingsResponse_Change)(nil), // 6: smartcore.bos.meter.v1.PullMeterReadingsResponse.Change
-	(*timestamppb.Timestamp)(nil),            // 7: google.protobuf.Timestamp
-	(*typespb.ResourceSupport)(nil),          // 8: smartcore.bos.types.v1.ResourceSupport
-	(*fieldmaskpb.FieldMask)(nil),            // 9: google.protobuf.FieldMask
+	nil,                                      // 6: smartcore.bos.meter.v1.MeterReading.AggregationsEntry
+	(*PullMeterReadingsResponse_Change)(nil), // 7: smartcore.bos.meter.v1.PullMeterReadingsResponse.Change
+	(*timestamppb.Timestamp)(nil),            // 8: google.protobuf.Timestamp
+	(*typespb.ResourceSupport)(nil),          // 9: smartcore.bos.types.v1.ResourceSupport
+	(*fieldmaskpb.FieldMask)(nil),            // 10: google.protobuf.FieldMask
+	(*typespb.Aggregation)(nil),              // 11: smartcore.bos.types.v1.Aggregation
 }
 var file_smartcore_bos_meter_v1_meter_proto_depIdxs = []int32{
-	7,  // 0: smartcore.bos.meter.v1.MeterReading.start_time:type_name -> google.protobuf.Timestamp
-	7,  // 1: smartcore.bos.meter.v1.MeterReading.end_time:type_name -> google.protobuf.Timestamp
-	8,  // 2: smartcore.bos.meter.v1.MeterReadingSupport.resource_support:type_name -> smartcore.bos.types.v1.ResourceSupport
-	9,  // 3: smartcore.bos.meter.v1.GetMeterReadingRequest.read_mask:type_name -> google.protobuf.FieldMask
-	9,  // 4: smartcore.bos.meter.v1.PullMeterReadingsRequest.read_mask:type_name -> google.protobuf.FieldMask
-	6,  // 5: smartcore.bos.meter.v1.PullMeterReadingsResponse.changes:type_name -> smartcore.bos.meter.v1.PullMeterReadingsResponse.Change
-	7,  // 6: smartcore.bos.meter.v1.PullMeterReadingsResponse.Change.change_time:type_name -> google.protobuf.Timestamp
-	0,  // 7: smartcore.bos.meter.v1.PullMeterReadingsResponse.Change.meter_reading:type_name -> smartcore.bos.meter.v1.MeterReading
-	2,  // 8: smartcore.bos.meter.v1.MeterApi.GetMeterReading:input_type -> smartcore.bos.meter.v1.GetMeterReadingRequest
-	3,  // 9: smartcore.bos.meter.v1.MeterApi.PullMeterReadings:input_type -> smartcore.bos.meter.v1.PullMeterReadingsRequest
-	5,  // 10: smartcore.bos.meter.v1.MeterInfo.DescribeMeterReading:input_type -> smartcore.bos.meter.v1.DescribeMeterReadingRequest
-	0,  // 11: smartcore.bos.meter.v1.MeterApi.GetMeterReading:output_type -> smartcore.bos.meter.v1.MeterReading
-	4,  // 12: smartcore.bos.meter.v1.MeterApi.PullMeterReadings:output_type -> smartcore.bos.meter.v1.PullMeterReadingsResponse
-	1,  // 13: smartcore.bos.meter.v1.MeterInfo.DescribeMeterReading:output_type -> smartcore.bos.meter.v1.MeterReadingSupport
-	11, // [11:14] is the sub-list for method output_type
-	8,  // [8:11] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	8,  // 0: smartcore.bos.meter.v1.MeterReading.start_time:type_name -> google.protobuf.Timestamp
+	8,  // 1: smartcore.bos.meter.v1.MeterReading.end_time:type_name -> google.protobuf.Timestamp
+	6,  // 2: smartcore.bos.meter.v1.MeterReading.aggregations:type_name -> smartcore.bos.meter.v1.MeterReading.AggregationsEntry
+	9,  // 3: smartcore.bos.meter.v1.MeterReadingSupport.resource_support:type_name -> smartcore.bos.types.v1.ResourceSupport
+	10, // 4: smartcore.bos.meter.v1.GetMeterReadingRequest.read_mask:type_name -> google.protobuf.FieldMask
+	10, // 5: smartcore.bos.meter.v1.PullMeterReadingsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	7,  // 6: smartcore.bos.meter.v1.PullMeterReadingsResponse.changes:type_name -> smartcore.bos.meter.v1.PullMeterReadingsResponse.Change
+	11, // 7: smartcore.bos.meter.v1.MeterReading.AggregationsEntry.value:type_name -> smartcore.bos.types.v1.Aggregation
+	8,  // 8: smartcore.bos.meter.v1.PullMeterReadingsResponse.Change.change_time:type_name -> google.protobuf.Timestamp
+	0,  // 9: smartcore.bos.meter.v1.PullMeterReadingsResponse.Change.meter_reading:type_name -> smartcore.bos.meter.v1.MeterReading
+	2,  // 10: smartcore.bos.meter.v1.MeterApi.GetMeterReading:input_type -> smartcore.bos.meter.v1.GetMeterReadingRequest
+	3,  // 11: smartcore.bos.meter.v1.MeterApi.PullMeterReadings:input_type -> smartcore.bos.meter.v1.PullMeterReadingsRequest
+	5,  // 12: smartcore.bos.meter.v1.MeterInfo.DescribeMeterReading:input_type -> smartcore.bos.meter.v1.DescribeMeterReadingRequest
+	0,  // 13: smartcore.bos.meter.v1.MeterApi.GetMeterReading:output_type -> smartcore.bos.meter.v1.MeterReading
+	4,  // 14: smartcore.bos.meter.v1.MeterApi.PullMeterReadings:output_type -> smartcore.bos.meter.v1.PullMeterReadingsResponse
+	1,  // 15: smartcore.bos.meter.v1.MeterInfo.DescribeMeterReading:output_type -> smartcore.bos.meter.v1.MeterReadingSupport
+	13, // [13:16] is the sub-list for method output_type
+	10, // [10:13] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_smartcore_bos_meter_v1_meter_proto_init() }
@@ -522,7 +540,7 @@ func file_smartcore_bos_meter_v1_meter_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_smartcore_bos_meter_v1_meter_proto_rawDesc), len(file_smartcore_bos_meter_v1_meter_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
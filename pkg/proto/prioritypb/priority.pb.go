@@ -0,0 +1,443 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.35.1
+// source: smartcore/bos/priority/v1/priority.proto
+
+package prioritypb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PriorityArray holds the writes to one field of a device.
+type PriorityArray struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the device.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The gRPC method used to write the field, like /smartcore.bos.onoff.v1.OnOffApi/UpdateOnOff.
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// The field of the written resource, like level_percent.
+	// Empty if the method is arbitrated as a whole.
+	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	// The occupied levels, highest priority first.
+	Slots []*PriorityArray_Slot `protobuf:"bytes,4,rep,name=slots,proto3" json:"slots,omitempty"`
+	// The level whose value is applied to the device, the level of the first slot.
+	// Zero if no levels are occupied.
+	EffectiveLevel int32 `protobuf:"varint,5,opt,name=effective_level,json=effectiveLevel,proto3" json:"effective_level,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PriorityArray) Reset() {
+	*x = PriorityArray{}
+	mi := &file_smartcore_bos_priority_v1_priority_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriorityArray) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriorityArray) ProtoMessage() {}
+
+func (x *PriorityArray) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_priority_v1_priority_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriorityArray.ProtoReflect.Descriptor instead.
+func (*PriorityArray) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_priority_v1_priority_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PriorityArray) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PriorityArray) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *PriorityArray) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *PriorityArray) GetSlots() []*PriorityArray_Slot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *PriorityArray) GetEffectiveLevel() int32 {
+	if x != nil {
+		return x.EffectiveLevel
+	}
+	return 0
+}
+
+type ListPriorityArraysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPriorityArraysRequest) Reset() {
+	*x = ListPriorityArraysRequest{}
+	mi := &file_smartcore_bos_priority_v1_priority_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriorityArraysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriorityArraysRequest) ProtoMessage() {}
+
+func (x *ListPriorityArraysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_priority_v1_priority_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriorityArraysRequest.ProtoReflect.Descriptor instead.
+func (*ListPriorityArraysRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_priority_v1_priority_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListPriorityArraysRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListPriorityArraysResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PriorityArrays []*PriorityArray       `protobuf:"bytes,1,rep,name=priority_arrays,json=priorityArrays,proto3" json:"priority_arrays,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPriorityArraysResponse) Reset() {
+	*x = ListPriorityArraysResponse{}
+	mi := &file_smartcore_bos_priority_v1_priority_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriorityArraysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriorityArraysResponse) ProtoMessage() {}
+
+func (x *ListPriorityArraysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_priority_v1_priority_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriorityArraysResponse.ProtoReflect.Descriptor instead.
+func (*ListPriorityArraysResponse) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_priority_v1_priority_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPriorityArraysResponse) GetPriorityArrays() []*PriorityArray {
+	if x != nil {
+		return x.PriorityArrays
+	}
+	return nil
+}
+
+type RelinquishPriorityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The method and field identifying the priority array, as in PriorityArray.
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Field  string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	// The level to relinquish, 1 to 16.
+	Level         int32 `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelinquishPriorityRequest) Reset() {
+	*x = RelinquishPriorityRequest{}
+	mi := &file_smartcore_bos_priority_v1_priority_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelinquishPriorityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelinquishPriorityRequest) ProtoMessage() {}
+
+func (x *RelinquishPriorityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_priority_v1_priority_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelinquishPriorityRequest.ProtoReflect.Descriptor instead.
+func (*RelinquishPriorityRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_priority_v1_priority_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RelinquishPriorityRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RelinquishPriorityRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *RelinquishPriorityRequest) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *RelinquishPriorityRequest) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+// Slot is a write occupying a level of a priority array.
+type PriorityArray_Slot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The level, 1 to 16, where 1 is the highest priority.
+	Level int32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	// Who wrote the value, from the sc-priority-owner request metadata.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The write request, only containing the value of this field.
+	Value *anypb.Any `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// When the value was written.
+	WriteTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=write_time,json=writeTime,proto3" json:"write_time,omitempty"`
+	// When the write relinquishes its level, absent if it doesn't expire.
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriorityArray_Slot) Reset() {
+	*x = PriorityArray_Slot{}
+	mi := &file_smartcore_bos_priority_v1_priority_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriorityArray_Slot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriorityArray_Slot) ProtoMessage() {}
+
+func (x *PriorityArray_Slot) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_priority_v1_priority_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriorityArray_Slot.ProtoReflect.Descriptor instead.
+func (*PriorityArray_Slot) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_priority_v1_priority_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *PriorityArray_Slot) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *PriorityArray_Slot) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *PriorityArray_Slot) GetValue() *anypb.Any {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *PriorityArray_Slot) GetWriteTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WriteTime
+	}
+	return nil
+}
+
+func (x *PriorityArray_Slot) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+var File_smartcore_bos_priority_v1_priority_proto protoreflect.FileDescriptor
+
+const file_smartcore_bos_priority_v1_priority_proto_rawDesc = "" +
+	"\n" +
+	"(smartcore/bos/priority/v1/priority.proto\x12\x19smartcore.bos.priority.v1\x1a\x19google/protobuf/any.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x98\x03\n" +
+	"\rPriorityArray\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x14\n" +
+	"\x05field\x18\x03 \x01(\tR\x05field\x12C\n" +
+	"\x05slots\x18\x04 \x03(\v2-.smartcore.bos.priority.v1.PriorityArray.SlotR\x05slots\x12'\n" +
+	"\x0feffective_level\x18\x05 \x01(\x05R\x0eeffectiveLevel\x1a\xd6\x01\n" +
+	"\x04Slot\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\x05R\x05level\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\x12*\n" +
+	"\x05value\x18\x03 \x01(\v2\x14.google.protobuf.AnyR\x05value\x129\n" +
+	"\n" +
+	"write_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\twriteTime\x12;\n" +
+	"\vexpire_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expireTime\"/\n" +
+	"\x19ListPriorityArraysRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"o\n" +
+	"\x1aListPriorityArraysResponse\x12Q\n" +
+	"\x0fpriority_arrays\x18\x01 \x03(\v2(.smartcore.bos.priority.v1.PriorityArrayR\x0epriorityArrays\"s\n" +
+	"\x19RelinquishPriorityRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x14\n" +
+	"\x05field\x18\x03 \x01(\tR\x05field\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\x05R\x05level2\x87\x02\n" +
+	"\vPriorityApi\x12\x81\x01\n" +
+	"\x12ListPriorityArrays\x124.smartcore.bos.priority.v1.ListPriorityArraysRequest\x1a5.smartcore.bos.priority.v1.ListPriorityArraysResponse\x12t\n" +
+	"\x12RelinquishPriority\x124.smartcore.bos.priority.v1.RelinquishPriorityRequest\x1a(.smartcore.bos.priority.v1.PriorityArrayB6Z4github.com/smart-core-os/sc-bos/pkg/proto/prioritypbb\x06proto3"
+
+var (
+	file_smartcore_bos_priority_v1_priority_proto_rawDescOnce sync.Once
+	file_smartcore_bos_priority_v1_priority_proto_rawDescData []byte
+)
+
+func file_smartcore_bos_priority_v1_priority_proto_rawDescGZIP() []byte {
+	file_smartcore_bos_priority_v1_priority_proto_rawDescOnce.Do(func() {
+		file_smartcore_bos_priority_v1_priority_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_smartcore_bos_priority_v1_priority_proto_rawDesc), len(file_smartcore_bos_priority_v1_priority_proto_rawDesc)))
+	})
+	return file_smartcore_bos_priority_v1_priority_proto_rawDescData
+}
+
+var file_smartcore_bos_priority_v1_priority_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_smartcore_bos_priority_v1_priority_proto_goTypes = []any{
+	(*PriorityArray)(nil),              // 0: smartcore.bos.priority.v1.PriorityArray
+	(*ListPriorityArraysRequest)(nil),  // 1: smartcore.bos.priority.v1.ListPriorityArraysRequest
+	(*ListPriorityArraysResponse)(nil), // 2: smartcore.bos.priority.v1.ListPriorityArraysResponse
+	(*RelinquishPriorityRequest)(nil),  // 3: smartcore.bos.priority.v1.RelinquishPriorityRequest
+	(*PriorityArray_Slot)(nil),         // 4: smartcore.bos.priority.v1.PriorityArray.Slot
+	(*anypb.Any)(nil),                  // 5: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil),      // 6: google.protobuf.Timestamp
+}
+var file_smartcore_bos_priority_v1_priority_proto_depIdxs = []int32{
+	4, // 0: smartcore.bos.priority.v1.PriorityArray.slots:type_name -> smartcore.bos.priority.v1.PriorityArray.Slot
+	0, // 1: smartcore.bos.priority.v1.ListPriorityArraysResponse.priority_arrays:type_name -> smartcore.bos.priority.v1.PriorityArray
+	5, // 2: smartcore.bos.priority.v1.PriorityArray.Slot.value:type_name -> google.protobuf.Any
+	6, // 3: smartcore.bos.priority.v1.PriorityArray.Slot.write_time:type_name -> google.protobuf.Timestamp
+	6, // 4: smartcore.bos.priority.v1.PriorityArray.Slot.expire_time:type_name -> google.protobuf.Timestamp
+	1, // 5: smartcore.bos.priority.v1.PriorityApi.ListPriorityArrays:input_type -> smartcore.bos.priority.v1.ListPriorityArraysRequest
+	3, // 6: smartcore.bos.priority.v1.PriorityApi.RelinquishPriority:input_type -> smartcore.bos.priority.v1.RelinquishPriorityRequest
+	2, // 7: smartcore.bos.priority.v1.PriorityApi.ListPriorityArrays:output_type -> smartcore.bos.priority.v1.ListPriorityArraysResponse
+	0, // 8: smartcore.bos.priority.v1.PriorityApi.RelinquishPriority:output_type -> smartcore.bos.priority.v1.PriorityArray
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_smartcore_bos_priority_v1_priority_proto_init() }
+func file_smartcore_bos_priority_v1_priority_proto_init() {
+	if File_smartcore_bos_priority_v1_priority_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_smartcore_bos_priority_v1_priority_proto_rawDesc), len(file_smartcore_bos_priority_v1_priority_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_smartcore_bos_priority_v1_priority_proto_goTypes,
+		DependencyIndexes: file_smartcore_bos_priority_v1_priority_proto_depIdxs,
+		MessageInfos:      file_smartcore_bos_priority_v1_priority_proto_msgTypes,
+	}.Build()
+	File_smartcore_bos_priority_v1_priority_proto = out.File
+	file_smartcore_bos_priority_v1_priority_proto_goTypes = nil
+	file_smartcore_bos_priority_v1_priority_proto_depIdxs = nil
+}
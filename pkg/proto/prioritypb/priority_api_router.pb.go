@@ -0,0 +1,100 @@
+// Code generated by protoc-gen-router. DO NOT EDIT.
+//lint:file-ignore SA1019 Routers intentionally use a deprecated packaged - they are deprecated themselves
+
+package prioritypb
+
+import (
+	context "context"
+	fmt "fmt"
+	router "github.com/smart-core-os/sc-bos/pkg/router"
+	grpc "google.golang.org/grpc"
+)
+
+// ApiRouter is a PriorityApiServer that allows routing named requests to specific PriorityApiClient
+// Deprecated: routing is now handled dynamically by [node.Node].
+type ApiRouter struct {
+	UnimplementedPriorityApiServer
+
+	router.Router
+}
+
+// compile time check that we implement the interface we need
+var _ PriorityApiServer = (*ApiRouter)(nil)
+
+// NewApiRouter constructs a new empty ApiRouter with the provided options.
+// Deprecated: routing is now handled dynamically by [node.Node].
+func NewApiRouter(opts ...router.Option) *ApiRouter {
+	return &ApiRouter{
+		Router: router.NewRouter(opts...),
+	}
+}
+
+// WithPriorityApiClientFactory instructs the router to create a new
+// client the first time Get is called for that name.
+func WithPriorityApiClientFactory(f func(name string) (PriorityApiClient, error)) router.Option {
+	return router.WithFactory(func(name string) (any, error) {
+		return f(name)
+	})
+}
+
+func (r *ApiRouter) Register(server grpc.ServiceRegistrar) {
+	RegisterPriorityApiServer(server, r)
+}
+
+// Add extends Router.Add to panic if client is not of type PriorityApiClient.
+func (r *ApiRouter) Add(name string, client any) any {
+	if !r.HoldsType(client) {
+		panic(fmt.Sprintf("not correct type: client of type %T is not a PriorityApiClient", client))
+	}
+	return r.Router.Add(name, client)
+}
+
+func (r *ApiRouter) HoldsType(client any) bool {
+	_, ok := client.(PriorityApiClient)
+	return ok
+}
+
+func (r *ApiRouter) AddPriorityApiClient(name string, client PriorityApiClient) PriorityApiClient {
+	res := r.Add(name, client)
+	if res == nil {
+		return nil
+	}
+	return res.(PriorityApiClient)
+}
+
+func (r *ApiRouter) RemovePriorityApiClient(name string) PriorityApiClient {
+	res := r.Remove(name)
+	if res == nil {
+		return nil
+	}
+	return res.(PriorityApiClient)
+}
+
+func (r *ApiRouter) GetPriorityApiClient(name string) (PriorityApiClient, error) {
+	res, err := r.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(PriorityApiClient), nil
+}
+
+func (r *ApiRouter) ListPriorityArrays(ctx context.Context, request *ListPriorityArraysRequest) (*ListPriorityArraysResponse, error) {
+	child, err := r.GetPriorityApiClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.ListPriorityArrays(ctx, request)
+}
+
+func (r *ApiRouter) RelinquishPriority(ctx context.Context, request *RelinquishPriorityRequest) (*PriorityArray, error) {
+	child, err := r.GetPriorityApiClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.RelinquishPriority(ctx, request)
+}
@@ -0,0 +1,43 @@
+// Code generated by protoc-gen-wrapper. DO NOT EDIT.
+
+package prioritypb
+
+import (
+	wrap "github.com/smart-core-os/sc-bos/pkg/wrap"
+	grpc "google.golang.org/grpc"
+)
+
+// WrapApi	adapts a PriorityApiServer	and presents it as a PriorityApiClient
+// Deprecated: for client use, use [wrap.ServerToClient]; for server registration, use [github.com/smart-core-os/sc-bos/pkg/node.HasServer].
+func WrapApi(server PriorityApiServer) *ApiWrapper {
+	conn := wrap.ServerToClient(PriorityApi_ServiceDesc, server)
+	client := NewPriorityApiClient(conn)
+	return &ApiWrapper{
+		PriorityApiClient: client,
+		server:            server,
+		conn:              conn,
+		desc:              PriorityApi_ServiceDesc,
+	}
+}
+
+type ApiWrapper struct {
+	PriorityApiClient
+
+	server PriorityApiServer
+	conn   grpc.ClientConnInterface
+	desc   grpc.ServiceDesc
+}
+
+// UnwrapServer returns the underlying server instance.
+func (w *ApiWrapper) UnwrapServer() PriorityApiServer {
+	return w.server
+}
+
+// Unwrap implements wrap.Unwrapper and returns the underlying server instance as an unknown type.
+func (w *ApiWrapper) Unwrap() any {
+	return w.UnwrapServer()
+}
+
+func (w *ApiWrapper) UnwrapService() (grpc.ClientConnInterface, grpc.ServiceDesc) {
+	return w.conn, w.desc
+}
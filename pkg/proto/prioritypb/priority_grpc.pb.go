@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v7.35.1
+// source: smartcore/bos/priority/v1/priority.proto
+
+package prioritypb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PriorityApi_ListPriorityArrays_FullMethodName = "/smartcore.bos.priority.v1.PriorityApi/ListPriorityArrays"
+	PriorityApi_RelinquishPriority_FullMethodName = "/smartcore.bos.priority.v1.PriorityApi/RelinquishPriority"
+)
+
+// PriorityApiClient is the client API for PriorityApi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PriorityApi exposes how writes to a device are arbitrated between the writers that target it.
+//
+// Each field of a device that can be written has a priority array with 16 levels, like a BACnet priority array.
+// Level 1 is the highest priority, 16 the lowest.
+// A write occupies a level until it is relinquished or its lease expires,
+// the value at the highest occupied level is the effective value, applied to the device.
+// When the effective level is relinquished the value at the next highest level is applied.
+//
+// Writers choose their level using the sc-priority, sc-priority-owner, and sc-priority-lease request metadata.
+type PriorityApiClient interface {
+	// List the priority arrays for the named device.
+	ListPriorityArrays(ctx context.Context, in *ListPriorityArraysRequest, opts ...grpc.CallOption) (*ListPriorityArraysResponse, error)
+	// Remove the write at a level of a priority array, applying the value at the next highest level if needed.
+	RelinquishPriority(ctx context.Context, in *RelinquishPriorityRequest, opts ...grpc.CallOption) (*PriorityArray, error)
+}
+
+type priorityApiClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPriorityApiClient(cc grpc.ClientConnInterface) PriorityApiClient {
+	return &priorityApiClient{cc}
+}
+
+func (c *priorityApiClient) ListPriorityArrays(ctx context.Context, in *ListPriorityArraysRequest, opts ...grpc.CallOption) (*ListPriorityArraysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPriorityArraysResponse)
+	err := c.cc.Invoke(ctx, PriorityApi_ListPriorityArrays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priorityApiClient) RelinquishPriority(ctx context.Context, in *RelinquishPriorityRequest, opts ...grpc.CallOption) (*PriorityArray, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriorityArray)
+	err := c.cc.Invoke(ctx, PriorityApi_RelinquishPriority_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PriorityApiServer is the server API for PriorityApi service.
+// All implementations must embed UnimplementedPriorityApiServer
+// for forward compatibility.
+//
+// PriorityApi exposes how writes to a device are arbitrated between the writers that target it.
+//
+// Each field of a device that can be written has a priority array with 16 levels, like a BACnet priority array.
+// Level 1 is the highest priority, 16 the lowest.
+// A write occupies a level until it is relinquished or its lease expires,
+// the value at the highest occupied level is the effective value, applied to the device.
+// When the effective level is relinquished the value at the next highest level is applied.
+//
+// Writers choose their level using the sc-priority, sc-priority-owner, and sc-priority-lease request metadata.
+type PriorityApiServer interface {
+	// List the priority arrays for the named device.
+	ListPriorityArrays(context.Context, *ListPriorityArraysRequest) (*ListPriorityArraysResponse, error)
+	// Remove the write at a level of a priority array, applying the value at the next highest level if needed.
+	RelinquishPriority(context.Context, *RelinquishPriorityRequest) (*PriorityArray, error)
+	mustEmbedUnimplementedPriorityApiServer()
+}
+
+// UnimplementedPriorityApiServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPriorityApiServer struct{}
+
+func (UnimplementedPriorityApiServer) ListPriorityArrays(context.Context, *ListPriorityArraysRequest) (*ListPriorityArraysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPriorityArrays not implemented")
+}
+func (UnimplementedPriorityApiServer) RelinquishPriority(context.Context, *RelinquishPriorityRequest) (*PriorityArray, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelinquishPriority not implemented")
+}
+func (UnimplementedPriorityApiServer) mustEmbedUnimplementedPriorityApiServer() {}
+func (UnimplementedPriorityApiServer) testEmbeddedByValue()                     {}
+
+// UnsafePriorityApiServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PriorityApiServer will
+// result in compilation errors.
+type UnsafePriorityApiServer interface {
+	mustEmbedUnimplementedPriorityApiServer()
+}
+
+func RegisterPriorityApiServer(s grpc.ServiceRegistrar, srv PriorityApiServer) {
+	// If the following call pancis, it indicates UnimplementedPriorityApiServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PriorityApi_ServiceDesc, srv)
+}
+
+func _PriorityApi_ListPriorityArrays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriorityArraysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriorityApiServer).ListPriorityArrays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriorityApi_ListPriorityArrays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriorityApiServer).ListPriorityArrays(ctx, req.(*ListPriorityArraysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriorityApi_RelinquishPriority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelinquishPriorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriorityApiServer).RelinquishPriority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriorityApi_RelinquishPriority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriorityApiServer).RelinquishPriority(ctx, req.(*RelinquishPriorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PriorityApi_ServiceDesc is the grpc.ServiceDesc for PriorityApi service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PriorityApi_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "smartcore.bos.priority.v1.PriorityApi",
+	HandlerType: (*PriorityApiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPriorityArrays",
+			Handler:    _PriorityApi_ListPriorityArrays_Handler,
+		},
+		{
+			MethodName: "RelinquishPriority",
+			Handler:    _PriorityApi_RelinquishPriority_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "smartcore/bos/priority/v1/priority.proto",
+}
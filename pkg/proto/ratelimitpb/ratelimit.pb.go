@@ -0,0 +1,517 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.35.1
+// source: smartcore/bos/ratelimit/v1/ratelimit.proto
+
+package ratelimitpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Limits are the rate limits and quotas applied to a client.
+// A zero value means there is no limit.
+type Limits struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The sustained rate of requests, including opening streams, allowed per second.
+	RequestsPerSecond float64 `protobuf:"fixed64,1,opt,name=requests_per_second,json=requestsPerSecond,proto3" json:"requests_per_second,omitempty"`
+	// How many requests can be made at once before requests_per_second applies.
+	Burst int32 `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
+	// The number of streams the client can have open at once.
+	MaxStreams int32 `protobuf:"varint,3,opt,name=max_streams,json=maxStreams,proto3" json:"max_streams,omitempty"`
+	// The largest page_size of requests, larger page sizes are reduced to this.
+	MaxPageSize   int32 `protobuf:"varint,4,opt,name=max_page_size,json=maxPageSize,proto3" json:"max_page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Limits) Reset() {
+	*x = Limits{}
+	mi := &file_smartcore_bos_ratelimit_v1_ratelimit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Limits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Limits) ProtoMessage() {}
+
+func (x *Limits) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_ratelimit_v1_ratelimit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Limits.ProtoReflect.Descriptor instead.
+func (*Limits) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_ratelimit_v1_ratelimit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Limits) GetRequestsPerSecond() float64 {
+	if x != nil {
+		return x.RequestsPerSecond
+	}
+	return 0
+}
+
+func (x *Limits) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *Limits) GetMaxStreams() int32 {
+	if x != nil {
+		return x.MaxStreams
+	}
+	return 0
+}
+
+func (x *Limits) GetMaxPageSize() int32 {
+	if x != nil {
+		return x.MaxPageSize
+	}
+	return 0
+}
+
+// ClientUsage is how much of its limits a client has used.
+type ClientUsage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Identifies the client, either "subject:<token subject>" or "addr:<ip>".
+	Client string `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	// The subject of the client's access token, empty if it didn't present one.
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// The human readable name from the client's access token, if any.
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Whether the client is a tenant, authenticated using a tenant secret.
+	Tenant bool `protobuf:"varint,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// The system roles of the client's access token.
+	Roles []string `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles,omitempty"`
+	// The limits applied to the client.
+	Limits *Limits `protobuf:"bytes,6,opt,name=limits,proto3" json:"limits,omitempty"`
+	// The number of streams the client has open now.
+	OpenStreams int32 `protobuf:"varint,7,opt,name=open_streams,json=openStreams,proto3" json:"open_streams,omitempty"`
+	// The number of requests the client could make now without being limited.
+	// Absent if the client's request rate isn't limited.
+	AvailableRequests *float64 `protobuf:"fixed64,8,opt,name=available_requests,json=availableRequests,proto3,oneof" json:"available_requests,omitempty"`
+	// The number of requests allowed since the client was first seen.
+	RequestCount int64 `protobuf:"varint,9,opt,name=request_count,json=requestCount,proto3" json:"request_count,omitempty"`
+	// The number of requests rejected because the client was over a limit.
+	RejectedCount int64 `protobuf:"varint,10,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
+	// The number of requests whose page_size was reduced to limits.max_page_size.
+	PageSizeCappedCount int64 `protobuf:"varint,11,opt,name=page_size_capped_count,json=pageSizeCappedCount,proto3" json:"page_size_capped_count,omitempty"`
+	// When the client was first seen, usage is forgotten for clients that have been idle for a while.
+	FirstRequestTime *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=first_request_time,json=firstRequestTime,proto3" json:"first_request_time,omitempty"`
+	// When the client last made a request.
+	LastRequestTime *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=last_request_time,json=lastRequestTime,proto3" json:"last_request_time,omitempty"`
+	// When a request from the client was last rejected, absent if none have been.
+	LastRejectedTime *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=last_rejected_time,json=lastRejectedTime,proto3" json:"last_rejected_time,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ClientUsage) Reset() {
+	*x = ClientUsage{}
+	mi := &file_smartcore_bos_ratelimit_v1_ratelimit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientUsage) ProtoMessage() {}
+
+func (x *ClientUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_ratelimit_v1_ratelimit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientUsage.ProtoReflect.Descriptor instead.
+func (*ClientUsage) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_ratelimit_v1_ratelimit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClientUsage) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *ClientUsage) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *ClientUsage) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *ClientUsage) GetTenant() bool {
+	if x != nil {
+		return x.Tenant
+	}
+	return false
+}
+
+func (x *ClientUsage) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *ClientUsage) GetLimits() *Limits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *ClientUsage) GetOpenStreams() int32 {
+	if x != nil {
+		return x.OpenStreams
+	}
+	return 0
+}
+
+func (x *ClientUsage) GetAvailableRequests() float64 {
+	if x != nil && x.AvailableRequests != nil {
+		return *x.AvailableRequests
+	}
+	return 0
+}
+
+func (x *ClientUsage) GetRequestCount() int64 {
+	if x != nil {
+		return x.RequestCount
+	}
+	return 0
+}
+
+func (x *ClientUsage) GetRejectedCount() int64 {
+	if x != nil {
+		return x.RejectedCount
+	}
+	return 0
+}
+
+func (x *ClientUsage) GetPageSizeCappedCount() int64 {
+	if x != nil {
+		return x.PageSizeCappedCount
+	}
+	return 0
+}
+
+func (x *ClientUsage) GetFirstRequestTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstRequestTime
+	}
+	return nil
+}
+
+func (x *ClientUsage) GetLastRequestTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRequestTime
+	}
+	return nil
+}
+
+func (x *ClientUsage) GetLastRejectedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRejectedTime
+	}
+	return nil
+}
+
+type ListClientUsagesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the controller.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Only list clients that have had requests rejected.
+	OnlyRejected  bool `protobuf:"varint,2,opt,name=only_rejected,json=onlyRejected,proto3" json:"only_rejected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClientUsagesRequest) Reset() {
+	*x = ListClientUsagesRequest{}
+	mi := &file_smartcore_bos_ratelimit_v1_ratelimit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClientUsagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientUsagesRequest) ProtoMessage() {}
+
+func (x *ListClientUsagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_ratelimit_v1_ratelimit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientUsagesRequest.ProtoReflect.Descriptor instead.
+func (*ListClientUsagesRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_ratelimit_v1_ratelimit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListClientUsagesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListClientUsagesRequest) GetOnlyRejected() bool {
+	if x != nil {
+		return x.OnlyRejected
+	}
+	return false
+}
+
+type ListClientUsagesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The clients, ordered by client.
+	ClientUsages  []*ClientUsage `protobuf:"bytes,1,rep,name=client_usages,json=clientUsages,proto3" json:"client_usages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClientUsagesResponse) Reset() {
+	*x = ListClientUsagesResponse{}
+	mi := &file_smartcore_bos_ratelimit_v1_ratelimit_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClientUsagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClientUsagesResponse) ProtoMessage() {}
+
+func (x *ListClientUsagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_ratelimit_v1_ratelimit_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClientUsagesResponse.ProtoReflect.Descriptor instead.
+func (*ListClientUsagesResponse) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_ratelimit_v1_ratelimit_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListClientUsagesResponse) GetClientUsages() []*ClientUsage {
+	if x != nil {
+		return x.ClientUsages
+	}
+	return nil
+}
+
+type GetClientUsageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the controller.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The client, as in ClientUsage.client.
+	Client        string `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClientUsageRequest) Reset() {
+	*x = GetClientUsageRequest{}
+	mi := &file_smartcore_bos_ratelimit_v1_ratelimit_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClientUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientUsageRequest) ProtoMessage() {}
+
+func (x *GetClientUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_ratelimit_v1_ratelimit_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetClientUsageRequest) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_ratelimit_v1_ratelimit_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetClientUsageRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetClientUsageRequest) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+var File_smartcore_bos_ratelimit_v1_ratelimit_proto protoreflect.FileDescriptor
+
+const file_smartcore_bos_ratelimit_v1_ratelimit_proto_rawDesc = "" +
+	"\n" +
+	"*smartcore/bos/ratelimit/v1/ratelimit.proto\x12\x1asmartcore.bos.ratelimit.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x93\x01\n" +
+	"\x06Limits\x12.\n" +
+	"\x13requests_per_second\x18\x01 \x01(\x01R\x11requestsPerSecond\x12\x14\n" +
+	"\x05burst\x18\x02 \x01(\x05R\x05burst\x12\x1f\n" +
+	"\vmax_streams\x18\x03 \x01(\x05R\n" +
+	"maxStreams\x12\"\n" +
+	"\rmax_page_size\x18\x04 \x01(\x05R\vmaxPageSize\"\x97\x05\n" +
+	"\vClientUsage\x12\x16\n" +
+	"\x06client\x18\x01 \x01(\tR\x06client\x12\x18\n" +
+	"\asubject\x18\x02 \x01(\tR\asubject\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x16\n" +
+	"\x06tenant\x18\x04 \x01(\bR\x06tenant\x12\x14\n" +
+	"\x05roles\x18\x05 \x03(\tR\x05roles\x12:\n" +
+	"\x06limits\x18\x06 \x01(\v2\".smartcore.bos.ratelimit.v1.LimitsR\x06limits\x12!\n" +
+	"\fopen_streams\x18\a \x01(\x05R\vopenStreams\x122\n" +
+	"\x12available_requests\x18\b \x01(\x01H\x00R\x11availableRequests\x88\x01\x01\x12#\n" +
+	"\rrequest_count\x18\t \x01(\x03R\frequestCount\x12%\n" +
+	"\x0erejected_count\x18\n" +
+	" \x01(\x03R\rrejectedCount\x123\n" +
+	"\x16page_size_capped_count\x18\v \x01(\x03R\x13pageSizeCappedCount\x12H\n" +
+	"\x12first_request_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x10firstRequestTime\x12F\n" +
+	"\x11last_request_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x0flastRequestTime\x12H\n" +
+	"\x12last_rejected_time\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x10lastRejectedTimeB\x15\n" +
+	"\x13_available_requests\"R\n" +
+	"\x17ListClientUsagesRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\ronly_rejected\x18\x02 \x01(\bR\fonlyRejected\"h\n" +
+	"\x18ListClientUsagesResponse\x12L\n" +
+	"\rclient_usages\x18\x01 \x03(\v2'.smartcore.bos.ratelimit.v1.ClientUsageR\fclientUsages\"C\n" +
+	"\x15GetClientUsageRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06client\x18\x02 \x01(\tR\x06client2\xfb\x01\n" +
+	"\fRateLimitApi\x12}\n" +
+	"\x10ListClientUsages\x123.smartcore.bos.ratelimit.v1.ListClientUsagesRequest\x1a4.smartcore.bos.ratelimit.v1.ListClientUsagesResponse\x12l\n" +
+	"\x0eGetClientUsage\x121.smartcore.bos.ratelimit.v1.GetClientUsageRequest\x1a'.smartcore.bos.ratelimit.v1.ClientUsageB7Z5github.com/smart-core-os/sc-bos/pkg/proto/ratelimitpbb\x06proto3"
+
+var (
+	file_smartcore_bos_ratelimit_v1_ratelimit_proto_rawDescOnce sync.Once
+	file_smartcore_bos_ratelimit_v1_ratelimit_proto_rawDescData []byte
+)
+
+func file_smartcore_bos_ratelimit_v1_ratelimit_proto_rawDescGZIP() []byte {
+	file_smartcore_bos_ratelimit_v1_ratelimit_proto_rawDescOnce.Do(func() {
+		file_smartcore_bos_ratelimit_v1_ratelimit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_smartcore_bos_ratelimit_v1_ratelimit_proto_rawDesc), len(file_smartcore_bos_ratelimit_v1_ratelimit_proto_rawDesc)))
+	})
+	return file_smartcore_bos_ratelimit_v1_ratelimit_proto_rawDescData
+}
+
+var file_smartcore_bos_ratelimit_v1_ratelimit_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_smartcore_bos_ratelimit_v1_ratelimit_proto_goTypes = []any{
+	(*Limits)(nil),                   // 0: smartcore.bos.ratelimit.v1.Limits
+	(*ClientUsage)(nil),              // 1: smartcore.bos.ratelimit.v1.ClientUsage
+	(*ListClientUsagesRequest)(nil),  // 2: smartcore.bos.ratelimit.v1.ListClientUsagesRequest
+	(*ListClientUsagesResponse)(nil), // 3: smartcore.bos.ratelimit.v1.ListClientUsagesResponse
+	(*GetClientUsageRequest)(nil),    // 4: smartcore.bos.ratelimit.v1.GetClientUsageRequest
+	(*timestamppb.Timestamp)(nil),    // 5: google.protobuf.Timestamp
+}
+var file_smartcore_bos_ratelimit_v1_ratelimit_proto_depIdxs = []int32{
+	0, // 0: smartcore.bos.ratelimit.v1.ClientUsage.limits:type_name -> smartcore.bos.ratelimit.v1.Limits
+	5, // 1: smartcore.bos.ratelimit.v1.ClientUsage.first_request_time:type_name -> google.protobuf.Timestamp
+	5, // 2: smartcore.bos.ratelimit.v1.ClientUsage.last_request_time:type_name -> google.protobuf.Timestamp
+	5, // 3: smartcore.bos.ratelimit.v1.ClientUsage.last_rejected_time:type_name -> google.protobuf.Timestamp
+	1, // 4: smartcore.bos.ratelimit.v1.ListClientUsagesResponse.client_usages:type_name -> smartcore.bos.ratelimit.v1.ClientUsage
+	2, // 5: smartcore.bos.ratelimit.v1.RateLimitApi.ListClientUsages:input_type -> smartcore.bos.ratelimit.v1.ListClientUsagesRequest
+	4, // 6: smartcore.bos.ratelimit.v1.RateLimitApi.GetClientUsage:input_type -> smartcore.bos.ratelimit.v1.GetClientUsageRequest
+	3, // 7: smartcore.bos.ratelimit.v1.RateLimitApi.ListClientUsages:output_type -> smartcore.bos.ratelimit.v1.ListClientUsagesResponse
+	1, // 8: smartcore.bos.ratelimit.v1.RateLimitApi.GetClientUsage:output_type -> smartcore.bos.ratelimit.v1.ClientUsage
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_smartcore_bos_ratelimit_v1_ratelimit_proto_init() }
+func file_smartcore_bos_ratelimit_v1_ratelimit_proto_init() {
+	if File_smartcore_bos_ratelimit_v1_ratelimit_proto != nil {
+		return
+	}
+	file_smartcore_bos_ratelimit_v1_ratelimit_proto_msgTypes[1].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_smartcore_bos_ratelimit_v1_ratelimit_proto_rawDesc), len(file_smartcore_bos_ratelimit_v1_ratelimit_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_smartcore_bos_ratelimit_v1_ratelimit_proto_goTypes,
+		DependencyIndexes: file_smartcore_bos_ratelimit_v1_ratelimit_proto_depIdxs,
+		MessageInfos:      file_smartcore_bos_ratelimit_v1_ratelimit_proto_msgTypes,
+	}.Build()
+	File_smartcore_bos_ratelimit_v1_ratelimit_proto = out.File
+	file_smartcore_bos_ratelimit_v1_ratelimit_proto_goTypes = nil
+	file_smartcore_bos_ratelimit_v1_ratelimit_proto_depIdxs = nil
+}
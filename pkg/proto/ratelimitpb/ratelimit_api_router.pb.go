@@ -0,0 +1,100 @@
+// Code generated by protoc-gen-router. DO NOT EDIT.
+//lint:file-ignore SA1019 Routers intentionally use a deprecated packaged - they are deprecated themselves
+
+package ratelimitpb
+
+import (
+	context "context"
+	fmt "fmt"
+	router "github.com/smart-core-os/sc-bos/pkg/router"
+	grpc "google.golang.org/grpc"
+)
+
+// ApiRouter is a RateLimitApiServer that allows routing named requests to specific RateLimitApiClient
+// Deprecated: routing is now handled dynamically by [node.Node].
+type ApiRouter struct {
+	UnimplementedRateLimitApiServer
+
+	router.Router
+}
+
+// compile time check that we implement the interface we need
+var _ RateLimitApiServer = (*ApiRouter)(nil)
+
+// NewApiRouter constructs a new empty ApiRouter with the provided options.
+// Deprecated: routing is now handled dynamically by [node.Node].
+func NewApiRouter(opts ...router.Option) *ApiRouter {
+	return &ApiRouter{
+		Router: router.NewRouter(opts...),
+	}
+}
+
+// WithRateLimitApiClientFactory instructs the router to create a new
+// client the first time Get is called for that name.
+func WithRateLimitApiClientFactory(f func(name string) (RateLimitApiClient, error)) router.Option {
+	return router.WithFactory(func(name string) (any, error) {
+		return f(name)
+	})
+}
+
+func (r *ApiRouter) Register(server grpc.ServiceRegistrar) {
+	RegisterRateLimitApiServer(server, r)
+}
+
+// Add extends Router.Add to panic if client is not of type RateLimitApiClient.
+func (r *ApiRouter) Add(name string, client any) any {
+	if !r.HoldsType(client) {
+		panic(fmt.Sprintf("not correct type: client of type %T is not a RateLimitApiClient", client))
+	}
+	return r.Router.Add(name, client)
+}
+
+func (r *ApiRouter) HoldsType(client any) bool {
+	_, ok := client.(RateLimitApiClient)
+	return ok
+}
+
+func (r *ApiRouter) AddRateLimitApiClient(name string, client RateLimitApiClient) RateLimitApiClient {
+	res := r.Add(name, client)
+	if res == nil {
+		return nil
+	}
+	return res.(RateLimitApiClient)
+}
+
+func (r *ApiRouter) RemoveRateLimitApiClient(name string) RateLimitApiClient {
+	res := r.Remove(name)
+	if res == nil {
+		return nil
+	}
+	return res.(RateLimitApiClient)
+}
+
+func (r *ApiRouter) GetRateLimitApiClient(name string) (RateLimitApiClient, error) {
+	res, err := r.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	return res.(RateLimitApiClient), nil
+}
+
+func (r *ApiRouter) ListClientUsages(ctx context.Context, request *ListClientUsagesRequest) (*ListClientUsagesResponse, error) {
+	child, err := r.GetRateLimitApiClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.ListClientUsages(ctx, request)
+}
+
+func (r *ApiRouter) GetClientUsage(ctx context.Context, request *GetClientUsageRequest) (*ClientUsage, error) {
+	child, err := r.GetRateLimitApiClient(request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return child.GetClientUsage(ctx, request)
+}
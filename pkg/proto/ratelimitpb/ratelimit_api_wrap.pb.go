@@ -0,0 +1,43 @@
+// Code generated by protoc-gen-wrapper. DO NOT EDIT.
+
+package ratelimitpb
+
+import (
+	wrap "github.com/smart-core-os/sc-bos/pkg/wrap"
+	grpc "google.golang.org/grpc"
+)
+
+// WrapApi	adapts a RateLimitApiServer	and presents it as a RateLimitApiClient
+// Deprecated: for client use, use [wrap.ServerToClient]; for server registration, use [github.com/smart-core-os/sc-bos/pkg/node.HasServer].
+func WrapApi(server RateLimitApiServer) *ApiWrapper {
+	conn := wrap.ServerToClient(RateLimitApi_ServiceDesc, server)
+	client := NewRateLimitApiClient(conn)
+	return &ApiWrapper{
+		RateLimitApiClient: client,
+		server:             server,
+		conn:               conn,
+		desc:               RateLimitApi_ServiceDesc,
+	}
+}
+
+type ApiWrapper struct {
+	RateLimitApiClient
+
+	server RateLimitApiServer
+	conn   grpc.ClientConnInterface
+	desc   grpc.ServiceDesc
+}
+
+// UnwrapServer returns the underlying server instance.
+func (w *ApiWrapper) UnwrapServer() RateLimitApiServer {
+	return w.server
+}
+
+// Unwrap implements wrap.Unwrapper and returns the underlying server instance as an unknown type.
+func (w *ApiWrapper) Unwrap() any {
+	return w.UnwrapServer()
+}
+
+func (w *ApiWrapper) UnwrapService() (grpc.ClientConnInterface, grpc.ServiceDesc) {
+	return w.conn, w.desc
+}
@@ -0,0 +1,169 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v7.35.1
+// source: smartcore/bos/ratelimit/v1/ratelimit.proto
+
+package ratelimitpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RateLimitApi_ListClientUsages_FullMethodName = "/smartcore.bos.ratelimit.v1.RateLimitApi/ListClientUsages"
+	RateLimitApi_GetClientUsage_FullMethodName   = "/smartcore.bos.ratelimit.v1.RateLimitApi/GetClientUsage"
+)
+
+// RateLimitApiClient is the client API for RateLimitApi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// RateLimitApi reports how much of their rate limits and quotas the clients of a controller are using.
+//
+// Clients are identified by the subject of their access token,
+// or the address they connect from if they don't present a valid token.
+type RateLimitApiClient interface {
+	ListClientUsages(ctx context.Context, in *ListClientUsagesRequest, opts ...grpc.CallOption) (*ListClientUsagesResponse, error)
+	GetClientUsage(ctx context.Context, in *GetClientUsageRequest, opts ...grpc.CallOption) (*ClientUsage, error)
+}
+
+type rateLimitApiClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRateLimitApiClient(cc grpc.ClientConnInterface) RateLimitApiClient {
+	return &rateLimitApiClient{cc}
+}
+
+func (c *rateLimitApiClient) ListClientUsages(ctx context.Context, in *ListClientUsagesRequest, opts ...grpc.CallOption) (*ListClientUsagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClientUsagesResponse)
+	err := c.cc.Invoke(ctx, RateLimitApi_ListClientUsages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rateLimitApiClient) GetClientUsage(ctx context.Context, in *GetClientUsageRequest, opts ...grpc.CallOption) (*ClientUsage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClientUsage)
+	err := c.cc.Invoke(ctx, RateLimitApi_GetClientUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RateLimitApiServer is the server API for RateLimitApi service.
+// All implementations must embed UnimplementedRateLimitApiServer
+// for forward compatibility.
+//
+// RateLimitApi reports how much of their rate limits and quotas the clients of a controller are using.
+//
+// Clients are identified by the subject of their access token,
+// or the address they connect from if they don't present a valid token.
+type RateLimitApiServer interface {
+	ListClientUsages(context.Context, *ListClientUsagesRequest) (*ListClientUsagesResponse, error)
+	GetClientUsage(context.Context, *GetClientUsageRequest) (*ClientUsage, error)
+	mustEmbedUnimplementedRateLimitApiServer()
+}
+
+// UnimplementedRateLimitApiServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRateLimitApiServer struct{}
+
+func (UnimplementedRateLimitApiServer) ListClientUsages(context.Context, *ListClientUsagesRequest) (*ListClientUsagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientUsages not implemented")
+}
+func (UnimplementedRateLimitApiServer) GetClientUsage(context.Context, *GetClientUsageRequest) (*ClientUsage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClientUsage not implemented")
+}
+func (UnimplementedRateLimitApiServer) mustEmbedUnimplementedRateLimitApiServer() {}
+func (UnimplementedRateLimitApiServer) testEmbeddedByValue()                      {}
+
+// UnsafeRateLimitApiServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RateLimitApiServer will
+// result in compilation errors.
+type UnsafeRateLimitApiServer interface {
+	mustEmbedUnimplementedRateLimitApiServer()
+}
+
+func RegisterRateLimitApiServer(s grpc.ServiceRegistrar, srv RateLimitApiServer) {
+	// If the following call pancis, it indicates UnimplementedRateLimitApiServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RateLimitApi_ServiceDesc, srv)
+}
+
+func _RateLimitApi_ListClientUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientUsagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateLimitApiServer).ListClientUsages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RateLimitApi_ListClientUsages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateLimitApiServer).ListClientUsages(ctx, req.(*ListClientUsagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RateLimitApi_GetClientUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClientUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateLimitApiServer).GetClientUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RateLimitApi_GetClientUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateLimitApiServer).GetClientUsage(ctx, req.(*GetClientUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RateLimitApi_ServiceDesc is the grpc.ServiceDesc for RateLimitApi service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RateLimitApi_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "smartcore.bos.ratelimit.v1.RateLimitApi",
+	HandlerType: (*RateLimitApiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListClientUsages",
+			Handler:    _RateLimitApi_ListClientUsages_Handler,
+		},
+		{
+			MethodName: "GetClientUsage",
+			Handler:    _RateLimitApi_GetClientUsage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "smartcore/bos/ratelimit/v1/ratelimit.proto",
+}
@@ -221,6 +221,57 @@ func (SecurityEvent_EventType) EnumDescriptor() ([]byte, []int) {
 	return file_smartcore_bos_securityevent_v1_security_event_proto_rawDescGZIP(), []int{0, 1}
 }
 
+type SecurityEvent_Media_Type int32
+
+const (
+	SecurityEvent_Media_TYPE_UNSPECIFIED SecurityEvent_Media_Type = 0
+	// A still image captured when the event occurred.
+	SecurityEvent_Media_SNAPSHOT SecurityEvent_Media_Type = 1
+	// A recorded video clip covering the time around the event.
+	SecurityEvent_Media_CLIP SecurityEvent_Media_Type = 2
+)
+
+// Enum value maps for SecurityEvent_Media_Type.
+var (
+	SecurityEvent_Media_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "SNAPSHOT",
+		2: "CLIP",
+	}
+	SecurityEvent_Media_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"SNAPSHOT":         1,
+		"CLIP":             2,
+	}
+)
+
+func (x SecurityEvent_Media_Type) Enum() *SecurityEvent_Media_Type {
+	p := new(SecurityEvent_Media_Type)
+	*p = x
+	return p
+}
+
+func (x SecurityEvent_Media_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SecurityEvent_Media_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_smartcore_bos_securityevent_v1_security_event_proto_enumTypes[2].Descriptor()
+}
+
+func (SecurityEvent_Media_Type) Type() protoreflect.EnumType {
+	return &file_smartcore_bos_securityevent_v1_security_event_proto_enumTypes[2]
+}
+
+func (x SecurityEvent_Media_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SecurityEvent_Media_Type.Descriptor instead.
+func (SecurityEvent_Media_Type) EnumDescriptor() ([]byte, []int) {
+	return file_smartcore_bos_securityevent_v1_security_event_proto_rawDescGZIP(), []int{0, 1, 0}
+}
+
 // SecurityEvent describes a security event that has occurred.
 // At a minimum this should define the time the event occurred, a description of the event
 // and a unique ID for the event, typically derived from the originating system.
@@ -244,7 +295,9 @@ type SecurityEvent struct {
 	// Optional. The priority of the security event
 	Priority int32 `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
 	// Optional. The type of the security event
-	EventType     SecurityEvent_EventType `protobuf:"varint,8,opt,name=event_type,json=eventType,proto3,enum=smartcore.bos.securityevent.v1.SecurityEvent_EventType" json:"event_type,omitempty"`
+	EventType SecurityEvent_EventType `protobuf:"varint,8,opt,name=event_type,json=eventType,proto3,enum=smartcore.bos.securityevent.v1.SecurityEvent_EventType" json:"event_type,omitempty"`
+	// Optional. Media captured relating to the event.
+	Media         []*SecurityEvent_Media `protobuf:"bytes,9,rep,name=media,proto3" json:"media,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -335,6 +388,13 @@ func (x *SecurityEvent) GetEventType() SecurityEvent_EventType {
 	return SecurityEvent_EVENT_TYPE_UNKNOWN
 }
 
+func (x *SecurityEvent) GetMedia() []*SecurityEvent_Media {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
 type ListSecurityEventsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The name of the device to get security events for.
@@ -660,6 +720,105 @@ func (x *SecurityEvent_Source) GetZone() string {
 	return ""
 }
 
+// Media is visual evidence of what triggered the event, like a camera snapshot or video clip.
+type SecurityEvent_Media struct {
+	state protoimpl.MessageState   `protogen:"open.v1"`
+	Type  SecurityEvent_Media_Type `protobuf:"varint,1,opt,name=type,proto3,enum=smartcore.bos.securityevent.v1.SecurityEvent_Media_Type" json:"type,omitempty"`
+	// The url the media can be downloaded from.
+	// Urls are typically signed and short-lived, fetch the event again for a fresh url.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// The latest time the url will be valid for, you will not be able to use the url after this time.
+	ExpireAfterTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expire_after_time,json=expireAfterTime,proto3" json:"expire_after_time,omitempty"`
+	// The media type of the content, like image/jpeg, if known.
+	MediaType string `protobuf:"bytes,4,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	// For CLIP media, the period of time the clip covers.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// The name of the device that captured the media, typically a camera.
+	Source        string `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecurityEvent_Media) Reset() {
+	*x = SecurityEvent_Media{}
+	mi := &file_smartcore_bos_securityevent_v1_security_event_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityEvent_Media) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityEvent_Media) ProtoMessage() {}
+
+func (x *SecurityEvent_Media) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_securityevent_v1_security_event_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityEvent_Media.ProtoReflect.Descriptor instead.
+func (*SecurityEvent_Media) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_securityevent_v1_security_event_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *SecurityEvent_Media) GetType() SecurityEvent_Media_Type {
+	if x != nil {
+		return x.Type
+	}
+	return SecurityEvent_Media_TYPE_UNSPECIFIED
+}
+
+func (x *SecurityEvent_Media) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *SecurityEvent_Media) GetExpireAfterTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireAfterTime
+	}
+	return nil
+}
+
+func (x *SecurityEvent_Media) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *SecurityEvent_Media) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *SecurityEvent_Media) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *SecurityEvent_Media) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
 type PullSecurityEventsResponse_Change struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -673,7 +832,7 @@ type PullSecurityEventsResponse_Change struct {
 
 func (x *PullSecurityEventsResponse_Change) Reset() {
 	*x = PullSecurityEventsResponse_Change{}
-	mi := &file_smartcore_bos_securityevent_v1_security_event_proto_msgTypes[6]
+	mi := &file_smartcore_bos_securityevent_v1_security_event_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -685,7 +844,7 @@ func (x *PullSecurityEventsResponse_Change) String() string {
 func (*PullSecurityEventsResponse_Change) ProtoMessage() {}
 
 func (x *PullSecurityEventsResponse_Change) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_securityevent_v1_security_event_proto_msgTypes[6]
+	mi := &file_smartcore_bos_securityevent_v1_security_event_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -740,7 +899,7 @@ var File_smartcore_bos_securityevent_v1_security_event_proto protoreflect.FileDe
 
 const file_smartcore_bos_securityevent_v1_security_event_proto_rawDesc = "" +
 	"\n" +
-	"3smartcore/bos/securityevent/v1/security_event.proto\x12\x1esmartcore.bos.securityevent.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\"smartcore/bos/actor/v1/actor.proto\x1a#smartcore/bos/types/v1/change.proto\"\xa9\t\n" +
+	"3smartcore/bos/securityevent/v1/security_event.proto\x12\x1esmartcore.bos.securityevent.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\"smartcore/bos/actor/v1/actor.proto\x1a#smartcore/bos/types/v1/change.proto\"\x85\r\n" +
 	"\rSecurityEvent\x12J\n" +
 	"\x13security_event_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x11securityEventTime\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x0e\n" +
@@ -750,13 +909,28 @@ const file_smartcore_bos_securityevent_v1_security_event_proto_rawDesc = "" +
 	"\x05state\x18\x06 \x01(\x0e23.smartcore.bos.securityevent.v1.SecurityEvent.StateR\x05state\x12\x1a\n" +
 	"\bpriority\x18\a \x01(\x05R\bpriority\x12V\n" +
 	"\n" +
-	"event_type\x18\b \x01(\x0e27.smartcore.bos.securityevent.v1.SecurityEvent.EventTypeR\teventType\x1at\n" +
+	"event_type\x18\b \x01(\x0e27.smartcore.bos.securityevent.v1.SecurityEvent.EventTypeR\teventType\x12I\n" +
+	"\x05media\x18\t \x03(\v23.smartcore.bos.securityevent.v1.SecurityEvent.MediaR\x05media\x1at\n" +
 	"\x06Source\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
 	"\tsubsystem\x18\x03 \x01(\tR\tsubsystem\x12\x14\n" +
 	"\x05floor\x18\x04 \x01(\tR\x05floor\x12\x12\n" +
-	"\x04zone\x18\x05 \x01(\tR\x04zone\"N\n" +
+	"\x04zone\x18\x05 \x01(\tR\x04zone\x1a\x8e\x03\n" +
+	"\x05Media\x12L\n" +
+	"\x04type\x18\x01 \x01(\x0e28.smartcore.bos.securityevent.v1.SecurityEvent.Media.TypeR\x04type\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12F\n" +
+	"\x11expire_after_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0fexpireAfterTime\x12\x1d\n" +
+	"\n" +
+	"media_type\x18\x04 \x01(\tR\tmediaType\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x16\n" +
+	"\x06source\x18\a \x01(\tR\x06source\"4\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bSNAPSHOT\x10\x01\x12\b\n" +
+	"\x04CLIP\x10\x02\"N\n" +
 	"\x05State\x12\x11\n" +
 	"\rSTATE_UNKNOWN\x10\x00\x12\x12\n" +
 	"\x0eUNACKNOWLEDGED\x10\x01\x12\x10\n" +
@@ -833,46 +1007,53 @@ func file_smartcore_bos_securityevent_v1_security_event_proto_rawDescGZIP() []by
 	return file_smartcore_bos_securityevent_v1_security_event_proto_rawDescData
 }
 
-var file_smartcore_bos_securityevent_v1_security_event_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_smartcore_bos_securityevent_v1_security_event_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_smartcore_bos_securityevent_v1_security_event_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_smartcore_bos_securityevent_v1_security_event_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_smartcore_bos_securityevent_v1_security_event_proto_goTypes = []any{
 	(SecurityEvent_State)(0),                  // 0: smartcore.bos.securityevent.v1.SecurityEvent.State
 	(SecurityEvent_EventType)(0),              // 1: smartcore.bos.securityevent.v1.SecurityEvent.EventType
-	(*SecurityEvent)(nil),                     // 2: smartcore.bos.securityevent.v1.SecurityEvent
-	(*ListSecurityEventsRequest)(nil),         // 3: smartcore.bos.securityevent.v1.ListSecurityEventsRequest
-	(*ListSecurityEventsResponse)(nil),        // 4: smartcore.bos.securityevent.v1.ListSecurityEventsResponse
-	(*PullSecurityEventsRequest)(nil),         // 5: smartcore.bos.securityevent.v1.PullSecurityEventsRequest
-	(*PullSecurityEventsResponse)(nil),        // 6: smartcore.bos.securityevent.v1.PullSecurityEventsResponse
-	(*SecurityEvent_Source)(nil),              // 7: smartcore.bos.securityevent.v1.SecurityEvent.Source
-	(*PullSecurityEventsResponse_Change)(nil), // 8: smartcore.bos.securityevent.v1.PullSecurityEventsResponse.Change
-	(*timestamppb.Timestamp)(nil),             // 9: google.protobuf.Timestamp
-	(*actorpb.Actor)(nil),                     // 10: smartcore.bos.actor.v1.Actor
-	(*fieldmaskpb.FieldMask)(nil),             // 11: google.protobuf.FieldMask
-	(typespb.ChangeType)(0),                   // 12: smartcore.bos.types.v1.ChangeType
+	(SecurityEvent_Media_Type)(0),             // 2: smartcore.bos.securityevent.v1.SecurityEvent.Media.Type
+	(*SecurityEvent)(nil),                     // 3: smartcore.bos.securityevent.v1.SecurityEvent
+	(*ListSecurityEventsRequest)(nil),         // 4: smartcore.bos.securityevent.v1.ListSecurityEventsRequest
+	(*ListSecurityEventsResponse)(nil),        // 5: smartcore.bos.securityevent.v1.ListSecurityEventsResponse
+	(*PullSecurityEventsRequest)(nil),         // 6: smartcore.bos.securityevent.v1.PullSecurityEventsRequest
+	(*PullSecurityEventsResponse)(nil),        // 7: smartcore.bos.securityevent.v1.PullSecurityEventsResponse
+	(*SecurityEvent_Source)(nil),              // 8: smartcore.bos.securityevent.v1.SecurityEvent.Source
+	(*SecurityEvent_Media)(nil),               // 9: smartcore.bos.securityevent.v1.SecurityEvent.Media
+	(*PullSecurityEventsResponse_Change)(nil), // 10: smartcore.bos.securityevent.v1.PullSecurityEventsResponse.Change
+	(*timestamppb.Timestamp)(nil),             // 11: google.protobuf.Timestamp
+	(*actorpb.Actor)(nil),                     // 12: smartcore.bos.actor.v1.Actor
+	(*fieldmaskpb.FieldMask)(nil),             // 13: google.protobuf.FieldMask
+	(typespb.ChangeType)(0),                   // 14: smartcore.bos.types.v1.ChangeType
 }
 var file_smartcore_bos_securityevent_v1_security_event_proto_depIdxs = []int32{
-	9,  // 0: smartcore.bos.securityevent.v1.SecurityEvent.security_event_time:type_name -> google.protobuf.Timestamp
-	7,  // 1: smartcore.bos.securityevent.v1.SecurityEvent.source:type_name -> smartcore.bos.securityevent.v1.SecurityEvent.Source
-	10, // 2: smartcore.bos.securityevent.v1.SecurityEvent.actor:type_name -> smartcore.bos.actor.v1.Actor
+	11, // 0: smartcore.bos.securityevent.v1.SecurityEvent.security_event_time:type_name -> google.protobuf.Timestamp
+	8,  // 1: smartcore.bos.securityevent.v1.SecurityEvent.source:type_name -> smartcore.bos.securityevent.v1.SecurityEvent.Source
+	12, // 2: smartcore.bos.securityevent.v1.SecurityEvent.actor:type_name -> smartcore.bos.actor.v1.Actor
 	0,  // 3: smartcore.bos.securityevent.v1.SecurityEvent.state:type_name -> smartcore.bos.securityevent.v1.SecurityEvent.State
 	1,  // 4: smartcore.bos.securityevent.v1.SecurityEvent.event_type:type_name -> smartcore.bos.securityevent.v1.SecurityEvent.EventType
-	11, // 5: smartcore.bos.securityevent.v1.ListSecurityEventsRequest.read_mask:type_name -> google.protobuf.FieldMask
-	2,  // 6: smartcore.bos.securityevent.v1.ListSecurityEventsResponse.security_events:type_name -> smartcore.bos.securityevent.v1.SecurityEvent
-	11, // 7: smartcore.bos.securityevent.v1.PullSecurityEventsRequest.read_mask:type_name -> google.protobuf.FieldMask
-	8,  // 8: smartcore.bos.securityevent.v1.PullSecurityEventsResponse.changes:type_name -> smartcore.bos.securityevent.v1.PullSecurityEventsResponse.Change
-	9,  // 9: smartcore.bos.securityevent.v1.PullSecurityEventsResponse.Change.change_time:type_name -> google.protobuf.Timestamp
-	2,  // 10: smartcore.bos.securityevent.v1.PullSecurityEventsResponse.Change.new_value:type_name -> smartcore.bos.securityevent.v1.SecurityEvent
-	2,  // 11: smartcore.bos.securityevent.v1.PullSecurityEventsResponse.Change.old_value:type_name -> smartcore.bos.securityevent.v1.SecurityEvent
-	12, // 12: smartcore.bos.securityevent.v1.PullSecurityEventsResponse.Change.type:type_name -> smartcore.bos.types.v1.ChangeType
-	3,  // 13: smartcore.bos.securityevent.v1.SecurityEventApi.ListSecurityEvents:input_type -> smartcore.bos.securityevent.v1.ListSecurityEventsRequest
-	5,  // 14: smartcore.bos.securityevent.v1.SecurityEventApi.PullSecurityEvents:input_type -> smartcore.bos.securityevent.v1.PullSecurityEventsRequest
-	4,  // 15: smartcore.bos.securityevent.v1.SecurityEventApi.ListSecurityEvents:output_type -> smartcore.bos.securityevent.v1.ListSecurityEventsResponse
-	6,  // 16: smartcore.bos.securityevent.v1.SecurityEventApi.PullSecurityEvents:output_type -> smartcore.bos.securityevent.v1.PullSecurityEventsResponse
-	15, // [15:17] is the sub-list for method output_type
-	13, // [13:15] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	9,  // 5: smartcore.bos.securityevent.v1.SecurityEvent.media:type_name -> smartcore.bos.securityevent.v1.SecurityEvent.Media
+	13, // 6: smartcore.bos.securityevent.v1.ListSecurityEventsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	3,  // 7: smartcore.bos.securityevent.v1.ListSecurityEventsResponse.security_events:type_name -> smartcore.bos.securityevent.v1.SecurityEvent
+	13, // 8: smartcore.bos.securityevent.v1.PullSecurityEventsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	10, // 9: smartcore.bos.securityevent.v1.PullSecurityEventsResponse.changes:type_name -> smartcore.bos.securityevent.v1.PullSecurityEventsResponse.Change
+	2,  // 10: smartcore.bos.securityevent.v1.SecurityEvent.Media.type:type_name -> smartcore.bos.securityevent.v1.SecurityEvent.Media.Type
+	11, // 11: smartcore.bos.securityevent.v1.SecurityEvent.Media.expire_after_time:type_name -> google.protobuf.Timestamp
+	11, // 12: smartcore.bos.securityevent.v1.SecurityEvent.Media.start_time:type_name -> google.protobuf.Timestamp
+	11, // 13: smartcore.bos.securityevent.v1.SecurityEvent.Media.end_time:type_name -> google.protobuf.Timestamp
+	11, // 14: smartcore.bos.securityevent.v1.PullSecurityEventsResponse.Change.change_time:type_name -> google.protobuf.Timestamp
+	3,  // 15: smartcore.bos.securityevent.v1.PullSecurityEventsResponse.Change.new_value:type_name -> smartcore.bos.securityevent.v1.SecurityEvent
+	3,  // 16: smartcore.bos.securityevent.v1.PullSecurityEventsResponse.Change.old_value:type_name -> smartcore.bos.securityevent.v1.SecurityEvent
+	14, // 17: smartcore.bos.securityevent.v1.PullSecurityEventsResponse.Change.type:type_name -> smartcore.bos.types.v1.ChangeType
+	4,  // 18: smartcore.bos.securityevent.v1.SecurityEventApi.ListSecurityEvents:input_type -> smartcore.bos.securityevent.v1.ListSecurityEventsRequest
+	6,  // 19: smartcore.bos.securityevent.v1.SecurityEventApi.PullSecurityEvents:input_type -> smartcore.bos.securityevent.v1.PullSecurityEventsRequest
+	5,  // 20: smartcore.bos.securityevent.v1.SecurityEventApi.ListSecurityEvents:output_type -> smartcore.bos.securityevent.v1.ListSecurityEventsResponse
+	7,  // 21: smartcore.bos.securityevent.v1.SecurityEventApi.PullSecurityEvents:output_type -> smartcore.bos.securityevent.v1.PullSecurityEventsResponse
+	20, // [20:22] is the sub-list for method output_type
+	18, // [18:20] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_smartcore_bos_securityevent_v1_security_event_proto_init() }
@@ -885,8 +1066,8 @@ func file_smartcore_bos_securityevent_v1_security_event_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_smartcore_bos_securityevent_v1_security_event_proto_rawDesc), len(file_smartcore_bos_securityevent_v1_security_event_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   7,
+			NumEnums:      3,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
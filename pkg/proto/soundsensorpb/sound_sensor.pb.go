@@ -30,8 +30,11 @@ type SoundLevel struct {
 	// Optional. The measured sound/noise level, usually in dBA or dB, use Info service to check the unit.
 	// Omitted means unknown sound/noise level.
 	SoundPressureLevel *float32 `protobuf:"fixed32,1,opt,name=sound_pressure_level,json=soundPressureLevel,proto3,oneof" json:"sound_pressure_level,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Optional, read-only. How the values of a group of devices were aggregated to produce this value, keyed by field name.
+	// Present when this value is aggregated from multiple devices, like for a zone.
+	Aggregations  map[string]*typespb.Aggregation `protobuf:"bytes,2,rep,name=aggregations,proto3" json:"aggregations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SoundLevel) Reset() {
@@ -71,6 +74,13 @@ func (x *SoundLevel) GetSoundPressureLevel() float32 {
 	return 0
 }
 
+func (x *SoundLevel) GetAggregations() map[string]*typespb.Aggregation {
+	if x != nil {
+		return x.Aggregations
+	}
+	return nil
+}
+
 type SoundLevelSupport struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// How a named device supports read/write/pull apis
@@ -339,7 +349,7 @@ type PullSoundLevelResponse_Change struct {
 
 func (x *PullSoundLevelResponse_Change) Reset() {
 	*x = PullSoundLevelResponse_Change{}
-	mi := &file_smartcore_bos_soundsensor_v1_sound_sensor_proto_msgTypes[6]
+	mi := &file_smartcore_bos_soundsensor_v1_sound_sensor_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -351,7 +361,7 @@ func (x *PullSoundLevelResponse_Change) String() string {
 func (*PullSoundLevelResponse_Change) ProtoMessage() {}
 
 func (x *PullSoundLevelResponse_Change) ProtoReflect() protoreflect.Message {
-	mi := &file_smartcore_bos_soundsensor_v1_sound_sensor_proto_msgTypes[6]
+	mi := &file_smartcore_bos_soundsensor_v1_sound_sensor_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -392,10 +402,14 @@ var File_smartcore_bos_soundsensor_v1_sound_sensor_proto protoreflect.FileDescri
 
 const file_smartcore_bos_soundsensor_v1_sound_sensor_proto_rawDesc = "" +
 	"\n" +
-	"/smartcore/bos/soundsensor/v1/sound_sensor.proto\x12\x1csmartcore.bos.soundsensor.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a!smartcore/bos/types/v1/info.proto\"\\\n" +
+	"/smartcore/bos/soundsensor/v1/sound_sensor.proto\x12\x1csmartcore.bos.soundsensor.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a(smartcore/bos/types/v1/aggregation.proto\x1a!smartcore/bos/types/v1/info.proto\"\xa2\x02\n" +
 	"\n" +
 	"SoundLevel\x125\n" +
-	"\x14sound_pressure_level\x18\x01 \x01(\x02H\x00R\x12soundPressureLevel\x88\x01\x01B\x17\n" +
+	"\x14sound_pressure_level\x18\x01 \x01(\x02H\x00R\x12soundPressureLevel\x88\x01\x01\x12^\n" +
+	"\faggregations\x18\x02 \x03(\v2:.smartcore.bos.soundsensor.v1.SoundLevel.AggregationsEntryR\faggregations\x1ad\n" +
+	"\x11AggregationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x129\n" +
+	"\x05value\x18\x02 \x01(\v2#.smartcore.bos.types.v1.AggregationR\x05value:\x028\x01B\x17\n" +
 	"\x15_sound_pressure_level\"\x91\x01\n" +
 	"\x11SoundLevelSupport\x12R\n" +
 	"\x10resource_support\x18\x01 \x01(\v2'.smartcore.bos.types.v1.ResourceSupportR\x0fresourceSupport\x12(\n" +
@@ -435,7 +449,7 @@ func file_smartcore_bos_soundsensor_v1_sound_sensor_proto_rawDescGZIP() []byte {
 	return file_smartcore_bos_soundsensor_v1_sound_sensor_proto_rawDescData
 }
 
-var file_smartcore_bos_soundsensor_v1_sound_sensor_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_smartcore_bos_soundsensor_v1_sound_sensor_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_smartcore_bos_soundsensor_v1_sound_sensor_proto_goTypes = []any{
 	(*SoundLevel)(nil),                    // 0: smartcore.bos.soundsensor.v1.SoundLevel
 	(*SoundLevelSupport)(nil),             // 1: smartcore.bos.soundsensor.v1.SoundLevelSupport
@@ -443,29 +457,33 @@ var file_smartcore_bos_soundsensor_v1_sound_sensor_proto_goTypes = []any{
 	(*PullSoundLevelRequest)(nil),         // 3: smartcore.bos.soundsensor.v1.PullSoundLevelRequest
 	(*PullSoundLevelResponse)(nil),        // 4: smartcore.bos.soundsensor.v1.PullSoundLevelResponse
 	(*DescribeSoundLevelRequest)(nil),     // 5: smartcore.bos.soundsensor.v1.DescribeSoundLevelRequest
-	(*PullSoundLevelResponse_Change)(nil), // 6: smartcore.bos.soundsensor.v1.PullSoundLevelResponse.Change
-	(*typespb.ResourceSupport)(nil),       // 7: smartcore.bos.types.v1.ResourceSupport
-	(*fieldmaskpb.FieldMask)(nil),         // 8: google.protobuf.FieldMask
-	(*timestamppb.Timestamp)(nil),         // 9: google.protobuf.Timestamp
+	nil,                                   // 6: smartcore.bos.soundsensor.v1.SoundLevel.AggregationsEntry
+	(*PullSoundLevelResponse_Change)(nil), // 7: smartcore.bos.soundsensor.v1.PullSoundLevelResponse.Change
+	(*typespb.ResourceSupport)(nil),       // 8: smartcore.bos.types.v1.ResourceSupport
+	(*fieldmaskpb.FieldMask)(nil),         // 9: google.protobuf.FieldMask
+	(*typespb.Aggregation)(nil),           // 10: smartcore.bos.types.v1.Aggregation
+	(*timestamppb.Timestamp)(nil),         // 11: google.protobuf.Timestamp
 }
 var file_smartcore_bos_soundsensor_v1_sound_sensor_proto_depIdxs = []int32{
-	7, // 0: smartcore.bos.soundsensor.v1.SoundLevelSupport.resource_support:type_name -> smartcore.bos.types.v1.ResourceSupport
-	8, // 1: smartcore.bos.soundsensor.v1.GetSoundLevelRequest.read_mask:type_name -> google.protobuf.FieldMask
-	8, // 2: smartcore.bos.soundsensor.v1.PullSoundLevelRequest.read_mask:type_name -> google.protobuf.FieldMask
-	6, // 3: smartcore.bos.soundsensor.v1.PullSoundLevelResponse.changes:type_name -> smartcore.bos.soundsensor.v1.PullSoundLevelResponse.Change
-	9, // 4: smartcore.bos.soundsensor.v1.PullSoundLevelResponse.Change.change_time:type_name -> google.protobuf.Timestamp
-	0, // 5: smartcore.bos.soundsensor.v1.PullSoundLevelResponse.Change.sound_level:type_name -> smartcore.bos.soundsensor.v1.SoundLevel
-	2, // 6: smartcore.bos.soundsensor.v1.SoundSensorApi.GetSoundLevel:input_type -> smartcore.bos.soundsensor.v1.GetSoundLevelRequest
-	3, // 7: smartcore.bos.soundsensor.v1.SoundSensorApi.PullSoundLevel:input_type -> smartcore.bos.soundsensor.v1.PullSoundLevelRequest
-	5, // 8: smartcore.bos.soundsensor.v1.SoundSensorInfo.DescribeSoundLevel:input_type -> smartcore.bos.soundsensor.v1.DescribeSoundLevelRequest
-	0, // 9: smartcore.bos.soundsensor.v1.SoundSensorApi.GetSoundLevel:output_type -> smartcore.bos.soundsensor.v1.SoundLevel
-	4, // 10: smartcore.bos.soundsensor.v1.SoundSensorApi.PullSoundLevel:output_type -> smartcore.bos.soundsensor.v1.PullSoundLevelResponse
-	1, // 11: smartcore.bos.soundsensor.v1.SoundSensorInfo.DescribeSoundLevel:output_type -> smartcore.bos.soundsensor.v1.SoundLevelSupport
-	9, // [9:12] is the sub-list for method output_type
-	6, // [6:9] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	6,  // 0: smartcore.bos.soundsensor.v1.SoundLevel.aggregations:type_name -> smartcore.bos.soundsensor.v1.SoundLevel.AggregationsEntry
+	8,  // 1: smartcore.bos.soundsensor.v1.SoundLevelSupport.resource_support:type_name -> smartcore.bos.types.v1.ResourceSupport
+	9,  // 2: smartcore.bos.soundsensor.v1.GetSoundLevelRequest.read_mask:type_name -> google.protobuf.FieldMask
+	9,  // 3: smartcore.bos.soundsensor.v1.PullSoundLevelRequest.read_mask:type_name -> google.protobuf.FieldMask
+	7,  // 4: smartcore.bos.soundsensor.v1.PullSoundLevelResponse.changes:type_name -> smartcore.bos.soundsensor.v1.PullSoundLevelResponse.Change
+	10, // 5: smartcore.bos.soundsensor.v1.SoundLevel.AggregationsEntry.value:type_name -> smartcore.bos.types.v1.Aggregation
+	11, // 6: smartcore.bos.soundsensor.v1.PullSoundLevelResponse.Change.change_time:type_name -> google.protobuf.Timestamp
+	0,  // 7: smartcore.bos.soundsensor.v1.PullSoundLevelResponse.Change.sound_level:type_name -> smartcore.bos.soundsensor.v1.SoundLevel
+	2,  // 8: smartcore.bos.soundsensor.v1.SoundSensorApi.GetSoundLevel:input_type -> smartcore.bos.soundsensor.v1.GetSoundLevelRequest
+	3,  // 9: smartcore.bos.soundsensor.v1.SoundSensorApi.PullSoundLevel:input_type -> smartcore.bos.soundsensor.v1.PullSoundLevelRequest
+	5,  // 10: smartcore.bos.soundsensor.v1.SoundSensorInfo.DescribeSoundLevel:input_type -> smartcore.bos.soundsensor.v1.DescribeSoundLevelRequest
+	0,  // 11: smartcore.bos.soundsensor.v1.SoundSensorApi.GetSoundLevel:output_type -> smartcore.bos.soundsensor.v1.SoundLevel
+	4,  // 12: smartcore.bos.soundsensor.v1.SoundSensorApi.PullSoundLevel:output_type -> smartcore.bos.soundsensor.v1.PullSoundLevelResponse
+	1,  // 13: smartcore.bos.soundsensor.v1.SoundSensorInfo.DescribeSoundLevel:output_type -> smartcore.bos.soundsensor.v1.SoundLevelSupport
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_smartcore_bos_soundsensor_v1_sound_sensor_proto_init() }
@@ -480,7 +498,7 @@ func file_smartcore_bos_soundsensor_v1_sound_sensor_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_smartcore_bos_soundsensor_v1_sound_sensor_proto_rawDesc), len(file_smartcore_bos_soundsensor_v1_sound_sensor_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
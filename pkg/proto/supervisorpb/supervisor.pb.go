@@ -190,7 +190,10 @@ type InstallUpdateRequest struct {
 	// An opaque identifier for this update, stored and echoed back in UpdateStatus. The Supervisor does
 	// not interpret it; it lets the caller correlate the version-keyed status with its own record. May
 	// be empty.
-	DeploymentId  string `protobuf:"bytes,4,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	DeploymentId string `protobuf:"bytes,4,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	// A detached Ed25519ph (RFC 8032) signature of the artefact, verified against the Supervisor's
+	// configured signing key before installing. Required when the Supervisor has a signing key.
+	Signature     []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -253,6 +256,13 @@ func (x *InstallUpdateRequest) GetDeploymentId() string {
 	return ""
 }
 
+func (x *InstallUpdateRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 type InstallUpdateResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The status of the update after it was accepted.
@@ -483,12 +493,13 @@ const file_smartcore_bos_supervisor_v1_supervisor_proto_rawDesc = "" +
 	"INSTALLING\x10\x03\x12\r\n" +
 	"\tCOMPLETED\x10\x04\x12\n" +
 	"\n" +
-	"\x06FAILED\x10\x05\"\x94\x01\n" +
+	"\x06FAILED\x10\x05\"\xb2\x01\n" +
 	"\x14InstallUpdateRequest\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\tR\aversion\x12!\n" +
 	"\fdownload_url\x18\x02 \x01(\tR\vdownloadUrl\x12\x1a\n" +
 	"\bchecksum\x18\x03 \x01(\tR\bchecksum\x12#\n" +
-	"\rdeployment_id\x18\x04 \x01(\tR\fdeploymentId\"Z\n" +
+	"\rdeployment_id\x18\x04 \x01(\tR\fdeploymentId\x12\x1c\n" +
+	"\tsignature\x18\x05 \x01(\fR\tsignature\"Z\n" +
 	"\x15InstallUpdateResponse\x12A\n" +
 	"\x06status\x18\x01 \x01(\v2).smartcore.bos.supervisor.v1.UpdateStatusR\x06status\"\x18\n" +
 	"\x16GetUpdateStatusRequest\"\\\n" +
@@ -7,6 +7,7 @@
 package tenantpb
 
 import (
+	accesspb "github.com/smart-core-os/sc-bos/pkg/proto/accesspb"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
@@ -435,8 +436,12 @@ func (x *PullTenantsResponse) GetChanges() []*PullTenantsResponse_Change {
 }
 
 type CreateTenantRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tenant        *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Tenant *Tenant                `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// Cardholders to create in the access control system along with the tenant, granting them building access.
+	// If any cardholder can't be created the tenant is not created.
+	// Requires the tenant service to be configured with an access control system.
+	Cardholders   []*accesspb.Cardholder `protobuf:"bytes,2,rep,name=cardholders,proto3" json:"cardholders,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -478,6 +483,13 @@ func (x *CreateTenantRequest) GetTenant() *Tenant {
 	return nil
 }
 
+func (x *CreateTenantRequest) GetCardholders() []*accesspb.Cardholder {
+	if x != nil {
+		return x.Cardholders
+	}
+	return nil
+}
+
 type GetTenantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1764,7 +1776,7 @@ var File_smartcore_bos_tenant_v1_tenants_proto protoreflect.FileDescriptor
 
 const file_smartcore_bos_tenant_v1_tenants_proto_rawDesc = "" +
 	"\n" +
-	"%smartcore/bos/tenant/v1/tenants.proto\x12\x17smartcore.bos.tenant.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9e\x01\n" +
+	"%smartcore/bos/tenant/v1/tenants.proto\x12\x17smartcore.bos.tenant.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a$smartcore/bos/access/v1/access.proto\"\x9e\x01\n" +
 	"\x06Tenant\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12;\n" +
@@ -1805,9 +1817,10 @@ const file_smartcore_bos_tenant_v1_tenants_proto_rawDesc = "" +
 	"\x06Change\x12;\n" +
 	"\vchange_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"changeTime\x127\n" +
-	"\x06tenant\x18\x02 \x01(\v2\x1f.smartcore.bos.tenant.v1.TenantR\x06tenant\"N\n" +
+	"\x06tenant\x18\x02 \x01(\v2\x1f.smartcore.bos.tenant.v1.TenantR\x06tenant\"\x95\x01\n" +
 	"\x13CreateTenantRequest\x127\n" +
-	"\x06tenant\x18\x01 \x01(\v2\x1f.smartcore.bos.tenant.v1.TenantR\x06tenant\"\"\n" +
+	"\x06tenant\x18\x01 \x01(\v2\x1f.smartcore.bos.tenant.v1.TenantR\x06tenant\x12E\n" +
+	"\vcardholders\x18\x02 \x03(\v2#.smartcore.bos.access.v1.CardholderR\vcardholders\"\"\n" +
 	"\x10GetTenantRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x8b\x01\n" +
 	"\x13UpdateTenantRequest\x127\n" +
@@ -1950,7 +1963,8 @@ var file_smartcore_bos_tenant_v1_tenants_proto_goTypes = []any{
 	(*PullSecretsResponse_Change)(nil), // 31: smartcore.bos.tenant.v1.PullSecretsResponse.Change
 	(*PullSecretResponse_Change)(nil),  // 32: smartcore.bos.tenant.v1.PullSecretResponse.Change
 	(*timestamppb.Timestamp)(nil),      // 33: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),      // 34: google.protobuf.FieldMask
+	(*accesspb.Cardholder)(nil),        // 34: smartcore.bos.access.v1.Cardholder
+	(*fieldmaskpb.FieldMask)(nil),      // 35: google.protobuf.FieldMask
 }
 var file_smartcore_bos_tenant_v1_tenants_proto_depIdxs = []int32{
 	33, // 0: smartcore.bos.tenant.v1.Tenant.create_time:type_name -> google.protobuf.Timestamp
@@ -1962,63 +1976,64 @@ var file_smartcore_bos_tenant_v1_tenants_proto_depIdxs = []int32{
 	0,  // 6: smartcore.bos.tenant.v1.ListTenantsResponse.tenants:type_name -> smartcore.bos.tenant.v1.Tenant
 	29, // 7: smartcore.bos.tenant.v1.PullTenantsResponse.changes:type_name -> smartcore.bos.tenant.v1.PullTenantsResponse.Change
 	0,  // 8: smartcore.bos.tenant.v1.CreateTenantRequest.tenant:type_name -> smartcore.bos.tenant.v1.Tenant
-	0,  // 9: smartcore.bos.tenant.v1.UpdateTenantRequest.tenant:type_name -> smartcore.bos.tenant.v1.Tenant
-	34, // 10: smartcore.bos.tenant.v1.UpdateTenantRequest.update_mask:type_name -> google.protobuf.FieldMask
-	30, // 11: smartcore.bos.tenant.v1.PullTenantResponse.changes:type_name -> smartcore.bos.tenant.v1.PullTenantResponse.Change
-	1,  // 12: smartcore.bos.tenant.v1.ListSecretsResponse.secrets:type_name -> smartcore.bos.tenant.v1.Secret
-	31, // 13: smartcore.bos.tenant.v1.PullSecretsResponse.changes:type_name -> smartcore.bos.tenant.v1.PullSecretsResponse.Change
-	1,  // 14: smartcore.bos.tenant.v1.CreateSecretRequest.secret:type_name -> smartcore.bos.tenant.v1.Secret
-	1,  // 15: smartcore.bos.tenant.v1.UpdateSecretRequest.secret:type_name -> smartcore.bos.tenant.v1.Secret
-	32, // 16: smartcore.bos.tenant.v1.PullSecretResponse.changes:type_name -> smartcore.bos.tenant.v1.PullSecretResponse.Change
-	33, // 17: smartcore.bos.tenant.v1.PullTenantsResponse.Change.change_time:type_name -> google.protobuf.Timestamp
-	0,  // 18: smartcore.bos.tenant.v1.PullTenantsResponse.Change.tenant:type_name -> smartcore.bos.tenant.v1.Tenant
-	33, // 19: smartcore.bos.tenant.v1.PullTenantResponse.Change.change_time:type_name -> google.protobuf.Timestamp
-	0,  // 20: smartcore.bos.tenant.v1.PullTenantResponse.Change.tenant:type_name -> smartcore.bos.tenant.v1.Tenant
-	33, // 21: smartcore.bos.tenant.v1.PullSecretsResponse.Change.change_time:type_name -> google.protobuf.Timestamp
-	1,  // 22: smartcore.bos.tenant.v1.PullSecretsResponse.Change.secret:type_name -> smartcore.bos.tenant.v1.Secret
-	33, // 23: smartcore.bos.tenant.v1.PullSecretResponse.Change.change_time:type_name -> google.protobuf.Timestamp
-	1,  // 24: smartcore.bos.tenant.v1.PullSecretResponse.Change.secret:type_name -> smartcore.bos.tenant.v1.Secret
-	2,  // 25: smartcore.bos.tenant.v1.TenantApi.ListTenants:input_type -> smartcore.bos.tenant.v1.ListTenantsRequest
-	4,  // 26: smartcore.bos.tenant.v1.TenantApi.PullTenants:input_type -> smartcore.bos.tenant.v1.PullTenantsRequest
-	6,  // 27: smartcore.bos.tenant.v1.TenantApi.CreateTenant:input_type -> smartcore.bos.tenant.v1.CreateTenantRequest
-	7,  // 28: smartcore.bos.tenant.v1.TenantApi.GetTenant:input_type -> smartcore.bos.tenant.v1.GetTenantRequest
-	8,  // 29: smartcore.bos.tenant.v1.TenantApi.UpdateTenant:input_type -> smartcore.bos.tenant.v1.UpdateTenantRequest
-	9,  // 30: smartcore.bos.tenant.v1.TenantApi.DeleteTenant:input_type -> smartcore.bos.tenant.v1.DeleteTenantRequest
-	11, // 31: smartcore.bos.tenant.v1.TenantApi.PullTenant:input_type -> smartcore.bos.tenant.v1.PullTenantRequest
-	13, // 32: smartcore.bos.tenant.v1.TenantApi.AddTenantZones:input_type -> smartcore.bos.tenant.v1.AddTenantZonesRequest
-	14, // 33: smartcore.bos.tenant.v1.TenantApi.RemoveTenantZones:input_type -> smartcore.bos.tenant.v1.RemoveTenantZonesRequest
-	15, // 34: smartcore.bos.tenant.v1.TenantApi.ListSecrets:input_type -> smartcore.bos.tenant.v1.ListSecretsRequest
-	17, // 35: smartcore.bos.tenant.v1.TenantApi.PullSecrets:input_type -> smartcore.bos.tenant.v1.PullSecretsRequest
-	19, // 36: smartcore.bos.tenant.v1.TenantApi.CreateSecret:input_type -> smartcore.bos.tenant.v1.CreateSecretRequest
-	20, // 37: smartcore.bos.tenant.v1.TenantApi.VerifySecret:input_type -> smartcore.bos.tenant.v1.VerifySecretRequest
-	21, // 38: smartcore.bos.tenant.v1.TenantApi.GetSecret:input_type -> smartcore.bos.tenant.v1.GetSecretRequest
-	23, // 39: smartcore.bos.tenant.v1.TenantApi.UpdateSecret:input_type -> smartcore.bos.tenant.v1.UpdateSecretRequest
-	24, // 40: smartcore.bos.tenant.v1.TenantApi.DeleteSecret:input_type -> smartcore.bos.tenant.v1.DeleteSecretRequest
-	26, // 41: smartcore.bos.tenant.v1.TenantApi.PullSecret:input_type -> smartcore.bos.tenant.v1.PullSecretRequest
-	28, // 42: smartcore.bos.tenant.v1.TenantApi.RegenerateSecret:input_type -> smartcore.bos.tenant.v1.RegenerateSecretRequest
-	3,  // 43: smartcore.bos.tenant.v1.TenantApi.ListTenants:output_type -> smartcore.bos.tenant.v1.ListTenantsResponse
-	5,  // 44: smartcore.bos.tenant.v1.TenantApi.PullTenants:output_type -> smartcore.bos.tenant.v1.PullTenantsResponse
-	0,  // 45: smartcore.bos.tenant.v1.TenantApi.CreateTenant:output_type -> smartcore.bos.tenant.v1.Tenant
-	0,  // 46: smartcore.bos.tenant.v1.TenantApi.GetTenant:output_type -> smartcore.bos.tenant.v1.Tenant
-	0,  // 47: smartcore.bos.tenant.v1.TenantApi.UpdateTenant:output_type -> smartcore.bos.tenant.v1.Tenant
-	10, // 48: smartcore.bos.tenant.v1.TenantApi.DeleteTenant:output_type -> smartcore.bos.tenant.v1.DeleteTenantResponse
-	12, // 49: smartcore.bos.tenant.v1.TenantApi.PullTenant:output_type -> smartcore.bos.tenant.v1.PullTenantResponse
-	0,  // 50: smartcore.bos.tenant.v1.TenantApi.AddTenantZones:output_type -> smartcore.bos.tenant.v1.Tenant
-	0,  // 51: smartcore.bos.tenant.v1.TenantApi.RemoveTenantZones:output_type -> smartcore.bos.tenant.v1.Tenant
-	16, // 52: smartcore.bos.tenant.v1.TenantApi.ListSecrets:output_type -> smartcore.bos.tenant.v1.ListSecretsResponse
-	18, // 53: smartcore.bos.tenant.v1.TenantApi.PullSecrets:output_type -> smartcore.bos.tenant.v1.PullSecretsResponse
-	1,  // 54: smartcore.bos.tenant.v1.TenantApi.CreateSecret:output_type -> smartcore.bos.tenant.v1.Secret
-	1,  // 55: smartcore.bos.tenant.v1.TenantApi.VerifySecret:output_type -> smartcore.bos.tenant.v1.Secret
-	1,  // 56: smartcore.bos.tenant.v1.TenantApi.GetSecret:output_type -> smartcore.bos.tenant.v1.Secret
-	1,  // 57: smartcore.bos.tenant.v1.TenantApi.UpdateSecret:output_type -> smartcore.bos.tenant.v1.Secret
-	25, // 58: smartcore.bos.tenant.v1.TenantApi.DeleteSecret:output_type -> smartcore.bos.tenant.v1.DeleteSecretResponse
-	27, // 59: smartcore.bos.tenant.v1.TenantApi.PullSecret:output_type -> smartcore.bos.tenant.v1.PullSecretResponse
-	1,  // 60: smartcore.bos.tenant.v1.TenantApi.RegenerateSecret:output_type -> smartcore.bos.tenant.v1.Secret
-	43, // [43:61] is the sub-list for method output_type
-	25, // [25:43] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	34, // 9: smartcore.bos.tenant.v1.CreateTenantRequest.cardholders:type_name -> smartcore.bos.access.v1.Cardholder
+	0,  // 10: smartcore.bos.tenant.v1.UpdateTenantRequest.tenant:type_name -> smartcore.bos.tenant.v1.Tenant
+	35, // 11: smartcore.bos.tenant.v1.UpdateTenantRequest.update_mask:type_name -> google.protobuf.FieldMask
+	30, // 12: smartcore.bos.tenant.v1.PullTenantResponse.changes:type_name -> smartcore.bos.tenant.v1.PullTenantResponse.Change
+	1,  // 13: smartcore.bos.tenant.v1.ListSecretsResponse.secrets:type_name -> smartcore.bos.tenant.v1.Secret
+	31, // 14: smartcore.bos.tenant.v1.PullSecretsResponse.changes:type_name -> smartcore.bos.tenant.v1.PullSecretsResponse.Change
+	1,  // 15: smartcore.bos.tenant.v1.CreateSecretRequest.secret:type_name -> smartcore.bos.tenant.v1.Secret
+	1,  // 16: smartcore.bos.tenant.v1.UpdateSecretRequest.secret:type_name -> smartcore.bos.tenant.v1.Secret
+	32, // 17: smartcore.bos.tenant.v1.PullSecretResponse.changes:type_name -> smartcore.bos.tenant.v1.PullSecretResponse.Change
+	33, // 18: smartcore.bos.tenant.v1.PullTenantsResponse.Change.change_time:type_name -> google.protobuf.Timestamp
+	0,  // 19: smartcore.bos.tenant.v1.PullTenantsResponse.Change.tenant:type_name -> smartcore.bos.tenant.v1.Tenant
+	33, // 20: smartcore.bos.tenant.v1.PullTenantResponse.Change.change_time:type_name -> google.protobuf.Timestamp
+	0,  // 21: smartcore.bos.tenant.v1.PullTenantResponse.Change.tenant:type_name -> smartcore.bos.tenant.v1.Tenant
+	33, // 22: smartcore.bos.tenant.v1.PullSecretsResponse.Change.change_time:type_name -> google.protobuf.Timestamp
+	1,  // 23: smartcore.bos.tenant.v1.PullSecretsResponse.Change.secret:type_name -> smartcore.bos.tenant.v1.Secret
+	33, // 24: smartcore.bos.tenant.v1.PullSecretResponse.Change.change_time:type_name -> google.protobuf.Timestamp
+	1,  // 25: smartcore.bos.tenant.v1.PullSecretResponse.Change.secret:type_name -> smartcore.bos.tenant.v1.Secret
+	2,  // 26: smartcore.bos.tenant.v1.TenantApi.ListTenants:input_type -> smartcore.bos.tenant.v1.ListTenantsRequest
+	4,  // 27: smartcore.bos.tenant.v1.TenantApi.PullTenants:input_type -> smartcore.bos.tenant.v1.PullTenantsRequest
+	6,  // 28: smartcore.bos.tenant.v1.TenantApi.CreateTenant:input_type -> smartcore.bos.tenant.v1.CreateTenantRequest
+	7,  // 29: smartcore.bos.tenant.v1.TenantApi.GetTenant:input_type -> smartcore.bos.tenant.v1.GetTenantRequest
+	8,  // 30: smartcore.bos.tenant.v1.TenantApi.UpdateTenant:input_type -> smartcore.bos.tenant.v1.UpdateTenantRequest
+	9,  // 31: smartcore.bos.tenant.v1.TenantApi.DeleteTenant:input_type -> smartcore.bos.tenant.v1.DeleteTenantRequest
+	11, // 32: smartcore.bos.tenant.v1.TenantApi.PullTenant:input_type -> smartcore.bos.tenant.v1.PullTenantRequest
+	13, // 33: smartcore.bos.tenant.v1.TenantApi.AddTenantZones:input_type -> smartcore.bos.tenant.v1.AddTenantZonesRequest
+	14, // 34: smartcore.bos.tenant.v1.TenantApi.RemoveTenantZones:input_type -> smartcore.bos.tenant.v1.RemoveTenantZonesRequest
+	15, // 35: smartcore.bos.tenant.v1.TenantApi.ListSecrets:input_type -> smartcore.bos.tenant.v1.ListSecretsRequest
+	17, // 36: smartcore.bos.tenant.v1.TenantApi.PullSecrets:input_type -> smartcore.bos.tenant.v1.PullSecretsRequest
+	19, // 37: smartcore.bos.tenant.v1.TenantApi.CreateSecret:input_type -> smartcore.bos.tenant.v1.CreateSecretRequest
+	20, // 38: smartcore.bos.tenant.v1.TenantApi.VerifySecret:input_type -> smartcore.bos.tenant.v1.VerifySecretRequest
+	21, // 39: smartcore.bos.tenant.v1.TenantApi.GetSecret:input_type -> smartcore.bos.tenant.v1.GetSecretRequest
+	23, // 40: smartcore.bos.tenant.v1.TenantApi.UpdateSecret:input_type -> smartcore.bos.tenant.v1.UpdateSecretRequest
+	24, // 41: smartcore.bos.tenant.v1.TenantApi.DeleteSecret:input_type -> smartcore.bos.tenant.v1.DeleteSecretRequest
+	26, // 42: smartcore.bos.tenant.v1.TenantApi.PullSecret:input_type -> smartcore.bos.tenant.v1.PullSecretRequest
+	28, // 43: smartcore.bos.tenant.v1.TenantApi.RegenerateSecret:input_type -> smartcore.bos.tenant.v1.RegenerateSecretRequest
+	3,  // 44: smartcore.bos.tenant.v1.TenantApi.ListTenants:output_type -> smartcore.bos.tenant.v1.ListTenantsResponse
+	5,  // 45: smartcore.bos.tenant.v1.TenantApi.PullTenants:output_type -> smartcore.bos.tenant.v1.PullTenantsResponse
+	0,  // 46: smartcore.bos.tenant.v1.TenantApi.CreateTenant:output_type -> smartcore.bos.tenant.v1.Tenant
+	0,  // 47: smartcore.bos.tenant.v1.TenantApi.GetTenant:output_type -> smartcore.bos.tenant.v1.Tenant
+	0,  // 48: smartcore.bos.tenant.v1.TenantApi.UpdateTenant:output_type -> smartcore.bos.tenant.v1.Tenant
+	10, // 49: smartcore.bos.tenant.v1.TenantApi.DeleteTenant:output_type -> smartcore.bos.tenant.v1.DeleteTenantResponse
+	12, // 50: smartcore.bos.tenant.v1.TenantApi.PullTenant:output_type -> smartcore.bos.tenant.v1.PullTenantResponse
+	0,  // 51: smartcore.bos.tenant.v1.TenantApi.AddTenantZones:output_type -> smartcore.bos.tenant.v1.Tenant
+	0,  // 52: smartcore.bos.tenant.v1.TenantApi.RemoveTenantZones:output_type -> smartcore.bos.tenant.v1.Tenant
+	16, // 53: smartcore.bos.tenant.v1.TenantApi.ListSecrets:output_type -> smartcore.bos.tenant.v1.ListSecretsResponse
+	18, // 54: smartcore.bos.tenant.v1.TenantApi.PullSecrets:output_type -> smartcore.bos.tenant.v1.PullSecretsResponse
+	1,  // 55: smartcore.bos.tenant.v1.TenantApi.CreateSecret:output_type -> smartcore.bos.tenant.v1.Secret
+	1,  // 56: smartcore.bos.tenant.v1.TenantApi.VerifySecret:output_type -> smartcore.bos.tenant.v1.Secret
+	1,  // 57: smartcore.bos.tenant.v1.TenantApi.GetSecret:output_type -> smartcore.bos.tenant.v1.Secret
+	1,  // 58: smartcore.bos.tenant.v1.TenantApi.UpdateSecret:output_type -> smartcore.bos.tenant.v1.Secret
+	25, // 59: smartcore.bos.tenant.v1.TenantApi.DeleteSecret:output_type -> smartcore.bos.tenant.v1.DeleteSecretResponse
+	27, // 60: smartcore.bos.tenant.v1.TenantApi.PullSecret:output_type -> smartcore.bos.tenant.v1.PullSecretResponse
+	1,  // 61: smartcore.bos.tenant.v1.TenantApi.RegenerateSecret:output_type -> smartcore.bos.tenant.v1.Secret
+	44, // [44:62] is the sub-list for method output_type
+	26, // [26:44] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_smartcore_bos_tenant_v1_tenants_proto_init() }
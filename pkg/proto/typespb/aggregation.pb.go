@@ -0,0 +1,256 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.35.1
+// source: smartcore/bos/types/v1/aggregation.proto
+
+package typespb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The reasons a member's value can be excluded.
+type Aggregation_Reason int32
+
+const (
+	Aggregation_REASON_UNSPECIFIED Aggregation_Reason = 0
+	// The member has not reported a value recently.
+	Aggregation_STALE Aggregation_Reason = 1
+	// The member's value is an outlier compared to the values of the other members.
+	Aggregation_OUTLIER Aggregation_Reason = 2
+)
+
+// Enum value maps for Aggregation_Reason.
+var (
+	Aggregation_Reason_name = map[int32]string{
+		0: "REASON_UNSPECIFIED",
+		1: "STALE",
+		2: "OUTLIER",
+	}
+	Aggregation_Reason_value = map[string]int32{
+		"REASON_UNSPECIFIED": 0,
+		"STALE":              1,
+		"OUTLIER":            2,
+	}
+)
+
+func (x Aggregation_Reason) Enum() *Aggregation_Reason {
+	p := new(Aggregation_Reason)
+	*p = x
+	return p
+}
+
+func (x Aggregation_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Aggregation_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_smartcore_bos_types_v1_aggregation_proto_enumTypes[0].Descriptor()
+}
+
+func (Aggregation_Reason) Type() protoreflect.EnumType {
+	return &file_smartcore_bos_types_v1_aggregation_proto_enumTypes[0]
+}
+
+func (x Aggregation_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Aggregation_Reason.Descriptor instead.
+func (Aggregation_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_smartcore_bos_types_v1_aggregation_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// Aggregation describes how a value was produced from the values of a group of members, like the devices in a zone.
+type Aggregation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The names of members whose values contributed to the aggregated value.
+	Contributors []string `protobuf:"bytes,1,rep,name=contributors,proto3" json:"contributors,omitempty"`
+	// Members whose values were excluded from the aggregated value.
+	Exclusions    []*Aggregation_Exclusion `protobuf:"bytes,2,rep,name=exclusions,proto3" json:"exclusions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Aggregation) Reset() {
+	*x = Aggregation{}
+	mi := &file_smartcore_bos_types_v1_aggregation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Aggregation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Aggregation) ProtoMessage() {}
+
+func (x *Aggregation) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_types_v1_aggregation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Aggregation.ProtoReflect.Descriptor instead.
+func (*Aggregation) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_types_v1_aggregation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Aggregation) GetContributors() []string {
+	if x != nil {
+		return x.Contributors
+	}
+	return nil
+}
+
+func (x *Aggregation) GetExclusions() []*Aggregation_Exclusion {
+	if x != nil {
+		return x.Exclusions
+	}
+	return nil
+}
+
+// Exclusion describes why a member's value was excluded from the aggregated value.
+type Aggregation_Exclusion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the excluded member.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Why the member was excluded.
+	Reason        Aggregation_Reason `protobuf:"varint,2,opt,name=reason,proto3,enum=smartcore.bos.types.v1.Aggregation_Reason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Aggregation_Exclusion) Reset() {
+	*x = Aggregation_Exclusion{}
+	mi := &file_smartcore_bos_types_v1_aggregation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Aggregation_Exclusion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Aggregation_Exclusion) ProtoMessage() {}
+
+func (x *Aggregation_Exclusion) ProtoReflect() protoreflect.Message {
+	mi := &file_smartcore_bos_types_v1_aggregation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Aggregation_Exclusion.ProtoReflect.Descriptor instead.
+func (*Aggregation_Exclusion) Descriptor() ([]byte, []int) {
+	return file_smartcore_bos_types_v1_aggregation_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Aggregation_Exclusion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Aggregation_Exclusion) GetReason() Aggregation_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return Aggregation_REASON_UNSPECIFIED
+}
+
+var File_smartcore_bos_types_v1_aggregation_proto protoreflect.FileDescriptor
+
+const file_smartcore_bos_types_v1_aggregation_proto_rawDesc = "" +
+	"\n" +
+	"(smartcore/bos/types/v1/aggregation.proto\x12\x16smartcore.bos.types.v1\"\x9f\x02\n" +
+	"\vAggregation\x12\"\n" +
+	"\fcontributors\x18\x01 \x03(\tR\fcontributors\x12M\n" +
+	"\n" +
+	"exclusions\x18\x02 \x03(\v2-.smartcore.bos.types.v1.Aggregation.ExclusionR\n" +
+	"exclusions\x1ac\n" +
+	"\tExclusion\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12B\n" +
+	"\x06reason\x18\x02 \x01(\x0e2*.smartcore.bos.types.v1.Aggregation.ReasonR\x06reason\"8\n" +
+	"\x06Reason\x12\x16\n" +
+	"\x12REASON_UNSPECIFIED\x10\x00\x12\t\n" +
+	"\x05STALE\x10\x01\x12\v\n" +
+	"\aOUTLIER\x10\x02B3Z1github.com/smart-core-os/sc-bos/pkg/proto/typespbb\x06proto3"
+
+var (
+	file_smartcore_bos_types_v1_aggregation_proto_rawDescOnce sync.Once
+	file_smartcore_bos_types_v1_aggregation_proto_rawDescData []byte
+)
+
+func file_smartcore_bos_types_v1_aggregation_proto_rawDescGZIP() []byte {
+	file_smartcore_bos_types_v1_aggregation_proto_rawDescOnce.Do(func() {
+		file_smartcore_bos_types_v1_aggregation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_smartcore_bos_types_v1_aggregation_proto_rawDesc), len(file_smartcore_bos_types_v1_aggregation_proto_rawDesc)))
+	})
+	return file_smartcore_bos_types_v1_aggregation_proto_rawDescData
+}
+
+var file_smartcore_bos_types_v1_aggregation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_smartcore_bos_types_v1_aggregation_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_smartcore_bos_types_v1_aggregation_proto_goTypes = []any{
+	(Aggregation_Reason)(0),       // 0: smartcore.bos.types.v1.Aggregation.Reason
+	(*Aggregation)(nil),           // 1: smartcore.bos.types.v1.Aggregation
+	(*Aggregation_Exclusion)(nil), // 2: smartcore.bos.types.v1.Aggregation.Exclusion
+}
+var file_smartcore_bos_types_v1_aggregation_proto_depIdxs = []int32{
+	2, // 0: smartcore.bos.types.v1.Aggregation.exclusions:type_name -> smartcore.bos.types.v1.Aggregation.Exclusion
+	0, // 1: smartcore.bos.types.v1.Aggregation.Exclusion.reason:type_name -> smartcore.bos.types.v1.Aggregation.Reason
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_smartcore_bos_types_v1_aggregation_proto_init() }
+func file_smartcore_bos_types_v1_aggregation_proto_init() {
+	if File_smartcore_bos_types_v1_aggregation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_smartcore_bos_types_v1_aggregation_proto_rawDesc), len(file_smartcore_bos_types_v1_aggregation_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_smartcore_bos_types_v1_aggregation_proto_goTypes,
+		DependencyIndexes: file_smartcore_bos_types_v1_aggregation_proto_depIdxs,
+		EnumInfos:         file_smartcore_bos_types_v1_aggregation_proto_enumTypes,
+		MessageInfos:      file_smartcore_bos_types_v1_aggregation_proto_msgTypes,
+	}.Build()
+	File_smartcore_bos_types_v1_aggregation_proto = out.File
+	file_smartcore_bos_types_v1_aggregation_proto_goTypes = nil
+	file_smartcore_bos_types_v1_aggregation_proto_depIdxs = nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/smart-core-os/sc-bos/pkg/app/stores"
+	"github.com/smart-core-os/sc-bos/pkg/maintenance"
 	"github.com/smart-core-os/sc-bos/pkg/node"
 	"github.com/smart-core-os/sc-bos/pkg/proto/alertpb"
 	"github.com/smart-core-os/sc-bos/pkg/system"
@@ -32,7 +33,8 @@ func NewSystem(services system.Services) *System {
 		cohortManagerName: "", // use the default
 		cohortManager:     services.CohortManager,
 
-		stores: services.Stores,
+		stores:      services.Stores,
+		maintenance: services.Maintenance,
 	}
 	s.Service = service.New(
 		service.MonoApply(s.applyConfig),
@@ -52,7 +54,8 @@ type System struct {
 	cohortManagerName string
 	cohortManager     node.Remote
 
-	stores *stores.Stores
+	stores      *stores.Stores
+	maintenance *maintenance.Windows // nil if alerts aren't checked against maintenance windows
 }
 
 func (s *System) applyConfig(ctx context.Context, cfg config.Root) error {
@@ -76,13 +79,13 @@ func (s *System) applyConfig(ctx context.Context, cfg config.Root) error {
 
 		announcer.Announce(s.name,
 			node.HasServer(alertpb.RegisterAlertApiServer, alertpb.AlertApiServer(server)),
-			node.HasServer(alertpb.RegisterAlertAdminApiServer, alertpb.AlertAdminApiServer(server)),
+			node.HasServer(alertpb.RegisterAlertAdminApiServer, s.adminServer(server)),
 		)
 	case config.StorageTypeHub:
 		server := hubalerts.NewServer("", s.name, s.cohortManager)
 		announcer.Announce(s.name,
 			node.HasServer(alertpb.RegisterAlertApiServer, alertpb.AlertApiServer(server)),
-			node.HasServer(alertpb.RegisterAlertAdminApiServer, alertpb.AlertAdminApiServer(server)),
+			node.HasServer(alertpb.RegisterAlertAdminApiServer, s.adminServer(server)),
 		)
 	default:
 		return fmt.Errorf("unsuported storage type %s", cfg.Storage.Type)
@@ -90,3 +93,11 @@ func (s *System) applyConfig(ctx context.Context, cfg config.Root) error {
 
 	return nil
 }
+
+// adminServer returns server, annotating or suppressing alerts created for devices in maintenance.
+func (s *System) adminServer(server alertpb.AlertAdminApiServer) alertpb.AlertAdminApiServer {
+	if s.maintenance == nil {
+		return server
+	}
+	return maintenanceAdminServer{AlertAdminApiServer: server, windows: s.maintenance}
+}
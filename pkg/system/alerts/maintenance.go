@@ -0,0 +1,30 @@
+package alerts
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smart-core-os/sc-bos/pkg/maintenance"
+	"github.com/smart-core-os/sc-bos/pkg/proto/alertpb"
+)
+
+// maintenanceAdminServer annotates or suppresses alerts created for devices in maintenance.
+type maintenanceAdminServer struct {
+	alertpb.AlertAdminApiServer
+	windows *maintenance.Windows
+}
+
+func (s maintenanceAdminServer) CreateAlert(ctx context.Context, request *alertpb.CreateAlertRequest) (*alertpb.Alert, error) {
+	if request.Alert == nil {
+		return s.AlertAdminApiServer.CreateAlert(ctx, request)
+	}
+	alert := s.windows.AnnotateAlert(request.Alert)
+	if alert == nil {
+		// suppressed, reply as if it was created so callers don't retry
+		return request.Alert, nil
+	}
+	request = proto.Clone(request).(*alertpb.CreateAlertRequest)
+	request.Alert = alert
+	return s.AlertAdminApiServer.CreateAlert(ctx, request)
+}
@@ -45,6 +45,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
+	"github.com/smart-core-os/sc-bos/internal/tracing"
 	"github.com/smart-core-os/sc-bos/internal/util/grpc/reflectionapi"
 	"github.com/smart-core-os/sc-bos/pkg/node"
 	"github.com/smart-core-os/sc-bos/pkg/proto/hubpb"
@@ -74,7 +75,10 @@ func (f *factory) New(services system.Services) service.Lifecycle {
 		checks: services.HealthChecks,
 		ignore: []string{services.GRPCEndpoint}, // avoid infinite recursion
 		newClient: func(address string) (*grpc.ClientConn, error) {
-			return grpc.NewClient(address, grpc.WithTransportCredentials(credentials.NewTLS(services.ClientTLSConfig)))
+			return grpc.NewClient(address,
+				grpc.WithTransportCredentials(credentials.NewTLS(services.ClientTLSConfig)),
+				tracing.DialOption(),
+			)
 		},
 		reflection: services.ReflectionServer,
 		announcer:  services.Node,
@@ -642,6 +642,7 @@ func testReflection(t *testing.T, ctx context.Context, conn *grpc.ClientConn) {
 		{Name: "smartcore.bos.health.v1.HealthHistory"},
 		{Name: "smartcore.bos.hub.v1.HubApi"},
 		{Name: "smartcore.bos.log.v1.LogApi"},
+		{Name: "smartcore.bos.maintenance.v1.MaintenanceApi"},
 		{Name: "smartcore.bos.metadata.v1.MetadataApi"},
 		{Name: "smartcore.bos.mock.v1.MockDeviceApi"},
 		{Name: "smartcore.bos.onoff.v1.OnOffApi"},
@@ -9,6 +9,7 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protodesc"
@@ -16,6 +17,7 @@ import (
 	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/descriptorpb"
 
+	"github.com/smart-core-os/sc-bos/internal/metrics"
 	"github.com/smart-core-os/sc-bos/internal/util/grpc/reflectionapi"
 	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/hubpb"
@@ -55,6 +57,10 @@ func (s *System) scanLocalHub(ctx context.Context, c *cohort, hubClient hubpb.Hu
 // Errors encountered during tasks are logged and the tasks are retried until ctx is done.
 // nodeName is a human-readable name for the node, used in logs to distinguish between remote nodes.
 func (s *System) scanRemoteNode(ctx context.Context, nodeName string, n *remoteNode) {
+	if conn, ok := n.conn.(*grpc.ClientConn); ok {
+		go watchConnectivity(ctx, n.addr, conn)
+	}
+
 	go s.poll(ctx, func(ctx context.Context) error {
 		return s.reflectNode(ctx, n)
 	}, zap.String("task", "reflect"), zap.String("remoteAddr", n.addr), zap.String("nodeName", nodeName))
@@ -72,6 +78,18 @@ func (s *System) scanRemoteNode(ctx context.Context, nodeName string, n *remoteN
 	}, zap.String("remoteAddr", n.addr), zap.String("nodeName", nodeName))
 }
 
+// watchConnectivity reports whether conn is connected to the node at addr via metrics until ctx is done.
+func watchConnectivity(ctx context.Context, addr string, conn *grpc.ClientConn) {
+	defer metrics.ForgetGatewayNode(addr)
+	for {
+		state := conn.GetState()
+		metrics.SetGatewayNodeConnected(addr, state == connectivity.Ready)
+		if !conn.WaitForStateChange(ctx, state) {
+			return
+		}
+	}
+}
+
 // pullSelf updates node.Self with metadata about itself.
 func (s *System) pullSelf(ctx context.Context, node *remoteNode) (task.Next, error) {
 	mdClient := metadatapb.NewMetadataApiClient(node.conn)
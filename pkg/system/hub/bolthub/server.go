@@ -4,8 +4,10 @@ import (
 	"context"
 	"crypto/tls"
 	"errors"
+	"time"
 
 	"github.com/timshannon/bolthold"
+	bolt "go.etcd.io/bbolt"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -20,6 +22,7 @@ import (
 	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/typespb"
 	"github.com/smart-core-os/sc-bos/pkg/system/hub/internal/hubpage"
+	"github.com/smart-core-os/sc-bos/pkg/system/hub/internal/revocation"
 	"github.com/smart-core-os/sc-bos/pkg/system/hub/remote"
 )
 
@@ -52,6 +55,8 @@ type Server struct {
 	ManagerAddr   string
 	Authority     pki.Source  // trust authority for the cohort of smart core nodes
 	TestTLSConfig *tls.Config // TLS config used when initiating test connections with a node
+	// Revocations, if not nil, is updated with any certificates revoked by this server.
+	Revocations *pki.RevocationList
 }
 
 type DbEnrollment struct {
@@ -61,6 +66,15 @@ type DbEnrollment struct {
 	Cert        []byte
 }
 
+type DbRevocation struct {
+	SerialNumber []byte
+	Issuer       []byte
+	RevokeTime   time.Time
+	ExpireTime   time.Time
+	Address      string
+	Name         string
+}
+
 func (s *Server) Close() error {
 	return s.db.Close()
 }
@@ -319,7 +333,30 @@ func (s *Server) ForgetHubNode(ctx context.Context, request *hubpb.ForgetHubNode
 		return nil, err
 	}
 
-	err = s.db.Delete(reg.Address, &DbEnrollment{})
+	var en DbEnrollment
+	if err := s.db.Get(reg.Address, &en); err != nil {
+		s.logger.Warn("db.Get failed, no rollback", zap.Error(err), zap.Bool("remote_deleted", remoteDeleted))
+		return nil, status.Errorf(codes.Unknown, "error reading enrollment from database, retrying may resolve this issue")
+	}
+	revoked, err := revocation.New(en.Cert, en.Address, en.Name, time.Now())
+	if err != nil {
+		// we still want to forget the node, even if we can't work out which cert to revoke
+		s.logger.Warn("unable to revoke certificate for forgotten node", zap.Error(err), zap.String("target_address", reg.Address))
+		revoked = nil
+	}
+
+	err = s.db.Bolt().Update(func(tx *bolt.Tx) error {
+		if revoked != nil {
+			expired := bolthold.Where("ExpireTime").Le(revoked.RevokeTime.AsTime())
+			if err := s.db.TxDeleteMatching(tx, &DbRevocation{}, expired); err != nil {
+				return err
+			}
+			if err := s.db.TxUpsert(tx, revocation.Key(revoked), toDbRevocation(revoked)); err != nil {
+				return err
+			}
+		}
+		return s.db.TxDelete(tx, reg.Address, &DbEnrollment{})
+	})
 	if err != nil {
 		s.logger.Warn("db.Delete failed, no rollback", zap.Error(err), zap.Bool("remote_deleted", remoteDeleted))
 		if remoteDeleted {
@@ -329,6 +366,10 @@ func (s *Server) ForgetHubNode(ctx context.Context, request *hubpb.ForgetHubNode
 		return nil, status.Errorf(codes.Unknown, "error removing enrollment from database, retrying may resolve this issue")
 	}
 
+	if revoked != nil && s.Revocations != nil {
+		s.Revocations.Add(revocation.ToPKI(revoked))
+	}
+
 	go s.dbChanges.Send(context.Background(), &hubpb.PullHubNodesResponse_Change{
 		OldValue:   reg,
 		NewValue:   nil,
@@ -338,3 +379,54 @@ func (s *Server) ForgetHubNode(ctx context.Context, request *hubpb.ForgetHubNode
 
 	return &hubpb.ForgetHubNodeResponse{}, nil
 }
+
+func (s *Server) ListRevokedCertificates(_ context.Context, request *hubpb.ListRevokedCertificatesRequest) (*hubpb.ListRevokedCertificatesResponse, error) {
+	var dbRevocations []DbRevocation
+	err := s.db.Find(&dbRevocations, nil)
+	if err != nil {
+		s.logger.Error("db.Find failed for all DbRevocation types", zap.Error(err))
+		return nil, status.Error(codes.Unavailable, "unable to retrieve revocations")
+	}
+
+	now := time.Now()
+	var all []*hubpb.RevokedCertificate
+	for _, r := range dbRevocations {
+		rc := fromDbRevocation(r)
+		if revocation.Expired(rc, now) {
+			continue
+		}
+		all = append(all, rc)
+	}
+
+	certs, nextPageToken, total, err := hubpage.PaginateFunc(all, revocation.Key, request.GetPageSize(), request.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	return &hubpb.ListRevokedCertificatesResponse{
+		RevokedCertificates: certs,
+		NextPageToken:       nextPageToken,
+		TotalSize:           total,
+	}, nil
+}
+
+func toDbRevocation(rc *hubpb.RevokedCertificate) DbRevocation {
+	return DbRevocation{
+		SerialNumber: rc.SerialNumber,
+		Issuer:       rc.Issuer,
+		RevokeTime:   rc.RevokeTime.AsTime(),
+		ExpireTime:   rc.ExpireTime.AsTime(),
+		Address:      rc.Address,
+		Name:         rc.Name,
+	}
+}
+
+func fromDbRevocation(r DbRevocation) *hubpb.RevokedCertificate {
+	return &hubpb.RevokedCertificate{
+		SerialNumber: r.SerialNumber,
+		Issuer:       r.Issuer,
+		RevokeTime:   timestamppb.New(r.RevokeTime),
+		ExpireTime:   timestamppb.New(r.ExpireTime),
+		Address:      r.Address,
+		Name:         r.Name,
+	}
+}
@@ -0,0 +1,136 @@
+package bolthub
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/smart-core-os/sc-bos/internal/util/pki"
+	"github.com/smart-core-os/sc-bos/pkg/proto/enrollmentpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/hubpb"
+)
+
+func TestServer_ForgetHubNode_revokes(t *testing.T) {
+	nodeAddr := serveNode(t)
+	s, err := NewServer(filepath.Join(t.TempDir(), "hub.db"), zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	s.TestTLSConfig = &tls.Config{InsecureSkipVerify: true}
+	s.Revocations = &pki.RevocationList{}
+
+	certPEM, leaf := newCert(t, 42)
+	if err := s.db.Insert(nodeAddr, &DbEnrollment{Name: "ac1", Address: nodeAddr, Cert: certPEM}); err != nil {
+		t.Fatal(err)
+	}
+	// already expired revocations are not listed, and are removed when a new revocation is added
+	if err := s.db.Insert("old", &DbRevocation{SerialNumber: []byte{1}, ExpireTime: time.Now().Add(-time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := t.Context()
+	if _, err := s.ForgetHubNode(ctx, &hubpb.ForgetHubNodeRequest{Address: nodeAddr}); err != nil {
+		t.Fatalf("ForgetHubNode: %v", err)
+	}
+	if _, err := s.GetHubNode(ctx, &hubpb.GetHubNodeRequest{Address: nodeAddr}); err == nil {
+		t.Error("node still enrolled after ForgetHubNode")
+	}
+	if !s.Revocations.Revoked(leaf) {
+		t.Error("forgotten node's certificate not added to Revocations")
+	}
+
+	res, err := s.ListRevokedCertificates(ctx, &hubpb.ListRevokedCertificatesRequest{})
+	if err != nil {
+		t.Fatalf("ListRevokedCertificates: %v", err)
+	}
+	if len(res.RevokedCertificates) != 1 {
+		t.Fatalf("ListRevokedCertificates returned %d certs, want 1", len(res.RevokedCertificates))
+	}
+	got := res.RevokedCertificates[0]
+	if new(big.Int).SetBytes(got.SerialNumber).Int64() != 42 || got.Address != nodeAddr || got.Name != "ac1" {
+		t.Errorf("revoked certificate = %v, want serial 42 for ac1", got)
+	}
+	var all []DbRevocation
+	if err := s.db.Find(&all, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 1 {
+		t.Errorf("db has %d revocations, want 1 after expired entries are removed", len(all))
+	}
+
+	// forgetting a missing node is allowed
+	if _, err := s.ForgetHubNode(ctx, &hubpb.ForgetHubNodeRequest{Address: nodeAddr, AllowMissing: true}); err != nil {
+		t.Errorf("ForgetHubNode(AllowMissing): %v", err)
+	}
+}
+
+type fakeNode struct {
+	enrollmentpb.UnimplementedEnrollmentApiServer
+}
+
+func (fakeNode) DeleteEnrollment(context.Context, *enrollmentpb.DeleteEnrollmentRequest) (*enrollmentpb.Enrollment, error) {
+	return &enrollmentpb.Enrollment{}, nil
+}
+
+// serveNode starts a node that accepts requests to forget its enrollment, returning its address.
+func serveNode(t *testing.T) string {
+	t.Helper()
+	_, leaf := newCert(t, 1)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{leafCert(leaf)}})))
+	enrollmentpb.RegisterEnrollmentApiServer(srv, fakeNode{})
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
+
+var testKey = func() *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}()
+
+// newCert returns a self-signed certificate as PEM, and parsed.
+func newCert(t *testing.T, serial int64) ([]byte, *x509.Certificate) {
+	t.Helper()
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, testKey.Public(), testKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), leaf
+}
+
+func leafCert(leaf *x509.Certificate) tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{leaf.Raw}, PrivateKey: testKey, Leaf: leaf}
+}
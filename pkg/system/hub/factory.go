@@ -28,6 +28,7 @@ import (
 	"github.com/smart-core-os/sc-bos/pkg/system"
 	"github.com/smart-core-os/sc-bos/pkg/system/hub/bolthub"
 	"github.com/smart-core-os/sc-bos/pkg/system/hub/config"
+	"github.com/smart-core-os/sc-bos/pkg/system/hub/internal/revocation"
 	"github.com/smart-core-os/sc-bos/pkg/system/hub/pgxhub"
 	"github.com/smart-core-os/sc-bos/pkg/task/service"
 	"github.com/smart-core-os/sc-bos/pkg/util/netutil"
@@ -50,6 +51,7 @@ func (f *factory) New(services system.Services) service.Lifecycle {
 		sharedKey:       services.PrivateKey,
 		clientTLSConfig: services.ClientTLSConfig,
 		certs:           services.GRPCCerts,
+		revokers:        services.Revokers,
 		logger:          services.Logger.Named("hub"),
 		boltDb:          services.Database,
 		stores:          services.Stores,
@@ -81,11 +83,15 @@ type System struct {
 	certs   *pki.SourceSet
 	sources []pki.Source
 	undos   []node.Undo
+
+	revokers *pki.RevokerSet
+	revoked  *pki.RevocationList // certs revoked by this hub, nil if not using local storage
 }
 
 func (s *System) applyConfig(ctx context.Context, cfg config.Root) error {
 	s.undoAll()
 	s.deleteSources()
+	s.deleteRevocations()
 
 	// While the hub system is active, this node is a HUB rather than just a NODE.
 	// When applyConfig returns (ctx cancelled), the undo reverts the node to NODE.
@@ -122,6 +128,7 @@ func (s *System) applyConfig(ctx context.Context, cfg config.Root) error {
 
 		server.Authority = caSource
 		server.TestTLSConfig = s.clientTLSConfig
+		server.Revocations = s.newRevocations()
 		server.ManagerName = cfg.Name
 		if server.ManagerName == "" {
 			server.ManagerName = s.name
@@ -151,6 +158,7 @@ func (s *System) applyConfig(ctx context.Context, cfg config.Root) error {
 
 		server.Authority = caSource
 		server.TestTLSConfig = s.clientTLSConfig
+		server.Revocations = s.newRevocations()
 		server.ManagerName = cfg.Name
 		if server.ManagerName == "" {
 			server.ManagerName = s.name
@@ -183,6 +191,11 @@ func (s *System) applyConfig(ctx context.Context, cfg config.Root) error {
 
 	if err == nil {
 		hubClient := hubpb.NewHubApiClient(hubConn)
+		if s.revoked != nil {
+			if err := loadRevocations(ctx, hubClient, s.revoked); err != nil {
+				return fmt.Errorf("load revocations: %w", err)
+			}
+		}
 		for _, n := range cfg.Nodes {
 			go func() {
 				for {
@@ -215,6 +228,40 @@ func (s *System) deleteSources() {
 	s.sources = nil
 }
 
+// newRevocations creates a list of certificates revoked by this hub and checks it during gRPC TLS handshakes.
+func (s *System) newRevocations() *pki.RevocationList {
+	s.revoked = &pki.RevocationList{}
+	if s.revokers != nil {
+		s.revokers.Append(s.revoked)
+	}
+	return s.revoked
+}
+
+func (s *System) deleteRevocations() {
+	if s.revoked != nil && s.revokers != nil {
+		s.revokers.Delete(s.revoked)
+	}
+	s.revoked = nil
+}
+
+// loadRevocations populates list with all certificates revoked by the hub.
+func loadRevocations(ctx context.Context, client hubpb.HubApiClient, list *pki.RevocationList) error {
+	req := &hubpb.ListRevokedCertificatesRequest{PageSize: 1000}
+	for {
+		res, err := client.ListRevokedCertificates(ctx, req)
+		if err != nil {
+			return err
+		}
+		for _, rc := range res.RevokedCertificates {
+			list.Add(revocation.ToPKI(rc))
+		}
+		if res.NextPageToken == "" {
+			return nil
+		}
+		req.PageToken = res.NextPageToken
+	}
+}
+
 func (s *System) undoAll() {
 	for _, u := range s.undos {
 		u()
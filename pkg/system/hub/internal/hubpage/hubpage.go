@@ -1,4 +1,4 @@
-// Package hubpage provides pagination helpers shared by the hub node store implementations.
+// Package hubpage provides pagination helpers shared by the hub store implementations.
 package hubpage
 
 import (
@@ -23,33 +23,39 @@ const (
 // and the total number of nodes across all pages.
 // nodes is sorted in place.
 func Paginate(nodes []*hubpb.HubNode, pageSize int32, pageToken string) (page []*hubpb.HubNode, nextPageToken string, total int32, err error) {
+	return PaginateFunc(nodes, (*hubpb.HubNode).GetName, pageSize, pageToken)
+}
+
+// PaginateFunc is like Paginate but for any item type, sorting and paging items by the key returned by keyFunc.
+// items is sorted in place.
+func PaginateFunc[T any](items []T, keyFunc func(T) string, pageSize int32, pageToken string) (page []T, nextPageToken string, total int32, err error) {
 	token := &typespb.PageToken{}
 	if err := decodePageToken(pageToken, token); err != nil {
 		return nil, "", 0, err
 	}
 
-	sort.Slice(nodes, func(i, j int) bool {
-		return nodes[i].GetName() < nodes[j].GetName()
+	sort.Slice(items, func(i, j int) bool {
+		return keyFunc(items[i]) < keyFunc(items[j])
 	})
 
-	lastKey := token.GetLastResourceName() // the name of the last node we sent
+	lastKey := token.GetLastResourceName() // the key of the last item we sent
 	nextIndex := 0
 	if lastKey != "" {
-		nextIndex = sort.Search(len(nodes), func(i int) bool {
-			return nodes[i].GetName() >= lastKey
+		nextIndex = sort.Search(len(items), func(i int) bool {
+			return keyFunc(items[i]) >= lastKey
 		})
-		if nextIndex < len(nodes) && nodes[nextIndex].GetName() == lastKey {
+		if nextIndex < len(items) && keyFunc(items[nextIndex]) == lastKey {
 			nextIndex++
 		}
 	}
 
 	upperBound := nextIndex + capPageSize(int(pageSize))
-	if upperBound > len(nodes) {
-		upperBound = len(nodes)
+	if upperBound > len(items) {
+		upperBound = len(items)
 		token = nil
 	} else {
 		token.PageStart = &typespb.PageToken_LastResourceName{
-			LastResourceName: nodes[upperBound-1].GetName(),
+			LastResourceName: keyFunc(items[upperBound-1]),
 		}
 	}
 
@@ -57,7 +63,7 @@ func Paginate(nodes []*hubpb.HubNode, pageSize int32, pageToken string) (page []
 	if err != nil {
 		return nil, "", 0, err
 	}
-	return nodes[nextIndex:upperBound], nextPageToken, int32(len(nodes)), nil
+	return items[nextIndex:upperBound], nextPageToken, int32(len(items)), nil
 }
 
 func capPageSize(pageSize int) int {
@@ -0,0 +1,59 @@
+// Package revocation converts between certificates issued by the hub and the revocation entries that are shared with
+// enrolled nodes.
+package revocation
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/internal/util/pki"
+	"github.com/smart-core-os/sc-bos/pkg/proto/hubpb"
+)
+
+// ErrNoCert is returned by New when the enrollment has no certificate to revoke.
+var ErrNoCert = errors.New("no certificate")
+
+// New returns a revocation entry for the leaf certificate in certPEM, which was issued to the node at address.
+func New(certPEM []byte, address, name string, now time.Time) (*hubpb.RevokedCertificate, error) {
+	certs, err := pki.ParseCertificatesPEM(certPEM)
+	if err != nil {
+		return nil, err
+	}
+	if len(certs) == 0 {
+		return nil, ErrNoCert
+	}
+	leaf := certs[0]
+	return &hubpb.RevokedCertificate{
+		SerialNumber: leaf.SerialNumber.Bytes(),
+		Issuer:       leaf.RawIssuer,
+		RevokeTime:   timestamppb.New(now),
+		ExpireTime:   timestamppb.New(leaf.NotAfter),
+		Address:      address,
+		Name:         name,
+	}, nil
+}
+
+// Key returns a string that uniquely identifies the revoked certificate.
+// Keys are stable and are suitable for sorting and paging.
+func Key(rc *hubpb.RevokedCertificate) string {
+	return new(big.Int).SetBytes(rc.GetSerialNumber()).Text(16)
+}
+
+// Expired reports whether the revoked certificate has expired by now and no longer needs to be listed.
+func Expired(rc *hubpb.RevokedCertificate, now time.Time) bool {
+	if rc.GetExpireTime() == nil {
+		return false
+	}
+	return !now.Before(rc.GetExpireTime().AsTime())
+}
+
+// ToPKI converts rc into the form used by pki.RevocationList.
+func ToPKI(rc *hubpb.RevokedCertificate) pki.RevokedCert {
+	return pki.RevokedCert{
+		RawIssuer:    rc.GetIssuer(),
+		SerialNumber: new(big.Int).SetBytes(rc.GetSerialNumber()),
+	}
+}
@@ -2,6 +2,7 @@ package pgxhub
 
 import (
 	"context"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 )
@@ -114,3 +115,66 @@ func scanRow(row pgx.Row, dst *Enrollment) error {
 	}
 	return nil
 }
+
+type Revocation struct {
+	SerialNumber []byte
+	Issuer       []byte
+	RevokeTime   time.Time
+	ExpireTime   time.Time
+	Address      string
+	Name         string
+}
+
+func InsertRevocation(ctx context.Context, tx pgx.Tx, r Revocation) error {
+	// language=postgresql
+	query := `
+		INSERT INTO revocation (serial_number, issuer, revoke_time, expire_time, address, name)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (issuer, serial_number) DO NOTHING;
+	`
+
+	_, err := tx.Exec(ctx, query, r.SerialNumber, r.Issuer, r.RevokeTime, r.ExpireTime, r.Address, r.Name)
+	return err
+}
+
+// SelectRevocations returns all revocations that have not expired by now.
+func SelectRevocations(ctx context.Context, tx pgx.Tx, now time.Time) ([]Revocation, error) {
+	// language=postgresql
+	query := `
+		SELECT serial_number, issuer, revoke_time, expire_time, address, name
+		FROM revocation
+		WHERE expire_time > $1;
+	`
+
+	rows, err := tx.Query(ctx, query, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revocations []Revocation
+	for rows.Next() {
+		var r Revocation
+		var addressNull, nameNull *string
+		err = rows.Scan(&r.SerialNumber, &r.Issuer, &r.RevokeTime, &r.ExpireTime, &addressNull, &nameNull)
+		if err != nil {
+			return nil, err
+		}
+		if addressNull != nil {
+			r.Address = *addressNull
+		}
+		if nameNull != nil {
+			r.Name = *nameNull
+		}
+		revocations = append(revocations, r)
+	}
+	return revocations, rows.Err()
+}
+
+// DeleteExpiredRevocations removes all revocations that expired before now.
+func DeleteExpiredRevocations(ctx context.Context, tx pgx.Tx, now time.Time) error {
+	// language=postgresql
+	query := `DELETE FROM revocation WHERE expire_time <= $1`
+	_, err := tx.Exec(ctx, query, now)
+	return err
+}
@@ -5,3 +5,14 @@ CREATE TABLE IF NOT EXISTS enrollment
     description TEXT,
     cert        BYTEA NOT NULL
 );
+
+CREATE TABLE IF NOT EXISTS revocation
+(
+    serial_number BYTEA       NOT NULL,
+    issuer        BYTEA       NOT NULL,
+    revoke_time   TIMESTAMPTZ NOT NULL,
+    expire_time   TIMESTAMPTZ NOT NULL,
+    address       TEXT,
+    name          TEXT,
+    PRIMARY KEY (issuer, serial_number)
+);
@@ -6,6 +6,7 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -25,6 +26,7 @@ import (
 	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/typespb"
 	"github.com/smart-core-os/sc-bos/pkg/system/hub/internal/hubpage"
+	"github.com/smart-core-os/sc-bos/pkg/system/hub/internal/revocation"
 	"github.com/smart-core-os/sc-bos/pkg/system/hub/remote"
 )
 
@@ -83,6 +85,8 @@ type Server struct {
 	ManagerAddr   string
 	Authority     pki.Source  // trust authority for the cohort of smart core nodes
 	TestTLSConfig *tls.Config // TLS config used when initiating test connections with a node
+	// Revocations, if not nil, is updated with any certificates revoked by this server.
+	Revocations *pki.RevocationList
 }
 
 func (n *Server) GetHubNode(ctx context.Context, request *hubpb.GetHubNodeRequest) (*hubpb.HubNode, error) {
@@ -355,7 +359,27 @@ func (n *Server) ForgetHubNode(ctx context.Context, request *hubpb.ForgetHubNode
 		return nil, err
 	}
 
+	now := time.Now()
+	var revoked *hubpb.RevokedCertificate
 	err = pgx.BeginFunc(ctx, n.write, func(tx pgx.Tx) error {
+		en, err := SelectEnrollment(ctx, tx, reg.Address)
+		if err != nil {
+			return err
+		}
+		revoked, err = revocation.New(en.Cert, en.Address, en.Name, now)
+		if err != nil {
+			// we still want to forget the node, even if we can't work out which cert to revoke
+			logger.Warn("unable to revoke certificate for forgotten node", zap.Error(err))
+			revoked = nil
+		}
+		if revoked != nil {
+			if err := DeleteExpiredRevocations(ctx, tx, now); err != nil {
+				return err
+			}
+			if err := InsertRevocation(ctx, tx, toDbRevocation(revoked)); err != nil {
+				return err
+			}
+		}
 		return DeleteEnrollment(ctx, tx, reg.Address)
 	})
 	if err != nil {
@@ -368,6 +392,10 @@ func (n *Server) ForgetHubNode(ctx context.Context, request *hubpb.ForgetHubNode
 		return nil, status.Errorf(codes.Unknown, "error removing enrollment from database, retrying may resolve this issue")
 	}
 
+	if revoked != nil && n.Revocations != nil {
+		n.Revocations.Add(revocation.ToPKI(revoked))
+	}
+
 	go n.dbChanges.Send(context.Background(), &hubpb.PullHubNodesResponse_Change{
 		OldValue:   reg,
 		NewValue:   nil,
@@ -377,3 +405,53 @@ func (n *Server) ForgetHubNode(ctx context.Context, request *hubpb.ForgetHubNode
 
 	return &hubpb.ForgetHubNodeResponse{}, nil
 }
+
+func (n *Server) ListRevokedCertificates(ctx context.Context, request *hubpb.ListRevokedCertificatesRequest) (*hubpb.ListRevokedCertificatesResponse, error) {
+	logger := rpcutil.ServerLogger(ctx, n.logger)
+	var dbRevocations []Revocation
+	err := pgx.BeginFunc(ctx, n.read, func(tx pgx.Tx) (err error) {
+		dbRevocations, err = SelectRevocations(ctx, tx, time.Now())
+		return
+	})
+	if err != nil {
+		logger.Error("pool.SelectRevocations failed", zap.Error(err))
+		return nil, status.Error(codes.Unavailable, "unable to retrieve revocations")
+	}
+
+	all := make([]*hubpb.RevokedCertificate, 0, len(dbRevocations))
+	for _, r := range dbRevocations {
+		all = append(all, fromDbRevocation(r))
+	}
+
+	certs, nextPageToken, total, err := hubpage.PaginateFunc(all, revocation.Key, request.GetPageSize(), request.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	return &hubpb.ListRevokedCertificatesResponse{
+		RevokedCertificates: certs,
+		NextPageToken:       nextPageToken,
+		TotalSize:           total,
+	}, nil
+}
+
+func toDbRevocation(rc *hubpb.RevokedCertificate) Revocation {
+	return Revocation{
+		SerialNumber: rc.SerialNumber,
+		Issuer:       rc.Issuer,
+		RevokeTime:   rc.RevokeTime.AsTime(),
+		ExpireTime:   rc.ExpireTime.AsTime(),
+		Address:      rc.Address,
+		Name:         rc.Name,
+	}
+}
+
+func fromDbRevocation(r Revocation) *hubpb.RevokedCertificate {
+	return &hubpb.RevokedCertificate{
+		SerialNumber: r.SerialNumber,
+		Issuer:       r.Issuer,
+		RevokeTime:   timestamppb.New(r.RevokeTime),
+		ExpireTime:   timestamppb.New(r.ExpireTime),
+		Address:      r.Address,
+		Name:         r.Name,
+	}
+}
@@ -1,6 +1,13 @@
 // Package config defines configuration for the log system.
 package config
 
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap/zapcore"
+)
+
 // Root is the configuration for the log system plugin.
 type Root struct {
 	// LogFilePath is a glob pattern used to discover log files on disk for metadata
@@ -16,6 +23,9 @@ type Root struct {
 	// BufCap is the ring-buffer capacity for in-memory log message retention.
 	// Defaults to 1000. Maximum is 10000.
 	BufCap int `json:"bufCap,omitempty"`
+
+	// Forwarders ship captured log entries off-box, for example to a site's SIEM.
+	Forwarders []Forwarder `json:"forwarders,omitempty"`
 }
 
 const maxBufCap = 10000
@@ -31,3 +41,144 @@ func (r Root) BufCapOrDefault() int {
 	}
 	return r.BufCap
 }
+
+// Forwarder types.
+const (
+	ForwarderSyslog = "syslog"
+	ForwarderOTLP   = "otlp"
+	ForwarderHTTP   = "http"
+)
+
+// DefaultQueueBytes is the default size of a forwarder's queue.
+const DefaultQueueBytes = 64 << 20
+
+// Forwarder ships captured log entries to a remote sink.
+// Entries are queued on disk, under the controller's data dir, so they survive sink outages and restarts.
+type Forwarder struct {
+	// Name identifies the forwarder, in its health check and queue file. Required and unique.
+	Name string `json:"name,omitempty"`
+	// Type is the kind of sink: "syslog", "otlp", or "http".
+	Type string `json:"type,omitempty"`
+
+	// Level is the minimum level of entries forwarded, one of "debug", "info", "warn", or "error".
+	// Defaults to "info".
+	Level string `json:"level,omitempty"`
+	// Loggers overrides Level for named loggers and their children, keyed by logger name.
+	// The longest matching name wins, so {"driver": "warn", "driver.bacnet": "debug"} forwards debug entries
+	// from the bacnet driver and only warnings from other drivers.
+	Loggers map[string]string `json:"loggers,omitempty"`
+
+	// MaxQueueBytes bounds the size of queued entries waiting to be delivered.
+	// When full, the oldest entries are dropped. Defaults to DefaultQueueBytes.
+	MaxQueueBytes int64 `json:"maxQueueBytes,omitempty"`
+
+	Syslog *Syslog `json:"syslog,omitempty"`
+	OTLP   *OTLP   `json:"otlp,omitempty"`
+	HTTP   *HTTP   `json:"http,omitempty"`
+}
+
+// MaxQueueBytesOrDefault returns MaxQueueBytes, or DefaultQueueBytes if it isn't positive.
+func (f Forwarder) MaxQueueBytesOrDefault() int64 {
+	if f.MaxQueueBytes <= 0 {
+		return DefaultQueueBytes
+	}
+	return f.MaxQueueBytes
+}
+
+// Validate checks that the forwarder is complete, returning the first problem found.
+func (f Forwarder) Validate() error {
+	if f.Name == "" {
+		return errors.New("name is required")
+	}
+	if _, err := ParseLevel(f.Level); err != nil {
+		return err
+	}
+	for name, level := range f.Loggers {
+		if _, err := ParseLevel(level); err != nil {
+			return fmt.Errorf("logger %q: %w", name, err)
+		}
+	}
+	switch f.Type {
+	case ForwarderSyslog:
+		if f.Syslog == nil || f.Syslog.Address == "" {
+			return errors.New("syslog.address is required")
+		}
+		switch f.Syslog.Network {
+		case "", "udp", "tcp", "tls":
+		default:
+			return fmt.Errorf("syslog.network %q must be one of udp, tcp, or tls", f.Syslog.Network)
+		}
+		if _, ok := facilities[f.Syslog.Facility]; !ok && f.Syslog.Facility != "" {
+			return fmt.Errorf("unknown syslog.facility %q", f.Syslog.Facility)
+		}
+	case ForwarderOTLP:
+		if f.OTLP == nil || f.OTLP.Endpoint == "" {
+			return errors.New("otlp.endpoint is required")
+		}
+	case ForwarderHTTP:
+		if f.HTTP == nil || f.HTTP.URL == "" {
+			return errors.New("http.url is required")
+		}
+	default:
+		return fmt.Errorf("unknown type %q", f.Type)
+	}
+	return nil
+}
+
+// ParseLevel parses a forwarder level, defaulting to info if level is empty.
+func ParseLevel(level string) (zapcore.Level, error) {
+	if level == "" {
+		return zapcore.InfoLevel, nil
+	}
+	return zapcore.ParseLevel(level)
+}
+
+// Syslog configures a forwarder sending RFC 5424 messages to a syslog server.
+type Syslog struct {
+	// Network is how to connect to Address: "udp", "tcp", or "tls". Defaults to "udp".
+	// Messages sent over tcp and tls are framed using octet counting, as described in RFC 6587.
+	Network string `json:"network,omitempty"`
+	// Address is the host:port of the syslog server.
+	Address string `json:"address,omitempty"`
+	// Facility is the syslog facility of forwarded messages, like "local0" or "daemon". Defaults to "local0".
+	Facility string `json:"facility,omitempty"`
+	// AppName is the APP-NAME of forwarded messages. Defaults to "sc-bos".
+	AppName string `json:"appName,omitempty"`
+	// Hostname is the HOSTNAME of forwarded messages. Defaults to the host's name.
+	Hostname string `json:"hostname,omitempty"`
+	// CACertFile is the path to a PEM-encoded CA certificate used to verify the server when Network is "tls".
+	// If empty, the system root CAs are used.
+	CACertFile string `json:"caCertFile,omitempty"`
+}
+
+// FacilityOrDefault returns the numeric code of the configured facility.
+func (s Syslog) FacilityOrDefault() int {
+	if f, ok := facilities[s.Facility]; ok {
+		return f
+	}
+	return facilities["local0"]
+}
+
+var facilities = map[string]int{
+	"kern": 0, "user": 1, "mail": 2, "daemon": 3, "auth": 4, "syslog": 5, "lpr": 6, "news": 7,
+	"uucp": 8, "cron": 9, "authpriv": 10, "ftp": 11,
+	"local0": 16, "local1": 17, "local2": 18, "local3": 19, "local4": 20, "local5": 21, "local6": 22, "local7": 23,
+}
+
+// OTLP configures a forwarder exporting to an OpenTelemetry collector using OTLP/HTTP with protobuf encoding.
+type OTLP struct {
+	// Endpoint is the URL logs are posted to, like "https://collector:4318/v1/logs".
+	Endpoint string `json:"endpoint,omitempty"`
+	// Headers are sent with each export, typically for authentication.
+	Headers map[string]string `json:"headers,omitempty"`
+	// ServiceName is the service.name resource attribute of exported logs. Defaults to "sc-bos".
+	ServiceName string `json:"serviceName,omitempty"`
+}
+
+// HTTP configures a forwarder posting batches of entries as JSON lines,
+// one smartcore.bos.log.v1.LogMessage per line, to a URL.
+type HTTP struct {
+	URL string `json:"url,omitempty"`
+	// Headers are sent with each request, typically for authentication.
+	Headers map[string]string `json:"headers,omitempty"`
+}
@@ -0,0 +1,124 @@
+package log
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
+	"github.com/smart-core-os/sc-bos/pkg/system/log/config"
+	"github.com/smart-core-os/sc-bos/pkg/system/log/forward"
+)
+
+// startForwarders starts a forward.Forwarder for each of cfgs, fed from the controller's root logger,
+// until ctx is done.
+func (s *System) startForwarders(ctx context.Context, cfgs []config.Forwarder) error {
+	if len(cfgs) == 0 {
+		return nil
+	}
+	if s.services.AddLogCore == nil {
+		s.logger.Warn("log forwarders configured but log capture is unavailable, logs will not be forwarded")
+		return nil
+	}
+	names := make(map[string]bool, len(cfgs))
+	for i, cfg := range cfgs {
+		if err := cfg.Validate(); err != nil {
+			return fmt.Errorf("forwarders[%d]: %w", i, err)
+		}
+		if names[cfg.Name] {
+			return fmt.Errorf("forwarders[%d]: duplicate name %q", i, cfg.Name)
+		}
+		names[cfg.Name] = true
+	}
+
+	var forwarders []*forward.Forwarder
+	closeAll := func() error {
+		var errs []error
+		for _, f := range forwarders {
+			errs = append(errs, f.Close())
+		}
+		return errors.Join(errs...)
+	}
+	for _, cfg := range cfgs {
+		opts := []forward.Option{forward.WithLogger(s.logger.Named("forward"))}
+		if s.services.DataDir != "" {
+			opts = append(opts, forward.WithQueueFile(filepath.Join(s.services.DataDir, "log-forward", cfg.Name+".sqlite3")))
+		}
+		if s.services.Health != nil {
+			check, err := s.services.Health.NewFaultCheck(s.name, &healthpb.HealthCheck{
+				Id:          "logForwarder:" + cfg.Name,
+				DisplayName: fmt.Sprintf("Log forwarder %s", cfg.Name),
+				Description: fmt.Sprintf("Checks logs are being delivered to the %s forwarder's sink", cfg.Type),
+			})
+			if err != nil {
+				return errors.Join(fmt.Errorf("forwarder %q: create health check: %w", cfg.Name, err), closeAll())
+			}
+			opts = append(opts, forward.WithHealthCheck(check))
+		}
+		f, err := forward.Open(ctx, cfg, opts...)
+		if err != nil {
+			return errors.Join(fmt.Errorf("forwarder %q: %w", cfg.Name, err), closeAll())
+		}
+		forwarders = append(forwarders, f)
+	}
+
+	for _, f := range forwarders {
+		go func() {
+			f.Run(ctx)
+			if err := f.Close(); err != nil {
+				s.logger.Warn("failed to close log forwarder", zap.String("forwarder", f.Name()), zap.Error(err))
+			}
+		}()
+	}
+	remove := s.services.AddLogCore(&forwardCore{forwarders: forwarders})
+	context.AfterFunc(ctx, remove)
+	return nil
+}
+
+// forwardCore is a zapcore.Core that passes log entries to the forwarders that accept them.
+// withFields accumulates fields added via With() so they appear on every Write.
+type forwardCore struct {
+	forwarders []*forward.Forwarder
+	withFields []zapcore.Field
+}
+
+func (c *forwardCore) Enabled(level zapcore.Level) bool {
+	for _, f := range c.forwarders {
+		if level >= f.MinLevel() {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *forwardCore) With(fields []zapcore.Field) zapcore.Core {
+	merged := make([]zapcore.Field, len(c.withFields)+len(fields))
+	copy(merged, c.withFields)
+	copy(merged[len(c.withFields):], fields)
+	return &forwardCore{forwarders: c.forwarders, withFields: merged}
+}
+
+func (c *forwardCore) Check(entry zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	for _, f := range c.forwarders {
+		if f.Enabled(entry.LoggerName, entry.Level) {
+			return ce.AddCore(entry, c)
+		}
+	}
+	return ce
+}
+
+func (c *forwardCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	msg := entryToLogMessage(entry, append(c.withFields, fields...))
+	for _, f := range c.forwarders {
+		if f.Enabled(entry.LoggerName, entry.Level) {
+			f.Enqueue(msg)
+		}
+	}
+	return nil
+}
+
+func (c *forwardCore) Sync() error { return nil }
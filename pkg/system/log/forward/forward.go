@@ -0,0 +1,418 @@
+// Package forward ships log messages to remote sinks: syslog servers, OpenTelemetry collectors, and HTTP endpoints.
+//
+// Each Forwarder queues messages on disk before delivering them in batches, so messages logged while the sink is
+// unavailable are delivered once it recovers.
+// The queue is bounded, dropping the oldest messages when full.
+// Delivery is at least once: a batch that partly reached the sink before failing is sent again.
+// Messages the sink rejects outright, for example because they are too large, are dropped rather than retried.
+package forward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/logpb"
+	"github.com/smart-core-os/sc-bos/pkg/system/log/config"
+)
+
+const (
+	// bufferSize is how many messages can wait to be written to the queue before new messages are dropped.
+	bufferSize = 1024
+	// batchSize is the most messages written to the queue, or delivered to the sink, at once.
+	batchSize = 500
+	// sendTimeout bounds how long a single delivery to the sink can take.
+	sendTimeout = 30 * time.Second
+	// minBackoff and maxBackoff bound the wait before retrying a failed delivery.
+	minBackoff = time.Second
+	maxBackoff = time.Minute
+	// dropLogInterval is the least time between logs reporting dropped messages.
+	dropLogInterval = time.Minute
+)
+
+// sink delivers messages to a remote system.
+type sink interface {
+	// send delivers msgs, returning a permanentError if retrying the same messages can't succeed.
+	send(ctx context.Context, msgs []*logpb.LogMessage) error
+	close() error
+}
+
+// permanentError is returned by a sink that rejected messages in a way that retrying won't fix.
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e permanentError) Unwrap() error {
+	return e.err
+}
+
+func isPermanent(err error) bool {
+	var pe permanentError
+	return errors.As(err, &pe)
+}
+
+// Forwarder delivers log messages to a sink.
+// Messages passed to Enqueue are delivered while Run is running.
+type Forwarder struct {
+	name   string
+	levels levels
+	sink   sink
+	queue  *queue
+	check  *healthpb.FaultCheck
+	logger *zap.Logger
+
+	queueFile string // where the queue is stored, or "" to hold it in memory
+
+	in      chan *logpb.LogMessage
+	wake    chan struct{} // signalled when messages are added to the queue
+	dropped atomic.Int64  // messages dropped because in was full
+
+	failing  bool      // whether the last delivery failed, only accessed by deliver
+	lastDrop time.Time // when dropped messages were last logged, only accessed by persist
+
+	// messages rejected by the sink since they were last logged, and the last rejection, only accessed by deliver
+	rejected     int64
+	rejectErr    error
+	lastRejected time.Time
+}
+
+// Option configures a Forwarder.
+type Option func(*Forwarder)
+
+// WithQueueFile stores the forwarder's queue in a SQLite database at path.
+// By default the queue is held in memory, so is lost when the forwarder is closed.
+func WithQueueFile(path string) Option {
+	return func(f *Forwarder) {
+		f.queueFile = path
+	}
+}
+
+// WithLogger sets the logger used to report delivery problems.
+func WithLogger(logger *zap.Logger) Option {
+	return func(f *Forwarder) {
+		f.logger = logger
+	}
+}
+
+// WithHealthCheck reports the forwarder's delivery status to check.
+// The check is marked failed while messages can't be delivered to the sink.
+// Run disposes of the check when it returns.
+func WithHealthCheck(check *healthpb.FaultCheck) Option {
+	return func(f *Forwarder) {
+		f.check = check
+	}
+}
+
+// Open returns a Forwarder for cfg, opening its queue.
+// Any messages left in the queue from a previous run are delivered once Run is called.
+// Close the Forwarder to close its queue.
+func Open(ctx context.Context, cfg config.Forwarder, opts ...Option) (*Forwarder, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	f := &Forwarder{
+		name:   cfg.Name,
+		logger: zap.NewNop(),
+		in:     make(chan *logpb.LogMessage, bufferSize),
+		wake:   make(chan struct{}, 1),
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	var err error
+	if f.levels, err = newLevels(cfg); err != nil {
+		return nil, err
+	}
+	switch cfg.Type {
+	case config.ForwarderSyslog:
+		f.sink, err = newSyslogSink(*cfg.Syslog)
+	case config.ForwarderOTLP:
+		f.sink = newOTLPSink(*cfg.OTLP)
+	case config.ForwarderHTTP:
+		f.sink = newHTTPSink(*cfg.HTTP)
+	}
+	if err != nil {
+		return nil, err
+	}
+	f.queue, err = openQueue(ctx, f.queueFile, cfg.MaxQueueBytesOrDefault(), f.logger)
+	if err != nil {
+		return nil, fmt.Errorf("open queue: %w", err)
+	}
+	return f, nil
+}
+
+// Name returns the name of the forwarder, from its config.
+func (f *Forwarder) Name() string {
+	return f.name
+}
+
+// MinLevel returns the lowest level of any message the forwarder might deliver.
+func (f *Forwarder) MinLevel() zapcore.Level {
+	return f.levels.min
+}
+
+// Enabled returns whether messages at level logged by the named logger are delivered.
+func (f *Forwarder) Enabled(logger string, level zapcore.Level) bool {
+	return f.levels.enabled(logger, level)
+}
+
+// Enqueue adds msg to the messages to deliver, without blocking.
+// If messages are being logged faster than they can be queued, msg is dropped.
+// Callers should check Enabled first, msg is not filtered by Enqueue.
+func (f *Forwarder) Enqueue(msg *logpb.LogMessage) {
+	select {
+	case f.in <- msg:
+	default:
+		f.dropped.Add(1)
+	}
+}
+
+// Run writes enqueued messages to the queue and delivers them to the sink, until ctx is done.
+func (f *Forwarder) Run(ctx context.Context) {
+	if f.check != nil {
+		defer f.check.Dispose()
+	}
+	var wg sync.WaitGroup
+	wg.Go(func() { f.persist(ctx) })
+	wg.Go(func() { f.deliver(ctx) })
+	wg.Wait()
+}
+
+// Close closes the queue and any connection to the sink. Call Close after Run has returned.
+func (f *Forwarder) Close() error {
+	return errors.Join(f.sink.close(), f.queue.close())
+}
+
+// persist moves messages from the in channel to the queue.
+func (f *Forwarder) persist(ctx context.Context) {
+	var dropped int64
+	for {
+		var batch [][]byte
+		select {
+		case <-ctx.Done():
+			return
+		case msg := <-f.in:
+			batch = append(batch, marshal(msg))
+		}
+	drain:
+		for len(batch) < batchSize {
+			select {
+			case msg := <-f.in:
+				batch = append(batch, marshal(msg))
+			default:
+				break drain
+			}
+		}
+		n, err := f.queue.push(ctx, batch)
+		if err != nil {
+			n = int64(len(batch))
+			if ctx.Err() == nil {
+				f.logger.Warn("failed to queue log messages for forwarding", zap.String("forwarder", f.name), zap.Error(err))
+			}
+		}
+		dropped += n + f.dropped.Swap(0)
+		if dropped > 0 && time.Since(f.lastDrop) >= dropLogInterval {
+			f.logger.Warn("log forwarder queue full, dropped log messages",
+				zap.String("forwarder", f.name), zap.Int64("count", dropped))
+			f.lastDrop = time.Now()
+			dropped = 0
+		}
+		select {
+		case f.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// deliver sends queued messages to the sink, removing them from the queue once delivered.
+func (f *Forwarder) deliver(ctx context.Context) {
+	backoff := minBackoff
+	for {
+		lastID, payloads, err := f.queue.peek(ctx, batchSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			f.failed(fmt.Errorf("read queue: %w", err))
+			if !sleep(ctx, backoff) {
+				return
+			}
+			backoff = min(backoff*2, maxBackoff)
+			continue
+		}
+		if len(payloads) == 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-f.wake:
+				continue
+			}
+		}
+
+		msgs := make([]*logpb.LogMessage, 0, len(payloads))
+		for _, p := range payloads {
+			msg := &logpb.LogMessage{}
+			if err := proto.Unmarshal(p, msg); err != nil {
+				continue // can never be delivered
+			}
+			msgs = append(msgs, msg)
+		}
+		var dropped int
+		if len(msgs) > 0 {
+			dropped, err = f.send(ctx, msgs)
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			f.failed(err)
+			if !sleep(ctx, backoff) {
+				return
+			}
+			backoff = min(backoff*2, maxBackoff)
+			continue
+		}
+		backoff = minBackoff
+		if dropped > 0 {
+			f.reject(dropped)
+		}
+		if len(msgs) == 0 || dropped < len(msgs) {
+			f.delivered()
+		} else if f.check != nil {
+			f.check.MarkFailed(f.rejectErr)
+		}
+		if err := f.queue.remove(ctx, lastID); err != nil && ctx.Err() == nil {
+			f.logger.Warn("failed to remove forwarded log messages from queue", zap.String("forwarder", f.name), zap.Error(err))
+		}
+	}
+}
+
+// send delivers msgs to the sink, dropping any the sink permanently rejects.
+// A rejected batch is split in half and each half sent again, until the rejected messages are found.
+// It returns how many messages were dropped, and any error that should cause the whole batch to be retried.
+func (f *Forwarder) send(ctx context.Context, msgs []*logpb.LogMessage) (dropped int, err error) {
+	sendCtx, cancel := context.WithTimeout(ctx, sendTimeout)
+	err = f.sink.send(sendCtx, msgs)
+	cancel()
+	if !isPermanent(err) {
+		return 0, err
+	}
+	if len(msgs) == 1 {
+		f.rejectErr = err
+		return 1, nil
+	}
+	mid := len(msgs) / 2
+	dropped, err = f.send(ctx, msgs[:mid])
+	if err != nil {
+		return 0, err
+	}
+	n, err := f.send(ctx, msgs[mid:])
+	if err != nil {
+		return 0, err
+	}
+	return dropped + n, nil
+}
+
+// reject records messages dropped because the sink rejected them.
+// Rejections are logged at most once per dropLogInterval, as forwarded logs could otherwise be rejected in turn.
+func (f *Forwarder) reject(n int) {
+	f.rejected += int64(n)
+	if time.Since(f.lastRejected) >= dropLogInterval {
+		f.logger.Warn("log forwarder sink rejected log messages, dropped them",
+			zap.String("forwarder", f.name), zap.Int64("count", f.rejected), zap.Error(f.rejectErr))
+		f.lastRejected = time.Now()
+		f.rejected = 0
+	}
+}
+
+// failed records a failed delivery.
+// Only the first failure is logged, as forwarded logs would otherwise fill the queue with more failures.
+func (f *Forwarder) failed(err error) {
+	if f.check != nil {
+		f.check.MarkFailed(err)
+	}
+	if !f.failing {
+		f.logger.Warn("failed to forward logs, will retry", zap.String("forwarder", f.name), zap.Error(err))
+		f.failing = true
+	}
+}
+
+// delivered records a successful delivery.
+func (f *Forwarder) delivered() {
+	if f.check != nil {
+		f.check.MarkRunning()
+	}
+	if f.failing {
+		f.logger.Info("log forwarding resumed", zap.String("forwarder", f.name))
+		f.failing = false
+	}
+}
+
+func marshal(msg *logpb.LogMessage) []byte {
+	b, _ := proto.Marshal(msg) // LogMessage has no fields that can fail to marshal
+	return b
+}
+
+// sleep waits for d, returning false if ctx is done first.
+func sleep(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
+
+// levels is the minimum level of messages delivered, by logger name.
+type levels struct {
+	level   zapcore.Level
+	loggers map[string]zapcore.Level
+	min     zapcore.Level
+}
+
+func newLevels(cfg config.Forwarder) (levels, error) {
+	level, err := config.ParseLevel(cfg.Level)
+	if err != nil {
+		return levels{}, err
+	}
+	l := levels{level: level, min: level, loggers: make(map[string]zapcore.Level, len(cfg.Loggers))}
+	for name, v := range cfg.Loggers {
+		level, err := config.ParseLevel(v)
+		if err != nil {
+			return levels{}, fmt.Errorf("logger %q: %w", name, err)
+		}
+		l.loggers[name] = level
+		l.min = min(l.min, level)
+	}
+	return l, nil
+}
+
+// enabled returns whether messages from logger at level are delivered.
+// The level of the logger with the longest name that is logger, or one of its parents, applies.
+func (l levels) enabled(logger string, level zapcore.Level) bool {
+	want, best := l.level, -1
+	for name, v := range l.loggers {
+		if len(name) <= best {
+			continue
+		}
+		if logger == name || strings.HasPrefix(logger, name+".") {
+			want, best = v, len(name)
+		}
+	}
+	return level >= want
+}
@@ -0,0 +1,290 @@
+package forward
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/logpb"
+	"github.com/smart-core-os/sc-bos/pkg/system/log/config"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+)
+
+func TestLevels_enabled(t *testing.T) {
+	l, err := newLevels(config.Forwarder{
+		Level:   "warn",
+		Loggers: map[string]string{"driver": "error", "driver.bacnet": "debug"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		logger string
+		level  zapcore.Level
+		want   bool
+	}{
+		{"", zapcore.WarnLevel, true},
+		{"auto.lights", zapcore.InfoLevel, false},
+		{"driver", zapcore.WarnLevel, false},
+		{"driver.opcua", zapcore.ErrorLevel, true},
+		{"driver.bacnet", zapcore.DebugLevel, true},
+		{"driver.bacnet.ahu-1", zapcore.DebugLevel, true},
+		{"driver.bacnetx", zapcore.WarnLevel, false},
+	}
+	for _, tt := range tests {
+		if got := l.enabled(tt.logger, tt.level); got != tt.want {
+			t.Errorf("enabled(%q, %v) = %v, want %v", tt.logger, tt.level, got, tt.want)
+		}
+	}
+	if l.min != zapcore.DebugLevel {
+		t.Errorf("min = %v, want debug", l.min)
+	}
+}
+
+// Messages logged while the sink is failing are delivered once it recovers,
+// with the health check reflecting the outage.
+func TestForwarder_outage(t *testing.T) {
+	var mu sync.Mutex
+	failing := true
+	received := make(chan string, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		if failing {
+			http.Error(w, "down for maintenance", http.StatusServiceUnavailable)
+			return
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("Authorization = %q", got)
+		}
+		sc := bufio.NewScanner(r.Body)
+		for sc.Scan() {
+			msg := &logpb.LogMessage{}
+			if err := protojson.Unmarshal(sc.Bytes(), msg); err != nil {
+				t.Errorf("unmarshal line %q: %v", sc.Text(), err)
+				continue
+			}
+			received <- msg.Message
+		}
+	}))
+	defer srv.Close()
+
+	var normality struct {
+		sync.Mutex
+		v healthpb.HealthCheck_Normality
+	}
+	registry := healthpb.NewRegistry(healthpb.WithOnCheckUpdate(func(_ string, c *healthpb.HealthCheck) {
+		normality.Lock()
+		defer normality.Unlock()
+		normality.v = c.GetNormality()
+	}))
+	isNormality := func(want healthpb.HealthCheck_Normality) func() bool {
+		return func() bool {
+			normality.Lock()
+			defer normality.Unlock()
+			return normality.v == want
+		}
+	}
+	check, err := registry.ForOwner("test").NewFaultCheck("node", &healthpb.HealthCheck{Id: "forwarder"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := Open(t.Context(), config.Forwarder{
+		Name: "soc",
+		Type: config.ForwarderHTTP,
+		HTTP: &config.HTTP{URL: srv.URL, Headers: map[string]string{"Authorization": "Bearer token"}},
+	}, WithQueueFile(t.TempDir()+"/queue.sqlite3"), WithHealthCheck(check))
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer f.Close()
+	ctx, stop := context.WithCancel(t.Context())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f.Run(ctx)
+	}()
+	defer func() {
+		stop()
+		<-done
+	}()
+
+	f.Enqueue(&logpb.LogMessage{Message: "first"})
+	f.Enqueue(&logpb.LogMessage{Message: "second"})
+	waitFor(t, isNormality(healthpb.HealthCheck_ABNORMAL))
+
+	mu.Lock()
+	failing = false
+	mu.Unlock()
+	for _, want := range []string{"first", "second"} {
+		select {
+		case got := <-received:
+			if got != want {
+				t.Errorf("received %q, want %q", got, want)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for %q", want)
+		}
+	}
+	waitFor(t, isNormality(healthpb.HealthCheck_NORMAL))
+}
+
+// Messages the sink rejects permanently are dropped, without holding up the messages queued after them.
+func TestForwarder_rejected(t *testing.T) {
+	received := make(chan string, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msgs []string
+		sc := bufio.NewScanner(r.Body)
+		for sc.Scan() {
+			msg := &logpb.LogMessage{}
+			if err := protojson.Unmarshal(sc.Bytes(), msg); err != nil {
+				t.Errorf("unmarshal line %q: %v", sc.Text(), err)
+				continue
+			}
+			if msg.Message == "too large" {
+				http.Error(w, "too large", http.StatusRequestEntityTooLarge)
+				return
+			}
+			msgs = append(msgs, msg.Message)
+		}
+		for _, msg := range msgs {
+			received <- msg
+		}
+	}))
+	defer srv.Close()
+
+	f, err := Open(t.Context(), config.Forwarder{
+		Name: "soc",
+		Type: config.ForwarderHTTP,
+		HTTP: &config.HTTP{URL: srv.URL},
+	})
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	defer f.Close()
+	ctx, stop := context.WithCancel(t.Context())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f.Run(ctx)
+	}()
+	defer func() {
+		stop()
+		<-done
+	}()
+
+	// queue the messages together so they are delivered as one batch
+	_, err = f.queue.push(ctx, [][]byte{
+		marshal(&logpb.LogMessage{Message: "first"}),
+		marshal(&logpb.LogMessage{Message: "too large"}),
+		marshal(&logpb.LogMessage{Message: "second"}),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Enqueue(&logpb.LogMessage{Message: "third"})
+
+	var got []string
+	for !slices.Contains(got, "third") {
+		select {
+		case msg := <-received:
+			got = append(got, msg)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for messages after the rejected one, got %v", got)
+		}
+	}
+	for _, want := range []string{"first", "second"} {
+		if !slices.Contains(got, want) {
+			t.Errorf("received %v, want %q", got, want)
+		}
+	}
+	if slices.Contains(got, "too large") {
+		t.Errorf("received %v, want rejected message dropped", got)
+	}
+}
+
+func TestPost_permanent(t *testing.T) {
+	for code, want := range map[int]bool{
+		http.StatusBadRequest:            true,
+		http.StatusNotFound:              true,
+		http.StatusRequestEntityTooLarge: true,
+		http.StatusRequestTimeout:        false,
+		http.StatusTooManyRequests:       false,
+		http.StatusInternalServerError:   false,
+		http.StatusServiceUnavailable:    false,
+	} {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(code)
+		}))
+		err := post(t.Context(), srv.Client(), srv.URL, nil, "text/plain", nil)
+		srv.Close()
+		if err == nil {
+			t.Errorf("post() status %d error = nil, want error", code)
+			continue
+		}
+		if got := isPermanent(err); got != want {
+			t.Errorf("post() status %d permanent = %v, want %v", code, got, want)
+		}
+	}
+}
+
+func TestOTLPSink(t *testing.T) {
+	got := make(chan *collogspb.ExportLogsServiceRequest, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ct := r.Header.Get("Content-Type"); ct != "application/x-protobuf" {
+			t.Errorf("Content-Type = %q", ct)
+		}
+		body, _ := io.ReadAll(r.Body)
+		req := &collogspb.ExportLogsServiceRequest{}
+		if err := proto.Unmarshal(body, req); err != nil {
+			t.Errorf("unmarshal: %v", err)
+		}
+		got <- req
+	}))
+	defer srv.Close()
+
+	s := newOTLPSink(config.OTLP{Endpoint: srv.URL})
+	err := s.send(t.Context(), []*logpb.LogMessage{
+		{Logger: "a", Level: logpb.Level_LEVEL_ERROR, Message: "one"},
+		{Logger: "b", Message: "two"},
+		{Logger: "a", Message: "three"},
+	})
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	req := <-got
+	scopes := req.GetResourceLogs()[0].GetScopeLogs()
+	if len(scopes) != 2 || scopes[0].GetScope().GetName() != "a" || scopes[1].GetScope().GetName() != "b" {
+		t.Fatalf("scopes = %v, want a and b", scopes)
+	}
+	records := scopes[0].GetLogRecords()
+	if len(records) != 2 || records[0].GetBody().GetStringValue() != "one" || records[1].GetBody().GetStringValue() != "three" {
+		t.Errorf("scope a records = %v, want one and three", records)
+	}
+	if got := records[0].GetSeverityText(); got != "ERROR" {
+		t.Errorf("severity text = %q, want ERROR", got)
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,70 @@
+package forward
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/logpb"
+	"github.com/smart-core-os/sc-bos/pkg/system/log/config"
+)
+
+// httpSink posts messages to a URL as JSON lines, one LogMessage per line.
+type httpSink struct {
+	client  *http.Client
+	url     string
+	headers map[string]string
+}
+
+func newHTTPSink(cfg config.HTTP) *httpSink {
+	return &httpSink{client: http.DefaultClient, url: cfg.URL, headers: cfg.Headers}
+}
+
+func (s *httpSink) send(ctx context.Context, msgs []*logpb.LogMessage) error {
+	var body bytes.Buffer
+	for _, msg := range msgs {
+		line, err := protojson.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		body.Write(line)
+		body.WriteByte('\n')
+	}
+	return post(ctx, s.client, s.url, s.headers, "application/x-ndjson", body.Bytes())
+}
+
+func (s *httpSink) close() error {
+	return nil
+}
+
+// post sends body to url, returning an error unless the server responds with a 2xx status.
+// A 4xx status, other than 408 Request Timeout or 429 Too Many Requests, is a permanentError.
+func post(ctx context.Context, client *http.Client, url string, headers map[string]string, contentType string, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		err := fmt.Errorf("%s: %s", resp.Status, bytes.TrimSpace(msg))
+		if resp.StatusCode/100 == 4 && resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests {
+			return permanentError{err}
+		}
+		return err
+	}
+	_, _ = io.Copy(io.Discard, resp.Body) // allow the connection to be reused
+	return nil
+}
@@ -0,0 +1,113 @@
+package forward
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strings"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/logpb"
+	"github.com/smart-core-os/sc-bos/pkg/system/log/config"
+)
+
+// otlpSink exports messages to an OpenTelemetry collector using OTLP/HTTP with protobuf encoding.
+type otlpSink struct {
+	client   *http.Client
+	endpoint string
+	headers  map[string]string
+	resource *resourcepb.Resource
+}
+
+func newOTLPSink(cfg config.OTLP) *otlpSink {
+	serviceName := cfg.ServiceName
+	if serviceName == "" {
+		serviceName = "sc-bos"
+	}
+	attrs := []*commonpb.KeyValue{stringAttr("service.name", serviceName)}
+	if host, err := os.Hostname(); err == nil {
+		attrs = append(attrs, stringAttr("host.name", host))
+	}
+	return &otlpSink{
+		client:   http.DefaultClient,
+		endpoint: cfg.Endpoint,
+		headers:  cfg.Headers,
+		resource: &resourcepb.Resource{Attributes: attrs},
+	}
+}
+
+func (s *otlpSink) send(ctx context.Context, msgs []*logpb.LogMessage) error {
+	body, err := proto.Marshal(otlpRequest(s.resource, msgs))
+	if err != nil {
+		return err
+	}
+	return post(ctx, s.client, s.endpoint, s.headers, "application/x-protobuf", body)
+}
+
+func (s *otlpSink) close() error {
+	return nil
+}
+
+// otlpRequest converts msgs to an export request, using the logger name as the instrumentation scope.
+func otlpRequest(resource *resourcepb.Resource, msgs []*logpb.LogMessage) *collogspb.ExportLogsServiceRequest {
+	rl := &logspb.ResourceLogs{Resource: resource}
+	scopes := make(map[string]*logspb.ScopeLogs)
+	for _, msg := range msgs {
+		sl, ok := scopes[msg.Logger]
+		if !ok {
+			sl = &logspb.ScopeLogs{Scope: &commonpb.InstrumentationScope{Name: msg.Logger}}
+			scopes[msg.Logger] = sl
+			rl.ScopeLogs = append(rl.ScopeLogs, sl)
+		}
+		sl.LogRecords = append(sl.LogRecords, otlpRecord(msg))
+	}
+	return &collogspb.ExportLogsServiceRequest{ResourceLogs: []*logspb.ResourceLogs{rl}}
+}
+
+func otlpRecord(msg *logpb.LogMessage) *logspb.LogRecord {
+	r := &logspb.LogRecord{
+		SeverityNumber: otlpSeverity(msg.Level),
+		SeverityText:   strings.TrimPrefix(msg.Level.String(), "LEVEL_"),
+		Body:           &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: msg.Message}},
+	}
+	if msg.Timestamp != nil {
+		r.TimeUnixNano = uint64(msg.Timestamp.AsTime().UnixNano())
+		r.ObservedTimeUnixNano = r.TimeUnixNano
+	}
+	for k, v := range msg.Fields {
+		r.Attributes = append(r.Attributes, stringAttr(k, v))
+	}
+	if loc := msg.SourceLocation; loc != nil {
+		r.Attributes = append(r.Attributes,
+			stringAttr("code.filepath", loc.File),
+			&commonpb.KeyValue{Key: "code.lineno", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(loc.Line)}}},
+			stringAttr("code.function", loc.Function),
+		)
+	}
+	if msg.StackTrace != "" {
+		r.Attributes = append(r.Attributes, stringAttr("exception.stacktrace", msg.StackTrace))
+	}
+	return r
+}
+
+func otlpSeverity(l logpb.Level) logspb.SeverityNumber {
+	switch l {
+	case logpb.Level_LEVEL_DEBUG:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_DEBUG
+	case logpb.Level_LEVEL_WARN:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_WARN
+	case logpb.Level_LEVEL_ERROR:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_ERROR
+	default:
+		return logspb.SeverityNumber_SEVERITY_NUMBER_INFO
+	}
+}
+
+func stringAttr(k, v string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: k, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v}}}
+}
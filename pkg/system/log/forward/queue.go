@@ -0,0 +1,141 @@
+package forward
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/smart-core-os/sc-bos/internal/sqlite"
+)
+
+const appID = 0x5C0505
+
+//go:embed schema/*.sql
+var schemaVersionsFS embed.FS
+var schema = sqlite.MustLoadVersionedSchema(schemaVersionsFS, "schema")
+
+// queue is a FIFO of encoded log messages, bounded by the total size of the messages it holds.
+type queue struct {
+	db       *sqlite.Database
+	maxBytes int64
+
+	mu   sync.Mutex // guards size, and orders the writes that change it
+	size int64      // total size of queued payloads
+}
+
+// openQueue opens the queue stored in the SQLite database at path, or in memory if path is empty.
+func openQueue(ctx context.Context, path string, maxBytes int64, logger *zap.Logger) (*queue, error) {
+	opts := []sqlite.Option{sqlite.WithLogger(logger), sqlite.WithApplicationID(appID)}
+	var db *sqlite.Database
+	if path == "" {
+		db = sqlite.OpenMemory(opts...)
+	} else {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return nil, fmt.Errorf("mkdir: %w", err)
+		}
+		var err error
+		db, err = sqlite.Open(ctx, path, opts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := db.Migrate(ctx, schema); err != nil {
+		return nil, errors.Join(err, db.Close())
+	}
+	q := &queue{db: db, maxBytes: maxBytes}
+	err := db.ReadTx(ctx, func(tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, "SELECT COALESCE(SUM(LENGTH(payload)), 0) FROM log_queue").Scan(&q.size)
+	})
+	if err != nil {
+		return nil, errors.Join(err, db.Close())
+	}
+	return q, nil
+}
+
+// push appends payloads to the queue, dropping the oldest payloads if the queue grows beyond maxBytes.
+// Returns the number of payloads dropped.
+func (q *queue) push(ctx context.Context, payloads [][]byte) (int64, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var size, dropped int64
+	err := q.db.WriteTx(ctx, func(tx *sql.Tx) error {
+		size, dropped = q.size, 0
+		for _, p := range payloads {
+			if _, err := tx.ExecContext(ctx, "INSERT INTO log_queue (payload) VALUES (?)", p); err != nil {
+				return err
+			}
+			size += int64(len(p))
+		}
+		if size <= q.maxBytes {
+			return nil
+		}
+		// drop the newest row that takes the total over maxBytes, along with everything older
+		res, err := tx.ExecContext(ctx, `DELETE FROM log_queue WHERE id <= (
+			SELECT id FROM (SELECT id, SUM(LENGTH(payload)) OVER (ORDER BY id DESC) AS total FROM log_queue)
+			WHERE total > ? ORDER BY id DESC LIMIT 1)`, q.maxBytes)
+		if err != nil {
+			return err
+		}
+		dropped, err = res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		return tx.QueryRowContext(ctx, "SELECT COALESCE(SUM(LENGTH(payload)), 0) FROM log_queue").Scan(&size)
+	})
+	if err != nil {
+		return 0, err
+	}
+	q.size = size
+	return dropped, nil
+}
+
+// peek returns up to n of the oldest payloads in the queue, along with the id of the last one returned.
+func (q *queue) peek(ctx context.Context, n int) (lastID int64, payloads [][]byte, err error) {
+	err = q.db.ReadTx(ctx, func(tx *sql.Tx) error {
+		rows, err := tx.QueryContext(ctx, "SELECT id, payload FROM log_queue ORDER BY id LIMIT ?", n)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var payload []byte
+			if err := rows.Scan(&lastID, &payload); err != nil {
+				return err
+			}
+			payloads = append(payloads, payload)
+		}
+		return rows.Err()
+	})
+	return lastID, payloads, err
+}
+
+// remove removes all payloads up to and including the one with id, as returned by peek.
+func (q *queue) remove(ctx context.Context, id int64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var removed int64
+	err := q.db.WriteTx(ctx, func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx, "SELECT COALESCE(SUM(LENGTH(payload)), 0) FROM log_queue WHERE id <= ?", id).Scan(&removed)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, "DELETE FROM log_queue WHERE id <= ?", id)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	q.size -= removed
+	return nil
+}
+
+func (q *queue) close() error {
+	return q.db.Close()
+}
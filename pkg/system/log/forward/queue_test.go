@@ -0,0 +1,74 @@
+package forward
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestQueue(t *testing.T) {
+	ctx := t.Context()
+	path := filepath.Join(t.TempDir(), "queue.sqlite3")
+	q, err := openQueue(ctx, path, 10, zap.NewNop())
+	if err != nil {
+		t.Fatalf("openQueue: %v", err)
+	}
+
+	dropped, err := q.push(ctx, [][]byte{[]byte("aaa"), []byte("bbb"), []byte("ccc")})
+	if err != nil || dropped != 0 {
+		t.Fatalf("push() = %d, %v, want 0, nil", dropped, err)
+	}
+	// takes the queue to 12 bytes, so the oldest entry goes
+	dropped, err = q.push(ctx, [][]byte{[]byte("ddd")})
+	if err != nil || dropped != 1 {
+		t.Fatalf("push() = %d, %v, want 1, nil", dropped, err)
+	}
+
+	lastID, payloads, err := q.peek(ctx, 2)
+	if err != nil {
+		t.Fatalf("peek: %v", err)
+	}
+	if want := [][]byte{[]byte("bbb"), []byte("ccc")}; !equalPayloads(payloads, want) {
+		t.Errorf("peek() = %q, want %q", payloads, want)
+	}
+	if err := q.remove(ctx, lastID); err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+	if q.size != 3 {
+		t.Errorf("size = %d, want 3", q.size)
+	}
+
+	// the queue survives being reopened
+	if err := q.close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	q, err = openQueue(ctx, path, 10, zap.NewNop())
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	defer q.close()
+	if q.size != 3 {
+		t.Errorf("reopened size = %d, want 3", q.size)
+	}
+	_, payloads, err = q.peek(ctx, 10)
+	if err != nil {
+		t.Fatalf("peek: %v", err)
+	}
+	if want := [][]byte{[]byte("ddd")}; !equalPayloads(payloads, want) {
+		t.Errorf("peek() after reopen = %q, want %q", payloads, want)
+	}
+}
+
+func equalPayloads(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,6 @@
+CREATE TABLE log_queue
+(
+    id      INTEGER PRIMARY KEY AUTOINCREMENT,
+    -- A binary smartcore.bos.log.v1.LogMessage proto message.
+    payload BLOB NOT NULL
+);
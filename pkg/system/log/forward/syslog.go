@@ -0,0 +1,178 @@
+package forward
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"syscall"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/logpb"
+	"github.com/smart-core-os/sc-bos/pkg/system/log/config"
+)
+
+// syslogTimeFormat is the RFC 5424 TIMESTAMP format, which allows at most microsecond precision.
+const syslogTimeFormat = "2006-01-02T15:04:05.000000Z07:00"
+
+// syslogSink sends messages to a syslog server using the RFC 5424 format.
+// A connection is made on the first send, and again on the send after a failure.
+type syslogSink struct {
+	network  string // "udp", "tcp", or "tls"
+	address  string
+	tls      *tls.Config // used when network is "tls"
+	facility int
+	hostname string
+	appName  string
+	procID   string
+
+	conn net.Conn
+}
+
+func newSyslogSink(cfg config.Syslog) (*syslogSink, error) {
+	s := &syslogSink{
+		network:  cfg.Network,
+		address:  cfg.Address,
+		facility: cfg.FacilityOrDefault(),
+		hostname: cfg.Hostname,
+		appName:  cfg.AppName,
+		procID:   strconv.Itoa(os.Getpid()),
+	}
+	if s.network == "" {
+		s.network = "udp"
+	}
+	if s.hostname == "" {
+		s.hostname, _ = os.Hostname()
+	}
+	if s.appName == "" {
+		s.appName = "sc-bos"
+	}
+	if s.network == "tls" {
+		host, _, err := net.SplitHostPort(cfg.Address)
+		if err != nil {
+			return nil, fmt.Errorf("address: %w", err)
+		}
+		s.tls = &tls.Config{ServerName: host}
+		if cfg.CACertFile != "" {
+			caCert, err := os.ReadFile(cfg.CACertFile)
+			if err != nil {
+				return nil, fmt.Errorf("reading CA cert %q: %w", cfg.CACertFile, err)
+			}
+			pool := x509.NewCertPool()
+			if !pool.AppendCertsFromPEM(caCert) {
+				return nil, fmt.Errorf("failed to parse CA cert %q", cfg.CACertFile)
+			}
+			s.tls.RootCAs = pool
+		}
+	}
+	return s, nil
+}
+
+func (s *syslogSink) send(ctx context.Context, msgs []*logpb.LogMessage) error {
+	if s.conn == nil {
+		conn, err := s.dial(ctx)
+		if err != nil {
+			return err
+		}
+		s.conn = conn
+	}
+	deadline, _ := ctx.Deadline()
+	if err := s.conn.SetWriteDeadline(deadline); err != nil {
+		return s.reset(err)
+	}
+	for _, msg := range msgs {
+		line := formatSyslog(s.facility, s.hostname, s.appName, s.procID, msg)
+		if s.network != "udp" {
+			// octet counting framing, RFC 6587 section 3.4.1
+			line = append([]byte(strconv.Itoa(len(line))+" "), line...)
+		}
+		if _, err := s.conn.Write(line); err != nil {
+			if errors.Is(err, syscall.EMSGSIZE) {
+				// the message can't fit in a datagram, but the connection is still usable
+				return permanentError{err}
+			}
+			return s.reset(err)
+		}
+	}
+	return nil
+}
+
+func (s *syslogSink) dial(ctx context.Context) (net.Conn, error) {
+	if s.network == "tls" {
+		d := &tls.Dialer{Config: s.tls}
+		return d.DialContext(ctx, "tcp", s.address)
+	}
+	var d net.Dialer
+	return d.DialContext(ctx, s.network, s.address)
+}
+
+// reset closes the connection after a failed write, so the next send reconnects.
+func (s *syslogSink) reset(err error) error {
+	err = errors.Join(err, s.conn.Close())
+	s.conn = nil
+	return err
+}
+
+func (s *syslogSink) close() error {
+	if s.conn == nil {
+		return nil
+	}
+	return s.conn.Close()
+}
+
+// formatSyslog returns msg as an RFC 5424 syslog message.
+// The logger name is used as the MSGID, any fields are appended to the message as a JSON object,
+// and any stack trace follows on the next lines.
+func formatSyslog(facility int, hostname, appName, procID string, msg *logpb.LogMessage) []byte {
+	ts := "-"
+	if msg.Timestamp != nil {
+		ts = msg.Timestamp.AsTime().Format(syslogTimeFormat)
+	}
+	text := msg.Message
+	if len(msg.Fields) > 0 {
+		fields, _ := json.Marshal(msg.Fields) // map keys are sorted, and strings always marshal
+		text += " " + string(fields)
+	}
+	if msg.StackTrace != "" {
+		text += "\n" + msg.StackTrace
+	}
+	return fmt.Appendf(nil, "<%d>1 %s %s %s %s %s - %s",
+		facility*8+syslogSeverity(msg.Level), ts,
+		syslogHeader(hostname, 255), syslogHeader(appName, 48), syslogHeader(procID, 128), syslogHeader(msg.Logger, 32),
+		text)
+}
+
+// syslogHeader returns v as an RFC 5424 header field: printable ASCII with no spaces, at most n long,
+// or "-" if empty.
+func syslogHeader(v string, n int) string {
+	if v == "" {
+		return "-"
+	}
+	b := []byte(v)
+	for i, c := range b {
+		if c < 33 || c > 126 {
+			b[i] = '_'
+		}
+	}
+	if len(b) > n {
+		b = b[:n]
+	}
+	return string(b)
+}
+
+func syslogSeverity(l logpb.Level) int {
+	switch l {
+	case logpb.Level_LEVEL_DEBUG:
+		return 7
+	case logpb.Level_LEVEL_WARN:
+		return 4
+	case logpb.Level_LEVEL_ERROR:
+		return 3
+	default:
+		return 6 // informational
+	}
+}
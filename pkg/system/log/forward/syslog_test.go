@@ -0,0 +1,116 @@
+package forward
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/logpb"
+	"github.com/smart-core-os/sc-bos/pkg/system/log/config"
+)
+
+func TestFormatSyslog(t *testing.T) {
+	msg := &logpb.LogMessage{
+		Timestamp: timestamppb.New(time.Date(2026, 10, 19, 9, 30, 0, 123456789, time.UTC)),
+		Level:     logpb.Level_LEVEL_WARN,
+		Logger:    "driver.bacnet",
+		Message:   "device offline",
+		Fields:    map[string]string{"device": "ahu-1", "attempt": "3"},
+	}
+	got := string(formatSyslog(16, "bos host", "sc-bos", "42", msg))
+	want := `<132>1 2026-10-19T09:30:00.123456Z bos_host sc-bos 42 driver.bacnet - device offline {"attempt":"3","device":"ahu-1"}`
+	if got != want {
+		t.Errorf("formatSyslog()\n got %s\nwant %s", got, want)
+	}
+
+	got = string(formatSyslog(1, "", "sc-bos", "42", &logpb.LogMessage{Level: logpb.Level_LEVEL_DEBUG, Message: "hi"}))
+	if want := "<15>1 - - sc-bos 42 - - hi"; got != want {
+		t.Errorf("formatSyslog() empty\n got %s\nwant %s", got, want)
+	}
+
+	got = string(formatSyslog(1, "", "sc-bos", "42", &logpb.LogMessage{Level: logpb.Level_LEVEL_ERROR, Message: "panic", StackTrace: "main.main\n\tmain.go:10"}))
+	if want := "<11>1 - - sc-bos 42 - - panic\nmain.main\n\tmain.go:10"; got != want {
+		t.Errorf("formatSyslog() stack trace\n got %s\nwant %s", got, want)
+	}
+}
+
+// A message too large for a UDP datagram is rejected permanently, so it is dropped rather than retried.
+func TestSyslogSink_UDPTooLarge(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	s, err := newSyslogSink(config.Syslog{Network: "udp", Address: conn.LocalAddr().String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.close()
+	err = s.send(t.Context(), []*logpb.LogMessage{{Message: strings.Repeat("x", 70_000)}})
+	if !isPermanent(err) {
+		t.Fatalf("send() error = %v, want permanent error", err)
+	}
+	if err := s.send(t.Context(), []*logpb.LogMessage{{Message: "small"}}); err != nil {
+		t.Errorf("send() after too large error = %v", err)
+	}
+}
+
+func TestSyslogSink_TCP(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	frames := make(chan string, 2)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		for {
+			lenStr, err := r.ReadString(' ')
+			if err != nil {
+				return
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(lenStr))
+			if err != nil {
+				t.Errorf("bad frame length %q", lenStr)
+				return
+			}
+			buf := make([]byte, n)
+			if _, err := io.ReadFull(r, buf); err != nil {
+				return
+			}
+			frames <- string(buf)
+		}
+	}()
+
+	s, err := newSyslogSink(config.Syslog{Network: "tcp", Address: lis.Addr().String(), Hostname: "h"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.close()
+	err = s.send(t.Context(), []*logpb.LogMessage{{Message: "one"}, {Message: "two"}})
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+	for _, want := range []string{"one", "two"} {
+		select {
+		case got := <-frames:
+			if !strings.HasPrefix(got, "<134>1 - h sc-bos ") || !strings.HasSuffix(got, " - - "+want) {
+				t.Errorf("frame = %q, want local0.info message %q", got, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for %q", want)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package log
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/logpb"
+	"github.com/smart-core-os/sc-bos/pkg/system"
+	"github.com/smart-core-os/sc-bos/pkg/system/log/config"
+)
+
+func TestSystem_forwarders(t *testing.T) {
+	received := make(chan *logpb.LogMessage, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sc := bufio.NewScanner(r.Body)
+		for sc.Scan() {
+			msg := &logpb.LogMessage{}
+			if err := protojson.Unmarshal(sc.Bytes(), msg); err != nil {
+				t.Errorf("unmarshal line %q: %v", sc.Text(), err)
+				continue
+			}
+			received <- msg
+		}
+	}))
+	defer srv.Close()
+
+	var core zapcore.Core
+	dataDir := t.TempDir()
+	s := NewSystem(system.Services{
+		Logger:  zap.NewNop(),
+		Node:    node.New("test"),
+		DataDir: dataDir,
+		Health:  healthpb.NewRegistry().ForOwner("system:log"),
+		AddLogCore: func(c zapcore.Core) func() {
+			if _, ok := c.(*forwardCore); ok {
+				core = c
+			}
+			return func() {}
+		},
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	err := s.applyConfig(ctx, config.Root{Forwarders: []config.Forwarder{{
+		Name:    "soc",
+		Type:    config.ForwarderHTTP,
+		Level:   "warn",
+		Loggers: map[string]string{"driver.bacnet": "debug"},
+		HTTP:    &config.HTTP{URL: srv.URL},
+	}}})
+	if err != nil {
+		t.Fatalf("applyConfig: %v", err)
+	}
+	if core == nil {
+		t.Fatal("no forwarding core registered")
+	}
+
+	logger := zap.New(core)
+	logger.Named("auto").Info("filtered out")
+	logger.Named("auto").Warn("auto warning", zap.String("zone", "floor-1"))
+	logger.Named("driver").Named("bacnet").Debug("bacnet debug")
+
+	want := map[string]string{"auto warning": "auto", "bacnet debug": "driver.bacnet"}
+	for range want {
+		select {
+		case msg := <-received:
+			logger, ok := want[msg.Message]
+			if !ok {
+				t.Errorf("unexpected message %q", msg.Message)
+				continue
+			}
+			if msg.Logger != logger {
+				t.Errorf("%q logger = %q, want %q", msg.Message, msg.Logger, logger)
+			}
+			delete(want, msg.Message)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for %v", want)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(dataDir, "log-forward", "soc.sqlite3")); err != nil {
+		t.Errorf("queue file: %v", err)
+	}
+}
+
+func TestSystem_forwardersInvalid(t *testing.T) {
+	s := NewSystem(system.Services{
+		Logger:     zap.NewNop(),
+		Node:       node.New("test"),
+		AddLogCore: func(zapcore.Core) func() { return func() {} },
+	})
+	fwd := config.Forwarder{Name: "soc", Type: config.ForwarderHTTP, HTTP: &config.HTTP{URL: "http://localhost"}}
+	err := s.applyConfig(t.Context(), config.Root{Forwarders: []config.Forwarder{fwd, fwd}})
+	if err == nil || !strings.Contains(err.Error(), "duplicate name") {
+		t.Errorf("applyConfig() error = %v, want duplicate name error", err)
+	}
+}
@@ -27,6 +27,11 @@ func (s *System) applyConfig(ctx context.Context, cfg config.Root) error {
 		context.AfterFunc(ctx, removeCapture)
 	}
 
+	// Ship captured entries to any configured remote sinks.
+	if err := s.startForwarders(ctx, cfg.Forwarders); err != nil {
+		return err
+	}
+
 	// Sync log level: if the controller exposes an AtomicLevel, keep the
 	// model's level in sync with it and vice-versa.
 	if s.services.LogLevel != nil {
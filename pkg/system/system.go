@@ -15,6 +15,7 @@ import (
 	"github.com/smart-core-os/sc-bos/internal/util/pki"
 	"github.com/smart-core-os/sc-bos/pkg/app/stores"
 	"github.com/smart-core-os/sc-bos/pkg/auth/token"
+	"github.com/smart-core-os/sc-bos/pkg/maintenance"
 	"github.com/smart-core-os/sc-bos/pkg/node"
 	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
 	"github.com/smart-core-os/sc-bos/pkg/task/service"
@@ -28,6 +29,7 @@ type Services struct {
 	HTTPEndpoint    string     // host:port of this controller's HTTPS API (e.g. "localhost:8301")
 	Node            *node.Node // for advertising devices
 	HealthChecks    HealthCheckCollection
+	Health          *healthpb.Checks // for checks owned by the system, see healthpb.Checks
 	CohortManager   node.Remote
 	Database        *bolthold.Store
 	Stores          *stores.Stores
@@ -43,7 +45,10 @@ type Services struct {
 	// enrolled in a cohort then the cohort certificates will be used,
 	// if the controller has been configured to read certificates from a file then they will be used.
 	// These certificates get used in preference to self signed certificates only.
-	GRPCCerts       *pki.SourceSet
+	GRPCCerts *pki.SourceSet
+	// Revokers allows a system to contribute a pki.Revoker that is checked during gRPC TLS handshakes.
+	// Peers presenting a certificate reported as revoked will have their connections rejected.
+	Revokers        *pki.RevokerSet
 	PrivateKey      pki.PrivateKey // the key managed by the controller
 	ClientTLSConfig *tls.Config    // for connecting to other smartcore nodes
 
@@ -60,6 +65,10 @@ type Services struct {
 	// LogLevel is the zap.AtomicLevel controlling the controller's root logger.
 	// Systems can read and update the live log level via this handle.
 	LogLevel *zap.AtomicLevel
+
+	// Maintenance holds the maintenance windows of the controller's devices.
+	// Systems that raise alerts or health checks can use it to annotate or suppress them.
+	Maintenance *maintenance.Windows
 }
 
 type Factory interface {
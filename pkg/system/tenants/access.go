@@ -0,0 +1,88 @@
+package tenants
+
+import (
+	"context"
+	"slices"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/accesspb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/tenantpb"
+	"github.com/smart-core-os/sc-bos/pkg/system/tenants/config"
+)
+
+// accessServer creates cardholders in an access control system when tenants are created.
+type accessServer struct {
+	tenantpb.TenantApiServer
+	cfg    *config.Access           // nil if not configured
+	client accesspb.AccessApiClient // nil if cfg is nil
+	logger *zap.Logger
+}
+
+func (s *accessServer) CreateTenant(ctx context.Context, request *tenantpb.CreateTenantRequest) (*tenantpb.Tenant, error) {
+	if len(request.GetCardholders()) == 0 {
+		return s.TenantApiServer.CreateTenant(ctx, request)
+	}
+	if s.cfg == nil {
+		return nil, status.Error(codes.FailedPrecondition, "access control is not configured, can't create cardholders")
+	}
+
+	tenant, err := s.TenantApiServer.CreateTenant(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	logger := s.logger.With(zap.String("tenant", tenant.GetId()))
+
+	var created []string
+	for i, ch := range request.GetCardholders() {
+		ch = proto.Clone(ch).(*accesspb.Cardholder)
+		ch.AccessGroups = s.accessGroups(tenant, ch.GetAccessGroups())
+		if ch.Description == "" {
+			ch.Description = tenant.GetTitle()
+		}
+		res, err := s.client.CreateCardholder(ctx, &accesspb.CreateCardholderRequest{Name: s.cfg.Name, Cardholder: ch})
+		if err != nil {
+			logger.Warn("failed to create tenant cardholder, rolling back", zap.Int("index", i), zap.Error(err))
+			s.rollback(context.WithoutCancel(ctx), logger, tenant, created)
+			st := status.Convert(err)
+			return nil, status.Errorf(st.Code(), "cardholders[%d]: %s", i, st.Message())
+		}
+		created = append(created, res.GetId())
+	}
+	logger.Info("created tenant cardholders", zap.Strings("ids", created))
+	return tenant, nil
+}
+
+// rollback removes tenant and disables cardholders, which can't be deleted via the AccessApi.
+func (s *accessServer) rollback(ctx context.Context, logger *zap.Logger, tenant *tenantpb.Tenant, cardholders []string) {
+	for _, id := range cardholders {
+		_, err := s.client.DisableCardholder(ctx, &accesspb.DisableCardholderRequest{Name: s.cfg.Name, CardholderId: id})
+		if err != nil {
+			logger.Error("failed to disable cardholder during rollback", zap.String("cardholder", id), zap.Error(err))
+		}
+	}
+	_, err := s.TenantApiServer.DeleteTenant(ctx, &tenantpb.DeleteTenantRequest{Id: tenant.GetId()})
+	if err != nil {
+		logger.Error("failed to delete tenant during rollback", zap.Error(err))
+	}
+}
+
+// accessGroups returns the access groups a cardholder of tenant should have, in addition to the given groups.
+func (s *accessServer) accessGroups(tenant *tenantpb.Tenant, groups []string) []string {
+	res := slices.Clone(s.cfg.AccessGroups)
+	for _, zone := range tenant.GetZoneNames() {
+		res = append(res, s.cfg.ZoneAccessGroups[zone]...)
+	}
+	res = append(res, groups...)
+	seen := make(map[string]bool, len(res))
+	return slices.DeleteFunc(res, func(g string) bool {
+		if seen[g] {
+			return true
+		}
+		seen[g] = true
+		return false
+	})
+}
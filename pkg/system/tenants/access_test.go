@@ -0,0 +1,136 @@
+package tenants
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/accesspb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/tenantpb"
+	"github.com/smart-core-os/sc-bos/pkg/system/tenants/config"
+)
+
+func TestAccessServer_CreateTenant(t *testing.T) {
+	tenants := &fakeTenants{}
+	acs := &fakeAccess{}
+	s := &accessServer{
+		TenantApiServer: tenants,
+		cfg: &config.Access{
+			Name:             "acs",
+			AccessGroups:     []string{"building"},
+			ZoneAccessGroups: map[string][]string{"floor-1": {"floor-1", "building"}},
+		},
+		client: accesspb.WrapApi(acs),
+		logger: zap.NewNop(),
+	}
+
+	_, err := s.CreateTenant(context.Background(), &tenantpb.CreateTenantRequest{
+		Tenant: &tenantpb.Tenant{Title: "Acme", ZoneNames: []string{"floor-1"}},
+		Cardholders: []*accesspb.Cardholder{
+			{FirstName: "Ada"},
+			{FirstName: "Grace", Description: "Contractor", AccessGroups: []string{"car-park"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*accesspb.Cardholder{
+		{FirstName: "Ada", Description: "Acme", AccessGroups: []string{"building", "floor-1"}},
+		{FirstName: "Grace", Description: "Contractor", AccessGroups: []string{"building", "floor-1", "car-park"}},
+	}
+	if diff := cmp.Diff(want, acs.created, protocmp.Transform()); diff != "" {
+		t.Errorf("cardholders (-want,+got)\n%s", diff)
+	}
+	if len(tenants.deleted) > 0 {
+		t.Errorf("tenant deleted: %v", tenants.deleted)
+	}
+}
+
+func TestAccessServer_CreateTenant_rollback(t *testing.T) {
+	tenants := &fakeTenants{}
+	acs := &fakeAccess{failAfter: 1}
+	s := &accessServer{
+		TenantApiServer: tenants,
+		cfg:             &config.Access{Name: "acs"},
+		client:          accesspb.WrapApi(acs),
+		logger:          zap.NewNop(),
+	}
+	_, err := s.CreateTenant(context.Background(), &tenantpb.CreateTenantRequest{
+		Tenant:      &tenantpb.Tenant{Title: "Acme"},
+		Cardholders: []*accesspb.Cardholder{{FirstName: "Ada"}, {FirstName: "Grace"}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("got %v, want InvalidArgument", err)
+	}
+	if diff := cmp.Diff([]string{"t1"}, tenants.deleted); diff != "" {
+		t.Errorf("deleted tenants (-want,+got)\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"1"}, acs.disabled); diff != "" {
+		t.Errorf("disabled cardholders (-want,+got)\n%s", diff)
+	}
+}
+
+func TestAccessServer_CreateTenant_notConfigured(t *testing.T) {
+	tenants := &fakeTenants{}
+	s := &accessServer{TenantApiServer: tenants, logger: zap.NewNop()}
+	_, err := s.CreateTenant(context.Background(), &tenantpb.CreateTenantRequest{
+		Tenant:      &tenantpb.Tenant{Title: "Acme"},
+		Cardholders: []*accesspb.Cardholder{{FirstName: "Ada"}},
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("got %v, want FailedPrecondition", err)
+	}
+	if tenants.count > 0 {
+		t.Error("tenant was created")
+	}
+
+	// tenants without cardholders don't need access control
+	if _, err := s.CreateTenant(context.Background(), &tenantpb.CreateTenantRequest{Tenant: &tenantpb.Tenant{Title: "Acme"}}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type fakeTenants struct {
+	tenantpb.UnimplementedTenantApiServer
+	count   int
+	deleted []string
+}
+
+func (f *fakeTenants) CreateTenant(_ context.Context, req *tenantpb.CreateTenantRequest) (*tenantpb.Tenant, error) {
+	f.count++
+	return &tenantpb.Tenant{Id: "t" + strconv.Itoa(f.count), Title: req.Tenant.Title, ZoneNames: req.Tenant.ZoneNames}, nil
+}
+
+func (f *fakeTenants) DeleteTenant(_ context.Context, req *tenantpb.DeleteTenantRequest) (*tenantpb.DeleteTenantResponse, error) {
+	f.deleted = append(f.deleted, req.Id)
+	return &tenantpb.DeleteTenantResponse{}, nil
+}
+
+type fakeAccess struct {
+	accesspb.UnimplementedAccessApiServer
+	failAfter int // fail creating cardholders after this many, if non-zero
+	created   []*accesspb.Cardholder
+	disabled  []string
+}
+
+func (f *fakeAccess) CreateCardholder(_ context.Context, req *accesspb.CreateCardholderRequest) (*accesspb.Cardholder, error) {
+	if req.Name != "acs" {
+		return nil, status.Error(codes.NotFound, req.Name)
+	}
+	if f.failAfter > 0 && len(f.created) >= f.failAfter {
+		return nil, status.Error(codes.InvalidArgument, "bad card number")
+	}
+	f.created = append(f.created, req.Cardholder)
+	return &accesspb.Cardholder{Id: strconv.Itoa(len(f.created))}, nil
+}
+
+func (f *fakeAccess) DisableCardholder(_ context.Context, req *accesspb.DisableCardholderRequest) (*accesspb.Cardholder, error) {
+	f.disabled = append(f.disabled, req.CardholderId)
+	return &accesspb.Cardholder{Id: req.CardholderId, Disabled: true}, nil
+}
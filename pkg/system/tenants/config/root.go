@@ -8,6 +8,18 @@ import (
 type Root struct {
 	system.Config
 	Storage *Storage `json:"storage,omitempty"`
+	// Access configures granting building access to people when their tenant is created.
+	Access *Access `json:"access,omitempty"`
+}
+
+// Access configures the access control system cardholders are created in during tenant on-boarding.
+type Access struct {
+	// Name of the device implementing the AccessApi cardholder methods, for example a gallagher driver.
+	Name string `json:"name,omitempty"`
+	// AccessGroups are given to every cardholder created for a tenant.
+	AccessGroups []string `json:"accessGroups,omitempty"`
+	// ZoneAccessGroups are given to cardholders of tenants that occupy the zone, keyed by zone name.
+	ZoneAccessGroups map[string][]string `json:"zoneAccessGroups,omitempty"`
 }
 
 type StorageType string
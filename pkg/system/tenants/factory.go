@@ -9,6 +9,7 @@ import (
 
 	"github.com/smart-core-os/sc-bos/pkg/app/stores"
 	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/proto/accesspb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/tenantpb"
 	"github.com/smart-core-os/sc-bos/pkg/system"
 	"github.com/smart-core-os/sc-bos/pkg/system/tenants/config"
@@ -84,7 +85,7 @@ func (s *System) applyConfig(ctx context.Context, cfg config.Root) error {
 			return fmt.Errorf("init: %w", err)
 		}
 
-		srv, err = node.RegistryService(tenantpb.TenantApi_ServiceDesc, server)
+		srv, err = node.RegistryService(tenantpb.TenantApi_ServiceDesc, s.newAccessServer(cfg.Access, server))
 		if err != nil {
 			return fmt.Errorf("can't create local TenantApi service: %w", err)
 		}
@@ -100,3 +101,11 @@ func (s *System) applyConfig(ctx context.Context, cfg config.Root) error {
 
 	return nil
 }
+
+func (s *System) newAccessServer(cfg *config.Access, server tenantpb.TenantApiServer) *accessServer {
+	srv := &accessServer{TenantApiServer: server, cfg: cfg, logger: s.logger}
+	if cfg != nil {
+		srv.client = accesspb.NewAccessApiClient(s.node.ClientConn())
+	}
+	return srv
+}
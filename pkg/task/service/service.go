@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"math"
+	"reflect"
 	"sync"
 	"time"
 
@@ -223,6 +224,28 @@ func (l *Service[C]) Configure(data []byte) (State, error) {
 	return l.applyConfig(state, config)
 }
 
+// ConfigureChanged is like Configure, but leaves the service as it is if data parses to a config equal to the one the
+// service is already using.
+// Returns whether the service was configured.
+// A service that failed to apply its config is always configured, so the config is retried.
+func (l *Service[C]) ConfigureChanged(data []byte) (State, bool, error) {
+	if config, err := l.parse(data); err == nil {
+		l.mu.Lock()
+		state := l.state
+		if l.config != nil && state.Err == nil && !state.Loading && reflect.DeepEqual(*l.config, config) {
+			// record the new config bytes without applying them again
+			state.Config = data
+			state.LastConfigTime = l.now()
+			state, err := l.saveLocked(state)
+			l.mu.Unlock()
+			return state, false, err
+		}
+		l.mu.Unlock()
+	}
+	state, err := l.Configure(data)
+	return state, true, err
+}
+
 // Stop transitions the service to the inactive state.
 // The context for any ApplyFunc calls will be cancelled.
 // Config and other state will not be adjusted.
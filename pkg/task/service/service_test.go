@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"slices"
 	"sync"
 	"testing"
 	"time"
@@ -643,3 +644,44 @@ func (l *testRetryLogger) assertLog(want RetryContext) {
 		l.Fatalf("Retry log (-want, +got)\n%s", diff)
 	}
 }
+
+func TestService_ConfigureChanged(t *testing.T) {
+	type config struct {
+		Lights []string `json:"lights"`
+	}
+	applied := make(chan config, 10)
+	s := New(func(_ context.Context, cfg config) error {
+		applied <- cfg
+		return nil
+	})
+	if _, err := s.Start(); err != nil {
+		t.Fatal(err)
+	}
+	configure := func(data string, wantConfigured bool) {
+		t.Helper()
+		state, configured, err := s.ConfigureChanged([]byte(data))
+		if err != nil {
+			t.Fatalf("ConfigureChanged(%s): %v", data, err)
+		}
+		if configured != wantConfigured {
+			t.Errorf("ConfigureChanged(%s) configured = %v, want %v", data, configured, wantConfigured)
+		}
+		if string(state.Config) != data {
+			t.Errorf("ConfigureChanged(%s) state.Config = %s", data, state.Config)
+		}
+	}
+
+	configure(`{"lights":["a"]}`, true)
+	<-applied
+	// properties not in the config don't change it
+	configure(`{"lights":["a"],"thermostats":["b"]}`, false)
+	configure(`{"lights":["a","b"]}`, true)
+	if got := <-applied; !slices.Equal(got.Lights, []string{"a", "b"}) {
+		t.Errorf("applied %v, want lights a and b", got)
+	}
+	select {
+	case cfg := <-applied:
+		t.Errorf("unexpected apply %v", cfg)
+	default:
+	}
+}
@@ -0,0 +1,70 @@
+package jsontypes
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
+)
+
+// DeviceQuery is a devicespb.Device_Query encoded using protojson.
+type DeviceQuery struct {
+	pb *devicespb.Device_Query
+}
+
+// Pb returns q as a devicespb.Device_Query, nil if q is nil.
+func (q *DeviceQuery) Pb() *devicespb.Device_Query {
+	if q == nil {
+		return nil
+	}
+	return q.pb
+}
+
+func (q *DeviceQuery) UnmarshalJSON(bytes []byte) error {
+	pb := &devicespb.Device_Query{}
+	if err := protojson.Unmarshal(bytes, pb); err != nil {
+		return fmt.Errorf("device query: %w", err)
+	}
+	*q = DeviceQuery{pb}
+	return nil
+}
+
+func (q *DeviceQuery) MarshalJSON() ([]byte, error) {
+	return protojson.Marshal(q.pb)
+}
+
+// DeviceCondition is a devicespb.Device_Query_Condition encoded using protojson.
+type DeviceCondition struct {
+	pb *devicespb.Device_Query_Condition
+}
+
+// Pb returns c as a devicespb.Device_Query_Condition, nil if c is nil.
+func (c *DeviceCondition) Pb() *devicespb.Device_Query_Condition {
+	if c == nil {
+		return nil
+	}
+	return c.pb
+}
+
+func (c *DeviceCondition) UnmarshalJSON(bytes []byte) error {
+	pb := &devicespb.Device_Query_Condition{}
+	if err := protojson.Unmarshal(bytes, pb); err != nil {
+		return fmt.Errorf("condition: %w", err)
+	}
+	*c = DeviceCondition{pb}
+	return nil
+}
+
+func (c *DeviceCondition) MarshalJSON() ([]byte, error) {
+	return protojson.Marshal(c.pb)
+}
+
+// DeviceConditionsPb returns conds as devicespb.Device_Query_Conditions.
+func DeviceConditionsPb(conds []*DeviceCondition) []*devicespb.Device_Query_Condition {
+	pbs := make([]*devicespb.Device_Query_Condition, len(conds))
+	for i, c := range conds {
+		pbs[i] = c.Pb()
+	}
+	return pbs
+}
@@ -0,0 +1,97 @@
+package jsontypes
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
+)
+
+func TestDeviceQuery_UnmarshalJSON(t *testing.T) {
+	var v struct {
+		Query  *DeviceQuery `json:"query"`
+		Absent *DeviceQuery `json:"absent"`
+	}
+	err := json.Unmarshal([]byte(`{"query": {"conditions": [{"field": "metadata.membership.subsystem", "stringEqual": "hvac"}]}}`), &v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &devicespb.Device_Query{Conditions: []*devicespb.Device_Query_Condition{
+		{Field: "metadata.membership.subsystem", Value: &devicespb.Device_Query_Condition_StringEqual{StringEqual: "hvac"}},
+	}}
+	if diff := cmp.Diff(want, v.Query.Pb(), protocmp.Transform()); diff != "" {
+		t.Errorf("query (-want +got):\n%s", diff)
+	}
+	if got := v.Absent.Pb(); got != nil {
+		t.Errorf("absent query = %v, want nil", got)
+	}
+
+	if err := json.Unmarshal([]byte(`{"query": {"conditions": [{"unknown": 1}]}}`), &v); err == nil {
+		t.Error("want error for unknown field, got nil")
+	}
+}
+
+func TestDeviceQuery_MarshalJSON(t *testing.T) {
+	var q DeviceQuery
+	in := `{"conditions":[{"field":"name","stringEqual":"a"}]}`
+	if err := json.Unmarshal([]byte(in), &q); err != nil {
+		t.Fatal(err)
+	}
+	out, err := json.Marshal(&q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got, want any
+	_ = json.Unmarshal(out, &got)
+	_ = json.Unmarshal([]byte(in), &want)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("MarshalJSON (-want +got):\n%s", diff)
+	}
+}
+
+func TestDeviceCondition_UnmarshalJSON(t *testing.T) {
+	var v struct {
+		Conditions []*DeviceCondition `json:"conditions"`
+		Absent     *DeviceCondition   `json:"absent"`
+	}
+	err := json.Unmarshal([]byte(`{"conditions": [{"field": "name", "stringContains": "light"}]}`), &v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantConds := []*devicespb.Device_Query_Condition{
+		{Field: "name", Value: &devicespb.Device_Query_Condition_StringContains{StringContains: "light"}},
+	}
+	if diff := cmp.Diff(wantConds, DeviceConditionsPb(v.Conditions), protocmp.Transform()); diff != "" {
+		t.Errorf("conditions (-want +got):\n%s", diff)
+	}
+	if got := v.Absent.Pb(); got != nil {
+		t.Errorf("absent condition = %v, want nil", got)
+	}
+
+	if err := json.Unmarshal([]byte(`{"conditions": [{"unknown": 1}]}`), &v); err == nil {
+		t.Error("want error for unknown field, got nil")
+	}
+}
+
+func TestDeviceCondition_MarshalJSON(t *testing.T) {
+	var c DeviceCondition
+	in := `{"field":"name","stringEqual":"a"}`
+	if err := json.Unmarshal([]byte(in), &c); err != nil {
+		t.Fatal(err)
+	}
+	out, err := json.Marshal(&c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got, want any
+	_ = json.Unmarshal(out, &got)
+	_ = json.Unmarshal([]byte(in), &want)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("MarshalJSON (-want +got):\n%s", diff)
+	}
+}
@@ -2,6 +2,7 @@ package jsontypes
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/robfig/cron/v3"
 )
@@ -50,3 +51,36 @@ func (s *Schedule) UnmarshalJSON(bytes []byte) error {
 	*s = Schedule{Schedule: schedule, Raw: str}
 	return nil
 }
+
+// ScheduleRange is a recurring period of time, starting at each Start and ending at the following End.
+type ScheduleRange struct {
+	Start Schedule `json:"start"`
+	End   Schedule `json:"end"`
+}
+
+// AnalyseScheduleRanges works out if we are currently within a schedule period, and when that might change.
+// It returns:
+//   - on: true if now is currently within one of ranges
+//   - onStart: the next time the matching range starts
+//   - onEnd: the next time the matching range ends, aka the end of the matched range
+//   - changesIn: the time until the matching schedule range ends, or the next range starts
+func AnalyseScheduleRanges(now time.Time, ranges []ScheduleRange) (on bool, onStart, onEnd time.Time, changesIn time.Duration) {
+	checkTime := func(t time.Time) {
+		d := t.Sub(now)
+		if changesIn == 0 || d < changesIn {
+			changesIn = d
+		}
+	}
+	for _, period := range ranges {
+		startAt := period.Start.Next(now)
+		endAt := period.End.Next(now)
+		if startAt.After(endAt) {
+			// If start is after end, then we're currently within the range of [start, end).
+			// This changesIn calculation falls down if periods overlap, but for simplicity we'll use this simple approach.
+			return true, startAt, endAt, endAt.Sub(now)
+		}
+		checkTime(startAt)
+		checkTime(endAt)
+	}
+	return
+}
@@ -0,0 +1,47 @@
+package jsontypes
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAnalyseScheduleRanges(t *testing.T) {
+	workHours := []ScheduleRange{
+		{Start: *MustParseSchedule("0 9 * * *"), End: *MustParseSchedule("0 17 * * *")},
+	}
+	day := func(h, m int) time.Time {
+		return time.Date(2024, 3, 4, h, m, 0, 0, time.Local)
+	}
+	tests := []struct {
+		name          string
+		now           time.Time
+		ranges        []ScheduleRange
+		wantOn        bool
+		wantStart     time.Time
+		wantEnd       time.Time
+		wantChangesIn time.Duration
+	}{
+		{"no ranges", day(12, 0), nil, false, time.Time{}, time.Time{}, 0},
+		{"before", day(8, 0), workHours, false, time.Time{}, time.Time{}, time.Hour},
+		{"start", day(9, 0), workHours, true, day(9, 0).AddDate(0, 0, 1), day(17, 0), 8 * time.Hour},
+		{"during", day(12, 30), workHours, true, day(9, 0).AddDate(0, 0, 1), day(17, 0), 4*time.Hour + 30*time.Minute},
+		{"after", day(18, 0), workHours, false, time.Time{}, time.Time{}, 15 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			on, onStart, onEnd, changesIn := AnalyseScheduleRanges(tt.now, tt.ranges)
+			if on != tt.wantOn {
+				t.Errorf("on = %v, want %v", on, tt.wantOn)
+			}
+			if !onStart.Equal(tt.wantStart) {
+				t.Errorf("onStart = %v, want %v", onStart, tt.wantStart)
+			}
+			if !onEnd.Equal(tt.wantEnd) {
+				t.Errorf("onEnd = %v, want %v", onEnd, tt.wantEnd)
+			}
+			if changesIn != tt.wantChangesIn {
+				t.Errorf("changesIn = %v, want %v", changesIn, tt.wantChangesIn)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package area
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"go.uber.org/zap"
@@ -118,6 +119,21 @@ func (a *Area) applyConfig(ctx context.Context, cfg config.Root) error {
 	services.Logger = a.services.Logger.With(zap.String("zone", cfg.Name))
 	services.Devices = &zone.Devices{}
 
+	featureCfg := cfg.Raw
+	if len(cfg.DeviceQueries) > 0 {
+		if services.DevicesApi == nil {
+			return errors.New("deviceQueries requires a DevicesApi service to be configured")
+		}
+		// check the query paths are valid before we start any features
+		emptyMembers := make(map[string][]string, len(cfg.DeviceQueries))
+		for key := range cfg.DeviceQueries {
+			emptyMembers[key] = nil
+		}
+		if _, err := withMembers(cfg.Raw, emptyMembers); err != nil {
+			return err
+		}
+	}
+
 	type serviceConfig struct {
 		service.Lifecycle
 		cfg []byte
@@ -126,9 +142,10 @@ func (a *Area) applyConfig(ctx context.Context, cfg config.Root) error {
 	featureImpls := make([]service.Lifecycle, 0, len(a.features)+len(cfg.Drivers))
 	for _, feature := range a.features {
 		impl := feature.New(services)
-		serviceConfigs = append(serviceConfigs, serviceConfig{Lifecycle: impl, cfg: cfg.Raw})
+		serviceConfigs = append(serviceConfigs, serviceConfig{Lifecycle: impl, cfg: featureCfg})
 		featureImpls = append(featureImpls, impl)
 	}
+	zoneFeatures := featureImpls[:len(a.features)]
 
 	driverServices := driver.Services{
 		Logger:          services.Logger.Named("driver"),
@@ -177,11 +194,42 @@ func (a *Area) applyConfig(ctx context.Context, cfg config.Root) error {
 	for _, impl := range featureImpls {
 		a.waitUntilLoaded(ctx, impl)
 	}
-	services.Devices.Freeze()
+
+	if len(cfg.DeviceQueries) == 0 {
+		services.Devices.Freeze()
+		return nil
+	}
+
+	// membership changes as devices are announced, update the features that use the changed device lists.
+	// Features whose config doesn't include a changed list are left running as they are.
+	go watchMembers(ctx, services.DevicesApi, cfg.Name, cfg.DeviceQueries, func(members map[string][]string) {
+		featureCfg, err := withMembers(cfg.Raw, members)
+		if err != nil {
+			services.Logger.Warn("failed to update zone members", zap.Error(err))
+			return
+		}
+		for _, impl := range zoneFeatures {
+			a.waitUntilLoaded(ctx, impl)
+			if _, err := configureChanged(impl, featureCfg); err != nil {
+				services.Logger.Warn("failed to reconfigure zone feature with new members", zap.Error(err))
+			}
+		}
+	})
 
 	return nil
 }
 
+// configureChanged configures impl with data, unless impl can tell that data doesn't change its config.
+func configureChanged(impl service.Lifecycle, data []byte) (service.State, error) {
+	if c, ok := impl.(interface {
+		ConfigureChanged(data []byte) (service.State, bool, error)
+	}); ok {
+		state, _, err := c.ConfigureChanged(data)
+		return state, err
+	}
+	return impl.Configure(data)
+}
+
 func (a *Area) waitUntilLoaded(ctx context.Context, impl service.Lifecycle) {
 	ctx, stop := context.WithCancel(ctx)
 	defer stop()
@@ -7,6 +7,7 @@ import (
 
 	"github.com/smart-core-os/sc-bos/pkg/driver"
 	"github.com/smart-core-os/sc-bos/pkg/proto/metadatapb"
+	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
 	"github.com/smart-core-os/sc-bos/pkg/zone"
 )
 
@@ -19,6 +20,11 @@ type Root struct {
 type Self struct {
 	Metadata *metadatapb.Metadata `json:"metadata,omitempty"`
 	Drivers  []driver.RawConfig   `json:"drivers,omitempty"`
+	// DeviceQueries adds devices matching a query to the device lists of the zone features.
+	// Keys are the path of the list in the zone config, separated by "/",
+	// for example "lights" or "lightGroups/floor3".
+	// Membership is updated as devices matching the query are added or removed.
+	DeviceQueries map[string]*jsontypes.DeviceQuery `json:"deviceQueries,omitempty"`
 }
 
 func (r *Root) UnmarshalJSON(buf []byte) error {
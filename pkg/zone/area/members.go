@@ -0,0 +1,167 @@
+package area
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
+	"github.com/smart-core-os/sc-bos/pkg/task"
+	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
+)
+
+// memberSettleDelay is how long to collect membership changes before reconfiguring the zone features.
+// This avoids reconfiguring the features for each device when many devices are announced together.
+const memberSettleDelay = 500 * time.Millisecond
+
+type memberUpdate struct {
+	key   string
+	names []string
+}
+
+// watchMembers resolves the names of devices matching each of queries.
+// The zone itself, named zoneName, and any devices it announces under its name are never members.
+// onChange is called with the names matching each query key whenever membership changes, after changes have settled.
+// watchMembers blocks until ctx is done.
+func watchMembers(ctx context.Context, client devicespb.DevicesApiClient, zoneName string, queries map[string]*jsontypes.DeviceQuery, onChange func(members map[string][]string)) {
+	nameMask, err := fieldmaskpb.New(&devicespb.Device{}, "name")
+	if err != nil {
+		panic(err) // only happens if the Device message changes
+	}
+
+	updates := make(chan memberUpdate)
+	for key, query := range queries {
+		go func() {
+			// the task is configured to retry forever (until ctx is done) so the error is ignored.
+			_ = task.Run(ctx, func(ctx context.Context) (task.Next, error) {
+				stream, err := client.PullDevices(ctx, &devicespb.PullDevicesRequest{
+					ReadMask: nameMask,
+					Query:    query.Pb(),
+				})
+				if err != nil {
+					return task.Normal, err
+				}
+				names := make(map[string]struct{})
+				for {
+					res, err := stream.Recv()
+					if err != nil {
+						return task.ResetBackoff, err
+					}
+					for _, change := range res.GetChanges() {
+						if isZoneDevice(zoneName, change.GetName()) {
+							continue
+						}
+						switch {
+						case change.GetNewValue() != nil:
+							names[change.GetName()] = struct{}{}
+						case change.GetOldValue() != nil:
+							delete(names, change.GetName())
+						}
+					}
+					select {
+					case updates <- memberUpdate{key: key, names: slices.Sorted(maps.Keys(names))}:
+					case <-ctx.Done():
+						return task.Normal, ctx.Err()
+					}
+				}
+			}, task.WithRetry(task.RetryUnlimited), task.WithBackoff(100*time.Millisecond, time.Minute))
+		}()
+	}
+
+	members := make(map[string][]string, len(queries))
+	var applied map[string][]string
+	var settled <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case u := <-updates:
+			members[u.key] = u.names
+			if settled == nil {
+				settled = time.After(memberSettleDelay)
+			}
+		case <-settled:
+			settled = nil
+			if maps.EqualFunc(applied, members, slices.Equal) {
+				continue
+			}
+			applied = maps.Clone(members)
+			onChange(applied)
+		}
+	}
+}
+
+// isZoneDevice reports whether name is the zone, or one of the devices the zone announces, like "zone/floor3".
+func isZoneDevice(zoneName, name string) bool {
+	return name == zoneName || strings.HasPrefix(name, zoneName+"/")
+}
+
+// withMembers returns a copy of the zone config raw with the device names in members added to the device lists in raw.
+// Keys in members are paths to the list in raw, separated by "/".
+// Names already in the list are not added again.
+func withMembers(raw []byte, members map[string][]string) (json.RawMessage, error) {
+	if len(members) == 0 {
+		return raw, nil
+	}
+	var root map[string]any
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber() // don't lose precision in properties we don't understand
+	if err := dec.Decode(&root); err != nil {
+		return nil, err
+	}
+	if root == nil {
+		root = make(map[string]any)
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(members)) {
+		path := strings.Split(key, "/")
+		obj := root
+		for _, p := range path[:len(path)-1] {
+			switch child := obj[p].(type) {
+			case nil:
+				newChild := make(map[string]any)
+				obj[p] = newChild
+				obj = newChild
+			case map[string]any:
+				obj = child
+			default:
+				return nil, fmt.Errorf("deviceQueries %q: %q is not an object", key, p)
+			}
+		}
+
+		leaf := path[len(path)-1]
+		var list []any
+		switch v := obj[leaf].(type) {
+		case nil:
+		case []any:
+			list = v
+		default:
+			return nil, fmt.Errorf("deviceQueries %q: %q is not a list", key, leaf)
+		}
+		seen := make(map[string]struct{}, len(list))
+		for _, item := range list {
+			if name, ok := item.(string); ok {
+				seen[name] = struct{}{}
+			}
+		}
+		for _, name := range members[key] {
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			seen[name] = struct{}{}
+			list = append(list, name)
+		}
+		if list != nil {
+			obj[leaf] = list
+		}
+	}
+
+	return json.Marshal(root)
+}
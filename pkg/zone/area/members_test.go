@@ -0,0 +1,183 @@
+package area
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/typespb"
+	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
+)
+
+func TestWithMembers(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		members map[string][]string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "no members",
+			raw:  `{"name":"zone","lights":["a"]}`,
+			want: `{"name":"zone","lights":["a"]}`,
+		},
+		{
+			name:    "new list",
+			raw:     `{"name":"zone"}`,
+			members: map[string][]string{"lights": {"a", "b"}},
+			want:    `{"name":"zone","lights":["a","b"]}`,
+		},
+		{
+			name:    "existing list",
+			raw:     `{"name":"zone","lights":["a","c"]}`,
+			members: map[string][]string{"lights": {"a", "b"}},
+			want:    `{"name":"zone","lights":["a","c","b"]}`,
+		},
+		{
+			name:    "nested list",
+			raw:     `{"name":"zone","lightGroups":{"floor2":["x"]}}`,
+			members: map[string][]string{"lightGroups/floor3": {"a"}},
+			want:    `{"name":"zone","lightGroups":{"floor2":["x"],"floor3":["a"]}}`,
+		},
+		{
+			name:    "no matches",
+			raw:     `{"name":"zone"}`,
+			members: map[string][]string{"lights": nil},
+			want:    `{"name":"zone"}`,
+		},
+		{
+			name:    "preserves numbers",
+			raw:     `{"name":"zone","threshold":12345678901234567890}`,
+			members: map[string][]string{"lights": {"a"}},
+			want:    `{"name":"zone","threshold":12345678901234567890,"lights":["a"]}`,
+		},
+		{
+			name:    "not a list",
+			raw:     `{"name":"zone","lights":"a"}`,
+			members: map[string][]string{"lights": {"a"}},
+			wantErr: true,
+		},
+		{
+			name:    "not an object",
+			raw:     `{"name":"zone","lightGroups":["a"]}`,
+			members: map[string][]string{"lightGroups/floor3": {"a"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := withMembers([]byte(tt.raw), tt.members)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var gotV, wantV any
+			if err := json.Unmarshal(got, &gotV); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tt.want), &wantV); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(wantV, gotV); diff != "" {
+				t.Errorf("withMembers (-want,+got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWatchMembers(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+
+		client := &pullDevicesClient{ch: make(chan *devicespb.PullDevicesResponse)}
+		queries := map[string]*jsontypes.DeviceQuery{"lights": {}}
+		var got []map[string][]string
+		go watchMembers(ctx, client, "zone", queries, func(members map[string][]string) {
+			got = append(got, members)
+		})
+
+		add := func(names ...string) *devicespb.PullDevicesResponse {
+			res := &devicespb.PullDevicesResponse{}
+			for _, name := range names {
+				res.Changes = append(res.Changes, &devicespb.PullDevicesResponse_Change{
+					Name: name, Type: typespb.ChangeType_ADD, NewValue: &devicespb.Device{Name: name},
+				})
+			}
+			return res
+		}
+		remove := func(name string) *devicespb.PullDevicesResponse {
+			return &devicespb.PullDevicesResponse{Changes: []*devicespb.PullDevicesResponse_Change{
+				{Name: name, Type: typespb.ChangeType_REMOVE, OldValue: &devicespb.Device{Name: name}},
+			}}
+		}
+
+		// changes that happen together are applied together, the zone is never its own member
+		client.ch <- add("b", "a", "zone", "zone/floor3")
+		client.ch <- add("c")
+		synctest.Wait()
+		if len(got) != 0 {
+			t.Fatalf("members changed before settling: %v", got)
+		}
+		time.Sleep(memberSettleDelay)
+		synctest.Wait()
+		want := []map[string][]string{{"lights": {"a", "b", "c"}}}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Fatalf("members (-want,+got)\n%s", diff)
+		}
+
+		client.ch <- remove("b")
+		time.Sleep(memberSettleDelay)
+		synctest.Wait()
+		want = append(want, map[string][]string{"lights": {"a", "c"}})
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Fatalf("members (-want,+got)\n%s", diff)
+		}
+
+		// changes that cancel each other out don't cause an update
+		client.ch <- remove("a")
+		client.ch <- add("a")
+		time.Sleep(memberSettleDelay)
+		synctest.Wait()
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Fatalf("members (-want,+got)\n%s", diff)
+		}
+	})
+}
+
+// pullDevicesClient implements devicespb.DevicesApiClient where PullDevices returns responses sent on ch.
+type pullDevicesClient struct {
+	devicespb.DevicesApiClient
+	ch chan *devicespb.PullDevicesResponse
+}
+
+func (c *pullDevicesClient) PullDevices(ctx context.Context, _ *devicespb.PullDevicesRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[devicespb.PullDevicesResponse], error) {
+	return &pullDevicesStream{ctx: ctx, ch: c.ch}, nil
+}
+
+type pullDevicesStream struct {
+	grpc.ClientStream
+	ctx context.Context
+	ch  chan *devicespb.PullDevicesResponse
+}
+
+func (s *pullDevicesStream) Recv() (*devicespb.PullDevicesResponse, error) {
+	select {
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	case res := <-s.ch:
+		return res, nil
+	}
+}
@@ -20,4 +20,21 @@
 //	    "audience": ["lights/02", "lights/03"]
 //	  }
 //	}
+//
+// # Device Queries
+//
+// Instead of listing every device, an area can add devices to a feature's lists using device queries.
+// Keys in "deviceQueries" are paths to the list, separated by "/", and membership is updated as devices matching the
+// query are added or removed from the node.
+//
+//	{
+//	  "name": "Floor3",
+//	  "type": "area",
+//	  "deviceQueries": {
+//	    "lights": {"conditions": [
+//	      {"field": "metadata.location.floor", "stringEqual": "3"},
+//	      {"field": "metadata.traits.name", "stringEqual": "smartcore.traits.Light"}
+//	    ]}
+//	  }
+//	}
 package zone
@@ -4,10 +4,12 @@ import (
 	"crypto/tls"
 	"net/http"
 
+	"github.com/timshannon/bolthold"
 	"go.uber.org/zap"
 
 	"github.com/smart-core-os/sc-bos/pkg/driver"
 	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/proto/devicespb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
 	"github.com/smart-core-os/sc-bos/pkg/task/service"
 )
@@ -20,6 +22,8 @@ type Services struct {
 	HTTPMux         *http.ServeMux
 	Config          service.ConfigUpdater
 	Health          *healthpb.Checks
+	DevicesApi      devicespb.DevicesApiClient // for resolving zone membership from device queries
+	Database        *bolthold.Store            // for state that should survive reconfiguration, may be nil
 
 	DriverFactories map[string]driver.Factory
 }
@@ -7,10 +7,12 @@ import (
 
 	"github.com/smart-core-os/sc-bos/pkg/node"
 	"github.com/smart-core-os/sc-bos/pkg/proto/airqualitysensorpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
 	"github.com/smart-core-os/sc-bos/pkg/task/service"
 	"github.com/smart-core-os/sc-bos/pkg/trait"
 	"github.com/smart-core-os/sc-bos/pkg/zone"
 	"github.com/smart-core-os/sc-bos/pkg/zone/feature/airquality/config"
+	"github.com/smart-core-os/sc-bos/pkg/zone/feature/merge"
 )
 
 var Feature = zone.FactoryFunc(func(services zone.Services) service.Lifecycle {
@@ -19,6 +21,7 @@ var Feature = zone.FactoryFunc(func(services zone.Services) service.Lifecycle {
 		announcer: node.NewReplaceAnnouncer(services.Node),
 		devices:   services.Devices,
 		clients:   services.Node,
+		health:    services.Health,
 		logger:    services.Logger,
 	}
 	f.Service = service.New(service.MonoApply(f.applyConfig))
@@ -30,6 +33,7 @@ type feature struct {
 	announcer *node.ReplaceAnnouncer
 	devices   *zone.Devices
 	clients   node.ClientConner
+	health    *healthpb.Checks
 	logger    *zap.Logger
 }
 
@@ -39,9 +43,18 @@ func (f *feature) applyConfig(ctx context.Context, cfg config.Root) error {
 
 	if len(cfg.AirQualitySensors) > 0 {
 		group := &Group{
-			client: airqualitysensorpb.NewAirQualitySensorApiClient(f.clients.ClientConn()),
-			names:  cfg.AirQualitySensors,
-			logger: logger,
+			client:      airqualitysensorpb.NewAirQualitySensorApiClient(f.clients.ClientConn()),
+			names:       cfg.AirQualitySensors,
+			aggregation: cfg.Aggregation,
+			logger:      logger,
+		}
+		if f.health != nil && cfg.Aggregation != nil {
+			exclusions, err := merge.NewExclusionCheck(f.health, cfg.Name, "airQuality")
+			if err != nil {
+				return err
+			}
+			context.AfterFunc(ctx, exclusions.Dispose)
+			group.exclusions = exclusions
 		}
 
 		f.devices.Add(cfg.AirQualitySensors...)
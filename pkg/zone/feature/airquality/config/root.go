@@ -2,10 +2,14 @@ package config
 
 import (
 	"github.com/smart-core-os/sc-bos/pkg/zone"
+	"github.com/smart-core-os/sc-bos/pkg/zone/feature/merge"
 )
 
 type Root struct {
 	zone.Config
 
 	AirQualitySensors []string `json:"airQualitySensors,omitempty"`
+	// Aggregation configures how the values of AirQualitySensors are combined.
+	// Defaults to an unweighted mean.
+	Aggregation *merge.Config `json:"aggregation,omitempty"`
 }
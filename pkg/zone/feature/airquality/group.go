@@ -2,6 +2,7 @@ package airquality
 
 import (
 	"context"
+	"time"
 
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
@@ -24,6 +25,9 @@ type Group struct {
 	client airqualitysensorpb.AirQualitySensorApiClient
 	names  []string
 
+	aggregation *merge.Config
+	exclusions  *merge.ExclusionCheck // may be nil
+
 	logger *zap.Logger
 }
 
@@ -48,7 +52,7 @@ func (g *Group) GetAirQuality(ctx context.Context, request *airqualitysensorpb.G
 			g.logger.Warn("some airquality sensors failed to get", zap.Errors("errors", multierr.Errors(err)))
 		}
 	}
-	return mergeAirQuality(allRes)
+	return g.mergeAirQuality(merge.NewMembers(g.aggregation, g.names, allRes))
 }
 
 func (g *Group) PullAirQuality(request *airqualitysensorpb.PullAirQualityRequest, server airqualitysensorpb.AirQualitySensorApi_PullAirQualityServer) error {
@@ -106,10 +110,13 @@ func (g *Group) PullAirQuality(request *airqualitysensorpb.PullAirQualityRequest
 			indexes[name] = i
 		}
 		values := make([]*airqualitysensorpb.AirQuality, len(g.names))
+		times := make([]time.Time, len(g.names))
 
 		var last *airqualitysensorpb.AirQuality
 		eq := cmp.Equal(cmp.FloatValueApprox(0, 0.001))
 		filter := masks.NewResponseFilter(masks.WithFieldMask(request.ReadMask))
+		var staleTimer merge.StaleTimer
+		defer staleTimer.Stop()
 
 		for {
 			select {
@@ -117,26 +124,32 @@ func (g *Group) PullAirQuality(request *airqualitysensorpb.PullAirQualityRequest
 				return ctx.Err()
 			case change := <-changes:
 				values[indexes[change.name]] = change.val
-				r, err := mergeAirQuality(values)
-				if err != nil {
-					return err
-				}
-				filter.Filter(r)
-
-				// don't send duplicates
-				if eq(last, r) {
-					continue
-				}
-				last = r
-
-				err = server.Send(&airqualitysensorpb.PullAirQualityResponse{Changes: []*airqualitysensorpb.PullAirQualityResponse_Change{{
-					Name:       request.Name,
-					ChangeTime: timestamppb.Now(),
-					AirQuality: r,
-				}}})
-				if err != nil {
-					return err
-				}
+				times[indexes[change.name]] = time.Now()
+			case <-staleTimer.C():
+				// recalculate now some values are stale
+			}
+			members := merge.NewMembers(g.aggregation, g.names, values)
+			members.Times = times
+			staleTimer.Reset(members.StaleTime(), members.Now)
+			r, err := g.mergeAirQuality(members)
+			if err != nil {
+				return err
+			}
+			filter.Filter(r)
+
+			// don't send duplicates
+			if eq(last, r) {
+				continue
+			}
+			last = r
+
+			err = server.Send(&airqualitysensorpb.PullAirQualityResponse{Changes: []*airqualitysensorpb.PullAirQualityResponse_Change{{
+				Name:       request.Name,
+				ChangeTime: timestamppb.Now(),
+				AirQuality: r,
+			}}})
+			if err != nil {
+				return err
 			}
 		}
 	})
@@ -144,16 +157,25 @@ func (g *Group) PullAirQuality(request *airqualitysensorpb.PullAirQualityRequest
 	return group.Wait()
 }
 
-func mergeAirQuality(all []*airqualitysensorpb.AirQuality) (*airqualitysensorpb.AirQuality, error) {
-	switch len(all) {
-	case 0:
+func (g *Group) mergeAirQuality(m *merge.Members[*airqualitysensorpb.AirQuality]) (*airqualitysensorpb.AirQuality, error) {
+	r, err := mergeAirQuality(m)
+	if err == nil {
+		g.exclusions.Update(m.Exclusions())
+	}
+	return r, err
+}
+
+func mergeAirQuality(m *merge.Members[*airqualitysensorpb.AirQuality]) (*airqualitysensorpb.AirQuality, error) {
+	all := m.Values
+	switch {
+	case len(all) == 0:
 		return nil, status.Errorf(codes.FailedPrecondition, "zone has no air quality sensor names")
-	case 1:
+	case len(all) == 1 && m.Config == nil:
 		return all[0], nil
 	default:
 		out := &airqualitysensorpb.AirQuality{}
 		// CO2
-		if val, ok := merge.Mean(all, func(e *airqualitysensorpb.AirQuality) (float32, bool) {
+		if val, ok := merge.MeanOf(m, "carbon_dioxide_level", func(e *airqualitysensorpb.AirQuality) (float32, bool) {
 			if e == nil || e.CarbonDioxideLevel == nil {
 				return 0, false
 			}
@@ -162,7 +184,7 @@ func mergeAirQuality(all []*airqualitysensorpb.AirQuality) (*airqualitysensorpb.
 			out.CarbonDioxideLevel = &val
 		}
 		// VOC
-		if val, ok := merge.Mean(all, func(e *airqualitysensorpb.AirQuality) (float32, bool) {
+		if val, ok := merge.MeanOf(m, "volatile_organic_compounds", func(e *airqualitysensorpb.AirQuality) (float32, bool) {
 			if e == nil || e.VolatileOrganicCompounds == nil {
 				return 0, false
 			}
@@ -171,7 +193,7 @@ func mergeAirQuality(all []*airqualitysensorpb.AirQuality) (*airqualitysensorpb.
 			out.VolatileOrganicCompounds = &val
 		}
 		// AirPressure
-		if val, ok := merge.Mean(all, func(e *airqualitysensorpb.AirQuality) (float32, bool) {
+		if val, ok := merge.MeanOf(m, "air_pressure", func(e *airqualitysensorpb.AirQuality) (float32, bool) {
 			if e == nil || e.AirPressure == nil {
 				return 0, false
 			}
@@ -180,7 +202,7 @@ func mergeAirQuality(all []*airqualitysensorpb.AirQuality) (*airqualitysensorpb.
 			out.AirPressure = &val
 		}
 		// InfectionRisk
-		if val, ok := merge.Mean(all, func(e *airqualitysensorpb.AirQuality) (float32, bool) {
+		if val, ok := merge.MeanOf(m, "infection_risk", func(e *airqualitysensorpb.AirQuality) (float32, bool) {
 			if e == nil || e.InfectionRisk == nil {
 				return 0, false
 			}
@@ -198,7 +220,7 @@ func mergeAirQuality(all []*airqualitysensorpb.AirQuality) (*airqualitysensorpb.
 			out.Comfort = val
 		}
 		// IAQ Score
-		if val, ok := merge.Mean(all, func(e *airqualitysensorpb.AirQuality) (float32, bool) {
+		if val, ok := merge.MeanOf(m, "score", func(e *airqualitysensorpb.AirQuality) (float32, bool) {
 			if e == nil || e.Score == nil {
 				return 0, false
 			}
@@ -207,7 +229,7 @@ func mergeAirQuality(all []*airqualitysensorpb.AirQuality) (*airqualitysensorpb.
 			out.Score = &val
 		}
 		// PM1
-		if val, ok := merge.Mean(all, func(e *airqualitysensorpb.AirQuality) (float32, bool) {
+		if val, ok := merge.MeanOf(m, "particulate_matter_1", func(e *airqualitysensorpb.AirQuality) (float32, bool) {
 			if e == nil || e.ParticulateMatter_1 == nil {
 				return 0, false
 			}
@@ -216,7 +238,7 @@ func mergeAirQuality(all []*airqualitysensorpb.AirQuality) (*airqualitysensorpb.
 			out.ParticulateMatter_1 = &val
 		}
 		// PM10
-		if val, ok := merge.Mean(all, func(e *airqualitysensorpb.AirQuality) (float32, bool) {
+		if val, ok := merge.MeanOf(m, "particulate_matter_10", func(e *airqualitysensorpb.AirQuality) (float32, bool) {
 			if e == nil || e.ParticulateMatter_10 == nil {
 				return 0, false
 			}
@@ -225,7 +247,7 @@ func mergeAirQuality(all []*airqualitysensorpb.AirQuality) (*airqualitysensorpb.
 			out.ParticulateMatter_10 = &val
 		}
 		// PM25
-		if val, ok := merge.Mean(all, func(e *airqualitysensorpb.AirQuality) (float32, bool) {
+		if val, ok := merge.MeanOf(m, "particulate_matter_25", func(e *airqualitysensorpb.AirQuality) (float32, bool) {
 			if e == nil || e.ParticulateMatter_25 == nil {
 				return 0, false
 			}
@@ -234,7 +256,7 @@ func mergeAirQuality(all []*airqualitysensorpb.AirQuality) (*airqualitysensorpb.
 			out.ParticulateMatter_25 = &val
 		}
 		// AirChangePerHour
-		if val, ok := merge.Mean(all, func(e *airqualitysensorpb.AirQuality) (float32, bool) {
+		if val, ok := merge.MeanOf(m, "air_change_per_hour", func(e *airqualitysensorpb.AirQuality) (float32, bool) {
 			if e == nil || e.AirChangePerHour == nil {
 				return 0, false
 			}
@@ -242,6 +264,7 @@ func mergeAirQuality(all []*airqualitysensorpb.AirQuality) (*airqualitysensorpb.
 		}); ok {
 			out.AirChangePerHour = &val
 		}
+		out.Aggregations = m.Aggregations()
 		return out, nil
 	}
 }
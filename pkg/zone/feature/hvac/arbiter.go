@@ -0,0 +1,286 @@
+package hvac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/timshannon/bolthold"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/airtemperaturepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/tenantpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/typespb"
+	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
+	"github.com/smart-core-os/sc-bos/pkg/zone/feature/hvac/config"
+)
+
+// tenantRefreshInterval is how often we check which tenant the zone belongs to.
+const tenantRefreshInterval = 5 * time.Minute
+
+// arbiter decides which set point the thermostats in a group should have,
+// based on the most recently requested set point and the configured rules.
+type arbiter struct {
+	rules *config.SetPointRules
+	now   func() time.Time
+
+	changed chan struct{} // notified when the decision might have changed for reasons other than time
+
+	mu         sync.Mutex
+	requested  *float64
+	expireTime time.Time // zero if requested doesn't expire
+	tenant     string    // the tenant the zone belongs to, or "" if none
+	written    *float64  // the set point last written to the thermostats
+
+	db     *bolthold.Store // saves requests, nil if requests aren't saved
+	dbKey  string
+	logger *zap.Logger
+}
+
+// savedSetPointRequest is how requested set points are saved, so they survive the zone being reconfigured or the
+// controller restarting.
+type savedSetPointRequest struct {
+	SetPoint   float64
+	ExpireTime time.Time // zero if the request doesn't expire
+}
+
+func newArbiter(rules *config.SetPointRules) *arbiter {
+	return &arbiter{
+		rules:   rules,
+		now:     time.Now,
+		changed: make(chan struct{}, 1),
+	}
+}
+
+// persist saves requested set points to db using key, restoring any request saved previously.
+func (a *arbiter) persist(db *bolthold.Store, key string, logger *zap.Logger) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.db, a.dbKey, a.logger = db, key, logger
+	var saved savedSetPointRequest
+	switch err := db.Get(key, &saved); {
+	case errors.Is(err, bolthold.ErrNotFound):
+	case err != nil:
+		logger.Warn("failed to load requested set point", zap.Error(err))
+	default:
+		a.requested = &saved.SetPoint
+		a.expireTime = saved.ExpireTime
+	}
+}
+
+// decision is the outcome of applying set point rules.
+type decision struct {
+	ok        bool // false if there's no set point to write
+	setPoint  float64
+	source    *airtemperaturepb.TemperatureGoalSource
+	changesAt time.Time // when the decision may next change, zero if it only changes on request
+}
+
+// request records that setPoint was requested, returning the resulting decision.
+func (a *arbiter) request(setPoint float64) decision {
+	now := a.now()
+	a.mu.Lock()
+	a.requested = &setPoint
+	a.expireTime = time.Time{}
+	if d := a.rules.OverrideDurationOrZero(); d > 0 {
+		a.expireTime = now.Add(d)
+	}
+	res := a.decideLocked(now)
+	a.saveLocked()
+	a.mu.Unlock()
+	a.notify()
+	return res
+}
+
+func (a *arbiter) saveLocked() {
+	if a.db == nil || a.requested == nil {
+		return
+	}
+	saved := savedSetPointRequest{SetPoint: *a.requested, ExpireTime: a.expireTime}
+	if err := a.db.Upsert(a.dbKey, &saved); err != nil {
+		a.logger.Warn("failed to save requested set point", zap.Error(err))
+	}
+}
+
+// deltaBase returns the set point that a requested change in set point is relative to.
+// This is the set point in effect, before any unoccupied offset which is applied to the new set point anyway.
+func (a *arbiter) deltaBase() (float64, bool) {
+	now := a.now()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	d := a.limitedLocked(now)
+	return d.setPoint, d.ok
+}
+
+func (a *arbiter) setTenant(tenant string) {
+	a.mu.Lock()
+	changed := a.tenant != tenant
+	a.tenant = tenant
+	a.mu.Unlock()
+	if changed {
+		a.notify()
+	}
+}
+
+// setWritten records that setPoint has been written to the thermostats.
+func (a *arbiter) setWritten(setPoint float64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.written = &setPoint
+}
+
+// needsWrite returns the current decision and whether its set point differs from the one last written.
+func (a *arbiter) needsWrite() (decision, bool) {
+	now := a.now()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	d := a.decideLocked(now)
+	if !d.ok {
+		return d, false
+	}
+	return d, a.written == nil || *a.written != d.setPoint
+}
+
+func (a *arbiter) decide() decision {
+	now := a.now()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.decideLocked(now)
+}
+
+func (a *arbiter) notify() {
+	select {
+	case a.changed <- struct{}{}:
+	default:
+	}
+}
+
+func (a *arbiter) requestActiveLocked(now time.Time) bool {
+	return a.requested != nil && (a.expireTime.IsZero() || now.Before(a.expireTime))
+}
+
+func (a *arbiter) decideLocked(now time.Time) decision {
+	res := a.limitedLocked(now)
+	if !res.ok {
+		return res
+	}
+	rules, source := a.rules, res.source
+
+	if len(rules.OccupiedSchedule) > 0 {
+		occupied, _, _, changesIn := jsontypes.AnalyseScheduleRanges(now, rules.OccupiedSchedule)
+		if changesIn > 0 {
+			changesAt := now.Add(changesIn)
+			if res.changesAt.IsZero() || changesAt.Before(res.changesAt) {
+				res.changesAt = changesAt
+			}
+		}
+		if !occupied && rules.UnoccupiedOffset != 0 {
+			res.setPoint += rules.UnoccupiedOffset
+			source.Rule = airtemperaturepb.TemperatureGoalSource_UNOCCUPIED_OFFSET
+			source.Description = fmt.Sprintf("The space is not expected to be occupied, the set point has been adjusted by %+.1f°C", rules.UnoccupiedOffset)
+			if v, limited := rules.BuildingLimits.Clamp(res.setPoint); limited {
+				res.setPoint = v
+				source.Rule = airtemperaturepb.TemperatureGoalSource_BUILDING_LIMIT
+				source.Description = fmt.Sprintf("The set point is outside the limits for the building, limited to %.1f°C", v)
+			}
+		}
+	}
+	return res
+}
+
+// limitedLocked returns the requested or default set point, limited by the tenant and building limits.
+func (a *arbiter) limitedLocked(now time.Time) decision {
+	rules := a.rules
+	source := &airtemperaturepb.TemperatureGoalSource{}
+	if a.requested != nil {
+		source.RequestedSetPoint = &typespb.Temperature{ValueCelsius: *a.requested}
+		if !a.expireTime.IsZero() {
+			source.RequestExpireTime = timestamppb.New(a.expireTime)
+		}
+	}
+
+	var res decision
+	switch {
+	case a.requestActiveLocked(now):
+		res.setPoint = *a.requested
+		source.Rule = airtemperaturepb.TemperatureGoalSource_REQUESTED
+		source.Description = "The requested set point is in use"
+		res.changesAt = a.expireTime
+	case rules.DefaultSetPoint != nil:
+		res.setPoint = *rules.DefaultSetPoint
+		source.Rule = airtemperaturepb.TemperatureGoalSource_DEFAULT
+		if a.requested != nil {
+			source.Description = "The requested set point expired, the default set point is in use"
+		} else {
+			source.Description = "The default set point is in use"
+		}
+	default:
+		// nothing to arbitrate
+		return decision{source: source}
+	}
+	res.ok = true
+
+	if source.Rule == airtemperaturepb.TemperatureGoalSource_REQUESTED && a.tenant != "" {
+		if v, limited := rules.TenantLimits[a.tenant].Clamp(res.setPoint); limited {
+			res.setPoint = v
+			source.Rule = airtemperaturepb.TemperatureGoalSource_TENANT_LIMIT
+			source.Description = fmt.Sprintf("The requested set point is outside the limits for your tenancy, limited to %.1f°C", v)
+		}
+	}
+	if v, limited := rules.BuildingLimits.Clamp(res.setPoint); limited {
+		res.setPoint = v
+		source.Rule = airtemperaturepb.TemperatureGoalSource_BUILDING_LIMIT
+		source.Description = fmt.Sprintf("The set point is outside the limits for the building, limited to %.1f°C", v)
+	}
+
+	res.source = source
+	return res
+}
+
+// watchTenant keeps track of which of the tenants with limits the zone belongs to.
+// Tenants are checked periodically until ctx is done.
+func (a *arbiter) watchTenant(ctx context.Context, client tenantpb.TenantApiClient, zoneName string, logger *zap.Logger) {
+	ids := make([]string, 0, len(a.rules.TenantLimits))
+	for id := range a.rules.TenantLimits {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids) // for a stable choice if the zone belongs to multiple tenants
+
+	refresh := func() error {
+		for _, id := range ids {
+			tenant, err := client.GetTenant(ctx, &tenantpb.GetTenantRequest{Id: id})
+			if err != nil {
+				return fmt.Errorf("tenant %q: %w", id, err)
+			}
+			if slices.Contains(tenant.GetZoneNames(), zoneName) {
+				a.setTenant(id)
+				return nil
+			}
+		}
+		a.setTenant("")
+		return nil
+	}
+
+	ticker := time.NewTicker(tenantRefreshInterval)
+	defer ticker.Stop()
+	var failing bool
+	for {
+		if err := refresh(); err != nil {
+			if !failing {
+				logger.Warn("failed to check zone tenant, will retry", zap.Error(err))
+			}
+			failing = true
+		} else {
+			failing = false
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
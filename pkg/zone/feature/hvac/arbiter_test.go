@@ -0,0 +1,166 @@
+package hvac
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/timshannon/bolthold"
+	"go.uber.org/zap"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/airtemperaturepb"
+	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
+	"github.com/smart-core-os/sc-bos/pkg/zone/feature/hvac/config"
+)
+
+func TestArbiter_decide(t *testing.T) {
+	ptr := func(v float64) *float64 { return &v }
+	// a Wednesday
+	officeHours := time.Date(2024, 1, 3, 10, 0, 0, 0, time.Local)
+	overnight := time.Date(2024, 1, 3, 22, 0, 0, 0, time.Local)
+	rules := func() *config.SetPointRules {
+		return &config.SetPointRules{
+			BuildingLimits:   &config.Limits{Min: ptr(16), Max: ptr(26)},
+			TenantLimits:     map[string]*config.Limits{"t1": {Min: ptr(20), Max: ptr(23)}},
+			DefaultSetPoint:  ptr(21),
+			OverrideDuration: &jsontypes.Duration{Duration: 2 * time.Hour},
+			OccupiedSchedule: []config.Range{{
+				Start: *jsontypes.MustParseSchedule("0 8 * * 1-5"),
+				End:   *jsontypes.MustParseSchedule("0 18 * * 1-5"),
+			}},
+			UnoccupiedOffset: -4,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		rules     *config.SetPointRules
+		now       time.Time
+		tenant    string
+		requested *float64
+		requestAt time.Time
+		wantOk    bool
+		wantSP    float64
+		wantRule  airtemperaturepb.TemperatureGoalSource_Rule
+	}{
+		{name: "default", rules: rules(), now: officeHours, wantOk: true, wantSP: 21, wantRule: airtemperaturepb.TemperatureGoalSource_DEFAULT},
+		{name: "no default", rules: &config.SetPointRules{}, now: officeHours},
+		{name: "requested", rules: rules(), now: officeHours, requested: ptr(22), requestAt: officeHours,
+			wantOk: true, wantSP: 22, wantRule: airtemperaturepb.TemperatureGoalSource_REQUESTED},
+		{name: "expired", rules: rules(), now: officeHours, requested: ptr(22), requestAt: officeHours.Add(-3 * time.Hour),
+			wantOk: true, wantSP: 21, wantRule: airtemperaturepb.TemperatureGoalSource_DEFAULT},
+		{name: "building limit", rules: rules(), now: officeHours, requested: ptr(30), requestAt: officeHours,
+			wantOk: true, wantSP: 26, wantRule: airtemperaturepb.TemperatureGoalSource_BUILDING_LIMIT},
+		{name: "tenant limit", rules: rules(), now: officeHours, tenant: "t1", requested: ptr(25), requestAt: officeHours,
+			wantOk: true, wantSP: 23, wantRule: airtemperaturepb.TemperatureGoalSource_TENANT_LIMIT},
+		{name: "other tenant", rules: rules(), now: officeHours, tenant: "t2", requested: ptr(25), requestAt: officeHours,
+			wantOk: true, wantSP: 25, wantRule: airtemperaturepb.TemperatureGoalSource_REQUESTED},
+		{name: "unoccupied", rules: rules(), now: overnight,
+			wantOk: true, wantSP: 17, wantRule: airtemperaturepb.TemperatureGoalSource_UNOCCUPIED_OFFSET},
+		{name: "unoccupied building limit", rules: rules(), now: overnight, requested: ptr(18), requestAt: overnight,
+			wantOk: true, wantSP: 16, wantRule: airtemperaturepb.TemperatureGoalSource_BUILDING_LIMIT},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newArbiter(tt.rules)
+			a.setTenant(tt.tenant)
+			if tt.requested != nil {
+				a.now = func() time.Time { return tt.requestAt }
+				a.request(*tt.requested)
+			}
+			a.now = func() time.Time { return tt.now }
+			got := a.decide()
+			if got.ok != tt.wantOk {
+				t.Fatalf("ok = %v, want %v", got.ok, tt.wantOk)
+			}
+			if !got.ok {
+				return
+			}
+			if got.setPoint != tt.wantSP {
+				t.Errorf("setPoint = %v, want %v", got.setPoint, tt.wantSP)
+			}
+			if got.source.GetRule() != tt.wantRule {
+				t.Errorf("rule = %v, want %v", got.source.GetRule(), tt.wantRule)
+			}
+			if got.source.GetDescription() == "" {
+				t.Errorf("description is empty")
+			}
+		})
+	}
+}
+
+func TestArbiter_decide_changesAt(t *testing.T) {
+	ptr := func(v float64) *float64 { return &v }
+	now := time.Date(2024, 1, 3, 10, 0, 0, 0, time.Local)
+	a := newArbiter(&config.SetPointRules{
+		DefaultSetPoint:  ptr(21),
+		OverrideDuration: &jsontypes.Duration{Duration: time.Hour},
+		OccupiedSchedule: []config.Range{{
+			Start: *jsontypes.MustParseSchedule("0 8 * * *"),
+			End:   *jsontypes.MustParseSchedule("0 18 * * *"),
+		}},
+	})
+	a.now = func() time.Time { return now }
+	if got, want := a.decide().changesAt, now.Add(8*time.Hour); !got.Equal(want) {
+		t.Errorf("changesAt = %v, want end of occupied period %v", got, want)
+	}
+	a.request(22)
+	if got, want := a.decide().changesAt, now.Add(time.Hour); !got.Equal(want) {
+		t.Errorf("changesAt = %v, want request expiry %v", got, want)
+	}
+}
+
+func TestArbiter_deltaBase(t *testing.T) {
+	ptr := func(v float64) *float64 { return &v }
+	now := time.Date(2024, 1, 3, 22, 0, 0, 0, time.Local) // unoccupied
+	a := newArbiter(&config.SetPointRules{
+		BuildingLimits:   &config.Limits{Max: ptr(26)},
+		OccupiedSchedule: []config.Range{{Start: *jsontypes.MustParseSchedule("0 8 * * 1-5"), End: *jsontypes.MustParseSchedule("0 18 * * 1-5")}},
+		UnoccupiedOffset: -4,
+	})
+	a.now = func() time.Time { return now }
+	if _, ok := a.deltaBase(); ok {
+		t.Fatal("deltaBase() ok with nothing requested and no default")
+	}
+	a.request(28)
+	// deltas apply to the limited set point, without the unoccupied offset which is applied again after the request
+	if got, ok := a.deltaBase(); !ok || got != 26 {
+		t.Errorf("deltaBase() = %v, %v, want 26, true", got, ok)
+	}
+}
+
+func TestArbiter_persist(t *testing.T) {
+	db, err := bolthold.Open(filepath.Join(t.TempDir(), "test.db"), 0600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	now := time.Date(2024, 1, 3, 10, 0, 0, 0, time.Local)
+	def := 21.0
+	rules := &config.SetPointRules{DefaultSetPoint: &def, OverrideDuration: &jsontypes.Duration{Duration: time.Hour}}
+
+	a := newArbiter(rules)
+	a.now = func() time.Time { return now }
+	a.persist(db, "zone1", zap.NewNop())
+	a.request(22)
+
+	// a new arbiter, as created when the zone is reconfigured, picks up the request
+	b := newArbiter(rules)
+	b.now = func() time.Time { return now }
+	b.persist(db, "zone1", zap.NewNop())
+	if got := b.decide(); !got.ok || got.setPoint != 22 {
+		t.Errorf("restored decision = %v, %v, want 22", got.setPoint, got.ok)
+	}
+	// including when it expires
+	b.now = func() time.Time { return now.Add(2 * time.Hour) }
+	if got := b.decide(); got.setPoint != def {
+		t.Errorf("restored request did not expire, got %v", got.setPoint)
+	}
+
+	other := newArbiter(rules)
+	other.now = func() time.Time { return now }
+	other.persist(db, "zone2", zap.NewNop())
+	if got := other.decide(); got.setPoint != def {
+		t.Errorf("request restored for other zone, got %v", got.setPoint)
+	}
+}
@@ -1,7 +1,11 @@
 package config
 
 import (
+	"time"
+
+	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
 	"github.com/smart-core-os/sc-bos/pkg/zone"
+	"github.com/smart-core-os/sc-bos/pkg/zone/feature/merge"
 )
 
 type Root struct {
@@ -14,4 +18,69 @@ type Root struct {
 type Thermostat struct {
 	ReadOnlyThermostat bool     `json:"thermostatReadOnly,omitempty"`
 	Thermostats        []string `json:"thermostats,omitempty"`
+	// SetPointRules arbitrate requested set points before they are written to the thermostats.
+	// Thermostat groups without their own rules use the rules of the zone.
+	SetPointRules *SetPointRules `json:"setPointRules,omitempty"`
+	// Aggregation configures how the values of Thermostats are combined.
+	// Thermostat groups without their own aggregation use the aggregation of the zone.
+	Aggregation *merge.Config `json:"aggregation,omitempty"`
+}
+
+// SetPointRules describe how a requested set point is adjusted before it is written to the thermostats.
+// Rules are applied in this order: tenant limits, building limits, then the unoccupied offset.
+// The unoccupied offset is still limited by the building limits.
+type SetPointRules struct {
+	// BuildingLimits is the band all set points must be within.
+	BuildingLimits *Limits `json:"buildingLimits,omitempty"`
+	// TenantLimits are the limits for requested set points when the zone belongs to a tenant.
+	// Keys are tenant ids, a zone belongs to a tenant when the tenant lists the zone in its zone names.
+	TenantLimits map[string]*Limits `json:"tenantLimits,omitempty"`
+
+	// DefaultSetPoint is the set point used when none has been requested, or the requested set point has expired.
+	// If absent, set points are only written when requested.
+	DefaultSetPoint *float64 `json:"defaultSetPointCelsius,omitempty"`
+	// OverrideDuration is how long a requested set point applies before reverting to DefaultSetPoint.
+	// Requested set points don't expire if absent or if there is no DefaultSetPoint.
+	OverrideDuration *jsontypes.Duration `json:"overrideDuration,omitempty"`
+
+	// OccupiedSchedule are periods when the zone is expected to be occupied.
+	// Outside these periods UnoccupiedOffset is added to the set point.
+	// If absent the zone is always considered occupied.
+	OccupiedSchedule []Range `json:"occupiedSchedule,omitempty"`
+	// UnoccupiedOffset is added to the set point when the zone is not expected to be occupied.
+	// For example -3 to lower the set point by 3 degrees overnight.
+	UnoccupiedOffset float64 `json:"unoccupiedOffsetCelsius,omitempty"`
+}
+
+// OverrideDurationOrZero returns OverrideDuration, or 0 if requested set points don't expire.
+func (r *SetPointRules) OverrideDurationOrZero() time.Duration {
+	if r.DefaultSetPoint == nil {
+		return 0
+	}
+	return r.OverrideDuration.Or(0)
+}
+
+// Limits restrict set points to between Min and Max, inclusive.
+// Either may be absent to leave that side unrestricted.
+type Limits struct {
+	Min *float64 `json:"minCelsius,omitempty"`
+	Max *float64 `json:"maxCelsius,omitempty"`
 }
+
+// Clamp returns v limited to be within l, and whether v was outside l.
+// A nil l does not limit v.
+func (l *Limits) Clamp(v float64) (float64, bool) {
+	if l == nil {
+		return v, false
+	}
+	if l.Min != nil && v < *l.Min {
+		return *l.Min, true
+	}
+	if l.Max != nil && v > *l.Max {
+		return *l.Max, true
+	}
+	return v, false
+}
+
+// Range is a recurring period of time, see jsontypes.ScheduleRange.
+type Range = jsontypes.ScheduleRange
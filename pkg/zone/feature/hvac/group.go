@@ -2,6 +2,7 @@ package hvac
 
 import (
 	"context"
+	"time"
 
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
@@ -9,6 +10,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/smart-core-os/sc-bos/pkg/proto/airtemperaturepb"
@@ -25,6 +27,10 @@ type Group struct {
 	client   airtemperaturepb.AirTemperatureApiClient
 	names    []string
 	readOnly bool
+	arbiter  *arbiter // nil if there are no set point rules
+
+	aggregation *merge.Config
+	exclusions  *merge.ExclusionCheck // may be nil
 
 	logger *zap.Logger
 }
@@ -50,13 +56,118 @@ func (g *Group) GetAirTemperature(ctx context.Context, request *airtemperaturepb
 			g.logger.Warn("some hvacs failed to get", zap.Errors("errors", multierr.Errors(err)))
 		}
 	}
-	return mergeAirTemperature(allRes)
+	return g.withSource(g.mergeAirTemperature(merge.NewMembers(g.aggregation, g.names, allRes)))
 }
 
 func (g *Group) UpdateAirTemperature(ctx context.Context, request *airtemperaturepb.UpdateAirTemperatureRequest) (*airtemperaturepb.AirTemperature, error) {
 	if g.readOnly {
 		return nil, status.Errorf(codes.FailedPrecondition, "read-only")
 	}
+	if g.arbiter != nil {
+		var err error
+		request, err = g.arbitrate(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return g.withSource(g.updateAll(ctx, request))
+}
+
+// arbitrate records the set point requested by request, returning a request that writes the set point chosen by the
+// set point rules instead.
+func (g *Group) arbitrate(ctx context.Context, request *airtemperaturepb.UpdateAirTemperatureRequest) (*airtemperaturepb.UpdateAirTemperatureRequest, error) {
+	var requested float64
+	switch goal := request.GetState().GetTemperatureGoal().(type) {
+	case nil:
+		return request, nil // not updating the set point
+	case *airtemperaturepb.AirTemperature_TemperatureSetPoint:
+		requested = goal.TemperatureSetPoint.GetValueCelsius()
+	case *airtemperaturepb.AirTemperature_TemperatureSetPointDelta:
+		current, ok := g.arbiter.deltaBase()
+		if !ok {
+			res, err := g.GetAirTemperature(ctx, &airtemperaturepb.GetAirTemperatureRequest{Name: request.Name})
+			if err != nil {
+				return nil, err
+			}
+			sp := res.GetTemperatureSetPoint()
+			if sp == nil {
+				return nil, status.Error(codes.FailedPrecondition, "no current set point to apply delta to")
+			}
+			current = sp.ValueCelsius
+		}
+		requested = current + goal.TemperatureSetPointDelta.GetValueCelsius()
+	default:
+		return nil, status.Error(codes.InvalidArgument, "only set points are supported by zones with set point rules")
+	}
+
+	d := g.arbiter.request(requested)
+	request = proto.Clone(request).(*airtemperaturepb.UpdateAirTemperatureRequest)
+	request.State.TemperatureGoal = &airtemperaturepb.AirTemperature_TemperatureSetPoint{
+		TemperatureSetPoint: &typespb.Temperature{ValueCelsius: d.setPoint},
+	}
+	if request.UpdateMask != nil {
+		for i, p := range request.UpdateMask.Paths {
+			if p == "temperature_set_point_delta" {
+				request.UpdateMask.Paths[i] = "temperature_set_point"
+			}
+		}
+	}
+	return request, nil
+}
+
+// enforceSetPoints writes the set point chosen by the set point rules to the thermostats whenever it changes,
+// for example when a requested set point expires or the zone becomes unoccupied.
+// Blocks until ctx is done.
+func (g *Group) enforceSetPoints(ctx context.Context) {
+	const retryDelay = time.Minute
+	for {
+		d, write := g.arbiter.needsWrite()
+		wakeAt := d.changesAt
+		if write {
+			_, err := g.updateAll(ctx, &airtemperaturepb.UpdateAirTemperatureRequest{
+				State: &airtemperaturepb.AirTemperature{
+					TemperatureGoal: &airtemperaturepb.AirTemperature_TemperatureSetPoint{
+						TemperatureSetPoint: &typespb.Temperature{ValueCelsius: d.setPoint},
+					},
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"temperature_set_point"}},
+			})
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				if g.logger != nil {
+					g.logger.Warn("failed to write set point, will retry", zap.Float64("setPoint", d.setPoint), zap.Error(err))
+				}
+				retryAt := g.arbiter.now().Add(retryDelay)
+				if wakeAt.IsZero() || retryAt.Before(wakeAt) {
+					wakeAt = retryAt
+				}
+			}
+		}
+
+		var timer *time.Timer
+		var timerC <-chan time.Time
+		if !wakeAt.IsZero() {
+			timer = time.NewTimer(wakeAt.Sub(g.arbiter.now()))
+			timerC = timer.C
+		}
+		select {
+		case <-ctx.Done():
+		case <-timerC:
+		case <-g.arbiter.changed:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// updateAll sends request to all the thermostats in the group, returning the merged response.
+func (g *Group) updateAll(ctx context.Context, request *airtemperaturepb.UpdateAirTemperatureRequest) (*airtemperaturepb.AirTemperature, error) {
 	fns := make([]func() (*airtemperaturepb.AirTemperature, error), len(g.names))
 	for i, name := range g.names {
 		request := proto.Clone(request).(*airtemperaturepb.UpdateAirTemperatureRequest)
@@ -74,10 +185,24 @@ func (g *Group) UpdateAirTemperature(ctx context.Context, request *airtemperatur
 
 	if err != nil {
 		if g.logger != nil {
-			g.logger.Warn("some hvacs failed to get", zap.Errors("errors", multierr.Errors(err)))
+			g.logger.Warn("some hvacs failed to update", zap.Errors("errors", multierr.Errors(err)))
+		}
+	}
+	if g.arbiter != nil {
+		if sp := request.GetState().GetTemperatureSetPoint(); sp != nil {
+			g.arbiter.setWritten(sp.ValueCelsius)
 		}
 	}
-	return mergeAirTemperature(allRes)
+	return g.mergeAirTemperature(merge.NewMembers(g.aggregation, g.names, allRes))
+}
+
+// withSource adds the source of the temperature goal to res, if the group has set point rules.
+func (g *Group) withSource(res *airtemperaturepb.AirTemperature, err error) (*airtemperaturepb.AirTemperature, error) {
+	if err != nil || g.arbiter == nil || res == nil {
+		return res, err
+	}
+	res.TemperatureGoalSource = g.arbiter.decide().source
+	return res, nil
 }
 
 func (g *Group) PullAirTemperature(request *airtemperaturepb.PullAirTemperatureRequest, server airtemperaturepb.AirTemperatureApi_PullAirTemperatureServer) error {
@@ -133,10 +258,13 @@ func (g *Group) PullAirTemperature(request *airtemperaturepb.PullAirTemperatureR
 			indexes[name] = i
 		}
 		values := make([]*airtemperaturepb.AirTemperature, len(g.names))
+		times := make([]time.Time, len(g.names))
 
 		var last *airtemperaturepb.AirTemperature
 		eq := cmp.Equal(cmp.FloatValueApprox(0, 0.001))
 		filter := masks.NewResponseFilter(masks.WithFieldMask(request.ReadMask))
+		var staleTimer merge.StaleTimer
+		defer staleTimer.Stop()
 
 		for {
 			select {
@@ -144,26 +272,32 @@ func (g *Group) PullAirTemperature(request *airtemperaturepb.PullAirTemperatureR
 				return ctx.Err()
 			case change := <-changes:
 				values[indexes[change.name]] = change.val
-				r, err := mergeAirTemperature(values)
-				if err != nil {
-					return err
-				}
-				filter.Filter(r)
+				times[indexes[change.name]] = time.Now()
+			case <-staleTimer.C():
+				// recalculate now some values are stale
+			}
+			members := merge.NewMembers(g.aggregation, g.names, values)
+			members.Times = times
+			staleTimer.Reset(members.StaleTime(), members.Now)
+			r, err := g.withSource(g.mergeAirTemperature(members))
+			if err != nil {
+				return err
+			}
+			filter.Filter(r)
 
-				// don't send duplicates
-				if eq(last, r) {
-					continue
-				}
-				last = r
-
-				err = server.Send(&airtemperaturepb.PullAirTemperatureResponse{Changes: []*airtemperaturepb.PullAirTemperatureResponse_Change{{
-					Name:           request.Name,
-					ChangeTime:     timestamppb.Now(),
-					AirTemperature: r,
-				}}})
-				if err != nil {
-					return err
-				}
+			// don't send duplicates
+			if eq(last, r) {
+				continue
+			}
+			last = r
+
+			err = server.Send(&airtemperaturepb.PullAirTemperatureResponse{Changes: []*airtemperaturepb.PullAirTemperatureResponse_Change{{
+				Name:           request.Name,
+				ChangeTime:     timestamppb.Now(),
+				AirTemperature: r,
+			}}})
+			if err != nil {
+				return err
 			}
 		}
 	})
@@ -171,16 +305,25 @@ func (g *Group) PullAirTemperature(request *airtemperaturepb.PullAirTemperatureR
 	return group.Wait()
 }
 
-func mergeAirTemperature(all []*airtemperaturepb.AirTemperature) (*airtemperaturepb.AirTemperature, error) {
-	switch len(all) {
-	case 0:
+func (g *Group) mergeAirTemperature(m *merge.Members[*airtemperaturepb.AirTemperature]) (*airtemperaturepb.AirTemperature, error) {
+	r, err := mergeAirTemperature(m)
+	if err == nil {
+		g.exclusions.Update(m.Exclusions())
+	}
+	return r, err
+}
+
+func mergeAirTemperature(m *merge.Members[*airtemperaturepb.AirTemperature]) (*airtemperaturepb.AirTemperature, error) {
+	all := m.Values
+	switch {
+	case len(all) == 0:
 		return nil, status.Error(codes.FailedPrecondition, "zone has no hvac names")
-	case 1:
+	case len(all) == 1 && m.Config == nil:
 		return all[0], nil
 	default:
 		out := &airtemperaturepb.AirTemperature{}
 		// TemperatureGoal
-		if setPoint, ok := merge.Mean(all, func(e *airtemperaturepb.AirTemperature) (float64, bool) {
+		if setPoint, ok := merge.MeanOf(m, "temperature_set_point", func(e *airtemperaturepb.AirTemperature) (float64, bool) {
 			switch t := e.GetTemperatureGoal().(type) { // note: Get is e-nil safe
 			case *airtemperaturepb.AirTemperature_TemperatureSetPoint:
 				return t.TemperatureSetPoint.ValueCelsius, true
@@ -191,7 +334,7 @@ func mergeAirTemperature(all []*airtemperaturepb.AirTemperature) (*airtemperatur
 			out.TemperatureGoal = &airtemperaturepb.AirTemperature_TemperatureSetPoint{TemperatureSetPoint: &typespb.Temperature{ValueCelsius: setPoint}}
 		}
 		// AmbientTemperature
-		if val, ok := merge.Mean(all, func(e *airtemperaturepb.AirTemperature) (float64, bool) {
+		if val, ok := merge.MeanOf(m, "ambient_temperature", func(e *airtemperaturepb.AirTemperature) (float64, bool) {
 			if e == nil || e.AmbientTemperature == nil {
 				return 0, false
 			}
@@ -200,7 +343,7 @@ func mergeAirTemperature(all []*airtemperaturepb.AirTemperature) (*airtemperatur
 			out.AmbientTemperature = &typespb.Temperature{ValueCelsius: val}
 		}
 		// AmbientHumidity
-		if val, ok := merge.Mean(all, func(e *airtemperaturepb.AirTemperature) (float32, bool) {
+		if val, ok := merge.MeanOf(m, "ambient_humidity", func(e *airtemperaturepb.AirTemperature) (float32, bool) {
 			if e == nil || e.AmbientHumidity == nil {
 				return 0, false
 			}
@@ -209,7 +352,7 @@ func mergeAirTemperature(all []*airtemperaturepb.AirTemperature) (*airtemperatur
 			out.AmbientHumidity = &val
 		}
 		// DewPoint
-		if val, ok := merge.Mean(all, func(e *airtemperaturepb.AirTemperature) (float64, bool) {
+		if val, ok := merge.MeanOf(m, "dew_point", func(e *airtemperaturepb.AirTemperature) (float64, bool) {
 			if e == nil || e.DewPoint == nil {
 				return 0, false
 			}
@@ -237,6 +380,7 @@ func mergeAirTemperature(all []*airtemperaturepb.AirTemperature) (*airtemperatur
 			out.Mode = 0
 			break
 		}
+		out.Aggregations = m.Aggregations()
 		return out, nil
 	}
 }
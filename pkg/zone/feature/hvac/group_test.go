@@ -0,0 +1,158 @@
+package hvac
+
+import (
+	"context"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/airtemperaturepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/typespb"
+	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
+	"github.com/smart-core-os/sc-bos/pkg/wrap"
+	"github.com/smart-core-os/sc-bos/pkg/zone/feature/hvac/config"
+	"github.com/smart-core-os/sc-bos/pkg/zone/feature/merge"
+)
+
+func TestGroup_UpdateAirTemperature_setPointRules(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+
+		ptr := func(v float64) *float64 { return &v }
+		r := airtemperaturepb.NewApiRouter()
+		models := map[string]*airtemperaturepb.Model{}
+		for _, name := range []string{"T1", "T2"} {
+			models[name] = airtemperaturepb.NewModel()
+			r.Add(name, airtemperaturepb.NewAirTemperatureApiClient(wrap.ServerToClient(airtemperaturepb.AirTemperatureApi_ServiceDesc, airtemperaturepb.NewModelServer(models[name]))))
+		}
+		group := &Group{
+			client: airtemperaturepb.NewAirTemperatureApiClient(wrap.ServerToClient(airtemperaturepb.AirTemperatureApi_ServiceDesc, r)),
+			names:  []string{"T1", "T2"},
+			arbiter: newArbiter(&config.SetPointRules{
+				BuildingLimits:   &config.Limits{Min: ptr(16), Max: ptr(26)},
+				DefaultSetPoint:  ptr(21),
+				OverrideDuration: &jsontypes.Duration{Duration: 4 * time.Hour},
+			}),
+			logger: zap.NewNop(),
+		}
+		go group.enforceSetPoints(ctx)
+		synctest.Wait()
+
+		assertSetPoints := func(want float64) {
+			t.Helper()
+			for name, model := range models {
+				got, err := model.GetAirTemperature()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if sp := got.GetTemperatureSetPoint().GetValueCelsius(); sp != want {
+					t.Errorf("%s set point = %v, want %v", name, sp, want)
+				}
+			}
+		}
+		// the default is written on start
+		assertSetPoints(21)
+
+		res, err := group.UpdateAirTemperature(ctx, &airtemperaturepb.UpdateAirTemperatureRequest{
+			State: &airtemperaturepb.AirTemperature{
+				TemperatureGoal: &airtemperaturepb.AirTemperature_TemperatureSetPoint{TemperatureSetPoint: &typespb.Temperature{ValueCelsius: 28}},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := res.GetTemperatureGoalSource().GetRule(); got != airtemperaturepb.TemperatureGoalSource_BUILDING_LIMIT {
+			t.Errorf("rule = %v, want BUILDING_LIMIT", got)
+		}
+		if got := res.GetTemperatureGoalSource().GetRequestedSetPoint().GetValueCelsius(); got != 28 {
+			t.Errorf("requested set point = %v, want 28", got)
+		}
+		assertSetPoints(26)
+
+		res, err = group.UpdateAirTemperature(ctx, &airtemperaturepb.UpdateAirTemperatureRequest{
+			State: &airtemperaturepb.AirTemperature{
+				TemperatureGoal: &airtemperaturepb.AirTemperature_TemperatureSetPointDelta{TemperatureSetPointDelta: &typespb.Temperature{ValueCelsius: -3}},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := res.GetTemperatureGoalSource().GetRule(); got != airtemperaturepb.TemperatureGoalSource_REQUESTED {
+			t.Errorf("rule = %v, want REQUESTED", got)
+		}
+		// the delta applies to the limited set point in effect, not the 28 that was requested
+		assertSetPoints(23)
+
+		// the override expires and reverts to the default
+		time.Sleep(4 * time.Hour)
+		synctest.Wait()
+		assertSetPoints(21)
+		got, err := group.GetAirTemperature(ctx, &airtemperaturepb.GetAirTemperatureRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rule := got.GetTemperatureGoalSource().GetRule(); rule != airtemperaturepb.TemperatureGoalSource_DEFAULT {
+			t.Errorf("rule = %v, want DEFAULT", rule)
+		}
+	})
+}
+
+func TestGroup_PullAirTemperature_stale(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+
+		r := airtemperaturepb.NewApiRouter()
+		models := map[string]*airtemperaturepb.Model{}
+		for name, temp := range map[string]float64{"T1": 20, "T2": 24} {
+			models[name] = airtemperaturepb.NewModel()
+			_, _ = models[name].UpdateAirTemperature(&airtemperaturepb.AirTemperature{AmbientTemperature: &typespb.Temperature{ValueCelsius: temp}})
+			r.Add(name, airtemperaturepb.NewAirTemperatureApiClient(wrap.ServerToClient(airtemperaturepb.AirTemperatureApi_ServiceDesc, airtemperaturepb.NewModelServer(models[name]))))
+		}
+		group := &Group{
+			client:      airtemperaturepb.NewAirTemperatureApiClient(wrap.ServerToClient(airtemperaturepb.AirTemperatureApi_ServiceDesc, r)),
+			names:       []string{"T1", "T2"},
+			aggregation: &merge.Config{StaleAfter: &jsontypes.Duration{Duration: time.Minute}},
+			logger:      zap.NewNop(),
+		}
+		client := airtemperaturepb.NewAirTemperatureApiClient(wrap.ServerToClient(airtemperaturepb.AirTemperatureApi_ServiceDesc, group))
+		stream, err := client.PullAirTemperature(ctx, &airtemperaturepb.PullAirTemperatureRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var latest *airtemperaturepb.AirTemperature
+		go func() {
+			for {
+				res, err := stream.Recv()
+				if err != nil {
+					return
+				}
+				for _, change := range res.Changes {
+					latest = change.AirTemperature
+				}
+			}
+		}()
+		assertAmbient := func(want float64) {
+			t.Helper()
+			synctest.Wait()
+			if got := latest.GetAmbientTemperature().GetValueCelsius(); got != want {
+				t.Errorf("ambient temperature = %v, want %v", got, want)
+			}
+		}
+		assertAmbient(22)
+
+		time.Sleep(40 * time.Second)
+		_, _ = models["T1"].UpdateAirTemperature(&airtemperaturepb.AirTemperature{AmbientTemperature: &typespb.Temperature{ValueCelsius: 21}})
+		assertAmbient(22.5)
+
+		// T2 has not reported for a minute, without any other changes the zone value is recalculated
+		time.Sleep(21 * time.Second)
+		assertAmbient(21)
+		if got := latest.GetAggregations()["ambient_temperature"].GetExclusions(); len(got) != 1 || got[0].Name != "T2" {
+			t.Errorf("exclusions = %v, want T2", got)
+		}
+	})
+}
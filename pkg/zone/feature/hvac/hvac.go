@@ -4,14 +4,18 @@ import (
 	"context"
 	"path"
 
+	"github.com/timshannon/bolthold"
 	"go.uber.org/zap"
 
 	"github.com/smart-core-os/sc-bos/pkg/node"
 	"github.com/smart-core-os/sc-bos/pkg/proto/airtemperaturepb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/tenantpb"
 	"github.com/smart-core-os/sc-bos/pkg/task/service"
 	"github.com/smart-core-os/sc-bos/pkg/trait"
 	"github.com/smart-core-os/sc-bos/pkg/zone"
 	"github.com/smart-core-os/sc-bos/pkg/zone/feature/hvac/config"
+	"github.com/smart-core-os/sc-bos/pkg/zone/feature/merge"
 )
 
 var Feature = zone.FactoryFunc(func(services zone.Services) service.Lifecycle {
@@ -20,6 +24,8 @@ var Feature = zone.FactoryFunc(func(services zone.Services) service.Lifecycle {
 		announcer: node.NewReplaceAnnouncer(services.Node),
 		devices:   services.Devices,
 		clients:   services.Node,
+		health:    services.Health,
+		db:        services.Database,
 		logger:    services.Logger,
 	}
 	f.Service = service.New(service.MonoApply(f.applyConfig))
@@ -31,6 +37,8 @@ type feature struct {
 	announcer *node.ReplaceAnnouncer
 	devices   *zone.Devices
 	clients   node.ClientConner
+	health    *healthpb.Checks
+	db        *bolthold.Store // may be nil
 	logger    *zap.Logger
 }
 
@@ -38,6 +46,7 @@ func (f *feature) applyConfig(ctx context.Context, cfg config.Root) error {
 	announce := f.announcer.Replace(ctx)
 	logger := f.logger
 	client := airtemperaturepb.NewAirTemperatureApiClient(f.clients.ClientConn())
+	tenantClient := tenantpb.NewTenantApiClient(f.clients.ClientConn())
 	publish := func(name string, t config.Thermostat) error {
 		group := &Group{
 			client:   client,
@@ -45,6 +54,34 @@ func (f *feature) applyConfig(ctx context.Context, cfg config.Root) error {
 			readOnly: t.ReadOnlyThermostat,
 			logger:   logger,
 		}
+		rules := t.SetPointRules
+		if rules == nil {
+			rules = cfg.SetPointRules
+		}
+		aggregation := t.Aggregation
+		if aggregation == nil {
+			aggregation = cfg.Aggregation
+		}
+		group.aggregation = aggregation
+		if f.health != nil && aggregation != nil {
+			exclusions, err := merge.NewExclusionCheck(f.health, name, "airTemperature")
+			if err != nil {
+				return err
+			}
+			context.AfterFunc(ctx, exclusions.Dispose)
+			group.exclusions = exclusions
+		}
+		if rules != nil && !t.ReadOnlyThermostat {
+			group.arbiter = newArbiter(rules)
+			if f.db != nil {
+				// requested set points are kept if the zone is reconfigured or the controller restarts
+				group.arbiter.persist(f.db, name, logger)
+			}
+			if len(rules.TenantLimits) > 0 {
+				go group.arbiter.watchTenant(ctx, tenantClient, cfg.Name, logger)
+			}
+			go group.enforceSetPoints(ctx)
+		}
 		f.devices.Add(t.Thermostats...)
 		announce.Announce(name,
 			node.HasServer(airtemperaturepb.RegisterAirTemperatureApiServer, airtemperaturepb.AirTemperatureApiServer(group)),
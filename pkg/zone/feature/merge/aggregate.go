@@ -0,0 +1,330 @@
+package merge
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/typespb"
+	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
+)
+
+// Method is an algorithm for combining the values of group members into a single value.
+type Method string
+
+const (
+	// MethodMean is the weighted mean of all member values.
+	MethodMean Method = "mean"
+	// MethodMedian is the weighted median of all member values.
+	MethodMedian Method = "median"
+	// MethodTrimmedMean excludes the highest and lowest member values as outliers, see Config.TrimFraction,
+	// then takes the weighted mean of the remaining values.
+	MethodTrimmedMean Method = "trimmedMean"
+	// MethodIQR excludes member values outside the interquartile range as outliers, see Config.IQRFactor,
+	// then takes the weighted mean of the remaining values.
+	MethodIQR Method = "iqr"
+)
+
+const (
+	DefaultTrimFraction = 0.2
+	DefaultIQRFactor    = 1.5
+)
+
+// Config configures how the values of group members are combined.
+// A nil Config combines values using an unweighted mean, without excluding any values.
+type Config struct {
+	// Method is how member values are combined, defaults to MethodMean.
+	// Not all methods apply to all values, summed values ignore Method.
+	Method Method `json:"method,omitempty"`
+	// Weights adjusts how much each member contributes to the combined value, keyed by member name.
+	// Members without a weight have a weight of 1, weights must not be negative.
+	Weights map[string]float64 `json:"weights,omitempty"`
+	// StaleAfter excludes member values that haven't been received for this long.
+	// A value is received each time a member is read or reports a change, and combined values are recalculated as
+	// member values become stale, so members that only report on change are excluded between changes.
+	// Sums ignore StaleAfter, the last value of a cumulative member like a meter is still part of the total.
+	StaleAfter *jsontypes.Duration `json:"staleAfter,omitempty"`
+	// TrimFraction is the fraction of values to exclude from each end when using MethodTrimmedMean.
+	// Must be less than 0.5, defaults to DefaultTrimFraction.
+	TrimFraction float64 `json:"trimFraction,omitempty"`
+	// IQRFactor is how many interquartile ranges outside the first and third quartile values can be before they are
+	// excluded when using MethodIQR. Defaults to DefaultIQRFactor.
+	// At least 4 values are needed before values are excluded.
+	IQRFactor float64 `json:"iqrFactor,omitempty"`
+}
+
+// UnmarshalJSON decodes c, returning an error if it is not valid, see Validate.
+func (c *Config) UnmarshalJSON(buf []byte) error {
+	type alias Config
+	var a alias
+	if err := json.Unmarshal(buf, &a); err != nil {
+		return err
+	}
+	*c = Config(a)
+	return c.Validate()
+}
+
+// Validate returns an error if c has an unknown Method or values outside their allowed range.
+func (c *Config) Validate() error {
+	if c == nil {
+		return nil
+	}
+	var errs []error
+	switch c.Method {
+	case "", MethodMean, MethodMedian, MethodTrimmedMean, MethodIQR:
+	default:
+		errs = append(errs, fmt.Errorf("method %q: unknown method", c.Method))
+	}
+	for _, name := range slices.Sorted(maps.Keys(c.Weights)) {
+		if w := c.Weights[name]; w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			errs = append(errs, fmt.Errorf("weights[%q] %v: must be a non-negative number", name, w))
+		}
+	}
+	if c.TrimFraction < 0 || c.TrimFraction >= 0.5 || math.IsNaN(c.TrimFraction) {
+		errs = append(errs, fmt.Errorf("trimFraction %v: must be at least 0 and less than 0.5", c.TrimFraction))
+	}
+	if c.IQRFactor < 0 || math.IsNaN(c.IQRFactor) || math.IsInf(c.IQRFactor, 0) {
+		errs = append(errs, fmt.Errorf("iqrFactor %v: must be a non-negative number", c.IQRFactor))
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("aggregation: %w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// Sample is the value of a single group member.
+type Sample struct {
+	Name  string
+	Value float64
+	Time  time.Time // when Value was received, the zero time means now
+}
+
+// Result is the combination of Samples.
+type Result struct {
+	Value float64
+	OK    bool // false if no samples contributed to Value
+	// Aggregation describes which samples contributed to Value.
+	Aggregation *typespb.Aggregation
+}
+
+// Combine combines samples into a single value using c.Method.
+// Stale samples and outliers are excluded.
+func (c *Config) Combine(samples []Sample, now time.Time) Result {
+	return c.combine(samples, now, c.staleAfter(), c.method(), weightedMean)
+}
+
+// CombineLog is like Combine but averages samples logarithmically, suitable for values like decibels.
+// Outliers are detected using the logarithmic values.
+func (c *Config) CombineLog(samples []Sample, now time.Time) Result {
+	return c.combine(samples, now, c.staleAfter(), c.method(), weightedLogMean)
+}
+
+// Sum adds the weighted values of samples.
+// Method and StaleAfter are not used, stale samples are included using their last known value.
+func (c *Config) Sum(samples []Sample, now time.Time) Result {
+	return c.combine(samples, now, 0, MethodMean, weightedSum)
+}
+
+func (c *Config) staleAfter() time.Duration {
+	if c == nil {
+		return 0
+	}
+	return c.StaleAfter.Or(0)
+}
+
+// StaleTime returns when the sample received at t becomes stale.
+// Returns the zero time if samples don't become stale.
+func (c *Config) StaleTime(t time.Time) time.Time {
+	staleAfter := c.staleAfter()
+	if staleAfter <= 0 || t.IsZero() {
+		return time.Time{}
+	}
+	return t.Add(staleAfter)
+}
+
+func (c *Config) method() Method {
+	if c == nil || c.Method == "" {
+		return MethodMean
+	}
+	return c.Method
+}
+
+func (c *Config) weight(name string) float64 {
+	if c == nil {
+		return 1
+	}
+	if w, ok := c.Weights[name]; ok {
+		return w
+	}
+	return 1
+}
+
+type weighted struct {
+	Sample
+	weight float64
+}
+
+func (c *Config) combine(samples []Sample, now time.Time, staleAfter time.Duration, method Method, combine func([]weighted) float64) Result {
+	agg := &typespb.Aggregation{}
+	exclude := func(s Sample, reason typespb.Aggregation_Reason) {
+		agg.Exclusions = append(agg.Exclusions, &typespb.Aggregation_Exclusion{Name: s.Name, Reason: reason})
+	}
+
+	candidates := make([]weighted, 0, len(samples))
+	for _, s := range samples {
+		if staleAfter > 0 && !s.Time.IsZero() && now.Sub(s.Time) >= staleAfter {
+			exclude(s, typespb.Aggregation_STALE)
+			continue
+		}
+		candidates = append(candidates, weighted{Sample: s, weight: c.weight(s.Name)})
+	}
+	// sort by value so outlier detection and medians are simple
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Value < candidates[j].Value
+	})
+
+	var value float64
+	switch method {
+	case MethodMedian:
+		value = weightedMedian(candidates)
+	case MethodTrimmedMean:
+		fraction := DefaultTrimFraction
+		if c.TrimFraction > 0 {
+			fraction = c.TrimFraction
+		}
+		n := int(float64(len(candidates)) * fraction)
+		if n > 0 && len(candidates)-2*n > 0 {
+			for _, w := range candidates[:n] {
+				exclude(w.Sample, typespb.Aggregation_OUTLIER)
+			}
+			for _, w := range candidates[len(candidates)-n:] {
+				exclude(w.Sample, typespb.Aggregation_OUTLIER)
+			}
+			candidates = candidates[n : len(candidates)-n]
+		}
+		value = combine(candidates)
+	case MethodIQR:
+		factor := DefaultIQRFactor
+		if c.IQRFactor > 0 {
+			factor = c.IQRFactor
+		}
+		if len(candidates) >= 4 {
+			q1, q3 := quantile(candidates, 0.25), quantile(candidates, 0.75)
+			lo, hi := q1-factor*(q3-q1), q3+factor*(q3-q1)
+			candidates = slices.DeleteFunc(candidates, func(w weighted) bool {
+				if w.Value < lo || w.Value > hi {
+					exclude(w.Sample, typespb.Aggregation_OUTLIER)
+					return true
+				}
+				return false
+			})
+		}
+		value = combine(candidates)
+	default:
+		value = combine(candidates)
+	}
+
+	for _, w := range candidates {
+		agg.Contributors = append(agg.Contributors, w.Name)
+	}
+	slices.Sort(agg.Contributors)
+	slices.SortFunc(agg.Exclusions, func(a, b *typespb.Aggregation_Exclusion) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return Result{Value: value, OK: len(candidates) > 0, Aggregation: agg}
+}
+
+// totalWeight returns the sum of the weights of ws.
+// If all weights are zero, the weights are treated as equal.
+func totalWeight(ws []weighted) (total float64, equal bool) {
+	for _, w := range ws {
+		total += w.weight
+	}
+	if total == 0 {
+		return float64(len(ws)), true
+	}
+	return total, false
+}
+
+func weightedMean(ws []weighted) float64 {
+	if len(ws) == 0 {
+		return 0
+	}
+	total, equal := totalWeight(ws)
+	var res float64
+	for _, w := range ws {
+		weight := w.weight
+		if equal {
+			weight = 1
+		}
+		res += w.Value * weight / total
+	}
+	return res
+}
+
+func weightedLogMean(ws []weighted) float64 {
+	if len(ws) == 0 {
+		return 0
+	}
+	total, equal := totalWeight(ws)
+	var energy float64
+	for _, w := range ws {
+		weight := w.weight
+		if equal {
+			weight = 1
+		}
+		energy += math.Pow(10, w.Value/10.0) * weight / total
+	}
+	return 10.0 * math.Log10(energy)
+}
+
+func weightedSum(ws []weighted) float64 {
+	var res float64
+	for _, w := range ws {
+		res += w.Value * w.weight
+	}
+	return res
+}
+
+// weightedMedian returns the value at which half of the total weight of ws is reached.
+// ws must be sorted by value.
+func weightedMedian(ws []weighted) float64 {
+	if len(ws) == 0 {
+		return 0
+	}
+	total, equal := totalWeight(ws)
+	var acc float64
+	for i, w := range ws {
+		weight := w.weight
+		if equal {
+			weight = 1
+		}
+		acc += weight
+		switch {
+		case acc > total/2:
+			return w.Value
+		case acc == total/2 && i+1 < len(ws):
+			// exactly half way between two values
+			return (w.Value + ws[i+1].Value) / 2
+		}
+	}
+	return ws[len(ws)-1].Value
+}
+
+// quantile returns the q-th quantile of the values in ws using linear interpolation.
+// ws must be sorted by value and not be empty.
+func quantile(ws []weighted, q float64) float64 {
+	pos := q * float64(len(ws)-1)
+	i := int(pos)
+	if i+1 >= len(ws) {
+		return ws[len(ws)-1].Value
+	}
+	frac := pos - float64(i)
+	return ws[i].Value + frac*(ws[i+1].Value-ws[i].Value)
+}
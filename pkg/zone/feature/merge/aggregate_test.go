@@ -0,0 +1,219 @@
+package merge
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/typespb"
+	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
+)
+
+func TestConfig_Combine(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	samples := func(vals ...float64) []Sample {
+		res := make([]Sample, len(vals))
+		for i, v := range vals {
+			res[i] = Sample{Name: string(rune('a' + i)), Value: v}
+		}
+		return res
+	}
+	excluded := func(reason typespb.Aggregation_Reason, names ...string) []*typespb.Aggregation_Exclusion {
+		var res []*typespb.Aggregation_Exclusion
+		for _, n := range names {
+			res = append(res, &typespb.Aggregation_Exclusion{Name: n, Reason: reason})
+		}
+		return res
+	}
+
+	tests := []struct {
+		name    string
+		cfg     *Config
+		samples []Sample
+		want    float64
+		wantOk  bool
+		wantAgg *typespb.Aggregation
+	}{
+		{name: "empty", cfg: &Config{}, wantAgg: &typespb.Aggregation{}},
+		{name: "nil config", samples: samples(1, 2, 6), want: 3, wantOk: true,
+			wantAgg: &typespb.Aggregation{Contributors: []string{"a", "b", "c"}}},
+		{name: "weighted mean", cfg: &Config{Weights: map[string]float64{"a": 3}}, samples: samples(1, 5), want: 2, wantOk: true,
+			wantAgg: &typespb.Aggregation{Contributors: []string{"a", "b"}}},
+		{name: "zero weights", cfg: &Config{Weights: map[string]float64{"a": 0, "b": 0}}, samples: samples(1, 5), want: 3, wantOk: true,
+			wantAgg: &typespb.Aggregation{Contributors: []string{"a", "b"}}},
+		{name: "median", cfg: &Config{Method: MethodMedian}, samples: samples(1, 2, 100), want: 2, wantOk: true,
+			wantAgg: &typespb.Aggregation{Contributors: []string{"a", "b", "c"}}},
+		{name: "median even", cfg: &Config{Method: MethodMedian}, samples: samples(1, 2, 4, 100), want: 3, wantOk: true,
+			wantAgg: &typespb.Aggregation{Contributors: []string{"a", "b", "c", "d"}}},
+		{name: "weighted median", cfg: &Config{Method: MethodMedian, Weights: map[string]float64{"c": 5}}, samples: samples(1, 2, 100), want: 100, wantOk: true,
+			wantAgg: &typespb.Aggregation{Contributors: []string{"a", "b", "c"}}},
+		{name: "trimmed mean", cfg: &Config{Method: MethodTrimmedMean}, samples: samples(100, 2, 3, 4, -50), want: 3, wantOk: true,
+			wantAgg: &typespb.Aggregation{Contributors: []string{"b", "c", "d"}, Exclusions: excluded(typespb.Aggregation_OUTLIER, "a", "e")}},
+		{name: "trimmed mean too few", cfg: &Config{Method: MethodTrimmedMean}, samples: samples(1, 5), want: 3, wantOk: true,
+			wantAgg: &typespb.Aggregation{Contributors: []string{"a", "b"}}},
+		{name: "iqr", cfg: &Config{Method: MethodIQR}, samples: samples(20, 21, 22, 21, 35), want: 21, wantOk: true,
+			wantAgg: &typespb.Aggregation{Contributors: []string{"a", "b", "c", "d"}, Exclusions: excluded(typespb.Aggregation_OUTLIER, "e")}},
+		{name: "iqr too few", cfg: &Config{Method: MethodIQR}, samples: samples(20, 21, 35), want: 76.0 / 3, wantOk: true,
+			wantAgg: &typespb.Aggregation{Contributors: []string{"a", "b", "c"}}},
+		{name: "stale", cfg: &Config{StaleAfter: &jsontypes.Duration{Duration: time.Minute}},
+			samples: []Sample{{Name: "a", Value: 1, Time: now}, {Name: "b", Value: 100, Time: now.Add(-time.Hour)}, {Name: "c", Value: 3}},
+			want:    2, wantOk: true,
+			wantAgg: &typespb.Aggregation{Contributors: []string{"a", "c"}, Exclusions: excluded(typespb.Aggregation_STALE, "b")}},
+		{name: "all stale", cfg: &Config{StaleAfter: &jsontypes.Duration{Duration: time.Minute}},
+			samples: []Sample{{Name: "a", Value: 1, Time: now.Add(-time.Hour)}},
+			wantAgg: &typespb.Aggregation{Exclusions: excluded(typespb.Aggregation_STALE, "a")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.cfg.Combine(tt.samples, now)
+			if got.OK != tt.wantOk {
+				t.Fatalf("OK = %v, want %v", got.OK, tt.wantOk)
+			}
+			if diff := cmp.Diff(tt.want, got.Value, cmp.Comparer(func(a, b float64) bool { return math.Abs(a-b) < 1e-9 })); diff != "" {
+				t.Errorf("Value (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantAgg, got.Aggregation, protocmp.Transform()); diff != "" {
+				t.Errorf("Aggregation (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConfig_Sum(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	cfg := &Config{
+		Method:     MethodMedian, // ignored
+		Weights:    map[string]float64{"b": 0.5},
+		StaleAfter: &jsontypes.Duration{Duration: time.Minute},
+	}
+	got := cfg.Sum([]Sample{
+		{Name: "a", Value: 10, Time: now},
+		{Name: "b", Value: 10, Time: now},
+		{Name: "c", Value: 10, Time: now.Add(-time.Hour)},
+	}, now)
+	// stale values are still part of the total, meters are cumulative
+	if !got.OK || got.Value != 25 {
+		t.Errorf("Sum = %v (ok=%v), want 25", got.Value, got.OK)
+	}
+	if want := []string{"a", "b", "c"}; !cmp.Equal(got.Aggregation.Contributors, want) {
+		t.Errorf("contributors = %v, want %v", got.Aggregation.Contributors, want)
+	}
+}
+
+func TestConfig_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		wantErr bool
+	}{
+		{"empty", `{}`, false},
+		{"all fields", `{"method":"trimmedMean","weights":{"a":0,"b":2.5},"staleAfter":"1m","trimFraction":0.1,"iqrFactor":3}`, false},
+		{"known methods", `{"method":"iqr"}`, false},
+		{"unknown method", `{"method":"mode"}`, true},
+		{"negative weight", `{"weights":{"a":1,"b":-1}}`, true},
+		{"negative trimFraction", `{"trimFraction":-0.1}`, true},
+		{"trimFraction half", `{"trimFraction":0.5}`, true},
+		{"trimFraction too large", `{"trimFraction":2}`, true},
+		{"negative iqrFactor", `{"iqrFactor":-1}`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg Config
+			err := json.Unmarshal([]byte(tt.json), &cfg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			// feature configs embed Config as a pointer field
+			var featureCfg struct {
+				Aggregation *Config `json:"aggregation"`
+			}
+			err = json.Unmarshal([]byte(`{"aggregation":`+tt.json+`}`), &featureCfg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Unmarshal() feature error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMembers(t *testing.T) {
+	type reading struct{ a, b *float64 }
+	ptr := func(v float64) *float64 { return &v }
+	values := []reading{
+		{a: ptr(1), b: ptr(10)},
+		{a: ptr(2), b: ptr(11)},
+		{a: ptr(3)},
+		{a: ptr(102), b: ptr(12)},
+		{a: ptr(2), b: ptr(50)},
+	}
+	names := []string{"r1", "r2", "r3", "r4", "r5"}
+	field := func(f func(reading) *float64) func(reading) (float64, bool) {
+		return func(r reading) (float64, bool) {
+			if v := f(r); v != nil {
+				return *v, true
+			}
+			return 0, false
+		}
+	}
+	fieldA := field(func(r reading) *float64 { return r.a })
+	fieldB := field(func(r reading) *float64 { return r.b })
+
+	t.Run("nil config", func(t *testing.T) {
+		m := NewMembers(nil, names, values)
+		if got, ok := MeanOf(m, "a", fieldA); !ok || math.Abs(got-22) > 1e-9 {
+			t.Errorf("MeanOf(a) = %v, %v, want 22", got, ok)
+		}
+		if m.Aggregations() != nil {
+			t.Errorf("Aggregations() = %v, want nil", m.Aggregations())
+		}
+		if got := m.Exclusions(); len(got) != 0 {
+			t.Errorf("Exclusions() = %v, want none", got)
+		}
+	})
+
+	t.Run("iqr", func(t *testing.T) {
+		m := NewMembers(&Config{Method: MethodIQR}, names, values)
+		if got, ok := MeanOf(m, "a", fieldA); !ok || got != 2 {
+			t.Errorf("MeanOf(a) = %v, %v, want 2", got, ok)
+		}
+		if got, ok := MeanOf(m, "b", fieldB); !ok || got != 11 {
+			t.Errorf("MeanOf(b) = %v, %v, want 11", got, ok)
+		}
+		if got := m.Aggregations()["a"].GetContributors(); !cmp.Equal(got, []string{"r1", "r2", "r3", "r5"}) {
+			t.Errorf("contributors(a) = %v", got)
+		}
+		want := []*typespb.Aggregation_Exclusion{
+			{Name: "r4", Reason: typespb.Aggregation_OUTLIER},
+			{Name: "r5", Reason: typespb.Aggregation_OUTLIER},
+		}
+		if diff := cmp.Diff(want, m.Exclusions(), protocmp.Transform()); diff != "" {
+			t.Errorf("Exclusions() (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestMembers_StaleTime(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	cfg := &Config{StaleAfter: &jsontypes.Duration{Duration: time.Minute}}
+	m := NewMembersAt(cfg, []string{"a", "b", "c", "d"}, []int{1, 2, 3, 4}, now)
+	m.Times = []time.Time{now.Add(-10 * time.Second), now.Add(-2 * time.Minute), {}, now.Add(-30 * time.Second)}
+	if got, want := m.StaleTime(), now.Add(30*time.Second); !got.Equal(want) {
+		t.Errorf("StaleTime() = %v, want %v", got, want)
+	}
+	// values are stale at exactly StaleTime, so recalculating when a StaleTimer fires excludes them
+	m.Now = now.Add(30 * time.Second)
+	if got, ok := MeanOf(m, "v", func(v int) (int, bool) { return v, true }); !ok || got != 2 {
+		t.Errorf("MeanOf() = %v, %v, want 2 from a and c", got, ok)
+	}
+	if got, want := m.StaleTime(), now.Add(50*time.Second); !got.Equal(want) {
+		t.Errorf("StaleTime() after d is stale = %v, want %v", got, want)
+	}
+
+	if got := NewMembersAt(nil, []string{"a"}, []int{1}, now).StaleTime(); !got.IsZero() {
+		t.Errorf("StaleTime() without StaleAfter = %v, want zero", got)
+	}
+}
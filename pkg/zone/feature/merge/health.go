@@ -0,0 +1,73 @@
+package merge
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
+	"github.com/smart-core-os/sc-bos/pkg/proto/typespb"
+)
+
+// ExclusionCheck is a health check that is abnormal while any group members are excluded from combined values.
+type ExclusionCheck struct {
+	check *healthpb.FaultCheck
+
+	mu   sync.Mutex
+	last string // the details of the last fault, to avoid repeated updates
+}
+
+// NewExclusionCheck creates a new ExclusionCheck for the group called name.
+// Kind identifies the values being combined, like "airTemperature", so a group can have more than one ExclusionCheck.
+func NewExclusionCheck(checks *healthpb.Checks, name, kind string) (*ExclusionCheck, error) {
+	check, err := checks.NewFaultCheck(name, &healthpb.HealthCheck{
+		Id:              kind + "MemberExclusions",
+		DisplayName:     "Member Exclusions",
+		Description:     fmt.Sprintf("Checks that all members contribute to the combined %s value", kind),
+		OccupantImpact:  healthpb.HealthCheck_COMFORT,
+		EquipmentImpact: healthpb.HealthCheck_FUNCTION,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ExclusionCheck{check: check}, nil
+}
+
+// Update sets the health check to report exclusions.
+// The check is normal if exclusions is empty.
+// Update is safe to call on a nil ExclusionCheck, it does nothing.
+func (c *ExclusionCheck) Update(exclusions []*typespb.Aggregation_Exclusion) {
+	if c == nil {
+		return
+	}
+	details := make([]string, len(exclusions))
+	for i, e := range exclusions {
+		details[i] = fmt.Sprintf("%s (%s)", e.GetName(), strings.ToLower(e.GetReason().String()))
+	}
+	detailsText := strings.Join(details, ", ")
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if detailsText == c.last {
+		return
+	}
+	c.last = detailsText
+	if len(exclusions) == 0 {
+		c.check.ClearFaults()
+		return
+	}
+	c.check.SetFault(&healthpb.HealthCheck_Error{
+		SummaryText: fmt.Sprintf("%d members excluded", len(exclusions)),
+		DetailsText: detailsText,
+		Code:        &healthpb.HealthCheck_Error_Code{Code: "MembersExcluded", System: "zone"},
+	})
+}
+
+// Dispose removes the health check.
+// Dispose is safe to call on a nil ExclusionCheck, it does nothing.
+func (c *ExclusionCheck) Dispose() {
+	if c == nil {
+		return
+	}
+	c.check.Dispose()
+}
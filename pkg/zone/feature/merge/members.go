@@ -0,0 +1,164 @@
+package merge
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/smart-core-os/sc-bos/pkg/proto/typespb"
+)
+
+// Members holds the values of named group members so their fields can be combined using a Config.
+// How each field was combined is recorded and available via Aggregations and Exclusions.
+type Members[E any] struct {
+	Config *Config
+	Names  []string
+	Values []E         // Values[i] is the value for Names[i], or the zero E if unknown
+	Times  []time.Time // Times[i] is when Values[i] was received, optional
+	Now    time.Time
+
+	aggregations map[string]*typespb.Aggregation
+}
+
+// NewMembers returns Members for values received now.
+// Set Times if values were received at different times.
+func NewMembers[E any](cfg *Config, names []string, values []E) *Members[E] {
+	return NewMembersAt(cfg, names, values, time.Now())
+}
+
+// NewMembersAt returns Members for values received at now.
+func NewMembersAt[E any](cfg *Config, names []string, values []E, now time.Time) *Members[E] {
+	times := make([]time.Time, len(values))
+	for i := range times {
+		times[i] = now
+	}
+	return &Members[E]{Config: cfg, Names: names, Values: values, Times: times, Now: now}
+}
+
+// StaleTime returns when the next member value that isn't yet stale becomes stale.
+// Returns the zero time if no values will become stale.
+func (m *Members[E]) StaleTime() time.Time {
+	var next time.Time
+	for _, t := range m.Times {
+		at := m.Config.StaleTime(t)
+		if at.IsZero() || !at.After(m.Now) {
+			continue
+		}
+		if next.IsZero() || at.Before(next) {
+			next = at
+		}
+	}
+	return next
+}
+
+// StaleTimer fires when member values become stale, so combined values can be recalculated.
+// The zero StaleTimer is ready to use and never fires until Reset.
+type StaleTimer struct {
+	timer *time.Timer
+}
+
+// C returns the channel the timer fires on.
+func (t *StaleTimer) C() <-chan time.Time {
+	if t.timer == nil {
+		return nil
+	}
+	return t.timer.C
+}
+
+// Reset arranges for the timer to fire at, see Members.StaleTime, stopping it if at is the zero time.
+func (t *StaleTimer) Reset(at, now time.Time) {
+	if at.IsZero() {
+		t.Stop()
+		return
+	}
+	if t.timer == nil {
+		t.timer = time.NewTimer(at.Sub(now))
+		return
+	}
+	t.timer.Reset(at.Sub(now))
+}
+
+// Stop stops the timer.
+func (t *StaleTimer) Stop() {
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+}
+
+// MeanOf combines the field f of each member value using m.Config.
+// If m.Config is nil, MeanOf is equivalent to Mean and no aggregation is recorded.
+func MeanOf[N Number, E any](m *Members[E], field string, f func(E) (N, bool)) (N, bool) {
+	if m.Config == nil {
+		return Mean(m.Values, f)
+	}
+	return record[N](m, field, m.Config.Combine(samples(m, f), m.Now))
+}
+
+// LogMeanOf is like MeanOf but averages values logarithmically, like LogMean.
+func LogMeanOf[N Number, E any](m *Members[E], field string, f func(E) (N, bool)) (N, bool) {
+	if m.Config == nil {
+		return LogMean(m.Values, f)
+	}
+	return record[N](m, field, m.Config.CombineLog(samples(m, f), m.Now))
+}
+
+// SumOf adds the field f of each member value using m.Config.
+// If m.Config is nil, SumOf is equivalent to Sum and no aggregation is recorded.
+func SumOf[N Number, E any](m *Members[E], field string, f func(E) (N, bool)) (N, bool) {
+	if m.Config == nil {
+		return Sum(m.Values, f)
+	}
+	return record[N](m, field, m.Config.Sum(samples(m, f), m.Now))
+}
+
+func samples[N Number, E any](m *Members[E], f func(E) (N, bool)) []Sample {
+	res := make([]Sample, 0, len(m.Values))
+	for i, e := range m.Values {
+		v, ok := f(e)
+		if !ok {
+			continue
+		}
+		s := Sample{Value: float64(v)}
+		if i < len(m.Names) {
+			s.Name = m.Names[i]
+		}
+		if i < len(m.Times) {
+			s.Time = m.Times[i]
+		}
+		res = append(res, s)
+	}
+	return res
+}
+
+func record[N Number, E any](m *Members[E], field string, res Result) (N, bool) {
+	if m.aggregations == nil {
+		m.aggregations = make(map[string]*typespb.Aggregation)
+	}
+	m.aggregations[field] = res.Aggregation
+	return N(res.Value), res.OK
+}
+
+// Aggregations returns how each field was combined, keyed by field name.
+// Returns nil if m.Config is nil.
+func (m *Members[E]) Aggregations() map[string]*typespb.Aggregation {
+	return m.aggregations
+}
+
+// Exclusions returns all members excluded from any field, sorted by name.
+// If a member was excluded for different reasons, the first reason by field name is returned.
+func (m *Members[E]) Exclusions() []*typespb.Aggregation_Exclusion {
+	byName := make(map[string]*typespb.Aggregation_Exclusion)
+	for _, field := range slices.Sorted(maps.Keys(m.aggregations)) {
+		for _, e := range m.aggregations[field].GetExclusions() {
+			if _, ok := byName[e.Name]; !ok {
+				byName[e.Name] = e
+			}
+		}
+	}
+	res := slices.Collect(maps.Values(byName))
+	slices.SortFunc(res, func(a, b *typespb.Aggregation_Exclusion) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return res
+}
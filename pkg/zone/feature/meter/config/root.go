@@ -5,6 +5,7 @@ import (
 
 	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
 	"github.com/smart-core-os/sc-bos/pkg/zone"
+	"github.com/smart-core-os/sc-bos/pkg/zone/feature/merge"
 )
 
 type Root struct {
@@ -13,6 +14,9 @@ type Root struct {
 	Meters        []string            `json:"meters,omitempty"`
 	MeterGroups   map[string][]string `json:"meterGroups,omitempty"`
 	HistoryBackup *HistoryBackup      `json:"HistoryBackup,omitempty"`
+	// Aggregation configures how the readings of Meters and MeterGroups are combined.
+	// Readings are always summed, Aggregation.Method is not used.
+	Aggregation *merge.Config `json:"aggregation,omitempty"`
 }
 
 type HistoryBackup struct {
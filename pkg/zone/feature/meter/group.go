@@ -39,6 +39,9 @@ type Group struct {
 	historyBackupConf *config.HistoryBackup
 	now               func() time.Time
 
+	aggregation *merge.Config
+	exclusions  *merge.ExclusionCheck // may be nil
+
 	logger *zap.Logger
 }
 
@@ -108,7 +111,7 @@ func (g *Group) GetMeterReading(ctx context.Context, request *meterpb.GetMeterRe
 	if err := run.InParallel(ctx, run.DefaultConcurrency, fns...); err != nil {
 		return nil, err
 	}
-	return mergeMeterReading(allRes)
+	return g.mergeMeterReading(merge.NewMembersAt(g.aggregation, g.names, allRes, g.now()))
 }
 
 type nameToError struct {
@@ -218,6 +221,7 @@ func (g *Group) PullMeterReadings(request *meterpb.PullMeterReadingsRequest, ser
 			indexes[name] = i
 		}
 		values := make([]value, len(g.names))
+		times := make([]time.Time, len(g.names))
 
 		var last *meterpb.MeterReading
 		eq := cmp.Equal(cmp.FloatValueApprox(0, 0.001))
@@ -229,7 +233,10 @@ func (g *Group) PullMeterReadings(request *meterpb.PullMeterReadingsRequest, ser
 				return ctx.Err()
 			case change := <-changes:
 				values[indexes[change.name]] = change
-				r, err := mergeMeterReading(values)
+				times[indexes[change.name]] = g.now()
+				members := merge.NewMembersAt(g.aggregation, g.names, values, g.now())
+				members.Times = times
+				r, err := g.mergeMeterReading(members)
 				if err != nil {
 					continue
 				}
@@ -292,7 +299,16 @@ type value struct {
 	err  error
 }
 
-func mergeMeterReading(all []value) (*meterpb.MeterReading, error) {
+func (g *Group) mergeMeterReading(m *merge.Members[value]) (*meterpb.MeterReading, error) {
+	r, err := mergeMeterReading(m)
+	if err == nil {
+		g.exclusions.Update(m.Exclusions())
+	}
+	return r, err
+}
+
+func mergeMeterReading(m *merge.Members[value]) (*meterpb.MeterReading, error) {
+	all := m.Values
 	switch len(all) {
 	case 0:
 		return nil, status.Error(codes.FailedPrecondition, "zone has no meter names")
@@ -312,7 +328,7 @@ func mergeMeterReading(all []value) (*meterpb.MeterReading, error) {
 		}
 
 		out := &meterpb.MeterReading{}
-		out.Usage, _ = merge.Sum(all, func(v value) (float32, bool) {
+		out.Usage, _ = merge.SumOf(m, "usage", func(v value) (float32, bool) {
 			if v.err != nil || v.val == nil {
 				return 0, false
 			}
@@ -330,6 +346,7 @@ func mergeMeterReading(all []value) (*meterpb.MeterReading, error) {
 			}
 			return v.val.EndTime
 		})
+		out.Aggregations = m.Aggregations()
 		return out, nil
 	}
 }
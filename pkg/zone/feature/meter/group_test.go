@@ -18,6 +18,7 @@ import (
 	"github.com/smart-core-os/sc-bos/pkg/proto/historypb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/meterpb"
 	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
+	"github.com/smart-core-os/sc-bos/pkg/zone/feature/merge"
 	"github.com/smart-core-os/sc-bos/pkg/zone/feature/meter/config"
 )
 
@@ -493,7 +494,7 @@ func Test_mergeMeterReading(t *testing.T) {
 			name = strings.Join(names, ",")
 		}
 		t.Run(name, func(t *testing.T) {
-			got, err := mergeMeterReading(tt.in)
+			got, err := mergeMeterReading(merge.NewMembers(nil, nil, tt.in))
 			if (err != nil) != tt.wantErr {
 				t.Errorf("mergeMeterReading() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -8,9 +8,11 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/meterpb"
 	"github.com/smart-core-os/sc-bos/pkg/task/service"
 	"github.com/smart-core-os/sc-bos/pkg/zone"
+	"github.com/smart-core-os/sc-bos/pkg/zone/feature/merge"
 	"github.com/smart-core-os/sc-bos/pkg/zone/feature/meter/config"
 )
 
@@ -20,6 +22,7 @@ var Feature = zone.FactoryFunc(func(services zone.Services) service.Lifecycle {
 		announcer: node.NewReplaceAnnouncer(services.Node),
 		devices:   services.Devices,
 		clients:   services.Node,
+		health:    services.Health,
 		logger:    services.Logger,
 	}
 	f.Service = service.New(service.MonoApply(f.applyConfig), service.WithParser(config.ParseConfig))
@@ -31,6 +34,7 @@ type feature struct {
 	announcer *node.ReplaceAnnouncer
 	devices   *zone.Devices
 	clients   node.ClientConner
+	health    *healthpb.Checks
 	logger    *zap.Logger
 }
 
@@ -42,9 +46,9 @@ func (f *feature) applyConfig(ctx context.Context, cfg config.Root) error {
 	apiClient := meterpb.NewMeterApiClient(conn)
 	infoClient := meterpb.NewMeterInfoClient(conn)
 	historyClient := meterpb.NewMeterHistoryClient(conn)
-	announceGroup := func(name string, devices []string) {
+	announceGroup := func(name string, devices []string) error {
 		if len(devices) == 0 {
-			return
+			return nil
 		}
 
 		group := &Group{
@@ -57,6 +61,15 @@ func (f *feature) applyConfig(ctx context.Context, cfg config.Root) error {
 			now: time.Now,
 
 			historyBackupConf: cfg.HistoryBackup,
+			aggregation:       cfg.Aggregation,
+		}
+		if f.health != nil && cfg.Aggregation != nil {
+			exclusions, err := merge.NewExclusionCheck(f.health, name, "meterReading")
+			if err != nil {
+				return err
+			}
+			context.AfterFunc(ctx, exclusions.Dispose)
+			group.exclusions = exclusions
 		}
 		f.devices.Add(devices...)
 		announce.Announce(name,
@@ -64,11 +77,16 @@ func (f *feature) applyConfig(ctx context.Context, cfg config.Root) error {
 			node.HasServer(meterpb.RegisterMeterInfoServer, meterpb.MeterInfoServer(group)),
 			node.HasTrait(meterpb.TraitName),
 		)
+		return nil
 	}
 
-	announceGroup(cfg.Name, cfg.Meters)
+	if err := announceGroup(cfg.Name, cfg.Meters); err != nil {
+		return err
+	}
 	for name, meters := range cfg.MeterGroups {
-		announceGroup(path.Join(cfg.Name, name), meters)
+		if err := announceGroup(path.Join(cfg.Name, name), meters); err != nil {
+			return err
+		}
 	}
 
 	return nil
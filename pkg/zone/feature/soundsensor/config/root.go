@@ -2,11 +2,14 @@ package config
 
 import (
 	"github.com/smart-core-os/sc-bos/pkg/zone"
+	"github.com/smart-core-os/sc-bos/pkg/zone/feature/merge"
 )
 
 type Root struct {
 	zone.Config
 
 	SoundSensors []string `json:"soundSensors,omitempty"`
+	// Aggregation configures how the values of SoundSensors are combined.
+	// Defaults to an unweighted logarithmic mean.
+	Aggregation *merge.Config `json:"aggregation,omitempty"`
 }
-
@@ -2,6 +2,7 @@ package soundsensor
 
 import (
 	"context"
+	"time"
 
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
@@ -24,6 +25,9 @@ type Group struct {
 	client soundsensorpb.SoundSensorApiClient
 	names  []string
 
+	aggregation *merge.Config
+	exclusions  *merge.ExclusionCheck // may be nil
+
 	logger *zap.Logger
 }
 
@@ -48,7 +52,7 @@ func (g *Group) GetSoundLevel(ctx context.Context, request *soundsensorpb.GetSou
 			g.logger.Warn("some sound sensors failed to get", zap.Errors("errors", multierr.Errors(err)))
 		}
 	}
-	return mergeSoundLevel(allRes)
+	return g.mergeSoundLevel(merge.NewMembers(g.aggregation, g.names, allRes))
 }
 
 func (g *Group) PullSoundLevel(request *soundsensorpb.PullSoundLevelRequest, server soundsensorpb.SoundSensorApi_PullSoundLevelServer) error {
@@ -106,10 +110,13 @@ func (g *Group) PullSoundLevel(request *soundsensorpb.PullSoundLevelRequest, ser
 			indexes[name] = i
 		}
 		values := make([]*soundsensorpb.SoundLevel, len(g.names))
+		times := make([]time.Time, len(g.names))
 
 		var last *soundsensorpb.SoundLevel
 		eq := cmp.Equal(cmp.FloatValueApprox(0, 0.001))
 		filter := masks.NewResponseFilter(masks.WithFieldMask(request.ReadMask))
+		var staleTimer merge.StaleTimer
+		defer staleTimer.Stop()
 
 		for {
 			select {
@@ -117,26 +124,32 @@ func (g *Group) PullSoundLevel(request *soundsensorpb.PullSoundLevelRequest, ser
 				return ctx.Err()
 			case change := <-changes:
 				values[indexes[change.name]] = change.val
-				r, err := mergeSoundLevel(values)
-				if err != nil {
-					return err
-				}
-				filter.Filter(r)
-
-				// don't send duplicates
-				if eq(last, r) {
-					continue
-				}
-				last = r
-
-				err = server.Send(&soundsensorpb.PullSoundLevelResponse{Changes: []*soundsensorpb.PullSoundLevelResponse_Change{{
-					Name:       request.Name,
-					ChangeTime: timestamppb.Now(),
-					SoundLevel: r,
-				}}})
-				if err != nil {
-					return err
-				}
+				times[indexes[change.name]] = time.Now()
+			case <-staleTimer.C():
+				// recalculate now some values are stale
+			}
+			members := merge.NewMembers(g.aggregation, g.names, values)
+			members.Times = times
+			staleTimer.Reset(members.StaleTime(), members.Now)
+			r, err := g.mergeSoundLevel(members)
+			if err != nil {
+				return err
+			}
+			filter.Filter(r)
+
+			// don't send duplicates
+			if eq(last, r) {
+				continue
+			}
+			last = r
+
+			err = server.Send(&soundsensorpb.PullSoundLevelResponse{Changes: []*soundsensorpb.PullSoundLevelResponse_Change{{
+				Name:       request.Name,
+				ChangeTime: timestamppb.Now(),
+				SoundLevel: r,
+			}}})
+			if err != nil {
+				return err
 			}
 		}
 	})
@@ -144,16 +157,25 @@ func (g *Group) PullSoundLevel(request *soundsensorpb.PullSoundLevelRequest, ser
 	return group.Wait()
 }
 
-func mergeSoundLevel(all []*soundsensorpb.SoundLevel) (*soundsensorpb.SoundLevel, error) {
-	switch len(all) {
-	case 0:
+func (g *Group) mergeSoundLevel(m *merge.Members[*soundsensorpb.SoundLevel]) (*soundsensorpb.SoundLevel, error) {
+	r, err := mergeSoundLevel(m)
+	if err == nil {
+		g.exclusions.Update(m.Exclusions())
+	}
+	return r, err
+}
+
+func mergeSoundLevel(m *merge.Members[*soundsensorpb.SoundLevel]) (*soundsensorpb.SoundLevel, error) {
+	all := m.Values
+	switch {
+	case len(all) == 0:
 		return nil, status.Errorf(codes.FailedPrecondition, "zone has no sound sensor names")
-	case 1:
+	case len(all) == 1 && m.Config == nil:
 		return all[0], nil
 	default:
 		out := &soundsensorpb.SoundLevel{}
 		// SoundPressureLevel - use logarithmic average (mean energy)
-		if val, ok := merge.LogMean(all, func(e *soundsensorpb.SoundLevel) (float32, bool) {
+		if val, ok := merge.LogMeanOf(m, "sound_pressure_level", func(e *soundsensorpb.SoundLevel) (float32, bool) {
 			if e == nil || e.SoundPressureLevel == nil {
 				return 0, false
 			}
@@ -161,7 +183,7 @@ func mergeSoundLevel(all []*soundsensorpb.SoundLevel) (*soundsensorpb.SoundLevel
 		}); ok {
 			out.SoundPressureLevel = &val
 		}
+		out.Aggregations = m.Aggregations()
 		return out, nil
 	}
 }
-
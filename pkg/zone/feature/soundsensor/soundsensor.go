@@ -6,9 +6,11 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/smart-core-os/sc-bos/pkg/node"
+	"github.com/smart-core-os/sc-bos/pkg/proto/healthpb"
 	"github.com/smart-core-os/sc-bos/pkg/proto/soundsensorpb"
 	"github.com/smart-core-os/sc-bos/pkg/task/service"
 	"github.com/smart-core-os/sc-bos/pkg/zone"
+	"github.com/smart-core-os/sc-bos/pkg/zone/feature/merge"
 	"github.com/smart-core-os/sc-bos/pkg/zone/feature/soundsensor/config"
 )
 
@@ -18,6 +20,7 @@ var Feature = zone.FactoryFunc(func(services zone.Services) service.Lifecycle {
 		announcer: node.NewReplaceAnnouncer(services.Node),
 		devices:   services.Devices,
 		clients:   services.Node,
+		health:    services.Health,
 		logger:    services.Logger,
 	}
 	f.Service = service.New(service.MonoApply(f.applyConfig))
@@ -29,6 +32,7 @@ type feature struct {
 	announcer *node.ReplaceAnnouncer
 	devices   *zone.Devices
 	clients   node.ClientConner
+	health    *healthpb.Checks
 	logger    *zap.Logger
 }
 
@@ -38,9 +42,18 @@ func (f *feature) applyConfig(ctx context.Context, cfg config.Root) error {
 
 	if len(cfg.SoundSensors) > 0 {
 		group := &Group{
-			client: soundsensorpb.NewSoundSensorApiClient(f.clients.ClientConn()),
-			names:  cfg.SoundSensors,
-			logger: logger,
+			client:      soundsensorpb.NewSoundSensorApiClient(f.clients.ClientConn()),
+			names:       cfg.SoundSensors,
+			aggregation: cfg.Aggregation,
+			logger:      logger,
+		}
+		if f.health != nil && cfg.Aggregation != nil {
+			exclusions, err := merge.NewExclusionCheck(f.health, cfg.Name, "soundLevel")
+			if err != nil {
+				return err
+			}
+			context.AfterFunc(ctx, exclusions.Dispose)
+			group.exclusions = exclusions
 		}
 
 		f.devices.Add(cfg.SoundSensors...)
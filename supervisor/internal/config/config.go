@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/smart-core-os/sc-bos/pkg/util/jsontypes"
+	"github.com/smart-core-os/sc-bos/supervisor/internal/install"
 )
 
 // A rough approximation of rules for these identifiers - excludes invalid characters but doesn't exactly match the
@@ -19,6 +20,8 @@ import (
 var (
 	imageRepoPattern = regexp.MustCompile(`^[a-zA-Z0-9._/:-]+$`)
 	unitNamePattern  = regexp.MustCompile(`^[a-zA-Z0-9._:@-]+$`)
+	installerPattern = regexp.MustCompile(`^(podman|binary)$`)
+	restartPattern   = regexp.MustCompile(`^(systemctl|dbus)$`)
 )
 
 // Config is the Supervisor's runtime configuration. Every option is optional in the config file; an
@@ -29,9 +32,17 @@ type Config struct {
 	// StateDir holds durable state: the update record at <StateDir>/state.json and downloaded artefacts
 	// under <StateDir>/staging.
 	StateDir string `json:"stateDir,omitempty"`
-	// ImageRepo is the image repository whose :current/:previous tags the installer swaps.
+	// Installer selects how updates are installed: "podman" swaps image tags, "binary" unpacks artefacts
+	// into A/B directories under InstallDir for BOS running as a plain systemd service.
+	Installer string `json:"installer,omitempty"`
+	// ImageRepo is the image repository whose :current/:previous tags the podman installer swaps.
 	ImageRepo string `json:"imageRepo,omitempty"`
-	// Unit is the systemd unit (and container name) for BOS that the Supervisor restarts.
+	// InstallDir is where the binary installer unpacks versions and maintains the current symlink.
+	InstallDir string `json:"installDir,omitempty"`
+	// Restart selects how the binary installer restarts Unit: "systemctl" or "dbus".
+	Restart string `json:"restart,omitempty"`
+	// Unit is the systemd unit (and container name) for BOS that the Supervisor restarts. As with systemctl, a unit
+	// without a type suffix is a ".service".
 	Unit string `json:"unit,omitempty"`
 	// CommitDeadline bounds how long reconcile waits for BOS to Commit a new version before rolling back.
 	// It is a Go duration string, e.g. "2m" or "90s".
@@ -39,6 +50,10 @@ type Config struct {
 	// AllowInsecureDownloads permits artefact download URLs that do not use HTTPS. It defaults to false
 	// (HTTPS only); enable it only for development against a plain-HTTP update server such as cloudsim.
 	AllowInsecureDownloads bool `json:"allowInsecureDownloads,omitempty"`
+	// SigningKey is the base64 encoded Ed25519 public key that artefacts must be signed by. When set,
+	// every update must carry a signature that verifies against it before the artefact is installed.
+	// It is required by the binary installer, which would otherwise unpack and run unverified code.
+	SigningKey string `json:"signingKey,omitempty"`
 }
 
 // Default returns the configuration used when no config file is present, and for any option a present
@@ -47,7 +62,10 @@ func Default() Config {
 	return Config{
 		Socket:                 "/run/sc-bos-supervisor/supervisor.sock",
 		StateDir:               "/var/lib/sc-bos-supervisor",
+		Installer:              "podman",
 		ImageRepo:              "localhost/smartcore/bos",
+		InstallDir:             "/opt/sc-bos",
+		Restart:                "systemctl",
 		Unit:                   "sc-bos",
 		CommitDeadline:         jsontypes.Duration{Duration: 2 * time.Minute},
 		AllowInsecureDownloads: false,
@@ -57,7 +75,8 @@ func Default() Config {
 // Load reads configuration from path, overlaying any options it sets onto the defaults. A missing file
 // is not an error - the defaults are returned. A file that cannot be opened, parsed, or whose values are
 // invalid (unknown field, unparseable duration, non-positive commitDeadline, a required field set to
-// empty, or a malformed imageRepo or unit) is an error.
+// empty, a malformed imageRepo or unit, an unknown installer or restart, or a missing or malformed
+// signingKey) is an error.
 func Load(path string) (Config, error) {
 	cfg := Default()
 
@@ -87,6 +106,9 @@ func Load(path string) (Config, error) {
 		{name: "stateDir", value: cfg.StateDir},
 		{name: "imageRepo", value: cfg.ImageRepo, valid: imageRepoPattern, what: "a valid image repository path"},
 		{name: "unit", value: cfg.Unit, valid: unitNamePattern, what: "a valid systemd unit name"},
+		{name: "installer", value: cfg.Installer, valid: installerPattern, what: `"podman" or "binary"`},
+		{name: "installDir", value: cfg.InstallDir},
+		{name: "restart", value: cfg.Restart, valid: restartPattern, what: `"systemctl" or "dbus"`},
 	} {
 		if f.value == "" {
 			return Config{}, fmt.Errorf("invalid config %s: %s must not be empty", path, f.name)
@@ -101,5 +123,13 @@ func Load(path string) (Config, error) {
 	if cfg.CommitDeadline.Duration <= 0 {
 		return Config{}, fmt.Errorf("invalid config %s: commitDeadline must be positive, got %s", path, cfg.CommitDeadline.Duration)
 	}
+	if cfg.Installer == "binary" && cfg.SigningKey == "" {
+		return Config{}, fmt.Errorf("invalid config %s: signingKey is required by the binary installer", path)
+	}
+	if cfg.SigningKey != "" {
+		if _, err := install.ParsePublicKey(cfg.SigningKey); err != nil {
+			return Config{}, fmt.Errorf("invalid config %s: signingKey: %w", path, err)
+		}
+	}
 	return cfg, nil
 }
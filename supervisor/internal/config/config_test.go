@@ -47,6 +47,12 @@ func TestLoad_InvalidIsError(t *testing.T) {
 		"unit slash":             `{"unit": "bad/unit"}`,
 		"unit space":             `{"unit": "bad unit"}`,
 		"unit punctuation":       `{"unit": "bad!unit"}`,
+		"unknown installer":      `{"installer": "rpm"}`,
+		"empty install dir":      `{"installDir": ""}`,
+		"unknown restart":        `{"restart": "reboot"}`,
+		"binary without key":     `{"installer": "binary"}`,
+		"key not base64":         `{"signingKey": "not base64!"}`,
+		"key wrong length":       `{"signingKey": "AAAA"}`,
 	} {
 		t.Run(name, func(t *testing.T) {
 			if _, err := Load(writeConfig(t, content)); err == nil {
@@ -93,10 +99,14 @@ func TestLoad_FullConfig(t *testing.T) {
 	cfg, err := Load(writeConfig(t, `{
 		"socket": "/tmp/s.sock",
 		"stateDir": "/tmp/state",
+		"installer": "binary",
 		"imageRepo": "localhost/foo/bar",
+		"installDir": "/srv/bos",
+		"restart": "dbus",
 		"unit": "foo",
 		"commitDeadline": "90s",
-		"allowInsecureDownloads": true
+		"allowInsecureDownloads": true,
+		"signingKey": "11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="
 	}`))
 	if err != nil {
 		t.Fatal(err)
@@ -105,10 +115,14 @@ func TestLoad_FullConfig(t *testing.T) {
 	want := Config{
 		Socket:                 "/tmp/s.sock",
 		StateDir:               "/tmp/state",
+		Installer:              "binary",
 		ImageRepo:              "localhost/foo/bar",
+		InstallDir:             "/srv/bos",
+		Restart:                "dbus",
 		Unit:                   "foo",
 		CommitDeadline:         jsontypes.Duration{Duration: 90 * time.Second},
 		AllowInsecureDownloads: true,
+		SigningKey:             "11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo=",
 	}
 	if diff := cmp.Diff(want, cfg); diff != "" {
 		t.Errorf("config mismatch (-want +got):\n%s", diff)
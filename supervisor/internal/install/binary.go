@@ -0,0 +1,279 @@
+package install
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// slotA and slotB are the directories, under the install dir, that versions are unpacked into.
+	slotA = "a"
+	slotB = "b"
+	// currentLink is the symlink, under the install dir, pointing at the slot the BOS unit runs from.
+	currentLink = "current"
+	// previousLink is the symlink, under the install dir, pointing at the slot current pointed at before
+	// the last Apply switched it. It is what Rollback switches back to, and is removed once used.
+	previousLink = "previous"
+	// versionFile records the version held by a slot. It is written after the slot is fully unpacked, so
+	// a slot without it is incomplete and never selected.
+	versionFile = ".supervisor-version"
+)
+
+// BinaryInstaller implements Installer for hosts that run BOS as a plain systemd service rather than a
+// container.
+//
+// Versions are unpacked into one of two slot directories, <dir>/a and <dir>/b, and the <dir>/current
+// symlink points at the slot the unit runs from, so the unit's ExecStart should reference paths under
+// <dir>/current. Applying a version unpacks it into the slot current doesn't point at, points the
+// <dir>/previous symlink at the slot being replaced, then switches current; Rollback switches current
+// back to the slot previous records. This mirrors the :current/:previous tags of PodmanInstaller.
+type BinaryInstaller struct {
+	dir    string // holds the slots and the current symlink, e.g. "/opt/sc-bos"
+	unit   string // systemd unit for BOS, e.g. "sc-bos"
+	logger *zap.Logger
+
+	// run executes an external command, see PodmanInstaller.run.
+	run commandRunner
+	// restartUnit restarts the BOS unit. It is chosen by the RestartMethod.
+	restartUnit func(ctx context.Context) error
+}
+
+// NewBinaryInstaller returns an Installer that unpacks artefacts, which are tarballs optionally
+// compressed with gzip, under dir and restarts unit using method.
+func NewBinaryInstaller(dir, unit string, method RestartMethod, logger *zap.Logger) *BinaryInstaller {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	b := &BinaryInstaller{
+		dir:    dir,
+		unit:   unit,
+		logger: logger,
+		run:    execRunner(logger),
+	}
+	switch method {
+	case RestartDBus:
+		b.restartUnit = func(ctx context.Context) error { return restartUnitDBus(ctx, fullUnitName(unit)) }
+	default:
+		b.restartUnit = b.systemctlRestart
+	}
+	return b
+}
+
+func (b *BinaryInstaller) Apply(ctx context.Context, artefactPath, version string) error {
+	current, err := b.currentSlot()
+	if err != nil {
+		return err
+	}
+	// As with the Podman tag swap, skip the switch when version is already current - a resume after the
+	// switch - so the other slot keeps the previous good version to roll back to.
+	if current != "" && b.slotVersion(current) == version {
+		return b.restart(ctx)
+	}
+	target := otherSlot(current)
+	// The target slot may already hold version if a previous attempt stopped before the switch.
+	if b.slotVersion(target) != version {
+		if err := b.unpack(artefactPath, target, version); err != nil {
+			return fmt.Errorf("unpack artefact: %w", err)
+		}
+	}
+	// Record the slot being replaced before switching, so Rollback returns to it rather than guessing.
+	if current != "" {
+		if err := b.setLink(previousLink, current); err != nil {
+			return fmt.Errorf("point previous at slot %s: %w", current, err)
+		}
+	}
+	if err := b.setLink(currentLink, target); err != nil {
+		return fmt.Errorf("switch current to slot %s: %w", target, err)
+	}
+	return b.restart(ctx)
+}
+
+func (b *BinaryInstaller) Rollback(ctx context.Context) error {
+	current, err := b.currentSlot()
+	if err != nil {
+		return err
+	}
+	previous, err := b.readSlotLink(previousLink)
+	if err != nil {
+		return err
+	}
+	// A failed first install, or one already rolled back, has no previous slot to return to. Previous can
+	// also equal current if an Apply stopped between recording it and switching current.
+	if previous == "" || previous == current || b.slotVersion(previous) == "" {
+		return errors.New("no previous version to roll back to")
+	}
+	if err := b.setLink(currentLink, previous); err != nil {
+		return fmt.Errorf("switch current to previous slot %s: %w", previous, err)
+	}
+	// The slot rolled back from holds the bad version, so it must not become a rollback target.
+	if err := os.Remove(filepath.Join(b.dir, previousLink)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("remove previous link: %w", err)
+	}
+	return b.restart(ctx)
+}
+
+// currentSlot returns the slot the current symlink points at, or "" if there is no symlink yet.
+func (b *BinaryInstaller) currentSlot() (string, error) {
+	return b.readSlotLink(currentLink)
+}
+
+// readSlotLink returns the slot the symlink name points at, or "" if there is no such symlink.
+func (b *BinaryInstaller) readSlotLink(name string) (string, error) {
+	target, err := os.Readlink(filepath.Join(b.dir, name))
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("read %s link: %w", name, err)
+	}
+	switch slot := filepath.Base(target); slot {
+	case slotA, slotB:
+		return slot, nil
+	default:
+		return "", fmt.Errorf("%s link points at %q, not a slot", name, target)
+	}
+}
+
+// slotVersion returns the version held by slot, or "" if the slot is empty or incomplete.
+func (b *BinaryInstaller) slotVersion(slot string) string {
+	data, err := os.ReadFile(filepath.Join(b.dir, slot, versionFile))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// otherSlot returns the slot that isn't slot. The first install, with no current slot, uses slot a.
+func otherSlot(slot string) string {
+	if slot == slotA {
+		return slotB
+	}
+	return slotA
+}
+
+// unpack replaces the contents of slot with the artefact, recording version once it is complete.
+func (b *BinaryInstaller) unpack(artefactPath, slot, version string) error {
+	dst := filepath.Join(b.dir, slot)
+	if err := os.RemoveAll(dst); err != nil {
+		return fmt.Errorf("clear slot: %w", err)
+	}
+	if err := os.MkdirAll(dst, 0o755); err != nil {
+		return err
+	}
+	f, err := os.Open(artefactPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := extractTar(f, dst); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dst, versionFile), []byte(version+"\n"), 0o644)
+}
+
+// setLink atomically points the symlink name at slot, by renaming a new symlink over it.
+func (b *BinaryInstaller) setLink(name, slot string) error {
+	link := filepath.Join(b.dir, name)
+	tmp := link + ".tmp"
+	if err := os.Remove(tmp); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if err := os.Symlink(slot, tmp); err != nil {
+		return err
+	}
+	return os.Rename(tmp, link)
+}
+
+func (b *BinaryInstaller) restart(ctx context.Context) error {
+	if err := b.restartUnit(ctx); err != nil {
+		return fmt.Errorf("restart %s: %w", b.unit, err)
+	}
+	return nil
+}
+
+func (b *BinaryInstaller) systemctlRestart(ctx context.Context) error {
+	_, err := b.run(ctx, "systemctl", "restart", b.unit)
+	return err
+}
+
+// extractTar unpacks the tar stream r, which may be gzip compressed, into dst.
+// Only directories, regular files, and symlinks are supported. Entries, or symlink targets, that would
+// escape dst are rejected, and writes are made through an os.Root so an entry can't escape dst by way of a
+// symlink unpacked before it.
+func extractTar(r io.Reader, dst string) error {
+	br := bufio.NewReader(r)
+	if magic, _ := br.Peek(2); bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return fmt.Errorf("gzip: %w", err)
+		}
+		defer zr.Close()
+		r = zr
+	} else {
+		r = br
+	}
+
+	root, err := os.OpenRoot(dst)
+	if err != nil {
+		return err
+	}
+	defer root.Close()
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read tar: %w", err)
+		}
+		name := filepath.Clean(filepath.FromSlash(hdr.Name))
+		if !filepath.IsLocal(name) {
+			return fmt.Errorf("entry %q is outside the artefact", hdr.Name)
+		}
+		if err := extractEntry(root, tr, hdr, name); err != nil {
+			return fmt.Errorf("entry %q: %w", hdr.Name, err)
+		}
+	}
+}
+
+func extractEntry(root *os.Root, r io.Reader, hdr *tar.Header, name string) error {
+	mode := hdr.FileInfo().Mode().Perm()
+	if hdr.Typeflag == tar.TypeDir {
+		return root.MkdirAll(name, mode|0o700)
+	}
+	if err := root.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return err
+	}
+	switch hdr.Typeflag {
+	case tar.TypeReg:
+		f, err := root.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(f, r); err != nil {
+			return errors.Join(err, f.Close())
+		}
+		return f.Close()
+	case tar.TypeSymlink:
+		if filepath.IsAbs(hdr.Linkname) || !filepath.IsLocal(filepath.Join(filepath.Dir(name), hdr.Linkname)) {
+			return errors.New("symlink points outside the artefact")
+		}
+		return root.Symlink(hdr.Linkname, name)
+	default:
+		return fmt.Errorf("unsupported type %q", hdr.Typeflag)
+	}
+}
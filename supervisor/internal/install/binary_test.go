@@ -0,0 +1,289 @@
+package install
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+// tarEntry is one entry of a test artefact. An entry with a link is a symlink, a name ending in / is a
+// directory, and anything else is a regular file holding body.
+type tarEntry struct {
+	name string
+	body string
+	link string
+}
+
+// writeArtefact writes a tarball of entries to a temporary file, returning its path.
+func writeArtefact(t *testing.T, compress bool, entries ...tarEntry) string {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, e := range entries {
+		hdr := &tar.Header{Name: e.name, Mode: 0o755, Typeflag: tar.TypeReg, Size: int64(len(e.body))}
+		switch {
+		case e.link != "":
+			hdr.Typeflag, hdr.Linkname, hdr.Size = tar.TypeSymlink, e.link, 0
+		case strings.HasSuffix(e.name, "/"):
+			hdr.Typeflag, hdr.Size = tar.TypeDir, 0
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(e.body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	if compress {
+		var gz bytes.Buffer
+		zw := gzip.NewWriter(&gz)
+		if _, err := zw.Write(data); err != nil {
+			t.Fatal(err)
+		}
+		if err := zw.Close(); err != nil {
+			t.Fatal(err)
+		}
+		data = gz.Bytes()
+	}
+	path := filepath.Join(t.TempDir(), "artefact.tar")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// release is an artefact containing a BOS binary that prints version.
+func release(t *testing.T, version string) string {
+	t.Helper()
+	return writeArtefact(t, true,
+		tarEntry{name: "bin/"},
+		tarEntry{name: "bin/sc-bos", body: "sc-bos " + version},
+		tarEntry{name: "sc-bos", link: "bin/sc-bos"},
+	)
+}
+
+func newTestBinaryInstaller(t *testing.T, dir string, steps ...step) *BinaryInstaller {
+	t.Helper()
+	f := &fakeRunner{t: t, steps: steps}
+	t.Cleanup(func() {
+		if !t.Failed() && f.next != len(f.steps) {
+			t.Errorf("ran %d commands, want %d", f.next, len(f.steps))
+		}
+	})
+	b := &BinaryInstaller{dir: dir, unit: testUnit, logger: zap.NewNop(), run: f.run}
+	b.restartUnit = b.systemctlRestart
+	return b
+}
+
+var restartUnit = []string{"systemctl", "restart", testUnit}
+
+// assertRunning checks that the BOS binary under current is the one for version.
+func assertRunning(t *testing.T, dir, version string) {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join(dir, currentLink, "sc-bos"))
+	if err != nil {
+		t.Fatalf("read current binary: %v", err)
+	}
+	if got, want := string(data), "sc-bos "+version; got != want {
+		t.Errorf("current binary = %q, want %q", got, want)
+	}
+}
+
+// A first install unpacks into slot a, points current at it, and restarts the unit. There is nothing to
+// roll back to.
+func TestBinaryApply_FirstInstall(t *testing.T) {
+	dir := t.TempDir()
+	b := newTestBinaryInstaller(t, dir, expect("", restartUnit...))
+
+	if err := b.Apply(context.Background(), release(t, "v1"), "v1"); err != nil {
+		t.Fatalf("Apply() error = %v", err)
+	}
+	assertRunning(t, dir, "v1")
+	info, err := os.Stat(filepath.Join(dir, slotA, "bin", "sc-bos"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0o755 {
+		t.Errorf("binary mode = %v, want 0755", info.Mode().Perm())
+	}
+	if err := b.Rollback(context.Background()); err == nil || !strings.Contains(err.Error(), "no previous version") {
+		t.Errorf("Rollback() error = %v, want no previous version error", err)
+	}
+}
+
+// An upgrade unpacks into the other slot, keeping the previous version for Rollback, which switches back.
+// The slot rolled back from still holds a complete version, but is never rolled back to.
+func TestBinaryApply_UpgradeAndRollback(t *testing.T) {
+	dir := t.TempDir()
+	b := newTestBinaryInstaller(t, dir,
+		expect("", restartUnit...),
+		expect("", restartUnit...),
+		expect("", restartUnit...),
+	)
+	ctx := context.Background()
+
+	if err := b.Apply(ctx, release(t, "v1"), "v1"); err != nil {
+		t.Fatalf("Apply(v1) error = %v", err)
+	}
+	if err := b.Apply(ctx, release(t, "v2"), "v2"); err != nil {
+		t.Fatalf("Apply(v2) error = %v", err)
+	}
+	assertRunning(t, dir, "v2")
+	if got := b.slotVersion(slotB); got != "v2" {
+		t.Errorf("slot b version = %q, want v2", got)
+	}
+
+	if err := b.Rollback(ctx); err != nil {
+		t.Fatalf("Rollback() error = %v", err)
+	}
+	assertRunning(t, dir, "v1")
+
+	if err := b.Rollback(ctx); err == nil || !strings.Contains(err.Error(), "no previous version") {
+		t.Errorf("second Rollback() error = %v, want no previous version error", err)
+	}
+	assertRunning(t, dir, "v1")
+}
+
+// Re-applying the current version only restarts, leaving the previous version in place to roll back to.
+func TestBinaryApply_AlreadyCurrentSkipsSwitch(t *testing.T) {
+	dir := t.TempDir()
+	b := newTestBinaryInstaller(t, dir,
+		expect("", restartUnit...),
+		expect("", restartUnit...),
+		expect("", restartUnit...),
+	)
+	ctx := context.Background()
+	v2 := release(t, "v2")
+
+	if err := b.Apply(ctx, release(t, "v1"), "v1"); err != nil {
+		t.Fatalf("Apply(v1) error = %v", err)
+	}
+	if err := b.Apply(ctx, v2, "v2"); err != nil {
+		t.Fatalf("Apply(v2) error = %v", err)
+	}
+	if err := b.Apply(ctx, v2, "v2"); err != nil {
+		t.Fatalf("Apply(v2) again error = %v", err)
+	}
+	assertRunning(t, dir, "v2")
+	if got := b.slotVersion(slotA); got != "v1" {
+		t.Errorf("slot a version = %q, want v1", got)
+	}
+}
+
+// A failed unpack leaves current, and the version it points at, untouched.
+func TestBinaryApply_UnpackFails(t *testing.T) {
+	dir := t.TempDir()
+	b := newTestBinaryInstaller(t, dir, expect("", restartUnit...))
+	ctx := context.Background()
+
+	if err := b.Apply(ctx, release(t, "v1"), "v1"); err != nil {
+		t.Fatalf("Apply(v1) error = %v", err)
+	}
+	bad := writeArtefact(t, false, tarEntry{name: "../escape", body: "x"})
+	err := b.Apply(ctx, bad, "v2")
+	if err == nil || !strings.Contains(err.Error(), "unpack artefact") {
+		t.Fatalf("Apply() error = %v, want unpack artefact error", err)
+	}
+	assertRunning(t, dir, "v1")
+	if got := b.slotVersion(slotB); got != "" {
+		t.Errorf("slot b version = %q, want incomplete", got)
+	}
+}
+
+// A failed restart is surfaced after current has been switched, so a retry only restarts.
+func TestBinaryApply_RestartFails(t *testing.T) {
+	dir := t.TempDir()
+	b := newTestBinaryInstaller(t, dir,
+		expectErr(restartUnit...),
+		expect("", restartUnit...),
+	)
+	ctx := context.Background()
+	v1 := release(t, "v1")
+
+	err := b.Apply(ctx, v1, "v1")
+	if err == nil || !strings.Contains(err.Error(), "restart "+testUnit) {
+		t.Fatalf("Apply() error = %v, want restart error", err)
+	}
+	if err := b.Apply(ctx, v1, "v1"); err != nil {
+		t.Fatalf("Apply() retry error = %v", err)
+	}
+	assertRunning(t, dir, "v1")
+}
+
+// systemd's D-Bus API needs the unit type that systemctl would assume.
+func TestNewBinaryInstaller_DBusRestart(t *testing.T) {
+	var got []string
+	restartUnitDBus = func(_ context.Context, unit string) error {
+		got = append(got, unit)
+		return nil
+	}
+	t.Cleanup(func() { restartUnitDBus = dbusRestart })
+
+	for _, unit := range []string{testUnit, "sc-bos.service", "sc-bos@blue.service", "sc-bos.v2"} {
+		b := NewBinaryInstaller(t.TempDir(), unit, RestartDBus, nil)
+		if err := b.restartUnit(context.Background()); err != nil {
+			t.Fatalf("restart %s: %v", unit, err)
+		}
+	}
+	want := []string{"sc-bos.service", "sc-bos.service", "sc-bos@blue.service", "sc-bos.v2.service"}
+	if !slices.Equal(got, want) {
+		t.Errorf("restarted units %v, want %v", got, want)
+	}
+}
+
+func TestExtractTar(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []tarEntry
+		wantErr string
+	}{
+		{name: "plain", entries: []tarEntry{{name: "a/b/file", body: "x"}, {name: "link", link: "a/b"}}},
+		{name: "absolute", entries: []tarEntry{{name: "/etc/passwd", body: "x"}}, wantErr: "outside the artefact"},
+		{name: "parent", entries: []tarEntry{{name: "a/../../file", body: "x"}}, wantErr: "outside the artefact"},
+		{name: "absolute link", entries: []tarEntry{{name: "link", link: "/etc"}}, wantErr: "points outside"},
+		{name: "parent link", entries: []tarEntry{{name: "a/link", link: "../.."}}, wantErr: "points outside"},
+		{
+			// each link looks local on its own, but together they reach above dst
+			name: "chained links",
+			entries: []tarEntry{
+				{name: "top/"},
+				{name: "deep/"},
+				{name: "deep/up", link: "../top"},
+				{name: "deep/up/escape", link: "../.."},
+				{name: "deep/up/escape/file", body: "x"},
+			},
+			wantErr: "path escapes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := os.Open(writeArtefact(t, false, tt.entries...))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+			err = extractTar(f, t.TempDir())
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("extractTar() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("extractTar() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
-// Package install applies BOS update artefacts on a Rocky Linux / Podman host: it loads the artefact
-// image, moves the stable :current tag onto it (recording :previous as a rollback pointer), and
-// restarts only the BOS systemd unit. Confirmation that the new version is good is obtained out-of-band:
+// Package install applies BOS update artefacts to a host. On a Rocky Linux / Podman host PodmanInstaller
+// loads the artefact image, moves the stable :current tag onto it (recording :previous as a rollback
+// pointer), and restarts only the BOS systemd unit. Where BOS runs as a plain systemd service,
+// BinaryInstaller unpacks the artefact tarball into the inactive of two A/B directories and switches a
+// current symlink onto it, the other directory being the rollback pointer. Confirmation that the new version is good is obtained out-of-band:
 // BOS asserts its running version via the Supervisor's Commit RPC (see supervisor/docs/state-model.md),
 // so the Installer itself only applies and rolls back.
 package install
@@ -12,10 +14,11 @@ import "context"
 // platform surface is just apply and rollback.
 type Installer interface {
 	// Apply loads the artefact at artefactPath, records the rollback pointer, repoints the stable tag
-	// onto version, and restarts the BOS unit so it is recreated from the new image. It is idempotent:
+	// (or symlink) onto version, and restarts the BOS unit so it runs the new version. It is idempotent:
 	// re-applying an already-applied version is a no-op, so recovery can re-run it safely.
 	Apply(ctx context.Context, artefactPath, version string) error
-	// Rollback repoints the stable tag back to the previous image and restarts the BOS unit. It returns
-	// an error if there is no previous image to roll back to (e.g. a failed first install).
+	// Rollback repoints the stable tag (or symlink) back to the previous version and restarts the BOS
+	// unit. It returns an error if there is no previous version to roll back to (e.g. a failed first
+	// install).
 	Rollback(ctx context.Context) error
 }
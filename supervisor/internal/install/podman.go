@@ -22,7 +22,7 @@ type PodmanInstaller struct {
 	logger *zap.Logger
 
 	// run executes an external command and returns its combined output. It is a field so tests can
-	// substitute a fake that records the commands and returns prepared output; production uses execRun.
+	// substitute a fake that records the commands and returns prepared output; production uses execRunner.
 	run commandRunner
 }
 
@@ -35,13 +35,12 @@ func NewPodmanInstaller(repo, unit string, logger *zap.Logger) *PodmanInstaller
 	if logger == nil {
 		logger = zap.NewNop()
 	}
-	p := &PodmanInstaller{
+	return &PodmanInstaller{
 		repo:   repo,
 		unit:   unit,
 		logger: logger,
+		run:    execRunner(logger),
 	}
-	p.run = p.execRun
-	return p
 }
 
 func (p *PodmanInstaller) Apply(ctx context.Context, artefactPath, version string) error {
@@ -152,17 +151,20 @@ func (p *PodmanInstaller) systemctl(ctx context.Context, args ...string) (string
 	return p.run(ctx, "systemctl", args...)
 }
 
-func (p *PodmanInstaller) execRun(ctx context.Context, name string, args ...string) (string, error) {
-	p.logger.Debug("exec", zap.String("cmd", name), zap.Strings("args", args))
-	out, err := exec.CommandContext(ctx, name, args...).CombinedOutput()
-	if err == nil {
-		return string(out), nil
-	}
-	wrapped := fmt.Errorf("%s %s %w", name, strings.Join(args, " "), err)
-	if exitErr, ok := errors.AsType[*exec.ExitError](err); ok {
-		return string(out), &exitCodeError{code: exitErr.ExitCode(), err: wrapped}
+// execRunner returns a commandRunner that runs commands on the host, logging each to logger.
+func execRunner(logger *zap.Logger) commandRunner {
+	return func(ctx context.Context, name string, args ...string) (string, error) {
+		logger.Debug("exec", zap.String("cmd", name), zap.Strings("args", args))
+		out, err := exec.CommandContext(ctx, name, args...).CombinedOutput()
+		if err == nil {
+			return string(out), nil
+		}
+		wrapped := fmt.Errorf("%s %s %w", name, strings.Join(args, " "), err)
+		if exitErr, ok := errors.AsType[*exec.ExitError](err); ok {
+			return string(out), &exitCodeError{code: exitErr.ExitCode(), err: wrapped}
+		}
+		return string(out), wrapped
 	}
-	return string(out), wrapped
 }
 
 type exitCodeError struct {
@@ -43,7 +43,7 @@ func expect(out string, cmd ...string) step { return step{want: cmd, out: out} }
 func expectErr(cmd ...string) step { return step{want: cmd, err: errPodman} }
 
 // expectExit builds a step that expects cmd and returns an *exitCodeError with the given exit code, the
-// way execRun surfaces a command that ran but exited non-zero (e.g. podman image exists). This lets tests
+// way execRunner surfaces a command that ran but exited non-zero (e.g. podman image exists). This lets tests
 // drive imageExists' exit-code handling without a real podman.
 func expectExit(code int, cmd ...string) step {
 	return step{want: cmd, err: &exitCodeError{code: code, err: errPodman}}
@@ -0,0 +1,62 @@
+package install
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+
+	"github.com/coreos/go-systemd/v22/dbus"
+)
+
+// RestartMethod selects how an installer restarts the BOS systemd unit.
+type RestartMethod string
+
+const (
+	// RestartSystemctl restarts the unit by running systemctl restart.
+	RestartSystemctl RestartMethod = "systemctl"
+	// RestartDBus restarts the unit by asking systemd over the system D-Bus, for hosts where the Supervisor
+	// can't run systemctl, e.g. when it runs in a minimal container with the bus socket mounted.
+	RestartDBus RestartMethod = "dbus"
+)
+
+// unitTypes are the suffixes that name the type of a systemd unit.
+var unitTypes = []string{
+	".service", ".socket", ".device", ".mount", ".automount", ".swap", ".target", ".path", ".timer", ".slice", ".scope",
+}
+
+// fullUnitName returns unit with the .service suffix that systemctl assumes when unit doesn't name its type.
+// systemd's D-Bus API doesn't assume a type, rejecting names like "sc-bos" as invalid.
+func fullUnitName(unit string) string {
+	if slices.Contains(unitTypes, path.Ext(unit)) {
+		return unit
+	}
+	return unit + ".service"
+}
+
+// restartUnitDBus is dbusRestart, replaced in tests.
+var restartUnitDBus = dbusRestart
+
+// dbusRestart restarts unit via systemd's D-Bus API and waits for the restart job to finish, matching
+// the behaviour of systemctl restart.
+func dbusRestart(ctx context.Context, unit string) error {
+	conn, err := dbus.NewSystemConnectionContext(ctx)
+	if err != nil {
+		return fmt.Errorf("connect to systemd: %w", err)
+	}
+	defer conn.Close()
+
+	done := make(chan string, 1)
+	if _, err := conn.RestartUnitContext(ctx, unit, "replace", done); err != nil {
+		return fmt.Errorf("restart job: %w", err)
+	}
+	select {
+	case result := <-done:
+		if result != "done" {
+			return fmt.Errorf("restart job %s", result)
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,49 @@
+package install
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// ErrUnsigned is returned by VerifySignature when the artefact has no signature.
+var ErrUnsigned = errors.New("artefact is not signed")
+
+// ParsePublicKey parses a base64 encoded Ed25519 public key, as configured for the Supervisor.
+func ParsePublicKey(s string) (ed25519.PublicKey, error) {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("decode key: %w", err)
+	}
+	if len(data) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("key is %d bytes, want %d", len(data), ed25519.PublicKeySize)
+	}
+	return ed25519.PublicKey(data), nil
+}
+
+// VerifySignature checks that sig is a detached Ed25519ph (RFC 8032) signature, by key, of the artefact
+// at path. The pre-hashed variant is used so the artefact, which may be several GiB, is streamed through
+// SHA-512 rather than read into memory.
+func VerifySignature(path string, sig []byte, key ed25519.PublicKey) error {
+	if len(sig) == 0 {
+		return ErrUnsigned
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	h := sha512.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return fmt.Errorf("hash artefact: %w", err)
+	}
+	if err := ed25519.VerifyWithOptions(key, h.Sum(nil), sig, &ed25519.Options{Hash: crypto.SHA512}); err != nil {
+		return fmt.Errorf("artefact signature: %w", err)
+	}
+	return nil
+}
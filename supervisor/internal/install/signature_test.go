@@ -0,0 +1,68 @@
+package install
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVerifySignature(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherPub, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := []byte("artefact contents")
+	path := filepath.Join(t.TempDir(), "artefact.tar")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	h := sha512.Sum512(data)
+	sig, err := priv.Sign(nil, h[:], &ed25519.Options{Hash: crypto.SHA512})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := VerifySignature(path, sig, pub); err != nil {
+		t.Errorf("VerifySignature() error = %v", err)
+	}
+	if err := VerifySignature(path, nil, pub); !errors.Is(err, ErrUnsigned) {
+		t.Errorf("VerifySignature(unsigned) error = %v, want %v", err, ErrUnsigned)
+	}
+	if err := VerifySignature(path, sig, otherPub); err == nil {
+		t.Error("VerifySignature(other key) succeeded, want error")
+	}
+	// a signature of the raw bytes, not the pre-hashed digest, is not accepted
+	if err := VerifySignature(path, ed25519.Sign(priv, data), pub); err == nil {
+		t.Error("VerifySignature(pure Ed25519) succeeded, want error")
+	}
+}
+
+func TestParsePublicKey(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ParsePublicKey(base64.StdEncoding.EncodeToString(pub))
+	if err != nil {
+		t.Fatalf("ParsePublicKey() error = %v", err)
+	}
+	if !got.Equal(pub) {
+		t.Errorf("ParsePublicKey() = %x, want %x", got, pub)
+	}
+	if _, err := ParsePublicKey("not base64!"); err == nil {
+		t.Error("ParsePublicKey(not base64) succeeded, want error")
+	}
+	if _, err := ParsePublicKey(base64.StdEncoding.EncodeToString(pub[:16])); err == nil || !strings.Contains(err.Error(), "16 bytes") {
+		t.Errorf("ParsePublicKey(short) error = %v, want length error", err)
+	}
+}
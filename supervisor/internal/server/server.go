@@ -11,6 +11,7 @@ package server
 
 import (
 	"context"
+	"crypto/ed25519"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -46,6 +47,9 @@ type target struct {
 	// interpret it; it is persisted with the goal and echoed in the derived status so the caller can
 	// correlate this version-keyed update with its own record.
 	DeploymentID string `json:"deploymentId,omitempty"`
+	// Signature is the detached signature of the artefact, verified against the signing key before the
+	// artefact is applied.
+	Signature []byte `json:"signature,omitempty"`
 }
 
 // record is the durable state. The goal (Target) drives reconcile and recovery; Committed is the last
@@ -80,6 +84,7 @@ type Service struct {
 	httpClient             *http.Client
 	commitDeadline         time.Duration
 	allowInsecureDownloads bool
+	signingKey             ed25519.PublicKey
 	logger                 *zap.Logger
 
 	// used to cancel background tasks from Stop
@@ -108,8 +113,9 @@ type Service struct {
 // outcome (and Reconcile can resume an interrupted goal) rather than IDLE.
 //
 // allowInsecureDownloads permits non-HTTPS artefact URLs; it is a development-only escape hatch and
-// defaults to false in production.
-func New(installer install.Installer, stateDir string, httpClient *http.Client, commitDeadline time.Duration, allowInsecureDownloads bool, logger *zap.Logger) *Service {
+// defaults to false in production. If signingKey is non-nil every artefact must carry a signature by
+// it, which is verified before the artefact is applied.
+func New(installer install.Installer, stateDir string, httpClient *http.Client, commitDeadline time.Duration, allowInsecureDownloads bool, signingKey ed25519.PublicKey, logger *zap.Logger) *Service {
 	if logger == nil {
 		logger = zap.NewNop()
 	}
@@ -120,6 +126,7 @@ func New(installer install.Installer, stateDir string, httpClient *http.Client,
 		httpClient:             httpClient,
 		commitDeadline:         commitDeadline,
 		allowInsecureDownloads: allowInsecureDownloads,
+		signingKey:             signingKey,
 		logger:                 logger,
 	}
 	s.ctx, s.cancel = context.WithCancel(context.Background())
@@ -141,6 +148,9 @@ func (s *Service) InstallUpdate(_ context.Context, req *supervisorpb.InstallUpda
 	if !ocitag.Valid(req.GetVersion()) {
 		return nil, status.Error(codes.InvalidArgument, "version is not a valid image tag")
 	}
+	if s.signingKey != nil && len(req.GetSignature()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "signature is required")
+	}
 
 	s.mu.Lock()
 	if s.reconciling {
@@ -152,7 +162,7 @@ func (s *Service) InstallUpdate(_ context.Context, req *supervisorpb.InstallUpda
 	// any host mutation, so a crash mid-install is recoverable; the URL/checksum it carries let recovery
 	// resume by re-fetching.
 	s.rec = record{
-		Target:    &target{Version: req.GetVersion(), URL: req.GetDownloadUrl(), Checksum: req.GetChecksum(), DeploymentID: req.GetDeploymentId()},
+		Target:    &target{Version: req.GetVersion(), URL: req.GetDownloadUrl(), Checksum: req.GetChecksum(), DeploymentID: req.GetDeploymentId(), Signature: req.GetSignature()},
 		Committed: s.rec.Committed,
 		StartTime: &now,
 	}
@@ -198,6 +208,12 @@ func (s *Service) reconcile(ctx context.Context, t target) {
 		return
 	}
 	defer func() { _ = os.Remove(artefact) }()
+	if s.signingKey != nil {
+		if err := install.VerifySignature(artefact, t.Signature, s.signingKey); err != nil {
+			s.settleFailed(fmt.Sprintf("download: %v", err))
+			return
+		}
+	}
 
 	// To wait for the new BOS version to commit, we record the gen of the previous version before restarting
 	// This should be done as late as possible, to minimise the chance of external interference. But we must do
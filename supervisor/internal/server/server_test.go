@@ -3,8 +3,11 @@ package server
 import (
 	"bytes"
 	"context"
+	"crypto"
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -128,7 +131,7 @@ func zeroSum() string { return checksum.Format(checksum.SHA256, make([]byte, sha
 
 func newService(t *testing.T, fake *fakeInstaller, dir string, client *http.Client) *Service {
 	t.Helper()
-	return New(fake, dir, client, testDeadline, false, nil)
+	return New(fake, dir, client, testDeadline, false, nil, nil)
 }
 
 func testClient(t *testing.T, svc *Service) supervisorpb.SupervisorApiClient {
@@ -560,6 +563,82 @@ func TestInstallUpdate_FailedDownload(t *testing.T) {
 	})
 }
 
+// With a signing key, an unsigned request is rejected up front and an artefact whose signature does not
+// verify is never applied.
+func TestInstallUpdate_Signature(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sign := func(t *testing.T, data []byte) []byte {
+		t.Helper()
+		h := sha512.Sum512(data)
+		sig, err := priv.Sign(nil, h[:], &ed25519.Options{Hash: crypto.SHA512})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sig
+	}
+	installSigned := func(c supervisorpb.SupervisorApiClient, url, sum string, sig []byte) error {
+		_, err := c.InstallUpdate(context.Background(), &supervisorpb.InstallUpdateRequest{
+			Version: "v2", DownloadUrl: url, Checksum: sum, Signature: sig,
+		})
+		return err
+	}
+
+	t.Run("unsigned", func(t *testing.T) {
+		_, url, sum, client := artefactServer(t)
+		c := testClient(t, New(&fakeInstaller{}, t.TempDir(), client, testDeadline, false, pub, nil))
+
+		err := installSigned(c, url, sum, nil)
+		if got := status.Code(err); got != codes.InvalidArgument {
+			t.Errorf("status code = %v, want %v", got, codes.InvalidArgument)
+		}
+	})
+
+	t.Run("bad signature", func(t *testing.T) {
+		_, url, sum, client := artefactServer(t)
+		fake := &fakeInstaller{}
+		c := testClient(t, New(fake, t.TempDir(), client, testDeadline, false, pub, nil))
+
+		synctest.Test(t, func(t *testing.T) {
+			if err := installSigned(c, url, sum, sign(t, []byte("something else"))); err != nil {
+				t.Fatal(err)
+			}
+			synctest.Wait()
+
+			st := getStatus(t, c)
+			if got := st.GetState(); got != supervisorpb.UpdateStatus_FAILED {
+				t.Errorf("state = %v, want %v", got, supervisorpb.UpdateStatus_FAILED)
+			}
+			if !strings.Contains(st.GetError(), "signature") {
+				t.Errorf("want signature error, got %q", st.GetError())
+			}
+			assertCalls(t, fake)
+		})
+	})
+
+	t.Run("signed", func(t *testing.T) {
+		data, url, sum, client := artefactServer(t)
+		fake := &fakeInstaller{}
+		c := testClient(t, New(fake, t.TempDir(), client, testDeadline, false, pub, nil))
+
+		synctest.Test(t, func(t *testing.T) {
+			if err := installSigned(c, url, sum, sign(t, data)); err != nil {
+				t.Fatal(err)
+			}
+			synctest.Wait()
+			commit(t, c, "v2")
+			synctest.Wait()
+
+			if got := getStatus(t, c).GetState(); got != supervisorpb.UpdateStatus_COMPLETED {
+				t.Errorf("state = %v, want %v", got, supervisorpb.UpdateStatus_COMPLETED)
+			}
+			assertCalls(t, fake, "Apply")
+		})
+	})
+}
+
 // The new version never commits within the deadline: the Supervisor rolls back to the previous image,
 // the previous version commits on its way back up confirming the rollback, and the update is FAILED.
 func TestInstallUpdate_RollbackOnNoCommit(t *testing.T) {
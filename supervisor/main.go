@@ -7,6 +7,7 @@ package main
 
 import (
 	"context"
+	"crypto/ed25519"
 	"errors"
 	"flag"
 	"fmt"
@@ -67,8 +68,21 @@ func run() error {
 		return fmt.Errorf("chmod socket: %w", err)
 	}
 
-	installer := install.NewPodmanInstaller(cfg.ImageRepo, cfg.Unit, logger.Named("installer"))
-	svc := server.New(installer, cfg.StateDir, http.DefaultClient, cfg.CommitDeadline.Duration, cfg.AllowInsecureDownloads, logger.Named("server"))
+	var installer install.Installer
+	switch cfg.Installer {
+	case "binary":
+		installer = install.NewBinaryInstaller(cfg.InstallDir, cfg.Unit, install.RestartMethod(cfg.Restart), logger.Named("installer"))
+	default:
+		installer = install.NewPodmanInstaller(cfg.ImageRepo, cfg.Unit, logger.Named("installer"))
+	}
+	var signingKey ed25519.PublicKey
+	if cfg.SigningKey != "" {
+		signingKey, err = install.ParsePublicKey(cfg.SigningKey)
+		if err != nil {
+			return fmt.Errorf("signing key: %w", err)
+		}
+	}
+	svc := server.New(installer, cfg.StateDir, http.DefaultClient, cfg.CommitDeadline.Duration, cfg.AllowInsecureDownloads, signingKey, logger.Named("server"))
 	// Drive the persisted goal to completion before accepting new requests: an install interrupted by a
 	// previous crash is resumed (or rolled back) here, so the local auto-recovery fires even across a
 	// Supervisor crash. A fresh InstallUpdate runs the same reconcile.
@@ -310,8 +310,17 @@ export function useDeviceFilters(forcedFilters) {
           cond.matcher = Device.Query.Condition.Matcher.ALL;
           cond.stringEqual = 'NORMAL';
         } else {
-          // any check should be abnormal to be classed as unhealthy
-          cond.stringIn = {stringsList: ['ABNORMAL', 'HIGH', 'LOW']}
+          // any check should be abnormal, outside of maintenance, to be classed as unhealthy
+          return {
+            field: 'health_checks',
+            matches: {
+              conditionsList: [
+                {field: 'normality', stringIn: {stringsList: ['ABNORMAL', 'HIGH', 'LOW']}},
+                // checks of devices in maintenance are expected to be abnormal
+                {field: 'maintenance', matcher: Device.Query.Condition.Matcher.NONE, present: {}}
+              ]
+            }
+          };
         }
         return cond;
       }
@@ -6,6 +6,7 @@ import {usePullDevicesMetadata, useDevicesMetadataField} from '@/composables/dev
 import {format} from '@/util/number.js';
 import {hasOneOf} from '@/util/proto.js';
 import {toQueryObject, watchResource} from '@/util/traits.js';
+import {Device} from '@smart-core-os/sc-bos-ui-gen/proto/smartcore/bos/devices/v1/devices_pb';
 import {HealthCheck} from '@smart-core-os/sc-bos-ui-gen/proto/smartcore/bos/health/v1/health_pb';
 import {computed, onScopeDispose, reactive, toRefs, toValue} from 'vue';
 
@@ -71,22 +72,52 @@ export function countChecksByNormality(checks, state) {
   }, 0);
 }
 
+/**
+ * A device query condition, relative to a health check, that matches checks of devices that aren't in maintenance.
+ * Use it alongside normality conditions in a health_checks matches query.
+ *
+ * @type {Partial<Device.Query.Condition.AsObject>}
+ */
+export const notInMaintenanceCondition = {
+  field: 'maintenance',
+  matcher: Device.Query.Condition.Matcher.NONE,
+  present: {}
+};
+
+/**
+ * Returns whether the check is abnormal and needs attention.
+ * Checks of devices in maintenance are expected to be abnormal, so they don't need attention.
+ *
+ * @param {import('@smart-core-os/sc-bos-ui-gen/proto/smartcore/bos/health/v1/health_pb').HealthCheck.AsObject} check
+ * @return {boolean}
+ */
+export function isAbnormal(check) {
+  return (check?.normality ?? 0) > HealthCheck.Normality.NORMAL && !check.maintenance;
+}
+
 /**
  * Counts the number of normal and abnormal checks.
+ * Abnormal checks of devices in maintenance are counted in the total, but not as abnormal.
  *
  * @param {Array<import('@smart-core-os/sc-bos-ui-gen/proto/smartcore/bos/health/v1/health_pb').HealthCheck.AsObject>} checks
  * @return {{normalCount: number, abnormalCount: number, totalCount: number}}
  */
 export function countChecks(checks) {
   const normalCount = countChecksByNormality(checks, HealthCheck.Normality.NORMAL);
-  const abnormalCount = checks?.reduce((acc, check) => {
-    if (check.normality > HealthCheck.Normality.NORMAL) acc++;
-    return acc;
-  }, 0);
+  let abnormalCount = 0;
+  let maintenanceCount = 0;
+  for (const check of checks ?? []) {
+    if (check.normality <= HealthCheck.Normality.NORMAL) continue;
+    if (isAbnormal(check)) {
+      abnormalCount++;
+    } else {
+      maintenanceCount++;
+    }
+  }
   return {
     normalCount,
     abnormalCount,
-    totalCount: normalCount + abnormalCount,
+    totalCount: normalCount + abnormalCount + maintenanceCount,
   }
 }
 
@@ -1,4 +1,5 @@
 import {usePullDevicesMetadata} from '@/composables/devices.js';
+import {notInMaintenanceCondition} from '@/traits/health/health.js';
 import {useRollingHistory} from '@/traits/health/rollingHistory.js';
 import {computed, toValue} from 'vue';
 
@@ -118,10 +119,11 @@ function useImpactTable(conditions, opts) {
       query: {
         conditionsList: [
           {
-            // devices with health checks that are both abnormal and have the specified impact
+            // devices with health checks that are abnormal outside of maintenance and have the specified impact
             field: 'health_checks', matches: {
               conditionsList: [
                 {field: 'normality', stringIn: {stringsList: ['ABNORMAL', 'HIGH', 'LOW']}},
+                notInMaintenanceCondition,
                 {field: opts.impactField, stringIn: {stringsList: opts.fields.map(f => f.key)}}
               ]
             }